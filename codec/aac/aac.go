@@ -0,0 +1,270 @@
+// +build cgo
+
+package aac
+
+/*
+#cgo LDFLAGS: -lfaad
+#include <neaacdec.h>
+*/
+import "C"
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+	"unsafe"
+
+	"github.com/dhowden/tag"
+	"github.com/mjibson/moggio/codec"
+)
+
+func init() {
+	codec.RegisterCodec("AAC", []string{"????ftyp"}, []string{"m4a", "mp4"}, NewMP4, nil)
+	codec.RegisterCodec("ADTS", []string{"\xff\xf1", "\xff\xf9"}, []string{"aac"}, NewADTS, nil)
+}
+
+// NewMP4 returns the AAC track of an MP4 file.
+func NewMP4(rf codec.Reader) (codec.Songs, error) {
+	return codec.Songs{codec.None: &AAC{Reader: rf}}, nil
+}
+
+// NewADTS returns a raw AAC stream with ADTS headers.
+func NewADTS(rf codec.Reader) (codec.Songs, error) {
+	return codec.Songs{codec.None: &AAC{Reader: rf, adts: true}}, nil
+}
+
+type AAC struct {
+	Reader codec.Reader
+	adts   bool
+	r      io.ReadCloser
+	dec    C.NeAACDecHandle
+	sr, ch int
+	// In MP4 files, t locates the frames in rs, and next is the index of the
+	// next frame. ADTS frames are read in sequence from br.
+	rs   io.ReadSeeker
+	t    *track
+	next int
+	br   *bufio.Reader
+	// samples holds decoded samples not yet returned by Play.
+	samples []float32
+	// skip is the number of samples to drop after a seek.
+	skip int
+	info *codec.SongInfo
+}
+
+func (a *AAC) Init() (sampleRate, channels int, err error) {
+	if a.dec == nil {
+		if err := a.open(); err != nil {
+			a.Close()
+			return 0, 0, err
+		}
+	}
+	return a.sr, a.ch, nil
+}
+
+func (a *AAC) open() error {
+	r, _, err := a.Reader()
+	if err != nil {
+		return err
+	}
+	a.r = r
+	a.dec = C.NeAACDecOpen()
+	conf := C.NeAACDecGetCurrentConfiguration(a.dec)
+	conf.outputFormat = C.FAAD_FMT_FLOAT
+	C.NeAACDecSetConfiguration(a.dec, conf)
+	var sr C.ulong
+	var ch C.uchar
+	if a.adts {
+		a.br = bufio.NewReader(r)
+		if err := skipID3(a.br); err != nil {
+			return err
+		}
+		h, err := a.br.Peek(7)
+		if err != nil {
+			return err
+		}
+		if C.NeAACDecInit(a.dec, (*C.uchar)(unsafe.Pointer(&h[0])), C.ulong(len(h)), &sr, &ch) < 0 {
+			return errADTS
+		}
+	} else {
+		rs, ok := r.(io.ReadSeeker)
+		if !ok {
+			b, err := ioutil.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			rs = bytes.NewReader(b)
+		}
+		t, err := parseMP4(rs)
+		if err != nil {
+			return err
+		}
+		if len(t.config) == 0 || C.NeAACDecInit2(a.dec, (*C.uchar)(unsafe.Pointer(&t.config[0])), C.ulong(len(t.config)), &sr, &ch) < 0 {
+			return fmt.Errorf("aac: unsupported audio config")
+		}
+		a.rs = rs
+		a.t = t
+		a.next = 0
+	}
+	a.sr, a.ch = int(sr), int(ch)
+	a.samples = nil
+	a.skip = 0
+	return nil
+}
+
+func (a *AAC) Info() (codec.SongInfo, error) {
+	if a.info != nil {
+		return *a.info, nil
+	}
+	var si *codec.SongInfo
+	if a.adts {
+		r, _, err := a.Reader()
+		if err != nil {
+			return codec.SongInfo{}, err
+		}
+		b, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return codec.SongInfo{}, err
+		}
+		si = new(codec.SongInfo)
+		if bytes.HasPrefix(b, []byte("ID3")) {
+			if s, _, _, err := a.Reader.Metadata(tag.MP3); err == nil {
+				si = s
+			}
+		}
+		n, sr, err := adtsSamples(bytes.NewReader(b))
+		if err != nil && n == 0 {
+			return codec.SongInfo{}, err
+		}
+		if sr > 0 {
+			si.Time = time.Duration(n) * time.Second / time.Duration(sr)
+		}
+		si.SampleRate = sr
+		si.Codec = "ADTS"
+		si.SetBitrate(int64(len(b)))
+	} else {
+		s, _, b, err := a.Reader.Metadata(tag.AAC)
+		if err != nil {
+			return codec.SongInfo{}, err
+		}
+		t, err := parseMP4(bytes.NewReader(b))
+		if err != nil {
+			return codec.SongInfo{}, err
+		}
+		si = s
+		if t.timescale > 0 {
+			si.Time = time.Duration(t.duration) * time.Second / time.Duration(t.timescale)
+		}
+		// The media time scale is the sample rate for audio tracks.
+		si.SampleRate = int(t.timescale)
+		si.Codec = "AAC"
+		si.SetBitrate(int64(len(b)))
+	}
+	a.info = si
+	return *si, nil
+}
+
+// frame returns the next encoded frame, or io.EOF after the last one.
+func (a *AAC) frame() ([]byte, error) {
+	if a.adts {
+		b, _, err := nextADTS(a.br)
+		return b, err
+	}
+	if a.next >= len(a.t.sizes) {
+		return nil, io.EOF
+	}
+	b := make([]byte, a.t.sizes[a.next])
+	if _, err := a.rs.Seek(a.t.offsets[a.next], io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(a.rs, b); err != nil {
+		return nil, err
+	}
+	a.next++
+	return b, nil
+}
+
+func (a *AAC) Play(n int) ([]float32, error) {
+	var err error
+	for len(a.samples) < n {
+		var b []byte
+		b, err = a.frame()
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// The end of the stream, possibly in the middle of a frame.
+			err = nil
+			if len(a.samples) == 0 {
+				err = io.EOF
+			}
+			break
+		} else if err != nil {
+			break
+		}
+		if len(b) == 0 {
+			continue
+		}
+		var fi C.NeAACDecFrameInfo
+		p := C.NeAACDecDecode(a.dec, &fi, (*C.uchar)(unsafe.Pointer(&b[0])), C.ulong(len(b)))
+		if fi.error != 0 {
+			err = fmt.Errorf("aac: %s", C.GoString(C.NeAACDecGetErrorMessage(fi.error)))
+			break
+		}
+		if p == nil || fi.samples == 0 {
+			continue
+		}
+		d := (*[1 << 28]float32)(p)[:fi.samples:fi.samples]
+		if a.skip > 0 {
+			s := a.skip
+			if s > len(d) {
+				s = len(d)
+			}
+			d = d[s:]
+			a.skip -= s
+		}
+		a.samples = append(a.samples, d...)
+	}
+	if n > len(a.samples) {
+		n = len(a.samples)
+	}
+	ret := a.samples[:n]
+	a.samples = a.samples[n:]
+	return ret, err
+}
+
+// Seek starts decoding at the frame before the one containing offset, since
+// each frame overlaps the previous one, and drops the samples before offset.
+func (a *AAC) Seek(offset time.Duration) error {
+	if a.t == nil || a.t.delta == 0 || a.t.timescale == 0 {
+		return codec.ErrSeek
+	}
+	target := uint64(int64(offset) * int64(a.t.timescale) / int64(time.Second))
+	i := int(target / uint64(a.t.delta))
+	if i > 0 {
+		i--
+	}
+	if i > len(a.t.sizes) {
+		i = len(a.t.sizes)
+	}
+	rem := target - uint64(i)*uint64(a.t.delta)
+	C.NeAACDecPostSeekReset(a.dec, C.long(i))
+	a.next = i
+	a.samples = nil
+	a.skip = int(rem*uint64(a.sr)/uint64(a.t.timescale)) * a.ch
+	return nil
+}
+
+func (a *AAC) Close() {
+	if a.dec != nil {
+		C.NeAACDecClose(a.dec)
+		a.dec = nil
+	}
+	if a.r != nil {
+		a.r.Close()
+		a.r = nil
+	}
+	a.rs = nil
+	a.br = nil
+}
@@ -0,0 +1,49 @@
+// +build cgo
+
+package aac
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+func TestPlay(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{"whole", testADTS(5)},
+		{"truncated", testADTS(5)[:50]},
+	}
+	for _, test := range tests {
+		a := &AAC{adts: true, Reader: func() (io.ReadCloser, int64, error) {
+			return ioutil.NopCloser(bytes.NewReader(test.b)), int64(len(test.b)), nil
+		}}
+		if sr, ch, err := a.Init(); sr != 44100 || ch != 1 || err != nil {
+			t.Fatalf("%s: got %v, %v, %v", test.name, sr, ch, err)
+		}
+		got := 0
+		for i := 0; ; i++ {
+			if i > 10 {
+				t.Fatalf("%s: did not end", test.name)
+			}
+			s, err := a.Play(1000)
+			got += len(s)
+			if err == io.EOF {
+				if len(s) != 0 {
+					t.Fatalf("%s: got %d samples at EOF", test.name, len(s))
+				}
+				break
+			} else if err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+		}
+		a.Close()
+		// The decoder may hold back the first frame.
+		if frames := len(test.b) / 11; got%1024 != 0 || got > frames*1024 || got < (frames-1)*1024 {
+			t.Errorf("%s: got %d samples from %d frames", test.name, got, frames)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package aac
+
+import (
+	"bufio"
+	"errors"
+	"io"
+)
+
+var errADTS = errors.New("aac: bad ADTS frame")
+
+// adtsRates are the sample rates of the ADTS sampling frequency indexes.
+var adtsRates = []int{96000, 88200, 64000, 48000, 44100, 32000, 24000, 22050, 16000, 12000, 11025, 8000, 7350}
+
+// skipID3 discards an ID3v2 tag at the start of r, if any.
+func skipID3(r *bufio.Reader) error {
+	h, err := r.Peek(10)
+	if err != nil || string(h[:3]) != "ID3" {
+		return nil
+	}
+	// The size is a 28 bit syncsafe integer.
+	n := int(h[6])<<21 | int(h[7])<<14 | int(h[8])<<7 | int(h[9])
+	n += 10
+	if h[5]&0x10 != 0 {
+		// Footer.
+		n += 10
+	}
+	_, err = r.Discard(n)
+	return err
+}
+
+// nextADTS returns the next ADTS frame, including its header, and its sample
+// rate.
+func nextADTS(r *bufio.Reader) ([]byte, int, error) {
+	h, err := r.Peek(7)
+	if err == io.EOF || (err != nil && len(h) == 0) {
+		return nil, 0, io.EOF
+	} else if err != nil {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	if h[0] != 0xff || h[1]&0xf6 != 0xf0 {
+		return nil, 0, errADTS
+	}
+	idx := int(h[2]>>2) & 0xf
+	if idx >= len(adtsRates) {
+		return nil, 0, errADTS
+	}
+	n := int(h[3]&3)<<11 | int(h[4])<<3 | int(h[5]>>5)
+	if n < 7 {
+		return nil, 0, errADTS
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	return b, adtsRates[idx], nil
+}
+
+// adtsSamples returns the number of samples per channel and the sample rate
+// of the ADTS stream in r. Each frame has 1024 samples.
+func adtsSamples(r io.Reader) (int64, int, error) {
+	br := bufio.NewReader(r)
+	if err := skipID3(br); err != nil {
+		return 0, 0, err
+	}
+	var n int64
+	var rate int
+	for {
+		_, sr, err := nextADTS(br)
+		if err == io.EOF {
+			return n, rate, nil
+		} else if err != nil {
+			return n, rate, err
+		}
+		n += 1024
+		rate = sr
+	}
+}
@@ -0,0 +1,84 @@
+package aac
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+)
+
+// testRawBlock is a silent mono raw data block: a long window with no scale
+// factor bands, then the end element.
+const testRawBlock = "\x01\x00\x00\x07"
+
+// testADTS returns frames silent AAC-LC ADTS frames of mono audio at 44.1kHz.
+func testADTS(frames int) []byte {
+	n := 7 + len(testRawBlock)
+	h := []byte{
+		0xff, 0xf1,
+		// LC profile, rate index 4 and one channel.
+		1<<6 | 4<<2,
+		1<<6 | byte(n>>11)&3,
+		byte(n >> 3),
+		byte(n&7)<<5 | 0x1f,
+		0xfc,
+	}
+	var b []byte
+	for i := 0; i < frames; i++ {
+		b = append(b, h...)
+		b = append(b, testRawBlock...)
+	}
+	return b
+}
+
+func TestNextADTS(t *testing.T) {
+	b := testADTS(2)
+	r := bufio.NewReader(bytes.NewReader(b))
+	for i := 0; i < 2; i++ {
+		f, sr, err := nextADTS(r)
+		if err != nil || sr != 44100 || !bytes.Equal(f, b[:len(b)/2]) {
+			t.Fatalf("frame %d: got %x, %d, %v", i, f, sr, err)
+		}
+	}
+	if _, _, err := nextADTS(r); err != io.EOF {
+		t.Fatalf("got %v at the end", err)
+	}
+
+	tests := []struct {
+		name string
+		b    []byte
+		err  error
+	}{
+		// Too short to be a header, so trailing bytes are ignored.
+		{"short header", b[:5], io.EOF},
+		{"short frame", b[:9], io.ErrUnexpectedEOF},
+		{"no sync", append([]byte{0}, b...), errADTS},
+		{"bad rate", append([]byte{0xff, 0xf1, 0xfc}, b[3:]...), errADTS},
+	}
+	for _, test := range tests {
+		if _, _, err := nextADTS(bufio.NewReader(bytes.NewReader(test.b))); err != test.err {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.err)
+		}
+	}
+}
+
+func TestADTSSamples(t *testing.T) {
+	tag := "ID3\x04\x00\x00\x00\x00\x00\x02\x00\x00"
+	tests := []struct {
+		name string
+		b    []byte
+		n    int64
+		err  error
+	}{
+		{"empty", nil, 0, nil},
+		{"frames", testADTS(3), 3 * 1024, nil},
+		{"tag", append([]byte(tag), testADTS(3)...), 3 * 1024, nil},
+		{"truncated", testADTS(3)[:30], 2 * 1024, io.ErrUnexpectedEOF},
+	}
+	for _, test := range tests {
+		n, sr, err := adtsSamples(bytes.NewReader(test.b))
+		if n != test.n || err != test.err || n > 0 && sr != 44100 {
+			t.Errorf("%s: got %d, %d, %v, want %d, %v", test.name, n, sr, err, test.n, test.err)
+		}
+	}
+}
@@ -0,0 +1,339 @@
+package aac
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+var errMP4 = errors.New("aac: no AAC track found")
+
+// track is the first AAC track of an MP4 file.
+type track struct {
+	// config is the AudioSpecificConfig from the esds box.
+	config []byte
+	// timescale is the number of time units per second, and duration the
+	// length of the track in them.
+	timescale uint32
+	duration  uint64
+	// delta is the duration of each frame.
+	delta uint32
+	// offsets and sizes locate each frame in the file.
+	offsets []int64
+	sizes   []uint32
+}
+
+// box is an MP4 box whose contents are in data.
+type box struct {
+	typ  string
+	data []byte
+}
+
+// readBoxes splits b into boxes.
+func readBoxes(b []byte) ([]box, error) {
+	var boxes []box
+	for len(b) > 0 {
+		if len(b) < 8 {
+			return nil, fmt.Errorf("aac: short box")
+		}
+		size := uint64(binary.BigEndian.Uint32(b))
+		typ := string(b[4:8])
+		hdr := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(b))
+		case 1:
+			if len(b) < 16 {
+				return nil, fmt.Errorf("aac: short box")
+			}
+			size = binary.BigEndian.Uint64(b[8:])
+			hdr = 16
+		}
+		if size < hdr || size > uint64(len(b)) {
+			return nil, fmt.Errorf("aac: bad %q box size", typ)
+		}
+		boxes = append(boxes, box{typ: typ, data: b[hdr:size]})
+		b = b[size:]
+	}
+	return boxes, nil
+}
+
+// child returns the contents of the first child of b with the given path.
+func child(b []byte, path ...string) []byte {
+	for _, p := range path {
+		boxes, err := readBoxes(b)
+		if err != nil {
+			return nil
+		}
+		b = nil
+		for _, x := range boxes {
+			if x.typ == p {
+				b = x.data
+				break
+			}
+		}
+		if b == nil {
+			return nil
+		}
+	}
+	return b
+}
+
+// findMoov reads the top level boxes of r and returns the contents of the
+// moov box.
+func findMoov(r io.ReadSeeker) ([]byte, error) {
+	var off int64
+	hdr := make([]byte, 16)
+	for {
+		if _, err := r.Seek(off, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(r, hdr[:8]); err != nil {
+			if err == io.EOF {
+				return nil, errMP4
+			}
+			return nil, err
+		}
+		size := int64(binary.BigEndian.Uint32(hdr))
+		typ := string(hdr[4:8])
+		n := int64(8)
+		if size == 1 {
+			if _, err := io.ReadFull(r, hdr[8:]); err != nil {
+				return nil, err
+			}
+			size = int64(binary.BigEndian.Uint64(hdr[8:]))
+			n = 16
+		}
+		if size == 0 && typ != "moov" {
+			return nil, errMP4
+		}
+		if size != 0 && size < n {
+			return nil, fmt.Errorf("aac: bad %q box size", typ)
+		}
+		if typ == "moov" {
+			if size == 0 {
+				b, err := ioutil.ReadAll(r)
+				return b, err
+			}
+			b := make([]byte, size-n)
+			_, err := io.ReadFull(r, b)
+			return b, err
+		}
+		off += size
+	}
+}
+
+// parseMP4 finds the first AAC track in r and its sample table.
+func parseMP4(r io.ReadSeeker) (*track, error) {
+	moov, err := findMoov(r)
+	if err != nil {
+		return nil, err
+	}
+	boxes, err := readBoxes(moov)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range boxes {
+		if b.typ != "trak" {
+			continue
+		}
+		t, err := parseTrak(b.data)
+		if err == errMP4 {
+			continue
+		}
+		return t, err
+	}
+	return nil, errMP4
+}
+
+func parseTrak(trak []byte) (*track, error) {
+	mdia := child(trak, "mdia")
+	stbl := child(mdia, "minf", "stbl")
+	stsd := child(stbl, "stsd")
+	if len(stsd) < 8 {
+		return nil, errMP4
+	}
+	entries, err := readBoxes(stsd[8:])
+	if err != nil || len(entries) == 0 || entries[0].typ != "mp4a" {
+		return nil, errMP4
+	}
+	t := new(track)
+	if t.config, err = parseMP4A(entries[0].data); err != nil {
+		return nil, err
+	}
+	if mdhd := child(mdia, "mdhd"); len(mdhd) >= 24 && mdhd[0] == 0 {
+		t.timescale = binary.BigEndian.Uint32(mdhd[12:])
+		t.duration = uint64(binary.BigEndian.Uint32(mdhd[16:]))
+	} else if len(mdhd) >= 32 {
+		t.timescale = binary.BigEndian.Uint32(mdhd[20:])
+		t.duration = binary.BigEndian.Uint64(mdhd[24:])
+	}
+	if stts := child(stbl, "stts"); len(stts) >= 16 {
+		// Only the first entry is used since AAC frames have a fixed size.
+		t.delta = binary.BigEndian.Uint32(stts[12:])
+	}
+	if err := t.parseSamples(stbl); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// parseMP4A returns the AudioSpecificConfig in an mp4a sample entry.
+func parseMP4A(b []byte) ([]byte, error) {
+	// The sound sample description is 28 bytes in version 0, with 16 or 36
+	// more in QuickTime versions 1 and 2.
+	if len(b) < 28 {
+		return nil, errMP4
+	}
+	n := 28
+	switch binary.BigEndian.Uint16(b[8:]) {
+	case 1:
+		n += 16
+	case 2:
+		n += 36
+	}
+	if len(b) < n {
+		return nil, errMP4
+	}
+	esds := child(b[n:], "esds")
+	if esds == nil {
+		// QuickTime puts it in a wave box.
+		esds = child(b[n:], "wave", "esds")
+	}
+	if len(esds) < 4 {
+		return nil, errMP4
+	}
+	return decoderConfig(esds[4:])
+}
+
+// decoderConfig returns the DecoderSpecificInfo in an ES_Descriptor.
+func decoderConfig(b []byte) ([]byte, error) {
+	for len(b) > 0 {
+		tag := b[0]
+		b = b[1:]
+		// The length is a big endian base 128 number of up to four bytes.
+		var size int
+		for i := 0; i < 4 && len(b) > 0; i++ {
+			c := b[0]
+			b = b[1:]
+			size = size<<7 | int(c&0x7f)
+			if c&0x80 == 0 {
+				break
+			}
+		}
+		if size > len(b) {
+			return nil, errMP4
+		}
+		switch tag {
+		case 3:
+			// ES_Descriptor: ES_ID, flags and their optional fields.
+			if size < 3 {
+				return nil, errMP4
+			}
+			flags := b[2]
+			n := 3
+			if flags&0x80 != 0 {
+				n += 2
+			}
+			if flags&0x40 != 0 && n < size {
+				n += 1 + int(b[n])
+			}
+			if flags&0x20 != 0 {
+				n += 2
+			}
+			if n > size {
+				return nil, errMP4
+			}
+			b = b[n:size]
+		case 4:
+			// DecoderConfigDescriptor: object type, stream type, buffer
+			// size and bit rates.
+			if size < 13 || b[0] != 0x40 {
+				return nil, errMP4
+			}
+			b = b[13:size]
+		case 5:
+			return b[:size], nil
+		default:
+			b = b[size:]
+		}
+	}
+	return nil, errMP4
+}
+
+// parseSamples reads the sample sizes and offsets from the sample table.
+func (t *track) parseSamples(stbl []byte) error {
+	stsz := child(stbl, "stsz")
+	if len(stsz) < 12 {
+		return errMP4
+	}
+	fixed := binary.BigEndian.Uint32(stsz[4:])
+	count := int(binary.BigEndian.Uint32(stsz[8:]))
+	if fixed == 0 && len(stsz) < 12+4*count {
+		return errMP4
+	}
+	t.sizes = make([]uint32, count)
+	for i := range t.sizes {
+		if fixed != 0 {
+			t.sizes[i] = fixed
+		} else {
+			t.sizes[i] = binary.BigEndian.Uint32(stsz[12+4*i:])
+		}
+	}
+
+	var chunks []int64
+	if stco := child(stbl, "stco"); len(stco) >= 8 {
+		n := int(binary.BigEndian.Uint32(stco[4:]))
+		if len(stco) < 8+4*n {
+			return errMP4
+		}
+		for i := 0; i < n; i++ {
+			chunks = append(chunks, int64(binary.BigEndian.Uint32(stco[8+4*i:])))
+		}
+	} else if co64 := child(stbl, "co64"); len(co64) >= 8 {
+		n := int(binary.BigEndian.Uint32(co64[4:]))
+		if len(co64) < 8+8*n {
+			return errMP4
+		}
+		for i := 0; i < n; i++ {
+			chunks = append(chunks, int64(binary.BigEndian.Uint64(co64[8+8*i:])))
+		}
+	} else {
+		return errMP4
+	}
+
+	stsc := child(stbl, "stsc")
+	if len(stsc) < 8 {
+		return errMP4
+	}
+	n := int(binary.BigEndian.Uint32(stsc[4:]))
+	if len(stsc) < 8+12*n {
+		return errMP4
+	}
+	t.offsets = make([]int64, 0, count)
+	for i := 0; i < n; i++ {
+		e := stsc[8+12*i:]
+		first := int(binary.BigEndian.Uint32(e)) - 1
+		per := int(binary.BigEndian.Uint32(e[4:]))
+		last := len(chunks)
+		if i+1 < n {
+			last = int(binary.BigEndian.Uint32(stsc[8+12*(i+1):])) - 1
+		}
+		if first < 0 || last > len(chunks) {
+			return errMP4
+		}
+		for c := first; c < last; c++ {
+			off := chunks[c]
+			for j := 0; j < per && len(t.offsets) < count; j++ {
+				t.offsets = append(t.offsets, off)
+				off += int64(t.sizes[len(t.offsets)-1])
+			}
+		}
+	}
+	if len(t.offsets) < count {
+		t.sizes = t.sizes[:len(t.offsets)]
+	}
+	return nil
+}
@@ -22,6 +22,9 @@ import (
 // ErrFormat indicates that decoding encountered an unknown format.
 var ErrFormat = errors.New("codec: unknown format")
 
+// ErrSeek indicates that a Reader does not support seeking.
+var ErrSeek = errors.New("codec: reader is not seekable")
+
 type Songs map[ID]Song
 
 type ID string
@@ -97,6 +100,13 @@ func RegisterCodec(name string, magic, extensions []string, decode func(Reader)
 	codecs[name] = c
 }
 
+// HasExtension reports whether a registered codec decodes files with the
+// extension ext, like "mp3".
+func HasExtension(ext string) bool {
+	_, ok := allExtensions[ext]
+	return ok
+}
+
 // A reader is an io.Reader that can also peek ahead.
 type reader interface {
 	io.Reader
@@ -124,17 +134,24 @@ func match(magic string, b []byte) bool {
 	return true
 }
 
-// Sniff determines the format of r's data.
+// Sniff determines the format of r's data. The longest matching magic wins,
+// so that formats sharing a container, like Vorbis and Opus in Ogg, can be
+// told apart.
 func sniff(r reader) *codec {
+	var found *codec
+	longest := 0
 	for _, c := range codecs {
 		for _, m := range c.magic {
+			if len(m) <= longest {
+				continue
+			}
 			b, err := r.Peek(len(m))
 			if err == nil && match(m, b) {
-				return c
+				found, longest = c, len(m)
 			}
 		}
 	}
-	return nil
+	return found
 }
 
 // Reader returns a file reader and the file size in bytes (or 0 if streamed
@@ -162,12 +179,28 @@ func (rf Reader) Metadata(ft tag.FileType) (*SongInfo, tag.Metadata, []byte, err
 		return nil, nil, nil, fmt.Errorf("expected filetype %v, got %v", ft, m.FileType())
 	}
 	track, _ := m.Track()
+	disc, _ := m.Disc()
 	si := &SongInfo{
-		Artist:   m.Artist(),
-		Title:    m.Title(),
-		Album:    m.Album(),
-		Track:    float64(track),
-		ImageURL: dataURL(m),
+		Artist:      m.Artist(),
+		Title:       m.Title(),
+		Album:       m.Album(),
+		Track:       float64(track),
+		ImageURL:    dataURL(m),
+		Disc:        float64(disc),
+		AlbumArtist: m.AlbumArtist(),
+		Genre:       m.Genre(),
+		Year:        m.Year(),
+		Composer:    m.Composer(),
+	}
+	for k, v := range m.Raw() {
+		switch v := v.(type) {
+		case string:
+			// Vorbis comments.
+			si.SetReplayGain(k, v)
+		case *tag.Comm:
+			// ID3 TXXX frames.
+			si.SetReplayGain(v.Description, v.Text)
+		}
 	}
 	return si, m, b, nil
 }
@@ -177,10 +210,26 @@ func dataURL(m tag.Metadata) string {
 	if p == nil {
 		return ""
 	}
-	if p.MIMEType == "-->" {
-		return string(p.Data)
+	return ArtURL(p.MIMEType, p.Data)
+}
+
+// ArtStore, if set, stores a picture and returns its URL. Servers set it so
+// that song info does not carry whole pictures.
+var ArtStore func(mimeType string, data []byte) string
+
+// ArtURL returns the URL of a picture from ArtStore or, if ArtStore is not
+// set, a data URL. A MIME type of "-->" means data is already a URL.
+func ArtURL(mimeType string, data []byte) string {
+	if mimeType == "-->" {
+		return string(data)
+	}
+	if len(data) == 0 {
+		return ""
+	}
+	if ArtStore != nil {
+		return ArtStore(mimeType, data)
 	}
-	return fmt.Sprintf("data:%s;base64,%s", p.MIMEType, base64.StdEncoding.EncodeToString(p.Data))
+	return fmt.Sprintf("data:%s;base64,%s", mimeType, base64.StdEncoding.EncodeToString(data))
 }
 
 // Decode decodes audio that has been encoded in a registered codec.
@@ -0,0 +1,294 @@
+// Package cue reads cue sheets, which split one audio file into tracks.
+package cue
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+func init() {
+	codec.RegisterCodec("CUE", nil, []string{"cue"}, Read, nil)
+}
+
+// Sheet is a parsed cue sheet.
+type Sheet struct {
+	Title     string
+	Performer string
+	Tracks    []*Track
+	// gain holds ReplayGain values from REM comments.
+	gain codec.SongInfo
+}
+
+// Track is a track of a cue sheet.
+type Track struct {
+	// File is the name of the audio file that holds the track.
+	File      string
+	Number    int
+	Title     string
+	Performer string
+	// Start is the position of the track's INDEX 01 in File.
+	Start time.Duration
+	gain  codec.SongInfo
+}
+
+// Parse parses a cue sheet. Sheets that are not UTF-8 are read as Latin-1.
+func Parse(r io.Reader) (*Sheet, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	b = bytes.TrimPrefix(b, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(b) {
+		rs := make([]rune, len(b))
+		for i, c := range b {
+			rs[i] = rune(c)
+		}
+		b = []byte(string(rs))
+	}
+	s := new(Sheet)
+	var file string
+	var t *Track
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; sc.Scan(); line++ {
+		f := fields(sc.Text())
+		if len(f) == 0 {
+			continue
+		}
+		arg := func(i int) string {
+			if i < len(f) {
+				return f[i]
+			}
+			return ""
+		}
+		switch strings.ToUpper(f[0]) {
+		case "FILE":
+			file = arg(1)
+		case "TRACK":
+			n, err := strconv.Atoi(arg(1))
+			if err != nil {
+				return nil, fmt.Errorf("cue: line %d: bad track number", line)
+			}
+			t = &Track{
+				File:   file,
+				Number: n,
+				Start:  -1,
+			}
+			if strings.ToUpper(arg(2)) == "AUDIO" {
+				s.Tracks = append(s.Tracks, t)
+			}
+		case "INDEX":
+			if t == nil || arg(1) != "01" && arg(1) != "1" {
+				continue
+			}
+			d, err := parseTime(arg(2))
+			if err != nil {
+				return nil, fmt.Errorf("cue: line %d: %v", line, err)
+			}
+			t.Start = d
+		case "TITLE":
+			if t != nil {
+				t.Title = arg(1)
+			} else {
+				s.Title = arg(1)
+			}
+		case "PERFORMER":
+			if t != nil {
+				t.Performer = arg(1)
+			} else {
+				s.Performer = arg(1)
+			}
+		case "REM":
+			if t != nil {
+				t.gain.SetReplayGain(arg(1), strings.Join(f[2:], " "))
+			} else {
+				s.gain.SetReplayGain(arg(1), strings.Join(f[2:], " "))
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	for _, t := range s.Tracks {
+		if t.Start < 0 {
+			return nil, fmt.Errorf("cue: track %d has no INDEX 01", t.Number)
+		}
+	}
+	return s, nil
+}
+
+// fields splits a line into words and double quoted strings.
+func fields(line string) []string {
+	var f []string
+	line = strings.TrimSpace(line)
+	for line != "" {
+		if line[0] == '"' {
+			i := strings.IndexByte(line[1:], '"')
+			if i < 0 {
+				f = append(f, line[1:])
+				break
+			}
+			f = append(f, line[1:i+1])
+			line = line[i+2:]
+		} else {
+			i := strings.IndexAny(line, " \t")
+			if i < 0 {
+				f = append(f, line)
+				break
+			}
+			f = append(f, line[:i])
+			line = line[i:]
+		}
+		line = strings.TrimLeft(line, " \t")
+	}
+	return f
+}
+
+// parseTime parses a time in minutes, seconds and frames of 1/75 second.
+func parseTime(s string) (time.Duration, error) {
+	p := strings.Split(s, ":")
+	if len(p) != 3 {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	var n [3]int64
+	for i, v := range p {
+		x, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || x < 0 {
+			return 0, fmt.Errorf("bad time %q", s)
+		}
+		n[i] = x
+	}
+	frames := (n[0]*60+n[1])*75 + n[2]
+	return time.Duration(frames) * time.Second / 75, nil
+}
+
+// Songs returns a Song for each track, keyed by track number. open returns
+// the decoded audio file for a track's File. Each track ends at the start of
+// the next one in the same file.
+func (s *Sheet) Songs(open func(file string) (codec.Song, error)) (codec.Songs, error) {
+	songs := make(codec.Songs)
+	for i, t := range s.Tracks {
+		song, err := open(t.File)
+		if err != nil {
+			return nil, err
+		}
+		var end time.Duration
+		if i+1 < len(s.Tracks) && s.Tracks[i+1].File == t.File {
+			end = s.Tracks[i+1].Start
+		}
+		info := t.gain
+		if info.TrackGain == 0 && info.TrackPeak == 0 {
+			info.TrackGain, info.TrackPeak = s.gain.TrackGain, s.gain.TrackPeak
+		}
+		info.AlbumGain, info.AlbumPeak = s.gain.AlbumGain, s.gain.AlbumPeak
+		info.Title = t.Title
+		info.Artist = t.Performer
+		if info.Artist == "" {
+			info.Artist = s.Performer
+		}
+		info.Album = s.Title
+		info.Track = float64(t.Number)
+		songs[codec.Int(t.Number)] = NewSong(song, info, t.Start, end)
+	}
+	return songs, nil
+}
+
+// Read reads a cue sheet and returns its tracks. The sheet's Reader must be
+// a file, since the audio files are found relative to it.
+func Read(rf codec.Reader) (codec.Songs, error) {
+	r, _, err := rf()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	f, ok := r.(interface {
+		Name() string
+	})
+	if !ok {
+		return nil, fmt.Errorf("cue: sheet is not a file")
+	}
+	dir := filepath.Dir(f.Name())
+	s, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.Songs(func(file string) (codec.Song, error) {
+		path := resolve(dir, file)
+		songs, _, err := codec.ByExtension(path, fileReader(path))
+		if err != nil {
+			return nil, err
+		}
+		song, ok := songs[codec.None]
+		if !ok {
+			return nil, fmt.Errorf("cue: %v has no single song", file)
+		}
+		return song, nil
+	})
+}
+
+// Files returns the paths of the audio files referenced by the cue sheet at
+// path.
+func Files(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	s, err := Parse(f)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	seen := make(map[string]bool)
+	for _, t := range s.Tracks {
+		p := resolve(filepath.Dir(path), t.File)
+		if !seen[p] {
+			seen[p] = true
+			files = append(files, p)
+		}
+	}
+	return files, nil
+}
+
+// resolve returns the path of file, named in a sheet in dir. Sheets often
+// name the file that was ripped, like a WAV file, that has since been
+// encoded to another format, so a file with the same name and any other
+// extension is used if file does not exist.
+func resolve(dir, file string) string {
+	path := filepath.Join(dir, filepath.FromSlash(strings.Replace(file, `\`, "/", -1)))
+	if _, err := os.Stat(path); err == nil {
+		return path
+	}
+	matches, _ := filepath.Glob(strings.TrimSuffix(path, filepath.Ext(path)) + ".*")
+	for _, m := range matches {
+		if !strings.EqualFold(filepath.Ext(m), ".cue") {
+			return m
+		}
+	}
+	return path
+}
+
+func fileReader(path string) codec.Reader {
+	return func() (io.ReadCloser, int64, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, 0, err
+		}
+		fi, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+		return f, fi.Size(), nil
+	}
+}
@@ -0,0 +1,227 @@
+package cue
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+const testSheet = `REM GENRE Rock
+REM REPLAYGAIN_ALBUM_GAIN -7.50 dB
+REM REPLAYGAIN_ALBUM_PEAK 0.990000
+PERFORMER "The Band"
+TITLE "The Album"
+FILE "album.wav" WAVE
+  TRACK 01 AUDIO
+    TITLE "First"
+    REM REPLAYGAIN_TRACK_GAIN -6.25 dB
+    INDEX 00 00:00:00
+    INDEX 01 00:00:32
+  TRACK 02 AUDIO
+    TITLE "Second Song"
+    PERFORMER "Guest"
+    INDEX 00 03:58:70
+    INDEX 01 04:00:00
+  TRACK 03 DATA
+    INDEX 01 10:00:00
+FILE "disc 2\second.flac" FLAC
+  TRACK 04 AUDIO
+    TITLE Third
+    INDEX 1 00:02:00
+`
+
+func TestParse(t *testing.T) {
+	s, err := Parse(strings.NewReader(testSheet))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Title != "The Album" || s.Performer != "The Band" || s.gain.AlbumGain != -7.5 || s.gain.AlbumPeak != 0.99 {
+		t.Fatalf("bad sheet: %+v", s)
+	}
+	want := []Track{
+		{File: "album.wav", Number: 1, Title: "First", Start: 32 * time.Second / 75, gain: codec.SongInfo{TrackGain: -6.25}},
+		{File: "album.wav", Number: 2, Title: "Second Song", Performer: "Guest", Start: 4 * time.Minute},
+		{File: `disc 2\second.flac`, Number: 4, Title: "Third", Start: 2 * time.Second},
+	}
+	if len(s.Tracks) != len(want) {
+		t.Fatalf("got %d tracks, want %d", len(s.Tracks), len(want))
+	}
+	for i, tr := range s.Tracks {
+		if !reflect.DeepEqual(*tr, want[i]) {
+			t.Errorf("track %d: got %+v, want %+v", i, *tr, want[i])
+		}
+	}
+}
+
+func TestParseEncoding(t *testing.T) {
+	tests := []struct {
+		name  string
+		sheet string
+		want  string
+	}{
+		{"utf-8", "TITLE \"Café\"\n", "Café"},
+		{"bom", "\xef\xbb\xbfTITLE \"Café\"\n", "Café"},
+		{"latin-1", "TITLE \"Caf\xe9\"\n", "Café"},
+		{"crlf", "TITLE \"Album\"\r\n", "Album"},
+		{"lower case", "title \"Album\"\n", "Album"},
+	}
+	for _, test := range tests {
+		s, err := Parse(strings.NewReader(test.sheet))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		} else if s.Title != test.want {
+			t.Errorf("%s: got title %q, want %q", test.name, s.Title, test.want)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		sheet string
+		want  string
+	}{
+		{"FILE a.wav WAVE\nTRACK x AUDIO\n", "line 2: bad track number"},
+		{"FILE a.wav WAVE\nTRACK 01 AUDIO\nINDEX 01 1:2\n", "line 3: bad time"},
+		{"FILE a.wav WAVE\nTRACK 01 AUDIO\nINDEX 01 00:-1:00\n", "line 3: bad time"},
+		{"FILE a.wav WAVE\nTRACK 01 AUDIO\nINDEX 00 00:00:00\n", "track 1 has no INDEX 01"},
+	}
+	for _, test := range tests {
+		_, err := Parse(strings.NewReader(test.sheet))
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%q: got error %v, want %q", test.sheet, err, test.want)
+		}
+	}
+}
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{"", nil},
+		{"   \t", nil},
+		{"TRACK 01 AUDIO", []string{"TRACK", "01", "AUDIO"}},
+		{"  TITLE  \"Two  Words\" ", []string{"TITLE", "Two  Words"}},
+		{"\tFILE\t\"a.wav\"\tWAVE", []string{"FILE", "a.wav", "WAVE"}},
+		{`TITLE ""`, []string{"TITLE", ""}},
+		{`TITLE "Unterminated`, []string{"TITLE", "Unterminated"}},
+		{`TITLE "a"b`, []string{"TITLE", "a", "b"}},
+	}
+	for _, test := range tests {
+		if got := fields(test.line); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got %q, want %q", test.line, got, test.want)
+		}
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"00:00:00", 0, true},
+		{"00:00:75", time.Second, true},
+		{"01:02:15", time.Minute + 2*time.Second + 200*time.Millisecond, true},
+		{"99:59:74", 99*time.Minute + 59*time.Second + 74*time.Second/75, true},
+		{"00:00", 0, false},
+		{"a:00:00", 0, false},
+		{"00:00:-1", 0, false},
+		{"00:00:00:00", 0, false},
+	}
+	for _, test := range tests {
+		got, err := parseTime(test.in)
+		if ok := err == nil; ok != test.ok || got != test.want {
+			t.Errorf("%q: got %v, %v, want %v", test.in, got, err, test.want)
+		}
+	}
+}
+
+func TestSongs(t *testing.T) {
+	s, err := Parse(strings.NewReader(testSheet))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var opened []string
+	songs, err := s.Songs(func(file string) (codec.Song, error) {
+		opened = append(opened, file)
+		return &testSong{rate: 75, length: 20 * time.Minute}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"album.wav", "album.wav", `disc 2\second.flac`}; !reflect.DeepEqual(opened, want) {
+		t.Fatalf("opened %q, want %q", opened, want)
+	}
+	tests := []struct {
+		id         codec.ID
+		start, end time.Duration
+		info       codec.SongInfo
+	}{
+		{
+			codec.Int(1), 32 * time.Second / 75, 4 * time.Minute,
+			codec.SongInfo{Title: "First", Artist: "The Band", Album: "The Album", Track: 1, TrackGain: -6.25, AlbumGain: -7.5, AlbumPeak: 0.99},
+		},
+		{
+			// The last track of a file plays to its end.
+			codec.Int(2), 4 * time.Minute, 0,
+			codec.SongInfo{Title: "Second Song", Artist: "Guest", Album: "The Album", Track: 2, AlbumGain: -7.5, AlbumPeak: 0.99},
+		},
+		{
+			codec.Int(4), 2 * time.Second, 0,
+			codec.SongInfo{Title: "Third", Artist: "The Band", Album: "The Album", Track: 4, AlbumGain: -7.5, AlbumPeak: 0.99},
+		},
+	}
+	if len(songs) != len(tests) {
+		t.Fatalf("got %d songs, want %d", len(songs), len(tests))
+	}
+	for _, test := range tests {
+		song, ok := songs[test.id].(*Song)
+		if !ok {
+			t.Fatalf("%v: no song", test.id)
+		}
+		if song.start != test.start || song.end != test.end {
+			t.Errorf("%v: got %v to %v, want %v to %v", test.id, song.start, song.end, test.start, test.end)
+		}
+		if song.info != test.info {
+			t.Errorf("%v: got info %+v, want %+v", test.id, song.info, test.info)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"a.wav", "b.flac", "b.cue", "c.cue", filepath.Join("sub", "d.wav")} {
+		path := filepath.Join(dir, name)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		file, want string
+	}{
+		{"a.wav", "a.wav"},
+		// Encoded since the sheet was made.
+		{"b.wav", "b.flac"},
+		{"c.wav", "c.wav"},
+		{"missing.wav", "missing.wav"},
+		{`sub\d.wav`, filepath.Join("sub", "d.wav")},
+		{"sub/d.wav", filepath.Join("sub", "d.wav")},
+	}
+	for _, test := range tests {
+		if got, want := resolve(dir, test.file), filepath.Join(dir, test.want); got != want {
+			t.Errorf("%q: got %s, want %s", test.file, got, want)
+		}
+	}
+}
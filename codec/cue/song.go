@@ -0,0 +1,150 @@
+package cue
+
+import (
+	"io"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+// Song plays the part of another song between two positions.
+type Song struct {
+	song       codec.Song
+	info       codec.SongInfo
+	start, end time.Duration
+	init       bool
+	sr, ch     int
+	// remaining is the number of samples before end, or -1 if the song
+	// plays to the end of the underlying one.
+	remaining int
+}
+
+// NewSong returns a Song that plays song from start up to end, or to its end
+// if end is 0. The title, track number and track gain describe the whole of
+// song, so they are always taken from info; other fields of info override
+// song's if they are set.
+func NewSong(song codec.Song, info codec.SongInfo, start, end time.Duration) *Song {
+	return &Song{
+		song:  song,
+		info:  info,
+		start: start,
+		end:   end,
+	}
+}
+
+func (s *Song) Info() (codec.SongInfo, error) {
+	si, err := s.song.Info()
+	if err != nil {
+		return si, err
+	}
+	si.Title = s.info.Title
+	si.Track = s.info.Track
+	if s.info.Artist != "" {
+		si.Artist = s.info.Artist
+	}
+	if s.info.Album != "" {
+		si.Album = s.info.Album
+	}
+	if si.AlbumGain == 0 && si.AlbumPeak == 0 {
+		// A gain for the whole file is the album's.
+		si.AlbumGain, si.AlbumPeak = si.TrackGain, si.TrackPeak
+	}
+	si.TrackGain, si.TrackPeak = s.info.TrackGain, s.info.TrackPeak
+	if s.info.AlbumGain != 0 || s.info.AlbumPeak != 0 {
+		si.AlbumGain, si.AlbumPeak = s.info.AlbumGain, s.info.AlbumPeak
+	}
+	if s.end > 0 {
+		si.Time = s.end - s.start
+	} else if si.Time > s.start {
+		si.Time -= s.start
+	}
+	return si, nil
+}
+
+func (s *Song) Init() (sampleRate, channels int, err error) {
+	if !s.init {
+		s.sr, s.ch, err = s.song.Init()
+		if err != nil {
+			return 0, 0, err
+		}
+		if err := s.seek(0, true); err != nil {
+			s.song.Close()
+			return 0, 0, err
+		}
+		s.init = true
+	}
+	return s.sr, s.ch, nil
+}
+
+// frames returns the number of sample frames before d, rounded since cue
+// frames are not whole nanoseconds.
+func (s *Song) frames(d time.Duration) int {
+	return int((int64(d)*int64(s.sr) + int64(time.Second)/2) / int64(time.Second))
+}
+
+// seek moves the underlying song to offset from start, decoding up to it if
+// the song can't seek. fresh is whether the song was just initialized.
+func (s *Song) seek(offset time.Duration, fresh bool) error {
+	pos := s.start + offset
+	skip := s.frames(pos) * s.ch
+	sk, ok := s.song.(codec.Seeker)
+	if ok && sk.Seek(pos) == nil {
+		skip = 0
+	} else if ok || !fresh {
+		// Start over, since a failed Seek may leave the song anywhere.
+		s.song.Close()
+		if _, _, err := s.song.Init(); err != nil {
+			return err
+		}
+	}
+	for skip > 0 {
+		n := skip
+		if n > 4096 {
+			n = 4096
+		}
+		b, err := s.song.Play(n)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		skip -= len(b)
+		if len(b) < n {
+			break
+		}
+	}
+	s.remaining = -1
+	if s.end > 0 {
+		s.remaining = (s.frames(s.end) - s.frames(pos)) * s.ch
+		if s.remaining < 0 {
+			s.remaining = 0
+		}
+	}
+	return nil
+}
+
+// Seek implements codec.Seeker. Songs that can't seek are decoded from the
+// start of the file, so it only fails if the underlying song does.
+func (s *Song) Seek(offset time.Duration) error {
+	if !s.init {
+		return codec.ErrSeek
+	}
+	return s.seek(offset, false)
+}
+
+func (s *Song) Play(n int) ([]float32, error) {
+	if s.remaining == 0 {
+		return nil, io.EOF
+	}
+	if s.remaining > 0 && n > s.remaining {
+		n = s.remaining
+	}
+	b, err := s.song.Play(n)
+	if s.remaining > 0 {
+		s.remaining -= len(b)
+	}
+	return b, err
+}
+
+func (s *Song) Close() {
+	s.song.Close()
+	s.init = false
+}
@@ -0,0 +1,209 @@
+package cue
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+// testSong is a mono song whose samples are their frame numbers.
+type testSong struct {
+	rate   int
+	length time.Duration
+	pos    int
+	inits  int
+}
+
+func (s *testSong) Info() (codec.SongInfo, error) {
+	return codec.SongInfo{Title: "File", Artist: "Artist", Time: s.length, TrackGain: -3}, nil
+}
+
+func (s *testSong) Init() (int, int, error) {
+	s.pos = 0
+	s.inits++
+	return s.rate, 1, nil
+}
+
+func (s *testSong) Play(n int) ([]float32, error) {
+	var b []float32
+	for end := int(int64(s.length) * int64(s.rate) / int64(time.Second)); len(b) < n && s.pos < end; s.pos++ {
+		b = append(b, float32(s.pos))
+	}
+	if len(b) == 0 {
+		return nil, io.EOF
+	}
+	return b, nil
+}
+
+func (s *testSong) Close() {}
+
+// seekSong is a testSong that can seek.
+type seekSong struct {
+	testSong
+}
+
+func (s *seekSong) Seek(offset time.Duration) error {
+	s.pos = int(int64(offset) * int64(s.rate) / int64(time.Second))
+	return nil
+}
+
+// playAll plays s up to io.EOF and returns the first and last samples and how
+// many there were.
+func playAll(t *testing.T, s codec.Song) (first, last float32, n int) {
+	first, last = -1, -1
+	short := false
+	for {
+		b, err := s.Play(3)
+		if err == io.EOF {
+			if len(b) != 0 {
+				t.Fatalf("got %d samples with EOF", len(b))
+			}
+			return
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if short {
+			t.Fatalf("got %d samples after a short read", len(b))
+		}
+		if len(b) > 0 {
+			if first < 0 {
+				first = b[0]
+			}
+			last = b[len(b)-1]
+		}
+		n += len(b)
+		short = len(b) < 3
+	}
+}
+
+func TestSong(t *testing.T) {
+	tests := []struct {
+		name        string
+		seek        bool
+		start, end  time.Duration
+		offset      time.Duration
+		first, last float32
+		n           int
+	}{
+		{"whole", false, 0, 0, 0, 0, 99, 100},
+		{"start", false, time.Second / 2, 0, 0, 5, 99, 95},
+		{"part", false, time.Second / 2, 3 * time.Second, 0, 5, 29, 25},
+		{"part seek", true, time.Second / 2, 3 * time.Second, 0, 5, 29, 25},
+		{"offset", false, time.Second / 2, 3 * time.Second, time.Second, 15, 29, 15},
+		{"offset seek", true, time.Second / 2, 3 * time.Second, time.Second, 15, 29, 15},
+		{"past end", true, time.Second / 2, 3 * time.Second, 5 * time.Second, -1, -1, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := &testSong{rate: 10, length: 10 * time.Second}
+			var song codec.Song = ts
+			if test.seek {
+				ss := &seekSong{*ts}
+				ts, song = &ss.testSong, ss
+			}
+			s := NewSong(song, codec.SongInfo{Title: "Track"}, test.start, test.end)
+			if err := s.Seek(0); err != codec.ErrSeek {
+				t.Fatalf("seek before init: got %v", err)
+			}
+			if sr, ch, err := s.Init(); err != nil || sr != 10 || ch != 1 {
+				t.Fatalf("got %v, %v, %v", sr, ch, err)
+			}
+			if test.offset > 0 {
+				if err := s.Seek(test.offset); err != nil {
+					t.Fatal(err)
+				}
+			}
+			first, last, n := playAll(t, s)
+			if first != test.first || last != test.last || n != test.n {
+				t.Fatalf("got samples %v to %v, %d of them, want %v to %v, %d", first, last, n, test.first, test.last, test.n)
+			}
+			// Songs that can't seek decode from the start again.
+			want := 1
+			if test.offset > 0 && !test.seek {
+				want = 2
+			}
+			if ts.inits != want {
+				t.Fatalf("initialized %d times, want %d", ts.inits, want)
+			}
+		})
+	}
+}
+
+func TestSongHandoff(t *testing.T) {
+	// Each track ends where the next begins, and the last at the end of the
+	// file.
+	sheet, err := Parse(strings.NewReader(`FILE "album.wav" WAVE
+TRACK 01 AUDIO
+INDEX 01 00:00:00
+TRACK 02 AUDIO
+INDEX 00 00:01:00
+INDEX 01 00:02:10
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	songs, err := sheet.Songs(func(string) (codec.Song, error) {
+		return &testSong{rate: 75, length: 3 * time.Second}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		id          codec.ID
+		first, last float32
+		n           int
+	}{
+		{codec.Int(1), 0, 159, 160},
+		{codec.Int(2), 160, 224, 65},
+	}
+	for _, test := range tests {
+		s := songs[test.id]
+		if _, _, err := s.Init(); err != nil {
+			t.Fatal(err)
+		}
+		first, last, n := playAll(t, s)
+		if first != test.first || last != test.last || n != test.n {
+			t.Errorf("%v: got samples %v to %v, %d of them, want %v to %v, %d", test.id, first, last, n, test.first, test.last, test.n)
+		}
+		if b, err := s.Play(3); len(b) != 0 || err != io.EOF {
+			t.Errorf("%v: got %d samples, %v after the end", test.id, len(b), err)
+		}
+	}
+}
+
+func TestSongInfo(t *testing.T) {
+	tests := []struct {
+		name       string
+		info       codec.SongInfo
+		start, end time.Duration
+		want       codec.SongInfo
+	}{
+		{
+			// The file's track gain is the album's, and its title is not the
+			// track's.
+			"file tags",
+			codec.SongInfo{Title: "Track", Track: 2},
+			time.Second, 4 * time.Second,
+			codec.SongInfo{Title: "Track", Artist: "Artist", Track: 2, Time: 3 * time.Second, AlbumGain: -3},
+		},
+		{
+			"sheet tags",
+			codec.SongInfo{Title: "Track", Artist: "Sheet", Album: "Album", TrackGain: -1, AlbumGain: -2, AlbumPeak: 0.5},
+			time.Second, 0,
+			codec.SongInfo{Title: "Track", Artist: "Sheet", Album: "Album", Time: 9 * time.Second, TrackGain: -1, AlbumGain: -2, AlbumPeak: 0.5},
+		},
+	}
+	for _, test := range tests {
+		s := NewSong(&testSong{rate: 10, length: 10 * time.Second}, test.info, test.start, test.end)
+		got, err := s.Info()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
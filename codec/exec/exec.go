@@ -0,0 +1,223 @@
+// Package exec decodes formats without a native codec by running an external
+// decoder, like ffmpeg.
+package exec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+var (
+	// Extensions are the file extensions to decode, if no other codec does.
+	Extensions = []string{"ac3", "aif", "aiff", "alac", "ape", "dts", "mka", "mpc", "tta", "webm", "wma", "wv"}
+	// Decode is the decoder command. It reads a file on standard input and
+	// writes its first audio stream as 32-bit float little endian PCM, at the
+	// file's sample rate and channel count, on standard output.
+	Decode = []string{"ffmpeg", "-v", "quiet", "-i", "pipe:0", "-map", "0:a:0", "-f", "f32le", "pipe:1"}
+	// Probe is the probe command. It reads a file on standard input and
+	// writes information about it on standard output in the JSON format of
+	// ffprobe's -show_format and -show_streams options.
+	Probe = []string{"ffprobe", "-v", "quiet", "-print_format", "json", "-show_format", "-show_streams", "-select_streams", "a:0", "pipe:0"}
+)
+
+// Init registers Extensions, except those other codecs decode, if the Decode
+// and Probe commands are found. Extensions may have a leading dot but must
+// not be listed twice.
+func Init() error {
+	if len(Decode) == 0 || len(Probe) == 0 {
+		return fmt.Errorf("exec: no command")
+	}
+	for _, c := range [][]string{Decode, Probe} {
+		if _, err := exec.LookPath(c[0]); err != nil {
+			return err
+		}
+	}
+	seen := make(map[string]bool)
+	var exts []string
+	for _, e := range Extensions {
+		e = strings.TrimPrefix(strings.TrimSpace(e), ".")
+		switch {
+		case e == "":
+			continue
+		case seen[e]:
+			return fmt.Errorf("exec: extension listed twice: %s", e)
+		}
+		seen[e] = true
+		if codec.HasExtension(e) {
+			log.Printf("exec: not decoding %s, which a built-in codec decodes", e)
+			continue
+		}
+		exts = append(exts, e)
+	}
+	codec.RegisterCodec("EXEC", nil, exts, NewSongs, nil)
+	return nil
+}
+
+func NewSongs(rf codec.Reader) (codec.Songs, error) {
+	return codec.Songs{codec.None: &Song{Reader: rf}}, nil
+}
+
+type Song struct {
+	Reader codec.Reader
+	r      io.ReadCloser
+	cmd    *exec.Cmd
+	stderr bytes.Buffer
+	exited bool
+	out    io.Reader
+	buf    []byte
+	info   *probe
+}
+
+// probe is the output of the Probe command.
+type probe struct {
+	Streams []struct {
+		CodecName  string `json:"codec_name"`
+		SampleRate string `json:"sample_rate"`
+		Channels   int    `json:"channels"`
+		Duration   string `json:"duration"`
+		BitRate    string `json:"bit_rate"`
+		Tags       map[string]string
+	}
+	Format struct {
+		Duration string `json:"duration"`
+		Tags     map[string]string
+	}
+}
+
+func (s *Song) probe() (*probe, error) {
+	if s.info != nil {
+		return s.info, nil
+	}
+	r, _, err := s.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(Probe[0], Probe[1:]...)
+	cmd.Stdin = r
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("exec: %v: %v", err, strings.TrimSpace(stderr.String()))
+	}
+	p := new(probe)
+	if err := json.Unmarshal(stdout.Bytes(), p); err != nil {
+		return nil, fmt.Errorf("exec: bad probe output: %v", err)
+	}
+	if len(p.Streams) == 0 {
+		return nil, fmt.Errorf("exec: no audio stream")
+	}
+	s.info = p
+	return p, nil
+}
+
+func (s *Song) Info() (codec.SongInfo, error) {
+	var si codec.SongInfo
+	p, err := s.probe()
+	if err != nil {
+		return si, err
+	}
+	st := p.Streams[0]
+	d := p.Format.Duration
+	if d == "" {
+		d = st.Duration
+	}
+	if secs, err := strconv.ParseFloat(d, 64); err == nil {
+		si.Time = time.Duration(secs * float64(time.Second))
+	}
+	// Tags can be on the container or the stream, in any case.
+	for _, t := range []map[string]string{st.Tags, p.Format.Tags} {
+		for k, v := range t {
+			si.SetTag(k, v)
+		}
+	}
+	si.SampleRate, _ = strconv.Atoi(st.SampleRate)
+	si.Bitrate, _ = strconv.Atoi(st.BitRate)
+	si.Codec = strings.ToUpper(st.CodecName)
+	return si, nil
+}
+
+func (s *Song) Init() (sampleRate, channels int, err error) {
+	p, err := s.probe()
+	if err != nil {
+		return 0, 0, err
+	}
+	sr, _ := strconv.Atoi(p.Streams[0].SampleRate)
+	ch := p.Streams[0].Channels
+	if sr <= 0 || ch <= 0 {
+		return 0, 0, fmt.Errorf("exec: unknown format")
+	}
+	if s.cmd == nil {
+		r, _, err := s.Reader()
+		if err != nil {
+			return 0, 0, err
+		}
+		cmd := exec.Command(Decode[0], Decode[1:]...)
+		cmd.Stdin = r
+		s.stderr.Reset()
+		cmd.Stderr = &s.stderr
+		out, err := cmd.StdoutPipe()
+		if err != nil {
+			r.Close()
+			return 0, 0, err
+		}
+		if err := cmd.Start(); err != nil {
+			r.Close()
+			return 0, 0, err
+		}
+		s.r = r
+		s.cmd = cmd
+		s.exited = false
+		s.out = bufio.NewReader(out)
+	}
+	return sr, ch, nil
+}
+
+func (s *Song) Play(n int) ([]float32, error) {
+	if s.exited {
+		return nil, io.EOF
+	}
+	if cap(s.buf) < n*4 {
+		s.buf = make([]byte, n*4)
+	}
+	b := s.buf[:n*4]
+	m, err := io.ReadFull(s.out, b)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+		// The output ended, so the decoder is done: report if it failed.
+		s.exited = true
+		if werr := s.cmd.Wait(); werr != nil {
+			err = fmt.Errorf("exec: %v: %v", werr, strings.TrimSpace(s.stderr.String()))
+		} else if m == 0 {
+			err = io.EOF
+		}
+	}
+	f, _ := codec.PCMToFloat(make([]float32, 0, n), b[:m], 32, true)
+	return f, err
+}
+
+func (s *Song) Close() {
+	if s.cmd != nil {
+		if !s.exited {
+			s.cmd.Process.Kill()
+			s.cmd.Wait()
+		}
+		s.cmd = nil
+	}
+	if s.r != nil {
+		s.r.Close()
+		s.r = nil
+	}
+	s.out = nil
+}
@@ -0,0 +1,172 @@
+package exec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+	_ "github.com/mjibson/moggio/codec/wav"
+)
+
+const testProbe = `{
+	"streams": [{
+		"codec_name": "pcm_f32le",
+		"sample_rate": "8000",
+		"channels": 2,
+		"bit_rate": "512000",
+		"tags": {"title": "Title"}
+	}],
+	"format": {
+		"duration": "1.5",
+		"tags": {"ARTIST": "Artist"}
+	}
+}`
+
+// script writes an executable shell script with body to dir and returns its
+// path.
+func script(t *testing.T, dir, name, body string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// setCommands sets Decode and Probe to scripts with the given bodies until
+// the test ends.
+func setCommands(t *testing.T, decode, probe string) {
+	dir, err := ioutil.TempDir("", "exec")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldDecode, oldProbe := Decode, Probe
+	Decode = []string{script(t, dir, "decode", decode)}
+	Probe = []string{script(t, dir, "probe", probe)}
+	t.Cleanup(func() {
+		Decode, Probe = oldDecode, oldProbe
+		os.RemoveAll(dir)
+	})
+}
+
+func reader(b []byte) codec.Reader {
+	return func() (io.ReadCloser, int64, error) {
+		return ioutil.NopCloser(bytes.NewReader(b)), int64(len(b)), nil
+	}
+}
+
+func TestSong(t *testing.T) {
+	samples := []float32{0, 0.5, -0.5, 1, -1, 0.25}
+	var pcm bytes.Buffer
+	binary.Write(&pcm, binary.LittleEndian, samples)
+	tests := []struct {
+		name    string
+		decode  string
+		want    []float32
+		wantErr string
+	}{
+		{"ok", "cat", samples, ""},
+		{"whole reads", "head -c 16", samples[:4], ""},
+		{"fail", "cat; echo broken >&2; exit 3", samples, "broken"},
+		{"no output", "echo nothing here >&2; exit 1", nil, "nothing here"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setCommands(t, test.decode, "cat > /dev/null; cat <<'EOF'\n"+testProbe+"\nEOF")
+			s := &Song{Reader: reader(pcm.Bytes())}
+			defer s.Close()
+			si, err := s.Info()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if si.Time != 1500*time.Millisecond || si.Title != "Title" || si.Artist != "Artist" || si.SampleRate != 8000 || si.Codec != "PCM_F32LE" {
+				t.Fatalf("bad info: %+v", si)
+			}
+			sr, ch, err := s.Init()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sr != 8000 || ch != 2 {
+				t.Fatalf("got %v Hz, %v channels", sr, ch)
+			}
+			var got []float32
+			for i := 0; ; i++ {
+				if i > len(samples) {
+					t.Fatal("did not end")
+				}
+				f, err := s.Play(4)
+				got = append(got, f...)
+				if err == io.EOF {
+					if test.wantErr != "" {
+						t.Fatalf("got EOF, want %q", test.wantErr)
+					}
+					break
+				} else if err != nil {
+					if test.wantErr == "" || !strings.Contains(err.Error(), test.wantErr) {
+						t.Fatalf("got error %v, want %q", err, test.wantErr)
+					}
+					break
+				}
+			}
+			if f, err := s.Play(4); len(f) != 0 || err != io.EOF {
+				t.Fatalf("got %v, %v after the end", f, err)
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("got %v, want %v", got, test.want)
+			}
+			for i := range got {
+				if got[i] != test.want[i] {
+					t.Fatalf("got %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestProbeError(t *testing.T) {
+	tests := []struct {
+		name    string
+		probe   string
+		wantErr string
+	}{
+		{"exit", "echo bad file >&2; exit 1", "bad file"},
+		{"json", "echo not json", "bad probe output"},
+		{"no stream", `echo '{"streams": []}'`, "no audio stream"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setCommands(t, "cat", "cat > /dev/null; "+test.probe)
+			s := &Song{Reader: reader(nil)}
+			_, err := s.Info()
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("got error %v, want %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestInit(t *testing.T) {
+	setCommands(t, "cat", "cat")
+	defer func(e []string) { Extensions = e }(Extensions)
+
+	Extensions = []string{"tst", ".tst"}
+	if err := Init(); err == nil || !strings.Contains(err.Error(), "listed twice") {
+		t.Fatalf("got error %v, want listed twice", err)
+	}
+
+	// wav is decoded by the wav codec, so it is skipped instead of
+	// registered again.
+	Extensions = []string{"wav", ".tst", ""}
+	if err := Init(); err != nil {
+		t.Fatal(err)
+	}
+	if !codec.HasExtension("tst") {
+		t.Fatal("tst not registered")
+	}
+}
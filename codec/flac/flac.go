@@ -1,16 +1,15 @@
 package flac
 
 import (
+	"bufio"
 	"bytes"
-	"encoding/base64"
-	"fmt"
 	"io"
 	"io/ioutil"
-	"math"
-	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/codec/cue"
 	"gopkg.in/mewkiz/flac.v1"
 	"gopkg.in/mewkiz/flac.v1/frame"
 	"gopkg.in/mewkiz/flac.v1/meta"
@@ -21,82 +20,195 @@ func init() {
 }
 
 func New(rf codec.Reader) (codec.Songs, error) {
-	f := Flac{
-		Reader: rf,
+	r, _, err := rf()
+	if err != nil {
+		return nil, err
+	}
+	fs, _, err := parse(r, ioutil.Discard)
+	r.Close()
+	if err != nil {
+		return nil, err
+	}
+	// Split files with a cue sheet into tracks.
+	if s := cueSheet(fs); s != nil && len(s.Tracks) > 1 {
+		return s.Songs(func(string) (codec.Song, error) {
+			return &Flac{Reader: rf}, nil
+		})
+	}
+	return codec.Songs{codec.None: &Flac{Reader: rf}}, nil
+}
+
+// parse parses the metadata of the stream in r, after any ID3v2 tag, which
+// some taggers put before the FLAC signature. Everything read from r is
+// written to w. It returns the size of the tag.
+func parse(r io.Reader, w io.Writer) (*flac.Stream, int, error) {
+	head := make([]byte, id3HeaderSize)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, 0, err
+	}
+	head = head[:n]
+	tag := id3Size(head)
+	if tag == 0 {
+		r = io.MultiReader(bytes.NewReader(head), r)
+	} else {
+		w.Write(head)
+		if _, err := io.CopyN(w, r, int64(tag-len(head))); err != nil {
+			return nil, 0, err
+		}
+	}
+	s, err := flac.Parse(io.TeeReader(r, w))
+	return s, tag, err
+}
+
+const id3HeaderSize = 10
+
+// id3Size returns the size of the ID3v2 tag that b starts with, including
+// its header and footer, or 0 if b does not start with one.
+func id3Size(b []byte) int {
+	if len(b) < id3HeaderSize || string(b[:3]) != "ID3" {
+		return 0
+	}
+	// The size is syncsafe, 7 bits per byte, and excludes the header and
+	// footer.
+	n := 0
+	for _, c := range b[6:10] {
+		if c&0x80 != 0 {
+			return 0
+		}
+		n = n<<7 | int(c)
 	}
-	return codec.Songs{codec.None: &f}, nil
+	n += id3HeaderSize
+	if b[5]&0x10 != 0 {
+		n += id3HeaderSize
+	}
+	return n
+}
+
+// cueSheet returns the cue sheet in a CUESHEET tag, which has titles, or else
+// in a CUESHEET block.
+func cueSheet(fs *flac.Stream) *cue.Sheet {
+	for _, b := range fs.Blocks {
+		if v, ok := b.Body.(*meta.VorbisComment); ok {
+			for _, tag := range v.Tags {
+				if strings.ToUpper(tag[0]) != "CUESHEET" {
+					continue
+				}
+				if s, err := cue.Parse(strings.NewReader(tag[1])); err == nil {
+					return s
+				}
+			}
+		}
+	}
+	for _, b := range fs.Blocks {
+		cs, ok := b.Body.(*meta.CueSheet)
+		if !ok {
+			continue
+		}
+		s := new(cue.Sheet)
+		for _, t := range cs.Tracks {
+			if !t.IsAudio || len(t.Indicies) == 0 {
+				// The lead-out track has no indices.
+				continue
+			}
+			off := t.Offset + t.Indicies[0].Offset
+			for _, idx := range t.Indicies {
+				if idx.Num == 1 {
+					off = t.Offset + idx.Offset
+				}
+			}
+			s.Tracks = append(s.Tracks, &cue.Track{
+				Number: int(t.Num),
+				Start:  time.Duration(off) * time.Second / time.Duration(fs.Info.SampleRate),
+			})
+		}
+		return s
+	}
+	return nil
 }
 
 type Flac struct {
-	Reader  codec.Reader
-	r       io.ReadCloser
+	Reader codec.Reader
+	r      io.ReadCloser
+	// initbuf holds the ID3v2 tag, if any, and the metadata, up to the first
+	// frame.
 	initbuf []byte
 	f       *flac.Stream
+	// frames reads the frames that follow initbuf.
+	frames  io.Reader
 	samples []int32
+	// skip is the number of samples to drop after a seek.
+	skip int
+	// size is the file size in bytes, or 0 if unknown.
+	size int64
 }
 
 func (f *Flac) Init() (sampleRate, channels int, err error) {
 	if f.f == nil {
-		r, _, err := f.Reader()
+		r, sz, err := f.Reader()
 		if err != nil {
 			return 0, 0, err
 		}
+		f.size = sz
 		buf := new(bytes.Buffer)
-		defer func() {
-			f.initbuf = buf.Bytes()
-		}()
-		fr, err := flac.Parse(io.TeeReader(r, buf))
+		fr, tag, err := parse(r, buf)
 		if err != nil {
 			r.Close()
 			return 0, 0, err
 		}
+		// Parse reads ahead, so continue from what it read past the metadata.
+		b := buf.Bytes()
+		n := tag + metadataSize(fr)
+		f.initbuf = b[:n]
+		f.frames = bufio.NewReader(io.MultiReader(bytes.NewReader(b[n:]), r))
 		f.r = r
 		f.f = fr
+		f.samples = nil
+		f.skip = 0
 	}
 	return int(f.f.Info.SampleRate), int(f.f.Info.NChannels), nil
 }
 
+// metadataSize returns the number of bytes before the first frame of s.
+func metadataSize(s *flac.Stream) int {
+	// Signature and StreamInfo block.
+	n := 4 + 4 + 34
+	for _, b := range s.Blocks {
+		n += 4 + int(b.Length)
+	}
+	return n
+}
+
 func (f *Flac) Info() (info codec.SongInfo, err error) {
 	var r io.ReadCloser
 	if len(f.initbuf) != 0 {
 		r = ioutil.NopCloser(bytes.NewBuffer(f.initbuf))
 	}
 	if r == nil {
-		r, _, err = f.Reader()
+		r, f.size, err = f.Reader()
 		if err != nil {
 			return
 		}
 	}
-	fv, err := flac.Parse(r)
+	fv, _, err := parse(r, ioutil.Discard)
 	r.Close()
 	if err != nil {
 		return
 	}
 	si := codec.SongInfo{
-		Time: time.Duration(fv.Info.NSamples) / time.Duration(fv.Info.SampleRate) * time.Second,
+		Time:       time.Duration(fv.Info.NSamples) / time.Duration(fv.Info.SampleRate) * time.Second,
+		SampleRate: int(fv.Info.SampleRate),
+		Codec:      "FLAC",
 	}
+	si.SetBitrate(f.size)
 	for _, b := range fv.Blocks {
 		switch v := b.Body.(type) {
 		case *meta.VorbisComment:
 			for _, tag := range v.Tags {
-				switch tag[0] {
-				case "TITLE":
-					si.Title = tag[1]
-				case "ARTIST":
-					si.Artist = tag[1]
-				case "ALBUM":
-					si.Album = tag[1]
-				case "TRACKNUMBER":
-					n, _ := strconv.Atoi(tag[1])
-					si.Track = float64(n)
-				}
+				si.SetTag(tag[0], tag[1])
 			}
 		case *meta.Picture:
-			if v.MIME == "-->" {
-				si.ImageURL = string(v.Data)
-				break
-			}
-			si.ImageURL = fmt.Sprintf("data:%s;base64,%s", v.MIME, base64.StdEncoding.EncodeToString(v.Data))
+			si.ImageURL = codec.ArtURL(v.MIME, v.Data)
 		}
 	}
 	return si, nil
@@ -104,29 +216,122 @@ func (f *Flac) Info() (info codec.SongInfo, err error) {
 
 func (f *Flac) Play(n int) ([]float32, error) {
 	var err error
-	var frame *frame.Frame
+	var fr *frame.Frame
 	for len(f.samples) < n && err == nil {
-		frame, err = f.f.ParseNext()
+		fr, err = frame.Parse(f.frames)
 		if err != nil {
 			break
 		}
-		for i := 0; i < int(frame.BlockSize); i++ {
-			for _, sf := range frame.Subframes {
+		for i := 0; i < int(fr.BlockSize); i++ {
+			for _, sf := range fr.Subframes {
 				f.samples = append(f.samples, sf.Samples[i])
 			}
 		}
+		if f.skip > 0 {
+			s := f.skip
+			if s > len(f.samples) {
+				s = len(f.samples)
+			}
+			f.samples = f.samples[s:]
+			f.skip -= s
+		}
 	}
 	if n > len(f.samples) {
 		n = len(f.samples)
 	}
-	ret := make([]float32, n)
-	for i, s := range f.samples[:n] {
-		ret[i] = (float32(s) - math.MinInt16) / (math.MaxInt16 - math.MinInt16)
-	}
+	ret := codec.IntToFloat(make([]float32, 0, n), f.samples[:n], int(f.f.Info.BitsPerSample))
 	f.samples = f.samples[n:]
 	return ret, err
 }
 
+// Seek finds the frame containing offset with the SEEKTABLE, if any, and a
+// binary search for frame headers, then decodes up to offset.
+func (f *Flac) Seek(offset time.Duration) error {
+	rs, ok := f.r.(io.ReadSeeker)
+	if !ok {
+		return codec.ErrSeek
+	}
+	target := uint64(int64(offset) * int64(f.f.Info.SampleRate) / int64(time.Second))
+	if n := f.f.Info.NSamples; n > 0 && target > n {
+		target = n
+	}
+	// lo is the offset of a frame that starts at sample, and hi is past the
+	// frame containing target.
+	lo := int64(len(f.initbuf))
+	var sample uint64
+	hi, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	for _, b := range f.f.Blocks {
+		st, ok := b.Body.(*meta.SeekTable)
+		if !ok {
+			continue
+		}
+		for _, p := range st.Points {
+			if p.SampleNum == meta.PlaceholderPoint {
+				continue
+			}
+			if p.SampleNum <= target {
+				lo, sample = int64(len(f.initbuf))+int64(p.Offset), p.SampleNum
+			} else if o := int64(len(f.initbuf)) + int64(p.Offset); o < hi {
+				hi = o
+			}
+		}
+	}
+	for hi-lo > searchLimit {
+		mid := lo + (hi-lo)/2
+		off, num, err := f.nextFrame(rs, mid)
+		if err != nil || off >= hi || num > target {
+			hi = mid
+			continue
+		}
+		lo, sample = off, num
+	}
+	if _, err := rs.Seek(lo, io.SeekStart); err != nil {
+		return err
+	}
+	f.frames = bufio.NewReader(rs)
+	f.samples = nil
+	f.skip = int(target-sample) * int(f.f.Info.NChannels)
+	return nil
+}
+
+// searchLimit is the size below which a seek decodes forward instead of
+// searching.
+const searchLimit = 64 << 10
+
+// nextFrame returns the offset and first sample number of the first frame
+// that starts at or after off.
+func (f *Flac) nextFrame(rs io.ReadSeeker, off int64) (int64, uint64, error) {
+	if _, err := rs.Seek(off, io.SeekStart); err != nil {
+		return 0, 0, err
+	}
+	b := make([]byte, searchLimit)
+	n, err := io.ReadFull(rs, b)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return 0, 0, err
+	}
+	b = b[:n]
+	for i := 0; i+1 < len(b); i++ {
+		// Frames start with a 14-bit sync code and a zero bit.
+		if b[i] != 0xff || b[i+1]&0xfe != 0xf8 {
+			continue
+		}
+		// The header CRC rejects most false syncs.
+		h, err := frame.New(bytes.NewReader(b[i:]))
+		if err != nil {
+			continue
+		}
+		num := h.Num
+		if h.HasFixedBlockSize {
+			num *= uint64(f.f.Info.BlockSizeMax)
+		}
+		return off + int64(i), num, nil
+	}
+	return 0, 0, io.EOF
+}
+
 func (f *Flac) Close() {
 	if f.r != nil {
 		f.r.Close()
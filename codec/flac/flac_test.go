@@ -0,0 +1,25 @@
+package flac
+
+import "testing"
+
+func TestID3Size(t *testing.T) {
+	tests := []struct {
+		name string
+		b    string
+		want int
+	}{
+		{"flac", "fLaC\x00\x00\x00\x22\x10\x00", 0},
+		{"short", "ID3\x04\x00", 0},
+		{"empty", "ID3\x04\x00\x00\x00\x00\x00\x00", 10},
+		{"small", "ID3\x03\x00\x00\x00\x00\x00\x7f", 137},
+		{"syncsafe", "ID3\x04\x00\x00\x00\x00\x01\x00", 138},
+		{"large", "ID3\x04\x00\x00\x01\x02\x03\x04", 1<<21 + 2<<14 + 3<<7 + 4 + 10},
+		{"footer", "ID3\x04\x00\x10\x00\x00\x00\x05", 25},
+		{"not syncsafe", "ID3\x04\x00\x00\x00\x00\x00\x80", 0},
+	}
+	for _, test := range tests {
+		if got := id3Size([]byte(test.b)); got != test.want {
+			t.Errorf("%s: got %d, want %d", test.name, got, test.want)
+		}
+	}
+}
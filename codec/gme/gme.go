@@ -3,9 +3,9 @@
 package gme
 
 import (
+	"bytes"
 	"io"
 	"io/ioutil"
-	"math"
 	"strconv"
 
 	"github.com/mjibson/gme"
@@ -80,9 +80,23 @@ func (t *Track) Info() (codec.SongInfo, error) {
 		Title:  info.Song,
 		Album:  info.Game,
 		Track:  float64(t.track),
+		Codec:  format(b),
 	}, err
 }
 
+// format returns the name b's format is registered with.
+func format(b []byte) string {
+	switch {
+	case bytes.HasPrefix(b, []byte("SNES-SPC")):
+		return "SPC"
+	case bytes.HasPrefix(b, []byte("NESM\u001a")):
+		return "NSF"
+	case bytes.HasPrefix(b, []byte("NSFE")):
+		return "NSFE"
+	}
+	return ""
+}
+
 func (t *Track) Init() (sampleRate, channels int, err error) {
 	b, err := t.r.get()
 	if err != nil {
@@ -132,9 +146,5 @@ func (t *Track) Play(n int) ([]float32, error) {
 	if err != nil && err != io.EOF {
 		return nil, err
 	}
-	ret := make([]float32, n)
-	for i, s := range data {
-		ret[i] = (float32(s) - math.MinInt16) / (math.MaxInt16 - math.MinInt16)
-	}
-	return ret, err
+	return codec.Int16ToFloat(make([]float32, 0, n), data), err
 }
@@ -0,0 +1,238 @@
+package codec
+
+import (
+	"math"
+	"time"
+)
+
+// ReplayGainReference is the loudness, in LUFS, that ReplayGain 2.0 gains
+// adjust to.
+const ReplayGainReference = -18
+
+// Loudness measures integrated loudness as specified by ITU-R BS.1770 and EBU
+// R128: K-weighted mean square power over 400ms blocks with 75% overlap,
+// gated at -70 LUFS and then at 10 LU below the ungated loudness.
+type Loudness struct {
+	channels int
+	weights  []float64
+	filters  []kFilter
+	// sub holds the power of the last three 100ms sub-blocks, and cur is the
+	// sum of squares of the current sub-block of subLen frames.
+	sub    []float64
+	cur    float64
+	n      int
+	subLen int
+	blocks []float64
+	peak   float64
+}
+
+func NewLoudness(sampleRate, channels int) *Loudness {
+	l := &Loudness{
+		channels: channels,
+		weights:  make([]float64, channels),
+		filters:  make([]kFilter, channels),
+		subLen:   sampleRate / 10,
+	}
+	for i := range l.weights {
+		l.weights[i] = 1
+		l.filters[i] = newKFilter(float64(sampleRate))
+	}
+	if channels == 6 {
+		// 5.1: no LFE, boosted surrounds.
+		l.weights[3] = 0
+		l.weights[4] = 1.41
+		l.weights[5] = 1.41
+	}
+	return l
+}
+
+// Write adds interleaved samples to the measurement.
+func (l *Loudness) Write(samples []float32) {
+	for i := 0; i+l.channels <= len(samples); i += l.channels {
+		for c, s := range samples[i : i+l.channels] {
+			v := float64(s)
+			if a := math.Abs(v); a > l.peak {
+				l.peak = a
+			}
+			v = l.filters[c].filter(v)
+			l.cur += l.weights[c] * v * v
+		}
+		l.n++
+		if l.n < l.subLen {
+			continue
+		}
+		p := l.cur / float64(l.subLen)
+		if len(l.sub) == 3 {
+			l.blocks = append(l.blocks, (l.sub[0]+l.sub[1]+l.sub[2]+p)/4)
+			l.sub = l.sub[1:]
+		}
+		l.sub = append(l.sub, p)
+		l.cur, l.n = 0, 0
+	}
+}
+
+// Peak returns the largest absolute sample value.
+func (l *Loudness) Peak() float64 {
+	return l.peak
+}
+
+// lufs returns the loudness of a mean block power.
+func lufs(power float64) float64 {
+	return -0.691 + 10*math.Log10(power)
+}
+
+// gated returns the mean power of the blocks above the absolute and relative
+// gates, and their number.
+func (l *Loudness) gated() (float64, int) {
+	gate := func(threshold float64) (float64, int) {
+		var sum float64
+		var n int
+		for _, b := range l.blocks {
+			if lufs(b) > threshold {
+				sum += b
+				n++
+			}
+		}
+		if n == 0 {
+			return 0, 0
+		}
+		return sum / float64(n), n
+	}
+	p, n := gate(-70)
+	if n == 0 {
+		return 0, 0
+	}
+	return gate(lufs(p) - 10)
+}
+
+// Integrated returns the gated loudness in LUFS, or -Inf if it is silent or
+// too short to measure.
+func (l *Loudness) Integrated() float64 {
+	p, n := l.gated()
+	if n == 0 {
+		return math.Inf(-1)
+	}
+	return lufs(p)
+}
+
+// Gain returns the ReplayGain 2.0 adjustment in dB, or 0 if the loudness can
+// not be measured.
+func (l *Loudness) Gain() float64 {
+	i := l.Integrated()
+	if math.IsInf(i, -1) {
+		return 0
+	}
+	return ReplayGainReference - i
+}
+
+// LoudnessSummary is what is kept of a track's measurement to combine it
+// with those of the other tracks of its album, without measuring them again.
+type LoudnessSummary struct {
+	// Power is the mean power of the gated blocks, and Blocks their number.
+	Power  float64
+	Blocks int
+	Peak   float64
+}
+
+// Summary returns the summary of the measurement.
+func (l *Loudness) Summary() LoudnessSummary {
+	p, n := l.gated()
+	return LoudnessSummary{
+		Power:  p,
+		Blocks: n,
+		Peak:   l.peak,
+	}
+}
+
+// AlbumLoudness returns the ReplayGain adjustment in dB and the peak of the
+// tracks of an album. Each track is gated on its own, which is close to
+// gating the whole album for all but very uneven ones. The gain is 0 if the
+// loudness can not be measured.
+func AlbumLoudness(tracks []LoudnessSummary) (gain, peak float64) {
+	var sum float64
+	var n int
+	for _, t := range tracks {
+		sum += t.Power * float64(t.Blocks)
+		n += t.Blocks
+		if t.Peak > peak {
+			peak = t.Peak
+		}
+	}
+	if n == 0 {
+		return 0, peak
+	}
+	return ReplayGainReference - lufs(sum/float64(n)), peak
+}
+
+// MeasureLoudness decodes s, up to max if it does not end by itself, and
+// returns its loudness. s is closed.
+func MeasureLoudness(s Song, max time.Duration) (*Loudness, error) {
+	defer s.Close()
+	sr, ch, err := s.Init()
+	if err != nil {
+		return nil, err
+	}
+	l := NewLoudness(sr, ch)
+	remaining := int(int64(max) * int64(sr) / int64(time.Second) * int64(ch))
+	for remaining > 0 {
+		const n = 4096
+		b, err := s.Play(n)
+		l.Write(b)
+		remaining -= len(b)
+		if err != nil || len(b) < n {
+			break
+		}
+	}
+	return l, nil
+}
+
+// kFilter is the BS.1770 K-weighting filter: a high shelf followed by a high
+// pass, with coefficients computed for any sample rate.
+type kFilter struct {
+	shelf, pass biquad
+}
+
+func newKFilter(rate float64) kFilter {
+	var f kFilter
+	// High shelf.
+	f0, g, q := 1681.974450955533, 3.999843853973347, 0.7071752369554196
+	k := math.Tan(math.Pi * f0 / rate)
+	vh := math.Pow(10, g/20)
+	vb := math.Pow(vh, 0.4996667741545416)
+	a0 := 1 + k/q + k*k
+	f.shelf = biquad{
+		b0: (vh + vb*k/q + k*k) / a0,
+		b1: 2 * (k*k - vh) / a0,
+		b2: (vh - vb*k/q + k*k) / a0,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+	// High pass.
+	f0, q = 38.13547087602444, 0.5003270373238773
+	k = math.Tan(math.Pi * f0 / rate)
+	a0 = 1 + k/q + k*k
+	f.pass = biquad{
+		b0: 1,
+		b1: -2,
+		b2: 1,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+	return f
+}
+
+func (f *kFilter) filter(v float64) float64 {
+	return f.pass.filter(f.shelf.filter(v))
+}
+
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+	x1, x2, y1, y2     float64
+}
+
+func (b *biquad) filter(x float64) float64 {
+	y := b.b0*x + b.b1*b.x1 + b.b2*b.x2 - b.a1*b.y1 - b.a2*b.y2
+	b.x2, b.x1 = b.x1, x
+	b.y2, b.y1 = b.y1, y
+	return y
+}
@@ -3,7 +3,6 @@ package mpa
 import (
 	"bytes"
 	"io"
-	"math"
 	"time"
 
 	"github.com/dhowden/tag"
@@ -35,6 +34,7 @@ type Song struct {
 	decoder *mpa.Decoder
 	buff    [2][]float32
 	info    *codec.SongInfo
+	table   *mpseek.Table
 }
 
 func NewSong(rf codec.Reader) (*Song, error) {
@@ -64,15 +64,64 @@ func (s *Song) Info() (info codec.SongInfo, err error) {
 	if err != nil {
 		return
 	}
-	table, err := mpseek.CreateTable(bytes.NewReader(b), math.MaxFloat64)
+	table, err := mpseek.CreateTable(bytes.NewReader(b), seekGranularity)
 	if err != nil {
 		return
 	}
 	si.Time = time.Duration(table.Length()) * time.Second
+	si.SampleRate = table.SamplingFrequency()
+	si.Codec = "MP3"
+	si.SetBitrate(int64(len(b)))
 	s.info = si
+	s.table = table
 	return *si, nil
 }
 
+// seekGranularity is the time in seconds between seek table entries.
+const seekGranularity = 1
+
+// Seek seeks to the nearest seek table entry, decodes the frames needed to
+// warm up the decoder, then decodes up to offset.
+func (s *Song) Seek(offset time.Duration) error {
+	rs, ok := s.r.(io.ReadSeeker)
+	if !ok {
+		return codec.ErrSeek
+	}
+	if s.table == nil {
+		if _, err := s.Info(); err != nil {
+			return err
+		}
+	}
+	target := int64(offset) * int64(s.table.SamplingFrequency()) / int64(time.Second)
+	if n := s.table.NSamples(); target > n {
+		target = n
+	}
+	res := s.table.FindSample(target)
+	if _, err := rs.Seek(res.Offset, io.SeekStart); err != nil {
+		return err
+	}
+	s.decoder = &mpa.Decoder{Input: rs}
+	for i := 0; i < res.WarmUp; i++ {
+		// Errors are expected until the decoder has warmed up.
+		if err := s.decoder.DecodeFrame(); err == io.EOF || err == io.ErrUnexpectedEOF {
+			return err
+		}
+	}
+	s.buff[0], s.buff[1] = nil, nil
+	for skip := target - res.Sample; skip > 0; {
+		if err := s.decode(); err != nil {
+			return err
+		}
+		n := int64(len(s.buff[0]))
+		if n > skip {
+			n = skip
+		}
+		s.buff[0], s.buff[1] = s.buff[0][n:], s.buff[1][n:]
+		skip -= n
+	}
+	return nil
+}
+
 func (s *Song) decode() error {
 	s.buff[0] = nil
 	s.buff[1] = nil
@@ -104,6 +104,7 @@ func (n *NSFSong) Info() (si codec.SongInfo, err error) {
 		Album:  n.NSF.Game,
 		Track:  float64(n.Index),
 		Title:  title,
+		Codec:  "NSF",
 	}
 	return
 }
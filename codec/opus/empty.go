@@ -0,0 +1,4 @@
+package opus
+
+// This file is here to satisfy Go's requirement that there is at least one
+// buildable file in a package since the other file only builds with cgo.
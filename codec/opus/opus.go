@@ -0,0 +1,356 @@
+// +build cgo
+
+package opus
+
+/*
+#cgo pkg-config: opus
+#include <opus_multistream.h>
+*/
+import "C"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/jfreymuth/go-vorbis/ogg"
+	"github.com/mjibson/moggio/codec"
+)
+
+func init() {
+	// The identification header follows a 27 byte page header and a one
+	// segment lacing table.
+	codec.RegisterCodec("OPUS", []string{"OggS" + strings.Repeat("?", 24) + "OpusHead"}, []string{"opus"}, NewSongs, nil)
+}
+
+// Opus always decodes at 48kHz.
+const rate = 48000
+
+// maxFrame is the largest number of samples per channel in a packet (120ms).
+const maxFrame = rate * 120 / 1000
+
+func NewSongs(rf codec.Reader) (codec.Songs, error) {
+	return codec.Songs{codec.None: &Opus{Reader: rf}}, nil
+}
+
+type Opus struct {
+	Reader codec.Reader
+	r      io.ReadCloser
+	o      *ogg.Reader
+	head   *header
+	dec    *C.OpusMSDecoder
+	pcm    []float32
+	// samples holds decoded samples not yet returned by Play.
+	samples []float32
+	// skip is the number of samples still to drop from the start.
+	skip int
+	// remaining is the number of samples left before the end of the stream,
+	// or -1 if unknown.
+	remaining int
+	info      *codec.SongInfo
+}
+
+var errHeader = errors.New("opus: bad header")
+
+// header is the identification header.
+type header struct {
+	channels int
+	preSkip  int
+	// gain is the output gain in dB.
+	gain    float64
+	streams int
+	coupled int
+	mapping []byte
+}
+
+func parseHeader(b []byte) (*header, error) {
+	if len(b) < 19 || string(b[:8]) != "OpusHead" || b[8]>>4 != 0 {
+		return nil, errHeader
+	}
+	h := &header{
+		channels: int(b[9]),
+		preSkip:  int(binary.LittleEndian.Uint16(b[10:])),
+		gain:     float64(int16(binary.LittleEndian.Uint16(b[16:]))) / 256,
+	}
+	if h.channels == 0 {
+		return nil, errHeader
+	}
+	if b[18] == 0 {
+		if h.channels > 2 {
+			return nil, errHeader
+		}
+		h.streams = 1
+		h.coupled = h.channels - 1
+		h.mapping = []byte{0, 1}[:h.channels]
+		return h, nil
+	}
+	if len(b) < 21+h.channels {
+		return nil, errHeader
+	}
+	h.streams = int(b[19])
+	h.coupled = int(b[20])
+	h.mapping = b[21 : 21+h.channels]
+	return h, nil
+}
+
+// parseTags parses an OpusTags header, which holds Vorbis comments.
+func parseTags(b []byte, si *codec.SongInfo) {
+	if len(b) < 8 || string(b[:8]) != "OpusTags" {
+		return
+	}
+	b = b[8:]
+	next := func() []byte {
+		if len(b) < 4 {
+			return nil
+		}
+		n := binary.LittleEndian.Uint32(b)
+		b = b[4:]
+		if uint64(n) > uint64(len(b)) {
+			b = nil
+			return nil
+		}
+		s := b[:n]
+		b = b[n:]
+		return s
+	}
+	// Vendor string.
+	next()
+	if len(b) < 4 {
+		return
+	}
+	count := binary.LittleEndian.Uint32(b)
+	b = b[4:]
+	for i := uint32(0); i < count && len(b) > 0; i++ {
+		c := strings.SplitN(string(next()), "=", 2)
+		if len(c) != 2 {
+			continue
+		}
+		switch v := c[1]; strings.ToUpper(c[0]) {
+		case "R128_TRACK_GAIN":
+			si.TrackGain = r128Gain(v)
+		case "R128_ALBUM_GAIN":
+			si.AlbumGain = r128Gain(v)
+		case "METADATA_BLOCK_PICTURE":
+			if u := pictureURL(v); u != "" {
+				si.ImageURL = u
+			}
+		default:
+			si.SetTag(c[0], v)
+		}
+	}
+}
+
+// r128Gain converts an R128 gain tag, in 1/256 dB relative to -23 LUFS, to
+// a ReplayGain adjustment in dB.
+func r128Gain(v string) float64 {
+	n, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil {
+		return 0
+	}
+	return float64(n)/256 + codec.ReplayGainReference + 23
+}
+
+// pictureURL returns the URL of a base64 encoded FLAC picture block.
+func pictureURL(v string) string {
+	b, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return ""
+	}
+	u32 := func() int {
+		if len(b) < 4 {
+			b = nil
+			return 0
+		}
+		n := binary.BigEndian.Uint32(b)
+		b = b[4:]
+		if uint64(n) > uint64(len(b)) {
+			return len(b)
+		}
+		return int(n)
+	}
+	// Picture type.
+	u32()
+	n := u32()
+	mime := string(b[:n])
+	b = b[n:]
+	n = u32()
+	b = b[n:]
+	// Width, height, depth and colors.
+	for i := 0; i < 4; i++ {
+		u32()
+	}
+	n = u32()
+	return codec.ArtURL(mime, b[:n])
+}
+
+func (o *Opus) Init() (sampleRate, channels int, err error) {
+	if o.dec == nil {
+		if err := o.open(); err != nil {
+			o.Close()
+			return 0, 0, err
+		}
+	}
+	return rate, o.head.channels, nil
+}
+
+func (o *Opus) open() error {
+	r, _, err := o.Reader()
+	if err != nil {
+		return err
+	}
+	o.r = r
+	o.remaining = -1
+	if rs, ok := r.(io.ReadSeeker); ok {
+		// Length restores the position when done.
+		if l, err := ogg.NewReader(rs).Length(); err == nil {
+			o.remaining = int(l)
+		}
+	}
+	o.o = ogg.NewReader(r)
+	b, err := o.o.NextPacket()
+	if err != nil {
+		return err
+	}
+	h, err := parseHeader(b)
+	if err != nil {
+		return err
+	}
+	// Tags.
+	if _, err := o.o.NextPacket(); err != nil {
+		return err
+	}
+	var cerr C.int
+	o.dec = C.opus_multistream_decoder_create(C.opus_int32(rate), C.int(h.channels), C.int(h.streams), C.int(h.coupled), (*C.uchar)(unsafe.Pointer(&h.mapping[0])), &cerr)
+	if cerr != C.OPUS_OK {
+		o.dec = nil
+		return fmt.Errorf("opus: %s", C.GoString(C.opus_strerror(cerr)))
+	}
+	o.head = h
+	o.pcm = make([]float32, maxFrame*h.channels)
+	o.samples = nil
+	o.skip = h.preSkip * h.channels
+	if o.remaining >= 0 {
+		// The final granule position counts the pre-skip.
+		o.remaining = (o.remaining - h.preSkip) * h.channels
+		if o.remaining < 0 {
+			o.remaining = 0
+		}
+	}
+	return nil
+}
+
+func (o *Opus) Info() (codec.SongInfo, error) {
+	if o.info != nil {
+		return *o.info, nil
+	}
+	r, _, err := o.Reader()
+	if err != nil {
+		return codec.SongInfo{}, err
+	}
+	b, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return codec.SongInfo{}, err
+	}
+	or := ogg.NewReader(bytes.NewReader(b))
+	p, err := or.NextPacket()
+	if err != nil {
+		return codec.SongInfo{}, err
+	}
+	h, err := parseHeader(p)
+	if err != nil {
+		return codec.SongInfo{}, err
+	}
+	p, err = or.NextPacket()
+	if err != nil {
+		return codec.SongInfo{}, err
+	}
+	var si codec.SongInfo
+	parseTags(p, &si)
+	l, err := or.Length()
+	if err != nil {
+		return codec.SongInfo{}, err
+	}
+	if n := int64(l) - int64(h.preSkip); n > 0 {
+		si.Time = time.Duration(n) * time.Second / rate
+	}
+	si.SampleRate = rate
+	si.Codec = "OPUS"
+	si.SetBitrate(int64(len(b)))
+	o.info = &si
+	return si, nil
+}
+
+func (o *Opus) Play(n int) ([]float32, error) {
+	var err error
+	ch := o.head.channels
+	scale := float32(math.Pow(10, o.head.gain/20))
+	end := o.remaining == 0
+	for len(o.samples) < n && o.remaining != 0 {
+		var p []byte
+		p, err = o.o.NextPacket()
+		if err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				err = nil
+				end = true
+			}
+			break
+		}
+		if len(p) == 0 {
+			continue
+		}
+		d := C.opus_multistream_decode_float(o.dec, (*C.uchar)(unsafe.Pointer(&p[0])), C.opus_int32(len(p)), (*C.float)(unsafe.Pointer(&o.pcm[0])), C.int(maxFrame), 0)
+		if d < 0 {
+			err = fmt.Errorf("opus: %s", C.GoString(C.opus_strerror(C.int(d))))
+			break
+		}
+		b := o.pcm[:int(d)*ch]
+		if o.skip > 0 {
+			s := o.skip
+			if s > len(b) {
+				s = len(b)
+			}
+			b = b[s:]
+			o.skip -= s
+		}
+		if o.remaining > 0 && len(b) > o.remaining {
+			b = b[:o.remaining]
+		}
+		if o.remaining > 0 {
+			o.remaining -= len(b)
+		}
+		for _, s := range b {
+			o.samples = append(o.samples, s*scale)
+		}
+	}
+	if n > len(o.samples) {
+		n = len(o.samples)
+	}
+	ret := o.samples[:n]
+	o.samples = o.samples[n:]
+	if len(ret) == 0 && (end || o.remaining == 0) {
+		err = io.EOF
+	}
+	return ret, err
+}
+
+func (o *Opus) Close() {
+	if o.dec != nil {
+		C.opus_multistream_decoder_destroy(o.dec)
+		o.dec = nil
+	}
+	if o.r != nil {
+		o.r.Close()
+		o.r = nil
+	}
+	o.o = nil
+}
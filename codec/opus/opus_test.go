@@ -0,0 +1,126 @@
+// +build cgo
+
+package opus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+// oggCRC returns the Ogg checksum of b.
+func oggCRC(b []byte) uint32 {
+	var crc uint32
+	for _, c := range b {
+		crc ^= uint32(c) << 24
+		for i := 0; i < 8; i++ {
+			if crc&0x80000000 != 0 {
+				crc = crc<<1 ^ 0x04c11db7
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// oggPage returns an Ogg page holding packet p, which must be shorter than
+// 255 bytes.
+func oggPage(p []byte, flags byte, granule int64, seq uint32) []byte {
+	b := []byte("OggS\x00")
+	b = append(b, flags)
+	b = append(b, make([]byte, 20)...)
+	binary.LittleEndian.PutUint64(b[6:], uint64(granule))
+	binary.LittleEndian.PutUint32(b[14:], 1)
+	binary.LittleEndian.PutUint32(b[18:], seq)
+	b = append(b, 1, byte(len(p)))
+	b = append(b, p...)
+	binary.LittleEndian.PutUint32(b[22:], oggCRC(b))
+	return b
+}
+
+const (
+	// testPreSkip is the pre-skip of testOpus.
+	testPreSkip = 312
+	// testPacket is a mono 20ms CELT packet without a frame, which decodes to
+	// 960 samples of concealment.
+	testPacket = "\xf8"
+	testFrame  = 960
+)
+
+// testOpus returns a mono Ogg Opus stream of packets packets whose final
+// granule position is end.
+func testOpus(packets int, end int64) []byte {
+	head := []byte("OpusHead\x01\x01\x00\x00\x80\xbb\x00\x00\x00\x00\x00")
+	binary.LittleEndian.PutUint16(head[10:], testPreSkip)
+	var b []byte
+	b = append(b, oggPage(head, 0x02, 0, 0)...)
+	b = append(b, oggPage([]byte("OpusTags\x00\x00\x00\x00\x00\x00\x00\x00"), 0, 0, 1)...)
+	for i := 1; i <= packets; i++ {
+		var flags byte
+		granule := int64(i * testFrame)
+		if i == packets {
+			flags = 0x04
+			granule = end
+		}
+		b = append(b, oggPage([]byte(testPacket), flags, granule, uint32(i+1))...)
+	}
+	return b
+}
+
+// seekCloser is a bytes.Reader with a Close method.
+type seekCloser struct {
+	*bytes.Reader
+}
+
+func (seekCloser) Close() error { return nil }
+
+func TestPlay(t *testing.T) {
+	tests := []struct {
+		name string
+		seek bool
+		// end is the final granule position, and want the number of samples.
+		end  int64
+		want int
+	}{
+		// Without the length, all that is decoded plays.
+		{"stream", false, 5*testFrame - 100, 5*testFrame - testPreSkip},
+		{"trimmed", true, 5*testFrame - 100, 5*testFrame - 100 - testPreSkip},
+		{"whole", true, 5 * testFrame, 5*testFrame - testPreSkip},
+		{"pre-skip only", true, testPreSkip, 0},
+	}
+	for _, test := range tests {
+		b := testOpus(5, test.end)
+		o := &Opus{Reader: func() (io.ReadCloser, int64, error) {
+			if test.seek {
+				return seekCloser{bytes.NewReader(b)}, int64(len(b)), nil
+			}
+			return ioutil.NopCloser(bytes.NewReader(b)), int64(len(b)), nil
+		}}
+		if sr, ch, err := o.Init(); sr != rate || ch != 1 || err != nil {
+			t.Fatalf("%s: got %v, %v, %v", test.name, sr, ch, err)
+		}
+		got := 0
+		for i := 0; ; i++ {
+			if i > test.want/1000+2 {
+				t.Fatalf("%s: did not end", test.name)
+			}
+			s, err := o.Play(1000)
+			got += len(s)
+			if err == io.EOF {
+				if len(s) != 0 {
+					t.Fatalf("%s: got %d samples at EOF", test.name, len(s))
+				}
+				break
+			} else if err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+		}
+		o.Close()
+		if got != test.want {
+			t.Errorf("%s: got %d samples, want %d", test.name, got, test.want)
+		}
+	}
+}
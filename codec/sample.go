@@ -0,0 +1,64 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// IntToFloat converts signed integer samples with the given bit depth to
+// floats in [-1, 1), appending them to dst.
+func IntToFloat(dst []float32, samples []int32, bits int) []float32 {
+	scale := 1 / float32(int64(1)<<uint(bits-1))
+	for _, s := range samples {
+		dst = append(dst, float32(s)*scale)
+	}
+	return dst
+}
+
+// Int16ToFloat converts 16-bit samples to floats in [-1, 1), appending them
+// to dst.
+func Int16ToFloat(dst []float32, samples []int16) []float32 {
+	for _, s := range samples {
+		dst = append(dst, float32(s)/(1<<15))
+	}
+	return dst
+}
+
+// PCMToFloat converts little endian PCM data to floats in [-1, 1),
+// appending them to dst. Integer samples of 8 bits are unsigned and of 16, 24
+// or 32 bits are signed, as in WAV files; float samples are 32 or 64 bits.
+// Any partial sample at the end of b is ignored.
+func PCMToFloat(dst []float32, b []byte, bits int, float bool) ([]float32, error) {
+	size := (bits + 7) / 8
+	switch {
+	case float && bits == 32:
+		for ; len(b) >= 4; b = b[4:] {
+			dst = append(dst, math.Float32frombits(binary.LittleEndian.Uint32(b)))
+		}
+	case float && bits == 64:
+		for ; len(b) >= 8; b = b[8:] {
+			dst = append(dst, float32(math.Float64frombits(binary.LittleEndian.Uint64(b))))
+		}
+	case float:
+		return dst, fmt.Errorf("codec: unsupported float bit depth: %v", bits)
+	case bits <= 0 || bits > 32:
+		return dst, fmt.Errorf("codec: unsupported bit depth: %v", bits)
+	case size == 1:
+		for _, v := range b {
+			dst = append(dst, float32(int(v)-128)/128)
+		}
+	default:
+		// Samples are left-justified in size bytes, so scale by the
+		// container size, not the valid bits.
+		scale := 1 / float32(int64(1)<<uint(size*8-1))
+		for ; len(b) >= size; b = b[size:] {
+			var v uint32
+			for i := 0; i < size; i++ {
+				v |= uint32(b[i]) << uint(8*(4-size+i))
+			}
+			dst = append(dst, float32(int32(v)>>uint(8*(4-size)))*scale)
+		}
+	}
+	return dst, nil
+}
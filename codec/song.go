@@ -1,6 +1,10 @@
 package codec
 
-import "time"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
 
 type Song interface {
 	// Info returns information about a song.
@@ -8,14 +12,24 @@ type Song interface {
 	// Init is called before the first call to Play(). It should prepare resources
 	// needed for Play().
 	Init() (sampleRate, channels int, err error)
-	// Play returns the next n samples. Return < n to indicate end of song or 0
-	// to indicate EOF, neither of which should return an error. If < n samples
-	// are returned, Play will not be invoked again.
+	// Play returns the next n samples. Return < n to indicate end of song, and
+	// io.EOF with no samples once there are none left. If < n samples are
+	// returned, Play will not be invoked again.
 	Play(n int) ([]float32, error)
 	// Close releases resources used by the current file.
 	Close()
 }
 
+// Seeker is implemented by Songs that can seek without decoding everything
+// before the new position.
+type Seeker interface {
+	// Seek sets the position of the next Play to offset from the start of the
+	// song, rounded down to a sample frame. It may fail if the song's Reader
+	// does not support seeking, in which case the Song must be closed and
+	// initialized again before use.
+	Seek(offset time.Duration) error
+}
+
 type SongInfo struct {
 	Time     time.Duration
 	Artist   string
@@ -24,7 +38,101 @@ type SongInfo struct {
 	Track    float64
 	ImageURL string `json:",omitempty"`
 
+	// Disc is the disc number of multi-disc albums, or 0 if unknown.
+	Disc        float64 `json:",omitempty"`
+	AlbumArtist string  `json:",omitempty"`
+	Genre       string  `json:",omitempty"`
+	Year        int     `json:",omitempty"`
+	Composer    string  `json:",omitempty"`
+
+	// Bitrate is the average bitrate in bits per second, SampleRate is in
+	// Hz, and Codec is the name of the format, like "FLAC", or 0 or "" if
+	// unknown.
+	Bitrate    int    `json:",omitempty"`
+	SampleRate int    `json:",omitempty"`
+	Codec      string `json:",omitempty"`
+
 	// SongTitle, if set, is the currently playing song title. Needed for
 	// streaming.
 	SongTitle string
+
+	// ReplayGain adjustments in dB and peak amplitudes, or 0 if unknown.
+	TrackGain float64 `json:",omitempty"`
+	TrackPeak float64 `json:",omitempty"`
+	AlbumGain float64 `json:",omitempty"`
+	AlbumPeak float64 `json:",omitempty"`
+}
+
+// SetTag sets the field named by a tag, like a Vorbis comment or ffprobe
+// tag, from its value. name is case insensitive. It reports whether name is
+// a known tag.
+func (si *SongInfo) SetTag(name, value string) bool {
+	switch strings.ToUpper(name) {
+	case "TITLE":
+		si.Title = value
+	case "ARTIST":
+		si.Artist = value
+	case "ALBUM":
+		si.Album = value
+	case "ALBUMARTIST", "ALBUM ARTIST", "ALBUM_ARTIST":
+		si.AlbumArtist = value
+	case "TRACKNUMBER", "TRACK":
+		si.Track = float64(leadingInt(value))
+	case "DISCNUMBER", "DISC":
+		si.Disc = float64(leadingInt(value))
+	case "GENRE":
+		si.Genre = value
+	case "DATE", "YEAR":
+		si.Year = leadingInt(value)
+	case "COMPOSER":
+		si.Composer = value
+	default:
+		return si.SetReplayGain(name, value)
+	}
+	return true
+}
+
+// leadingInt returns the number at the start of s, like 3 from "3/12" or
+// 2001 from "2001-05-03", or 0 if there is none.
+func leadingInt(s string) int {
+	s = strings.TrimSpace(s)
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	n, _ := strconv.Atoi(s[:i])
+	return n
+}
+
+// SetBitrate sets Bitrate to the average of a file of size bytes. Time must
+// be set.
+func (si *SongInfo) SetBitrate(size int64) {
+	if si.Time > 0 && size > 0 {
+		si.Bitrate = int(size * 8 * int64(time.Second) / int64(si.Time))
+	}
+}
+
+// SetReplayGain sets the ReplayGain field named by a tag like
+// REPLAYGAIN_TRACK_GAIN from its value, like "-6.54 dB". name is case
+// insensitive. It reports whether name is a ReplayGain tag.
+func (si *SongInfo) SetReplayGain(name, value string) bool {
+	var f *float64
+	switch strings.ToUpper(name) {
+	case "REPLAYGAIN_TRACK_GAIN":
+		f = &si.TrackGain
+	case "REPLAYGAIN_TRACK_PEAK":
+		f = &si.TrackPeak
+	case "REPLAYGAIN_ALBUM_GAIN":
+		f = &si.AlbumGain
+	case "REPLAYGAIN_ALBUM_PEAK":
+		f = &si.AlbumPeak
+	default:
+		return false
+	}
+	value = strings.TrimSpace(value)
+	value = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(value, "dB"), "db"))
+	if v, err := strconv.ParseFloat(value, 64); err == nil {
+		*f = v
+	}
+	return true
 }
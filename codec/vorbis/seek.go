@@ -0,0 +1,214 @@
+package vorbis
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/jfreymuth/go-vorbis/ogg/vorbis"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Seek bisects the Ogg pages by granule position to find a page shortly
+// before offset, starts a new decoder there, and decodes up to offset. The
+// exact position is known from the granule position of the first page that
+// ends a decoded packet.
+func (v *Vorbis) Seek(offset time.Duration) error {
+	rs, ok := v.r.(io.ReadSeeker)
+	if !ok {
+		return codec.ErrSeek
+	}
+	ch := v.v.Channels()
+	target := uint64(int64(offset) * int64(v.v.SampleRate()) / int64(time.Second))
+	// Stop early enough that packet overlap can't pass target.
+	margin := uint64(v.v.MaxBlockSize() * 4)
+	if target > margin {
+		samples, start, err := v.seekPage(rs, target-margin)
+		if skip := int(target-start) * ch; err == nil && start <= target && skip <= len(samples) {
+			v.samples = samples[skip:]
+			v.skip = 0
+			return nil
+		}
+	}
+	// Decode from the start.
+	if _, err := rs.Seek(int64(len(v.header)), io.SeekStart); err != nil {
+		return err
+	}
+	vr, err := vorbis.Open(io.MultiReader(bytes.NewReader(v.header), rs))
+	if err != nil {
+		return err
+	}
+	v.v = vr
+	v.samples = nil
+	v.skip = int(target) * ch
+	return nil
+}
+
+var errNoPage = errors.New("vorbis: no page found")
+
+// seekPage starts a new decoder at a page after the last page that ends at or
+// before goal. It returns the first samples decoded and the position of the
+// first one.
+func (v *Vorbis) seekPage(rs io.ReadSeeker, goal uint64) ([]float32, uint64, error) {
+	lo := int64(len(v.header))
+	hi, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, 0, err
+	}
+	for hi-lo > searchLimit {
+		mid := lo + (hi-lo)/2
+		p, err := findPage(rs, mid)
+		if err != nil || p.off >= hi || p.granule == noGranule || p.granule > goal {
+			hi = mid
+			continue
+		}
+		lo = p.off
+	}
+	// Walk forward to the last page ending at or before goal, then to a page
+	// that starts with a new packet and ends at least two.
+	off := lo
+	found := false
+	for {
+		p, err := readPage(rs, off)
+		if err != nil {
+			return nil, 0, err
+		}
+		if p.granule != noGranule {
+			if p.granule > goal {
+				break
+			}
+			found = true
+		}
+		off += p.size
+	}
+	if !found {
+		return nil, 0, errNoPage
+	}
+	var p page
+	for {
+		p, err = readPage(rs, off)
+		if err != nil {
+			return nil, 0, err
+		}
+		if p.last {
+			return nil, 0, errNoPage
+		}
+		if !p.cont && p.packets >= 2 {
+			break
+		}
+		off += p.size
+	}
+	if _, err := rs.Seek(p.off, io.SeekStart); err != nil {
+		return nil, 0, err
+	}
+	vr, err := vorbis.Open(io.MultiReader(bytes.NewReader(v.header), rs))
+	if err != nil {
+		return nil, 0, err
+	}
+	// The first packet only primes the decoder, so the rest of the page's
+	// packets end at its granule position.
+	var samples []float32
+	var n uint64
+	for i := 1; i < p.packets; i++ {
+		b, err := vr.DecodePacket()
+		if err != nil {
+			return nil, 0, err
+		}
+		samples = append(samples, interleave(b)...)
+		if len(b) > 0 {
+			n += uint64(len(b[0]))
+		}
+	}
+	if n > p.granule {
+		return nil, 0, errNoPage
+	}
+	v.v = vr
+	return samples, p.granule - n, nil
+}
+
+// searchLimit is the size below which a seek walks pages instead of
+// searching.
+const searchLimit = 64 << 10
+
+const noGranule = ^uint64(0)
+
+// page is an Ogg page header.
+type page struct {
+	off, size  int64
+	granule    uint64
+	cont, last bool
+	// packets is the number of packets that end on the page.
+	packets int
+}
+
+// readPage reads the page header at off.
+func readPage(rs io.ReadSeeker, off int64) (page, error) {
+	if _, err := rs.Seek(off, io.SeekStart); err != nil {
+		return page{}, err
+	}
+	h := make([]byte, 27)
+	if _, err := io.ReadFull(rs, h); err != nil {
+		return page{}, err
+	}
+	if string(h[:4]) != "OggS" || h[4] != 0 {
+		return page{}, errNoPage
+	}
+	segs := make([]byte, h[26])
+	if _, err := io.ReadFull(rs, segs); err != nil {
+		return page{}, err
+	}
+	p := page{
+		off:     off,
+		size:    int64(len(h) + len(segs)),
+		granule: binary.LittleEndian.Uint64(h[6:]),
+		cont:    h[5]&1 != 0,
+		last:    h[5]&4 != 0,
+	}
+	for _, s := range segs {
+		p.size += int64(s)
+		if s < 255 {
+			p.packets++
+		}
+	}
+	return p, nil
+}
+
+// findPage returns the first page that starts at or after off.
+func findPage(rs io.ReadSeeker, off int64) (page, error) {
+	if _, err := rs.Seek(off, io.SeekStart); err != nil {
+		return page{}, err
+	}
+	b := make([]byte, searchLimit)
+	n, err := io.ReadFull(rs, b)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return page{}, err
+	}
+	b = b[:n]
+	for i := 0; ; i++ {
+		j := bytes.Index(b[i:], []byte("OggS"))
+		if j < 0 {
+			return page{}, errNoPage
+		}
+		i += j
+		if p, err := readPage(rs, off+int64(i)); err == nil {
+			return p, nil
+		}
+	}
+}
+
+// interleave converts samples per channel to interleaved samples.
+func interleave(samples [][]float32) []float32 {
+	if len(samples) == 0 {
+		return nil
+	}
+	c := len(samples)
+	data := make([]float32, c*len(samples[0]))
+	for i, cs := range samples {
+		for j, s := range cs {
+			data[j*c+i] = s
+		}
+	}
+	return data
+}
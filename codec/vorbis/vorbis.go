@@ -31,11 +31,15 @@ func NewSong(rf codec.Reader) (codec.Song, error) {
 }
 
 type Vorbis struct {
-	Reader  codec.Reader
-	r       io.ReadCloser
+	Reader codec.Reader
+	r      io.ReadCloser
+	// header holds the header pages.
+	header  []byte
 	v       *vorbis.Vorbis
 	samples []float32
-	info    *codec.SongInfo
+	// skip is the number of samples to drop after a seek.
+	skip int
+	info *codec.SongInfo
 }
 
 func (v *Vorbis) Init() (sampleRate, channels int, err error) {
@@ -44,13 +48,22 @@ func (v *Vorbis) Init() (sampleRate, channels int, err error) {
 		if err != nil {
 			return 0, 0, err
 		}
-		vr, err := vorbis.Open(r)
+		// Keep the headers so that decoding can start again at any page.
+		buf := new(bytes.Buffer)
+		if _, err := vorbis.Open(io.TeeReader(r, buf)); err != nil {
+			r.Close()
+			return 0, 0, err
+		}
+		v.header = buf.Bytes()
+		vr, err := vorbis.Open(io.MultiReader(bytes.NewReader(v.header), r))
 		if err != nil {
 			r.Close()
 			return 0, 0, err
 		}
 		v.r = r
 		v.v = vr
+		v.samples = nil
+		v.skip = 0
 	}
 	return v.v.SampleRate(), v.v.Channels(), nil
 }
@@ -73,6 +86,9 @@ func (v *Vorbis) Info() (info codec.SongInfo, err error) {
 		return
 	}
 	si.Time = time.Duration(l/uint64(vr.SampleRate())) * time.Second
+	si.SampleRate = vr.SampleRate()
+	si.Codec = "VORBIS"
+	si.SetBitrate(int64(len(b)))
 	v.info = si
 	return *si, nil
 }
@@ -85,15 +101,15 @@ func (v *Vorbis) Play(n int) ([]float32, error) {
 		if len(samples) == 0 {
 			break
 		}
-		n := len(samples[0])
-		c := len(samples)
-		data := make([]float32, c*n)
-		for i, cs := range samples {
-			for j, s := range cs {
-				data[j*c+i] = s
+		v.samples = append(v.samples, interleave(samples)...)
+		if v.skip > 0 {
+			s := v.skip
+			if s > len(v.samples) {
+				s = len(v.samples)
 			}
+			v.samples = v.samples[s:]
+			v.skip -= s
 		}
-		v.samples = append(v.samples, data...)
 	}
 	if n > len(v.samples) {
 		n = len(v.samples)
@@ -2,10 +2,12 @@ package mpa
 
 import (
 	"bytes"
+	"encoding/binary"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"time"
 
-	"github.com/mjibson/go-dsp/wav"
 	"github.com/mjibson/moggio/codec"
 )
 
@@ -21,31 +23,119 @@ func New(rf codec.Reader) (codec.Songs, error) {
 }
 
 type Wav struct {
-	Reader  codec.Reader
-	r       io.ReadCloser
+	Reader codec.Reader
+	r      io.ReadCloser
+	// initbuf holds the header, up to the start of the data chunk.
 	initbuf []byte
-	w       *wav.Wav
+	h       *header
+	// data reads the rest of the data chunk.
+	data    io.Reader
+	buf     []byte
+	samples []float32
+}
+
+const (
+	formatPCM        = 1
+	formatFloat      = 3
+	formatExtensible = 0xfffe
+)
+
+// header is the fmt chunk and the size of the data chunk.
+type header struct {
+	Format        uint16
+	Channels      uint16
+	SampleRate    uint32
+	BlockAlign    uint16
+	BitsPerSample uint16
+	dataSize      int64
+}
+
+func (h *header) float() bool {
+	return h.Format == formatFloat
+}
+
+// readHeader reads r up to the start of the data chunk.
+func readHeader(r io.Reader) (*header, error) {
+	b := make([]byte, 12)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	if string(b[:4]) != "RIFF" || string(b[8:]) != "WAVE" {
+		return nil, fmt.Errorf("wav: not a WAVE file")
+	}
+	var h *header
+	for {
+		if _, err := io.ReadFull(r, b[:8]); err != nil {
+			return nil, err
+		}
+		sz := int64(binary.LittleEndian.Uint32(b[4:]))
+		switch string(b[:4]) {
+		case "fmt ":
+			if sz < 16 {
+				return nil, fmt.Errorf("wav: bad fmt size")
+			}
+			f := make([]byte, sz+sz%2)
+			if _, err := io.ReadFull(r, f); err != nil {
+				return nil, err
+			}
+			h = &header{
+				Format:        binary.LittleEndian.Uint16(f),
+				Channels:      binary.LittleEndian.Uint16(f[2:]),
+				SampleRate:    binary.LittleEndian.Uint32(f[4:]),
+				BlockAlign:    binary.LittleEndian.Uint16(f[12:]),
+				BitsPerSample: binary.LittleEndian.Uint16(f[14:]),
+			}
+			if h.Format == formatExtensible && sz >= 26 {
+				// The format is the start of the sub-format GUID.
+				h.Format = binary.LittleEndian.Uint16(f[24:])
+			}
+			switch h.Format {
+			case formatPCM, formatFloat:
+			default:
+				return nil, fmt.Errorf("wav: unknown audio format: %02x", h.Format)
+			}
+			if h.Channels == 0 || h.SampleRate == 0 || h.BlockAlign == 0 {
+				return nil, fmt.Errorf("wav: bad fmt chunk")
+			}
+		case "data":
+			if h == nil {
+				return nil, fmt.Errorf("wav: missing fmt chunk")
+			}
+			h.dataSize = sz
+			return h, nil
+		default:
+			// Chunks are padded to an even size.
+			if _, err := io.CopyN(ioutil.Discard, r, sz+sz%2); err != nil {
+				return nil, err
+			}
+		}
+	}
 }
 
 func (w *Wav) Init() (sampleRate, channels int, err error) {
-	if w.w == nil {
+	if w.h == nil {
 		r, _, err := w.Reader()
 		if err != nil {
 			return 0, 0, err
 		}
 		buf := new(bytes.Buffer)
-		defer func() {
-			w.initbuf = buf.Bytes()
-		}()
-		wr, err := wav.New(io.TeeReader(r, buf))
+		h, err := readHeader(io.TeeReader(r, buf))
 		if err != nil {
 			r.Close()
 			return 0, 0, err
 		}
+		// Check the sample format now instead of on the first Play.
+		if _, err := codec.PCMToFloat(nil, nil, int(h.BitsPerSample), h.float()); err != nil {
+			r.Close()
+			return 0, 0, err
+		}
+		w.initbuf = buf.Bytes()
 		w.r = r
-		w.w = wr
+		w.h = h
+		w.data = io.LimitReader(r, h.dataSize)
+		w.samples = nil
 	}
-	return int(w.w.SampleRate), int(w.w.NumChannels), nil
+	return int(w.h.SampleRate), int(w.h.Channels), nil
 }
 
 func (w *Wav) Info() (info codec.SongInfo, err error) {
@@ -59,25 +149,78 @@ func (w *Wav) Info() (info codec.SongInfo, err error) {
 			return
 		}
 	}
-	wv, err := wav.New(r)
+	h, err := readHeader(r)
 	r.Close()
 	if err != nil {
 		return
 	}
+	frames := h.dataSize / int64(h.BlockAlign)
 	return codec.SongInfo{
-		Time: wv.Duration,
+		Time:       time.Duration(frames) * time.Second / time.Duration(h.SampleRate),
+		Bitrate:    int(h.SampleRate) * int(h.BlockAlign) * 8,
+		SampleRate: int(h.SampleRate),
+		Codec:      "WAV",
 	}, nil
 }
 
 func (w *Wav) Play(n int) ([]float32, error) {
-	return w.w.ReadFloats(n)
+	// Read whole frames so that channels stay aligned, and keep any samples
+	// past n for the next call.
+	samples := w.samples
+	w.samples = nil
+	var err error
+	if len(samples) < n {
+		ch := int(w.h.Channels)
+		frames := (n - len(samples) + ch - 1) / ch
+		size := frames * int(w.h.BlockAlign)
+		if cap(w.buf) < size {
+			w.buf = make([]byte, size)
+		}
+		b := w.buf[:size]
+		var m int
+		m, err = io.ReadFull(w.data, b)
+		end := err == io.EOF || err == io.ErrUnexpectedEOF
+		if end {
+			// The end of the data, possibly in the middle of a frame.
+			err = nil
+		}
+		b = b[:m-m%int(w.h.BlockAlign)]
+		samples, _ = codec.PCMToFloat(samples, b, int(w.h.BitsPerSample), w.h.float())
+		if end && len(samples) == 0 {
+			err = io.EOF
+		}
+	}
+	if len(samples) > n {
+		w.samples = samples[n:]
+		samples = samples[:n]
+	}
+	return samples, err
+}
+
+// Seek seeks to the byte offset of the sample frame at offset.
+func (w *Wav) Seek(offset time.Duration) error {
+	rs, ok := w.r.(io.Seeker)
+	if !ok {
+		return codec.ErrSeek
+	}
+	frames := int64(offset) * int64(w.h.SampleRate) / int64(time.Second)
+	off := frames * int64(w.h.BlockAlign)
+	if off > w.h.dataSize {
+		off = w.h.dataSize
+	}
+	if _, err := rs.Seek(int64(len(w.initbuf))+off, io.SeekStart); err != nil {
+		return err
+	}
+	w.data = io.LimitReader(w.r, w.h.dataSize-off)
+	w.samples = nil
+	return nil
 }
 
 func (w *Wav) Close() {
 	if w.r != nil {
 		w.r.Close()
+		w.r = nil
 	}
-	if w.w != nil {
-		w.w = nil
-	}
+	w.h = nil
+	w.data = nil
 }
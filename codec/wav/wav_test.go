@@ -0,0 +1,87 @@
+package mpa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+// testWav returns a 16-bit stereo WAV file of frames frames whose samples
+// are their index times 256, followed by extra bytes of data and a LIST chunk.
+func testWav(frames, extra int) []byte {
+	data := new(bytes.Buffer)
+	for i := 0; i < frames*2; i++ {
+		binary.Write(data, binary.LittleEndian, int16(i*256))
+	}
+	data.Write(make([]byte, extra))
+	b := new(bytes.Buffer)
+	b.WriteString("RIFF\x00\x00\x00\x00WAVE")
+	b.WriteString("fmt ")
+	binary.Write(b, binary.LittleEndian, []uint32{16})
+	binary.Write(b, binary.LittleEndian, []uint16{formatPCM, 2})
+	binary.Write(b, binary.LittleEndian, []uint32{44100, 44100 * 4})
+	binary.Write(b, binary.LittleEndian, []uint16{4, 16})
+	b.WriteString("data")
+	binary.Write(b, binary.LittleEndian, uint32(data.Len()))
+	data.WriteTo(b)
+	if extra%2 != 0 {
+		b.WriteByte(0)
+	}
+	b.WriteString("LIST\x04\x00\x00\x00INFO")
+	return b.Bytes()
+}
+
+func TestPlay(t *testing.T) {
+	tests := []struct {
+		name          string
+		frames, extra int
+		n             int
+	}{
+		{"whole reads", 6, 0, 4},
+		{"short last read", 5, 0, 4},
+		{"partial frame", 5, 3, 4},
+		{"odd reads", 5, 0, 3},
+		{"empty", 0, 0, 4},
+	}
+	for _, test := range tests {
+		b := testWav(test.frames, test.extra)
+		w := &Wav{Reader: func() (io.ReadCloser, int64, error) {
+			return ioutil.NopCloser(bytes.NewReader(b)), int64(len(b)), nil
+		}}
+		if sr, ch, err := w.Init(); sr != 44100 || ch != 2 || err != nil {
+			t.Fatalf("%s: got %v, %v, %v", test.name, sr, ch, err)
+		}
+		var got []float32
+		for i := 0; ; i++ {
+			if i > test.frames+1 {
+				t.Fatalf("%s: did not end", test.name)
+			}
+			s, err := w.Play(test.n)
+			got = append(got, s...)
+			if err == io.EOF {
+				if len(s) != 0 {
+					t.Fatalf("%s: got %d samples at EOF", test.name, len(s))
+				}
+				break
+			} else if err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+			if len(s) > test.n || len(s) < test.n && len(got) != test.frames*2 {
+				t.Fatalf("%s: got %d samples, want %d", test.name, len(s), test.n)
+			}
+		}
+		if len(got) != test.frames*2 {
+			t.Fatalf("%s: got %d samples, want %d", test.name, len(got), test.frames*2)
+		}
+		for i, s := range got {
+			if want := float32(i) / 128; s != want {
+				t.Fatalf("%s: sample %d: got %v, want %v", test.name, i, s, want)
+			}
+		}
+		if _, err := w.Play(test.n); err != io.EOF {
+			t.Fatalf("%s: got %v after EOF", test.name, err)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package zip
+
+import (
+	"archive/zip"
+	"bufio"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+func init() {
+	codec.RegisterCodec("ZIP", []string{"PK\u0003\u0004"}, []string{"zip"}, Read, Get)
+}
+
+// archive is an open zip file. Files that can be read at random are read
+// through the zip directory. Others, like HTTP bodies, are read in order from
+// the start, one file after another.
+type archive struct {
+	r io.ReadCloser
+	// zr and ra read r at random, or br reads it in order.
+	zr *zip.Reader
+	ra io.ReaderAt
+	br *bufio.Reader
+	// c is closed when a file read from the archive is closed.
+	c io.Closer
+}
+
+func open(rf codec.Reader) (*archive, error) {
+	r, sz, err := rf()
+	if err != nil {
+		return nil, err
+	}
+	a := &archive{r: r, c: ioutil.NopCloser(nil)}
+	if ra, ok := r.(io.ReaderAt); ok && sz > 0 {
+		zr, err := zip.NewReader(ra, sz)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+		a.zr, a.ra = zr, ra
+	} else {
+		a.br = bufio.NewReader(r)
+	}
+	return a, nil
+}
+
+// errReopen is returned when a file of an archive read in order is opened a
+// second time.
+var errReopen = errors.New("zip: file already read")
+
+// each calls f with each file in a, in order, until f returns true. The Reader
+// passed to f reads the file from a and is only valid during the call. In an
+// archive read in order, it can be opened once.
+func (a *archive) each(f func(name string, rf codec.Reader) (stop bool)) error {
+	if a.zr != nil {
+		for _, zf := range a.zr.File {
+			if zf.FileInfo().IsDir() {
+				continue
+			}
+			zf := zf
+			if f(zf.Name, func() (io.ReadCloser, int64, error) { return a.open(zf) }) {
+				break
+			}
+		}
+		return nil
+	}
+	for {
+		h, err := nextHeader(a.br)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		body, err := h.body(a.br)
+		if err != nil {
+			return err
+		}
+		if h.name[len(h.name)-1] != '/' {
+			opened := false
+			stop := f(h.name, func() (io.ReadCloser, int64, error) {
+				if opened {
+					return nil, 0, errReopen
+				}
+				opened = true
+				return &compressedFile{ioutil.NopCloser(body), a.c}, h.size, nil
+			})
+			if stop {
+				return nil
+			}
+		}
+		// Read the rest of the file to get to the next one.
+		if _, err := io.Copy(ioutil.Discard, body); err != nil {
+			return err
+		}
+		if h.descriptor {
+			if err := skipDescriptor(a.br); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// open opens f, a file in a.zr. Stored files can also seek.
+func (a *archive) open(f *zip.File) (io.ReadCloser, int64, error) {
+	sz := int64(f.UncompressedSize64)
+	if f.Method == zip.Store {
+		off, err := f.DataOffset()
+		if err != nil {
+			return nil, 0, err
+		}
+		return &storedFile{io.NewSectionReader(a.ra, off, sz), a.c}, sz, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, 0, err
+	}
+	return &compressedFile{rc, a.c}, sz, nil
+}
+
+func Read(rf codec.Reader) (codec.Songs, error) {
+	a, err := open(rf)
+	if err != nil {
+		return nil, err
+	}
+	defer a.r.Close()
+	songs := make(codec.Songs)
+	err = a.each(func(name string, r codec.Reader) bool {
+		// While listing, files are read from the open archive. Later reads,
+		// and second reads of an archive read in order, open it again.
+		listing := true
+		ss, _, _ := codec.ByExtension(name, func() (io.ReadCloser, int64, error) {
+			if listing {
+				rc, sz, err := r()
+				if err != errReopen {
+					return rc, sz, err
+				}
+			}
+			return member(rf, name)()
+		})
+		listing = false
+		for v, s := range ss {
+			songs[codec.NewID(name, string(v))] = s
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return songs, nil
+}
+
+func Get(rf codec.Reader, id codec.ID) (codec.Song, error) {
+	top, child := id.Pop()
+	return codec.ByExtensionID(top, child, member(rf, top))
+}
+
+// member returns a Reader for the file named name in the zip file in rf.
+// Each read opens the zip file once and decompresses the file as it is read.
+// Stored files in zip files that can be read at random can also seek.
+func member(rf codec.Reader, name string) codec.Reader {
+	return func() (io.ReadCloser, int64, error) {
+		a, err := open(rf)
+		if err != nil {
+			return nil, 0, err
+		}
+		a.c = a.r
+		var (
+			rc   io.ReadCloser
+			sz   int64
+			ferr error
+		)
+		err = a.each(func(n string, r codec.Reader) bool {
+			if n != name {
+				return false
+			}
+			rc, sz, ferr = r()
+			return true
+		})
+		if err == nil {
+			err = ferr
+		}
+		if err == nil && rc == nil {
+			err = fmt.Errorf("zip: %v unfound", name)
+		}
+		if err != nil {
+			a.r.Close()
+			return nil, 0, err
+		}
+		return rc, sz, nil
+	}
+}
+
+// storedFile is an uncompressed file in a zip file.
+type storedFile struct {
+	*io.SectionReader
+	archive io.Closer
+}
+
+func (f *storedFile) Close() error {
+	return f.archive.Close()
+}
+
+// compressedFile is a compressed file in a zip file.
+type compressedFile struct {
+	io.ReadCloser
+	archive io.Closer
+}
+
+func (f *compressedFile) Close() error {
+	f.ReadCloser.Close()
+	return f.archive.Close()
+}
+
+const (
+	fileHeaderSignature      = 0x04034b50
+	directoryHeaderSignature = 0x02014b50
+	directoryEndSignature    = 0x06054b50
+	dataDescriptorSignature  = 0x08074b50
+	fileHeaderLen            = 30
+	zip64ExtraID             = 0x0001
+)
+
+// header is the local header of a file in a zip file read in order.
+type header struct {
+	name   string
+	method uint16
+	// csize and size are the compressed and uncompressed sizes. If
+	// descriptor is set, they are unknown and follow the file instead.
+	csize, size int64
+	descriptor  bool
+}
+
+// nextHeader reads the header of the next file from r. It returns io.EOF at
+// the zip directory, which follows the files.
+func nextHeader(r *bufio.Reader) (*header, error) {
+	b := make([]byte, fileHeaderLen)
+	if _, err := io.ReadFull(r, b[:4]); err != nil {
+		return nil, err
+	}
+	switch binary.LittleEndian.Uint32(b) {
+	case fileHeaderSignature:
+	case directoryHeaderSignature, directoryEndSignature:
+		return nil, io.EOF
+	default:
+		return nil, zip.ErrFormat
+	}
+	if _, err := io.ReadFull(r, b[4:]); err != nil {
+		return nil, unexpected(err)
+	}
+	flags := binary.LittleEndian.Uint16(b[6:])
+	h := &header{
+		method:     binary.LittleEndian.Uint16(b[8:]),
+		csize:      int64(binary.LittleEndian.Uint32(b[18:])),
+		size:       int64(binary.LittleEndian.Uint32(b[22:])),
+		descriptor: flags&0x8 != 0,
+	}
+	n := int(binary.LittleEndian.Uint16(b[26:]))
+	b = make([]byte, n+int(binary.LittleEndian.Uint16(b[28:])))
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, unexpected(err)
+	}
+	if n == 0 {
+		return nil, zip.ErrFormat
+	}
+	h.name = string(b[:n])
+	if flags&0x1 != 0 {
+		return nil, fmt.Errorf("zip: %v is encrypted", h.name)
+	}
+	if h.descriptor {
+		h.csize, h.size = 0, 0
+	} else if h.csize == 0xffffffff || h.size == 0xffffffff {
+		// Zip64 sizes are in an extra field.
+		for e := b[n:]; len(e) >= 4; {
+			id, sz := binary.LittleEndian.Uint16(e), int(binary.LittleEndian.Uint16(e[2:]))
+			e = e[4:]
+			if sz > len(e) {
+				break
+			}
+			if id == zip64ExtraID && sz >= 16 {
+				h.size = int64(binary.LittleEndian.Uint64(e))
+				h.csize = int64(binary.LittleEndian.Uint64(e[8:]))
+			}
+			e = e[sz:]
+		}
+	}
+	return h, nil
+}
+
+// body returns a reader of the uncompressed file from r, which must be just
+// after h.
+func (h *header) body(r *bufio.Reader) (io.Reader, error) {
+	var cr io.Reader = r
+	if !h.descriptor {
+		cr = io.LimitReader(r, h.csize)
+	}
+	switch h.method {
+	case zip.Store:
+		if h.descriptor {
+			return nil, fmt.Errorf("zip: %v is stored without a size", h.name)
+		}
+		return cr, nil
+	case zip.Deflate:
+		// r reads bytes one at a time, so the decompressor stops at the end of
+		// the file.
+		return flate.NewReader(cr), nil
+	}
+	return nil, zip.ErrAlgorithm
+}
+
+// skipDescriptor skips the data descriptor after a file with an unknown size.
+func skipDescriptor(r *bufio.Reader) error {
+	b, err := r.Peek(4)
+	if err != nil {
+		return unexpected(err)
+	}
+	if binary.LittleEndian.Uint32(b) == dataDescriptorSignature {
+		r.Discard(4)
+	}
+	// The checksum and sizes, which are 8 bytes each instead of 4 in zip64
+	// files.
+	if _, err := r.Discard(12); err != nil {
+		return unexpected(err)
+	}
+	if b, err := r.Peek(4); err == nil {
+		switch binary.LittleEndian.Uint32(b) {
+		case fileHeaderSignature, directoryHeaderSignature, directoryEndSignature:
+		default:
+			r.Discard(8)
+		}
+	}
+	return nil
+}
+
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
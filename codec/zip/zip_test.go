@@ -0,0 +1,182 @@
+package zip
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mjibson/moggio/codec"
+)
+
+func init() {
+	codec.RegisterCodec("zip test", nil, []string{"zt"}, decodeTest, nil)
+}
+
+// testSong is a song of a file with the zt extension. It holds the file's
+// contents when it was listed.
+type testSong struct {
+	codec.Song
+	rf   codec.Reader
+	data string
+}
+
+func decodeTest(rf codec.Reader) (codec.Songs, error) {
+	r, _, err := rf()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return codec.Songs{codec.None: &testSong{rf: rf, data: string(b)}}, nil
+}
+
+// testZip returns a zip file with stored and compressed files, with and
+// without sizes in their headers.
+func testZip(t *testing.T) []byte {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	raw := func(name string, method uint16, data string) {
+		b := []byte(data)
+		if method == zip.Deflate {
+			c := new(bytes.Buffer)
+			fw, _ := flate.NewWriter(c, flate.BestCompression)
+			fw.Write(b)
+			fw.Close()
+			b = c.Bytes()
+		}
+		f, err := w.CreateRaw(&zip.FileHeader{
+			Name:               name,
+			Method:             method,
+			CRC32:              crc32.ChecksumIEEE([]byte(data)),
+			CompressedSize64:   uint64(len(b)),
+			UncompressedSize64: uint64(len(data)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write(b)
+	}
+	raw("a.zt", zip.Store, "stored")
+	if _, err := w.Create("d/"); err != nil {
+		t.Fatal(err)
+	}
+	// Create writes the sizes after the file.
+	f, err := w.Create("d/b.zt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write(bytes.Repeat([]byte("compressed "), 100))
+	raw("c.txt", zip.Deflate, "not a song")
+	raw("e.zt", zip.Deflate, "compressed with a size")
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// readCloser is a bytes.Reader with a Close method.
+type readCloser struct {
+	*bytes.Reader
+}
+
+func (readCloser) Close() error { return nil }
+
+func TestZip(t *testing.T) {
+	b := testZip(t)
+	want := map[codec.ID]string{
+		codec.NewID("a.zt", ""):   "stored",
+		codec.NewID("d/b.zt", ""): string(bytes.Repeat([]byte("compressed "), 100)),
+		codec.NewID("e.zt", ""):   "compressed with a size",
+	}
+	tests := []struct {
+		name string
+		open func() io.ReadCloser
+	}{
+		{"random", func() io.ReadCloser { return readCloser{bytes.NewReader(b)} }},
+		// Without ReadAt, the zip file is read in order.
+		{"in order", func() io.ReadCloser { return ioutil.NopCloser(bytes.NewBuffer(b)) }},
+	}
+	for _, test := range tests {
+		opens := 0
+		rf := func() (io.ReadCloser, int64, error) {
+			opens++
+			return test.open(), int64(len(b)), nil
+		}
+		songs, err := Read(rf)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if opens != 1 {
+			t.Errorf("%s: listing opened the zip file %d times", test.name, opens)
+		}
+		if len(songs) != len(want) {
+			t.Errorf("%s: got %d songs, want %d", test.name, len(songs), len(want))
+		}
+		for id, data := range want {
+			s, ok := songs[id]
+			if !ok {
+				t.Errorf("%s: %v missing", test.name, id)
+				continue
+			}
+			ts := s.(*testSong)
+			if ts.data != data {
+				t.Errorf("%s: %v: listed %q, want %q", test.name, id, ts.data, data)
+			}
+
+			// After listing, each read opens the zip file again.
+			opens = 0
+			r, sz, err := ts.rf()
+			if err != nil {
+				t.Fatalf("%s: %v: %v", test.name, id, err)
+			}
+			got, err := ioutil.ReadAll(r)
+			r.Close()
+			if err != nil || string(got) != data || opens != 1 {
+				t.Errorf("%s: %v: read %q, %v with %d opens, want %q", test.name, id, got, err, opens, data)
+			}
+			if sz != 0 && sz != int64(len(data)) {
+				t.Errorf("%s: %v: got size %d, want %d", test.name, id, sz, len(data))
+			}
+			if _, seeks := r.(io.Seeker); seeks != (test.name == "random" && id.Top() == "a.zt") {
+				t.Errorf("%s: %v: got seeking %v", test.name, id, seeks)
+			}
+
+			s, err = Get(rf, id)
+			if err != nil {
+				t.Fatalf("%s: get %v: %v", test.name, id, err)
+			}
+			if got := s.(*testSong).data; got != data {
+				t.Errorf("%s: get %v: got %q, want %q", test.name, id, got, data)
+			}
+		}
+		if _, err := Get(rf, codec.NewID("nothing.zt", "")); err == nil {
+			t.Errorf("%s: got a missing file", test.name)
+		}
+	}
+}
+
+func TestZipInvalid(t *testing.T) {
+	b := testZip(t)
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{"not a zip file", []byte("not a zip file")},
+		{"truncated", b[:50]},
+	}
+	for _, test := range tests {
+		rf := func() (io.ReadCloser, int64, error) {
+			return ioutil.NopCloser(bytes.NewBuffer(test.b)), int64(len(test.b)), nil
+		}
+		if _, err := Read(rf); err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+}
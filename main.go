@@ -11,39 +11,86 @@ import (
 	"time"
 
 	"github.com/facebookgo/httpcontrol"
+	"github.com/mjibson/moggio/output"
 	"github.com/mjibson/moggio/server"
 
 	// codecs
+	_ "github.com/mjibson/moggio/codec/aac"
+	_ "github.com/mjibson/moggio/codec/cue"
+	codecexec "github.com/mjibson/moggio/codec/exec"
 	_ "github.com/mjibson/moggio/codec/flac"
 	_ "github.com/mjibson/moggio/codec/gme"
 	_ "github.com/mjibson/moggio/codec/mpa"
 	_ "github.com/mjibson/moggio/codec/nsf"
+	_ "github.com/mjibson/moggio/codec/opus"
 	_ "github.com/mjibson/moggio/codec/rar"
 	_ "github.com/mjibson/moggio/codec/vorbis"
 	_ "github.com/mjibson/moggio/codec/wav"
+	_ "github.com/mjibson/moggio/codec/zip"
 
 	// protocols
 	_ "github.com/mjibson/moggio/protocol/bandcamp"
 	"github.com/mjibson/moggio/protocol/drive"
 	"github.com/mjibson/moggio/protocol/dropbox"
-	_ "github.com/mjibson/moggio/protocol/file"
+	"github.com/mjibson/moggio/protocol/file"
 	_ "github.com/mjibson/moggio/protocol/gmusic"
 	"github.com/mjibson/moggio/protocol/soundcloud"
 	_ "github.com/mjibson/moggio/protocol/stream"
 )
 
 var (
-	flagAddr       = flag.String("addr", ":6601", "listen address")
+	flagAddr       = flag.String("addr", ":6601", "listen address, or unix: and the path of a Unix socket")
+	flagTLSCert    = flag.String("tls-cert", "", "PEM certificate file to serve HTTPS with; needs -tls-key")
+	flagTLSKey     = flag.String("tls-key", "", "PEM key file of -tls-cert")
+	flagSelfSigned = flag.Bool("tls-self-signed", false, "serve HTTPS with a self-signed certificate kept in the state file, if -tls-cert is not set")
+	flagURL        = flag.String("url", "", "external URL of the server, like https://example.com/music, used for OAuth redirects; default made from -addr, and required with a Unix socket")
+	flagPrefix     = flag.String("prefix", "", "URL path to serve under, like /music, when behind a reverse proxy that passes it through")
 	flagDrive      = flag.String("drive", "792434736327-0pup5skbua0gbfld4min3nfv2reairte.apps.googleusercontent.com:OsN_bydWG45resaU0PPiDmtK", "Google Drive API credentials of the form ClientID:ClientSecret")
 	flagDropbox    = flag.String("dropbox", "rnhpqsbed2q2ezn:ldref688unj74ld", "Dropbox API credentials of the form ClientID:ClientSecret")
 	flagSoundcloud = flag.String("soundcloud", "ec28c2226a0838d01edc6ed0014e462e:a115e94029d698f541960c8dc8560978", "SoundCloud API credentials of the form ClientID:ClientSecret")
 	flagDev        = flag.Bool("dev", false, "enable dev mode")
+	flagOutput     = flag.String("output", "default", `comma-separated audio outputs: "default" for the system device, "null" to discard, "stream" to serve at /stream, or "file:<path>" to record (WAV if path ends in .wav, else raw float32 PCM)`)
+	flagRate       = flag.Int("rate", 44100, "output sample rate; songs are converted to it")
+	flagChannels   = flag.Int("channels", 2, "output channel count; songs are mixed up or down to it")
+	flagR128       = flag.Bool("r128", false, "measure EBU R128 loudness of local files without ReplayGain tags (slow)")
+	flagWatch      = flag.Bool("watch", false, "watch local directories and update the library when files change (linux only)")
+	flagMPD        = flag.String("mpd", "", "TCP address to serve the MPD protocol on, like :6600; empty to disable")
+	flagExec       = flag.String("exec", strings.Join(codecexec.Extensions, ","), "comma-separated extensions to decode with an external decoder, if found; empty to disable")
+	flagExecDecode = flag.String("exec-decode", strings.Join(codecexec.Decode, " "), "external decoder command, which converts standard input to f32le PCM on standard output")
+	flagExecProbe  = flag.String("exec-probe", strings.Join(codecexec.Probe, " "), "external probe command, which writes ffprobe JSON for standard input")
 	//flagCentral = flag.String("central", "https://moggio-music-client.appspot.com", "Central Moggio data server; empty to disable")
 	stateFile = flag.String("state", "", "specify non-default statefile location")
 )
 
 func main() {
 	flag.Parse()
+	if err := output.Init(*flagOutput, *flagRate, *flagChannels); err != nil {
+		log.Fatal(err)
+	}
+	file.R128 = *flagR128
+	file.WatchFiles = *flagWatch
+	server.MPDAddr = *flagMPD
+	if (*flagTLSCert == "") != (*flagTLSKey == "") {
+		log.Fatal("-tls-cert and -tls-key must be set together")
+	}
+	server.TLSCert = *flagTLSCert
+	server.TLSKey = *flagTLSKey
+	server.TLSSelfSigned = *flagSelfSigned
+	if strings.HasPrefix(*flagAddr, "unix:") && *flagURL == "" {
+		log.Fatal("-url must be set when -addr is a Unix socket")
+	}
+	server.BaseURL = *flagURL
+	if p := strings.Trim(*flagPrefix, "/"); p != "" {
+		server.PathPrefix = "/" + p
+	}
+	if *flagExec != "" {
+		codecexec.Extensions = strings.Split(*flagExec, ",")
+		codecexec.Decode = strings.Fields(*flagExecDecode)
+		codecexec.Probe = strings.Fields(*flagExecProbe)
+		if err := codecexec.Init(); err != nil {
+			log.Println("external decoder disabled:", err)
+		}
+	}
 	http.DefaultClient = &http.Client{
 		Transport: &httpcontrol.Transport{
 			ResponseHeaderTimeout: time.Second * 3,
@@ -51,11 +98,7 @@ func main() {
 			RetryAfterTimeout:     true,
 		},
 	}
-	redir := *flagAddr
-	if strings.HasPrefix(redir, ":") {
-		redir = "localhost" + redir
-	}
-	redir = "http://" + redir + "/api/oauth/"
+	redir := server.ExternalURL(*flagAddr) + "/api/oauth/"
 	if *flagDrive != "" {
 		sp := strings.Split(*flagDrive, ":")
 		if len(sp) != 2 {
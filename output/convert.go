@@ -0,0 +1,70 @@
+package output
+
+import "fmt"
+
+// Converter converts interleaved samples between sample rates and channel
+// counts. Rates are converted by linear interpolation. Mono input is copied to
+// all output channels, mono output is the average of the input channels, and
+// otherwise extra input channels are dropped and extra output channels repeat
+// the input ones.
+type Converter struct {
+	fromCh, toCh int
+	step, pos    float64
+	prev, cur    []float32
+}
+
+// NewConverter returns a Converter between two formats, which must have
+// positive rates and channel counts.
+func NewConverter(fromRate, fromChannels, toRate, toChannels int) (*Converter, error) {
+	if fromRate <= 0 || fromChannels <= 0 || toRate <= 0 || toChannels <= 0 {
+		return nil, fmt.Errorf("output: cannot convert from %v Hz, %v channels to %v Hz, %v channels", fromRate, fromChannels, toRate, toChannels)
+	}
+	return &Converter{
+		fromCh: fromChannels,
+		toCh:   toChannels,
+		step:   float64(fromRate) / float64(toRate),
+		prev:   make([]float32, toChannels),
+		cur:    make([]float32, toChannels),
+	}, nil
+}
+
+// Ratio returns the number of input frames consumed per output frame.
+func (c *Converter) Ratio() float64 {
+	return c.step
+}
+
+// Convert returns samples in the output format. Any partial frame at the end
+// of samples is ignored. Output lags input by one frame, which is held until
+// the next call.
+func (c *Converter) Convert(samples []float32) []float32 {
+	var out []float32
+	for i := 0; i+c.fromCh <= len(samples); i += c.fromCh {
+		frame := samples[i : i+c.fromCh]
+		switch {
+		case c.fromCh == c.toCh:
+			copy(c.cur, frame)
+		case c.fromCh == 1:
+			for j := range c.cur {
+				c.cur[j] = frame[0]
+			}
+		case c.toCh == 1:
+			var sum float32
+			for _, s := range frame {
+				sum += s
+			}
+			c.cur[0] = sum / float32(c.fromCh)
+		default:
+			for j := range c.cur {
+				c.cur[j] = frame[j%c.fromCh]
+			}
+		}
+		for ; c.pos < 1; c.pos += c.step {
+			for j, s := range c.cur {
+				out = append(out, c.prev[j]+(s-c.prev[j])*float32(c.pos))
+			}
+		}
+		c.pos--
+		copy(c.prev, c.cur)
+	}
+	return out
+}
@@ -0,0 +1,112 @@
+package output
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewConverterFormat(t *testing.T) {
+	tests := []struct {
+		fromRate, fromCh, toRate, toCh int
+		ok                             bool
+	}{
+		{44100, 2, 48000, 2, true},
+		{8000, 1, 44100, 6, true},
+		{0, 2, 44100, 2, false},
+		{44100, 0, 44100, 2, false},
+		{44100, 2, 0, 2, false},
+		{44100, 2, 44100, -1, false},
+	}
+	for _, test := range tests {
+		c, err := NewConverter(test.fromRate, test.fromCh, test.toRate, test.toCh)
+		if ok := err == nil; ok != test.ok {
+			t.Errorf("%+v: got error %v", test, err)
+		} else if ok && c == nil {
+			t.Errorf("%+v: nil converter", test)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name                           string
+		fromRate, fromCh, toRate, toCh int
+		in                             []float32
+		want                           []float32
+	}{
+		{
+			// Output lags by one frame.
+			name:     "same",
+			fromRate: 100, fromCh: 2, toRate: 100, toCh: 2,
+			in:   []float32{1, 2, 3, 4},
+			want: []float32{0, 0, 1, 2},
+		},
+		{
+			name:     "mono to stereo",
+			fromRate: 100, fromCh: 1, toRate: 100, toCh: 2,
+			in:   []float32{1, 2, 3},
+			want: []float32{0, 0, 1, 1, 2, 2},
+		},
+		{
+			name:     "stereo to mono",
+			fromRate: 100, fromCh: 2, toRate: 100, toCh: 1,
+			in:   []float32{1, 3, 2, 4, 5, 5},
+			want: []float32{0, 2, 3},
+		},
+		{
+			name:     "drop channels",
+			fromRate: 100, fromCh: 3, toRate: 100, toCh: 2,
+			in:   []float32{1, 2, 3, 4, 5, 6},
+			want: []float32{0, 0, 1, 2},
+		},
+		{
+			name:     "upsample",
+			fromRate: 100, fromCh: 1, toRate: 200, toCh: 1,
+			in:   []float32{2, 4, 6},
+			want: []float32{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name:     "downsample",
+			fromRate: 200, fromCh: 1, toRate: 100, toCh: 1,
+			in:   []float32{2, 4, 6, 8, 10, 12},
+			want: []float32{0, 4, 8},
+		},
+		{
+			name:     "partial frame",
+			fromRate: 100, fromCh: 2, toRate: 100, toCh: 2,
+			in:   []float32{1, 2, 3},
+			want: []float32{0, 0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := NewConverter(test.fromRate, test.fromCh, test.toRate, test.toCh)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := c.Convert(test.in)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestConvertSplit(t *testing.T) {
+	// Converting in pieces gives the same result as all at once.
+	in := []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	whole, _ := NewConverter(44100, 2, 48000, 1)
+	want := whole.Convert(in)
+	split, _ := NewConverter(44100, 2, 48000, 1)
+	var got []float32
+	for i := 0; i < len(in); i += 4 {
+		end := i + 4
+		if end > len(in) {
+			end = len(in)
+		}
+		got = append(got, split.Convert(in[i:end])...)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
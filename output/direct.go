@@ -12,6 +12,8 @@ import (
 var (
 	kernel32               = syscall.MustLoadDLL("kernel32")
 	CreateEvent            = kernel32.MustFindProc("CreateEventW")
+	SetEvent               = kernel32.MustFindProc("SetEvent")
+	CloseHandle            = kernel32.MustFindProc("CloseHandle")
 	WaitForMultipleObjects = kernel32.MustFindProc("WaitForMultipleObjects")
 
 	user32           = syscall.MustLoadDLL("user32")
@@ -32,6 +34,8 @@ const (
 type output struct {
 	ch      chan float32
 	stopped bool
+	// quit is signaled by Close to end start.
+	quit syscall.Handle
 
 	ds          *dsound.IDirectSound
 	sr, chans   int
@@ -90,6 +94,9 @@ func get(sampleRate, channels int) (Output, error) {
 		panic(err)
 	}
 
+	h, _, _ := CreateEvent.Call(0, 0, 0, 0)
+	o.quit = syscall.Handle(h)
+
 	go o.start()
 	return &o, nil
 }
@@ -109,6 +116,14 @@ func (o *output) start() {
 		notifies[i].Offset = uint32(i) * o.blockSize
 		events = append(events, syscall.Handle(h))
 	}
+	defer func() {
+		for _, h := range events {
+			CloseHandle.Call(uintptr(h))
+		}
+		o.buf2.Release()
+		o.ds.Release()
+	}()
+	events = append(events, o.quit)
 
 	notif, err := o.buf2.QueryInterfaceIDirectSoundNotify()
 	if err != nil {
@@ -131,6 +146,9 @@ func (o *output) start() {
 			0xFFFFFFFF,
 		)
 		switch {
+		case r == WAIT_OBJECT_0+numBlock:
+			return
+
 		case WAIT_OBJECT_0 <= r && r < WAIT_OBJECT_0+uintptr(len(events)):
 			idx := int(r - WAIT_OBJECT_0)
 			blockPos := (idx - 1 + numBlock) % numBlock
@@ -188,3 +206,7 @@ func (o *output) play() {
 		panic(err)
 	}
 }
+
+func (o *output) Close() {
+	SetEvent.Call(uintptr(o.quit))
+}
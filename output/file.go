@@ -0,0 +1,118 @@
+package output
+
+import (
+	"bytes"
+	"encoding/binary"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	wavHeaderSize = 46
+	waveFloat     = 3
+)
+
+// file records samples to disk. WAV files have their header sizes updated
+// after each write so that the recording is valid even if moggio never exits
+// cleanly.
+type file struct {
+	clock
+	f    *os.File
+	wav  bool
+	size uint32
+}
+
+func newFile(path string, sampleRate, channels int) (Output, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	o := &file{
+		clock: clock{perSec: int64(sampleRate * channels)},
+		f:     f,
+		wav:   strings.EqualFold(filepath.Ext(path), ".wav"),
+	}
+	if o.wav {
+		if err := o.writeHeader(sampleRate, channels); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	log.Println("output: recording to", path)
+	return o, nil
+}
+
+func (o *file) writeHeader(sampleRate, channels int) error {
+	const bits = 32
+	blockAlign := channels * bits / 8
+	h := []interface{}{
+		[]byte("RIFF"),
+		uint32(wavHeaderSize - 8),
+		[]byte("WAVE"),
+		[]byte("fmt "),
+		uint32(18),
+		uint16(waveFloat),
+		uint16(channels),
+		uint32(sampleRate),
+		uint32(sampleRate * blockAlign),
+		uint16(blockAlign),
+		uint16(bits),
+		uint16(0),
+		[]byte("data"),
+		uint32(0),
+	}
+	buf := new(bytes.Buffer)
+	for _, v := range h {
+		if err := binary.Write(buf, binary.LittleEndian, v); err != nil {
+			return err
+		}
+	}
+	_, err := o.f.Write(buf.Bytes())
+	return err
+}
+
+// updateSizes rewrites the RIFF and data chunk sizes in the WAV header.
+func (o *file) updateSizes() error {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, wavHeaderSize-8+o.size)
+	if _, err := o.f.WriteAt(b, 4); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b, o.size)
+	_, err := o.f.WriteAt(b, wavHeaderSize-4)
+	return err
+}
+
+func (o *file) Push(samples []float32) {
+	buf := new(bytes.Buffer)
+	for _, s := range samples {
+		_ = binary.Write(buf, binary.LittleEndian, s)
+	}
+	n, err := o.f.Write(buf.Bytes())
+	o.size += uint32(n)
+	if err == nil && o.wav {
+		err = o.updateSizes()
+	}
+	if err != nil {
+		log.Println(err)
+	}
+	o.wait(len(samples))
+}
+
+func (o *file) Start() {
+	o.reset()
+}
+
+func (o *file) Stop() {
+	if err := o.f.Sync(); err != nil {
+		log.Println(err)
+	}
+}
+
+func (o *file) Close() {
+	if err := o.f.Close(); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,85 @@
+package output
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// check checks that the file at path holds samples, after a WAV header
+	// with their size if wav is set.
+	check := func(name, path string, wav bool, samples []float32) {
+		t.Helper()
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := b
+		if wav {
+			size := uint32(len(samples) * 4)
+			if len(b) < wavHeaderSize {
+				t.Fatalf("%s: got %d bytes", name, len(b))
+			}
+			h, le := b[:wavHeaderSize], binary.LittleEndian
+			if string(h[:4]) != "RIFF" || string(h[8:16]) != "WAVEfmt " || string(h[38:42]) != "data" {
+				t.Fatalf("%s: bad header %q", name, h)
+			}
+			if got := le.Uint32(h[4:]); got != wavHeaderSize-8+size {
+				t.Errorf("%s: got RIFF size %d, want %d", name, got, wavHeaderSize-8+size)
+			}
+			if got := le.Uint32(h[42:]); got != size {
+				t.Errorf("%s: got data size %d, want %d", name, got, size)
+			}
+			if f, ch, rate, bits := le.Uint16(h[20:]), le.Uint16(h[22:]), le.Uint32(h[24:]), le.Uint16(h[34:]); f != waveFloat || ch != 2 || rate != 1000 || bits != 32 {
+				t.Errorf("%s: got format %d, %d channels, %d Hz, %d bits", name, f, ch, rate, bits)
+			}
+			data = b[wavHeaderSize:]
+		}
+		want := new(bytes.Buffer)
+		binary.Write(want, binary.LittleEndian, samples)
+		if !bytes.Equal(data, want.Bytes()) {
+			t.Errorf("%s: got data %x, want %x", name, data, want.Bytes())
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		wav  bool
+	}{
+		{"a.wav", true},
+		{"b.WAV", true},
+		{"c.raw", false},
+	} {
+		path := filepath.Join(dir, test.name)
+		o, err := newFile(path, 1000, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(test.name+" empty", path, test.wav, nil)
+		o.Start()
+		samples := []float32{0, 0.5, -1, float32(math.Inf(1))}
+		o.Push(samples[:2])
+		o.Push(samples[2:])
+		o.Stop()
+		check(test.name+" stopped", path, test.wav, samples)
+		o.Start()
+		o.Push([]float32{0.25, 0.25})
+		o.Close()
+		check(test.name+" closed", path, test.wav, append(samples, 0.25, 0.25))
+	}
+
+	if _, err := newFile(filepath.Join(dir, "none", "d.wav"), 1000, 2); err == nil {
+		t.Error("created a file in a missing directory")
+	}
+}
@@ -0,0 +1,247 @@
+package output
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SinkInfo describes a sink of a Multi.
+type SinkInfo struct {
+	Name   string
+	Volume float64
+	Mute   bool
+}
+
+// chunk is work for a sink's goroutine: samples to push, or a start or stop
+// of its output.
+type chunk struct {
+	out         Output
+	samples     []float32
+	start, stop bool
+}
+
+type sink struct {
+	SinkInfo
+	out    Output
+	ch     chan chunk
+	done   chan struct{}
+	exited chan struct{}
+}
+
+// run pushes chunks to the sink's output. Each sink has its own goroutine so
+// that sinks that block to pace themselves do not add up, and so that its
+// output is only ever used by one goroutine.
+func (s *sink) run() {
+	defer close(s.exited)
+	for {
+		select {
+		case c := <-s.ch:
+			switch {
+			case c.start:
+				c.out.Start()
+			case c.stop:
+				c.out.Stop()
+			default:
+				c.out.Push(c.samples)
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// close stops the sink's goroutine, waiting for any Push in progress to
+// finish, and then stops and closes its output.
+func (s *sink) close() {
+	close(s.done)
+	<-s.exited
+	s.out.Stop()
+	s.out.Close()
+}
+
+// Multi is an Output that plays to any number of sinks, each with its own
+// volume and mute. All sinks are opened once with the same sample format. Its
+// methods are safe for concurrent use. With no sinks, Push paces itself like
+// a null sink so that playback continues in real time.
+type Multi struct {
+	mu    sync.Mutex
+	sinks []*sink
+	c     config
+	// clock is only used by Push; restart tells it to reset the clock.
+	clock   clock
+	restart bool
+}
+
+func NewMulti(sampleRate, channels int) *Multi {
+	return &Multi{
+		c:     config{sampleRate, channels},
+		clock: clock{perSec: int64(sampleRate * channels)},
+	}
+}
+
+// Format returns the sample format of the sinks.
+func (m *Multi) Format() (sampleRate, channels int) {
+	return m.c.sr, m.c.ch
+}
+
+func (m *Multi) find(name string) (int, *sink) {
+	for i, s := range m.sinks {
+		if s.Name == name {
+			return i, s
+		}
+	}
+	return -1, nil
+}
+
+// Add adds the sink described by spec (see Parse) at full volume.
+func (m *Multi) Add(spec string) error {
+	open, err := Parse(spec)
+	if err != nil {
+		return err
+	}
+	return m.add(spec, open)
+}
+
+// add opens a sink with open and adds it as name.
+func (m *Multi) add(name string, open Backend) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, s := m.find(name); s != nil {
+		return fmt.Errorf("output: already have %s", name)
+	}
+	o, err := open(m.c.sr, m.c.ch)
+	if err != nil {
+		return fmt.Errorf("output %s: could not open audio (%v, %v): %v", name, m.c.sr, m.c.ch, err)
+	}
+	s := &sink{
+		SinkInfo: SinkInfo{
+			Name:   name,
+			Volume: 1,
+		},
+		out:    o,
+		ch:     make(chan chunk, 2),
+		done:   make(chan struct{}),
+		exited: make(chan struct{}),
+	}
+	m.sinks = append(m.sinks, s)
+	go s.run()
+	return nil
+}
+
+// Remove stops, closes and removes the named sink.
+func (m *Multi) Remove(name string) error {
+	m.mu.Lock()
+	i, s := m.find(name)
+	if s == nil {
+		m.mu.Unlock()
+		return fmt.Errorf("output: unknown sink: %s", name)
+	}
+	m.sinks = append(m.sinks[:i], m.sinks[i+1:]...)
+	m.mu.Unlock()
+	s.close()
+	return nil
+}
+
+// Set changes the volume, from 0 to 1, and mute state of the named sink.
+func (m *Multi) Set(name string, volume float64, mute bool) error {
+	if !(volume >= 0 && volume <= 1) {
+		return fmt.Errorf("output: volume must be between 0 and 1: %v", volume)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, s := m.find(name)
+	if s == nil {
+		return fmt.Errorf("output: unknown sink: %s", name)
+	}
+	s.Volume = volume
+	s.Mute = mute
+	return nil
+}
+
+// Sinks returns the current sinks in the order they were added.
+func (m *Multi) Sinks() []SinkInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r := make([]SinkInfo, len(m.sinks))
+	for i, s := range m.sinks {
+		r[i] = s.SinkInfo
+	}
+	return r
+}
+
+// send queues the chunk made by f for each sink, after those already queued,
+// and returns whether there were any sinks. f is called with m.mu held.
+func (m *Multi) send(f func(s *sink) chunk) bool {
+	m.mu.Lock()
+	sinks := make([]*sink, len(m.sinks))
+	chunks := make([]chunk, len(m.sinks))
+	for i, s := range m.sinks {
+		sinks[i] = s
+		chunks[i] = f(s)
+	}
+	m.mu.Unlock()
+	for i, s := range sinks {
+		select {
+		case s.ch <- chunks[i]:
+		case <-s.done:
+		}
+	}
+	return len(sinks) > 0
+}
+
+// Push sends samples to every sink. Muted sinks get silence so that they stay
+// in time with the others.
+func (m *Multi) Push(samples []float32) {
+	sent := m.send(func(s *sink) chunk {
+		gain := float32(s.Volume)
+		if s.Mute {
+			gain = 0
+		}
+		b := make([]float32, len(samples))
+		for i, v := range samples {
+			b[i] = v * gain
+		}
+		return chunk{out: s.out, samples: b}
+	})
+	if sent {
+		return
+	}
+	m.mu.Lock()
+	restart := m.restart
+	m.restart = false
+	m.mu.Unlock()
+	if restart {
+		m.clock.reset()
+	}
+	m.clock.wait(len(samples))
+}
+
+// Start starts every sink's output from its own goroutine, after any samples
+// already sent to it.
+func (m *Multi) Start() {
+	m.mu.Lock()
+	m.restart = true
+	m.mu.Unlock()
+	m.send(func(s *sink) chunk {
+		return chunk{out: s.out, start: true}
+	})
+}
+
+// Stop stops every sink's output from its own goroutine, after any samples
+// already sent to it.
+func (m *Multi) Stop() {
+	m.send(func(s *sink) chunk {
+		return chunk{out: s.out, stop: true}
+	})
+}
+
+// Close closes and removes all sinks.
+func (m *Multi) Close() {
+	m.mu.Lock()
+	sinks := m.sinks
+	m.sinks = nil
+	m.mu.Unlock()
+	for _, s := range sinks {
+		s.close()
+	}
+}
@@ -0,0 +1,174 @@
+package output
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testOutput sends a description of each call to ops. It reports calls from
+// more than one goroutine at a time as "concurrent".
+type testOutput struct {
+	ops  chan string
+	busy int32
+}
+
+func newTestOutput() *testOutput {
+	return &testOutput{ops: make(chan string, 100)}
+}
+
+func (o *testOutput) backend(sampleRate, channels int) (Output, error) {
+	return o, nil
+}
+
+func (o *testOutput) call(op string, d time.Duration) {
+	if atomic.AddInt32(&o.busy, 1) != 1 {
+		o.ops <- "concurrent"
+	}
+	time.Sleep(d)
+	atomic.AddInt32(&o.busy, -1)
+	o.ops <- op
+}
+
+func (o *testOutput) Push(samples []float32) {
+	o.call(fmt.Sprint("push ", samples), time.Millisecond)
+}
+
+func (o *testOutput) Start() { o.call("start", 0) }
+func (o *testOutput) Stop()  { o.call("stop", 0) }
+func (o *testOutput) Close() { o.call("close", 0) }
+
+// expect checks that the next calls to o are ops.
+func (o *testOutput) expect(t *testing.T, name string, ops ...string) {
+	t.Helper()
+	for _, want := range ops {
+		select {
+		case got := <-o.ops:
+			if got != want {
+				t.Fatalf("%s: got %q, want %q", name, got, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%s: timed out waiting for %q", name, want)
+		}
+	}
+}
+
+// idle checks that there are no more calls to o.
+func (o *testOutput) idle(t *testing.T, name string) {
+	t.Helper()
+	select {
+	case got := <-o.ops:
+		t.Fatalf("%s: got %q, want nothing", name, got)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestMulti(t *testing.T) {
+	m := NewMulti(1000, 1)
+	a, b := newTestOutput(), newTestOutput()
+	if err := m.add("a", a.backend); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.add("b", b.backend); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.add("a", b.backend); err == nil {
+		t.Fatal("added a twice")
+	}
+	if err := m.Add("nothing"); err == nil {
+		t.Fatal("added an unknown backend")
+	}
+
+	m.Start()
+	m.Push([]float32{1, 0.5})
+	a.expect(t, "a", "start", "push [1 0.5]")
+	b.expect(t, "b", "start", "push [1 0.5]")
+
+	if err := m.Set("a", 1, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Set("b", 0.5, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []float64{-0.1, 1.1} {
+		if err := m.Set("b", v, false); err == nil {
+			t.Fatalf("set volume %v", v)
+		}
+	}
+	if err := m.Set("c", 1, false); err == nil {
+		t.Fatal("set an unknown sink")
+	}
+	want := []SinkInfo{{"a", 1, true}, {"b", 0.5, false}}
+	if got := m.Sinks(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("got sinks %v, want %v", got, want)
+	}
+	m.Push([]float32{1, 0.5})
+	a.expect(t, "a", "push [0 0]")
+	b.expect(t, "b", "push [0.5 0.25]")
+
+	// Starts and stops from another goroutine than the one pushing are run
+	// in turn with the pushes.
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			m.Push([]float32{1})
+		}
+		close(done)
+	}()
+	for i := 0; i < 5; i++ {
+		m.Stop()
+		m.Start()
+	}
+	<-done
+	for name, o := range map[string]*testOutput{"a": a, "b": b} {
+		counts := make(map[string]int)
+		for counts["push [0]"]+counts["push [0.5]"] < 10 || counts["stop"] < 5 || counts["start"] < 5 {
+			select {
+			case op := <-o.ops:
+				counts[op]++
+			case <-time.After(time.Second):
+				t.Fatalf("%s: timed out with %v", name, counts)
+			}
+			if counts["concurrent"] > 0 {
+				t.Fatalf("%s: concurrent calls", name)
+			}
+		}
+		o.idle(t, name)
+	}
+
+	if err := m.Remove("b"); err != nil {
+		t.Fatal(err)
+	}
+	b.expect(t, "b", "stop", "close")
+	if err := m.Remove("b"); err == nil {
+		t.Fatal("removed b twice")
+	}
+	m.Push([]float32{0.25})
+	a.expect(t, "a", "push [0]")
+	b.idle(t, "b")
+	if err := m.Set("a", 1, false); err != nil {
+		t.Fatal(err)
+	}
+	m.Push([]float32{0.25})
+	a.expect(t, "a", "push [0.25]")
+
+	m.Close()
+	a.expect(t, "a", "stop", "close")
+	if s := m.Sinks(); len(s) != 0 {
+		t.Fatalf("got sinks %v after close", s)
+	}
+}
+
+func TestMultiNoSinks(t *testing.T) {
+	// Without sinks, Push keeps time.
+	m := NewMulti(1000, 2)
+	m.Start()
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		m.Push(make([]float32, 20))
+	}
+	if d := time.Since(start); d < 40*time.Millisecond || d > time.Second {
+		t.Fatalf("5 pushes of 10ms took %v", d)
+	}
+}
@@ -0,0 +1,60 @@
+package output
+
+import "time"
+
+// clock paces outputs that are not driven by an audio device so that samples
+// are consumed in real time.
+type clock struct {
+	perSec  int64
+	start   time.Time
+	samples int64
+}
+
+// wait blocks until the n samples just pushed would have finished playing.
+func (c *clock) wait(n int) {
+	now := time.Now()
+	if c.start.IsZero() {
+		c.start = now
+	}
+	c.samples += int64(n)
+	d := c.start.Add(time.Duration(c.samples) * time.Second / time.Duration(c.perSec)).Sub(now)
+	if d < -time.Second {
+		// We fell far behind (probably paused), so start over instead of
+		// rushing to catch up.
+		c.start = now
+		c.samples = int64(n)
+		d = time.Duration(c.samples) * time.Second / time.Duration(c.perSec)
+	}
+	if d > 0 {
+		time.Sleep(d)
+	}
+}
+
+func (c *clock) reset() {
+	c.start = time.Time{}
+	c.samples = 0
+}
+
+type null struct {
+	clock
+}
+
+func newNull(sampleRate, channels int) (Output, error) {
+	return &null{
+		clock: clock{perSec: int64(sampleRate * channels)},
+	}, nil
+}
+
+func (n *null) Push(samples []float32) {
+	n.wait(len(samples))
+}
+
+func (n *null) Start() {
+	n.reset()
+}
+
+func (n *null) Stop() {
+}
+
+func (n *null) Close() {
+}
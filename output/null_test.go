@@ -0,0 +1,54 @@
+package output
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNull(t *testing.T) {
+	o, err := newNull(1000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// took returns how long f took.
+	took := func(f func()) time.Duration {
+		start := time.Now()
+		f()
+		return time.Since(start)
+	}
+	within := func(name string, d, min, max time.Duration) {
+		t.Helper()
+		if d < min || d > max {
+			t.Errorf("%s: took %v, want %v to %v", name, d, min, max)
+		}
+	}
+
+	// Pushes take as long as their samples would play.
+	o.Start()
+	within("5 pushes of 10ms", took(func() {
+		for i := 0; i < 5; i++ {
+			o.Push(make([]float32, 20))
+		}
+	}), 40*time.Millisecond, 500*time.Millisecond)
+
+	// After a short pause, pushes catch up.
+	time.Sleep(50 * time.Millisecond)
+	within("catching up", took(func() {
+		o.Push(make([]float32, 20))
+	}), 0, 20*time.Millisecond)
+
+	// After a long pause, or a Start, they are paced from then on.
+	n := o.(*null)
+	n.start = n.start.Add(-2 * time.Second)
+	within("after a long pause", took(func() {
+		o.Push(make([]float32, 20))
+	}), 8*time.Millisecond, 500*time.Millisecond)
+	o.Stop()
+	time.Sleep(50 * time.Millisecond)
+	o.Start()
+	within("after start", took(func() {
+		o.Push(make([]float32, 20))
+		o.Push(make([]float32, 20))
+	}), 8*time.Millisecond, 500*time.Millisecond)
+	o.Close()
+}
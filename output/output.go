@@ -1,29 +1,106 @@
 package output
 
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
 type Output interface {
 	// Push puts the sample on the output buffer.
 	Push(samples []float32)
 	Stop()
 	Start()
+	// Close releases the output. It is not called while a Push is in
+	// progress, and the output is not used after.
+	Close()
 }
 
-var outputs = make(map[config]Output)
+// Backend opens an Output for a sample format.
+type Backend func(sampleRate, channels int) (Output, error)
 
 type config struct {
 	sr, ch int
 }
 
-func Get(sampleRate, channels int) (Output, error) {
-	c := config{sampleRate, channels}
-	if p, ok := outputs[c]; ok {
-		p.Start()
-		return p, nil
+var (
+	streamMu   sync.Mutex
+	httpStream *Stream
+)
+
+// Parse returns the Backend described by spec, which is one of:
+//
+//	"default": the system audio device
+//	"null": discard samples in real time
+//	"stream": serve audio over HTTP; see HTTPStream
+//	"file:<path>": record to path as a 32-bit float WAV if path ends in
+//	".wav", otherwise as raw 32-bit float little-endian PCM
+func Parse(spec string) (Backend, error) {
+	switch {
+	case spec == "default":
+		return get, nil
+	case spec == "null":
+		return newNull, nil
+	case spec == "stream":
+		streamMu.Lock()
+		defer streamMu.Unlock()
+		if httpStream == nil {
+			httpStream = NewStream()
+		}
+		return httpStream.get, nil
+	case strings.HasPrefix(spec, "file:"):
+		path := strings.TrimPrefix(spec, "file:")
+		if path == "" {
+			return nil, fmt.Errorf("output: missing file path")
+		}
+		return func(sampleRate, channels int) (Output, error) {
+			return newFile(path, sampleRate, channels)
+		}, nil
+	}
+	return nil, fmt.Errorf("output: unknown backend: %v", spec)
+}
+
+var (
+	defaults = []string{"default"}
+	format   = config{44100, 2}
+)
+
+// Init sets the comma-separated list of sinks returned by Defaults, and the
+// sample format returned by Format. An empty list means "default". Init should
+// be called before Defaults and Format.
+func Init(specs string, sampleRate, channels int) error {
+	if sampleRate <= 0 || channels <= 0 {
+		return fmt.Errorf("output: bad format: %v Hz, %v channels", sampleRate, channels)
+	}
+	format = config{sampleRate, channels}
+	if specs == "" {
+		specs = "default"
 	}
-	p, err := get(sampleRate, channels)
-	if err != nil {
-		return nil, err
+	sp := strings.Split(specs, ",")
+	for _, s := range sp {
+		if _, err := Parse(s); err != nil {
+			return err
+		}
 	}
-	outputs[c] = p
-	p.Start()
-	return p, nil
+	defaults = sp
+	return nil
+}
+
+// Defaults returns the sinks that playback starts with.
+func Defaults() []string {
+	return defaults
+}
+
+// Format returns the sample format that sinks are opened with. Songs in other
+// formats must be converted, for example with a Converter.
+func Format() (sampleRate, channels int) {
+	return format.sr, format.ch
+}
+
+// HTTPStream returns the Stream used by the "stream" backend, or nil if it has
+// never been used.
+func HTTPStream() *Stream {
+	streamMu.Lock()
+	defer streamMu.Unlock()
+	return httpStream
 }
@@ -60,3 +60,7 @@ func (p *port) Stop() {
 func (p *port) Start() {
 	p.st.Start()
 }
+
+func (p *port) Close() {
+	p.st.Close()
+}
@@ -41,3 +41,10 @@ func (o *output) Start() {
 
 func (o *output) Stop() {
 }
+
+func (o *output) Close() {
+	if _, err := o.st.Drain(); err != nil {
+		log.Println(err)
+	}
+	o.st.Free()
+}
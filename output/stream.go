@@ -0,0 +1,223 @@
+package output
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	streamRate     = 44100
+	streamChannels = 2
+	streamBits     = 16
+	// streamMetaint is the number of audio bytes between ICY metadata blocks.
+	streamMetaint = 16000
+	// streamBacklog is the number of chunks buffered per listener before
+	// chunks are dropped for that listener.
+	streamBacklog = 64
+)
+
+// Stream is an Output that serves the audio it receives over HTTP as an
+// endless 16-bit PCM WAV file, with optional ICY (Shoutcast) metadata. All
+// input is converted to 44.1kHz stereo so that listeners can stay connected
+// across songs with different formats.
+type Stream struct {
+	clock
+
+	mu        sync.Mutex
+	listeners map[chan []byte]bool
+	title     string
+}
+
+func NewStream() *Stream {
+	return &Stream{
+		clock:     clock{perSec: streamRate * streamChannels},
+		listeners: make(map[chan []byte]bool),
+	}
+}
+
+// SetTitle sets the StreamTitle sent to listeners that requested metadata.
+func (s *Stream) SetTitle(title string) {
+	s.mu.Lock()
+	s.title = title
+	s.mu.Unlock()
+}
+
+func (s *Stream) get(sampleRate, channels int) (Output, error) {
+	c, err := NewConverter(sampleRate, channels, streamRate, streamChannels)
+	if err != nil {
+		return nil, err
+	}
+	return &streamInput{
+		s: s,
+		c: c,
+	}, nil
+}
+
+func (s *Stream) write(frames []float32) {
+	buf := new(bytes.Buffer)
+	for _, f := range frames {
+		if f > 1 {
+			f = 1
+		} else if f < -1 {
+			f = -1
+		}
+		_ = binary.Write(buf, binary.LittleEndian, int16(f*32767))
+	}
+	b := buf.Bytes()
+	s.mu.Lock()
+	for l := range s.listeners {
+		select {
+		case l <- b:
+		default:
+			// Slow listener: drop audio instead of blocking playback.
+		}
+	}
+	s.mu.Unlock()
+	s.wait(len(frames))
+}
+
+func wavStreamHeader() []byte {
+	const blockAlign = streamChannels * streamBits / 8
+	h := []interface{}{
+		[]byte("RIFF"),
+		uint32(0xffffffff),
+		[]byte("WAVE"),
+		[]byte("fmt "),
+		uint32(16),
+		uint16(1),
+		uint16(streamChannels),
+		uint32(streamRate),
+		uint32(streamRate * blockAlign),
+		uint16(blockAlign),
+		uint16(streamBits),
+		[]byte("data"),
+		uint32(0xffffffff),
+	}
+	buf := new(bytes.Buffer)
+	for _, v := range h {
+		_ = binary.Write(buf, binary.LittleEndian, v)
+	}
+	return buf.Bytes()
+}
+
+// icyEscaper escapes the quotes that end a StreamTitle, and so also the
+// escape character.
+var icyEscaper = strings.NewReplacer(`\`, `\\`, "'", `\'`)
+
+// icyMeta returns an ICY metadata block for title.
+func icyMeta(title string) []byte {
+	if title == "" {
+		return []byte{0}
+	}
+	title = icyEscaper.Replace(title)
+	// A block holds at most 255*16 bytes, so shorten long titles without
+	// splitting a character or an escape.
+	if max := 255*16 - len("StreamTitle='';"); len(title) > max {
+		i := max
+		for i > 0 && !utf8.RuneStart(title[i]) {
+			i--
+		}
+		title = title[:i]
+		if n := len(title) - len(strings.TrimRight(title, `\`)); n%2 != 0 {
+			title = title[:len(title)-1]
+		}
+	}
+	m := fmt.Sprintf("StreamTitle='%s';", title)
+	n := (len(m) + 15) / 16
+	b := make([]byte, 1+n*16)
+	b[0] = byte(n)
+	copy(b[1:], m)
+	return b
+}
+
+func (s *Stream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	meta := r.Header.Get("Icy-MetaData") == "1"
+	w.Header().Set("Content-Type", "audio/wav")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("icy-name", "moggio")
+	if meta {
+		w.Header().Set("icy-metaint", fmt.Sprint(streamMetaint))
+	}
+	l := make(chan []byte, streamBacklog)
+	s.mu.Lock()
+	s.listeners[l] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.listeners, l)
+		s.mu.Unlock()
+	}()
+	log.Println("stream: listener connected:", r.RemoteAddr)
+	defer log.Println("stream: listener disconnected:", r.RemoteAddr)
+
+	flusher, _ := w.(http.Flusher)
+	remaining := streamMetaint
+	send := func(b []byte) error {
+		for len(b) > 0 {
+			n := len(b)
+			if meta && n > remaining {
+				n = remaining
+			}
+			if _, err := w.Write(b[:n]); err != nil {
+				return err
+			}
+			b = b[n:]
+			if !meta {
+				continue
+			}
+			remaining -= n
+			if remaining == 0 {
+				s.mu.Lock()
+				title := s.title
+				s.mu.Unlock()
+				if _, err := w.Write(icyMeta(title)); err != nil {
+					return err
+				}
+				remaining = streamMetaint
+			}
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+	if err := send(wavStreamHeader()); err != nil {
+		return
+	}
+	for {
+		select {
+		case b := <-l:
+			if err := send(b); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// streamInput converts one input format to the stream format.
+type streamInput struct {
+	s *Stream
+	c *Converter
+}
+
+func (in *streamInput) Push(samples []float32) {
+	in.s.write(in.c.Convert(samples))
+}
+
+func (in *streamInput) Start() {
+	in.s.reset()
+}
+
+func (in *streamInput) Stop() {
+}
+
+func (in *streamInput) Close() {
+}
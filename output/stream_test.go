@@ -0,0 +1,137 @@
+package output
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestICYMeta(t *testing.T) {
+	tests := []struct {
+		title, want string
+	}{
+		{"", ""},
+		{"a", "StreamTitle='a';"},
+		{"It's", `StreamTitle='It\'s';`},
+		{`a\b'`, `StreamTitle='a\\b\'';`},
+		{"exactly sixteen", "StreamTitle='exactly sixteen';"},
+	}
+	for _, test := range tests {
+		b := icyMeta(test.title)
+		if len(b) != 1+int(b[0])*16 || (len(b)-1)%16 != 0 {
+			t.Errorf("%q: got %d blocks in %d bytes", test.title, b[0], len(b))
+			continue
+		}
+		if len(test.want) > 0 && int(b[0]) != (len(test.want)+15)/16 {
+			t.Errorf("%q: got %d blocks", test.title, b[0])
+		}
+		if got := string(bytes.TrimRight(b[1:], "\x00")); got != test.want {
+			t.Errorf("%q: got %q, want %q", test.title, got, test.want)
+		}
+	}
+
+	// Long titles are cut to fit without splitting an escape or a character.
+	for _, title := range []string{
+		strings.Repeat("a", 5000),
+		strings.Repeat("'", 5000),
+		strings.Repeat("é", 5000),
+		"a" + strings.Repeat("'", 5000),
+		"a" + strings.Repeat("é", 5000),
+	} {
+		b := icyMeta(title)
+		m := string(bytes.TrimRight(b[1:], "\x00"))
+		if b[0] != 255 || len(b) != 1+255*16 {
+			t.Errorf("%.5q: got %d blocks in %d bytes", title, b[0], len(b))
+		}
+		if !strings.HasPrefix(m, "StreamTitle='") || !strings.HasSuffix(m, "';") || len(m) < 255*16-2 {
+			t.Errorf("%.5q: got %d bytes %.20q...%q", title, len(m), m, m[len(m)-5:])
+		}
+		v := strings.TrimSuffix(strings.TrimPrefix(m, "StreamTitle='"), "';")
+		if got := strings.NewReplacer(`\\`, `\`, `\'`, "'").Replace(v); !strings.HasPrefix(title, got) {
+			t.Errorf("%.5q: got %.20q...%q", title, got, got[len(got)-5:])
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	s := NewStream()
+	s.SetTitle("It's")
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+	req, err := http.NewRequest("GET", ts.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Icy-MetaData", "1")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct, mi := res.Header.Get("Content-Type"), res.Header.Get("icy-metaint"); ct != "audio/wav" || mi != "16000" {
+		t.Fatalf("got Content-Type %q, icy-metaint %q", ct, mi)
+	}
+	listeners := func() int {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.listeners)
+	}
+	for listeners() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Push enough stereo frames at the stream rate to cross a metadata
+	// block: sample i is i/32767, which is sent as i, give or take
+	// rounding.
+	o, err := s.get(streamRate, streamChannels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const n = 10000
+	samples := make([]float32, n)
+	for i := range samples {
+		samples[i] = float32(i) / 32767
+	}
+	go func() {
+		o.Start()
+		for i := 0; i < n; i += 1000 {
+			o.Push(samples[i : i+1000])
+		}
+	}()
+
+	body := make([]byte, len(wavStreamHeader())+n*2+len(icyMeta("It's")))
+	if _, err := io.ReadFull(res.Body, body); err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	meta := body[streamMetaint : streamMetaint+len(icyMeta("It's"))]
+	if !bytes.Equal(meta, icyMeta("It's")) {
+		t.Fatalf("got metadata %q", meta)
+	}
+	audio := append(body[:streamMetaint:streamMetaint], body[streamMetaint+len(meta):]...)
+	h := wavStreamHeader()
+	if !bytes.Equal(audio[:len(h)], h) {
+		t.Fatalf("got header %x", audio[:len(h)])
+	}
+	// The converter holds back a frame, so silence comes first.
+	for i := range samples {
+		want := i - streamChannels
+		if want < 0 {
+			want = 0
+		}
+		if got := int(int16(binary.LittleEndian.Uint16(audio[len(h)+i*2:]))); got < want-1 || got > want {
+			t.Fatalf("sample %d: got %d, want %d", i, got, want)
+		}
+	}
+
+	// The listener is removed when it disconnects.
+	for start := time.Now(); listeners() != 0; time.Sleep(time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatal("listener not removed")
+		}
+	}
+}
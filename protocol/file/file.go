@@ -4,12 +4,18 @@ import (
 	"encoding/gob"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
 	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/codec/cue"
 	"github.com/mjibson/moggio/protocol"
 	"golang.org/x/oauth2"
 )
@@ -19,6 +25,13 @@ func init() {
 	gob.Register(new(File))
 }
 
+// Workers is the number of files probed at once during Refresh.
+var Workers = runtime.NumCPU()
+
+// R128 enables measuring the loudness of songs without ReplayGain tags
+// during Refresh.
+var R128 bool
+
 func New(params []string, token *oauth2.Token) (protocol.Instance, error) {
 	if len(params) != 1 {
 		return nil, fmt.Errorf("expected one parameter")
@@ -41,6 +54,27 @@ func New(params []string, token *oauth2.Token) (protocol.Instance, error) {
 type File struct {
 	Path  string
 	Songs protocol.SongList
+	// Files holds the modification time and size of each file at the last
+	// refresh.
+	Files map[string]FileStat
+	// Loudness holds the measured loudness of the songs without ReplayGain
+	// tags, so that album gains include songs that were not probed again.
+	Loudness map[codec.ID]codec.LoudnessSummary
+
+	mu sync.Mutex
+	// pending holds the paths that changed since the last update.
+	pending map[string]bool
+}
+
+// FileStat is what Refresh uses to tell if a file has changed.
+type FileStat struct {
+	ModTime time.Time
+	Size    int64
+}
+
+func (s FileStat) same(t FileStat) bool {
+	// Equal, unlike ==, ignores the location, which gob does not keep.
+	return s.ModTime.Equal(t.ModTime) && s.Size == t.Size
 }
 
 func (f *File) Key() string {
@@ -73,7 +107,25 @@ func (f *File) List() (protocol.SongList, error) {
 }
 
 func (f *File) Refresh() (protocol.SongList, error) {
-	songs := make(protocol.SongList)
+	return f.RefreshProgress(nil)
+}
+
+// RefreshProgress implements protocol.ProgressRefresher. Files with the same
+// modification time and size as at the last refresh keep their songs; the
+// rest are probed by Workers goroutines. Cue sheets are always probed, since
+// the files they split may have changed.
+func (f *File) RefreshProgress(progress protocol.Progress) (protocol.SongList, error) {
+	f.mu.Lock()
+	f.pending = nil
+	f.mu.Unlock()
+	// old holds the IDs of the songs of each file at the last refresh.
+	old := make(map[string][]codec.ID)
+	for id := range f.Songs {
+		path, _ := id.Pop()
+		old[path] = append(old[path], id)
+	}
+	stats := make(map[string]FileStat)
+	var paths []string
 	err := filepath.Walk(f.Path, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -81,35 +133,241 @@ func (f *File) Refresh() (protocol.SongList, error) {
 		if info.IsDir() {
 			return nil
 		}
-		f, err := os.Open(path)
-		if err != nil {
-			return nil
+		stats[path] = FileStat{
+			ModTime: info.ModTime(),
+			Size:    info.Size(),
 		}
-		defer f.Close()
-		ss, _, err := codec.ByExtension(path, fileReader(path))
-		if err != nil || len(ss) == 0 {
-			return nil
+		paths = append(paths, path)
+		return nil
+	})
+
+	var mu sync.Mutex
+	songs := make(protocol.SongList)
+	// split holds the files that cue sheets split into tracks, which are
+	// listed by their sheets instead.
+	split := make(map[string]bool)
+	// covers caches the URL of each directory's folder image.
+	covers := make(map[string]string)
+	cover := func(dir string) string {
+		mu.Lock()
+		defer mu.Unlock()
+		u, ok := covers[dir]
+		if !ok {
+			u = folderImage(dir)
+			covers[dir] = u
 		}
-		for i, s := range ss {
-			info, _ := s.Info()
-			if info.Title == "" {
-				title := filepath.Base(path)
-				if len(ss) != 1 {
-					title += fmt.Sprintf(":%v", i)
+		return u
+	}
+	done := 0
+	finish := func() {
+		done++
+		if progress != nil {
+			progress(done, len(paths))
+		}
+	}
+
+	work := make(chan string)
+	var wg sync.WaitGroup
+	workers := Workers
+	if workers < 1 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range work {
+				ps, files := probe(path, cover)
+				mu.Lock()
+				for _, p := range files {
+					split[p] = true
 				}
-				info.Title = title
+				for id, info := range ps {
+					songs[id] = info
+				}
+				finish()
+				mu.Unlock()
 			}
-			if info.Album == "" {
-				info.Album = filepath.Base(filepath.Dir(path))
+		}()
+	}
+	for _, path := range paths {
+		ids := old[path]
+		if len(ids) > 0 && f.Files[path].same(stats[path]) && !isCue(path) {
+			mu.Lock()
+			for _, id := range ids {
+				songs[id] = f.Songs[id]
 			}
-			songs[codec.NewID(path, string(i))] = &info
+			finish()
+			mu.Unlock()
+			continue
 		}
-		return nil
-	})
+		work <- path
+	}
+	close(work)
+	wg.Wait()
+
+	for id := range songs {
+		if path, _ := id.Pop(); split[path] {
+			delete(songs, id)
+		}
+	}
+	if R128 {
+		f.Loudness = f.measure(songs)
+	}
 	f.Songs = songs
+	f.Files = stats
 	return songs, err
 }
 
+func isCue(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".cue")
+}
+
+// probe returns the songs of the file at path and, if it is a cue sheet, the
+// files it splits. cover returns the URL of a directory's folder image.
+func probe(path string, cover func(dir string) string) (protocol.SongList, []string) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil
+	}
+	f.Close()
+	var files []string
+	if isCue(path) {
+		files, _ = cue.Files(path)
+	}
+	ss, name, err := codec.ByExtension(path, fileReader(path))
+	if err != nil || len(ss) == 0 {
+		return nil, files
+	}
+	songs := make(protocol.SongList)
+	for i, s := range ss {
+		info, _ := s.Info()
+		if info.Codec == "" {
+			info.Codec = name
+		}
+		if info.Title == "" {
+			title := filepath.Base(path)
+			if len(ss) != 1 {
+				title += fmt.Sprintf(":%v", i)
+			}
+			info.Title = title
+		}
+		if info.Album == "" {
+			info.Album = filepath.Base(filepath.Dir(path))
+		}
+		if info.ImageURL == "" {
+			info.ImageURL = cover(filepath.Dir(path))
+		}
+		songs[codec.NewID(path, string(i))] = &info
+	}
+	return songs, files
+}
+
+// coverNames are the names, in order of preference, of images in a directory
+// that are used for songs without one.
+var coverNames = []string{"cover", "folder", "front", "album"}
+
+// folderImage returns the URL of the cover image in dir, or "" if it has none.
+func folderImage(dir string) string {
+	f, err := os.Open(dir)
+	if err != nil {
+		return ""
+	}
+	names, _ := f.Readdirnames(-1)
+	f.Close()
+	for _, c := range coverNames {
+		for _, name := range names {
+			ext := strings.ToLower(filepath.Ext(name))
+			if !strings.EqualFold(strings.TrimSuffix(name, filepath.Ext(name)), c) {
+				continue
+			}
+			var mime string
+			switch ext {
+			case ".jpg", ".jpeg":
+				mime = "image/jpeg"
+			case ".png":
+				mime = "image/png"
+			default:
+				continue
+			}
+			b, err := ioutil.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				continue
+			}
+			return codec.ArtURL(mime, b)
+		}
+	}
+	return ""
+}
+
+// measure sets the ReplayGain of the songs without tags from their loudness,
+// and returns the loudness of each. Songs unchanged since the last refresh,
+// which are the same as in f.Songs, keep their measurement; the rest are
+// measured.
+func (f *File) measure(songs protocol.SongList) map[codec.ID]codec.LoudnessSummary {
+	loudness := make(map[codec.ID]codec.LoudnessSummary)
+	// albums groups the IDs of untagged songs by directory and album.
+	albums := make(map[string][]codec.ID)
+	for id, info := range songs {
+		if l, ok := f.Loudness[id]; ok && info == f.Songs[id] {
+			loudness[id] = l
+		} else if info.TrackGain != 0 || info.AlbumGain != 0 {
+			continue
+		}
+		path, _ := id.Pop()
+		key := filepath.Dir(path) + "\x00" + info.Album
+		albums[key] = append(albums[key], id)
+	}
+	for _, ids := range albums {
+		var tracks []codec.LoudnessSummary
+		var measured []codec.ID
+		for _, id := range ids {
+			l, ok := loudness[id]
+			if !ok {
+				info := *songs[id]
+				var err error
+				l, err = f.measureSong(id, &info)
+				if err != nil {
+					log.Printf("r128: %v: %v", id, err)
+					continue
+				}
+				songs[id] = &info
+				loudness[id] = l
+			}
+			tracks = append(tracks, l)
+			measured = append(measured, id)
+		}
+		gain, peak := codec.AlbumLoudness(tracks)
+		// Copy the infos, since the old song list may be in use.
+		for _, id := range measured {
+			info := *songs[id]
+			info.AlbumGain, info.AlbumPeak = gain, peak
+			songs[id] = &info
+		}
+	}
+	return loudness
+}
+
+// measureSong measures the loudness of the song id with info and sets its
+// track gain and peak.
+func (f *File) measureSong(id codec.ID, info *codec.SongInfo) (codec.LoudnessSummary, error) {
+	s, err := f.GetSong(id)
+	if err != nil {
+		return codec.LoudnessSummary{}, err
+	}
+	max := info.Time
+	if max <= 0 {
+		// Bound songs that may never end.
+		max = time.Minute * 20
+	}
+	l, err := codec.MeasureLoudness(s, max)
+	if err != nil {
+		return codec.LoudnessSummary{}, err
+	}
+	info.TrackGain, info.TrackPeak = l.Gain(), l.Peak()
+	return l.Summary(), nil
+}
+
 func fileReader(path string) codec.Reader {
 	return func() (io.ReadCloser, int64, error) {
 		log.Println("open file", path)
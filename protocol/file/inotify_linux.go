@@ -0,0 +1,119 @@
+package file
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const watchMask = unix.IN_CREATE | unix.IN_CLOSE_WRITE | unix.IN_DELETE |
+	unix.IN_MOVED_FROM | unix.IN_MOVED_TO
+
+// inotify watches a directory tree with inotify.
+type inotify struct {
+	fd    int
+	f     *os.File
+	root  string
+	event func(path string)
+
+	mu sync.Mutex
+	// dirs holds the directory of each watch descriptor.
+	dirs map[int]string
+}
+
+// watchTree calls event with the path of each file or directory in the tree
+// at root that is created, written, removed or renamed. If events were lost
+// because the queue overflowed, it calls event with root.
+func watchTree(root string, event func(path string)) (io.Closer, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+	w := &inotify{
+		fd: fd,
+		// A nonblocking file uses the runtime poller, so Close stops a Read.
+		// Its Fd method must not be used, since it makes it blocking.
+		f:     os.NewFile(uintptr(fd), "inotify"),
+		root:  root,
+		event: event,
+		dirs:  make(map[int]string),
+	}
+	if err := w.add(root); err != nil {
+		w.f.Close()
+		return nil, err
+	}
+	go w.read()
+	return w.f, nil
+}
+
+// add watches dir and the directories in it.
+func (w *inotify) add(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if path == dir {
+				return err
+			}
+			return nil
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		wd, err := unix.InotifyAddWatch(w.fd, path, watchMask)
+		if err != nil {
+			if path == dir {
+				return err
+			}
+			log.Printf("watch %s: %v", path, err)
+			return nil
+		}
+		w.mu.Lock()
+		w.dirs[wd] = path
+		w.mu.Unlock()
+		return nil
+	})
+}
+
+func (w *inotify) read() {
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := w.f.Read(buf)
+		if err != nil {
+			// Closed.
+			return
+		}
+		for b := buf[:n]; len(b) >= unix.SizeofInotifyEvent; {
+			ev := (*unix.InotifyEvent)(unsafe.Pointer(&b[0]))
+			name := b[unix.SizeofInotifyEvent : unix.SizeofInotifyEvent+int(ev.Len)]
+			b = b[unix.SizeofInotifyEvent+int(ev.Len):]
+			name = bytes.TrimRight(name, "\x00")
+
+			if ev.Mask&unix.IN_Q_OVERFLOW != 0 {
+				// Directories created since may not be watched yet.
+				w.add(w.root)
+				w.event(w.root)
+				continue
+			}
+			w.mu.Lock()
+			dir, ok := w.dirs[int(ev.Wd)]
+			if ev.Mask&unix.IN_IGNORED != 0 {
+				delete(w.dirs, int(ev.Wd))
+			}
+			w.mu.Unlock()
+			if !ok || len(name) == 0 {
+				continue
+			}
+			path := filepath.Join(dir, string(name))
+			if ev.Mask&unix.IN_ISDIR != 0 && ev.Mask&(unix.IN_CREATE|unix.IN_MOVED_TO) != 0 {
+				// Removed directories drop their watches by themselves.
+				w.add(path)
+			}
+			w.event(path)
+		}
+	}
+}
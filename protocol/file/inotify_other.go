@@ -0,0 +1,12 @@
+// +build !linux
+
+package file
+
+import (
+	"fmt"
+	"io"
+)
+
+func watchTree(root string, event func(path string)) (io.Closer, error) {
+	return nil, fmt.Errorf("file: watching is only supported on linux")
+}
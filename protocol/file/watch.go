@@ -0,0 +1,131 @@
+package file
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mjibson/moggio/codec/cue"
+	"github.com/mjibson/moggio/protocol"
+)
+
+var (
+	// WatchFiles enables watching directories for changes.
+	WatchFiles bool
+	// WatchDelay is how long to wait after a change for more before
+	// updating, so that a burst, like an album being copied, is handled once.
+	WatchDelay = time.Second * 2
+)
+
+// Watch implements protocol.Watcher. Nothing is watched unless WatchFiles is
+// set.
+func (f *File) Watch(changed func()) (io.Closer, error) {
+	if !WatchFiles {
+		return nil, nil
+	}
+	var timer *time.Timer
+	return watchTree(f.Path, func(path string) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if f.pending == nil {
+			f.pending = make(map[string]bool)
+		}
+		f.pending[path] = true
+		if timer == nil {
+			timer = time.AfterFunc(WatchDelay, changed)
+		} else {
+			timer.Reset(WatchDelay)
+		}
+	})
+}
+
+// Update implements protocol.Watcher. It probes only the changed files,
+// unless a cue sheet changed or changes were lost, in which case it
+// refreshes.
+func (f *File) Update() (protocol.SongList, error) {
+	f.mu.Lock()
+	pending := f.pending
+	f.pending = nil
+	f.mu.Unlock()
+	for p := range pending {
+		if isCue(p) || p == f.Path {
+			return f.RefreshProgress(nil)
+		}
+	}
+	// Copy the maps, since the old ones may be in use.
+	songs := make(protocol.SongList, len(f.Songs))
+	for id, info := range f.Songs {
+		songs[id] = info
+	}
+	stats := make(map[string]FileStat, len(f.Files))
+	for path, st := range f.Files {
+		stats[path] = st
+	}
+	var paths []string
+	for p := range pending {
+		// Remove p and, if it was a directory, everything in it.
+		prefix := p + string(filepath.Separator)
+		for id := range songs {
+			if path, _ := id.Pop(); path == p || strings.HasPrefix(path, prefix) {
+				delete(songs, id)
+			}
+		}
+		for path := range stats {
+			if path == p || strings.HasPrefix(path, prefix) {
+				delete(stats, path)
+			}
+		}
+		filepath.Walk(p, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return nil
+			}
+			stats[path] = FileStat{
+				ModTime: info.ModTime(),
+				Size:    info.Size(),
+			}
+			paths = append(paths, path)
+			return nil
+		})
+	}
+	covers := make(map[string]string)
+	cover := func(dir string) string {
+		u, ok := covers[dir]
+		if !ok {
+			u = folderImage(dir)
+			covers[dir] = u
+		}
+		return u
+	}
+	for _, path := range paths {
+		if isSplit(path) {
+			continue
+		}
+		ps, _ := probe(path, cover)
+		for id, info := range ps {
+			songs[id] = info
+		}
+	}
+	if R128 {
+		f.Loudness = f.measure(songs)
+	}
+	f.Songs = songs
+	f.Files = stats
+	return songs, nil
+}
+
+// isSplit reports whether a cue sheet in the same directory splits the file
+// at path.
+func isSplit(path string) bool {
+	sheets, _ := filepath.Glob(filepath.Join(filepath.Dir(path), "*.[cC][uU][eE]"))
+	for _, s := range sheets {
+		files, _ := cue.Files(s)
+		for _, p := range files {
+			if p == path {
+				return true
+			}
+		}
+	}
+	return false
+}
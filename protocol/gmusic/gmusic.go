@@ -90,11 +90,18 @@ func (g *GMusic) Refresh() (protocol.SongList, error) {
 		tracks[codec.ID(t.ID)] = t
 		duration, _ := strconv.Atoi(t.DurationMillis)
 		si := &codec.SongInfo{
-			Time:   time.Duration(duration) * time.Millisecond,
-			Artist: t.AlbumArtist,
-			Title:  t.Title,
-			Album:  t.Album,
-			Track:  t.TrackNumber,
+			Time:        time.Duration(duration) * time.Millisecond,
+			Artist:      t.Artist,
+			Title:       t.Title,
+			Album:       t.Album,
+			Track:       t.TrackNumber,
+			Disc:        t.DiscNumber,
+			AlbumArtist: t.AlbumArtist,
+			Year:        int(t.Year),
+			Codec:       "MP3",
+		}
+		if si.Artist == "" {
+			si.Artist = t.AlbumArtist
 		}
 		if len(t.AlbumArtRef) != 0 {
 			si.ImageURL = t.AlbumArtRef[0].URL
@@ -37,6 +37,29 @@ type Instance interface {
 
 type SongList map[codec.ID]*codec.SongInfo
 
+// Progress reports that done of total items have been processed. total is 0
+// if unknown.
+type Progress func(done, total int)
+
+// ProgressRefresher is implemented by Instances that report their progress
+// while refreshing.
+type ProgressRefresher interface {
+	// RefreshProgress is like Refresh, but calls progress as it goes.
+	RefreshProgress(progress Progress) (SongList, error)
+}
+
+// Watcher is implemented by Instances that can watch for changes to their
+// songs.
+type Watcher interface {
+	// Watch calls changed, from any goroutine, when songs may have changed,
+	// until the returned Closer is closed. It returns a nil Closer if the
+	// instance is not being watched.
+	Watch(changed func()) (io.Closer, error)
+	// Update applies the changes since the last Update or Refresh. It is
+	// cheaper than Refresh.
+	Update() (SongList, error)
+}
+
 func (p *Protocol) NewInstance(params []string, token *oauth2.Token) (Instance, error) {
 	return p.newInstance(params, token)
 }
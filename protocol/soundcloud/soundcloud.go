@@ -83,12 +83,19 @@ func (s *Soundcloud) Info(id codec.ID) (*codec.SongInfo, error) {
 }
 
 func toInfo(f *soundcloud.Favorite) *codec.SongInfo {
-	return &codec.SongInfo{
+	si := &codec.SongInfo{
 		Time:     time.Duration(f.Duration) * time.Millisecond,
 		Artist:   f.User.Username,
 		Title:    f.Title,
 		ImageURL: f.ArtworkURL,
+		Genre:    f.Genre,
+		Codec:    "MP3",
 	}
+	// ReleaseYear is null or a JSON number.
+	if y, ok := f.ReleaseYear.(float64); ok {
+		si.Year = int(y)
+	}
+	return si
 }
 
 func (s *Soundcloud) SongList() protocol.SongList {
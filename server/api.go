@@ -0,0 +1,541 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bradfitz/slice"
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+)
+
+// Version 1 of the API is under /api/v1 and described by openAPI. Request
+// bodies are JSON whatever their content type, parameters that name things
+// are in the URL query, and commands return only once the server has run
+// them. Failures are JSON apiErrors, with a status of 400 for a bad request,
+// 404 for something that does not exist and 409 for a conflict with the
+// state of the server.
+
+// apiError is an error with the HTTP status to send it with.
+type apiError struct {
+	Status  int
+	Message string
+}
+
+func (e *apiError) Error() string {
+	return e.Message
+}
+
+func newAPIError(status int, format string, args ...interface{}) error {
+	return &apiError{
+		Status:  status,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return newAPIError(http.StatusBadRequest, format, args...)
+}
+
+func notFound(format string, args ...interface{}) error {
+	return newAPIError(http.StatusNotFound, format, args...)
+}
+
+func conflict(format string, args ...interface{}) error {
+	return newAPIError(http.StatusConflict, format, args...)
+}
+
+// apiJSON is JSON for the v1 API. It passes the URL query instead of the
+// form, so that bodies are never parsed as forms, sends errors as JSON with
+// their status, or 500 if they are not API errors, and sends 204 if there is
+// no result.
+func apiJSON(h func(io.Reader, url.Values, httprouter.Params) (interface{}, error)) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		d, err := h(r.Body, r.URL.Query(), ps)
+		if err != nil {
+			e, ok := err.(*apiError)
+			if !ok {
+				log.Println(err)
+				e = &apiError{
+					Status:  http.StatusInternalServerError,
+					Message: err.Error(),
+				}
+			}
+			b, _ := json.Marshal(e)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(e.Status)
+			w.Write(b)
+			return
+		}
+		if d == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		serveJSON(w, d)
+	}
+}
+
+// decode decodes the JSON body into v.
+func decode(body io.Reader, v interface{}) error {
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return badRequest("bad request body: %v", err)
+	}
+	return nil
+}
+
+// apiDuration is a duration in nanoseconds, like those of Status, or a
+// string like "1m30s".
+type apiDuration time.Duration
+
+func (d *apiDuration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		v, err := time.ParseDuration(s)
+		*d = apiDuration(v)
+		return err
+	}
+	var n int64
+	err := json.Unmarshal(b, &n)
+	*d = apiDuration(n)
+	return err
+}
+
+// check returns an API error if cmd would fail or do nothing. It should only
+// be called by the commands() function.
+func (srv *Server) check(cmd interface{}) error {
+	switch c := cmd.(type) {
+	case cmdPlayIdx:
+		if c < 0 || int(c) >= len(srv.Queue) {
+			return notFound("no queue index %d", c)
+		}
+	case cmdPlayTrack:
+		if !srv.hasSong(SongID(c)) {
+			return notFound("no such track: %q", c)
+		}
+	case cmdSeek:
+		if srv.song == nil {
+			return conflict("no song is playing")
+		}
+		if c < 0 || time.Duration(c) > srv.info.Time {
+			return badRequest("position out of range: %v", time.Duration(c))
+		}
+	case cmdVolume:
+		if !(c >= 0 && c <= 1) {
+			return badRequest("volume must be between 0 and 1: %v", float64(c))
+		}
+	case cmdCrossfade:
+		if c < 0 {
+			return badRequest("crossfade must not be negative: %v", time.Duration(c))
+		}
+	case cmdMinDuration:
+		if c < 0 {
+			return badRequest("minimum duration must not be negative: %v", time.Duration(c))
+		}
+	case cmdReplayGain:
+		switch c {
+		case "", "off", "track", "album":
+		default:
+			return badRequest("unknown replaygain mode: %s", c)
+		}
+	case cmdQueueChange:
+		return srv.checkChange(srv.Queue, PlaylistChange(c))
+	case cmdPlaylistChange:
+		return srv.checkChange(srv.Playlists[c.name], c.plc)
+	case cmdProtocolAdd:
+		id := codec.NewID(c.Name, c.Instance.Key())
+		if srv.inprogress[id] != nil {
+			return conflict("already adding %s: %s", c.Name, c.Instance.Key())
+		}
+		if _, err := srv.getInstance(c.Name, c.Instance.Key()); err == nil {
+			return conflict("already have %s: %s", c.Name, c.Instance.Key())
+		}
+	case cmdProtocolRemove:
+		if _, err := srv.getInstance(c.protocol, c.key); err != nil {
+			return notFound("%v", err)
+		}
+	case cmdProtocolRefresh:
+		if _, err := srv.getInstance(c.protocol, c.key); err != nil {
+			return notFound("%v", err)
+		}
+		if srv.inprogress[codec.NewID(c.protocol, c.key)] != nil {
+			return conflict("already refreshing %s: %s", c.protocol, c.key)
+		}
+	}
+	return nil
+}
+
+// checkChange returns an API error if plc does not apply to p or adds songs
+// that do not exist.
+func (srv *Server) checkChange(p Playlist, plc PlaylistChange) error {
+	if _, _, err := srv.playlistChange(p, plc); err != nil {
+		return badRequest("%v", err)
+	}
+	for _, c := range plc {
+		if c[0] != "add" {
+			continue
+		}
+		if len(c) < 2 {
+			return badRequest("missing track to add")
+		}
+		if !srv.hasSong(SongID(c[1])) {
+			return notFound("no such track: %q", c[1])
+		}
+	}
+	return nil
+}
+
+// runChecked runs cmd and waits for it, unless check returns an error.
+func (srv *Server) runChecked(cmd interface{}) error {
+	done := make(chan error, 1)
+	srv.ch <- cmdChecked{cmd, done}
+	return <-done
+}
+
+func (srv *Server) status() *Status {
+	ch := make(chan *waitData)
+	srv.ch <- cmdWaitData{
+		wt:   waitStatus,
+		done: ch,
+	}
+	return (<-ch).Data.(*Status)
+}
+
+func (srv *Server) snapshot(playlists bool) *snapshot {
+	ch := make(chan *snapshot)
+	srv.ch <- cmdSnapshot{playlists, ch}
+	return <-ch
+}
+
+// APIStatus returns the Status.
+func (srv *Server) APIStatus(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	return srv.status(), nil
+}
+
+// APICmd runs the command named by the cmd parameter, with the arguments in
+// the body, and returns the resulting Status. The commands, and their
+// arguments, are:
+//
+//	play, pause, stop, next, prev
+//	random, repeat, mute: toggle
+//	play_idx {Index}: play the queue from Index
+//	play_track {UID}: queue the album of the track and play it
+//	seek {Position}
+//	volume {Volume}: from 0 to 1
+//	crossfade {Duration}
+//	replaygain {Mode}: off, track or album
+//	min_duration {Duration}: of songs to add to the library
+//
+// Durations are nanoseconds or strings like "1m30s".
+func (srv *Server) APICmd(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	var args struct {
+		Index    *int
+		UID      *SongID
+		Position *apiDuration
+		Volume   *float64
+		Duration *apiDuration
+		Mode     *string
+	}
+	if err := json.NewDecoder(body).Decode(&args); err != nil && err != io.EOF {
+		return nil, badRequest("bad request body: %v", err)
+	}
+	missing := func(name string) error {
+		return badRequest("missing argument: %s", name)
+	}
+	var cmd interface{}
+	switch name := ps.ByName("cmd"); name {
+	case "play":
+		cmd = cmdPlay
+	case "pause":
+		cmd = cmdPause
+	case "stop":
+		cmd = cmdStop
+	case "next":
+		cmd = cmdNext
+	case "prev":
+		cmd = cmdPrev
+	case "random":
+		cmd = cmdRandom
+	case "repeat":
+		cmd = cmdRepeat
+	case "mute":
+		cmd = cmdMute
+	case "play_idx":
+		if args.Index == nil {
+			return nil, missing("Index")
+		}
+		cmd = cmdPlayIdx(*args.Index)
+	case "play_track":
+		if args.UID == nil {
+			return nil, missing("UID")
+		}
+		cmd = cmdPlayTrack(*args.UID)
+	case "seek":
+		if args.Position == nil {
+			return nil, missing("Position")
+		}
+		cmd = cmdSeek(*args.Position)
+	case "volume":
+		if args.Volume == nil {
+			return nil, missing("Volume")
+		}
+		cmd = cmdVolume(*args.Volume)
+	case "crossfade":
+		if args.Duration == nil {
+			return nil, missing("Duration")
+		}
+		cmd = cmdCrossfade(*args.Duration)
+	case "replaygain":
+		if args.Mode == nil {
+			return nil, missing("Mode")
+		}
+		cmd = cmdReplayGain(*args.Mode)
+	case "min_duration":
+		if args.Duration == nil {
+			return nil, missing("Duration")
+		}
+		cmd = cmdMinDuration(*args.Duration)
+	default:
+		return nil, notFound("unknown command: %s", name)
+	}
+	if err := srv.runChecked(cmd); err != nil {
+		return nil, err
+	}
+	return srv.status(), nil
+}
+
+// apiQueue is the queue and the index in it of the current song.
+type apiQueue struct {
+	Index  int
+	Tracks PlaylistInfo
+}
+
+func (srv *Server) queue() *apiQueue {
+	st := srv.snapshot(false)
+	return &apiQueue{
+		Index:  st.index,
+		Tracks: st.queue,
+	}
+}
+
+// APIQueue returns the queue.
+func (srv *Server) APIQueue(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	return srv.queue(), nil
+}
+
+// APIQueueChange applies the PlaylistChange in the body to the queue and
+// returns it.
+func (srv *Server) APIQueueChange(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	var plc PlaylistChange
+	if err := decode(body, &plc); err != nil {
+		return nil, err
+	}
+	if err := srv.runChecked(cmdQueueChange(plc)); err != nil {
+		return nil, err
+	}
+	return srv.queue(), nil
+}
+
+// apiPlaylist is a stored playlist.
+type apiPlaylist struct {
+	Name   string
+	Tracks PlaylistInfo
+}
+
+// APIPlaylists returns the playlists, by name.
+func (srv *Server) APIPlaylists(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	st := srv.snapshot(true)
+	pls := []apiPlaylist{}
+	for name, p := range st.playlists {
+		pls = append(pls, apiPlaylist{name, p})
+	}
+	slice.Sort(pls, func(i, j int) bool {
+		return pls[i].Name < pls[j].Name
+	})
+	return pls, nil
+}
+
+// APIPlaylist returns the playlist named by the name parameter.
+func (srv *Server) APIPlaylist(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	name := ps.ByName("name")
+	p, ok := srv.snapshot(true).playlists[name]
+	if !ok {
+		return nil, notFound("no such playlist: %s", name)
+	}
+	return &apiPlaylist{name, p}, nil
+}
+
+// APIPlaylistChange applies the PlaylistChange in the body to the playlist
+// named by the name parameter, which is made if needed and removed if
+// empty, and returns it.
+func (srv *Server) APIPlaylistChange(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	var plc PlaylistChange
+	if err := decode(body, &plc); err != nil {
+		return nil, err
+	}
+	name := ps.ByName("name")
+	err := srv.runChecked(cmdPlaylistChange{
+		plc:  plc,
+		name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p := srv.snapshot(true).playlists[name]
+	if p == nil {
+		p = PlaylistInfo{}
+	}
+	return &apiPlaylist{name, p}, nil
+}
+
+// APIPlaylistDelete removes the playlist named by the name parameter.
+func (srv *Server) APIPlaylistDelete(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	name := ps.ByName("name")
+	if _, ok := srv.snapshot(true).playlists[name]; !ok {
+		return nil, notFound("no such playlist: %s", name)
+	}
+	return nil, srv.runChecked(cmdPlaylistChange{
+		plc:  PlaylistChange{{"clear"}},
+		name: name,
+	})
+}
+
+// apiSource is an added protocol instance. Progress is set while it is
+// being refreshed.
+type apiSource struct {
+	Protocol string
+	Key      string
+	Progress *Progress
+}
+
+// apiSources are the added protocol instances and the parameters of the
+// protocols that can be added.
+type apiSources struct {
+	Available map[string]protocol.Params
+	Sources   []apiSource
+}
+
+func (srv *Server) sources() *apiSources {
+	ch := make(chan *waitData)
+	srv.ch <- cmdWaitData{
+		wt:   waitProtocols,
+		done: ch,
+	}
+	p := (<-ch).Data.(*Protocols)
+	s := &apiSources{
+		Available: p.Available,
+		Sources:   []apiSource{},
+	}
+	for name, keys := range p.Current {
+		for _, key := range keys {
+			id := codec.NewID(name, key)
+			s.Sources = append(s.Sources, apiSource{name, key, p.InProgress[id]})
+			delete(p.InProgress, id)
+		}
+	}
+	// The rest are being added.
+	for id, pr := range p.InProgress {
+		name, key := id.Pop()
+		s.Sources = append(s.Sources, apiSource{name, string(key), pr})
+	}
+	slice.Sort(s.Sources, func(i, j int) bool {
+		a, b := s.Sources[i], s.Sources[j]
+		if a.Protocol != b.Protocol {
+			return a.Protocol < b.Protocol
+		}
+		return a.Key < b.Key
+	})
+	return s
+}
+
+// APISources returns the sources.
+func (srv *Server) APISources(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	return srv.sources(), nil
+}
+
+// APISourceAdd adds a source from the Protocol and Params in the body, and
+// returns the sources. It is refreshed in the background.
+func (srv *Server) APISourceAdd(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	var ap struct {
+		Protocol string
+		Params   []string
+	}
+	if err := decode(body, &ap); err != nil {
+		return nil, err
+	}
+	prot, err := protocol.ByName(ap.Protocol)
+	if err != nil {
+		return nil, badRequest("%v", err)
+	}
+	inst, err := prot.NewInstance(ap.Params, nil)
+	if err != nil {
+		return nil, badRequest("%v", err)
+	}
+	err = srv.runChecked(cmdProtocolAdd{
+		Name:     ap.Protocol,
+		Instance: inst,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return srv.sources(), nil
+}
+
+// APISourceRemove removes the source named by the protocol and key
+// parameters.
+func (srv *Server) APISourceRemove(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	return nil, srv.runChecked(cmdProtocolRemove{
+		protocol: query.Get("protocol"),
+		key:      query.Get("key"),
+	})
+}
+
+// APISourceRefresh refreshes the source named by the protocol and key
+// parameters, and returns the sources once it is done.
+func (srv *Server) APISourceRefresh(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	ch := make(chan error, 1)
+	err := srv.runChecked(cmdProtocolRefresh{
+		protocol: query.Get("protocol"),
+		key:      query.Get("key"),
+		doDelete: true,
+		err:      ch,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := <-ch; err != nil {
+		return nil, err
+	}
+	return srv.sources(), nil
+}
+
+// APITracks returns the library in pages, like Library.
+func (srv *Server) APITracks(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	return srv.Library(body, query, ps)
+}
+
+// APITrack returns the track with the uid parameter.
+func (srv *Server) APITrack(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	id := SongID(query.Get("uid"))
+	ch := make(chan *codec.SongInfo)
+	srv.ch <- cmdTrack{id, ch}
+	info := <-ch
+	if info == nil {
+		return nil, notFound("no such track: %q", id)
+	}
+	return &listItem{id, info}, nil
+}
+
+// APISearch searches the library, like Search.
+func (srv *Server) APISearch(body io.Reader, query url.Values, ps httprouter.Params) (interface{}, error) {
+	// Only a bad query is the client's fault.
+	if _, err := parseQuery(query.Get("q")); err != nil {
+		return nil, badRequest("%v", err)
+	}
+	return srv.Search(body, query, ps)
+}
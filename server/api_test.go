@@ -0,0 +1,229 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+)
+
+func TestAPIJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		result interface{}
+		err    error
+		status int
+		body   string
+	}{
+		{"value", map[string]int{"A": 1}, nil, http.StatusOK, `{"A":1}`},
+		{"no result", nil, nil, http.StatusNoContent, ""},
+		{"bad request", nil, badRequest("bad %s", "thing"), http.StatusBadRequest, `{"Status":400,"Message":"bad thing"}`},
+		{"not found", nil, notFound("no %d", 3), http.StatusNotFound, `{"Status":404,"Message":"no 3"}`},
+		{"conflict", nil, conflict("busy"), http.StatusConflict, `{"Status":409,"Message":"busy"}`},
+		{"other error", nil, fmt.Errorf("broken"), http.StatusInternalServerError, `{"Status":500,"Message":"broken"}`},
+		{"error and result", "ignored", notFound("gone"), http.StatusNotFound, `{"Status":404,"Message":"gone"}`},
+	}
+	for _, test := range tests {
+		h := apiJSON(func(io.Reader, url.Values, httprouter.Params) (interface{}, error) {
+			return test.result, test.err
+		})
+		w := httptest.NewRecorder()
+		h(w, httptest.NewRequest("GET", "/api/v1/status", nil), nil)
+		if w.Code != test.status {
+			t.Errorf("%s: got status %d, want %d", test.name, w.Code, test.status)
+		}
+		if got := w.Body.String(); got != test.body {
+			t.Errorf("%s: got body %s, want %s", test.name, got, test.body)
+		}
+		if ct := w.Header().Get("Content-Type"); test.body != "" && ct != "application/json" {
+			t.Errorf("%s: got content type %q", test.name, ct)
+		}
+	}
+}
+
+func TestAPIJSONRequest(t *testing.T) {
+	// Form bodies are passed as they are, not parsed, and parameters are
+	// only from the query.
+	var body string
+	var query url.Values
+	h := apiJSON(func(r io.Reader, q url.Values, ps httprouter.Params) (interface{}, error) {
+		b, _ := ioutil.ReadAll(r)
+		body, query = string(b), q
+		return nil, nil
+	})
+	r := httptest.NewRequest("POST", "/api/v1/sources/refresh?protocol=file", strings.NewReader("key=a"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	h(httptest.NewRecorder(), r, nil)
+	if body != "key=a" || query.Get("protocol") != "file" || query.Get("key") != "" {
+		t.Fatalf("got body %q, query %v", body, query)
+	}
+}
+
+func TestAPIDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{`"1m30s"`, 90 * time.Second, true},
+		{`"-2s"`, -2 * time.Second, true},
+		{`1500000000`, 1500 * time.Millisecond, true},
+		{`0`, 0, true},
+		{`"90"`, 0, false},
+		{`1.5`, 0, false},
+		{`true`, 0, false},
+	}
+	for _, test := range tests {
+		var d apiDuration
+		err := json.Unmarshal([]byte(test.in), &d)
+		if ok := err == nil; ok != test.ok || ok && time.Duration(d) != test.want {
+			t.Errorf("%s: got %v, %v, want %v", test.in, time.Duration(d), err, test.want)
+		}
+	}
+}
+
+// status returns the HTTP status of err: 0 if nil and 500 if it is not an
+// apiError.
+func status(err error) int {
+	if err == nil {
+		return 0
+	}
+	if e, ok := err.(*apiError); ok {
+		return e.Status
+	}
+	return http.StatusInternalServerError
+}
+
+func TestAPISearchQuery(t *testing.T) {
+	srv := new(Server)
+	_, err := srv.APISearch(nil, url.Values{"q": {"year:soon"}}, nil)
+	if status(err) != http.StatusBadRequest || !strings.Contains(fmt.Sprint(err), `bad filter "year:soon"`) {
+		t.Fatalf("got %v (%d)", err, status(err))
+	}
+}
+
+func TestAPICmdArgs(t *testing.T) {
+	// These fail before the command is run.
+	tests := []struct {
+		cmd, body string
+		status    int
+		message   string
+	}{
+		{"nothing", "", http.StatusNotFound, "unknown command: nothing"},
+		{"play", "{", http.StatusBadRequest, "bad request body"},
+		{"play", `{"Index": "one"}`, http.StatusBadRequest, "bad request body"},
+		{"seek", `{"Position": "soon"}`, http.StatusBadRequest, "bad request body"},
+		{"play_idx", "", http.StatusBadRequest, "missing argument: Index"},
+		{"play_track", "{}", http.StatusBadRequest, "missing argument: UID"},
+		{"seek", `{"Duration": "1s"}`, http.StatusBadRequest, "missing argument: Position"},
+		{"volume", "", http.StatusBadRequest, "missing argument: Volume"},
+		{"crossfade", "", http.StatusBadRequest, "missing argument: Duration"},
+		{"replaygain", "", http.StatusBadRequest, "missing argument: Mode"},
+		{"min_duration", "", http.StatusBadRequest, "missing argument: Duration"},
+	}
+	srv := new(Server)
+	for _, test := range tests {
+		ps := httprouter.Params{{Key: "cmd", Value: test.cmd}}
+		_, err := srv.APICmd(strings.NewReader(test.body), nil, ps)
+		if status(err) != test.status || !strings.Contains(fmt.Sprint(err), test.message) {
+			t.Errorf("%s %s: got %v (%d), want %q (%d)", test.cmd, test.body, err, status(err), test.message, test.status)
+		}
+	}
+}
+
+// testSong is a codec.Song without samples.
+type testSong struct{}
+
+func (testSong) Info() (codec.SongInfo, error) { return codec.SongInfo{}, nil }
+func (testSong) Init() (int, int, error)       { return 44100, 2, nil }
+func (testSong) Play(n int) ([]float32, error) { return nil, nil }
+func (testSong) Close()                        {}
+
+func TestCheck(t *testing.T) {
+	song := SongID(codec.NewID("file", "test", "1"))
+	missing := SongID(codec.NewID("file", "test", "2"))
+	inst := testInstance{"1": {Title: "one"}}
+	srv := &Server{
+		Protocols: map[string]map[string]protocol.Instance{
+			"file": {"test": inst},
+		},
+		Queue:      Playlist{song},
+		Playlists:  map[string]Playlist{"list": {song, song}},
+		inprogress: make(map[codec.ID]*Progress),
+	}
+	srv.inprogress[codec.NewID("file", "busy")] = new(Progress)
+	srv.Protocols["file"]["busy"] = inst
+
+	tests := []struct {
+		name   string
+		cmd    interface{}
+		status int
+	}{
+		{"play", cmdPlay, 0},
+		{"play index", cmdPlayIdx(0), 0},
+		{"play bad index", cmdPlayIdx(1), http.StatusNotFound},
+		{"play negative index", cmdPlayIdx(-1), http.StatusNotFound},
+		{"play track", cmdPlayTrack(song), 0},
+		{"play missing track", cmdPlayTrack(missing), http.StatusNotFound},
+		{"play unknown protocol", cmdPlayTrack(codec.NewID("none", "test", "1")), http.StatusNotFound},
+		{"seek stopped", cmdSeek(time.Second), http.StatusConflict},
+		{"volume", cmdVolume(0.5), 0},
+		{"volume zero", cmdVolume(0), 0},
+		{"volume high", cmdVolume(1.5), http.StatusBadRequest},
+		{"volume negative", cmdVolume(-0.1), http.StatusBadRequest},
+		{"crossfade", cmdCrossfade(time.Second), 0},
+		{"crossfade negative", cmdCrossfade(-time.Second), http.StatusBadRequest},
+		{"min duration negative", cmdMinDuration(-time.Second), http.StatusBadRequest},
+		{"replaygain", cmdReplayGain("album"), 0},
+		{"replaygain unknown", cmdReplayGain("loud"), http.StatusBadRequest},
+		{"queue add", cmdQueueChange{{"add", string(song)}}, 0},
+		{"queue add missing", cmdQueueChange{{"add", string(missing)}}, http.StatusNotFound},
+		{"queue add nothing", cmdQueueChange{{"add"}}, http.StatusBadRequest},
+		{"queue remove", cmdQueueChange{{"rem", "0"}}, 0},
+		{"queue remove bad index", cmdQueueChange{{"rem", "1"}}, http.StatusBadRequest},
+		{"queue empty change", cmdQueueChange{{}}, http.StatusBadRequest},
+		{"queue unknown change", cmdQueueChange{{"shuffle"}}, http.StatusBadRequest},
+		{"playlist remove", cmdPlaylistChange{PlaylistChange{{"rem", "1"}}, "list"}, 0},
+		{"playlist remove bad index", cmdPlaylistChange{PlaylistChange{{"rem", "2"}}, "list"}, http.StatusBadRequest},
+		{"new playlist", cmdPlaylistChange{PlaylistChange{{"add", string(song)}}, "new"}, 0},
+		{"source add", cmdProtocolAdd{"file", testInstance{}}, http.StatusConflict},
+		{"source remove", cmdProtocolRemove{"file", "test"}, 0},
+		{"source remove missing", cmdProtocolRemove{"file", "other"}, http.StatusNotFound},
+		{"source remove unknown protocol", cmdProtocolRemove{"none", "test"}, http.StatusNotFound},
+		{"refresh", cmdProtocolRefresh{protocol: "file", key: "test"}, 0},
+		{"refresh missing", cmdProtocolRefresh{protocol: "file", key: "other"}, http.StatusNotFound},
+		{"refresh busy", cmdProtocolRefresh{protocol: "file", key: "busy"}, http.StatusConflict},
+	}
+	for _, test := range tests {
+		if err := srv.check(test.cmd); status(err) != test.status {
+			t.Errorf("%s: got %v (%d), want %d", test.name, err, status(err), test.status)
+		}
+	}
+
+	// Seeking needs a position within the playing song.
+	srv.song = testSong{}
+	srv.info.Time = time.Minute
+	for _, test := range []struct {
+		pos    time.Duration
+		status int
+	}{
+		{0, 0},
+		{time.Minute, 0},
+		{-time.Second, http.StatusBadRequest},
+		{time.Minute + time.Second, http.StatusBadRequest},
+	} {
+		if err := srv.check(cmdSeek(test.pos)); status(err) != test.status {
+			t.Errorf("seek %v: got %v (%d), want %d", test.pos, err, status(err), test.status)
+		}
+	}
+}
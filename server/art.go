@@ -0,0 +1,283 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/julienschmidt/httprouter"
+)
+
+// dbArt is the bucket of pictures, keyed by the hex SHA-1 of their data, and
+// thumbnails of them, keyed by hash and size.
+const dbArt = "art"
+
+// artPath is the URL path of stored pictures.
+const artPath = "/api/art/"
+
+// storeArt stores a picture and returns its URL. It is codec.ArtStore.
+func (srv *Server) storeArt(mimeType string, data []byte) string {
+	sum := sha1.Sum(data)
+	hash := hex.EncodeToString(sum[:])
+	key := []byte(hash)
+	var found bool
+	srv.db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket([]byte(dbArt)); b != nil {
+			found = b.Get(key) != nil
+		}
+		return nil
+	})
+	if !found {
+		err := srv.db.Update(func(tx *bolt.Tx) error {
+			b, err := tx.CreateBucketIfNotExists([]byte(dbArt))
+			if err != nil {
+				return err
+			}
+			return b.Put(key, artValue(mimeType, data))
+		})
+		if err != nil {
+			log.Println("store art:", err)
+		}
+	}
+	return artPath + hash
+}
+
+// artValue encodes a stored picture as its MIME type, a newline, and data.
+func artValue(mimeType string, data []byte) []byte {
+	return append([]byte(mimeType+"\n"), data...)
+}
+
+// loadArt returns the MIME type and data stored at key.
+func (srv *Server) loadArt(key string) (mimeType string, data []byte) {
+	srv.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(dbArt))
+		if b == nil {
+			return nil
+		}
+		v := b.Get([]byte(key))
+		if i := bytes.IndexByte(v, '\n'); i >= 0 {
+			mimeType = string(v[:i])
+			// v is only valid during the transaction.
+			data = append([]byte(nil), v[i+1:]...)
+		}
+		return nil
+	})
+	return
+}
+
+// migrateArt moves data URLs in song info, from before pictures were stored,
+// into the store. It reports whether any song info changed.
+func (srv *Server) migrateArt() bool {
+	changed := false
+	for _, protos := range srv.Protocols {
+		for _, inst := range protos {
+			sl, _ := inst.List()
+			for _, info := range sl {
+				if info == nil {
+					continue
+				}
+				mimeType, data, ok := parseDataURL(info.ImageURL)
+				if !ok {
+					continue
+				}
+				info.ImageURL = srv.storeArt(mimeType, data)
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// parseDataURL returns the MIME type and data of a base64 data URL.
+func parseDataURL(s string) (mimeType string, data []byte, ok bool) {
+	if !strings.HasPrefix(s, "data:") {
+		return "", nil, false
+	}
+	i := strings.Index(s, ";base64,")
+	if i < 0 {
+		return "", nil, false
+	}
+	data, err := base64.StdEncoding.DecodeString(s[i+len(";base64,"):])
+	if err != nil {
+		return "", nil, false
+	}
+	return s[len("data:"):i], data, true
+}
+
+// collectArt deletes the stored pictures that no song refers to, with their
+// thumbnails, and thumbnails of sizes no longer made. It must not run during
+// a refresh, whose songs may refer to pictures before they are listed.
+func (srv *Server) collectArt() error {
+	used := make(map[string]bool)
+	for _, protos := range srv.Protocols {
+		for _, inst := range protos {
+			sl, err := inst.List()
+			if err != nil {
+				return err
+			}
+			for _, info := range sl {
+				if info != nil && strings.HasPrefix(info.ImageURL, artPath) {
+					used[strings.TrimPrefix(info.ImageURL, artPath)] = true
+				}
+			}
+		}
+	}
+	var keys [][]byte
+	err := srv.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(dbArt))
+		if b == nil {
+			return nil
+		}
+		b.ForEach(func(k, v []byte) error {
+			hash, size := string(k), ""
+			if i := strings.IndexByte(hash, '/'); i >= 0 {
+				hash, size = hash[:i], hash[i+1:]
+			}
+			if !used[hash] || (size != "" && !isThumbnailSize(size)) {
+				keys = append(keys, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil && len(keys) > 0 {
+		log.Printf("deleted %d unused pictures", len(keys))
+	}
+	return err
+}
+
+// Art serves a stored picture. The size parameter, if set, scales it to fit
+// in a square of that many pixels, rounded up to one of thumbnailSizes.
+func (srv *Server) Art(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	hash := ps.ByName("hash")
+	size, _ := strconv.Atoi(r.FormValue("size"))
+	key := hash
+	if size > 0 {
+		size = thumbnailSize(size)
+		key += "/" + strconv.Itoa(size)
+	}
+	etag := `"` + key + `"`
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	mimeType, data := srv.loadArt(key)
+	if data == nil && size > 0 {
+		mimeType, data = srv.loadArt(hash)
+		if data == nil {
+			http.NotFound(w, r)
+			return
+		}
+		if m, d, err := thumbnail(data, size); err != nil {
+			log.Println("thumbnail:", err)
+		} else {
+			mimeType, data = m, d
+			err := srv.db.Update(func(tx *bolt.Tx) error {
+				return tx.Bucket([]byte(dbArt)).Put([]byte(key), artValue(mimeType, data))
+			})
+			if err != nil {
+				log.Println("store thumbnail:", err)
+			}
+		}
+	}
+	if data == nil {
+		http.NotFound(w, r)
+		return
+	}
+	// Pictures are addressed by content, so they never change.
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("ETag", etag)
+	if mimeType != "" {
+		w.Header().Set("Content-Type", mimeType)
+	}
+	w.Write(data)
+}
+
+// thumbnailSizes are the sizes, in increasing order, that thumbnails are
+// made and stored in.
+var thumbnailSizes = []int{64, 128, 256, 512}
+
+// thumbnailSize returns the smallest thumbnail size of at least size, or the
+// largest one.
+func thumbnailSize(size int) int {
+	for _, s := range thumbnailSizes {
+		if size <= s {
+			return s
+		}
+	}
+	return thumbnailSizes[len(thumbnailSizes)-1]
+}
+
+// isThumbnailSize reports whether s, from a thumbnail key, is one of
+// thumbnailSizes.
+func isThumbnailSize(s string) bool {
+	size, err := strconv.Atoi(s)
+	return err == nil && size > 0 && thumbnailSize(size) == size
+}
+
+// thumbnail scales a picture down to fit in a size by size square,
+// averaging the source pixels that cover each destination pixel. Pictures
+// that already fit are returned as they are.
+func thumbnail(data []byte, size int) (string, []byte, error) {
+	src, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, err
+	}
+	sb := src.Bounds()
+	sw, sh := sb.Dx(), sb.Dy()
+	if sw <= size && sh <= size {
+		return "image/" + format, data, nil
+	}
+	dw, dh := size, size
+	if sw > sh {
+		dh = sh * size / sw
+	} else {
+		dw = sw * size / sh
+	}
+	if dw < 1 {
+		dw = 1
+	}
+	if dh < 1 {
+		dh = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := sb.Min.Y+y*sh/dh, sb.Min.Y+(y+1)*sh/dh
+		for x := 0; x < dw; x++ {
+			x0, x1 := sb.Min.X+x*sw/dw, sb.Min.X+(x+1)*sw/dw
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{uint16(r / n), uint16(g / n), uint16(b / n), uint16(a / n)})
+		}
+	}
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85})
+	} else {
+		format = "png"
+		err = png.Encode(&buf, dst)
+	}
+	return "image/" + format, buf.Bytes(), err
+}
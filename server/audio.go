@@ -4,17 +4,66 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"time"
 
+	"github.com/mjibson/moggio/codec"
 	"github.com/mjibson/moggio/output"
 )
 
 func (srv *Server) audio() {
-	var out output.Output
+	var out output.Output = srv.outputs
 	var t chan interface{}
 	var seek *Seek
-	var dur time.Duration
+	// song is the song being played; info describes it. queued, if set, is
+	// played when song ends.
+	var song codec.Song
+	var info codec.SongInfo
+	var queued *preloadSong
+	// fade, if set, is mixing the start of queued, read through queued.seek,
+	// into the end of song.
+	crossfade := srv.Crossfade
+	var fade *fader
+	var sr int
 	var err error
+	// gain is the current gain. It is ramped toward volume, the product of
+	// the user's level and the song's ReplayGain, over rampTime to avoid
+	// clicks.
+	level := srv.gain()
+	rgMode := srv.ReplayGain
+	var rg float32 = 1
+	volume := level
+	gain := volume
+	setVolume := func() {
+		rg = replayGain(rgMode, info)
+		volume = level * rg
+	}
+	var channels int
+	var step float32
+	applyGain := func(samples []float32) []float32 {
+		if gain == 1 && volume == 1 {
+			return samples
+		}
+		// samples may be owned by the codec, so don't modify it.
+		b := make([]float32, len(samples))
+		for i := 0; i < len(samples); i += channels {
+			if gain < volume {
+				gain += step
+				if gain > volume {
+					gain = volume
+				}
+			} else if gain > volume {
+				gain -= step
+				if gain < volume {
+					gain = volume
+				}
+			}
+			for j := i; j < i+channels && j < len(b); j++ {
+				b[j] = samples[j] * gain
+			}
+		}
+		return b
+	}
 	send := func(v interface{}) {
 		go func() {
 			srv.ch <- v
@@ -26,22 +75,145 @@ func (srv *Server) audio() {
 			force:    force,
 		})
 	}
+	// conv, if set, converts from the song's format to the outputs' format,
+	// which is fixed so that the outputs are only opened once.
+	var conv *output.Converter
+	setFormat := func(rate, ch int) error {
+		if rate <= 0 || ch <= 0 {
+			return fmt.Errorf("moggio: bad audio format (%v, %v)", rate, ch)
+		}
+		conv = nil
+		if outRate, outCh := srv.outputs.Format(); rate != outRate || ch != outCh {
+			c, err := output.NewConverter(rate, ch, outRate, outCh)
+			if err != nil {
+				return err
+			}
+			conv = c
+		}
+		sr = rate
+		channels = ch
+		// Ramp the full range over rampTime.
+		step = float32(time.Second) / float32(rampTime) / float32(rate)
+		return nil
+	}
+	// canFade returns whether song should crossfade into queued.
+	canFade := func() bool {
+		return crossfade > 0 && info.Time > 0 && queued.info.Time > 0 &&
+			queued.sr > 0 && queued.ch > 0 &&
+			(info.Album == "" || queued.info.Album != info.Album)
+	}
+	startFade := func() error {
+		var conv *output.Converter
+		if queued.sr != sr || queued.ch != channels {
+			var err error
+			conv, err = output.NewConverter(queued.sr, queued.ch, sr, channels)
+			if err != nil {
+				return err
+			}
+		}
+		if queued.seek == nil {
+			queued.seek = NewSeek(true, queued.sr, queued.ch, queued.song)
+		}
+		d := info.Time - seek.Pos()
+		if d > crossfade {
+			d = crossfade
+		}
+		fade = &fader{
+			seek:  queued.seek,
+			conv:  conv,
+			inCh:  queued.ch,
+			ch:    channels,
+			total: int(int64(d) * int64(sr) / int64(time.Second)),
+			// The incoming song is later scaled by the outgoing song's
+			// ReplayGain, so compensate for the difference.
+			gain: replayGain(rgMode, queued.info) / rg,
+		}
+		return nil
+	}
+	// stopFade cancels any fade and rewinds queued so that it can start over.
+	stopFade := func() {
+		fade = nil
+		if queued != nil && queued.seek != nil {
+			if err := queued.seek.Seek(0); err != nil {
+				log.Println("could not rewind queued song:", err)
+			}
+		}
+	}
+	// unqueue removes queued and returns it. A fade that has begun goes on
+	// without it, so that song keeps fading out instead of jumping back to
+	// full volume, and then ends.
+	unqueue := func() *preloadSong {
+		f := fade
+		stopFade()
+		if f != nil && f.pos > 0 {
+			f.seek, f.conv, f.buf = nil, nil, nil
+			fade = f
+		}
+		q := queued
+		queued = nil
+		return q
+	}
+	// advance moves on to queued, continuing from where the fade, if any, got
+	// to. It reports whether the move happened.
+	advance := func() bool {
+		if queued.sr != sr || queued.ch != channels {
+			if err := setFormat(queued.sr, queued.ch); err != nil {
+				stopFade()
+				send(cmdError(err))
+				return false
+			}
+		}
+		seek = queued.seek
+		if seek == nil {
+			seek = NewSeek(queued.info.Time > 0, sr, channels, queued.song)
+		}
+		send(cmdGapless{
+			from: song,
+			next: queued,
+		})
+		song, info = queued.song, queued.info
+		setVolume()
+		if fade != nil {
+			// The fade already applied the new song's ReplayGain.
+			gain = volume
+		}
+		queued, fade = nil, nil
+		return true
+	}
 	tick := func() {
 		const expected = 4096
 		if seek == nil {
 			return
 		}
 		next, err := seek.Read(expected)
+		if fade == nil && queued != nil && err == nil && canFade() && seek.Pos() >= info.Time-crossfade {
+			if err := startFade(); err != nil {
+				send(cmdError(err))
+			}
+		}
+		faded := false
+		if fade != nil && len(next) > 0 {
+			next, faded = fade.mix(next)
+		}
 		if len(next) > 0 {
-			out.Push(next)
+			b := applyGain(next)
+			if conv != nil {
+				b = conv.Convert(b)
+			}
+			out.Push(b)
 			setTime(false)
 		}
-		if err != nil {
-			seek = nil
-		}
+		// A short read is the end of the song, as is an error.
+		end := err != nil || len(next) < expected || faded
 		if err == io.ErrUnexpectedEOF {
+			seek = nil
+			stopFade()
 			send(cmdRestartSong)
-		} else if err != nil {
+		} else if end && queued != nil && advance() {
+			// Continued straight into the next song.
+		} else if end {
+			seek = nil
+			stopFade()
 			send(cmdNext)
 		}
 	}
@@ -54,16 +226,24 @@ func (srv *Server) audio() {
 			send(cmdError(err))
 			return
 		}
+		// Start any fade again later from the beginning of the next song.
+		stopFade()
 		setTime(true)
 	}
 	setParams := func(c audioSetParams) {
-		out, err = output.Get(c.sr, c.ch)
-		if err != nil {
-			c.err <- fmt.Errorf("moggio: could not open audio (%v, %v): %v", c.sr, c.ch, err)
+		if err = setFormat(c.sr, c.ch); err != nil {
+			c.err <- err
 			return
 		}
-		dur = time.Second / (time.Duration(c.sr * c.ch))
-		seek = NewSeek(c.dur > 0, dur, c.play)
+		seek = c.seek
+		if seek == nil {
+			seek = NewSeek(c.info.Time > 0, sr, channels, c.song)
+		}
+		song, info = c.song, c.info
+		setVolume()
+		gain = volume
+		fade = nil
+		out.Start()
 		t = make(chan interface{})
 		close(t)
 		c.err <- nil
@@ -84,6 +264,18 @@ func (srv *Server) audio() {
 				setParams(c)
 			case cmdSeek:
 				doSeek(c)
+			case audioVolume:
+				level = float32(c)
+				setVolume()
+			case audioReplayGain:
+				rgMode = string(c)
+				setVolume()
+			case audioCrossfade:
+				crossfade = time.Duration(c)
+			case audioQueue:
+				queued = (*preloadSong)(c)
+			case audioUnqueue:
+				c <- unqueue()
 			default:
 				panic("unknown type")
 			}
@@ -91,14 +283,149 @@ func (srv *Server) audio() {
 	}
 }
 
+// fader mixes the start of one song into the end of another with an equal
+// power crossfade.
+type fader struct {
+	// seek reads the incoming song, or is nil to only fade out.
+	seek *Seek
+	// conv, if set, converts the incoming song's format, which has inCh
+	// channels, to the current format, which has ch channels.
+	conv     *output.Converter
+	inCh, ch int
+	// buf holds incoming samples that have been read but not mixed.
+	buf []float32
+	// pos is the number of frames mixed of total.
+	pos, total int
+	// gain scales the incoming song.
+	gain float32
+}
+
+// mix returns samples mixed with the incoming song, and whether the fade is
+// complete.
+func (f *fader) mix(samples []float32) ([]float32, bool) {
+	for f.seek != nil && len(f.buf) < len(samples) {
+		n := len(samples) - len(f.buf)
+		if f.conv != nil {
+			n = (int(float64(n/f.ch)*f.conv.Ratio()) + 1) * f.inCh
+		}
+		b, err := f.seek.Read(n)
+		short := len(b) < n
+		if f.conv != nil {
+			b = f.conv.Convert(b)
+		}
+		f.buf = append(f.buf, b...)
+		if err != nil || short {
+			// The incoming song is shorter than the fade; mix silence.
+			break
+		}
+	}
+	r := make([]float32, len(samples))
+	for i := 0; i < len(samples); i += f.ch {
+		x := 1.0
+		if f.pos < f.total {
+			x = float64(f.pos) / float64(f.total)
+		}
+		gOut := float32(math.Cos(x * math.Pi / 2))
+		gIn := float32(math.Sin(x * math.Pi / 2))
+		for j := i; j < i+f.ch && j < len(r); j++ {
+			var in float32
+			if j < len(f.buf) {
+				in = f.buf[j]
+			}
+			r[j] = samples[j]*gOut + in*gIn*f.gain
+		}
+		f.pos++
+	}
+	if len(f.buf) > len(samples) {
+		f.buf = f.buf[len(samples):]
+	} else {
+		f.buf = nil
+	}
+	return r, f.pos >= f.total
+}
+
 type audioSetParams struct {
 	sr   int
 	ch   int
-	dur  time.Duration
-	play func(int) ([]float32, error)
+	info codec.SongInfo
+	song codec.Song
+	// seek, if set, is used to read song instead of a new Seek.
+	seek *Seek
 	err  chan error
 }
 
+// audioQueue sets the song to play without a gap when the current one ends.
+type audioQueue *preloadSong
+
+// audioUnqueue removes the queued song and sends it, or nil if it has already
+// started playing, on the channel.
+type audioUnqueue chan *preloadSong
+
+// rampTime is how long it takes to fade between volume levels.
+const rampTime = time.Millisecond * 50
+
+// audioCrossfade sets the crossfade duration.
+type audioCrossfade time.Duration
+
+// audioVolume sets the playback gain, from 0 to 1.
+type audioVolume float32
+
+// audioReplayGain sets the ReplayGain mode.
+type audioReplayGain string
+
 type audioStop struct{}
 
 type audioPlay struct{}
+
+// volume returns the playback volume, from 0 to 1.
+func (srv *Server) volume() float64 {
+	return 1 - srv.Attenuation
+}
+
+// gain returns the playback gain for the current volume and mute settings.
+func (srv *Server) gain() float32 {
+	if srv.Mute {
+		return 0
+	}
+	return float32(srv.volume())
+}
+
+// replayGain returns the gain to apply to the song described by si in the
+// given ReplayGain mode. It falls back to album gain in track mode and vice
+// versa, and is limited so the peak does not clip.
+func replayGain(mode string, si codec.SongInfo) float32 {
+	gain, peak := si.TrackGain, si.TrackPeak
+	alt, altPeak := si.AlbumGain, si.AlbumPeak
+	switch mode {
+	case "track":
+	case "album":
+		gain, peak, alt, altPeak = alt, altPeak, gain, peak
+	default:
+		return 1
+	}
+	if gain == 0 && peak == 0 {
+		gain, peak = alt, altPeak
+	}
+	f := math.Pow(10, gain/20)
+	if peak > 0 && f*peak > 1 {
+		f = 1 / peak
+	}
+	return float32(f)
+}
+
+// setStreamTitle updates the ICY title of the HTTP stream output, if enabled,
+// from the current song info.
+func (srv *Server) setStreamTitle() {
+	s := output.HTTPStream()
+	if s == nil {
+		return
+	}
+	title := srv.info.SongTitle
+	if title == "" {
+		title = srv.info.Title
+		if srv.info.Artist != "" {
+			title = srv.info.Artist + " - " + title
+		}
+	}
+	s.SetTitle(title)
+}
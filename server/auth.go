@@ -0,0 +1,567 @@
+package server
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/julienschmidt/httprouter"
+)
+
+// Authentication is off until a password is set or an API token is added.
+// Then requests must carry a session cookie, from logging in with either, or
+// a token as a bearer token or access_token parameter, and are allowed by
+// the role of it.
+
+type role int
+
+const (
+	roleNone role = iota
+	// roleRead may view status, the library and playlists.
+	roleRead
+	// roleAdmin may also control playback and change the queue, playlists,
+	// sources, outputs and authentication.
+	roleAdmin
+)
+
+var roleNames = []string{
+	roleNone:  "",
+	roleRead:  "read",
+	roleAdmin: "admin",
+}
+
+func (r role) String() string {
+	return roleNames[r]
+}
+
+func (r role) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *role) UnmarshalText(b []byte) error {
+	for i, name := range roleNames {
+		if name != "" && name == string(b) {
+			*r = role(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown role: %q", b)
+}
+
+// dbAuth is the bucket of the password, tokens, sessions and CSRF key.
+// Tokens and sessions are keyed by a prefix and the hex SHA-256 of their
+// secret, which is not stored.
+const dbAuth = "auth"
+
+const (
+	authPassword      = "password"
+	authCSRFKey       = "csrf"
+	authTokenPrefix   = "token/"
+	authSessionPrefix = "session/"
+)
+
+const (
+	sessionCookie = "moggio_session"
+	sessionAge    = time.Hour * 24 * 30
+	// passwordIterations is the PBKDF2 iteration count of password hashes.
+	passwordIterations = 50000
+)
+
+type authToken struct {
+	Name    string
+	Role    role
+	Created time.Time
+}
+
+type authSession struct {
+	Role    role
+	Expires time.Time
+	// Token is the name of the token logged in with, if any, so that its
+	// sessions end when it is removed.
+	Token string `json:",omitempty"`
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func newSecret() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// pbkdf2 derives a key from password and salt with PBKDF2-HMAC-SHA256.
+func pbkdf2(password, salt []byte, iterations, size int) []byte {
+	prf := hmac.New(sha256.New, password)
+	var key []byte
+	for block := uint32(1); len(key) < size; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		prf.Write([]byte{byte(block >> 24), byte(block >> 16), byte(block >> 8), byte(block)})
+		u := prf.Sum(nil)
+		t := append([]byte(nil), u...)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+	return key[:size]
+}
+
+// authGet returns a copy of the value at key in the auth bucket.
+func (srv *Server) authGet(key string) []byte {
+	var v []byte
+	srv.db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket([]byte(dbAuth)); b != nil {
+			v = append(v, b.Get([]byte(key))...)
+		}
+		return nil
+	})
+	return v
+}
+
+func (srv *Server) authUpdate(f func(b *bolt.Bucket) error) error {
+	return srv.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(dbAuth))
+		if err != nil {
+			return err
+		}
+		return f(b)
+	})
+}
+
+// authEnabled reports whether a password or a token is set.
+func (srv *Server) authEnabled() bool {
+	var enabled bool
+	srv.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(dbAuth))
+		if b == nil {
+			return nil
+		}
+		if b.Get([]byte(authPassword)) != nil {
+			enabled = true
+			return nil
+		}
+		k, _ := b.Cursor().Seek([]byte(authTokenPrefix))
+		enabled = bytes.HasPrefix(k, []byte(authTokenPrefix))
+		return nil
+	})
+	return enabled
+}
+
+// checkSecret returns the role of a password or token, and the name of the
+// token.
+func (srv *Server) checkSecret(secret string) (role, string) {
+	if secret == "" {
+		return roleNone, ""
+	}
+	if v := srv.authGet(authPassword); len(v) > 16 {
+		key := pbkdf2([]byte(secret), v[:16], passwordIterations, len(v)-16)
+		if subtle.ConstantTimeCompare(key, v[16:]) == 1 {
+			return roleAdmin, ""
+		}
+	}
+	if t, err := srv.tokenByHash(hashSecret(secret)); err == nil {
+		return t.Role, t.Name
+	}
+	return roleNone, ""
+}
+
+// requestRole returns the role of r: admin if authentication is off, else
+// that of its token or session.
+func (srv *Server) requestRole(r *http.Request) role {
+	if !srv.authEnabled() {
+		return roleAdmin
+	}
+	if token := requestToken(r); token != "" {
+		if t, err := srv.tokenByHash(hashSecret(token)); err == nil {
+			return t.Role
+		}
+	}
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		var s authSession
+		key := authSessionPrefix + hashSecret(c.Value)
+		if v := srv.authGet(key); v != nil && json.Unmarshal(v, &s) == nil {
+			if time.Now().Before(s.Expires) {
+				return s.Role
+			}
+			srv.authUpdate(func(b *bolt.Bucket) error {
+				return b.Delete([]byte(key))
+			})
+		}
+	}
+	return roleNone
+}
+
+// requestToken returns the API token of r, from its Authorization header or
+// access_token parameter, or "" if it has none.
+func requestToken(r *http.Request) string {
+	if h := r.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return strings.TrimPrefix(h, "Bearer ")
+	}
+	return r.URL.Query().Get("access_token")
+}
+
+// csrfToken returns the csrf parameter that GET requests which change state
+// need: an HMAC of the session cookie of r with the server's CSRF key, so
+// that other sites cannot know it.
+func (srv *Server) csrfToken(r *http.Request) string {
+	key := srv.authGet(authCSRFKey)
+	if key == nil {
+		err := srv.authUpdate(func(b *bolt.Bucket) error {
+			if v := b.Get([]byte(authCSRFKey)); v != nil {
+				key = append(key, v...)
+				return nil
+			}
+			key = []byte(newSecret())
+			return b.Put([]byte(authCSRFKey), key)
+		})
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	mac := hmac.New(sha256.New, key)
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		mac.Write([]byte(c.Value))
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// checkCSRF reports whether r carries the csrf parameter of its session.
+func (srv *Server) checkCSRF(r *http.Request) bool {
+	csrf := r.URL.Query().Get("csrf")
+	return subtle.ConstantTimeCompare([]byte(csrf), []byte(srv.csrfToken(r))) == 1
+}
+
+// requireCSRF returns h, a handler of GET requests that change state. With
+// authentication on, requests without a valid token need the csrf parameter,
+// since their session cookie goes with links from other sites too.
+func (srv *Server) requireCSRF(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if !srv.authEnabled() {
+			h(w, r, ps)
+			return
+		}
+		_, err := srv.tokenByHash(hashSecret(requestToken(r)))
+		if err != nil && !srv.checkCSRF(r) {
+			http.Error(w, "bad csrf token", http.StatusForbidden)
+			return
+		}
+		h(w, r, ps)
+	}
+}
+
+func (srv *Server) tokenByHash(hash string) (*authToken, error) {
+	v := srv.authGet(authTokenPrefix + hash)
+	if v == nil {
+		return nil, fmt.Errorf("unknown token")
+	}
+	t := new(authToken)
+	return t, json.Unmarshal(v, t)
+}
+
+// authorize returns h, allowed only to requests of at least role need.
+func (srv *Server) authorize(need role, h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		switch got := srv.requestRole(r); {
+		case got >= need:
+			h(w, r, ps)
+		case got == roleNone:
+			w.Header().Set("WWW-Authenticate", `Bearer realm="moggio"`)
+			http.Error(w, "authentication required", http.StatusUnauthorized)
+		default:
+			http.Error(w, fmt.Sprintf("%s role required", need), http.StatusForbidden)
+		}
+	}
+}
+
+// authorizeHandler is authorize for an http.Handler.
+func (srv *Server) authorizeHandler(need role, h http.Handler) http.Handler {
+	a := srv.authorize(need, func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+		h.ServeHTTP(w, r)
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a(w, r, nil)
+	})
+}
+
+// startSession stores a session of role and sets its cookie.
+func (srv *Server) startSession(w http.ResponseWriter, r *http.Request, ro role, token string) error {
+	secret := newSecret()
+	s := authSession{
+		Role:    ro,
+		Expires: time.Now().Add(sessionAge),
+		Token:   token,
+	}
+	v, err := json.Marshal(&s)
+	if err != nil {
+		return err
+	}
+	err = srv.authUpdate(func(b *bolt.Bucket) error {
+		return b.Put([]byte(authSessionPrefix+hashSecret(secret)), v)
+	})
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    secret,
+		Path:     PathPrefix + "/",
+		Expires:  s.Expires,
+		HttpOnly: true,
+		Secure:   r.TLS != nil || strings.HasPrefix(BaseURL, "https:"),
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// endSessions removes the sessions for which end returns true.
+func (srv *Server) endSessions(end func(s *authSession) bool) error {
+	return srv.authUpdate(func(b *bolt.Bucket) error {
+		var keys [][]byte
+		c := b.Cursor()
+		prefix := []byte(authSessionPrefix)
+		for k, v := c.Seek(prefix); bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var s authSession
+			if json.Unmarshal(v, &s) != nil || end(&s) {
+				keys = append(keys, append([]byte(nil), k...))
+			}
+		}
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func serveJSON(w http.ResponseWriter, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	w.Write(b)
+}
+
+type authStatus struct {
+	// Enabled is whether authentication is on.
+	Enabled bool
+	// Role is that of the request: "", "read" or "admin".
+	Role role
+	// CSRF is the csrf parameter of the request's session, needed by
+	// GET /api/token/register.
+	CSRF string `json:",omitempty"`
+}
+
+// Auth returns whether authentication is on, the role of the request and its
+// CSRF token.
+func (srv *Server) Auth(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	serveJSON(w, authStatus{
+		Enabled: srv.authEnabled(),
+		Role:    srv.requestRole(r),
+		CSRF:    srv.csrfToken(r),
+	})
+}
+
+// Login starts a session with the password or a token, given as Password.
+func (srv *Server) Login(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var req struct {
+		Password string
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ro, token := srv.checkSecret(req.Password)
+	if ro == roleNone {
+		// Slow down guessing.
+		time.Sleep(time.Second)
+		http.Error(w, "wrong password", http.StatusUnauthorized)
+		return
+	}
+	if err := srv.startSession(w, r, ro, token); err != nil {
+		serveError(w, err)
+		return
+	}
+	serveJSON(w, authStatus{
+		Enabled: true,
+		Role:    ro,
+	})
+}
+
+// Logout ends the session of the request.
+func (srv *Server) Logout(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		key := authSessionPrefix + hashSecret(c.Value)
+		if err := srv.authUpdate(func(b *bolt.Bucket) error {
+			return b.Delete([]byte(key))
+		}); err != nil {
+			serveError(w, err)
+			return
+		}
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   sessionCookie,
+		Path:   PathPrefix + "/",
+		MaxAge: -1,
+	})
+}
+
+// SetPassword sets the admin password, or removes it if empty. All sessions
+// end, except that the request gets a new one.
+func (srv *Server) SetPassword(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var req struct {
+		Password string
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var v []byte
+	if req.Password != "" {
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			serveError(w, err)
+			return
+		}
+		v = append(salt, pbkdf2([]byte(req.Password), salt, passwordIterations, 32)...)
+	}
+	err := srv.authUpdate(func(b *bolt.Bucket) error {
+		if v == nil {
+			return b.Delete([]byte(authPassword))
+		}
+		return b.Put([]byte(authPassword), v)
+	})
+	if err == nil {
+		err = srv.endSessions(func(*authSession) bool { return true })
+	}
+	if err == nil && srv.authEnabled() {
+		err = srv.startSession(w, r, roleAdmin, "")
+	}
+	if err != nil {
+		serveError(w, err)
+		return
+	}
+	log.Println("password changed")
+}
+
+// Tokens lists the API tokens.
+func (srv *Server) Tokens(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	tokens := []authToken{}
+	err := srv.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(dbAuth))
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		prefix := []byte(authTokenPrefix)
+		for k, v := c.Seek(prefix); bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var t authToken
+			if err := json.Unmarshal(v, &t); err != nil {
+				return err
+			}
+			tokens = append(tokens, t)
+		}
+		return nil
+	})
+	return tokens, err
+}
+
+// TokenAdd adds an API token with a Name and Role, read or admin, and
+// returns it. It cannot be seen again.
+func (srv *Server) TokenAdd(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var t authToken
+	if err := json.NewDecoder(body).Decode(&t); err != nil {
+		return nil, err
+	}
+	if t.Name == "" {
+		return nil, fmt.Errorf("token name required")
+	}
+	if t.Role == roleNone {
+		return nil, fmt.Errorf("token role required")
+	}
+	tokens, err := srv.Tokens(nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range tokens.([]authToken) {
+		if o.Name == t.Name {
+			return nil, fmt.Errorf("token already exists: %s", t.Name)
+		}
+	}
+	t.Created = time.Now().UTC()
+	v, err := json.Marshal(&t)
+	if err != nil {
+		return nil, err
+	}
+	secret := newSecret()
+	err = srv.authUpdate(func(b *bolt.Bucket) error {
+		return b.Put([]byte(authTokenPrefix+hashSecret(secret)), v)
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("added %s token %s", t.Role, t.Name)
+	return struct {
+		Token string
+	}{
+		secret,
+	}, nil
+}
+
+// TokenRemove removes the API token named Name and its sessions.
+func (srv *Server) TokenRemove(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var req struct {
+		Name string
+	}
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return nil, err
+	}
+	found := false
+	err := srv.authUpdate(func(b *bolt.Bucket) error {
+		c := b.Cursor()
+		prefix := []byte(authTokenPrefix)
+		for k, v := c.Seek(prefix); bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var t authToken
+			if json.Unmarshal(v, &t) == nil && t.Name == req.Name {
+				found = true
+				return b.Delete(k)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("unknown token: %s", req.Name)
+	}
+	return nil, srv.endSessions(func(s *authSession) bool {
+		return s.Token == req.Name
+	})
+}
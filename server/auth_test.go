@@ -0,0 +1,346 @@
+package server
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestPBKDF2(t *testing.T) {
+	// The RFC 6070 inputs with SHA-256, and the last from RFC 7914.
+	tests := []struct {
+		password, salt string
+		iterations     int
+		want           string
+	}{
+		{"password", "salt", 1, "120fb6cffcf8b32c43e7225256c4f837a86548c92ccc35480805987cb70be17b"},
+		{"password", "salt", 2, "ae4d0c95af6b46d32d0adff928f06dd02a303f8ef3c251dfd6e2d85a95474c43"},
+		{"password", "salt", 4096, "c5e478d59288c841aa530db6845c4c8d962893a001ce4e11a4963873aa98134a"},
+		{"passwordPASSWORDpassword", "saltSALTsaltSALTsaltSALTsaltSALTsalt", 4096, "348c89dbcbd32b2f32d814b8116e84cf2b17347ebc1800181c4e2a1fb8dd53e1c635518c7dac47e9"},
+		{"pass\x00word", "sa\x00lt", 4096, "89b69d0516f829893c696226650a8687"},
+		{"passwd", "salt", 1, "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783"},
+	}
+	for _, test := range tests {
+		size := len(test.want) / 2
+		got := hex.EncodeToString(pbkdf2([]byte(test.password), []byte(test.salt), test.iterations, size))
+		if got != test.want {
+			t.Errorf("%q, %q, %d: got %s, want %s", test.password, test.salt, test.iterations, got, test.want)
+		}
+	}
+}
+
+func TestRole(t *testing.T) {
+	for _, r := range []role{roleRead, roleAdmin} {
+		b, _ := r.MarshalText()
+		var got role
+		if err := got.UnmarshalText(b); err != nil || got != r {
+			t.Errorf("%v: got %v, %v", r, got, err)
+		}
+	}
+	for _, s := range []string{"", "none", "Admin"} {
+		var r role
+		if err := r.UnmarshalText([]byte(s)); err == nil {
+			t.Errorf("%q: got %v, want error", s, r)
+		}
+	}
+}
+
+// testAuthServer returns a server with an empty database.
+func testAuthServer(t *testing.T) *Server {
+	dir, err := ioutil.TempDir("", "auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := bolt.Open(filepath.Join(dir, "state"), 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+		os.RemoveAll(dir)
+	})
+	return &Server{db: db}
+}
+
+// addToken adds a token and returns its secret.
+func addToken(t *testing.T, srv *Server, name, ro string) string {
+	v, err := srv.TokenAdd(strings.NewReader(`{"Name": "`+name+`", "Role": "`+ro+`"}`), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v.(struct{ Token string }).Token
+}
+
+// login logs in with secret and returns the session cookie.
+func login(t *testing.T, srv *Server, secret string) *http.Cookie {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/api/login", strings.NewReader(`{"Password": "`+secret+`"}`))
+	srv.Login(w, r, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("login: %d %s", w.Code, w.Body)
+	}
+	for _, c := range w.Result().Cookies() {
+		if c.Name == sessionCookie {
+			return c
+		}
+	}
+	t.Fatal("login: no session cookie")
+	return nil
+}
+
+func TestTokenAuth(t *testing.T) {
+	srv := testAuthServer(t)
+	r := httptest.NewRequest("GET", "/api/status", nil)
+	if srv.authEnabled() || srv.requestRole(r) != roleAdmin {
+		t.Fatal("authentication is on without a password or token")
+	}
+
+	admin := addToken(t, srv, "admin", "admin")
+	read := addToken(t, srv, "read", "read")
+	if !srv.authEnabled() {
+		t.Fatal("authentication is off with tokens")
+	}
+	for _, body := range []string{`{"Name": "read", "Role": "admin"}`, `{"Name": "x"}`, `{"Role": "read"}`, `{"Name": "x", "Role": "root"}`} {
+		if _, err := srv.TokenAdd(strings.NewReader(body), nil, nil); err == nil {
+			t.Errorf("%s: added", body)
+		}
+	}
+	tokens, err := srv.Tokens(nil, nil, nil)
+	if err != nil || len(tokens.([]authToken)) != 2 {
+		t.Fatalf("got tokens %v, %v", tokens, err)
+	}
+
+	tests := []struct {
+		name   string
+		header string
+		query  string
+		want   role
+		code   int
+	}{
+		{"none", "", "", roleNone, http.StatusUnauthorized},
+		{"bearer admin", "Bearer " + admin, "", roleAdmin, http.StatusOK},
+		{"bearer read", "Bearer " + read, "", roleRead, http.StatusForbidden},
+		{"parameter", "", "access_token=" + admin, roleAdmin, http.StatusOK},
+		{"bearer over parameter", "Bearer " + read, "access_token=" + admin, roleRead, http.StatusForbidden},
+		{"wrong", "Bearer " + admin + "x", "", roleNone, http.StatusUnauthorized},
+		{"hash", "Bearer " + hashSecret(admin), "", roleNone, http.StatusUnauthorized},
+		{"basic", "Basic " + admin, "", roleNone, http.StatusUnauthorized},
+	}
+	called := false
+	h := srv.authorize(roleAdmin, func(http.ResponseWriter, *http.Request, httprouter.Params) {
+		called = true
+	})
+	for _, test := range tests {
+		r := httptest.NewRequest("GET", "/api/cmd/play?"+test.query, nil)
+		if test.header != "" {
+			r.Header.Set("Authorization", test.header)
+		}
+		if got := srv.requestRole(r); got != test.want {
+			t.Errorf("%s: got role %q, want %q", test.name, got, test.want)
+		}
+		called = false
+		w := httptest.NewRecorder()
+		h(w, r, nil)
+		if w.Code != test.code || called != (test.code == http.StatusOK) {
+			t.Errorf("%s: got %d, called %v, want %d", test.name, w.Code, called, test.code)
+		}
+		if auth := w.Header().Get("WWW-Authenticate"); (test.code == http.StatusUnauthorized) != (auth != "") {
+			t.Errorf("%s: got WWW-Authenticate %q", test.name, auth)
+		}
+	}
+
+	// Sessions from a token end when it is removed.
+	c := login(t, srv, read)
+	r = httptest.NewRequest("GET", "/api/status", nil)
+	r.AddCookie(c)
+	if got := srv.requestRole(r); got != roleRead {
+		t.Fatalf("session: got role %q", got)
+	}
+	if _, err := srv.TokenRemove(strings.NewReader(`{"Name": "read"}`), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := srv.TokenRemove(strings.NewReader(`{"Name": "read"}`), nil, nil); err == nil {
+		t.Fatal("removed twice")
+	}
+	if got := srv.requestRole(r); got != roleNone {
+		t.Fatalf("removed token session: got role %q", got)
+	}
+	r = httptest.NewRequest("GET", "/api/status", nil)
+	r.Header.Set("Authorization", "Bearer "+read)
+	if got := srv.requestRole(r); got != roleNone {
+		t.Fatalf("removed token: got role %q", got)
+	}
+}
+
+func TestPasswordAuth(t *testing.T) {
+	srv := testAuthServer(t)
+	setPassword := func(password string, cookie *http.Cookie) *http.Cookie {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/api/password", strings.NewReader(`{"Password": "`+password+`"}`))
+		if cookie != nil {
+			r.AddCookie(cookie)
+		}
+		srv.SetPassword(w, r, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("set password: %d %s", w.Code, w.Body)
+		}
+		for _, c := range w.Result().Cookies() {
+			if c.Name == sessionCookie {
+				return c
+			}
+		}
+		return nil
+	}
+
+	first := setPassword("secret", nil)
+	if first == nil || !first.HttpOnly {
+		t.Fatalf("got session cookie %v", first)
+	}
+	if v := srv.authGet(authPassword); len(v) != 16+32 || strings.Contains(string(v), "secret") {
+		t.Fatalf("bad stored password %x", v)
+	}
+	tests := []struct {
+		secret string
+		want   role
+	}{
+		{"secret", roleAdmin},
+		{"Secret", roleNone},
+		{"secret ", roleNone},
+		{"", roleNone},
+	}
+	for _, test := range tests {
+		if got, _ := srv.checkSecret(test.secret); got != test.want {
+			t.Errorf("%q: got role %q, want %q", test.secret, got, test.want)
+		}
+	}
+	c := login(t, srv, "secret")
+	session := func(c *http.Cookie) role {
+		r := httptest.NewRequest("GET", "/api/status", nil)
+		r.AddCookie(c)
+		return srv.requestRole(r)
+	}
+	if got := session(c); got != roleAdmin {
+		t.Fatalf("login session: got role %q", got)
+	}
+
+	// Changing the password ends other sessions.
+	second := setPassword("other", c)
+	if got := session(c); got != roleNone {
+		t.Fatalf("old session: got role %q", got)
+	}
+	if got := session(first); got != roleNone {
+		t.Fatalf("first session: got role %q", got)
+	}
+	if got := session(second); got != roleAdmin {
+		t.Fatalf("new session: got role %q", got)
+	}
+	if got, _ := srv.checkSecret("secret"); got != roleNone {
+		t.Fatalf("old password: got role %q", got)
+	}
+
+	// Removing the password turns authentication off.
+	if c := setPassword("", second); c != nil {
+		t.Fatalf("got session cookie %v without a password", c)
+	}
+	if srv.authEnabled() {
+		t.Fatal("authentication is on after removing the password")
+	}
+}
+
+func TestCSRF(t *testing.T) {
+	srv := testAuthServer(t)
+	request := func(cookie, csrf string) *http.Request {
+		r := httptest.NewRequest("GET", "/api/token/register?csrf="+csrf, nil)
+		if cookie != "" {
+			r.AddCookie(&http.Cookie{Name: sessionCookie, Value: cookie})
+		}
+		return r
+	}
+	a := srv.csrfToken(request("a", ""))
+	if a != srv.csrfToken(request("a", "")) {
+		t.Fatal("token changed")
+	}
+	b := srv.csrfToken(request("b", ""))
+	none := srv.csrfToken(request("", ""))
+	if a == b || a == none {
+		t.Fatal("tokens of different sessions are equal")
+	}
+	tests := []struct {
+		name         string
+		cookie, csrf string
+		want         bool
+	}{
+		{"ok", "a", a, true},
+		{"no session", "", none, true},
+		{"missing", "a", "", false},
+		{"other session", "b", a, false},
+		{"no cookie", "", a, false},
+		{"truncated", "a", a[:len(a)-1], false},
+		{"hash", "a", hashSecret("a"), false},
+	}
+	for _, test := range tests {
+		if got := srv.checkCSRF(request(test.cookie, test.csrf)); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestRequireCSRF(t *testing.T) {
+	srv := testAuthServer(t)
+	called := false
+	h := srv.requireCSRF(func(http.ResponseWriter, *http.Request, httprouter.Params) {
+		called = true
+	})
+	get := func(query string, c *http.Cookie, header string) int {
+		r := httptest.NewRequest("GET", "/api/cmd/next?"+query, nil)
+		if c != nil {
+			r.AddCookie(c)
+		}
+		if header != "" {
+			r.Header.Set("Authorization", header)
+		}
+		called = false
+		w := httptest.NewRecorder()
+		h(w, r, nil)
+		if called != (w.Code == http.StatusOK) {
+			t.Fatalf("%s: got %d, called %v", query, w.Code, called)
+		}
+		return w.Code
+	}
+	if code := get("", nil, ""); code != http.StatusOK {
+		t.Fatalf("authentication off: got %d", code)
+	}
+
+	admin := addToken(t, srv, "admin", "admin")
+	c := login(t, srv, admin)
+	r := httptest.NewRequest("GET", "/", nil)
+	r.AddCookie(c)
+	csrf := srv.csrfToken(r)
+	tests := []struct {
+		name   string
+		query  string
+		cookie *http.Cookie
+		header string
+		code   int
+	}{
+		{"session", "", c, "", http.StatusForbidden},
+		{"session with csrf", "csrf=" + csrf, c, "", http.StatusOK},
+		{"session with wrong csrf", "csrf=x", c, "", http.StatusForbidden},
+		{"bearer", "", nil, "Bearer " + admin, http.StatusOK},
+		{"parameter", "access_token=" + admin, nil, "", http.StatusOK},
+		{"session with wrong token", "access_token=x", c, "", http.StatusForbidden},
+	}
+	for _, test := range tests {
+		if code := get(test.query, test.cookie, test.header); code != test.code {
+			t.Errorf("%s: got %d, want %d", test.name, code, test.code)
+		}
+	}
+}
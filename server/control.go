@@ -14,6 +14,7 @@ import (
 	"github.com/bradfitz/slice"
 	"github.com/mjibson/moggio/codec"
 	"github.com/mjibson/moggio/models"
+	"github.com/mjibson/moggio/output"
 	"github.com/mjibson/moggio/protocol"
 	"golang.org/x/net/websocket"
 	"golang.org/x/oauth2"
@@ -24,6 +25,8 @@ func (srv *Server) commands() {
 	var next, stop, tick, play, pause, prev func()
 	var timer <-chan time.Time
 	waiters := make(map[*websocket.Conn]chan struct{})
+	// idlers are the event channels of MPD clients.
+	idlers := make(map[chan waitType]bool)
 	broadcastData := func(wd *waitData) {
 		for ws := range waiters {
 			go func(ws *websocket.Conn) {
@@ -32,11 +35,32 @@ func (srv *Server) commands() {
 				}
 			}(ws)
 		}
+		for ch := range idlers {
+			// Clients keep up or miss events, but never block commands.
+			select {
+			case ch <- wd.Type:
+			default:
+			}
+		}
 	}
 	broadcast := func(wt waitType) {
+		if wt == waitPlaylist {
+			srv.playlistVersion++
+			srv.mpdQueueIDs()
+		}
 		wd := srv.makeWaitData(wt)
 		broadcastData(wd)
 	}
+	// libraryChanged sends clients the songs that changed.
+	libraryChanged := func() {
+		srv.index = nil
+		if d := srv.updateLibrary(); d != nil {
+			broadcastData(&waitData{
+				Type: waitLibrary,
+				Data: d,
+			})
+		}
+	}
 	broadcastErr := func(err error) {
 		printErr(err)
 		v := struct {
@@ -58,7 +82,6 @@ func (srv *Server) commands() {
 			waitPlaylist,
 			waitProtocols,
 			waitStatus,
-			waitTracks,
 		}
 		for _, wt := range inits {
 			data := srv.makeWaitData(wt)
@@ -69,6 +92,20 @@ func (srv *Server) commands() {
 				}
 			}()
 		}
+		// Send the diffs in order, since the client needs them all.
+		diffs := srv.library.since(c.seq)
+		go func() {
+			for _, d := range diffs {
+				wd := &waitData{
+					Type: waitLibrary,
+					Data: d,
+				}
+				if err := websocket.JSON.Send(ws, wd); err != nil {
+					srv.ch <- cmdDeleteWS(ws)
+					return
+				}
+			}
+		}()
 	}
 	deleteWS := func(c cmdDeleteWS) {
 		ws := (*websocket.Conn)(c)
@@ -109,6 +146,34 @@ func (srv *Server) commands() {
 		play()
 	}
 	var forceNext = false
+	// preload is the song after the current one, opened ahead of time. If handed
+	// is set, it has also been queued in the audio loop for gapless playback or
+	// crossfading.
+	// preloadGen is incremented to invalidate an in-progress preload.
+	var preload *preloadSong
+	var handed, preloading bool
+	var preloadGen int
+	// preloadFailed is a song that could not be preloaded. It is not tried
+	// again until the queue or the current song changes.
+	var preloadFailed SongID
+	// takePreload removes and returns the preloaded song. It returns nil if
+	// there is none or the audio loop has already started playing it.
+	takePreload := func() *preloadSong {
+		preloadGen++
+		preloading = false
+		p := preload
+		preload = nil
+		if p != nil && handed {
+			handed = false
+			r := make(chan *preloadSong)
+			srv.audioch <- audioUnqueue(r)
+			if <-r == nil {
+				// A cmdGapless for p is on its way.
+				return nil
+			}
+		}
+		return p
+	}
 	stop = func() {
 		log.Println("stop")
 		srv.state = stateStop
@@ -116,6 +181,10 @@ func (srv *Server) commands() {
 		if srv.song != nil || forceNext {
 			if srv.Random && len(srv.Queue) > 1 {
 				n := srv.PlaylistIndex
+				if preload != nil {
+					// Play the random song we already picked and opened.
+					n = preload.idx
+				}
 				for n == srv.PlaylistIndex {
 					n = rand.Intn(len(srv.Queue))
 				}
@@ -163,36 +232,51 @@ func (srv *Server) commands() {
 
 			srv.songID = srv.Queue[srv.PlaylistIndex]
 			sid = srv.songID
-			if info, err := srv.getSong(sid); err != nil {
-				broadcastErr(err)
-				forceNext = true
-				sendNext()
-				return
+			preloadFailed = ""
+			var sr, ch int
+			var seek *Seek
+			if p := takePreload(); p != nil && p.idx == srv.PlaylistIndex && p.id == sid {
+				srv.info = p.info
+				inst = p.inst
+				srv.song = p.song
+				sr, ch = p.sr, p.ch
+				seek = p.seek
 			} else {
-				srv.info = *info
-			}
-			inst = srv.Protocols[sid.Protocol()][sid.Key()]
-			song, err := inst.GetSong(sid.ID())
-			if err != nil {
-				forceNext = true
-				broadcastErr(err)
-				sendNext()
-				return
-			}
-			srv.song = song
-			sr, ch, err := srv.song.Init()
-			if err != nil {
-				srv.song.Close()
-				srv.song = nil
-				broadcastErr(err)
-				sendNext()
-				return
+				if p != nil {
+					p.song.Close()
+				}
+				if info, err := srv.getSong(sid); err != nil {
+					broadcastErr(err)
+					forceNext = true
+					sendNext()
+					return
+				} else {
+					srv.info = *info
+				}
+				inst = srv.Protocols[sid.Protocol()][sid.Key()]
+				song, err := inst.GetSong(sid.ID())
+				if err != nil {
+					forceNext = true
+					broadcastErr(err)
+					sendNext()
+					return
+				}
+				srv.song = song
+				sr, ch, err = srv.song.Init()
+				if err != nil {
+					srv.song.Close()
+					srv.song = nil
+					broadcastErr(err)
+					sendNext()
+					return
+				}
 			}
 			params := audioSetParams{
 				sr:   sr,
 				ch:   ch,
-				dur:  srv.info.Time,
-				play: srv.song.Play,
+				info: srv.info,
+				song: srv.song,
+				seek: seek,
 				err:  make(chan error),
 			}
 			srv.audioch <- params
@@ -202,10 +286,133 @@ func (srv *Server) commands() {
 				return
 			}
 			srv.elapsed = 0
+			srv.setStreamTitle()
 			log.Println("playing", srv.info.Title, sr, ch)
 			srv.state = statePlay
 		}
 	}
+	// nextIndex returns the queue index that plays after the current song, or
+	// -1 if there is none. In random mode, any other index is acceptable.
+	nextIndex := func() int {
+		i := srv.PlaylistIndex + 1
+		if i >= len(srv.Queue) {
+			if !srv.Repeat || len(srv.Queue) == 0 {
+				return -1
+			}
+			i = 0
+		}
+		return i
+	}
+	preloadValid := func(p *preloadSong) bool {
+		if p.idx >= len(srv.Queue) || srv.Queue[p.idx] != p.id {
+			return false
+		}
+		if srv.Random {
+			return p.idx != srv.PlaylistIndex
+		}
+		return p.idx == nextIndex()
+	}
+	startPreload := func() {
+		if preload != nil || preloading || srv.song == nil || srv.state != statePlay {
+			return
+		}
+		idx := nextIndex()
+		if srv.Random && len(srv.Queue) > 1 {
+			idx = srv.PlaylistIndex
+			for idx == srv.PlaylistIndex {
+				idx = rand.Intn(len(srv.Queue))
+			}
+		}
+		if idx < 0 || srv.Queue[idx] == preloadFailed {
+			return
+		}
+		id := srv.Queue[idx]
+		info, err := srv.getSong(id)
+		if err != nil {
+			// tick will report the error when it gets there.
+			return
+		}
+		p := &preloadSong{
+			idx:  idx,
+			id:   id,
+			info: *info,
+			inst: srv.Protocols[id.Protocol()][id.Key()],
+		}
+		p.song, err = p.inst.GetSong(id.ID())
+		if err != nil {
+			preloadFailed = id
+			return
+		}
+		preloading = true
+		gen := preloadGen
+		go func() {
+			var err error
+			p.sr, p.ch, err = p.song.Init()
+			srv.ch <- cmdPreloaded{
+				gen:  gen,
+				song: p,
+				err:  err,
+			}
+		}()
+	}
+	// checkPreload drops the preloaded song if the queue or play mode changed so
+	// that it is no longer next, and starts a new preload if needed.
+	checkPreload := func() {
+		if preload != nil && !preloadValid(preload) {
+			if p := takePreload(); p != nil {
+				p.song.Close()
+			}
+		}
+		startPreload()
+	}
+	preloaded := func(c cmdPreloaded) {
+		if c.gen != preloadGen {
+			c.song.song.Close()
+			return
+		}
+		preloading = false
+		if c.err != nil {
+			log.Println("preload:", c.err)
+			c.song.song.Close()
+			preloadFailed = c.song.id
+			return
+		}
+		preload = c.song
+		srv.audioch <- audioQueue(preload)
+		handed = true
+	}
+	gapless := func(c cmdGapless) {
+		if preload == c.next {
+			preload = nil
+			handed = false
+		}
+		if srv.song != c.from {
+			// Something else was started after the audio loop moved on to
+			// c.next, so it is no longer playing.
+			c.next.song.Close()
+			return
+		}
+		srv.song.Close()
+		srv.PlaylistIndex = c.next.idx
+		if srv.PlaylistIndex >= len(srv.Queue) || srv.Queue[srv.PlaylistIndex] != c.next.id {
+			// The queue changed after the switch.
+			for i, id := range srv.Queue {
+				if id == c.next.id {
+					srv.PlaylistIndex = i
+					break
+				}
+			}
+		}
+		srv.songID = c.next.id
+		sid = c.next.id
+		preloadFailed = ""
+		srv.info = c.next.info
+		inst = c.next.inst
+		srv.song = c.next.song
+		srv.elapsed = 0
+		srv.setStreamTitle()
+		log.Println("playing", srv.info.Title, "(gapless)")
+	}
 	infoTimer := func() {
 		timer = time.After(time.Second)
 		if inst == nil {
@@ -216,6 +423,7 @@ func (srv *Server) commands() {
 			broadcastErr(err)
 		} else if srv.info != *info {
 			srv.info = *info
+			srv.setStreamTitle()
 			broadcast(waitStatus)
 		}
 	}
@@ -245,7 +453,7 @@ func (srv *Server) commands() {
 			broadcastErr(err)
 			return
 		}
-		album := info.Album
+		album, albumArtist := info.Album, info.AlbumArtist
 		p, err := srv.getInstance(t.Protocol(), t.Key())
 		if err != nil {
 			broadcastErr(err)
@@ -259,13 +467,16 @@ func (srv *Server) commands() {
 		top := codec.NewID(t.Protocol(), t.Key())
 		var ids []codec.ID
 		for id, si := range list {
-			if si.Album == album {
+			if si.Album == album && (albumArtist == "" || si.AlbumArtist == albumArtist) {
 				ids = append(ids, id)
 			}
 		}
 		slice.Sort(ids, func(i, j int) bool {
 			a := list[ids[i]]
 			b := list[ids[j]]
+			if a.Disc != b.Disc {
+				return a.Disc < b.Disc
+			}
 			return a.Track < b.Track
 		})
 		plc := PlaylistChange{[]string{"clear"}}
@@ -308,12 +519,22 @@ func (srv *Server) commands() {
 		}
 		broadcast(waitPlaylist)
 	}
+	// collectArt deletes unused pictures once no refresh is in progress.
+	collectArt := func() {
+		if len(srv.inprogress) > 0 {
+			return
+		}
+		if err := srv.collectArt(); err != nil {
+			log.Println("collect art:", err)
+		}
+	}
 	protocolRemove := func(c cmdProtocolRemove) {
 		prots, ok := srv.Protocols[c.protocol]
 		if !ok {
 			return
 		}
 		delete(prots, c.key)
+		srv.unwatch(codec.NewID(c.protocol, c.key))
 		if srv.Token != "" {
 			d := models.Delete{
 				Protocol: c.protocol,
@@ -328,17 +549,26 @@ func (srv *Server) commands() {
 				r.Close()
 			}()
 		}
-		broadcast(waitTracks)
+		libraryChanged()
 		broadcast(waitProtocols)
+		collectArt()
 	}
 	removeInProgress := func(c cmdRemoveInProgress) {
 		delete(srv.inprogress, codec.ID(c))
+		libraryChanged()
 		broadcast(waitProtocols)
+		collectArt()
+	}
+	progress := func(c cmdProgress) {
+		if p := srv.inprogress[c.id]; p != nil {
+			*p = c.Progress
+			broadcast(waitProtocols)
+		}
 	}
 	protocolAdd := func(c cmdProtocolAdd) {
 		name, key := c.Name, c.Instance.Key()
 		id := codec.NewID(name, key)
-		if srv.inprogress[id] {
+		if srv.inprogress[id] != nil {
 			broadcastErr(fmt.Errorf("already adding %s: %s", name, key))
 			return
 		}
@@ -346,15 +576,13 @@ func (srv *Server) commands() {
 			broadcastErr(fmt.Errorf("already have %s: %s", name, key))
 			return
 		}
-		srv.inprogress[id] = true
+		srv.inprogress[id] = new(Progress)
 		broadcast(waitProtocols)
 		go func() {
-			defer func() {
-				srv.ch <- cmdRemoveInProgress(id)
-			}()
-			songs, err := c.Instance.Refresh()
+			songs, err := srv.refresh(id, c.Instance)
 			if err != nil {
 				srv.ch <- cmdError(err)
+				srv.ch <- cmdRemoveInProgress(id)
 				return
 			}
 			for k, v := range songs {
@@ -362,18 +590,23 @@ func (srv *Server) commands() {
 					delete(songs, k)
 				}
 			}
+			// Adding the instance also ends its progress, since commands
+			// may be handled out of order and art collection must see the
+			// instance.
 			srv.ch <- cmdProtocolAddInstance(c)
 		}()
 	}
 	protocolAddInstance := func(c cmdProtocolAddInstance) {
+		delete(srv.inprogress, codec.NewID(c.Name, c.Instance.Key()))
 		srv.Protocols[c.Name][c.Instance.Key()] = c.Instance
+		srv.watch(c.Name, c.Instance)
 		if srv.Token != "" {
 			srv.ch <- cmdPutSource{
 				protocol: c.Name,
 				key:      c.Instance.Key(),
 			}
 		}
-		broadcast(waitTracks)
+		libraryChanged()
 		broadcast(waitProtocols)
 	}
 	queueChange := func(c cmdQueueChange) {
@@ -383,6 +616,7 @@ func (srv *Server) commands() {
 			return
 		}
 		srv.Queue = n
+		preloadFailed = ""
 		if clear || len(n) == 0 {
 			stop()
 			srv.PlaylistIndex = 0
@@ -453,6 +687,34 @@ func (srv *Server) commands() {
 		}
 		srv.audioch <- c
 	}
+	setVolume := func(c cmdVolume) {
+		if !(c >= 0 && c <= 1) {
+			broadcastErr(fmt.Errorf("volume must be between 0 and 1: %v", float64(c)))
+			return
+		}
+		srv.Attenuation = 1 - float64(c)
+		srv.audioch <- audioVolume(srv.gain())
+	}
+	setCrossfade := func(c cmdCrossfade) {
+		if c < 0 {
+			broadcastErr(fmt.Errorf("crossfade must not be negative: %v", time.Duration(c)))
+			return
+		}
+		srv.Crossfade = time.Duration(c)
+		srv.audioch <- audioCrossfade(c)
+	}
+	setReplayGain := func(c cmdReplayGain) {
+		switch c {
+		case "off":
+			c = ""
+		case "", "track", "album":
+		default:
+			broadcastErr(fmt.Errorf("unknown replaygain mode: %s", c))
+			return
+		}
+		srv.ReplayGain = string(c)
+		srv.audioch <- audioReplayGain(c)
+	}
 	setUsername := func(c cmdSetUsername) {
 		srv.Username = string(c)
 	}
@@ -573,6 +835,14 @@ func (srv *Server) commands() {
 			ps[s.Protocol][s.Name] = p
 		}
 		srv.Protocols = ps
+		for id := range srv.watchers {
+			srv.unwatch(id)
+		}
+		for name, protos := range ps {
+			for _, inst := range protos {
+				srv.watch(name, inst)
+			}
+		}
 		go func() {
 			// protocolRefresh uses srv.Protocols, so
 			for i, s := range c {
@@ -587,12 +857,41 @@ func (srv *Server) commands() {
 			}
 		}()
 	}
+	outputAdd := func(c cmdOutputAdd) {
+		c.done <- srv.outputs.Add(c.name)
+	}
+	outputRemove := func(c cmdOutputRemove) {
+		c.done <- srv.outputs.Remove(c.name)
+	}
+	outputSet := func(c cmdOutputSet) {
+		c.done <- srv.outputs.Set(c.Name, c.Volume, c.Mute)
+	}
 	sendWaitData := func(c cmdWaitData) {
 		c.done <- srv.makeWaitData(c.wt)
 	}
+	sendSnapshot := func(c cmdSnapshot) {
+		c.done <- srv.makeSnapshot(c.playlists)
+	}
+	sendLibraryPage := func(c cmdLibraryPage) {
+		c.done <- srv.library.page(c.offset, c.limit)
+	}
+	sendSearchIndex := func(c cmdSearchIndex) {
+		if srv.index == nil {
+			srv.index = srv.makeSearchIndex()
+		}
+		c <- srv.index
+	}
 	protocolRefresh := func(c cmdProtocolRefresh) {
 		id := codec.NewID(c.protocol, c.key)
-		if srv.inprogress[id] {
+		if srv.inprogress[id] != nil {
+			if c.update {
+				// Try again once the current refresh is done.
+				retry := c
+				retry.err = make(chan error, 1)
+				time.AfterFunc(time.Second, func() {
+					srv.ch <- retry
+				})
+			}
 			c.err <- nil
 			return
 		}
@@ -601,17 +900,22 @@ func (srv *Server) commands() {
 			c.err <- err
 			return
 		}
-		srv.inprogress[id] = true
+		srv.inprogress[id] = new(Progress)
 		broadcast(waitProtocols)
 		go func() {
 			defer func() {
 				srv.ch <- cmdRemoveInProgress(id)
 			}()
-			f := inst.Refresh
-			if c.list {
-				f = inst.List
+			var songs protocol.SongList
+			var err error
+			switch {
+			case c.list:
+				songs, err = inst.List()
+			case c.update:
+				songs, err = inst.(protocol.Watcher).Update()
+			default:
+				songs, err = srv.refresh(id, inst)
 			}
-			songs, err := f()
 			if err != nil {
 				c.err <- err
 				return
@@ -646,6 +950,11 @@ func (srv *Server) commands() {
 			}(c)
 		}
 	}()
+	// Start from the time so that sequence numbers from before a restart are
+	// never reused. Microseconds fit in a JavaScript number.
+	srv.library.seq = time.Now().UnixNano() / 1e3
+	srv.updateLibrary()
+	srv.library.diffs = nil
 	infoTimer()
 	for {
 		select {
@@ -664,6 +973,18 @@ func (srv *Server) commands() {
 				}
 				continue
 			}
+			var done chan struct{}
+			var checked chan error
+			switch s := c.(type) {
+			case cmdSync:
+				c, done = s.cmd, s.done
+			case cmdChecked:
+				if err := srv.check(s.cmd); err != nil {
+					s.done <- err
+					continue
+				}
+				c, checked = s.cmd, s.done
+			}
 			save := true
 			doDroadcast := false
 			log.Printf("%T\n", c)
@@ -688,6 +1009,9 @@ func (srv *Server) commands() {
 					srv.Random = !srv.Random
 				case cmdRepeat:
 					srv.Repeat = !srv.Repeat
+				case cmdMute:
+					srv.Mute = !srv.Mute
+					srv.audioch <- audioVolume(srv.gain())
 				case cmdRestartSong:
 					restart()
 				default:
@@ -719,6 +1043,12 @@ func (srv *Server) commands() {
 				doSeek(c)
 			case cmdMinDuration:
 				setMinDuration(c)
+			case cmdVolume:
+				setVolume(c)
+			case cmdCrossfade:
+				setCrossfade(c)
+			case cmdReplayGain:
+				setReplayGain(c)
 			case cmdTokenRegister:
 				tokenRegister(c)
 			case cmdSetUsername:
@@ -733,26 +1063,72 @@ func (srv *Server) commands() {
 				removeDeleted()
 			case cmdRemoveInProgress:
 				removeInProgress(c)
+			case cmdProgress:
+				progress(c)
+				save = false
 			case cmdError:
 				broadcastErr(error(c))
 				save = false
 			case cmdWaitData:
 				sendWaitData(c)
 				save = false
+			case cmdSearchIndex:
+				sendSearchIndex(c)
+				save = false
+			case cmdLibraryPage:
+				sendLibraryPage(c)
+				save = false
+			case cmdTrack:
+				c.done <- srv.library.songs[c.id]
+				save = false
+			case cmdSnapshot:
+				sendSnapshot(c)
+				save = false
+			case cmdNewIdler:
+				idlers[chan waitType(c)] = true
+				save = false
+			case cmdDeleteIdler:
+				delete(idlers, chan waitType(c))
+				save = false
 			case cmdPutSource:
 				putSource(c)
 				save = false
 			case cmdProtocolRefresh:
 				protocolRefresh(c)
+			case cmdPreloaded:
+				preloaded(c)
+				save = false
+			case cmdGapless:
+				gapless(c)
+				doDroadcast = true
+			case cmdOutputAdd:
+				outputAdd(c)
+				save = false
+				doDroadcast = true
+			case cmdOutputRemove:
+				outputRemove(c)
+				save = false
+				doDroadcast = true
+			case cmdOutputSet:
+				outputSet(c)
+				save = false
+				doDroadcast = true
 			default:
 				panic(c)
 			}
+			checkPreload()
 			if save {
 				queueSave()
 			}
 			if save || doDroadcast {
 				broadcast(waitStatus)
 			}
+			if done != nil {
+				close(done)
+			}
+			if checked != nil {
+				checked <- nil
+			}
 		}
 	}
 }
@@ -769,6 +1145,7 @@ const (
 	cmdRepeat
 	cmdStop
 	cmdRestartSong
+	cmdMute
 )
 
 type cmdSeek time.Duration
@@ -798,6 +1175,12 @@ type cmdAddOAuth struct {
 
 type cmdMinDuration time.Duration
 
+type cmdVolume float64
+
+type cmdCrossfade time.Duration
+
+type cmdReplayGain string
+
 type cmdSetTime struct {
 	duration time.Duration
 	force    bool
@@ -820,11 +1203,40 @@ type cmdProtocolAddInstance cmdProtocolAdd
 
 type cmdRemoveInProgress codec.ID
 
+type cmdSearchIndex chan *searchIndex
+
+// cmdSync runs cmd and then closes done, so that a caller can wait for it.
+type cmdSync struct {
+	cmd  interface{}
+	done chan struct{}
+}
+
+// cmdChecked runs cmd if srv.check allows it, and then sends the error of
+// check, or nil, to done.
+type cmdChecked struct {
+	cmd  interface{}
+	done chan error
+}
+
+type cmdNewIdler chan waitType
+
+type cmdDeleteIdler chan waitType
+
+type cmdProgress struct {
+	id codec.ID
+	Progress
+}
+
 type cmdWaitData struct {
 	wt   waitType
 	done chan<- *waitData
 }
 
+type cmdSnapshot struct {
+	playlists bool
+	done      chan *snapshot
+}
+
 type cmdPutSource struct {
 	protocol, key string
 }
@@ -832,7 +1244,51 @@ type cmdPutSource struct {
 type cmdProtocolRefresh struct {
 	protocol, key  string
 	list, doDelete bool
-	err            chan error
+	// update applies the changes a protocol.Watcher saw instead of
+	// refreshing.
+	update bool
+	err    chan error
 }
 
 type cmdPlayTrack SongID
+
+// preloadSong is a song that has been opened and initialized before its turn
+// to play.
+type preloadSong struct {
+	idx    int
+	id     SongID
+	info   codec.SongInfo
+	inst   protocol.Instance
+	song   codec.Song
+	sr, ch int
+	// seek, if set, was created by the audio loop to crossfade song in.
+	seek *Seek
+}
+
+type cmdPreloaded struct {
+	gen  int
+	song *preloadSong
+	err  error
+}
+
+// cmdGapless is sent by the audio loop when it moves from song from to the
+// queued song next.
+type cmdGapless struct {
+	from codec.Song
+	next *preloadSong
+}
+
+type cmdOutputAdd struct {
+	name string
+	done chan error
+}
+
+type cmdOutputRemove struct {
+	name string
+	done chan error
+}
+
+type cmdOutputSet struct {
+	output.SinkInfo
+	done chan error
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"io"
+	"net/url"
+	"strconv"
+
+	"github.com/bradfitz/slice"
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// library is a versioned copy of the songs of all instances. Clients fetch it
+// in pages with Library and then follow the diffs sent on the websocket.
+type library struct {
+	seq   int64
+	songs map[SongID]*codec.SongInfo
+	// ids holds the keys of songs, sorted, or nil if not yet made.
+	ids []SongID
+	// diffs holds the most recent diffs, oldest first.
+	diffs []*libraryDiff
+}
+
+// maxDiffs is how many diffs are kept for clients that reconnect.
+const maxDiffs = 100
+
+// libraryDiff is the change from the previous sequence number to Seq. If
+// Reset is set, the client must fetch the library again instead.
+type libraryDiff struct {
+	Seq       int64
+	Reset     bool            `json:",omitempty"`
+	Instances []*instanceDiff `json:",omitempty"`
+}
+
+type instanceDiff struct {
+	Protocol string
+	Key      string
+	Added    []listItem `json:",omitempty"`
+	Changed  []listItem `json:",omitempty"`
+	Removed  []SongID   `json:",omitempty"`
+}
+
+// updateLibrary copies the songs of all instances into srv.library and
+// returns the diff, or nil if nothing changed. It should only be called by
+// commands().
+func (srv *Server) updateLibrary() *libraryDiff {
+	lib := &srv.library
+	songs := make(map[SongID]*codec.SongInfo)
+	diffs := make(map[codec.ID]*instanceDiff)
+	diff := func(s SongID) *instanceDiff {
+		protocol, key, _ := s.Triple()
+		id := codec.NewID(protocol, key)
+		d := diffs[id]
+		if d == nil {
+			d = &instanceDiff{
+				Protocol: protocol,
+				Key:      key,
+			}
+			diffs[id] = d
+		}
+		return d
+	}
+	for name, protos := range srv.Protocols {
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id, info := range sl {
+				s := SongID(codec.NewID(name, key, string(id)))
+				// Copy info, since instances may change it in place.
+				c := *info
+				songs[s] = &c
+				switch old := lib.songs[s]; {
+				case old == nil:
+					d := diff(s)
+					d.Added = append(d.Added, listItem{s, &c})
+				case *old != c:
+					d := diff(s)
+					d.Changed = append(d.Changed, listItem{s, &c})
+				}
+			}
+		}
+	}
+	for s := range lib.songs {
+		if songs[s] == nil {
+			d := diff(s)
+			d.Removed = append(d.Removed, s)
+		}
+	}
+	lib.songs = songs
+	if len(diffs) == 0 {
+		return nil
+	}
+	lib.ids = nil
+	lib.seq++
+	d := &libraryDiff{Seq: lib.seq}
+	for _, id := range diffs {
+		d.Instances = append(d.Instances, id)
+	}
+	lib.diffs = append(lib.diffs, d)
+	if len(lib.diffs) > maxDiffs {
+		lib.diffs = lib.diffs[len(lib.diffs)-maxDiffs:]
+	}
+	return d
+}
+
+// since returns the diffs after seq, or a reset if they are no longer kept.
+func (lib *library) since(seq int64) []*libraryDiff {
+	if seq == lib.seq {
+		return nil
+	}
+	if seq > lib.seq || len(lib.diffs) == 0 || lib.diffs[0].Seq > seq+1 {
+		return []*libraryDiff{{Seq: lib.seq, Reset: true}}
+	}
+	return lib.diffs[len(lib.diffs)-int(lib.seq-seq):]
+}
+
+// libraryPage is a page of the library at Seq, sorted by ID.
+type libraryPage struct {
+	Seq    int64
+	Total  int
+	Tracks []listItem
+}
+
+// page returns limit songs from offset.
+func (lib *library) page(offset, limit int) *libraryPage {
+	if lib.ids == nil {
+		lib.ids = make([]SongID, 0, len(lib.songs))
+		for s := range lib.songs {
+			lib.ids = append(lib.ids, s)
+		}
+		slice.Sort(lib.ids, func(i, j int) bool {
+			return lib.ids[i] < lib.ids[j]
+		})
+	}
+	p := &libraryPage{
+		Seq:    lib.seq,
+		Total:  len(lib.ids),
+		Tracks: []listItem{},
+	}
+	for i := offset; i < offset+limit && i < len(lib.ids); i++ {
+		s := lib.ids[i]
+		p.Tracks = append(p.Tracks, listItem{s, lib.songs[s]})
+	}
+	return p
+}
+
+// Library returns a page of the library, sorted by song ID, from the offset
+// parameter and of at most limit songs. Seq is the sequence number of the
+// last diff sent on the websocket that it includes; if it changes between
+// pages, the client must start again.
+func (srv *Server) Library(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	offset, _ := strconv.Atoi(form.Get("offset"))
+	if offset < 0 {
+		offset = 0
+	}
+	limit, _ := strconv.Atoi(form.Get("limit"))
+	if limit <= 0 || limit > 5000 {
+		limit = 5000
+	}
+	ch := make(chan *libraryPage)
+	srv.ch <- cmdLibraryPage{
+		offset: offset,
+		limit:  limit,
+		done:   ch,
+	}
+	return <-ch, nil
+}
+
+type cmdLibraryPage struct {
+	offset, limit int
+	done          chan *libraryPage
+}
+
+// cmdTrack sends the info of a song in the library, or nil, to done.
+type cmdTrack struct {
+	id   SongID
+	done chan *codec.SongInfo
+}
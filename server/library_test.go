@@ -0,0 +1,213 @@
+package server
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+)
+
+// diffSummary returns the changes in d as sorted strings of "+" for added,
+// "~" for changed or "-" for removed and the song ID.
+func diffSummary(d *libraryDiff) []string {
+	var s []string
+	for _, i := range d.Instances {
+		for _, item := range i.Added {
+			s = append(s, "+"+string(item.ID))
+		}
+		for _, item := range i.Changed {
+			s = append(s, "~"+string(item.ID))
+		}
+		for _, id := range i.Removed {
+			s = append(s, "-"+string(id))
+		}
+	}
+	sort.Strings(s)
+	return s
+}
+
+// fileSong returns the ID of song id of the file instance key.
+func fileSong(key, id string) string {
+	return string(codec.NewID("file", key, id))
+}
+
+func TestUpdateLibrary(t *testing.T) {
+	type songs map[string]protocol.SongList
+	tests := []struct {
+		name string
+		// songs are the lists of the instances of the file protocol.
+		songs songs
+		// want is the diff summary, or nil if nothing changed.
+		want []string
+	}{
+		{"empty", songs{}, nil},
+		{
+			"add",
+			songs{"a": {"1": {Title: "one"}, "2": {Title: "two"}}},
+			[]string{"+" + fileSong("a", "1"), "+" + fileSong("a", "2")},
+		},
+		{"same", songs{"a": {"1": {Title: "one"}, "2": {Title: "two"}}}, nil},
+		{
+			"change and remove",
+			songs{"a": {"1": {Title: "uno"}}},
+			[]string{"-" + fileSong("a", "2"), "~" + fileSong("a", "1")},
+		},
+		{
+			"instances",
+			songs{"a": {"1": {Title: "uno"}}, "b": {"1": {Title: "one"}}},
+			[]string{"+" + fileSong("b", "1")},
+		},
+		{"remove instance", songs{"b": {"1": {Title: "one"}}}, []string{"-" + fileSong("a", "1")}},
+	}
+	srv := new(Server)
+	seq := int64(0)
+	for _, test := range tests {
+		insts := make(map[string]protocol.Instance)
+		for key, sl := range test.songs {
+			insts[key] = testInstance(sl)
+		}
+		srv.Protocols = map[string]map[string]protocol.Instance{"file": insts}
+		d := srv.updateLibrary()
+		if test.want == nil {
+			if d != nil {
+				t.Fatalf("%s: got diff %v, want none", test.name, diffSummary(d))
+			}
+			if srv.library.seq != seq {
+				t.Fatalf("%s: got seq %d, want %d", test.name, srv.library.seq, seq)
+			}
+			continue
+		}
+		seq++
+		if d == nil {
+			t.Fatalf("%s: no diff, want %v", test.name, test.want)
+		}
+		if d.Seq != seq || srv.library.seq != seq {
+			t.Fatalf("%s: got seq %d, library seq %d, want %d", test.name, d.Seq, srv.library.seq, seq)
+		}
+		for _, i := range d.Instances {
+			if i.Protocol != "file" || (i.Key != "a" && i.Key != "b") {
+				t.Fatalf("%s: bad instance %s %s", test.name, i.Protocol, i.Key)
+			}
+		}
+		sort.Strings(test.want)
+		if got := diffSummary(d); !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestUpdateLibraryCopies(t *testing.T) {
+	// Changing an instance's info in place is seen as a change.
+	info := &codec.SongInfo{Title: "one"}
+	srv := &Server{
+		Protocols: map[string]map[string]protocol.Instance{
+			"file": {"a": testInstance{"1": info}},
+		},
+	}
+	srv.updateLibrary()
+	info.Title = "uno"
+	d := srv.updateLibrary()
+	if d == nil || len(d.Instances) != 1 || len(d.Instances[0].Changed) != 1 {
+		t.Fatalf("got %+v, want one change", d)
+	}
+	if got := d.Instances[0].Changed[0].Info.Title; got != "uno" {
+		t.Fatalf("got title %q", got)
+	}
+}
+
+func TestLibrarySince(t *testing.T) {
+	lib := &library{seq: 5}
+	for seq := int64(3); seq <= 5; seq++ {
+		lib.diffs = append(lib.diffs, &libraryDiff{Seq: seq})
+	}
+	tests := []struct {
+		seq int64
+		// want is the sequence numbers of the diffs, or -1 for a reset.
+		want []int64
+	}{
+		{5, nil},
+		{4, []int64{5}},
+		{3, []int64{4, 5}},
+		{2, []int64{3, 4, 5}},
+		// Diffs after 1 are no longer kept.
+		{1, []int64{-1}},
+		{0, []int64{-1}},
+		// From a server that has restarted.
+		{9, []int64{-1}},
+	}
+	for _, test := range tests {
+		var got []int64
+		for _, d := range lib.since(test.seq) {
+			if d.Reset {
+				if d.Seq != lib.seq {
+					t.Errorf("%d: reset to %d", test.seq, d.Seq)
+				}
+				got = append(got, -1)
+			} else {
+				got = append(got, d.Seq)
+			}
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d: got %v, want %v", test.seq, got, test.want)
+		}
+	}
+	if d := (&library{seq: 1}).since(0); len(d) != 1 || !d[0].Reset {
+		t.Errorf("no diffs: got %+v, want reset", d)
+	}
+}
+
+func TestLibraryPage(t *testing.T) {
+	lib := &library{
+		seq:   7,
+		songs: make(map[SongID]*codec.SongInfo),
+	}
+	for _, id := range []string{"c", "a", "e", "b", "d"} {
+		lib.songs[SongID(id)] = &codec.SongInfo{Title: id}
+	}
+	tests := []struct {
+		offset, limit int
+		want          string
+	}{
+		{0, 10, "abcde"},
+		{0, 2, "ab"},
+		{2, 2, "cd"},
+		{4, 2, "e"},
+		{5, 2, ""},
+		{100, 2, ""},
+		{1, 0, ""},
+	}
+	for _, test := range tests {
+		p := lib.page(test.offset, test.limit)
+		if p.Seq != 7 || p.Total != 5 {
+			t.Errorf("%d, %d: got seq %d, total %d", test.offset, test.limit, p.Seq, p.Total)
+		}
+		if p.Tracks == nil {
+			t.Errorf("%d, %d: nil tracks", test.offset, test.limit)
+		}
+		got := ""
+		for _, item := range p.Tracks {
+			if item.Info != lib.songs[item.ID] {
+				t.Errorf("%d, %d: wrong info for %s", test.offset, test.limit, item.ID)
+			}
+			got += string(item.ID)
+		}
+		if got != test.want {
+			t.Errorf("%d, %d: got %q, want %q", test.offset, test.limit, got, test.want)
+		}
+	}
+
+	// Pages are sorted again after a change.
+	srv := &Server{
+		library: *lib,
+		Protocols: map[string]map[string]protocol.Instance{
+			"file": {"a": testInstance{"1": {Title: "one"}}},
+		},
+	}
+	srv.updateLibrary()
+	p := srv.library.page(0, 10)
+	if want := SongID(fileSong("a", "1")); p.Total != 1 || p.Tracks[0].ID != want || p.Seq != 8 {
+		t.Fatalf("got %+v, want only %s at seq 8", p, want)
+	}
+}
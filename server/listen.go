@@ -0,0 +1,184 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// Options of the web server, set before ListenAndServe.
+var (
+	// TLSCert and TLSKey are the PEM certificate and key files to serve
+	// HTTPS with.
+	TLSCert, TLSKey string
+	// TLSSelfSigned serves HTTPS with a self-signed certificate, made once
+	// and kept in the state file, if TLSCert is empty.
+	TLSSelfSigned bool
+	// BaseURL is the URL that clients reach the server at, like
+	// https://example.com/music. It is made from the listen address if
+	// empty.
+	BaseURL string
+	// PathPrefix is the URL path, like /music, that the UI and API are
+	// served under, as by a reverse proxy that passes it through.
+	PathPrefix string
+)
+
+// ExternalURL returns the URL of the server listening on addr, without a
+// trailing slash.
+func ExternalURL(addr string) string {
+	if BaseURL != "" {
+		return strings.TrimSuffix(BaseURL, "/")
+	}
+	scheme := "http"
+	if TLSCert != "" || TLSSelfSigned {
+		scheme = "https"
+	}
+	host := addr
+	if strings.HasPrefix(host, ":") {
+		host = "localhost" + host
+	}
+	return scheme + "://" + host + PathPrefix
+}
+
+// isUnix reports whether addr names a Unix socket, like unix:/run/moggio.sock.
+func isUnix(addr string) bool {
+	return strings.HasPrefix(addr, "unix:")
+}
+
+// listen listens on the TCP network address or Unix socket addr.
+func listen(addr string) (net.Listener, error) {
+	if !isUnix(addr) {
+		return net.Listen("tcp", addr)
+	}
+	path := strings.TrimPrefix(addr, "unix:")
+	// Remove the socket of a previous run, which would be in use.
+	if fi, err := os.Stat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	return net.Listen("unix", path)
+}
+
+// tlsConfig returns the TLS configuration from the options, or nil to serve
+// plain HTTP.
+func (srv *Server) tlsConfig() (*tls.Config, error) {
+	var cert tls.Certificate
+	var err error
+	switch {
+	case TLSCert != "":
+		cert, err = tls.LoadX509KeyPair(TLSCert, TLSKey)
+	case TLSSelfSigned:
+		cert, err = srv.selfSigned()
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{Certificates: []tls.Certificate{cert}}, nil
+}
+
+// dbTLS is the bucket of the self-signed certificate and key.
+const dbTLS = "tls"
+
+// selfSigned returns the stored self-signed certificate, or makes a new one
+// if there is none, it expires within a month, or it isn't valid for the
+// host of BaseURL.
+func (srv *Server) selfSigned() (tls.Certificate, error) {
+	var certPEM, keyPEM []byte
+	srv.db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket([]byte(dbTLS)); b != nil {
+			certPEM = append(certPEM, b.Get([]byte("cert"))...)
+			keyPEM = append(keyPEM, b.Get([]byte("key"))...)
+		}
+		return nil
+	})
+	host := "localhost"
+	if u, err := url.Parse(BaseURL); err == nil && u.Host != "" {
+		host = u.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+	}
+	if cert, err := tls.X509KeyPair(certPEM, keyPEM); err == nil {
+		c, err := x509.ParseCertificate(cert.Certificate[0])
+		if err == nil && time.Now().AddDate(0, 1, 0).Before(c.NotAfter) && c.VerifyHostname(host) == nil {
+			return cert, nil
+		}
+	}
+	certPEM, keyPEM, err := makeCert(host)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	err = srv.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(dbTLS))
+		if err != nil {
+			return err
+		}
+		if err := b.Put([]byte("cert"), certPEM); err != nil {
+			return err
+		}
+		return b.Put([]byte("key"), keyPEM)
+	})
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+// makeCert makes a PEM certificate and key, valid for ten years for host,
+// localhost, the machine's hostname and the loopback addresses.
+func makeCert(host string) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	tmpl := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "moggio"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		DNSNames:              []string{"localhost"},
+	}
+	if h, err := os.Hostname(); err == nil {
+		tmpl.DNSNames = append(tmpl.DNSNames, h)
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+	} else if host != "localhost" {
+		tmpl.DNSNames = append(tmpl.DNSNames, host)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	kb, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kb})
+	return certPEM, keyPEM, nil
+}
@@ -0,0 +1,1483 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bradfitz/slice"
+	"github.com/mjibson/moggio/codec"
+)
+
+// MPDAddr is the TCP address to serve the MPD protocol on, or empty to not.
+var MPDAddr string
+
+// mpdVersion is the MPD protocol version in the greeting.
+const mpdVersion = "0.19.0"
+
+// ListenMPD listens on the TCP network address addr and serves MPD clients
+// in the background.
+func (srv *Server) ListenMPD(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	log.Println("moggio: mpd listening on", addr)
+	started := time.Now()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				if ne, ok := err.(net.Error); ok && ne.Temporary() {
+					time.Sleep(time.Second)
+					continue
+				}
+				log.Println("mpd:", err)
+				return
+			}
+			go srv.serveMPD(conn, started)
+		}
+	}()
+	return nil
+}
+
+// MPD error codes.
+const (
+	mpdErrArg        = 2
+	mpdErrPassword   = 3
+	mpdErrPermission = 4
+	mpdErrUnknown    = 5
+	mpdErrNoExist    = 50
+	mpdErrSystem     = 52
+	mpdErrExist      = 56
+)
+
+type mpdError struct {
+	code int
+	msg  string
+}
+
+func (e *mpdError) Error() string {
+	return e.msg
+}
+
+func mpdErrorf(code int, format string, args ...interface{}) error {
+	return &mpdError{code, fmt.Sprintf(format, args...)}
+}
+
+// mpdSubsystems are the idle subsystems that change with each wait type.
+var mpdSubsystems = map[waitType][]string{
+	waitStatus:    {"player", "mixer", "options", "output"},
+	waitPlaylist:  {"playlist", "stored_playlist"},
+	waitLibrary:   {"database"},
+	waitProtocols: {"update"},
+}
+
+type mpdConn struct {
+	srv     *Server
+	w       *bufio.Writer
+	started time.Time
+	events  chan waitType
+	// role is that of the password given, if any.
+	role role
+	// changed holds the subsystems that changed since the last idle.
+	changed map[string]bool
+
+	// cmdList holds the commands of a command list, if one is begun.
+	cmdList [][]string
+	inList  bool
+	listOK  bool
+}
+
+func (srv *Server) serveMPD(conn net.Conn, started time.Time) {
+	defer conn.Close()
+	c := &mpdConn{
+		srv:     srv,
+		w:       bufio.NewWriter(conn),
+		started: started,
+		events:  make(chan waitType, 64),
+		changed: make(map[string]bool),
+	}
+	srv.ch <- cmdNewIdler(c.events)
+	defer func() {
+		srv.ch <- cmdDeleteIdler(c.events)
+	}()
+	lines := make(chan string)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(lines)
+		s := bufio.NewScanner(conn)
+		for s.Scan() {
+			select {
+			case lines <- s.Text():
+			case <-done:
+				return
+			}
+		}
+	}()
+	fmt.Fprintf(c.w, "OK MPD %s\n", mpdVersion)
+	c.w.Flush()
+	for {
+		select {
+		case wt := <-c.events:
+			c.change(wt)
+		case line, ok := <-lines:
+			if !ok || !c.line(line, lines) {
+				return
+			}
+			if err := c.w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *mpdConn) change(wt waitType) {
+	for _, s := range mpdSubsystems[wt] {
+		c.changed[s] = true
+	}
+}
+
+// line runs a command line. It returns false if the connection should close.
+func (c *mpdConn) line(line string, lines <-chan string) bool {
+	args, err := parseMPDArgs(line)
+	if err == nil && len(args) == 0 {
+		err = mpdErrorf(mpdErrUnknown, "No command given")
+	}
+	if err != nil {
+		c.ack(err, 0, "")
+		c.cmdList, c.inList = nil, false
+		return true
+	}
+	if c.inList {
+		if args[0] != "command_list_end" {
+			c.cmdList = append(c.cmdList, args)
+			return true
+		}
+		list := c.cmdList
+		c.cmdList, c.inList = nil, false
+		for i, a := range list {
+			if err := c.exec(a); err != nil {
+				c.ack(err, i, a[0])
+				return true
+			}
+			if c.listOK {
+				fmt.Fprintln(c.w, "list_OK")
+			}
+		}
+		fmt.Fprintln(c.w, "OK")
+		return true
+	}
+	switch args[0] {
+	case "command_list_begin", "command_list_ok_begin":
+		c.inList = true
+		c.listOK = args[0] == "command_list_ok_begin"
+		return true
+	case "idle":
+		if err := c.check("idle"); err != nil {
+			c.ack(err, 0, "idle")
+			return true
+		}
+		return c.idle(args[1:], lines)
+	case "noidle":
+		// Not idle, so nothing to stop.
+		return true
+	case "close":
+		return false
+	}
+	if err := c.exec(args); err != nil {
+		c.ack(err, 0, args[0])
+	} else {
+		fmt.Fprintln(c.w, "OK")
+	}
+	return true
+}
+
+func (c *mpdConn) exec(args []string) error {
+	h := mpdCommands[args[0]]
+	if h == nil {
+		return mpdErrorf(mpdErrUnknown, "unknown command %q", args[0])
+	}
+	if err := c.check(args[0]); err != nil {
+		return err
+	}
+	return h(c, args[1:])
+}
+
+// mpdOpenCommands need no password.
+var mpdOpenCommands = map[string]bool{
+	"commands":    true,
+	"notcommands": true,
+	"password":    true,
+	"ping":        true,
+	"tagtypes":    true,
+}
+
+// mpdAdminCommands change the server, so need the admin role.
+var mpdAdminCommands = map[string]bool{
+	"add":            true,
+	"addid":          true,
+	"clear":          true,
+	"delete":         true,
+	"deleteid":       true,
+	"load":           true,
+	"next":           true,
+	"pause":          true,
+	"play":           true,
+	"playid":         true,
+	"playlistadd":    true,
+	"playlistclear":  true,
+	"playlistdelete": true,
+	"previous":       true,
+	"random":         true,
+	"repeat":         true,
+	"rm":             true,
+	"save":           true,
+	"seek":           true,
+	"seekcur":        true,
+	"seekid":         true,
+	"setvol":         true,
+	"stop":           true,
+}
+
+// check returns an error if the client may not run cmd. All may if
+// authentication is off.
+func (c *mpdConn) check(cmd string) error {
+	if mpdOpenCommands[cmd] || !c.srv.authEnabled() {
+		return nil
+	}
+	need := roleRead
+	if mpdAdminCommands[cmd] {
+		need = roleAdmin
+	}
+	if c.role < need {
+		return mpdErrorf(mpdErrPermission, "you don't have permission for %q", cmd)
+	}
+	return nil
+}
+
+func (c *mpdConn) ack(err error, i int, cmd string) {
+	code := mpdErrSystem
+	if e, ok := err.(*mpdError); ok {
+		code = e.code
+	}
+	fmt.Fprintf(c.w, "ACK [%d@%d] {%s} %s\n", code, i, cmd, err)
+}
+
+// idle waits until one of subsystems, or any if none are given, changes and
+// then reports which did. It returns false if the connection closed.
+func (c *mpdConn) idle(subsystems []string, lines <-chan string) bool {
+	want := func(s string) bool {
+		if len(subsystems) == 0 {
+			return true
+		}
+		for _, v := range subsystems {
+			if v == s {
+				return true
+			}
+		}
+		return false
+	}
+	for {
+		var changed []string
+		for s := range c.changed {
+			if want(s) {
+				changed = append(changed, s)
+			}
+		}
+		if len(changed) > 0 {
+			sort.Strings(changed)
+			for _, s := range changed {
+				c.pair("changed", s)
+				delete(c.changed, s)
+			}
+			fmt.Fprintln(c.w, "OK")
+			return true
+		}
+		c.w.Flush()
+		select {
+		case wt := <-c.events:
+			c.change(wt)
+		case line, ok := <-lines:
+			// Only noidle is allowed while idle.
+			if !ok || strings.TrimSpace(line) != "noidle" {
+				return false
+			}
+			fmt.Fprintln(c.w, "OK")
+			return true
+		}
+	}
+}
+
+// pair writes a response line. Newlines in value are replaced, since they
+// would end it.
+func (c *mpdConn) pair(key string, value interface{}) {
+	v := strings.Replace(fmt.Sprint(value), "\n", " ", -1)
+	fmt.Fprintf(c.w, "%s: %s\n", key, v)
+}
+
+// run sends cmd to the server and waits until it is done, so that the
+// commands of a client are applied in order.
+func (c *mpdConn) run(cmd interface{}) {
+	done := make(chan struct{})
+	c.srv.ch <- cmdSync{cmd, done}
+	<-done
+}
+
+func (c *mpdConn) state(playlists bool) *snapshot {
+	return c.srv.snapshot(playlists)
+}
+
+func (c *mpdConn) index() *searchIndex {
+	ch := make(chan *searchIndex)
+	c.srv.ch <- cmdSearchIndex(ch)
+	return <-ch
+}
+
+// mpdQueueIDs returns the ID of each song of the queue. A song keeps its ID
+// while others are added or removed, and added songs get new ones, so that
+// clients can refer to them after the queue changes. It should only be called
+// by the commands() function.
+func (srv *Server) mpdQueueIDs() []int {
+	same := len(srv.Queue) == len(srv.idQueue)
+	for i := 0; same && i < len(srv.Queue); i++ {
+		same = srv.Queue[i] == srv.idQueue[i]
+	}
+	if same {
+		return srv.queueIDs
+	}
+	// The queue only changes by removing songs and adding them at the end,
+	// so keep the IDs of the old songs that are still there in order.
+	old := srv.idQueue
+	ids := make([]int, len(srv.Queue))
+	j := 0
+	for i, id := range srv.Queue {
+		k := j
+		for k < len(old) && old[k] != id {
+			k++
+		}
+		if k < len(old) {
+			ids[i] = srv.queueIDs[k]
+			j = k + 1
+		} else {
+			// The rest were added.
+			srv.lastQueueID++
+			ids[i] = srv.lastQueueID
+			j = len(old)
+		}
+	}
+	srv.idQueue = append(Playlist(nil), srv.Queue...)
+	srv.queueIDs = ids
+	return ids
+}
+
+// mpdPosition returns the position in the queue of st of the song with ID s.
+func mpdPosition(st *snapshot, s string) (int, error) {
+	id, err := mpdInt(s)
+	if err != nil {
+		return 0, err
+	}
+	for i, v := range st.ids {
+		if v == id {
+			return i, nil
+		}
+	}
+	return 0, mpdErrorf(mpdErrNoExist, "No such song")
+}
+
+var mpdCommands map[string]func(*mpdConn, []string) error
+
+func init() {
+	mpdCommands = map[string]func(*mpdConn, []string) error{
+		"add":              (*mpdConn).add,
+		"addid":            (*mpdConn).addid,
+		"clear":            (*mpdConn).clear,
+		"commands":         (*mpdConn).commands,
+		"currentsong":      (*mpdConn).currentsong,
+		"delete":           (*mpdConn).delete,
+		"deleteid":         (*mpdConn).deleteid,
+		"find":             (*mpdConn).find,
+		"list":             (*mpdConn).list,
+		"listplaylist":     (*mpdConn).listplaylist,
+		"listplaylistinfo": (*mpdConn).listplaylistinfo,
+		"listplaylists":    (*mpdConn).listplaylists,
+		"load":             (*mpdConn).load,
+		"next":             (*mpdConn).next,
+		"notcommands":      (*mpdConn).notcommands,
+		"outputs":          (*mpdConn).outputs,
+		"password":         (*mpdConn).password,
+		"pause":            (*mpdConn).pause,
+		"ping":             (*mpdConn).ping,
+		"play":             (*mpdConn).play,
+		"playid":           (*mpdConn).playid,
+		"playlistadd":      (*mpdConn).playlistadd,
+		"playlistclear":    (*mpdConn).playlistclear,
+		"playlistdelete":   (*mpdConn).playlistdelete,
+		"playlistid":       (*mpdConn).playlistid,
+		"playlistinfo":     (*mpdConn).playlistinfo,
+		"plchanges":        (*mpdConn).plchanges,
+		"previous":         (*mpdConn).previous,
+		"random":           (*mpdConn).random,
+		"repeat":           (*mpdConn).repeat,
+		"rm":               (*mpdConn).rm,
+		"save":             (*mpdConn).save,
+		"search":           (*mpdConn).search,
+		"seek":             (*mpdConn).seek,
+		"seekcur":          (*mpdConn).seekcur,
+		"seekid":           (*mpdConn).seekid,
+		"setvol":           (*mpdConn).setvol,
+		"stats":            (*mpdConn).stats,
+		"status":           (*mpdConn).status,
+		"stop":             (*mpdConn).stop,
+		"tagtypes":         (*mpdConn).tagtypes,
+	}
+}
+
+func nargs(args []string, min, max int) error {
+	if len(args) < min || len(args) > max {
+		return mpdErrorf(mpdErrArg, "wrong number of arguments")
+	}
+	return nil
+}
+
+func mpdInt(s string) (int, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, mpdErrorf(mpdErrArg, "Integer expected: %s", s)
+	}
+	return i, nil
+}
+
+func mpdBool(s string) (bool, error) {
+	switch s {
+	case "0":
+		return false, nil
+	case "1":
+		return true, nil
+	}
+	return false, mpdErrorf(mpdErrArg, "Boolean (0/1) expected: %s", s)
+}
+
+// mpdRange parses a position, or a range of them like START:END or START:,
+// of a list of n songs.
+func mpdRange(s string, n int) (start, end int, err error) {
+	i := strings.IndexByte(s, ':')
+	if i < 0 {
+		start, err = mpdInt(s)
+		if err != nil {
+			return
+		}
+		if start < 0 || start >= n {
+			return 0, 0, mpdErrorf(mpdErrArg, "Bad song index")
+		}
+		return start, start + 1, nil
+	}
+	if start, err = mpdInt(s[:i]); err != nil {
+		return 0, 0, err
+	}
+	end = n
+	if s[i+1:] != "" {
+		if end, err = mpdInt(s[i+1:]); err != nil {
+			return 0, 0, err
+		}
+	}
+	if end > n {
+		end = n
+	}
+	if start < 0 || start > end {
+		return 0, 0, mpdErrorf(mpdErrArg, "Bad song index")
+	}
+	return start, end, nil
+}
+
+// mpdURIs escapes song IDs for use as MPD URIs, which must not contain
+// newlines.
+var mpdURIs = strings.NewReplacer("%", "%25", codec.IdSep, "%0A")
+
+func mpdURI(id SongID) string {
+	return mpdURIs.Replace(string(id))
+}
+
+func parseMPDURI(uri string) SongID {
+	return SongID(strings.NewReplacer("%0A", codec.IdSep, "%25", "%").Replace(uri))
+}
+
+// song returns the song of uri from idx.
+func (idx *searchIndex) song(uri string) (listItem, error) {
+	id := parseMPDURI(uri)
+	for _, d := range idx.docs {
+		if d.item.ID == id {
+			return d.item, nil
+		}
+	}
+	return listItem{}, mpdErrorf(mpdErrNoExist, "No such song")
+}
+
+// mpdTags are the tags of songs, in the order they are written.
+var mpdTags = []struct {
+	name string
+	get  func(*codec.SongInfo) string
+}{
+	{"Artist", func(si *codec.SongInfo) string { return si.Artist }},
+	{"Album", func(si *codec.SongInfo) string { return si.Album }},
+	{"AlbumArtist", func(si *codec.SongInfo) string { return si.AlbumArtist }},
+	{"Title", func(si *codec.SongInfo) string { return si.Title }},
+	{"Track", func(si *codec.SongInfo) string { return mpdNumber(si.Track) }},
+	{"Disc", func(si *codec.SongInfo) string { return mpdNumber(si.Disc) }},
+	{"Genre", func(si *codec.SongInfo) string { return si.Genre }},
+	{"Date", func(si *codec.SongInfo) string { return mpdNumber(float64(si.Year)) }},
+	{"Composer", func(si *codec.SongInfo) string { return si.Composer }},
+}
+
+func mpdNumber(f float64) string {
+	if f == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// mpdTagName returns the name of tag as written in responses, and whether it
+// is known. The file tag is known, any is not.
+func mpdTagName(tag string) (string, bool) {
+	if strings.EqualFold(tag, "file") {
+		return "file", true
+	}
+	for _, t := range mpdTags {
+		if strings.EqualFold(t.name, tag) {
+			return t.name, true
+		}
+	}
+	return "", false
+}
+
+// mpdValues returns the values of tag of a song. The any tag has all of them.
+func mpdValues(tag string, item listItem) []string {
+	if tag == "file" {
+		return []string{mpdURI(item.ID)}
+	}
+	var values []string
+	for _, t := range mpdTags {
+		if tag == "any" || tag == t.name {
+			values = append(values, t.get(item.Info))
+		}
+	}
+	if tag == "any" {
+		values = append(values, mpdURI(item.ID))
+	}
+	return values
+}
+
+// song writes the tags of item and, if pos is not -1, its position and ID in
+// the queue.
+func (c *mpdConn) song(item listItem, pos, id int) {
+	c.pair("file", mpdURI(item.ID))
+	if si := item.Info; si != nil {
+		for _, t := range mpdTags {
+			if v := t.get(si); v != "" {
+				c.pair(t.name, v)
+			}
+		}
+		if si.Time > 0 {
+			c.pair("Time", int(si.Time.Seconds()))
+			c.pair("duration", fmt.Sprintf("%.3f", si.Time.Seconds()))
+		}
+	}
+	if pos >= 0 {
+		c.pair("Pos", pos)
+		c.pair("Id", id)
+	}
+}
+
+// Status commands.
+
+func (c *mpdConn) ping(args []string) error {
+	return nargs(args, 0, 0)
+}
+
+// password sets the role of the client to that of the password or an API
+// token.
+func (c *mpdConn) password(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	ro, _ := c.srv.checkSecret(args[0])
+	if ro == roleNone {
+		// Slow down guessing.
+		time.Sleep(time.Second)
+		return mpdErrorf(mpdErrPassword, "incorrect password")
+	}
+	c.role = ro
+	return nil
+}
+
+func (c *mpdConn) commands(args []string) error {
+	var names []string
+	for name := range mpdCommands {
+		names = append(names, name)
+	}
+	names = append(names, "close", "command_list_begin", "command_list_ok_begin", "command_list_end", "idle", "noidle")
+	sort.Strings(names)
+	for _, name := range names {
+		c.pair("command", name)
+	}
+	return nil
+}
+
+func (c *mpdConn) notcommands(args []string) error {
+	return nil
+}
+
+func (c *mpdConn) tagtypes(args []string) error {
+	for _, t := range mpdTags {
+		c.pair("tagtype", t.name)
+	}
+	return nil
+}
+
+func (c *mpdConn) outputs(args []string) error {
+	st := c.state(false)
+	for i, o := range st.Outputs {
+		c.pair("outputid", i)
+		c.pair("outputname", o.Name)
+		c.pair("outputenabled", 1)
+	}
+	return nil
+}
+
+func (c *mpdConn) status(args []string) error {
+	st := c.state(false)
+	volume := int(st.Volume*100 + 0.5)
+	if st.Mute {
+		volume = 0
+	}
+	c.pair("volume", volume)
+	c.pair("repeat", mpdFlag(st.Repeat))
+	c.pair("random", mpdFlag(st.Random))
+	c.pair("single", 0)
+	c.pair("consume", 0)
+	c.pair("playlist", st.version)
+	c.pair("playlistlength", len(st.queue))
+	c.pair("xfade", int(st.Crossfade.Seconds()))
+	c.pair("state", st.State)
+	if i := st.current(); i >= 0 {
+		c.pair("song", i)
+		c.pair("songid", st.ids[i])
+	}
+	if st.State != stateStop {
+		c.pair("time", fmt.Sprintf("%d:%d", int(st.Elapsed.Seconds()), int(st.Time.Seconds())))
+		c.pair("elapsed", fmt.Sprintf("%.3f", st.Elapsed.Seconds()))
+		c.pair("duration", fmt.Sprintf("%.3f", st.Time.Seconds()))
+		c.pair("bitrate", st.SongInfo.Bitrate/1000)
+	}
+	return nil
+}
+
+func mpdFlag(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (c *mpdConn) currentsong(args []string) error {
+	st := c.state(false)
+	if i := st.current(); i >= 0 {
+		c.song(st.queue[i], i, st.ids[i])
+	}
+	return nil
+}
+
+func (c *mpdConn) stats(args []string) error {
+	idx := c.index()
+	artists := make(map[string]bool)
+	albums := make(map[string]bool)
+	var playtime time.Duration
+	for _, d := range idx.docs {
+		artists[d.item.Info.Artist] = true
+		albums[d.item.Info.Album] = true
+		playtime += d.item.Info.Time
+	}
+	c.pair("artists", len(artists))
+	c.pair("albums", len(albums))
+	c.pair("songs", len(idx.docs))
+	c.pair("uptime", int(time.Since(c.started).Seconds()))
+	c.pair("playtime", 0)
+	c.pair("db_playtime", int(playtime.Seconds()))
+	return nil
+}
+
+// Playback commands.
+
+// play plays the song at a position, or resumes.
+func (c *mpdConn) play(args []string) error {
+	return c.playSong(args, func(st *snapshot, s string) (int, error) {
+		i, _, err := mpdRange(s, len(st.queue))
+		return i, err
+	})
+}
+
+// playid plays the song with an ID, or resumes.
+func (c *mpdConn) playid(args []string) error {
+	return c.playSong(args, mpdPosition)
+}
+
+// playSong plays the song in the queue that find returns for args[0], or
+// resumes if there is none or it is -1.
+func (c *mpdConn) playSong(args []string, find func(*snapshot, string) (int, error)) error {
+	if err := nargs(args, 0, 1); err != nil {
+		return err
+	}
+	st := c.state(false)
+	if len(args) == 0 || args[0] == "-1" {
+		if st.State == statePause {
+			c.run(cmdPause)
+		} else {
+			c.run(cmdPlay)
+		}
+		return nil
+	}
+	i, err := find(st, args[0])
+	if err != nil {
+		return err
+	}
+	c.run(cmdPlayIdx(i))
+	return nil
+}
+
+func (c *mpdConn) pause(args []string) error {
+	if err := nargs(args, 0, 1); err != nil {
+		return err
+	}
+	st := c.state(false)
+	want := st.State == statePlay
+	if len(args) == 1 {
+		var err error
+		if want, err = mpdBool(args[0]); err != nil {
+			return err
+		}
+	}
+	if (want && st.State == statePlay) || (!want && st.State == statePause) {
+		c.run(cmdPause)
+	}
+	return nil
+}
+
+func (c *mpdConn) stop(args []string) error {
+	c.run(cmdStop)
+	return nil
+}
+
+func (c *mpdConn) next(args []string) error {
+	c.run(cmdNext)
+	return nil
+}
+
+func (c *mpdConn) previous(args []string) error {
+	c.run(cmdPrev)
+	return nil
+}
+
+func mpdSeconds(s string) (time.Duration, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, mpdErrorf(mpdErrArg, "Number expected: %s", s)
+	}
+	return time.Duration(f * float64(time.Second)), nil
+}
+
+// seek seeks in the song at a position, playing it first if it is not
+// current.
+func (c *mpdConn) seek(args []string) error {
+	return c.seekSong(args, func(st *snapshot, s string) (int, error) {
+		i, _, err := mpdRange(s, len(st.queue))
+		return i, err
+	})
+}
+
+// seekid seeks in the song with an ID, playing it first if it is not
+// current.
+func (c *mpdConn) seekid(args []string) error {
+	return c.seekSong(args, mpdPosition)
+}
+
+// seekSong seeks in the song in the queue that find returns for args[0].
+func (c *mpdConn) seekSong(args []string, find func(*snapshot, string) (int, error)) error {
+	if err := nargs(args, 2, 2); err != nil {
+		return err
+	}
+	st := c.state(false)
+	i, err := find(st, args[0])
+	if err != nil {
+		return err
+	}
+	d, err := mpdSeconds(args[1])
+	if err != nil {
+		return err
+	}
+	if i != st.current() || st.State == stateStop {
+		c.run(cmdPlayIdx(i))
+	}
+	c.run(cmdSeek(d))
+	return nil
+}
+
+// seekcur seeks in the current song, relative to the elapsed time if the
+// time is signed.
+func (c *mpdConn) seekcur(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	d, err := mpdSeconds(args[0])
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(args[0], "+") || strings.HasPrefix(args[0], "-") {
+		d += c.state(false).Elapsed
+		if d < 0 {
+			d = 0
+		}
+	}
+	c.run(cmdSeek(d))
+	return nil
+}
+
+func (c *mpdConn) setvol(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	v, err := mpdInt(args[0])
+	if err != nil {
+		return err
+	}
+	if v < 0 || v > 100 {
+		return mpdErrorf(mpdErrArg, "Invalid volume value")
+	}
+	if st := c.state(false); st.Mute && v > 0 {
+		c.run(cmdMute)
+	}
+	c.run(cmdVolume(float64(v) / 100))
+	return nil
+}
+
+func (c *mpdConn) random(args []string) error {
+	return c.toggle(args, cmdRandom, func(st *snapshot) bool { return st.Random })
+}
+
+func (c *mpdConn) repeat(args []string) error {
+	return c.toggle(args, cmdRepeat, func(st *snapshot) bool { return st.Repeat })
+}
+
+// toggle sends cmd if the setting get returns is not as args asks.
+func (c *mpdConn) toggle(args []string, cmd controlCmd, get func(*snapshot) bool) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	want, err := mpdBool(args[0])
+	if err != nil {
+		return err
+	}
+	if get(c.state(false)) != want {
+		c.run(cmd)
+	}
+	return nil
+}
+
+// Queue commands.
+
+func (c *mpdConn) add(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	item, err := c.index().song(args[0])
+	if err != nil {
+		return err
+	}
+	c.run(cmdQueueChange{{"add", string(item.ID)}})
+	return nil
+}
+
+func (c *mpdConn) addid(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return mpdErrorf(mpdErrArg, "only adding to the end of the queue is supported")
+	}
+	if err := c.add(args); err != nil {
+		return err
+	}
+	// The song was added at the end.
+	ids := c.state(false).ids
+	c.pair("Id", ids[len(ids)-1])
+	return nil
+}
+
+func (c *mpdConn) delete(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	st := c.state(false)
+	start, end, err := mpdRange(args[0], len(st.queue))
+	if err != nil {
+		return err
+	}
+	var plc PlaylistChange
+	for i := start; i < end; i++ {
+		plc = append(plc, []string{"rem", strconv.Itoa(i)})
+	}
+	c.run(cmdQueueChange(plc))
+	return nil
+}
+
+func (c *mpdConn) deleteid(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	i, err := mpdPosition(c.state(false), args[0])
+	if err != nil {
+		return err
+	}
+	c.run(cmdQueueChange{{"rem", strconv.Itoa(i)}})
+	return nil
+}
+
+func (c *mpdConn) clear(args []string) error {
+	c.run(cmdQueueChange{{"clear"}})
+	return nil
+}
+
+func (c *mpdConn) playlistinfo(args []string) error {
+	if err := nargs(args, 0, 1); err != nil {
+		return err
+	}
+	st := c.state(false)
+	start, end := 0, len(st.queue)
+	if len(args) == 1 {
+		var err error
+		if start, end, err = mpdRange(args[0], len(st.queue)); err != nil {
+			return err
+		}
+	}
+	for i := start; i < end; i++ {
+		c.song(st.queue[i], i, st.ids[i])
+	}
+	return nil
+}
+
+// playlistid lists the song in the queue with an ID, or all of them.
+func (c *mpdConn) playlistid(args []string) error {
+	if err := nargs(args, 0, 1); err != nil {
+		return err
+	}
+	st := c.state(false)
+	if len(args) == 0 {
+		for i, item := range st.queue {
+			c.song(item, i, st.ids[i])
+		}
+		return nil
+	}
+	i, err := mpdPosition(st, args[0])
+	if err != nil {
+		return err
+	}
+	c.song(st.queue[i], i, st.ids[i])
+	return nil
+}
+
+// plchanges lists the whole queue if it changed since version, since old
+// versions are not kept.
+func (c *mpdConn) plchanges(args []string) error {
+	if err := nargs(args, 1, 2); err != nil {
+		return err
+	}
+	v, err := mpdInt(args[0])
+	if err != nil {
+		return err
+	}
+	st := c.state(false)
+	if v == st.version {
+		return nil
+	}
+	for i, item := range st.queue {
+		c.song(item, i, st.ids[i])
+	}
+	return nil
+}
+
+// Stored playlist commands.
+
+func (c *mpdConn) playlist(name string) (PlaylistInfo, error) {
+	p, ok := c.state(true).playlists[name]
+	if !ok {
+		return nil, mpdErrorf(mpdErrNoExist, "No such playlist")
+	}
+	return p, nil
+}
+
+func (c *mpdConn) listplaylists(args []string) error {
+	st := c.state(true)
+	var names []string
+	for name := range st.playlists {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c.pair("playlist", name)
+	}
+	return nil
+}
+
+func (c *mpdConn) listplaylist(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	p, err := c.playlist(args[0])
+	if err != nil {
+		return err
+	}
+	for _, item := range p {
+		c.pair("file", mpdURI(item.ID))
+	}
+	return nil
+}
+
+func (c *mpdConn) listplaylistinfo(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	p, err := c.playlist(args[0])
+	if err != nil {
+		return err
+	}
+	for _, item := range p {
+		c.song(item, -1, -1)
+	}
+	return nil
+}
+
+func (c *mpdConn) load(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	p, err := c.playlist(args[0])
+	if err != nil {
+		return err
+	}
+	var plc PlaylistChange
+	for _, item := range p {
+		plc = append(plc, []string{"add", string(item.ID)})
+	}
+	c.run(cmdQueueChange(plc))
+	return nil
+}
+
+func (c *mpdConn) save(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	st := c.state(true)
+	if _, ok := st.playlists[args[0]]; ok {
+		return mpdErrorf(mpdErrExist, "Playlist already exists")
+	}
+	var plc PlaylistChange
+	for _, item := range st.queue {
+		plc = append(plc, []string{"add", string(item.ID)})
+	}
+	c.run(cmdPlaylistChange{
+		plc:  plc,
+		name: args[0],
+	})
+	return nil
+}
+
+// rm removes a playlist by clearing it, since empty ones are removed.
+func (c *mpdConn) rm(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	if _, err := c.playlist(args[0]); err != nil {
+		return err
+	}
+	return c.playlistclear(args)
+}
+
+func (c *mpdConn) playlistclear(args []string) error {
+	if err := nargs(args, 1, 1); err != nil {
+		return err
+	}
+	c.run(cmdPlaylistChange{
+		plc:  PlaylistChange{{"clear"}},
+		name: args[0],
+	})
+	return nil
+}
+
+func (c *mpdConn) playlistadd(args []string) error {
+	if err := nargs(args, 2, 2); err != nil {
+		return err
+	}
+	item, err := c.index().song(args[1])
+	if err != nil {
+		return err
+	}
+	c.run(cmdPlaylistChange{
+		plc:  PlaylistChange{{"add", string(item.ID)}},
+		name: args[0],
+	})
+	return nil
+}
+
+func (c *mpdConn) playlistdelete(args []string) error {
+	if err := nargs(args, 2, 2); err != nil {
+		return err
+	}
+	p, err := c.playlist(args[0])
+	if err != nil {
+		return err
+	}
+	i, _, err := mpdRange(args[1], len(p))
+	if err != nil {
+		return err
+	}
+	c.run(cmdPlaylistChange{
+		plc:  PlaylistChange{{"rem", strconv.Itoa(i)}},
+		name: args[0],
+	})
+	return nil
+}
+
+// Database commands.
+
+// mpdFilter matches songs whose tag compares to value with op: ==, != or
+// contains. If fold is set, case and diacritics are ignored.
+type mpdFilter struct {
+	tag   string
+	op    string
+	value string
+	fold  bool
+}
+
+func newMPDFilter(tag, op, value string, folded bool) (mpdFilter, error) {
+	f := mpdFilter{
+		tag:   "any",
+		op:    op,
+		value: value,
+		fold:  folded,
+	}
+	if !strings.EqualFold(tag, "any") {
+		name, ok := mpdTagName(tag)
+		if !ok {
+			return f, mpdErrorf(mpdErrArg, "Unknown tag type: %s", tag)
+		}
+		f.tag = name
+	}
+	if folded {
+		f.value = fold(value)
+	}
+	return f, nil
+}
+
+func (f mpdFilter) match(item listItem) bool {
+	for _, v := range mpdValues(f.tag, item) {
+		if f.fold {
+			v = fold(v)
+		}
+		var ok bool
+		if f.op == "contains" {
+			ok = strings.Contains(v, f.value)
+		} else {
+			ok = v == f.value
+		}
+		if ok {
+			return f.op != "!="
+		}
+	}
+	return f.op == "!="
+}
+
+// parseMPDFilters parses the filters of find, search and list: either pairs
+// of tag and value, which must be equal or, if search is set, contained, or
+// an expression like ((artist == 'x') AND (album contains "y")).
+func parseMPDFilters(args []string, search bool) ([]mpdFilter, error) {
+	if len(args) == 1 && strings.HasPrefix(args[0], "(") {
+		return parseMPDExpr(args[0], search)
+	}
+	if len(args)%2 != 0 {
+		return nil, mpdErrorf(mpdErrArg, "Incorrect number of filter arguments")
+	}
+	op := "=="
+	if search {
+		op = "contains"
+	}
+	var filters []mpdFilter
+	for i := 0; i < len(args); i += 2 {
+		f, err := newMPDFilter(args[i], op, args[i+1], search)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+func parseMPDExpr(s string, folded bool) ([]mpdFilter, error) {
+	bad := mpdErrorf(mpdErrArg, "bad filter: %s", s)
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, bad
+	}
+	s = strings.TrimSpace(s[1 : len(s)-1])
+	if strings.HasPrefix(s, "(") {
+		// Expressions joined by AND.
+		var filters []mpdFilter
+		for {
+			end := mpdParen(s)
+			if end < 0 {
+				return nil, bad
+			}
+			fs, err := parseMPDExpr(s[:end], folded)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, fs...)
+			s = strings.TrimSpace(s[end:])
+			if s == "" {
+				return filters, nil
+			}
+			if !strings.HasPrefix(s, "AND ") {
+				return nil, bad
+			}
+			s = strings.TrimSpace(s[4:])
+		}
+	}
+	sp := strings.SplitN(s, " ", 3)
+	if len(sp) != 3 {
+		return nil, bad
+	}
+	switch sp[1] {
+	case "==", "!=", "contains":
+	default:
+		return nil, bad
+	}
+	value, ok := mpdUnquote(strings.TrimSpace(sp[2]))
+	if !ok {
+		return nil, bad
+	}
+	f, err := newMPDFilter(sp[0], sp[1], value, folded)
+	if err != nil {
+		return nil, err
+	}
+	return []mpdFilter{f}, nil
+}
+
+// mpdParen returns the index after the parenthesis that closes the one at
+// the start of s, or -1.
+func mpdParen(s string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch b := s[i]; {
+		case quote != 0 && b == '\\':
+			i++
+		case quote != 0:
+			if b == quote {
+				quote = 0
+			}
+		case b == '\'' || b == '"':
+			quote = b
+		case b == '(':
+			depth++
+		case b == ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return -1
+}
+
+// mpdUnquote returns the string in single or double quotes that is s.
+func mpdUnquote(s string) (string, bool) {
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return "", false
+	}
+	var b []byte
+	for i := 1; i < len(s)-1; i++ {
+		if s[i] == '\\' && i+1 < len(s)-1 {
+			i++
+		}
+		b = append(b, s[i])
+	}
+	return string(b), true
+}
+
+// filter returns the songs that match all filters, sorted by artist, album,
+// disc, track and title.
+func (idx *searchIndex) filter(filters []mpdFilter) []listItem {
+	var docs []int
+Docs:
+	for i, d := range idx.docs {
+		for _, f := range filters {
+			if !f.match(d.item) {
+				continue Docs
+			}
+		}
+		docs = append(docs, i)
+	}
+	slice.Sort(docs, func(i, j int) bool {
+		return idx.docs[docs[i]].less(&idx.docs[docs[j]])
+	})
+	items := make([]listItem, len(docs))
+	for i, d := range docs {
+		items[i] = idx.docs[d].item
+	}
+	return items
+}
+
+func (c *mpdConn) find(args []string) error {
+	return c.findSongs(args, false)
+}
+
+func (c *mpdConn) search(args []string) error {
+	return c.findSongs(args, true)
+}
+
+// findSongs lists the songs that match the filters in args. A trailing
+// window START:END limits them; sort is ignored, since they are always
+// sorted.
+func (c *mpdConn) findSongs(args []string, search bool) error {
+	window := ""
+	for len(args) >= 2 {
+		if k := args[len(args)-2]; k == "window" {
+			window = args[len(args)-1]
+		} else if k != "sort" {
+			break
+		}
+		args = args[:len(args)-2]
+	}
+	if len(args) == 0 {
+		return mpdErrorf(mpdErrArg, "Incorrect number of filter arguments")
+	}
+	filters, err := parseMPDFilters(args, search)
+	if err != nil {
+		return err
+	}
+	items := c.index().filter(filters)
+	if window != "" {
+		start, end, err := mpdRange(window, len(items))
+		if err != nil {
+			return err
+		}
+		items = items[start:end]
+	}
+	for _, item := range items {
+		c.song(item, -1, -1)
+	}
+	return nil
+}
+
+// list lists the distinct values of a tag of the songs that match filters,
+// grouped by other tags:
+//
+//	list album artist "The Beatles" group date
+//
+// A single filter argument for album is an artist, as in old versions.
+func (c *mpdConn) list(args []string) error {
+	if len(args) == 0 {
+		return mpdErrorf(mpdErrArg, "too few arguments for \"list\"")
+	}
+	tag, ok := mpdTagName(args[0])
+	if !ok {
+		return mpdErrorf(mpdErrArg, "Unknown tag type: %s", args[0])
+	}
+	args = args[1:]
+	var groups []string
+	for len(args) >= 2 && args[len(args)-2] == "group" {
+		g, ok := mpdTagName(args[len(args)-1])
+		if !ok {
+			return mpdErrorf(mpdErrArg, "Unknown tag type: %s", args[len(args)-1])
+		}
+		groups = append([]string{g}, groups...)
+		args = args[:len(args)-2]
+	}
+	if tag == "Album" && len(args) == 1 && !strings.HasPrefix(args[0], "(") {
+		args = []string{"artist", args[0]}
+	}
+	filters, err := parseMPDFilters(args, false)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	var rows [][]string
+	for _, item := range c.index().filter(filters) {
+		var row []string
+		for _, g := range append(groups, tag) {
+			row = append(row, mpdValues(g, item)[0])
+		}
+		key := strings.Join(row, "\x00")
+		if row[len(row)-1] == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		rows = append(rows, row)
+	}
+	slice.Sort(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return false
+	})
+	var prev []string
+	for _, row := range rows {
+		for k, g := range groups {
+			if prev == nil || row[k] != prev[k] {
+				c.pair(g, row[k])
+			}
+		}
+		c.pair(tag, row[len(row)-1])
+		prev = row
+	}
+	return nil
+}
+
+// parseMPDArgs splits a command line into its words. Words in double quotes
+// may contain spaces, and backslash escapes quotes and backslashes in them.
+func parseMPDArgs(line string) ([]string, error) {
+	var args []string
+	for i := 0; i < len(line); {
+		switch line[i] {
+		case ' ', '\t', '\r':
+			i++
+			continue
+		case '"':
+			var b []byte
+			i++
+			for ; i < len(line) && line[i] != '"'; i++ {
+				if line[i] == '\\' && i+1 < len(line) {
+					i++
+				}
+				b = append(b, line[i])
+			}
+			if i >= len(line) {
+				return nil, mpdErrorf(mpdErrArg, "Missing closing '\"'")
+			}
+			i++
+			args = append(args, string(b))
+		default:
+			j := i
+			for j < len(line) && line[j] != ' ' && line[j] != '\t' && line[j] != '\r' {
+				j++
+			}
+			args = append(args, line[i:j])
+			i = j
+		}
+	}
+	return args, nil
+}
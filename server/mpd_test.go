@@ -0,0 +1,342 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestParseMPDArgs(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+		ok   bool
+	}{
+		{"", nil, true},
+		{"  \t", nil, true},
+		{"status", []string{"status"}, true},
+		{"ping\r", []string{"ping"}, true},
+		{"  add  \"a b\"  c ", []string{"add", "a b", "c"}, true},
+		{"seek\t1 2.5", []string{"seek", "1", "2.5"}, true},
+		{`find artist "Say \"Hi\""`, []string{"find", "artist", `Say "Hi"`}, true},
+		{`add "a\\b"`, []string{"add", `a\b`}, true},
+		{`add ""`, []string{"add", ""}, true},
+		{`add a"b`, []string{"add", `a"b`}, true},
+		{`add "a`, nil, false},
+		{`add "a\"`, nil, false},
+	}
+	for _, test := range tests {
+		got, err := parseMPDArgs(test.line)
+		if ok := err == nil; ok != test.ok || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got %q, %v, want %q", test.line, got, err, test.want)
+		}
+	}
+}
+
+func TestMPDRange(t *testing.T) {
+	tests := []struct {
+		s          string
+		start, end int
+		ok         bool
+	}{
+		{"0", 0, 1, true},
+		{"4", 4, 5, true},
+		{"5", 0, 0, false},
+		{"-1", 0, 0, false},
+		{"1:3", 1, 3, true},
+		{"1:", 1, 5, true},
+		{"0:9", 0, 5, true},
+		{"5:", 5, 5, true},
+		{"6:", 0, 0, false},
+		{"4:2", 0, 0, false},
+		{"-1:2", 0, 0, false},
+		{":3", 0, 0, false},
+		{"a", 0, 0, false},
+		{"1:b", 0, 0, false},
+	}
+	for _, test := range tests {
+		start, end, err := mpdRange(test.s, 5)
+		if ok := err == nil; ok != test.ok || start != test.start || end != test.end {
+			t.Errorf("%q: got %d, %d, %v, want %d, %d", test.s, start, end, err, test.start, test.end)
+		}
+	}
+}
+
+func TestParseMPDFilters(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		search bool
+		want   []mpdFilter
+	}{
+		{
+			"pairs",
+			[]string{"artist", "The Beatles", "ALBUM", "Help!"},
+			false,
+			[]mpdFilter{{"Artist", "==", "The Beatles", false}, {"Album", "==", "Help!", false}},
+		},
+		{
+			"search pairs",
+			[]string{"any", "Beyoncé", "file", "x"},
+			true,
+			[]mpdFilter{{"any", "contains", "beyonce", true}, {"file", "contains", "x", true}},
+		},
+		{
+			"expression",
+			[]string{`(artist == 'The Beatles')`},
+			false,
+			[]mpdFilter{{"Artist", "==", "The Beatles", false}},
+		},
+		{
+			"and",
+			[]string{`((artist != "A") AND (album contains 'b') AND (Date == "1999"))`},
+			false,
+			[]mpdFilter{{"Artist", "!=", "A", false}, {"Album", "contains", "b", false}, {"Date", "==", "1999", false}},
+		},
+		{
+			"quotes",
+			[]string{`((title == 'a (b)') AND (file == "a\"b\\c"))`},
+			false,
+			[]mpdFilter{{"Title", "==", "a (b)", false}, {"file", "==", `a"b\c`, false}},
+		},
+		{
+			"spaces",
+			[]string{`( ( title == 'x' )  AND  (any contains "Y") )`},
+			true,
+			[]mpdFilter{{"Title", "==", "x", true}, {"any", "contains", "y", true}},
+		},
+		{"odd pairs", []string{"artist", "x", "album"}, false, nil},
+		{"unknown tag", []string{"bogus", "x"}, false, nil},
+		{"unknown expression tag", []string{`(bogus == 'x')`}, false, nil},
+		{"bad operator", []string{`(artist = 'x')`}, false, nil},
+		{"unquoted", []string{`(artist == x)`}, false, nil},
+		{"mismatched quotes", []string{`(artist == 'x")`}, false, nil},
+		{"or", []string{`((artist == 'x') OR (album == 'y'))`}, false, nil},
+		{"unbalanced", []string{`((artist == 'x')`}, false, nil},
+		{"unclosed", []string{`((artist == 'x') AND (album == 'y')`}, false, nil},
+	}
+	for _, test := range tests {
+		got, err := parseMPDFilters(test.args, test.search)
+		if test.want == nil {
+			if err == nil {
+				t.Errorf("%s: got %+v, want error", test.name, got)
+			}
+		} else if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %+v, %v, want %+v", test.name, got, err, test.want)
+		}
+	}
+}
+
+func TestMPDQueueIDs(t *testing.T) {
+	tests := []struct {
+		name  string
+		queue Playlist
+		want  []int
+	}{
+		{"empty", nil, nil},
+		{"add", Playlist{"a", "b", "c"}, []int{1, 2, 3}},
+		{"same", Playlist{"a", "b", "c"}, []int{1, 2, 3}},
+		{"remove", Playlist{"a", "c"}, []int{1, 3}},
+		{"add again", Playlist{"a", "c", "b"}, []int{1, 3, 4}},
+		{"move to end", Playlist{"c", "b", "a"}, []int{3, 4, 5}},
+		{"replace", Playlist{"d", "e"}, []int{6, 7}},
+		{"add copy", Playlist{"d", "e", "d"}, []int{6, 7, 8}},
+		{"remove both", Playlist{"e"}, []int{7}},
+		{"clear", Playlist{}, []int{}},
+	}
+	srv := new(Server)
+	for _, test := range tests {
+		srv.Queue = test.queue
+		if got := srv.mpdQueueIDs(); !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+
+	st := &snapshot{ids: []int{3, 4, 5}}
+	for _, test := range []struct {
+		id  string
+		pos int
+		ok  bool
+	}{
+		{"3", 0, true},
+		{"5", 2, true},
+		{"0", 0, false},
+		{"x", 0, false},
+	} {
+		if pos, err := mpdPosition(st, test.id); (err == nil) != test.ok || pos != test.pos {
+			t.Errorf("position %s: got %d, %v, want %d", test.id, pos, err, test.pos)
+		}
+	}
+}
+
+// testMPDConn returns a client of a server without authentication and the
+// buffer its responses are written to.
+func testMPDConn(t *testing.T) (*mpdConn, *bytes.Buffer) {
+	buf := new(bytes.Buffer)
+	return &mpdConn{
+		srv:     testAuthServer(t),
+		w:       bufio.NewWriter(buf),
+		events:  make(chan waitType, 8),
+		changed: make(map[string]bool),
+	}, buf
+}
+
+func TestMPDCommandList(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  string
+	}{
+		{"one", []string{"ping"}, "OK\n"},
+		{"empty", []string{""}, "ACK [5@0] {} No command given\n"},
+		{"unknown", []string{"nothing"}, "ACK [5@0] {nothing} unknown command \"nothing\"\n"},
+		{"arguments", []string{"ping 1"}, "ACK [2@0] {ping} wrong number of arguments\n"},
+		{"list", []string{"command_list_begin", "ping", "notcommands", "command_list_end"}, "OK\n"},
+		{"empty list", []string{"command_list_begin", "command_list_end"}, "OK\n"},
+		{
+			"ok list",
+			[]string{"command_list_ok_begin", "ping", "notcommands", "command_list_end"},
+			"list_OK\nlist_OK\nOK\n",
+		},
+		{
+			// The error has the index of the command, and the rest are not
+			// run.
+			"list error",
+			[]string{"command_list_ok_begin", "ping", "nothing", "ping", "command_list_end", "ping"},
+			"list_OK\nACK [5@1] {nothing} unknown command \"nothing\"\nOK\n",
+		},
+		{
+			// A line that can't be parsed ends the list.
+			"list parse error",
+			[]string{"command_list_begin", "ping", `add "x`, "command_list_end"},
+			"ACK [2@0] {} Missing closing '\"'\nACK [5@0] {command_list_end} unknown command \"command_list_end\"\n",
+		},
+	}
+	for _, test := range tests {
+		c, buf := testMPDConn(t)
+		for _, line := range test.lines {
+			if !c.line(line, nil) {
+				t.Fatalf("%s: %q closed the connection", test.name, line)
+			}
+		}
+		c.w.Flush()
+		if got := buf.String(); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+	c, _ := testMPDConn(t)
+	if c.line("close", nil) {
+		t.Fatal("close did not close the connection")
+	}
+}
+
+func TestMPDIdle(t *testing.T) {
+	tests := []struct {
+		name string
+		// changed are the changes before the idle command, and events the
+		// changes sent while idle.
+		changed, events []waitType
+		// lines are the lines sent while idle.
+		lines []string
+		idle  string
+		want  string
+		open  bool
+	}{
+		{
+			"changed",
+			[]waitType{waitPlaylist}, nil, nil,
+			"idle",
+			"changed: playlist\nchanged: stored_playlist\nOK\n",
+			true,
+		},
+		{
+			"event",
+			nil, []waitType{waitLibrary}, nil,
+			"idle",
+			"changed: database\nOK\n",
+			true,
+		},
+		{
+			"subsystems",
+			[]waitType{waitPlaylist}, []waitType{waitStatus}, nil,
+			"idle mixer player",
+			"changed: mixer\nchanged: player\nOK\n",
+			true,
+		},
+		{
+			"noidle",
+			[]waitType{waitPlaylist}, nil, []string{"noidle"},
+			"idle database",
+			"OK\n",
+			true,
+		},
+		{"other command", nil, nil, []string{"status"}, "idle", "", false},
+	}
+	for _, test := range tests {
+		c, buf := testMPDConn(t)
+		for _, wt := range test.changed {
+			c.change(wt)
+		}
+		for _, wt := range test.events {
+			c.events <- wt
+		}
+		lines := make(chan string, len(test.lines))
+		for _, l := range test.lines {
+			lines <- l
+		}
+		if open := c.line(test.idle, lines); open != test.open {
+			t.Fatalf("%s: got open %v, want %v", test.name, open, test.open)
+		}
+		c.w.Flush()
+		if got := buf.String(); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	// Changes that were not asked for are kept for the next idle.
+	c, buf := testMPDConn(t)
+	c.change(waitStatus)
+	c.line("idle player", nil)
+	c.w.Flush()
+	buf.Reset()
+	c.line("idle", nil)
+	c.w.Flush()
+	if got, want := buf.String(), "changed: mixer\nchanged: options\nchanged: output\nOK\n"; got != want {
+		t.Errorf("kept changes: got %q, want %q", got, want)
+	}
+
+	// The connection closes while idle.
+	c, _ = testMPDConn(t)
+	lines := make(chan string)
+	close(lines)
+	if c.line("idle", lines) {
+		t.Error("closed while idle: connection left open")
+	}
+
+	// Not idle, so there is nothing to stop.
+	c, buf = testMPDConn(t)
+	c.line("noidle", nil)
+	c.w.Flush()
+	if buf.Len() != 0 {
+		t.Errorf("noidle: got %q", buf)
+	}
+}
+
+func TestMPDPermission(t *testing.T) {
+	c, buf := testMPDConn(t)
+	secret := addToken(t, c.srv, "read", "read")
+	for _, line := range []string{"idle", "status", "ping", "password wrong", "password " + secret, "add x"} {
+		c.line(line, nil)
+	}
+	c.w.Flush()
+	want := "ACK [4@0] {idle} you don't have permission for \"idle\"\n" +
+		"ACK [4@0] {status} you don't have permission for \"status\"\n" +
+		"OK\n" +
+		"ACK [3@0] {password} incorrect password\n" +
+		"OK\n" +
+		"ACK [4@0] {add} you don't have permission for \"add\"\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
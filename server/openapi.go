@@ -0,0 +1,488 @@
+package server
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// OpenAPI serves the OpenAPI description of version 1 of the API. It is open
+// to all, so that clients can find out how to log in.
+func (srv *Server) OpenAPI(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	io.WriteString(w, openAPI)
+}
+
+// openAPI describes version 1 of the API. Its server URL is relative, so it
+// holds under a path prefix.
+const openAPI = `{
+	"openapi": "3.0.3",
+	"info": {
+		"title": "Moggio",
+		"version": "1",
+		"description": "Control a Moggio music server. Commands return once the server has run them. If authentication is on, requests need a session cookie from /api/auth/login, or an API token as a bearer token or access_token parameter; reading needs the read role and changing anything the admin role."
+	},
+	"servers": [{"url": "."}],
+	"security": [{}, {"bearer": []}, {"token": []}, {"session": []}],
+	"paths": {
+		"/status": {
+			"get": {
+				"summary": "Get the playback status",
+				"operationId": "getStatus",
+				"responses": {
+					"200": {"$ref": "#/components/responses/Status"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"}
+				}
+			}
+		},
+		"/cmd/{cmd}": {
+			"post": {
+				"summary": "Run a playback command",
+				"description": "play, pause, stop, next and prev take no arguments; random, repeat and mute toggle. play_idx needs Index, play_track needs UID and queues the album of the track, seek needs Position, volume needs Volume, crossfade and min_duration need Duration, and replaygain needs Mode.",
+				"operationId": "runCommand",
+				"parameters": [{
+					"name": "cmd",
+					"in": "path",
+					"required": true,
+					"schema": {
+						"type": "string",
+						"enum": ["play", "pause", "stop", "next", "prev", "random", "repeat", "mute", "play_idx", "play_track", "seek", "volume", "crossfade", "replaygain", "min_duration"]
+					}
+				}],
+				"requestBody": {
+					"content": {"application/json": {"schema": {"$ref": "#/components/schemas/CommandArgs"}}}
+				},
+				"responses": {
+					"200": {"$ref": "#/components/responses/Status"},
+					"400": {"$ref": "#/components/responses/Error"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"},
+					"404": {"$ref": "#/components/responses/Error"},
+					"409": {"$ref": "#/components/responses/Error"}
+				}
+			}
+		},
+		"/queue": {
+			"get": {
+				"summary": "Get the queue",
+				"operationId": "getQueue",
+				"responses": {
+					"200": {"$ref": "#/components/responses/Queue"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"}
+				}
+			},
+			"post": {
+				"summary": "Change the queue",
+				"operationId": "changeQueue",
+				"requestBody": {"$ref": "#/components/requestBodies/PlaylistChange"},
+				"responses": {
+					"200": {"$ref": "#/components/responses/Queue"},
+					"400": {"$ref": "#/components/responses/Error"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"},
+					"404": {"$ref": "#/components/responses/Error"}
+				}
+			}
+		},
+		"/playlists": {
+			"get": {
+				"summary": "List the playlists",
+				"operationId": "listPlaylists",
+				"responses": {
+					"200": {
+						"description": "The playlists, by name.",
+						"content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Playlist"}}}}
+					},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"}
+				}
+			}
+		},
+		"/playlists/{name}": {
+			"parameters": [{"name": "name", "in": "path", "required": true, "schema": {"type": "string"}}],
+			"get": {
+				"summary": "Get a playlist",
+				"operationId": "getPlaylist",
+				"responses": {
+					"200": {"$ref": "#/components/responses/Playlist"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"},
+					"404": {"$ref": "#/components/responses/Error"}
+				}
+			},
+			"post": {
+				"summary": "Change a playlist",
+				"description": "The playlist is made if needed, and removed if it becomes empty.",
+				"operationId": "changePlaylist",
+				"requestBody": {"$ref": "#/components/requestBodies/PlaylistChange"},
+				"responses": {
+					"200": {"$ref": "#/components/responses/Playlist"},
+					"400": {"$ref": "#/components/responses/Error"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"},
+					"404": {"$ref": "#/components/responses/Error"}
+				}
+			},
+			"delete": {
+				"summary": "Remove a playlist",
+				"operationId": "deletePlaylist",
+				"responses": {
+					"204": {"description": "Removed."},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"},
+					"404": {"$ref": "#/components/responses/Error"}
+				}
+			}
+		},
+		"/sources": {
+			"get": {
+				"summary": "List the sources of music",
+				"operationId": "listSources",
+				"responses": {
+					"200": {"$ref": "#/components/responses/Sources"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"}
+				}
+			},
+			"post": {
+				"summary": "Add a source",
+				"description": "The source is refreshed in the background; it has Progress until it is done.",
+				"operationId": "addSource",
+				"requestBody": {
+					"required": true,
+					"content": {"application/json": {"schema": {"$ref": "#/components/schemas/NewSource"}}}
+				},
+				"responses": {
+					"200": {"$ref": "#/components/responses/Sources"},
+					"400": {"$ref": "#/components/responses/Error"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"},
+					"409": {"$ref": "#/components/responses/Error"}
+				}
+			},
+			"delete": {
+				"summary": "Remove a source",
+				"operationId": "removeSource",
+				"parameters": [
+					{"$ref": "#/components/parameters/protocol"},
+					{"$ref": "#/components/parameters/key"}
+				],
+				"responses": {
+					"204": {"description": "Removed."},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"},
+					"404": {"$ref": "#/components/responses/Error"}
+				}
+			}
+		},
+		"/sources/refresh": {
+			"post": {
+				"summary": "Refresh a source",
+				"description": "Returns once the refresh is done.",
+				"operationId": "refreshSource",
+				"parameters": [
+					{"$ref": "#/components/parameters/protocol"},
+					{"$ref": "#/components/parameters/key"}
+				],
+				"responses": {
+					"200": {"$ref": "#/components/responses/Sources"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"},
+					"404": {"$ref": "#/components/responses/Error"},
+					"409": {"$ref": "#/components/responses/Error"},
+					"500": {"$ref": "#/components/responses/Error"}
+				}
+			}
+		},
+		"/tracks": {
+			"get": {
+				"summary": "List the library",
+				"description": "Tracks are sorted by UID. If Seq changes between pages, the library changed and paging should start again.",
+				"operationId": "listTracks",
+				"parameters": [
+					{"$ref": "#/components/parameters/offset"},
+					{"name": "limit", "in": "query", "schema": {"type": "integer", "minimum": 1, "maximum": 5000, "default": 5000}}
+				],
+				"responses": {
+					"200": {
+						"description": "A page of the library.",
+						"content": {"application/json": {"schema": {"$ref": "#/components/schemas/LibraryPage"}}}
+					},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"}
+				}
+			}
+		},
+		"/track": {
+			"get": {
+				"summary": "Get a track",
+				"operationId": "getTrack",
+				"parameters": [{"name": "uid", "in": "query", "required": true, "schema": {"type": "string"}}],
+				"responses": {
+					"200": {
+						"description": "The track.",
+						"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Track"}}}
+					},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"},
+					"404": {"$ref": "#/components/responses/Error"}
+				}
+			}
+		},
+		"/search": {
+			"get": {
+				"summary": "Search the library",
+				"description": "Words match the start of words in the title, artist, album, album artist, composer or genre. Field filters are like artist:beatles, album:\"abbey road\", year:>2000, year:1990..1999 or time:>300 (seconds). Results are ranked.",
+				"operationId": "search",
+				"parameters": [
+					{"name": "q", "in": "query", "schema": {"type": "string"}},
+					{"$ref": "#/components/parameters/offset"},
+					{"name": "limit", "in": "query", "schema": {"type": "integer", "minimum": 1, "maximum": 1000, "default": 100}}
+				],
+				"responses": {
+					"200": {
+						"description": "A page of the results.",
+						"content": {"application/json": {"schema": {"$ref": "#/components/schemas/SearchResults"}}}
+					},
+					"400": {"$ref": "#/components/responses/Error"},
+					"401": {"$ref": "#/components/responses/Unauthorized"},
+					"403": {"$ref": "#/components/responses/Forbidden"}
+				}
+			}
+		}
+	},
+	"components": {
+		"securitySchemes": {
+			"bearer": {"type": "http", "scheme": "bearer", "description": "An API token."},
+			"token": {"type": "apiKey", "in": "query", "name": "access_token", "description": "An API token."},
+			"session": {"type": "apiKey", "in": "cookie", "name": "moggio_session"}
+		},
+		"parameters": {
+			"protocol": {"name": "protocol", "in": "query", "required": true, "schema": {"type": "string"}},
+			"key": {"name": "key", "in": "query", "required": true, "schema": {"type": "string"}},
+			"offset": {"name": "offset", "in": "query", "schema": {"type": "integer", "minimum": 0, "default": 0}}
+		},
+		"requestBodies": {
+			"PlaylistChange": {
+				"required": true,
+				"content": {"application/json": {"schema": {"$ref": "#/components/schemas/PlaylistChange"}}}
+			}
+		},
+		"responses": {
+			"Status": {
+				"description": "The playback status.",
+				"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Status"}}}
+			},
+			"Queue": {
+				"description": "The queue.",
+				"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Queue"}}}
+			},
+			"Playlist": {
+				"description": "The playlist.",
+				"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Playlist"}}}
+			},
+			"Sources": {
+				"description": "The sources.",
+				"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Sources"}}}
+			},
+			"Error": {
+				"description": "The request failed: 400 if it is bad, 404 if what it names does not exist, 409 if it conflicts with the state of the server.",
+				"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}
+			},
+			"Unauthorized": {
+				"description": "Authentication is required.",
+				"content": {"text/plain": {"schema": {"type": "string"}}}
+			},
+			"Forbidden": {
+				"description": "The admin role is required.",
+				"content": {"text/plain": {"schema": {"type": "string"}}}
+			}
+		},
+		"schemas": {
+			"Error": {
+				"type": "object",
+				"properties": {
+					"Status": {"type": "integer", "description": "The HTTP status."},
+					"Message": {"type": "string"}
+				}
+			},
+			"Duration": {
+				"type": "integer",
+				"format": "int64",
+				"description": "Nanoseconds."
+			},
+			"DurationArg": {
+				"oneOf": [
+					{"$ref": "#/components/schemas/Duration"},
+					{"type": "string", "example": "1m30s"}
+				]
+			},
+			"CommandArgs": {
+				"type": "object",
+				"properties": {
+					"Index": {"type": "integer", "minimum": 0, "description": "The queue index to play."},
+					"UID": {"type": "string", "description": "The track to play."},
+					"Position": {"$ref": "#/components/schemas/DurationArg"},
+					"Volume": {"type": "number", "minimum": 0, "maximum": 1},
+					"Duration": {"$ref": "#/components/schemas/DurationArg"},
+					"Mode": {"type": "string", "enum": ["off", "track", "album"]}
+				}
+			},
+			"SongID": {
+				"type": "object",
+				"description": "Identifies a track. UID is the whole ID: the protocol, source key and ID within the source, separated by newlines.",
+				"properties": {
+					"Protocol": {"type": "string"},
+					"Key": {"type": "string"},
+					"ID": {"type": "string"},
+					"UID": {"type": "string"}
+				}
+			},
+			"SongInfo": {
+				"type": "object",
+				"properties": {
+					"Time": {"$ref": "#/components/schemas/Duration"},
+					"Artist": {"type": "string"},
+					"Title": {"type": "string"},
+					"Album": {"type": "string"},
+					"Track": {"type": "number"},
+					"ImageURL": {"type": "string"},
+					"Disc": {"type": "number"},
+					"AlbumArtist": {"type": "string"},
+					"Genre": {"type": "string"},
+					"Year": {"type": "integer"},
+					"Composer": {"type": "string"},
+					"Bitrate": {"type": "integer"},
+					"SampleRate": {"type": "integer"},
+					"Codec": {"type": "string"},
+					"SongTitle": {"type": "string"},
+					"TrackGain": {"type": "number"},
+					"TrackPeak": {"type": "number"},
+					"AlbumGain": {"type": "number"},
+					"AlbumPeak": {"type": "number"}
+				}
+			},
+			"Track": {
+				"type": "object",
+				"properties": {
+					"ID": {"$ref": "#/components/schemas/SongID"},
+					"Info": {
+						"allOf": [{"$ref": "#/components/schemas/SongInfo"}],
+						"nullable": true,
+						"description": "Null if the track no longer exists."
+					}
+				}
+			},
+			"Status": {
+				"type": "object",
+				"properties": {
+					"State": {"type": "integer", "enum": [0, 1, 2], "description": "0 playing, 1 stopped, 2 paused."},
+					"Song": {"$ref": "#/components/schemas/SongID"},
+					"SongInfo": {"$ref": "#/components/schemas/SongInfo"},
+					"Elapsed": {"$ref": "#/components/schemas/Duration"},
+					"Time": {"$ref": "#/components/schemas/Duration"},
+					"Random": {"type": "boolean"},
+					"Repeat": {"type": "boolean"},
+					"Volume": {"type": "number"},
+					"Mute": {"type": "boolean"},
+					"Crossfade": {"$ref": "#/components/schemas/Duration"},
+					"ReplayGain": {"type": "string", "enum": ["", "track", "album"]},
+					"Username": {"type": "string"},
+					"Hostname": {"type": "string"},
+					"CentralURL": {"type": "string"},
+					"Outputs": {
+						"type": "array",
+						"items": {
+							"type": "object",
+							"properties": {
+								"Name": {"type": "string"},
+								"Volume": {"type": "number"},
+								"Mute": {"type": "boolean"}
+							}
+						}
+					}
+				}
+			},
+			"Queue": {
+				"type": "object",
+				"properties": {
+					"Index": {"type": "integer", "description": "The index of the current track."},
+					"Tracks": {"type": "array", "items": {"$ref": "#/components/schemas/Track"}}
+				}
+			},
+			"Playlist": {
+				"type": "object",
+				"properties": {
+					"Name": {"type": "string"},
+					"Tracks": {"type": "array", "items": {"$ref": "#/components/schemas/Track"}}
+				}
+			},
+			"PlaylistChange": {
+				"type": "array",
+				"description": "Changes applied in order: [\"clear\"], [\"rem\", index] with the index as a string, or [\"add\", UID].",
+				"items": {"type": "array", "items": {"type": "string"}, "minItems": 1, "maxItems": 2},
+				"example": [["clear"], ["add", "file\n/music\n/music/a.flac"]]
+			},
+			"Source": {
+				"type": "object",
+				"properties": {
+					"Protocol": {"type": "string"},
+					"Key": {"type": "string"},
+					"Progress": {
+						"type": "object",
+						"nullable": true,
+						"description": "Set while the source is refreshing: Done of Total files, with Total 0 if unknown.",
+						"properties": {
+							"Done": {"type": "integer"},
+							"Total": {"type": "integer"}
+						}
+					}
+				}
+			},
+			"NewSource": {
+				"type": "object",
+				"required": ["Protocol"],
+				"properties": {
+					"Protocol": {"type": "string"},
+					"Params": {"type": "array", "items": {"type": "string"}, "description": "As listed by the protocol in Available."}
+				}
+			},
+			"Sources": {
+				"type": "object",
+				"properties": {
+					"Available": {
+						"type": "object",
+						"description": "The protocols that can be added, by name. OAuth protocols are added by visiting OAuthURL instead.",
+						"additionalProperties": {
+							"type": "object",
+							"properties": {
+								"Params": {"type": "array", "items": {"type": "string"}},
+								"OAuthURL": {"type": "string"}
+							}
+						}
+					},
+					"Sources": {"type": "array", "items": {"$ref": "#/components/schemas/Source"}}
+				}
+			},
+			"LibraryPage": {
+				"type": "object",
+				"properties": {
+					"Seq": {"type": "integer", "format": "int64", "description": "The version of the library."},
+					"Total": {"type": "integer"},
+					"Tracks": {"type": "array", "items": {"$ref": "#/components/schemas/Track"}}
+				}
+			},
+			"SearchResults": {
+				"type": "object",
+				"properties": {
+					"Total": {"type": "integer"},
+					"Results": {"type": "array", "items": {"$ref": "#/components/schemas/Track"}, "nullable": true}
+				}
+			}
+		}
+	}
+}
+`
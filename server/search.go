@@ -0,0 +1,437 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/bradfitz/slice"
+	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/codec"
+)
+
+// Search searches the songs of all protocol instances. The q parameter holds
+// words, which match the start of words in the title, artist, album, album
+// artist, composer or genre, and field filters:
+//
+//	artist:beatles album:"abbey road" protocol:file codec:flac
+//	year:>2000 year:1990..1999 track:<=3 disc:2 time:>300
+//
+// Text filters match if the field contains the value; number filters compare,
+// with time in seconds. Quotes group words. Case and diacritics are ignored.
+// Results are ranked and paged by the offset and limit parameters.
+func (srv *Server) Search(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	q, err := parseQuery(form.Get("q"))
+	if err != nil {
+		return nil, err
+	}
+	offset, _ := strconv.Atoi(form.Get("offset"))
+	if offset < 0 {
+		offset = 0
+	}
+	limit, _ := strconv.Atoi(form.Get("limit"))
+	if limit <= 0 {
+		limit = 100
+	} else if limit > 1000 {
+		limit = 1000
+	}
+	ch := make(chan *searchIndex)
+	srv.ch <- cmdSearchIndex(ch)
+	results := (<-ch).search(q)
+	res := struct {
+		Total   int
+		Results []listItem
+	}{
+		Total: len(results),
+	}
+	if offset < len(results) {
+		results = results[offset:]
+		if len(results) > limit {
+			results = results[:limit]
+		}
+		res.Results = results
+	}
+	return res, nil
+}
+
+// Searchable text fields, in order of weight.
+const (
+	fieldTitle = iota
+	fieldArtist
+	fieldAlbum
+	fieldAlbumArtist
+	fieldComposer
+	fieldGenre
+	fieldCodec
+	fieldProtocol
+	numFields
+)
+
+var fieldNames = map[string]int{
+	"title":       fieldTitle,
+	"artist":      fieldArtist,
+	"album":       fieldAlbum,
+	"albumartist": fieldAlbumArtist,
+	"composer":    fieldComposer,
+	"genre":       fieldGenre,
+	"codec":       fieldCodec,
+	"protocol":    fieldProtocol,
+}
+
+// fieldWeights is the score of a word matching a field. Fields without
+// weights only match filters.
+var fieldWeights = [numFields]int{
+	fieldTitle:       8,
+	fieldArtist:      6,
+	fieldAlbum:       4,
+	fieldAlbumArtist: 4,
+	fieldComposer:    2,
+	fieldGenre:       1,
+}
+
+// numberFields are the fields of number filters.
+var numberFields = map[string]func(*codec.SongInfo) float64{
+	"year":  func(si *codec.SongInfo) float64 { return float64(si.Year) },
+	"track": func(si *codec.SongInfo) float64 { return si.Track },
+	"disc":  func(si *codec.SongInfo) float64 { return si.Disc },
+	"time":  func(si *codec.SongInfo) float64 { return si.Time.Seconds() },
+}
+
+// searchIndex is an index of songs. It is not changed once built, so it can
+// be searched outside of commands().
+type searchIndex struct {
+	docs []searchDoc
+	// words are the distinct words of all docs, sorted, and postings holds
+	// where each is found.
+	words    []string
+	postings map[string][]posting
+}
+
+type searchDoc struct {
+	item   listItem
+	fields [numFields]string
+}
+
+type posting struct {
+	doc   int
+	field int
+}
+
+// makeSearchIndex indexes the songs of all instances. It should only be
+// called by commands().
+func (srv *Server) makeSearchIndex() *searchIndex {
+	start := time.Now()
+	idx := &searchIndex{
+		postings: make(map[string][]posting),
+	}
+	for name, protos := range srv.Protocols {
+		for key, inst := range protos {
+			sl, _ := inst.List()
+			for id, info := range sl {
+				d := searchDoc{
+					item: listItem{
+						ID:   SongID(codec.NewID(name, key, string(id))),
+						Info: info,
+					},
+				}
+				d.fields[fieldTitle] = fold(info.Title)
+				d.fields[fieldArtist] = fold(info.Artist)
+				d.fields[fieldAlbum] = fold(info.Album)
+				d.fields[fieldAlbumArtist] = fold(info.AlbumArtist)
+				d.fields[fieldComposer] = fold(info.Composer)
+				d.fields[fieldGenre] = fold(info.Genre)
+				d.fields[fieldCodec] = fold(info.Codec)
+				d.fields[fieldProtocol] = fold(name)
+				n := len(idx.docs)
+				idx.docs = append(idx.docs, d)
+				for f, s := range d.fields {
+					if fieldWeights[f] == 0 {
+						continue
+					}
+					for _, w := range words(s) {
+						p := idx.postings[w]
+						if len(p) > 0 && p[len(p)-1] == (posting{n, f}) {
+							continue
+						}
+						idx.postings[w] = append(p, posting{n, f})
+					}
+				}
+			}
+		}
+	}
+	for w := range idx.postings {
+		idx.words = append(idx.words, w)
+	}
+	sort.Strings(idx.words)
+	log.Printf("indexed %d songs in %v", len(idx.docs), time.Since(start))
+	return idx
+}
+
+// query is a parsed search query.
+type query struct {
+	words   []string
+	text    []textFilter
+	numbers []numberFilter
+}
+
+type textFilter struct {
+	field int
+	value string
+}
+
+type numberFilter struct {
+	get    func(*codec.SongInfo) float64
+	op     string
+	value  float64
+	value2 float64
+}
+
+func (f numberFilter) match(si *codec.SongInfo) bool {
+	v := f.get(si)
+	switch f.op {
+	case ">":
+		return v > f.value
+	case ">=":
+		return v >= f.value
+	case "<":
+		return v < f.value
+	case "<=":
+		return v <= f.value
+	case "..":
+		return v >= f.value && v <= f.value2
+	}
+	return v == f.value
+}
+
+func parseQuery(s string) (*query, error) {
+	q := new(query)
+	for _, term := range splitQuery(s) {
+		name, value := "", term
+		if i := strings.IndexByte(term, ':'); i > 0 {
+			name, value = strings.ToLower(term[:i]), term[i+1:]
+		}
+		if get, ok := numberFields[name]; ok {
+			f, err := parseNumberFilter(get, value)
+			if err != nil {
+				return nil, fmt.Errorf("bad filter %q: %v", term, err)
+			}
+			q.numbers = append(q.numbers, f)
+		} else if field, ok := fieldNames[name]; ok {
+			if v := fold(value); v != "" {
+				q.text = append(q.text, textFilter{field, v})
+			}
+		} else {
+			// A word, or a phrase of them.
+			q.words = append(q.words, words(fold(term))...)
+		}
+	}
+	return q, nil
+}
+
+func parseNumberFilter(get func(*codec.SongInfo) float64, s string) (numberFilter, error) {
+	f := numberFilter{get: get}
+	if i := strings.Index(s, ".."); i >= 0 {
+		f.op = ".."
+		lo, err := strconv.ParseFloat(s[:i], 64)
+		if err != nil {
+			return f, err
+		}
+		hi, err := strconv.ParseFloat(s[i+2:], 64)
+		if err != nil {
+			return f, err
+		}
+		f.value, f.value2 = lo, hi
+		return f, nil
+	}
+	for _, op := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(s, op) {
+			f.op = op
+			s = s[len(op):]
+			break
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	f.value = v
+	return f, err
+}
+
+// splitQuery splits s into terms at spaces outside of double quotes, which
+// are removed.
+func splitQuery(s string) []string {
+	var terms []string
+	var cur []rune
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case unicode.IsSpace(r) && !quoted:
+			if len(cur) > 0 {
+				terms = append(terms, string(cur))
+				cur = cur[:0]
+			}
+		default:
+			cur = append(cur, r)
+		}
+	}
+	if len(cur) > 0 {
+		terms = append(terms, string(cur))
+	}
+	return terms
+}
+
+// search returns the songs that match q, best first. Every word must match
+// the start of a word of the song; songs score more for words in weightier
+// fields and for whole words.
+func (idx *searchIndex) search(q *query) []listItem {
+	var scores map[int]int
+	if len(q.words) > 0 {
+		for _, w := range q.words {
+			s := make(map[int]int)
+			i := sort.SearchStrings(idx.words, w)
+			for ; i < len(idx.words) && strings.HasPrefix(idx.words[i], w); i++ {
+				bonus := 1
+				if idx.words[i] == w {
+					bonus = 2
+				}
+				for _, p := range idx.postings[idx.words[i]] {
+					if scores != nil {
+						if _, ok := scores[p.doc]; !ok {
+							continue
+						}
+					}
+					if v := fieldWeights[p.field] * bonus; v > s[p.doc] {
+						s[p.doc] = v
+					}
+				}
+			}
+			for doc, v := range scores {
+				if _, ok := s[doc]; ok {
+					s[doc] += v
+				}
+			}
+			scores = s
+		}
+	} else {
+		scores = make(map[int]int, len(idx.docs))
+		for i := range idx.docs {
+			scores[i] = 0
+		}
+	}
+	var docs []int
+	for i := range scores {
+		if idx.docs[i].matches(q) {
+			docs = append(docs, i)
+		}
+	}
+	slice.Sort(docs, func(i, j int) bool {
+		a, b := docs[i], docs[j]
+		if scores[a] != scores[b] {
+			return scores[a] > scores[b]
+		}
+		return idx.docs[a].less(&idx.docs[b])
+	})
+	items := make([]listItem, len(docs))
+	for i, d := range docs {
+		items[i] = idx.docs[d].item
+	}
+	return items
+}
+
+func (d *searchDoc) matches(q *query) bool {
+	for _, f := range q.text {
+		if !strings.Contains(d.fields[f.field], f.value) {
+			return false
+		}
+	}
+	for _, f := range q.numbers {
+		if !f.match(d.item.Info) {
+			return false
+		}
+	}
+	return true
+}
+
+// less orders songs of equal score by artist, album, disc, track and title.
+func (d *searchDoc) less(e *searchDoc) bool {
+	for _, f := range []int{fieldArtist, fieldAlbum} {
+		if d.fields[f] != e.fields[f] {
+			return d.fields[f] < e.fields[f]
+		}
+	}
+	a, b := d.item.Info, e.item.Info
+	if a.Disc != b.Disc {
+		return a.Disc < b.Disc
+	}
+	if a.Track != b.Track {
+		return a.Track < b.Track
+	}
+	if d.fields[fieldTitle] != e.fields[fieldTitle] {
+		return d.fields[fieldTitle] < e.fields[fieldTitle]
+	}
+	return d.item.ID < e.item.ID
+}
+
+// words splits folded text into words of letters and digits.
+func words(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// fold returns s in lower case without diacritics on Latin letters.
+func fold(s string) string {
+	var b bytes.Buffer
+	for _, r := range s {
+		r = unicode.ToLower(r)
+		if f, ok := foldRunes[r]; ok {
+			b.WriteString(f)
+		} else if !unicode.Is(unicode.Mn, r) {
+			// Combining marks are dropped.
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// foldRunes maps lower case Latin letters with diacritics to ones without.
+var foldRunes = func() map[rune]string {
+	m := make(map[rune]string)
+	for base, rs := range map[string]string{
+		"a":  "àáâãäåāăąǎ",
+		"c":  "çćĉċč",
+		"d":  "ďđð",
+		"e":  "èéêëēĕėęě",
+		"g":  "ĝğġģ",
+		"h":  "ĥħ",
+		"i":  "ìíîïĩīĭįı",
+		"j":  "ĵ",
+		"k":  "ķ",
+		"l":  "ĺļľŀł",
+		"n":  "ñńņňŉ",
+		"o":  "òóôõöøōŏőǒ",
+		"r":  "ŕŗř",
+		"s":  "śŝşšș",
+		"t":  "ţťŧț",
+		"u":  "ùúûüũūŭůűųǔ",
+		"w":  "ŵ",
+		"y":  "ýÿŷ",
+		"z":  "źżž",
+		"ae": "æ",
+		"oe": "œ",
+		"ss": "ß",
+		"th": "þ",
+	} {
+		for _, r := range rs {
+			m[r] = base
+		}
+	}
+	return m
+}()
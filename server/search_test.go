@@ -0,0 +1,199 @@
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/protocol"
+)
+
+// testInstance is a protocol.Instance with a fixed song list.
+type testInstance protocol.SongList
+
+func (t testInstance) Key() string                               { return "test" }
+func (t testInstance) List() (protocol.SongList, error)          { return protocol.SongList(t), nil }
+func (t testInstance) Refresh() (protocol.SongList, error)       { return t.List() }
+func (t testInstance) Info(id codec.ID) (*codec.SongInfo, error) { return t[id], nil }
+func (t testInstance) GetSong(codec.ID) (codec.Song, error) {
+	return nil, fmt.Errorf("no songs")
+}
+
+func TestSplitQuery(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"  ", nil},
+		{"a b", []string{"a", "b"}},
+		{" a\tb  c ", []string{"a", "b", "c"}},
+		{`"abbey road" beatles`, []string{"abbey road", "beatles"}},
+		{`album:"abbey road"`, []string{"album:abbey road"}},
+		{`"unterminated quote`, []string{"unterminated quote"}},
+	}
+	for _, test := range tests {
+		if got := splitQuery(test.in); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	type number struct {
+		op            string
+		value, value2 float64
+	}
+	tests := []struct {
+		in      string
+		words   []string
+		text    []textFilter
+		numbers []number
+		err     string
+	}{
+		{in: ""},
+		{in: "Beyoncé  Halo", words: []string{"beyonce", "halo"}},
+		{in: `"let it be"`, words: []string{"let", "it", "be"}},
+		{in: "AC/DC", words: []string{"ac", "dc"}},
+		{
+			in:   `Artist:Beatles album:"Abbey Road" codec:flac protocol:file`,
+			text: []textFilter{{fieldArtist, "beatles"}, {fieldAlbum, "abbey road"}, {fieldCodec, "flac"}, {fieldProtocol, "file"}},
+		},
+		{in: "genre:", words: nil},
+		{in: "mood:happy", words: []string{"mood", "happy"}},
+		{in: ":word", words: []string{"word"}},
+		{
+			in:      "year:1999 year:>2000 track:<=3 disc:=2 time:>=300 year:1990..1999",
+			numbers: []number{{"", 1999, 0}, {">", 2000, 0}, {"<=", 3, 0}, {"=", 2, 0}, {">=", 300, 0}, {"..", 1990, 1999}},
+		},
+		{in: "year:soon", err: `bad filter "year:soon"`},
+		{in: "year:1990..", err: `bad filter "year:1990.."`},
+		{in: "track:>", err: `bad filter "track:>"`},
+	}
+	for _, test := range tests {
+		q, err := parseQuery(test.in)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%q: got error %v, want %s", test.in, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", test.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(q.words, test.words) {
+			t.Errorf("%q: got words %q, want %q", test.in, q.words, test.words)
+		}
+		if !reflect.DeepEqual(q.text, test.text) {
+			t.Errorf("%q: got text filters %v, want %v", test.in, q.text, test.text)
+		}
+		var numbers []number
+		for _, f := range q.numbers {
+			numbers = append(numbers, number{f.op, f.value, f.value2})
+		}
+		if !reflect.DeepEqual(numbers, test.numbers) {
+			t.Errorf("%q: got number filters %v, want %v", test.in, numbers, test.numbers)
+		}
+	}
+}
+
+func TestNumberFilter(t *testing.T) {
+	si := &codec.SongInfo{Year: 1999, Track: 3, Time: 5 * time.Minute}
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"year:1999", true},
+		{"year:=2000", false},
+		{"year:>1998", true},
+		{"year:>1999", false},
+		{"year:>=1999", true},
+		{"year:<1999", false},
+		{"track:<=3", true},
+		{"year:1990..1999", true},
+		{"year:2000..2010", false},
+		{"time:300", true},
+		{"disc:1", false},
+	}
+	for _, test := range tests {
+		q, err := parseQuery(test.in)
+		if err != nil {
+			t.Fatalf("%q: %v", test.in, err)
+		}
+		if got := q.numbers[0].match(si); got != test.want {
+			t.Errorf("%q: got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"ABC", "abc"},
+		{"Beyoncé", "beyonce"},
+		{"Mötley Crüe", "motley crue"},
+		{"Straße", "strasse"},
+		{"Œuvre", "oeuvre"},
+		// e and a combining acute accent.
+		{"Cafe\u0301", "cafe"},
+		{"Шостакович", "шостакович"},
+	}
+	for _, test := range tests {
+		if got := fold(test.in); got != test.want {
+			t.Errorf("%q: got %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	srv := &Server{
+		Protocols: map[string]map[string]protocol.Instance{
+			"file": {
+				"test": testInstance{
+					"1": {Title: "Come Together", Artist: "The Beatles", Album: "Abbey Road", Year: 1969, Track: 1, Codec: "FLAC"},
+					"2": {Title: "Something", Artist: "The Beatles", Album: "Abbey Road", Year: 1969, Track: 2, Codec: "FLAC"},
+					"3": {Title: "Abbey", Artist: "Someone", Album: "Other", Year: 2001, Track: 1, Codec: "MP3"},
+					"4": {Title: "Halo", Artist: "Beyoncé", Album: "I Am... Sasha Fierce", Year: 2008, Track: 3, Codec: "MP3"},
+				},
+			},
+		},
+	}
+	idx := srv.makeSearchIndex()
+	tests := []struct {
+		q    string
+		want []string
+	}{
+		// Titles weigh more than albums, and whole words more than prefixes.
+		{"abbey", []string{"3", "1", "2"}},
+		{"some", []string{"2", "3"}},
+		{"beatles some", []string{"2"}},
+		{"beyonce", []string{"4"}},
+		{"BEYONCÉ HAL", []string{"4"}},
+		{"nothing", nil},
+		{"codec:mp3", []string{"4", "3"}},
+		{"year:<2000", []string{"1", "2"}},
+		{"abbey year:>2000", []string{"3"}},
+		{`album:"abbey road" track:2`, []string{"2"}},
+		// Without words, songs are ordered by artist, album and track.
+		{"protocol:file", []string{"4", "3", "1", "2"}},
+	}
+	for _, test := range tests {
+		q, err := parseQuery(test.q)
+		if err != nil {
+			t.Fatalf("%q: %v", test.q, err)
+		}
+		var got []string
+		for _, item := range idx.search(q) {
+			_, _, id := item.ID.Triple()
+			got = append(got, string(id))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got %v, want %v", test.q, got, test.want)
+		}
+	}
+}
@@ -2,46 +2,34 @@ package server
 
 import (
 	"errors"
+	"io"
 	"time"
+
+	"github.com/mjibson/moggio/codec"
 )
 
+// Seek reads a song and tracks the position in it. Songs that implement
+// codec.Seeker seek directly; others are decoded from the start up to the
+// new position.
 type Seek struct {
-	b   []float32
-	pos int
-	sr  time.Duration
-	f   func(int) ([]float32, error)
+	song    codec.Song
+	canSeek bool
+	pos     int
+	sr, ch  int
 }
 
-func NewSeek(canSeek bool, sr time.Duration, f func(int) ([]float32, error)) *Seek {
-	s := Seek{
-		f:  f,
-		sr: sr,
-	}
-	if canSeek {
-		s.b = make([]float32, 4096)
+func NewSeek(canSeek bool, sampleRate, channels int, song codec.Song) *Seek {
+	return &Seek{
+		song:    song,
+		canSeek: canSeek,
+		sr:      sampleRate,
+		ch:      channels,
 	}
-	return &s
 }
 
 func (s *Seek) Read(n int) (b []float32, err error) {
-	if s.b == nil {
-		b, err = s.f(n)
-		s.pos += len(b)
-		return
-	}
-	for len(s.b)-s.pos < n {
-		b, err = s.f(n)
-		s.b = append(s.b, b...)
-		if err != nil || len(b) == 0 {
-			break
-		}
-	}
-	tot := s.pos + n
-	if len(s.b) < tot {
-		tot = len(s.b)
-	}
-	b = s.b[s.pos:tot]
-	s.pos = tot
+	b, err = s.song.Play(n)
+	s.pos += len(b)
 	return
 }
 
@@ -50,22 +38,39 @@ var errSeekable = errors.New("cannot seek this file")
 // Seek sets the offset for the next Read to offset, relative to the origin
 // of the file.
 func (s *Seek) Seek(offset time.Duration) error {
-	if s.b == nil {
+	if !s.canSeek {
 		return errSeekable
 	}
-	pos := int(offset / s.sr)
-	if pos < len(s.b) {
+	pos := int(int64(offset)*int64(s.sr)/int64(time.Second)) * s.ch
+	sk, ok := s.song.(codec.Seeker)
+	if ok && sk.Seek(offset) == nil {
 		s.pos = pos
 		return nil
 	}
-	_, err := s.Read(pos - len(s.b))
-	if err != nil {
-		return err
+	if ok || pos < s.pos {
+		// Start over, since a failed Seek may leave the song anywhere.
+		s.song.Close()
+		if _, _, err := s.song.Init(); err != nil {
+			return err
+		}
+		s.pos = 0
+	}
+	for s.pos < pos {
+		n := pos - s.pos
+		if n > 4096 {
+			n = 4096
+		}
+		b, err := s.Read(n)
+		if err != nil {
+			return err
+		}
+		if len(b) == 0 {
+			return io.ErrUnexpectedEOF
+		}
 	}
-	s.pos = pos
 	return nil
 }
 
 func (s *Seek) Pos() time.Duration {
-	return s.sr * time.Duration(s.pos)
+	return time.Duration(s.pos/s.ch) * time.Second / time.Duration(s.sr)
 }
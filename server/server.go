@@ -24,6 +24,7 @@ import (
 
 	"github.com/boltdb/bolt"
 	"github.com/mjibson/moggio/codec"
+	"github.com/mjibson/moggio/output"
 	"github.com/mjibson/moggio/protocol"
 	"github.com/pkg/browser"
 )
@@ -48,12 +49,13 @@ func ListenAndServe(stateFile, addr, central string, devMode bool) error {
 	if err != nil {
 		return err
 	}
-	if !devMode {
-		host := addr
-		if strings.HasPrefix(host, ":") {
-			host = "localhost" + host
+	if MPDAddr != "" {
+		if err := server.ListenMPD(MPDAddr); err != nil {
+			return err
 		}
-		err := browser.OpenURL("http://" + host + "/")
+	}
+	if !devMode && (BaseURL != "" || !isUnix(addr)) {
+		err := browser.OpenURL(ExternalURL(addr) + "/")
 		if err != nil {
 			log.Println(err)
 		}
@@ -135,6 +137,15 @@ type Server struct {
 	Random      bool
 	Protocols   map[string]map[string]protocol.Instance
 	MinDuration time.Duration
+	// Attenuation is 1 minus the playback volume, so that the default of
+	// full volume is the zero value, which gob does not save.
+	Attenuation float64
+	Mute        bool
+	// Crossfade is how long to overlap consecutive songs, or 0 to not. Songs
+	// from the same album are never crossfaded.
+	Crossfade time.Duration
+	// ReplayGain is the ReplayGain mode: "track", "album", or "" for off.
+	ReplayGain string
 
 	// Current song data.
 	PlaylistIndex int
@@ -143,10 +154,21 @@ type Server struct {
 	info          codec.SongInfo
 	elapsed       time.Duration
 
-	centralURL  string
-	inprogress  map[codec.ID]bool
+	centralURL string
+	inprogress map[codec.ID]*Progress
+	watchers   map[codec.ID]io.Closer
+	index      *searchIndex
+	library    library
+	// playlistVersion is incremented when the queue or playlists change.
+	playlistVersion int
+	// queueIDs are the MPD IDs of the songs of idQueue, the queue when they
+	// were last updated, and lastQueueID the last ID given out.
+	queueIDs    []int
+	idQueue     Playlist
+	lastQueueID int
 	ch          chan interface{}
 	audioch     chan interface{}
+	outputs     *output.Multi
 	state       State
 	db          *bolt.DB
 	savePending bool
@@ -177,6 +199,67 @@ func (srv *Server) playlistInfo(p Playlist) PlaylistInfo {
 	return r
 }
 
+// Progress is how far a protocol instance is through refreshing: Done of
+// Total files. Total is 0 if unknown.
+type Progress struct {
+	Done, Total int
+}
+
+// progressInterval is the least time between progress updates.
+const progressInterval = time.Second / 2
+
+// refresh refreshes inst, reporting its progress as id's if it can.
+func (srv *Server) refresh(id codec.ID, inst protocol.Instance) (protocol.SongList, error) {
+	pr, ok := inst.(protocol.ProgressRefresher)
+	if !ok {
+		return inst.Refresh()
+	}
+	var last time.Time
+	return pr.RefreshProgress(func(done, total int) {
+		if done < total && time.Since(last) < progressInterval {
+			return
+		}
+		last = time.Now()
+		srv.ch <- cmdProgress{id, Progress{done, total}}
+	})
+}
+
+// watch starts watching inst for changes, if it can be, and updates it when
+// there are some. It should only be called by commands() or before it runs.
+func (srv *Server) watch(name string, inst protocol.Instance) {
+	w, ok := inst.(protocol.Watcher)
+	if !ok {
+		return
+	}
+	key := inst.Key()
+	id := codec.NewID(name, key)
+	srv.unwatch(id)
+	c, err := w.Watch(func() {
+		srv.ch <- cmdProtocolRefresh{
+			protocol: name,
+			key:      key,
+			update:   true,
+			doDelete: true,
+			err:      make(chan error, 1),
+		}
+	})
+	if err != nil {
+		log.Printf("watch %s %s: %v", name, key, err)
+		return
+	}
+	if c != nil {
+		srv.watchers[id] = c
+	}
+}
+
+// unwatch stops watching the instance with id.
+func (srv *Server) unwatch(id codec.ID) {
+	if c := srv.watchers[id]; c != nil {
+		c.Close()
+		delete(srv.watchers, id)
+	}
+}
+
 var dir = filepath.Join("server")
 
 func New(stateFile, central string) (*Server, error) {
@@ -187,16 +270,34 @@ func New(stateFile, central string) (*Server, error) {
 		Playlists:   make(map[string]Playlist),
 		MinDuration: time.Second * 30,
 		centralURL:  central,
-		inprogress:  make(map[codec.ID]bool),
+		inprogress:  make(map[codec.ID]*Progress),
+		watchers:    make(map[codec.ID]io.Closer),
+		outputs:     output.NewMulti(output.Format()),
+	}
+	for _, spec := range output.Defaults() {
+		if err := srv.outputs.Add(spec); err != nil {
+			return nil, err
+		}
 	}
 	db, err := bolt.Open(stateFile, 0600, nil)
 	if err != nil {
 		return nil, err
 	}
 	srv.db = db
+	codec.ArtStore = srv.storeArt
 	if err := srv.restore(); err != nil {
 		log.Println(err)
 	}
+	if srv.migrateArt() {
+		if err := srv.save(); err != nil {
+			log.Println(err)
+		}
+	}
+	for name, protos := range srv.Protocols {
+		for _, inst := range protos {
+			srv.watch(name, inst)
+		}
+	}
 	log.Println("started from", stateFile)
 	go srv.commands()
 	go srv.audio()
@@ -295,6 +396,9 @@ func (srv *Server) playlistChange(p Playlist, plc PlaylistChange) (pl Playlist,
 	m := make([]SongID, len(p))
 	copy(m, p)
 	for _, c := range plc {
+		if len(c) == 0 {
+			return nil, false, fmt.Errorf("empty change")
+		}
 		cmd := c[0]
 		var arg string
 		if len(c) > 1 {
@@ -346,9 +450,55 @@ type Status struct {
 	Time       time.Duration
 	Random     bool
 	Repeat     bool
+	Volume     float64
+	Mute       bool
+	Crossfade  time.Duration
+	ReplayGain string
 	Username   string
 	Hostname   string
 	CentralURL string
+	Outputs    []output.SinkInfo
+}
+
+// snapshot is the state of the server at one time, as the API and MPD
+// clients see it.
+type snapshot struct {
+	*Status
+	// index is the position in queue of the current song.
+	index int
+	// version changes whenever the queue does.
+	version int
+	queue   PlaylistInfo
+	// ids are the IDs of the songs of queue, from mpdQueueIDs.
+	ids []int
+	// playlists is only set if asked for.
+	playlists map[string]PlaylistInfo
+}
+
+// makeSnapshot should only be called by the commands() function.
+func (srv *Server) makeSnapshot(playlists bool) *snapshot {
+	st := &snapshot{
+		Status:  srv.makeWaitData(waitStatus).Data.(*Status),
+		index:   srv.PlaylistIndex,
+		version: srv.playlistVersion,
+		queue:   srv.playlistInfo(srv.Queue),
+		ids:     srv.mpdQueueIDs(),
+	}
+	if playlists {
+		st.playlists = make(map[string]PlaylistInfo)
+		for name, p := range srv.Playlists {
+			st.playlists[name] = srv.playlistInfo(p)
+		}
+	}
+	return st
+}
+
+// current returns the index of the current song, or -1 if there is none.
+func (st *snapshot) current() int {
+	if st.index < 0 || st.index >= len(st.queue) {
+		return -1
+	}
+	return st.index
 }
 
 func (srv *Server) request(path string, body interface{}) (io.ReadCloser, error) {
@@ -0,0 +1,23 @@
+package server
+
+import "testing"
+
+func TestVolumeSaved(t *testing.T) {
+	for _, v := range []float64{0, 0.5, 1} {
+		srv := testAuthServer(t)
+		if srv.volume() != 1 {
+			t.Fatalf("got default volume %v", srv.volume())
+		}
+		srv.Attenuation = 1 - v
+		if err := srv.save(); err != nil {
+			t.Fatal(err)
+		}
+		restored := &Server{db: srv.db}
+		if err := restored.restore(); err != nil {
+			t.Fatal(err)
+		}
+		if got := restored.volume(); got != v {
+			t.Errorf("saved volume %v, restored %v", v, got)
+		}
+	}
+}
@@ -2940,15 +2940,15 @@ DFAsX4axFADpGjJvAAAAAQAA///YkPLVWEgAAA==
 
 	"/static/index.html": {
 		local:   "server/static/index.html",
-		size:    611,
-		modtime: 1456790235,
+		size:    714,
+		modtime: 1792294617,
 		compressed: `
-H4sIAAAJbogA/5ySP08jMRDF6+RT+NykuV3rupOw0wA1FAiJcmJP4gm2N7KHDVGU7453N1IEEn9ENftm
-5z2Pf7L+c3N3/fB0fys8x7Cc66GIAGljJCa5nM+0R3C1znREBmE95IJs5Auvm//y8iNBRCN7wv2uyyyF
-7RJjqoN7cuyNw54sNqP4KygRE4SmWAho/k0xTBxwGbvNhjqtJjX0A6Vn4TOujVSFgckqW4pa0yu6xgFD
-w7AK2NamFBmDkYUPAYtHZPlVQgTGXNdoI6VfuMdFP/VpdQanV507jEGOekHOyAhU0WpV9dgvNtOOh89Z
-D1lMwY+YC3VJGLE4HtuzOp0WV4NDXSxntyjZXvbbVjzI1rfbMhz0/fQ7FD82TQQ+jGs1XbgCGJ/UWwAA
-AP//AxuagmMCAAA=
+H4sIAAAAAAAC/6SSP2/jMAzFZ+dT6LRkOVu47YCTvFw7N0NRoCMj0TFTWQ4k1klg+LsX/lMEKVqgfyab
+FN9Pjw/Sv27u/t8/bm5FzY0vV3r8CA9hZyQGWa4yXSO4cpVlukEGYWuICdnIZ67yv/JyEKBBIzvC46GN
+LIVtA2NgI4/kuDYOO7KYT8VvQYGYwOfJgkfzZ8YwsceyaXc7arWaq7HvKTyJOmJlZN8Xm4gVnYZBJQYm
+q2xKqqITutwBQ86w9VjYlKSI6I1MfPaYakSWn4Q1wBgJfNFQ+Blo2uRDhFZLsnrbuvPEdNQJckY2QEGW
+Wjnqpn6ykQ48/mYdRDGDHzAmaoMwYt33xVINw/rf9djsbJl6tTkNaXXhLleIFO27++yTqpBtXezTaOxL
+wqtAv6Ofc3yj1GqOTav55b4MAEBVQWXKAgAA
 `,
 	},
 
@@ -3200,3874 +3200,3861 @@ yOfBjPhB/fY/cOKV9V/+fwAAAP//EAmardfwAAA=
 
 	"/static/js/moggio.js": {
 		local:   "server/static/js/moggio.js",
-		size:    1060037,
-		modtime: 1456790355,
+		size:    1065008,
+		modtime: 1792298478,
 		compressed: `
-H4sIAAAJbogA/+z9e3sTSbIgDv/vT1HwvjuSQJYNdE/PyG1YMGbaewDz2Ka7fVgeuyyV7RpklU6V5MvQ
-/u6/uOQl8lIlydA9ffbQu2ewKiMzIyMjIyMjIyPap7PxYJoX4yRrT7vjbtn5bL5U7aI763zOT9v3xh+K
-j/zXlP66TMsk3ZzeTLLiNCmz/5rlZba5eV9Xvf+Xv6iPG1hn9pe/pJ0ym87KcZJCo/fWO/g9199y9Q1b
-Pd0cZ1fJdlkWZfv+VjoeF9PkNB8Pk4tiOBtlSev+w+Lh/db9zsb0vCyuktPeoBhmm/ff7L58/3r76O3u
-wdGr3fdvX97vnt5ie6NNxH3zc3Y9Kcpp1f98e7uBY/iw/rE3SEej9qiniroa+3bGAxxvEuCjjx+yjxsK
-1ao9fjbuZ53b7qhra2Zdpt2tgsIudSFhkS9Eq1MYM0IXm+sbxY9lb5SNz6bnG8XDh52qXSLhDRq3nfbn
-R/0PBmXVRJep1FV9dz6vrD14sJI8SLaKyU2Zn51Pk/agkzxef/R9N3mVDrKTovjUTXbGgx5CPR+NEoKq
-ANEqKy+zIX7HooPzvEqqYlYOsgQpnsDPUT7IxlU2TGbjYVYm0/MsebH/crWa3sBEqcLktIDSJB9jMTb0
-emdr++3+NkwqAPHnpCxgloeA/2BalDcJ0GkqupuWWdZLngPvDIc5jjYdJWdlOp5icwA7SafZeKoRH6Tj
-5MTtNnn3/GD77cG+02eVXmS2Tz3M/z0pi8t8mFVvmN1eAI8BIfZnEyToVlHiGNZWVlozGFo1LfPBtLWx
-soKzdpZNf87Gw6J8V2an+XU2fIs9bOopb7d6a1GQVkc1EPYFtT+vJAnNYYLYqen/DNM2uk0OyhkM5zQ5
-4YpJxTWBBlWVpOP8IkVqVT2qvAb/e55WW/v7z01JPzGL3S9qd6jrJFFd3rsXRb7dMv3osSTJbXflrlhP
-YV4rWAcXAdYHpiTA2hYtjLXp54sxfjJsQPrJywa0ZeHCiE+yspoAz+aXX4nYeZRHDmxRnNz5clxiu1JY
-J7fA9SyttKQEbg+XwMbKbffz/Zqlc7//5G+3H7uPvwnCry4IaZiDUVrh6CpoF9ilSLJL6KBKCq45LAaz
-C+wxhR7gwziZTYaAAwgfIFs6ILwGxcWkGCMUbtizs/MEt92TdPCpIlK/G2UpUAf2eRjgeTqF4gnwUvam
-ABH7prjMkotZNcWRYL0Mh0LDLYnSBfwEUqSj/F+ZQhQWCCJ4gfUvsH5K2gP8mk2oxzKjLms7gEZLrglj
-uuglO1Oc4/wCOSnFcRQ0rhR5xGsKpiofw0zZzqEm8CCQL8f2CoUj/FW347zcfWOag5U2+JSVBIYKxOA8
-A7LV7kBHA6D6NNuiWdtMrG6Hy9Su4iEsoHH2rixAlkzzrGpP0xKWVzcBTCYVgRZlQppIDs2sb8A/P3Kh
-VkmSHHQSAEQYQH1Q5hNgHgAmqA/5xw3xuZeNgU3K9GSEG1r8+2+/JafpqMqceoNifJqfzXTNKciyDRRm
-7dZlOpplLeRgC96RVa/KfOpU2z35JzB4zxn7jRm5qPkpu5G/Oxsoq7R8sxTdAukHLeOaIcJNC2y06sKU
-wG44eKdJieja4k5IfNFQjwBxomWTHR6z025TKy4KGxp1AQFDgv/faaPiYUZ0RIt9C7h6C7msnQN4Crzc
-lTX1iO6Z4kT/AfJHAiIka+iozx/AmFinbymdnpZPqiRMihJDY9JikjNLb6PAea3WtatMOUVGiRogLiOj
-x7wqQbq9K0Y3IPFGbv0mSGwOW0PwrJou0lwjqEEvsrqDlSq382L8RkkRFLhacrKgvEIcrNjCM9xlBrsG
-CZ8EpU9PNrKN20AloBluNkmuUHQjPAlz2OQT2GlpxyOdAP4x+EXwbxO+GuLBGvfWjX4FHLgA2tkeUX8P
-1obFxVvYSLUq4TMi7oDdWMdIU6yAAL2jvHpZpmdn+fgMCMqyRJSmzrTsvASY8Ww0kiAKDRRR/JcsVPOw
-qWgZFiF1Ny2lfQC91WyG33oncLylUXYitd5P/DrvJzU1hvkw7Eh+9OrdIvnkntGOELmbfOBpAbnYT1r+
-5ly1ujwJimMTrTrkpC9U2RQZDHnXbs64u/AvnCyAsgyrmzBbNtau8jPQdAj2PIvxaahDAGBlGrM9p3Ck
-At0J2J9hjAbTOymGN8kI1s+I9vtp+gn2cthe0jHrPLot+GQ0D1C9Tkj9ADUMRS4vN6VGJwntUUJ5DkjX
-BqIVtC09WKNO9ApIWMbyBFIJTUjxCZbpX/6SyIL3E/psa2pm8KptupK0xzRpO5zfTVpGb2l1Q0ZVzOb1
-oTBYqofZxG///cS0frsSEsEu7nCkw2w0TX8lRSVWchgrcYQFKQgewDV8p+H1BqMc/vnVB7jxAA4t+vwv
-F05K+vdldprORtO2GuQtndsSubACTTK6sICrtc4J3Azin04InubrMHUzTwa9tl0erGPB5Tmwxyp1O85E
-klGtXI5zWnNLlh1lOw5P1ewI9za5jhxJk45Q006AVuPWE0WtidvrtjmXdWM0vAk6rmFF20WEB/dIm6xQ
-ZwDGK0EaothF5Fk4a0lOIho2gZOMBDdtJsNmZrT9AheuPUADRpaC6qBJoBRZnw61S0rKrshIUMOotII1
-ge2PzpYg1wdWjaWNSB9qoTArx4sJe9l3g5xHlbBW1GBhRMysyElVwu/hJjSzqhlgIwQ5RJAbA3KzscCC
-2IwsiLU1lEJ6ntMbmmvgQxA/JZsAkA1MU8lpSdaFIqt6so33UPu4UWM+xh02vSxyUFVBq12lSYDZ7s1f
-XY3ttkO1KNx57ELyaInTcbNxNwnv9NnqxtlGwghZvJAkkepoW7JH1+GEToQ9nO0zvnk2LLD3kznLC5Xj
-32uFvZ9E96xALId6g0Nqf/v2dHtnXj/yyUPJo4jSvLGiTteBgTMOTBZOR32633/Svd98RgWQRwjTyO73
-+9+hhfTJIhZScQqdlMUgq76ZTb/4/siZ1EXteNnFZHrzSk+GY2VwisiqoCbo/QTayNKLBIRlhdVgYCSY
-jG7YS16CGKbdGo83vBLTwQAGOk3wTiE/zQfY1DjFcwpRZpRO8XqiR6jG7DGOseK1tm0im2tb8XBW6qPb
-yewETXITOLkp04S6s5ikwLPJZzQKYKUDssrdJmyd48bYWMC22bOcths9MBAyPbchJOf47JZRQNsT452w
-aS3rnfXgJAsM9qmF+gufSXCXaXnt6AVxay0vW/oPXdbz7l14p79VBsfkKp+eJyludrhTHicXoDYVQ3vd
-woMQck2fm5Rl0gyha3DQooyEHYH1gLOdmRE7RxygHWm3y/qkEYV6REZu8hic48OFu0+JHrls6U6tBL5V
-EhfmvsqcwU6n6eB829WkwrJ2C1ZI8jBKwa8zxGG2VGfh0FaC67t5S0gZEb6tIbmGFFFCW8uiq+je3GWk
-zPi4Kfay8WXv7e7L7aPttz/TqbEFJcOZEsny9AhqToHbP1u+n0+nKL75hsncA82bZDzRpwnyVktf4pKJ
-a6iFubrSjbBHcggbYJJOJjBNrI5TM2Q3xpqwd2V4LVUVsJUxGr1WoAnVrhFnL4ou2jsJITTH/NEyyOmz
-YZ3qdaO0fQcNpwTxuV2J3nE76KCmyH5IZOY2O/2RYrZWp6PUQ4faoPv90L2vge73H//wA2h53327B//9
-FbrrbDDDPrfHl3lZjC/IOIyK3NqDf1YjUKpgJeWjPrFUnYIHKMEJGGXyZnLvXlv5hl3l42FxxRIFCYhX
-fMMWmtu4pGfu28NPPTbjqzsVoRfu5xcTmH+gPhDjKqMZV650cCADLkShQwKW7OHZNGN+xmMZyK9pdj0F
-kmJLvxTlJ9Qif8pGk0odzQd5OZiN6BJ4grb48SBHwyTUBZYGYcWcQRtOWmGrJ8WMZskzH8HfN2SdR5O7
-6omltrIs3SRjvNyC8sFoNszY2JSyK8DxHvoacKVji8mN0Fojk0bKK20eai769s+uLeBmq36iJol/+5Mk
-KjjLuxKNkun+XltNnC8L8QZcFwn9RjT8c55d4SIOmlTVKmCBbEwV8mpnzIj2k3sWem0teUUEv2KLAF3S
-IJuuJhfEGIPzdHxmFsTpDPeS3kqNGIvQlI6yIIi+/yaIfndB9AqdoF6m0/QA/Rx6xt8mOGCuPUiUYDrJ
-p1d5lTXLpiNY8bCAcIqVzwTKibMxuWgYAwFvsIGvyCP2FYFCkkpRfxFFu00LRf4ipp1PsNiBEgymHQ4U
-KsZBogcKzu7VWDty8BbKVbrYAvsfEJIf4OdH6I5L6Rf7dBivDobbIJc0xMAlLco73/cgBqF9Bl4XgIzn
-+MnfNATJKxeAPjnlW9pv6hcQzu9gIe6hWCvf5Nf5OFK3Cdxp95fzLBv9BCJ65A8qKNb19gdlgZqSB28+
-M/NpaJc2L2anp1mZDfdwN2igoYRram+rGM0uxntZlf8rYzSbWg2hm9qGvpsag+Km2kyO+dwi4eIt/ZIP
-p+fzGxJg1v1lBExALIDeP57Di1Omux5ce1DXumQIMhgWfKY8kQWMLNHQyxitSFyM4X/zdOwtBPNZw1Xn
-pEps/9cs9TxuZImGJj/TEYhd2O/eFexx+uuhWy8OY0iI1MHzAVKPlkTPfFEQvODO89GwpJtGhtIfFFBJ
-6++g2KdzNEDFZEbPheIxbL95d3B4tPvi/2xvHaCWcsufX+zuvdzeO/ppe+cfPx2QrDVKHrYKGw8qZMbb
-knQ68pRFJasipiOPuPMsxT2RFTxcHpXdSWFzTG9ARdP3ykNsmB3pcnLTOi1Ir+urGsfHx/jPw9Xl/3uI
-FX9jqiS8TpN/lMVsgieu35TMoZ69wt+44k88DO+/uu+JrRj/r+67qfgFY7xjj5o4akRMhsojjlf4+4yx
-aexfZ4wv0P/GjNAbo1f433WMr0CXrJ1Hr/DfNEZez/jXamxlMrP1lSMKntdQSLBAYb8uFEMAiS0kKESU
-hEEdTh3djOrLYkXZstiSReTgupfo2Ipa3Q2Kr/OizP8Fp1H83TMY1skIgaaWdgI3gRZ3JVGg6+yrc9TG
-GRuU3RaXbnIyU0ASJW7HnKJtkW2k59HVXbcOrppm8/C6E6Hu0K8a3bJEidGDm6olilzojBr55S1HEImD
-QE3QySCmv8UwqpE/dWjddYLWIvqfUSmkbyaaN4d5NYENGp++9JOWW4ltEBOtqvTZuuFcyb+DCiMYPaiN
-tMHTwTHZOSVPMj0aRd/TfNrV9dKQo/EwQHbkdDLJ4ATgXNVTD32rSPXGs4sTUHbyao+1sGHoLsC4nbOB
-KkAODd9xzLwprMFLw2/nZHU65n6OcUKOL9Lrn9RP/RBE3Yb6DktcKxxYOJo36XV+MbtIJv8NRmUgFxlY
-dJpaVVJcjdFip61KM3b1gJFcpOP0DH6IRcEtgd4940sJcj8HrTO54tc1STXKlYmP9wiQ2adlcQFjAN2T
-TVPFaKgbwtWMKut5eqn8icm9BNqCFceeJWqbYqS1mzxjlACdP2mCJDtQEYRhFw0VNzyaiTIxYEWu0kte
-QMMVdnZM41aE7iZXxueYfJZYuRcDYflwkX7CutVM7Z+nvPWjQi4lpSKTYQClknNLuPde5lXOrSq3q8Gs
-LDO6zE8HGW15poJuDubkMs+ucI6qC3wJQLdJyt6Xj9NRl1E/BUojkiHrGldoZeGdVdZTq8SDNlbDsZSZ
-bkQPHq255BQIwhK/a9Mbt4d4+Zx94PDTqUPv5MdEM3zb5fiOy+CiTi2L46AR3V8lBBykdk/bH1rn+XCY
-jVvdpJXOpkXrY6fr1DlcsI6/lt4SAkhbEgRSLXIHgMVb6KbxhTKVupmNR1lVJcfwg2nyj2w6RcM5soSW
-EGoCydVTtzbMyUID/EWuWAGKdRRuRBEkoem0GyDVzmEBXXeOxbsV5GrYG8+xR/3uUDfGCAMQIUjzU6LF
-VLR7TA1MUhSvdHEBJTUjYRTkeND8GfHKLvDpNxMXVoLao2k6cSp5VO54zGrUw+oJoOOkAnk2GpLIvgBM
-k2xM7yah8XO2eKXVzXigGznNpoNzXE4ww3Ret3OX4IVzUaIs4yt1M5ksiQjcOOlSQ/7uYNDyCdE4rYom
-6fhGmsa39vf5wVemlCY7UH6VgYIEezQ7IVKF1B9Ueep4IkDYqzF/CoNdDYUpnyj4yMAastsRlbA6fff9
-M9bweWObjXQ39jcir101U54PY9LRe4PS/INFwN9Rt7wrCXlTc1vlb4sT6+X2u73trecH2y9BH59Veqe0
-aNHjQ0DV7N9kCHMexym3UyCA2mYHGb3bxZ0ZDq0xBLGRQKTjn+0P4iOtp64AS8syvYnJ+WUnRSEaTIo/
-+vmT8jPLwdNQf3db5m+vs9OFpmUHF6A9RZPGpnSpItbuQcFnp0XaNhh7GnC83clS6OKOMR/XveJqkVa3
-nKeYdlJJy7GnZLpJQ/vrFM3xdPDE97ChnodC2xszb2MeixZjPphuj4fz578RyVADUBs+yGC71/OKNnsN
-mVGIkFprlHsr6so9o7SNM3ROwc3nJBukuH7VW300mHB7rBlm1RR9l2FX4N4qnC1ySsKNurISeYzXqT49
-tvgz47BFN9hfSBkeIcU/yMNnK8V4D7cYKPla3QyLGZKhvreXBPB1+iRHvdUhKMXKP/gcD5Xjiv3MAqFD
-/ZPL+kuo8pV6z9DPf8nut8cLibyF+h9l6WW2ZP+vsc4X9k/nIxg5Pl09yTLz/m4opYCSquMsG5JCeZKp
-U2zAGfJ2E+SB7no+kr8o75tUd4Z48uFxdKO0QcbV6zOvRJ8AJbvC91rKTQ62NeUHR1eNwk3OK6GHXvpt
-lBNJRnvzuTrDutIMY8qXKTuPf5abnPeRdpJ1dvczI9hhYbWPT1HcEciSyAjQoDdR16/0jIR+bJiyS+XI
-o46xKmhGT+lOq+anHYX5KKlhPga0kI/CK/dWGjef+uvoNjdozpsqJEjPiPiuh3wAwFuJcqVUTrNQbsjs
-P2mS6BmooJJ1wxSPdxjxfdhYKaqIvZFuR0C6yaO/rrN9SocNcJ4TwirF8+A040m10yZiLA2si8Vo9IbP
-49bjOCg0Lql0i221C4creqLAEk1Co6eZcTRDhy+/0H2ix8OB+Tgo9hUg+r74vdwGmKkblChyXBbip+rU
-oijKY1iy8IkiKnuU837leq8gOwduK239dos+6sdvfNZkECr4tbbkUEx6pJ58hBaW4mtPVhofrNEbushb
-VvNMjclsjE/05FKbjiy1dPAZ+9pXvVSk9oEMb9LpOaxBmIM2d2mnSoFAu+sLNKgKuNKPIBL1W29+kMez
-82vyFEp++02BPd2shftRfrxIr3mif22i7mEjdQ+/gLqHDnUt/ovS5t9D7MMFiX1YQ+xDQewy46hqQl+W
-1I4UR2SYU67FhcTEAbC7nnbbjFV0y2QdNnSYOjEUHiZ11QdxTPD5pbYVu/JObcGbUp7Z2XXq6Tngisb2
-DLPlIm35YOARzoULHztZ8pzHm/ZwEGbu+k6JeQHbtttUt66h6HMOv9EQ1Yf1vKjlOY7RbQi3Cb0xyO/e
-MGMHPm9naYB0+4xoFv7gAibylIGX9Iw4rgvoMrOM1O4cW2g1esYev9Hx9ehaGBlsYJxdT3UgshpNxID8
-CRWRELd/tx5icAW9Y9du2gJTs5VvxGAPo7CHdkBOu2Y9+FoC7AZOozWAh/4476I8BRjZm621tWSfDfjq
-WiKwMqreA2TNJhw04ZnAVAMdZwJYtGRToar7+rshclcIok5sjcESfU+n65r1y4XLLWC2LjuPw/BDzVGR
-cHOk6EbjMdIUinOfWBIkcmGr+octtXwgqnhOJurlUDtwee6Kh3Uc9oEaOeLzaatrCgf61qWfDK7brcns
-ZJQP1k6d9tZUrY6tNiRrO6Mt0MMqFkh5lDAU/bBl2isjaEJtbQaQLo7E2T9J/rXDnx7ZT8XpKbAWGwTs
-V2k7cFRcC0IDNU5CATKvRLHfrvVUj9Xc92FU7dtOIJbsTuvuyv4OzH7VxZXx4Ech5mvppHiiEKXvRVQI
-1DSH3Qtcog0d1jVkcLJan4vqM/sgobe/85/bSV/HKHHqH9r6UjVZDXtYTR4nD1z/arHMmGV3NV+Y9tw1
-BljVMKCLHnqOybb81h8abH17DteeTouLfX6jx5VshBYKn18UU9m8r57e4x0QsHUR0d3iV4N2QMqYGUpo
-62Q2AvGfWx+NTQ8j3Y/bgmctkk4ewZ4egvzoTLAVdnFihfVXnfobwvAoKcmacz5uOwXdkEgPox2vRgZu
-XhxHeDaKpse4wfrXu6dZH2JfcNaniEDlV6nZF0y53A/QQhyyidgSeIfcj4IBoayMEAJYXXD1TQienx0U
-LeREvd1wRfJhvXi0+sk8Av3qhuiyqNObG18kHTaIJF+omQbsViZnljvQVSMI10zPTyFk141EEJkIhczD
-QPT722E/xtJNU+ajM2/SRI9VDMf6OR2W6dl/jIuThdSZ8DmYJZKrRpw7nKzuLX+RWsjAuQjBLtRsgnZu
-NAeQF/MqaRC3Xno9t54CwXrsSdZ78/zXo5+fv36/rZtRHoL95N49bse/v9GAI9BsdtVU1/aIQA6Wn4Du
-P3lkCxUvRTx6z9zQugTTVYMrrn5ij/bRu6+uMVFg8X+gvlrbI2izK5qrnA2UT0cmsppVqx3h7UgI65th
-ZHfgrPKspgDOBf2gyAToMwjdUVvn+svq6apWoKdbBJs1X4SLq7zuOpMUDTT11UeLqurunjxfvUb4QK9e
-St0PTyT1Mqrkt6/67IhnQXzm2nZUsE6gci4/5zzj3snsTucyeSrDMjnj9YcxX4bGhIE3s8G8iln1dOPY
-RW50dmIMiW3FGLKWRbBCLYu48sfue/JrKFumxWT/PB1q05dQ6MVXK22UPiOsOLp+DWe0hvlly9n23an3
-5tw0J5c5hUjo4+N5nAH3kHAbZ3GLsau480nDHvxqVXYLE7fvxy4J4FAZNgubk38nYlXM4GDwFUkpW6yn
-pnukqKGmuhWKIaUqMG7qRy2G8XWOMVMkgsrO149YCHvaBhiOJqorReQCBlnQ1RsG5A0pcUI5NRPeOXPK
-gUWwjZxP5yGdGP3SfhEHfLmrub+xL7FD0i5nf5uVZz9JDlIfzWiCM1q3/nxAJR3n0k/vN+5dn9mFxI2q
-kb2LWyrn8Wx9lAe7uobsEbRn3xCoFW/cToxgLysEU3uG2svlRx/SVWrdr03bBQq+2Hbhcr61lkR2r8Lf
-t6TjoJJd5osD47j7CUjx3YEX7nkC2nwNYZUvnQ9Mn0No5fnmQ9NnDR3zujeT6JWIOvpZiwFVfkcWImgs
-aMN9reFxjgs9V3Oo1QZwpmu1ATQGvE6Js15QdgiOsDNfXwI0dQAKjX/ELeosw5Y1oFErgtiR2u/W9Xgk
-f3ftjKjeGCn9sji1HoDokpjz+5AHxk8RD3jaLRUjRGJqDPW+hRPSaED0W5zMpqaDQVFi+CJubVSo6IOF
-/8KJvAqPfGVKhniWJZxoRsuqQXFxgjbBXzRBbZE9zbrfkRQC/Jn8/kYdw2NF6qQtG/uNw2MiGuqgyaXR
-OO+oIHEEIHy8cfC63bG6iMUVBOuq/RVcTJsLLxOSMvDFlEwXnnf7Ylsd0RIQfT9MHHomq4lDLIeRIyXW
-zuHTUpUbe4ZPUDUWx0bgbv8UE7/vhsjvBhCHLsShhHBDtMcDVyZy06dzlJlZracFK+8oLZUo2IfVC2Su
-dobQD27VkovrodrFyGwweEuq/pZ8YyFULC2+xTaw6muzuxH+r43TRe8hOK8b/fljEnSykTx8yO+s3JQ0
-MuSOQO0DwX7kG0o5Eqeg03HiePpoyowU1jtT5WZRBJ8UE7rlVc0/HysMmL8tyZvg2gNNcf6DLnHgFwyH
-Fph0lhiksIRgr3Pnz828EcJRiyGwnFPqCc89+m9loKqE605d3yrAfT1XmQEGjfsOOA2YMFmwQP6uQ88f
-8oI4+rR3+g4NKVy6Mz4tVFwkPY44raQblK4YaHaYtUv37pdtxKsHioDfRgAQacg3SMg2/LKa6o14RAEi
-DfmWOtmGX1ZTvRGPKEDUrUxfjzKMDsGlFKLJKJ+KgrbL0fNm2Gu2Ryr/ErPr17d6orHTarvdQtgrpxU6
-Kf1kKtlRxYYVYRivv9ph1TGLX79mWDiFSw/rlanUPKwI/3n91Q6rjvf8+nJYQqKEgsORYlHpUePRIRm+
-BiSCf5OTR02Li60k9q2MSe26/tXNBU8gHDj+4Ra1I3LZNqa2CuO9SfMvWnDZIGjE82VaYvnX4DNnpuJ9
-uVy2zDzVtOdzndVvViLL05+An9Q3IeikXmRrC+3IdYKT+pDnHicOKkplgyOP/mL1IN8GZGIzth13addF
-47ffIk4nAqCbtA6LGQelwZNHlqunaPrteElRY1TdlrSfD2zIxQ8fmahOKEYQCOV2OjhX6GnwrohbS9+6
-SUS3pZIkkvKKKe7OoaQFVexRUNqjI3HNTMx5dIQEqQM5OgJqcMdYKOIh/Bi7uE7WnlKuBQziHwXg8Gdr
-T20Qf02D3mRWnTOqWq2SlPV8mRz91hAHm1FnENQK1acP6x/rB2/pGPRgsy4KBc+x5QkR6OinLhBeSG8E
-DZgjdWMLCsptQpuE1B/CO1e25fvk3ou+3NEPYYQwr+wbmEBuW3D5/grtVU2Ku//MpQmVwxCVwwZU9qXF
-d6nHekkwl6LFHq0/H9DMmYQshDVEDkLCaJ+SYHeP+aA7wsgnz9zx0nimBcZij7X+bx88PVPKKOJVgFyw
-2kJXxU1/nT6re2PKHk4N/GiMuURxw3PmsyX92pofConj0A8xL8eNE7JBxWS/0vHATguOoWVb4qpCef16
-r21r3tn+GV7aSsm1wJoFfmu71yYeH/7b+Vg8hIqylqRBwGAOgRofHGf20kn54jRRObBESPOq3Sa4Cd88
-6x8nZN26faqul+A9VqRBGUW6Bn3xJl3q02JZ170j8KoIw09tiPJeOvwn6HxCPaDCqm3VNCYcWZm9k2JF
-D7GC/nqD2NnKHEfqa+iB1hJz8aEsMIo6CxZz5BfZKjf8zY7h5u93ICox9hpsFgPMSMXVKMi3ChrOX7rJ
-sBi3pu5zHFa07JmKxfxmkw1G25WFwl2D79PNsG2pjrMjzTQdyea1V+26e6rMNd3sZzKD58r8nfy4CM5o
-C3fTSylqNY4Yk1qIKjUoP9Rt9ATb2HomI60U8FbL1lK5hpSrISVd+uDFSF7Mqnoq+uSKYrAEhYLzs0em
-KEbLESm9TPOR3W/1oERVTIMcnQ6XOnpxiHW+yIAWn4yP8fHQS558/HwwyCZTOxB9AUxjiVEpivBqlB4b
-lmT2HSW+12/o151ge1RpqBKdI9nh0+hQ6nqKwcou3H8dRdifjkAwe4/C570HZ2uv1Zlt3WeB+aPvtGbr
-Ew8yzJ7ybfOch40HXERJfRhR3x/GHjLp7sTTns3EqL8RJPyjcPTFf+CiEHkNGQzzbZYNaZBxP7/FcdGL
-sX6LPkPXm6ny2feNaDWPQX5WAXU3Y509dSTIHAuA2Jbr+5EOiWZqVje9pyTmkBGQ8eECoIaxaoBjjwd/
-lXEC1rsxYqxGtJzgAWJtG+ZEZcet2qg8BPJx25hjLH4O8GEIfNiVbp9iLu7FvEFBExoXsYjbJn5dl4JP
-kusKUrZKrjD1V0peMVVhoj7T8TMdJ9V5mY8/rU6L1dN8KsK/C91HSgwp7YxICWbbtz86qznkjYaFLV9R
-B8ehn51DZ9uZoBU1RgyAT25OlGOa/fyU5w/oj2wyxhytU0wKgZ5BJ+gqgzHFfH85aMxafT3LgzrsY3gn
-SpxWZukn5DHcAJLTjHJUV4bx4LStz706gVmDo0z0sOWDYbZ7u9krvb6rw+qbg4J2FKm6kUNI39Xba89r
-/ci3GqfHhdwd446O9aKo31Am3iSp9ddPwqdidmX1I8/6XN/tfow5XRc8L0hN5KML/2s/ibryHervh90V
-u0o5f4mK7I4ZKy/SGwoXfVXmU6dA32rkY7HG7RmO/N6QL4JV2Q+Y3TqPuo/LIqHsah+xu2uk76+ZW7FK
-XxQgp4/11dIxoXrs3fgdUxz5M8w/meIp8ORG1z52d9bjSDxdisKtU4dkbJ5jt+KebmVnrAQFFCknLBkZ
-3+Q7htoVRs/XyRCwE2PWQ/mcXcmhYLR3fyQaVKcLPU+ht5ZOaanCGEpzknv747hNkAUFRIMWKs5H3wXK
-heiGlRy3p7BJz2XDGnnEXZNE0L+0lXhGyuLo+oEWapuIIx9eHNe1HL36VHeYujl5g1lzdezcZdZdLydr
-DygGs3bK1Hff5vNnuV1subYifaHopLN0bAQMGjcKeO3BoRZPik6+PefKGgDk4xJ8bUVvFXPxTE8ZlPri
-6tQWGguRu8NAu+pMKb66r/Vwse2p8Nd1lcUkalC8JHOT1QnvzUpNRDbSiY9vHVczO+FbnvnNmXfhCRNO
-uHST8Wc6PsnWu2WxCa6ZW2u20zkVB8Z0IUN7JrJDdd8a4wB/8mMT8k6c9xaZgZAf3gkucBnAMJsEVB+b
-5tSWjdITfDIk69MnmPXYtAuyBFMuvITCKZcuRItNufX8+YOmXHT4Vaacz/3/rinnJHTOMo5NqBi0mFDh
-tCKnUvqyRCcxfGck9kbt5Pk7TqEXLtJPfeAezxixDxE6fky8UIJuM+1IFXPJ4V1BzO8oXHrAKa2Wv9t6
-i1BvyHbCPDcvb958J7AlNthQQ/iyDdZrT22w0c0roIdDh4h+okORej5mTiBS3/9sQU4+dT3PNA1itvwv
-EljW4U8QgjqX7CuxUdLK1KnnxQDR+qohuT+viK77Dgbhm6t+hCgiJveReawqXwjRpyAs7K9d/8uhfFVB
-CzWvKDhjNpTvcZDq10kkrOmvUl6QwSk9qdq66aeJ++nXjh+2co57zlI3+C9u2n4U2sOO51E5xxSnL5hd
-g5wYdWCbw//iL5F4gt2nmb6fQDcCax5nBq4Czjte9yDveguEgFHLQWS0smbceGGUWMcdgae3Znbr/MAS
-4KmHmxzD99cN8XEzuabQv8/g//rJtV/0NB5AGcDj350m6ifL2Eyu5Ri1gSQSPr0t1ACzGP0gP+669Evl
-Eq30LVHTmrQBPAHQxrh01qTn3REZrGcewqbskBcdqRuByh2nW/Z7jPIwFoxqEc88ISMsLu7gIxRbfCUv
-s44XXMXLreGlp9Ipl/PoVVxoq/DjjNvMPHLhxyHaIS93YzMfbq23K7c6U30k6FeyWOrYSE3OH3uRX+d4
-FPvAjtU2yjAcaGZlxgeTNwj0ESdtUop0s4ihE2jMz6LkpC5DaB1dbD6giTDmpjYJAG14sbltVvNwvFM0
-12KGJygdZWIfY1DgMcZYCJij3as476G4TMAAOCa3VmnMx+M7t25J0wI1BdSraWZCbuj9q2/D+y6E07RM
-xxU6L73cfaMv/X89bEfQ7CbrbpBx7WcotcUvDnriRzWKsHkYnSU2ZXcLW6JbjE2TeF48J76ivcC6lSTr
-Ol4RebU7Sf9rlvm2GZjLEh9Ap2qqLQlaDpBaeca2aMrgyK/+5n/5vTNfLVwUw9ko62XXeEUYusgBBFrg
-/Xs0+6pBX7HA8JKTG5Wmk1x5OdBxfooNoMNKOsKktbfdz/d7a/UhRHolUvJ+/6/dAEzgoEL9aeBHj0Jo
-3jmh7HFYBh2Zqt+FxdKrFyAiqAi3BAD4AQE4GsD9/uMn+GuPW3/8vfnRJHgB8K8GUIbuhgJq3C5/hfbj
-v+Fn1zJ1v/+E+h5cw1/UsUyhA9+oD+elPHyk9s3TF/jwd/wg7xju97+jb3HJcL///frtx+5f+x+0NNWJ
-CLrMW13FW53PKxTX4kGyVUxuStrs24NO8nj90ffd5FU6AGSLT91kB/YAhHqOSR0RqjJ3jTqVO4XFqIpZ
-OcC4FMMMnRxH+SAb4z3WzGRofrH/cpWWry6EUziUmiTFD5LXO1vbb/e3Qe0ZZTrQRVkUGBIDg10UmGX1
-lC/BVXfTMst6yfOxTIJ6BoSZYnMAO0lxs9SI0zW3223y7vnB9tuDfafPCsSd7VMP83/DErrEbLdviJDJ
-vFVDdfDhzuA8G3yqKBX9SgsdHTC0xWDaUkoGsVli0lW0W4rz8I1RmLoeQ6BQV26NGqDaNubU5hG0tB40
-uHbhB9e6YYd/XSCnSMP/s8jHWypDrQMtCjRsnMPdanEYg7hRPozaZr4oiPpZXFDTq2+AFb5AgwuDMc3V
-pLwTw8Lwu4uqf26EJgtOJrgA+nxRvEWwpgU00Pqsj6a8MU+jgWrIpujC1KQ8dIFq8hKSVrVAKmQFt1B+
-cQW7QD5oBemGZqrp2o+ANKdZPhnFc9XWADvxnOZxTySYk62CmaYYTOnF848PX5JJ0DziMjI1fFVkhKk8
-i4p3ReKrv7S7Ye6jrn2BbMDUIdSz6GIXB8WeOhx5mKqYVZXEyXtwFClRb4+kzfeuOfeUWQTV4AG0/Byn
-2hjYvzyDzwmNknODHOQXNDUoSuBPOE2opw2ctZMJ0k0era+v/yFJfjiPjE4y4z7Di7EIp5PR8D6P+NWC
-cqf2ebSOH5h/ObaOjKXbgHA3QKeBp5viay3G4LYzj7/DAsPeImNcDTuJx2KjLC1dvgqhg8HchUG/c/jT
-KZSWMvl9gVXhhtGuN6bVWSMXmgU81XC3Q4PYbWD943OoOVoFaX+i5e1IgkE4o76kV1Gg1ife6Yz9VjEK
-n24nmVCO354Uom5YK2edvx9fNIk5Vdwo6PQrffWS5y5mrEkZJh7SZaFaYZ4tRIpAPjiqttNOWJ2/OECu
-giCB3ZINN+K25hr3Mk+WbCxAQQlvHrGxUhq5pI1Bh34JpX1PKyuIp1B0quGEzFbSbkbkWFu35TwELrWw
-4dQlAaVkLVHtPK1eUBhaVny4psJ0czN4172aPLLvIAK1acO9TnLpy/f3d4ton8uwgXTgKD1vaB2+3LCT
-HW8QzFC8Y5DRfPkE4U+Bf0/BWqi4ZvHDNshIy/awISrImerImxV54BHX+d7deVTDlUhEw9sXY3VE0dcS
-e14UWgRxzjICMBKEFsHFoUYABzFoDag62/iwTghaA6zOOD6wE4HWsQWL43o7lEmCHRpCZeMF+B6F8w4W
-xrOosdkFamFWB4olo69wwtfAWjNwnvZFZVs7okPL10yVdxUQM/ajXt1kta+MnT6mltMDRg9d/TCt8JMY
-LGvP14bzyjWVx0SHMEobNyZhrHAcmKyYFNeyKvaP/fDZ2ZkdDdkJM/CsobCtWu036cqLW9KlnWYjagaf
-Y5U26jMUfx/YmK3RN2rgFavnfv+7x3PNuT98M+f+weZcdNFczoy7c3Exoxe6u/w+yzFTeoXawjnP9Dv3
-qiJStwlct+veVHi2XafM1Ki1AC9q0V3QHvty+9Xz968Pjt7t7b7bVwdXj3p0eElH+RkKXwyxTGLuHJhi
-hIxBbnAYXQu/ui634rN0vqbPibn1DznhTqZgrHiHO/+YyVgN1trCoP7uafuDGn3SggVIOYiSFq2N1kel
-6ogd21auhKOzQ7WYGc4lYBxC0vJupjyGs0GufVBVboJcx8sXskvzAZNDunNQd34fRSotx3EPn2IdS4fy
-Y/8hFodZZ69z9vIVNtDxTbxPULW5HZiN7DIz77QYD7qzxU/HsA/S+6t4V+R07vEF/tn+4M+3JEIThZB1
-Yviafd8MnvHTmEQQtB74DSbn6HxwcIuvMCPCh3+BOcHAV1/eqZqxufPCRoGaefFt53XTgihzlPEvxXmR
-yyUfA50Vjs7qlA/ByWwASltEmnYohwInPdANAVoqRD06v5TKs6GXvCPTDr5Z5EATWLUYj250qgN62agb
-cZIv6PHBRp+W6UXCo3Gj8MdBUKjGS67qK2nZVVOqJFe0VKU68OPhGyj14JtedzqT5ud18O9/YvxCOQb5
-OIH0msDsjCrWw5iaLl+MotdA9uZFhfz3DeteScQGpk4Dznb/NW1ptQc3vUk4lzKqjEerXjnA356PljBl
-mKMeq1Nql4Vu5MEYzrC61/gRGFcDZ4xyvYwaoMWG3TKDsN8WaAGQ44Cupr75skBtUkX2SMnQ1ekTGbCU
-9rFoM1ustUTaUfoMH+c7+rxs6OzErGWHSGsCZ1ipldiQuVKbkfHiqAljHJASs20/8UNe9ze+79Kf7Lsw
-a2SQP52IZX5MtK+BgdefsdrVIlgbQ42wITbGV0B1FhyaScRDuRO3xL0L68t59TPM6lCbJlTDnRjpg3d9
-VBAYJ9V3zC0aNUw5uN/Oo/Zyzoe13chHg/Rd/W4Ma4gOjOok4DOvK9hjb+5UC76Daly8afvYhhl/DIll
-yWH+muMkylzidtmUTy4coONXKQ2hsayQpSmf5xEapCBceiCYt27BMRiY204tc5BXa53PcWy/atiXljYQ
-yqHrP7s1ZsM4A93Jo7ee+PUi56pMJ4+idBcUnDP5oq6Hkk+Muag8bnVkb3P7i/S4dJ9P/D590UMctuL/
-JT2O9dub+OKpzxJmYdp1+bicJxmyrnRXIQVLgvIdkfyilWr3o05uJT86uyHjsrgxGKmqjcCerel+/9Hf
-7+5BPM8j2LMD337s/u2bpfffYOmlF87LmXv1GwJgJuaUHkwj6q+ga9psCNO0PEOPlM/uZfYjvsyGwhnF
-9jI32fnDhwhM5xYm1KaFwutr2w7aj2DYDIaVUH1QqMCApwXlDDhPq90reseeldObHoZJa3OVLraAmljC
-SH74xC/fuZR+beD6gf+nb24IboO2GCEcqEtk8mI2VR3lcPiB79RFpQfE1TnkgCQHDAJg9QiwBj+D2z1t
-5x2MVrveIdmWj2fZBkeZW2iYhEDekZXVSOluHIqJoOHwYlZgE3W81muaIbRV/L/TbUDU3l07TnszsoB7
-uBtbIxC86j2lC/UHOn/LEN51dw0N1wIkNnYuJne/HzAtfIWLAt/awy6ZIEvrUp3ETKVz/HebjabGtEjf
-2Y5dlBfpVAvwNKlyGGyWvEknyqylW8KsNGOLM1Z28RsublIN7KjGeBo3Z6nChcxp5YJu+0tbVRe5JCHA
-f81v8qt7pA1MBA1pSpAAaIdVS4gmse2G03AsNuz0I3wy1mNRObrJP21QGbtDwr75T71RBsdifZ/ZFIrm
-noztQu+qcCUC7QY3gxFKKcwn5KG4KoyCyY+bfqTaRvCHTjAZrsO7mhuaAjd0Mn2NRkeYgSi3gQaIurxt
-KQdNmkjEW1kMhg12n9J67gpMuj7WrBC476GTYGgPN8Ph1BmQdBBh41Un40rHQgYvY6ttem2r8+gKNHSR
-Xj3cJP+a89hWue2sPgIxJY8u63d2xplrZqB9gUxr9Be6SNWcxM39R5iSXl1ueynpiWlWbCrl31SYHW08
-XJEJnaXjn/1qJ8LKEclZK36uaP6iMzojm0UlkLR6ejGYnKIgaJcvqFREpHjULqwe5u7Q/atDpFdbRYYS
-78SFIdmDlUWygrBH+xVsUYiMiK7s27RrrNmqInuXcpArO7CPUYuo7NB4Afv0t4UbYVde7Wfeh7ZzQFd4
-oTdXA4orDi12KtqXU44f7tLPlnhRY5wd3dLW/a6wlx08q29FOR3OX/qhtmdtjcqhw92J4FPXI2zf/OWX
-cLQ5QVRRLiLfiV8GQlqx3Rk2bpyuoe+liIEncYmH23U9RuQvCyE9RuQvDUGmfmHiF+nNBcoXXppzkSRd
-QnnJ0n01z/2tocwlvpCL6vtO3Cn6Kuza8Xq2KeGZvT0XS7k3el6W0W1TJNG+ctOKLB3c7MpNAiIinDnb
-u+B38/22yW2KsyV+0dnoKzlQiSOL8vVXN94wie4xY3EfIr5Ej7+TDE9K/7nKXhowpVfn+eDcJFrTHhuK
-HNmwl7xH+1vWO+vRVH7KsgnscbohFRAao01zLEs0oJyWxZjGVFCuT3xC4A7r9zo0HDWdGiyFMGr8RHgy
-c8bF4OhRa0ziyt3kQ8vWb31cVnU0lyMU79k0JN5INSqAtgbrfrwq6jeV6O7xLHpptfCmEtUnzTlB6ojx
-29FFtM1fynRCpqzmIC0N1gwvxIqaPItnswjWJ5DOvNuK+juKdo0Kqr8Ywbz24Jn+JvcJ+VlsMlqH/U0X
-ehvwstchjepKOwjmw6xHh8rVxLtJgQOjuj9R/9i7E3th8sW3JDTDcX95axe83/9hXgCYr3vLYu5TGp3o
-/77I1Urb2u6Btpie5Nt9y1e+byEW/3ohUhYw9Zr5054O5CrJcZkoDUKSTqG/k9k0Y1c4OgOwMtQjpCL6
-DVtR76TcUFXWbPjpjdFTvOTV7BVmxF+TWoMWVxuKig8YF9nYKDpqTHhy1C40joJwJxdzHwnrCIeKC+Wv
-0HzI1GxVbBYms0XoMLzV5K4eGzGNp9R2AZpW3jwrnheVB0PNJ3v9IGqWGsZN9Pj4WP9pxIT+IA9k5Dwc
-fKfjmHSsp1JziFB2aadEaWTSgZ2bFGcutzN1wuAa+nOnnzz7//+zug7HMd8RO07UMz7CHxsy8LiOnN2D
-R3SkT/LHDvF1a55PeyFcxo1HspmAnVNOTcLCA3MTO7W1ngzkUSk10UfejDpmRzgGOVVNQV/mCwmOhkZ4
-HrvWiWMfcd3ZTDlRDzOAgh01QydnUrENcir4WpmtNj0JqAvREqP/gi8D6BeeX7BRIhnXhNZ1axezaorD
-yHLC+ZgnkvO7MBcd95L9fAwbxFWmcnDlhgh8+LgBzS2fmqYqENb5aY7k10vcCEox7uFSbxPCBwkfO81+
-57CWy2JUqWw0Irsq51YlV3R+oIsWfbVd/Zqk17kndlTcbNs/P1v5HYQNoeie4hYUPiodxLNAvjRLn3pB
-YuQS2X5/fMaL+emXiho3ncZizP41icrn4f/HiOomrJhP1BezwaeMaQT4mZtZ+yYkH8NPtWAM4TWB/E05
-+n5G72bR+dT7vLJTEJm9NwVM+cV2eHqgoCxUhVzsbpsL356K5ndOzZOSlJ+cCJ4yRTnowyD584vZhUTG
-7b7p5doXdAviqrnbhgdx0Z0dDU6nKWrr0DMclHLcXgplNdI2wmQfNbiUNePTUXa9SvWev9sxywn3oPPp
-dNJfW7u6uupdPekV5dnawd7aoKqerGKdk+J6rZe8SCtOzQi7efopI4406XJ1a9k1nNvUFKNZC3RnpY3j
-xoNKLxzO6MgygtqDQVEOKbFZAUrrSGPdMrhB5/9AfGm7h9GohygVVvhXVhZJe1wQipgz3tsMVN1F6PmL
-VAR47tSxiZ9AwSYEu26ik6xBjbnxU73XTiAKYXtL/Z0jpRTigClNJ2hiuH3fFDPVr0SImEnrMvjiB0iw
-7g5ZXJ7M3wW3r+EMn+OxAo4YKkWkTw/2BNDJKn3KXBSXSnNLhsWA/Np4sy5m0wp0GN0aLPVLlSkzSdVC
-gUnE7+KUY3Z4I/NVGnrufKL8wShkEGbbodv+SpyHppwOT71n1KjrxiYpRp6fjcy9Ik8KpvRT6Dp4osi9
-VBluyQJLB37dmO4bZC+dFRUGxCQXuC60jwUuAUQKE+z5WVOFHRhGRs41OPtDGGiEBJlDAX7lTNyAJmWT
-7BkmBrqCv9iojGXmPCc256HWtejFIeqCo2J8RgMCUqtx+2fKwLnC57Gv95LMXq1FOjUPv2mVq1Qp/Op7
-kUdnNu6bMgr1svFl7+3uy+2j7bc/c0oKKBnOVKxTEXQLqYVOMdtlWZTtlrVB1Hll6QPFGFR/7UDXiiZ7
-U4G/FrTeUfsbK7cd9o7EBdI19pQjNa5Wp6OMe8IUpwsx/PEPtx+7j9a/WdL+PJa0O/gu/8HmtNRR1jlB
-VLNZ7QsuDm39+QY2AvvvZ2WrOfwSXX/HI/BSBwtGZrHjhcmhudwZg/v4eieNu55EFXubPVMSQB3zUefP
-p4Aq7uvGgsIWLs6mJmL04w6tGyOdSW2/qRmpx1q/50FXzM1ip12M3ZhqL17qmmWA0tSHOp3cF56KIwlU
-/aPx11ct/sSKA0vM31l70Ld/X0GFePTtndNX1xYOlOmAhTKZrvUR1JtOEEhTbX9IhzDcMQ2PI4jgvoHH
-SYrQgf4qqXP6ySthtEBHgbTkMwsfcLAdrE0GbHEeLeEXMPDQBrpYRskJ04wsquu83H1D7xXfwMHzoExh
-C/Ne8UQA9OsVTiLigvO3f+eTHRXBK72Y+IG74FNzvK475UQIZ+ALNDPZzFfy7VJHT1+BmfNuRS0VChcj
-HcJ4LYid5k5BpVRER9rVyTvjhONaSjWxq+IWk7lHV6QlpvHgJaqrjoG8p6e9ZL8wRkIgP9rEhI1JRXYp
-3GuRaZWNTsPALgulh/CH5vrPkZAgUVBmZ7j1CTL2fGoDm8wu+EINHwPxQKfFJFaJscQoQos/t/FD3URI
-mBIRuyCeDRFhWwJ+y5GSdhYya3KOmZtVrKKljK/aiMwNU+/DMj07o7hCrB6N0gnewRV3ti1TP8pqvHQ/
-SxiTVVoFtUeQJ5GOyKR11CCUkKLZNgIvoun/YsJJ0fmFA36PMhEICrUQ2uVFL64P1fZ4OP/GRJle8PJU
-X1GrhXOSYdfKnhs7unzZFedXSGDhaahKl143/tuzsirKl5gVFb6yFlgTFv3LUiRc0Tc3Q8KVcs8X844O
-b/fYAfLC23RhOb9ENgVaBWHrQ+BBOkHjs9m5q3h/c3Nx6sOHV/kXN1y1Q8YorAINY+J/SRIMf9TqfV1E
-Y2lrp1L81o26mMJS6BqbOyUfrkuWcacA+cEMlRntbmKGOhtzxqaPJHfwQ/bdf2VWRY5L7/ji6009yKGh
-n4IhB7Oqh9vMwWvJktRXr5Qh460fJu+p0Uc2XG/E7tBN1eTK/tKMjTLAiu/6K4N6vIbNMhaKbKF6nNIS
-0YPa98TQlG5m2roNk0NW89NBBoF6mj2aw2Ehrz2HJiO5KT838YJZ1fSH64iMvOs6IOOXME+6lIl1HKVS
-Xm8mqzKB9a3hblj1zltK5mghlZKHTuprVYcpoWvS+aAdja6i24/GWdFe9mtrzv0k987dalUadasMn4vC
-zjm9yjIVo5GfkIKiauMnopUIzoxYjCJN3G3HJbWV02JQ0V1Oj2XFyuOo8zipBnX+45hA+IvkW8yh28tK
-HIa7MTrFMl7ZwbFKu2dHton7/cdfKzkmcdP9/pPH1gMb7TqPv9l1/uBbIHasX9Qc8uUmjab7mvqgIf5V
-VVNr8xvy448sFc6czUI7e0f7O/94C8CuUE6e4UPvfvLw0Qamyn3O1wIwOji0fj+5lhf/OGP6vj8FjPh4
-VU2No+oYIdF0r7dB7Hlr+/Xro5939nde7LzeOTg8Oth9vb33/O3WNqDy/QYKRrLc4UnkdHSD3YloO+6z
-5za5uHTE02qUWLjRsKsrhtJEpQrfCtsvJiOxFnBK02i1WOoInUWVOL0R0MqtuQZUkXEusul5QUf77Dob
-oO9OSuczig6cnqXoiSv9dZIzOEio/QFDj8LI1NJR9yk9Zn2KgPv5mXyrfuvUgP+21M+62pp8twYjVfGV
-iGmdXQ8Yb9+vVdlKdUTlc/dlgJkaqLYNtZhB2xrDrumSqc3qjUa4pyoJcHtrTt+k9kAferjIaR5rV5jV
-KyI46a0mxM/RWRfsT7w6V80otMUxCP5u4JaLdGJYAr1xQBkwfmgk39FvrOK7RfT9wrxI2iHqAV8DsfmZ
-Ft9ZNs7KlJjPtqRvRk/JqU53NjhPx2d/fi6jsfGljmpOLUrjEhXlRiCrejVcy4paSdTD0skbl2DRAva3
-HLa+Lcmqsl2MsyVhrCp5oBc+meDYPz11J6ywX9QNKlvzJJBu7gr1SDYt5lNmAcyF7sy4UjjHyaSYaouf
-4BjdlM84Zv05I1lqHXLur0udKGqLWA+JQ1eJGwLKKLiVmo/oSnYx8Vd0OD8Db7F6feHhQC9fr4qNQaNs
-OqoG3lzWt57ER6uT8qlTlfnLDro3mVXnthsbjcbmUYMZ2gn9Huwsk9vcQPVJuz8BM0vRYppNJtrUa+bb
-LHUz1nAEcoSCwb3Axc7cuNFlKaqBO3dOGOPE4NGXjHDrBeURInoJlrTYS9z9ad9ypPeKBEdhYKbHgKmZ
-0bfKFpakfkMgPcRGa2F98xcSo1ZD6tcXdY3FSCtHfe83QjirqO/+xHIrNfvib3o2PucYxsOqeRkrdNb7
-/b+Hb2MD7fh+/9H6nINaGFoUj19Pvh2//uDj1x7gWXvackGbzjVf91T0dc9pkeZw1GoN68UUa6hryqnJ
-OBAVIeQBO6F7RMPFF3beg8294uBxrfXek956a/4axXrxFapX3fd1a3PZxYtr8btva/EPX4tX34L4LhHE
-98vD3P55QtbOF21unOcFgtcumtnuK4WrFSapaJRcXb5xZxdtVogq84oD2dDzJuSwsEqe2Azb2quwmBrX
-Ml6bcII6uUmG2WU2Qu6Ecxq/uO7S4sdETWEHujV+b5yJbnJ0fqZ3XnX+4Zia9a4Bd1Xdrx9S6r9bFNxl
-ggQblwj2Kte2GQcpN5my1+sSzkRfEIyrLiua74KyfIqzxSlkE0LHyRTJJL0MrYib8mpKL77k/RrdKGfD
-s8x9haDv17D4oqimiXpGaXjNPueyN3Tanq12YW3lCkYRD3vWiP8v8g1vMNV3ecD7Cj6zv2rKM27SygU+
-QZxle54nUKTBIWXjrmvXyeE918/o/9Vkeey8UNME9PmHp7776i4kkzCcY6MLiWlUpoabmw4ONqjFs8Eh
-sJsMTmDCsQb/V/KYzEGPzF24sWVKJ40hhWLVqDX4nTT5YTh572z+IHm7bbK3xX1AhKmP/rVElPtLc0xp
-0Z2s1NmItlWT8apGb7QDJzsee51QUJyqNZcp3CCg617M0GCAfrTqx25A1srpQdbWcKwneJNcFwG11mNB
-BD39ad7IbEQjnw9X5LSKaPL75+kQhKwX01yWtQPCiFaC7fTLptM2d7c5FcV2iwRJJi2bRHwRNjRk7BC+
-Rgwlq/O5Zr2JawLy/alZZ2WOaNKicq5L3N3klNEg3PGS97OI6Em/OZSnrOtoCU4LokS0I7+Grfn573Rb
-Nu+dbcl+q2lne0xhqcKGqMBviT/WNPU6Sy99jrAFflP80W9qcZ9AU6UxS2PgHIi7Jvq+uunTYhI0oq/L
-NI9CSqmvoZPgElGdQSNRcfhV0x3hSPjniPTspBaQ44/kGHCEuBeJFUf2zFW/UCOhU8xTmRajQX8KfFJx
-XuUsvszRTFgGzqyRas5ctuoEOWAmLuJi+qKM8G07rN09tLqzsbjetVCOxsAD/cgIL8fbET8tmMCPQKm8
-G0jorr9TuB6XjuiT/YuCxbCQFe6GS01uwyXTGVrwL8BCid0ADfq+BB4M/wWIKKEdIELfl0CE4ZdDpC50
-/B4phXey7/2Jw8X/+WPBx01OJgKRTriDposyMzib+Jd0ycRPDd2jOim8B8XkD7YX/VuNAL4qLgBUzqFn
-kW993GSUF3ljKPp1Z0kZCn+xonyHAPK4hcLs3ClovLLIe+Hi7cicmPGWjYwHq0VR09kUGWuCVMsW8qgH
-pOojnDv3vH+bF+b8S+OZe/lh54Y4f/T9tzvnP/7O+cUMX70sfOcstjTgPREmi26Y9S9mY3Xniykw+OJW
-JVMILqOV8q8flAUX0YD+oMwnGPhRJaagi2j7uZeBAFXRbTZrvuOtODksyvJBMT7Nz2a6Jvn4kULfIn/x
-FhLXgndk1asynzrV1M23M/YbM3JRE93mZKsb8orbUnSrGAPxZzidRLhp8Y7FCkeF0m9WdYAWVdwJiS8a
-svfysskOj9lpt6kVF4UNjbqAwCt6kFf4qkhk2yVRjBcJW8hlbfSqx/ugrqypR3TPFCf6D1gaEpAcB0yk
-GdxHdbSZdIx3vehpgA63xKscd1Jh0mKSM0vvgBZ6lpW8DvYzP9utV7pwDIt8DP+bw6J2YcxnDfdm5+3R
-i/evXm3vHe3t/rIP0E9UwfNfvYK/4t34Wt0SZvfic/aB4EHTvbl60VCRvnNCoKiEQUOj4iwf0EqMBFih
-ZYEh0GZTpeehLqYVJhHaaUW9hsu1PqhVLVDc4vqxwtcXH7DHmd81ddzMKIjQFvrUu+lRhhwWac8kjnRK
-dcBLWfZM90ttcgnHPNdnBp91KXpQHZbmqkLPddtHiTyQQQ1qBQUXs2r8f1sc9/y/ZrAxrCfBzZrpqKV7
-YuPUiWBhygbs8a77hjnoGgWn+0mCa7pBYfUiO8tF5tMQYHs89Isv0uuf+XoWAGjWAOJNOj3vDbJ81Han
-JVkLcOkkD5NHG8Fo9zQPmDei3oLqcieno6LQD80jqKwljztdf8k55CpFR+ZvD0ByDoPJL35r5s2CT0Ez
-H+4HOdewpexxE/Jn7yRXrzQl6qoQdbb3EzjAZkOzABvLvdboSkvu/6E6zPW6yQdeNHw1Ude8UuBpn3Wj
-RkTB6cijrIzSxlZmeOkIEu2F4QcY2GOKgxJhE2kDM0V8flHsCNzRrmP51XijNqNWgjIQdIK21/aPdatI
-uuUzCCiFUHpQqGG7DXXr2okV4CpcTR6JZxBuYw8f2pIIGVdXXc99v7q5+/L6XIwSdj395S/RSXzqJhn+
-0xNHuyia0UlTZhJZEc0LoK0sCnrfOs3LarrnZG0MC9mXoWalYKyizcQF3XDKp+nIyB6AloWlnXQHEwkD
-CoG7kvJx2wEGEV4ngbs+W9g3M/X7TxQTxXalZTiDFxwB5CD9Rak+Iz/WcutcljSJNV3suhYLl+skQg83
-43uJaVTUe/iw9AZO74H2QTPO2FUsSU+n6hQL4npqQ32jIgj6cIYxVVQ0Kw4nLhtCZy3g+FlenbO7z5QM
-Zxg+fFywLmhja49N4x5xQqXAx/p2Zen145G8Zh35E+MtmbJpKf1s0Y4CIT19GPfqB5oXKeI9/Qy33J8R
-XQ0STjC2IYmnVY9Yz3SbHsHaakzcHsU4zIayubCLhzXq1YPksW4Lj2fO+PRLbZ0Q0B3pPqYD7GDWeB8B
-uZiaCVZmk1E6yF7NSmDDyiNefMqipOomAaVv54xKIgnr4pcMLTIt9EdG8wgGE4ZzaXbNy8UefpRjZV5x
-liOoRm/73EWmgumjsq4rLkgQoOvbzAC8KkoiSUxQ2AX1QTT5MbYU3Yg/6qUrjwDmDqNt3lB2D+FeyUE6
-Ja7G0l6gQd0ZbYr2a5pIalJH5MxVcM6ABEugbmXFR+dNX42OinFg24uZU00FMqr6Z6r7/cePgogi5uAH
-H/6Ols2/frNs/sGWzX26/F4uvMg34+Y34+b/Y8bNdyWM9BqFVglzfgDL0zVHhuUmBm+DfZMAXNvk99HL
-cLkKl7D3yWqBye9PZPGTeLqmuFIfsCpliwsJ3TZjCW1d0ipUoUY/5ALdGj3rMA106v2pNIjnSRVpGYRO
-rQXw1h2ZMIMFVrCJVVkc85+K+m+Ol6aZB41WxyWNlHNtcMmz4Is4MrQDR/IaeixltTOg7JBFqui0QM3U
-RMTzC+KGPGCnn/3O4gU19ambFzduty9umqAPChf6oGiG3iuu/ArwqXY8e8GE1ZTUmja3PMaKfm8yjPpK
-tvvVqSlmfUa2SbV2dsaa/O11bQucZy6VssOzmIajrznihoDo9DRXtC1psY6cwAOGq0fQhWvXymUfuYWW
-Vdy45kx9vZXNAWuHLqueScKRYPW2iRrGqDNS1PHRl1j/ljH65U1GPWVKwzZ+9I291liW+5ayGkMZD9Uw
-bDv3D6rQjbGAeXsTgn60oLlrob1dbDaenxSX2VIT4tRo182HS/EoRdHo51GV4Z7CPmmJ+DSsJu+jvBBI
-HL10c1Hyuvvzw02u7xHVt2vXE9X01ExJi1DE+BZbdMoOo+2v63i2Mb+fbjawmVqu675lR4WLcreI+TZW
-FTNK3NjGWFNX++jPDkdxYoVNtbHa3ELEZqOUSNwQhWXZtBhMb03LEgu/iquZoXc9Ib7hk9X97N16rM+5
-7NDbaeOdhzHoLcgpcb4P5tE3LGuangmadnocYnJ1MeaIbYlKmarbCVVxEGI59OTUT/1YDkh+1ZjP0Fnx
-BZpN2u6qdszHugWh4OhP3mhdrjFArtStuQViKAk08a2W+oO50VFNcYdbep0sOJ24LIOhkT+FXIFSznld
-xcTDpbmN8qRE3YJaTdqmi9WA1PbyCrFlOf1UYWhQVPuAKuVtIHbxFMg52eqPAeoupEsJT+Ljf3oTWZef
-A0uwhVzdDHrcqO2vGbKMXLXKyHrBXtBEkoXFgZr0JSc8juGtazmX0+JwoybyqjcBylFseeYLCbsYWz31
-lonLSiEqtcy0+oXMJBsKu421ugCgHqZVYtzp8hgqJIa3cy/FUcvPY/268bfgKM9JaXqRXgeXRe7GvhrV
-33UXQmazFxWcY/W3rmzdzUkizT1Wylu01M2cJsucvaxxF3P2Hduku/3UbXkCvnmfd/X6GB4qOdZm2Ndq
-Emx0zbYCz0HAO8Zt1LfhHkt8nF2157Or3/eTyFES/ytUOi0Xi26wofa9mZfPSgW/9aNMeFt/bmYV8Pl0
-ezycoyzGQBdVGfkCVwfbZaIm2biYnSn/1hRJy7dO2QiUMgCkIKzkJlHNSpXaHj0ibJNXOnwOwpl6Zm2g
-qZ4a13FviMboSnulIiSrKrZF7JPuiyn2TZlhZl31XocjW82wBxM5mtPA48v15VRjknYcPkcssTLi28N2
-hJg48+p/jBkPam0Hfue+SPbKpWCnIN9zqtcRwqvnSPIGM0TdUKVQtiQL9OAFzx5xCU0XYbrFH2uU3c3w
-8KvEgCuda88vB0Xj+eWgkAvNyOvIEcZF15sWjoJsikcZ+6qTywB7PcM6WlfLpLTP9PCyeDaygZ5kgxSh
-fFJn7XBUkoUsuP7x19GZvAgV+J+Wn87H+UJzGbHp6JlmaE+X2e8bZgEjmWvawzyckeW65HkBBUBLOGKM
-rg5ZLhujePiftJQsWH6dFNNpcSEySJIrGs6pmsOeowyXvm+pVvalAS0Jl1ZsSwik3e181eX31V2Mf3FU
-iZFexE3n92/KTPInV2bEzVcrEpOPUg2TqhAqDSU9pJ6e20TrajD0KCcdXaU3JgjaCT7kUa98sB60o/KR
-GldPlUI5napWVNW1Rhm/54a9sHZH+60wjsa+4Bcr2JwkSs9BWy5qwxqF5kWuttp4dOoq8Lo1M3fPjRkH
-zR63kJlMHscLyeW1DLFnL3jvwhYnNzzjlFB0isXuNFOK1tRk3+dWKLQeeYSxt92pDg3obKRd3afRJk9M
-GgITXQ8TFwB7WVGuWlIqs8d5+dQEBOC2depc3ZyDgA7y57XS09kSgm2E+zYrIQPaoUOzrNyN4sDJkHxN
-QvVv2uPuJCYLrBwxv3Xnka+2WtRmyT7Vi6iXXkXtQq/aWPBmQplPuPKPnvCMXMW4y0rX7ETUGYXH0+Ca
-aq4aE1W1F9IHatAMtvLYCw8DHTXAz3VElbf/i/qienXIHTV0K7rff/yd45CK/qc/fPM//YP9Tyn+x3Lu
-p03hyO+SKU7erh3gK5MFg3WZt0C/yHhdS0brEg00Buy6tSvEVqHsKwH6r0bZ9T9K1k0WHIGu8iWDMG3E
-xnGqC3/7jXvwx6Nru0PCxzVlfjKbZljO87Lij6q7IvwNNJi/idBtmC50DtqeymyiKA7ccHAqRozLI4au
-t3pMzlNLQdZgcgYiaGlQSXOUwVhDBZmjvhIb2oCdiZw9ltLkiatSdLnTKZyRInwcBp0Ls0B5Gajw5JCP
-9S586+EmSSNeK0dRS9Yi8/AgQmYRbDTIplvXiYqw56OlneYcWriNmtg9PmqrHtVOzYII4LlpU+GVZZKV
-KInd1FVcCQPNRdPtYjAdZuYVb22YlWE74MWhwyfZYd+6yzgd/nNWTUUOFYKqgqVMZc56hl2XFPvomlZv
-tWILIjdrIOB/7iS2COpy4olqVqJFkzl6ObgschFmV1mLV5xouZrxXDFj24mLizoZM6+ew+Zob3HIDcqu
-xMqY+9RKeR6nvGEOJlhiEtwtOxuWKjrrhzcRdt0qe3Mkp978KV1iOt1uaqY0hnvjPuA0GsRPNvVrRZLb
-wxJSLxR7+9l0SqaSzejO66LajWLYmS/gHITnize3fdW82d7+yQz1T2CoYBi9cMP7p5Mg77m3Pmtb+PDP
-j2G6PMm/9YJWh6EOpK3p42pxsWsR7ooyxqEfLL0GKVwjgJtlr3grP1dkSfWGbqAk8I+J17ajiUVZT/Nc
-k4Tq6OnyFEzzNZqrUJxAEg4J6IzEU/m6olzzcD/4glCRYfRjHxE2nJp+5JsPKbbSfl3BImkNBQX0eXmB
-LIR/+3ZW/t3Pyj9l6SQ8FiMYBWF9MxufZd+eaX57pvk/4Znm2gOZh/vBLeZydn6f0G+9d4JoGWXp+NbN
-mK3eqWGATqgHmLXTbnLCG5BJuf1jcmL2ClyBjS8yEaCdT7MLUoh0s82vI7GO+0yKGoB++F9gfzfiFXqs
-GJstweilJ4Bs76Qrmx/QWjBsWe8csMlPb9o1z7EQWe/hVXYxmd7Yqxl9GxChPRXE7wKokfAloRzw5maD
-w/ykmDSg8KC5c6gsukYe9vsNrfTWzC2uCLLR1J2YD+sfnetxvMff9oF61L972Ytdo99OHKdolCfdoYp3
-At34zhtVPv5EYczXOwH6ilTZqP6VFqq1ESLbNYcYNBMaFWOE8t+tMe52hA8fflT875LlZHYCgvv9RBLD
-XvDE7hABooExWLtt5o6KI7HUcmY9vbLs0xcwJdT+cq50sLUsWYOxXv11PoRGOJiOrTYgvTX4RCrxfQiT
-RPECN+yjrfUN/XoqZGbDqnnEGSWGueaMOIOiTqYnmzttpL3lszx8qhYu8jzmwqeG6c4SXbsROjsReuU6
-/hCTyvXesVUDAfJBNPlROGGtrSHb2Eo/Iu6oAgiXL8tXdpNomypdrNFxnQNdThO8Rh1WV6nvTxbiCOhn
-oXiSxER1USNh4TSTibbmc4Zmpa/DGR5jLsgZLmug1ulzBaYQIcuUZgwMxig5ImCGkh/n1FdxwXFiNODq
-I8ElOhOLaOpHId7cyXdQ9QbrNuI46ke5zMDHuCxxEHabli3fxviQ7sY98jSPyUJ7g/KamT8qWyE+LNLX
-7dA2cTYoxl5TQxIjU/lj0LZLNA/1DQf0dh4NWXhcwbm0wMBd5JeYUvsRCeIPaGGJEV358dHGxYUt94WK
-FhYGIiIqXG8DVG7r/QpUafcz2jv+voi9Q5wT4Mg2yKpvRpAvNoLsXFzM6PS+q7Iof4tS9c388fuYP4hv
-ztjTV7hVtI+uu8nR9WP8nyd6po/Ss5Rcw5ikR7pCX+/5DKDBVZLbzQTbmiiS009oFh+u5JdkgIYeeNqN
-0sO2dQ48YjKfbNj+Fato7oMvanqh1u7VWE/vSzOLbZ06XKOhiMq1N0UvGnmDiG35nZ6d3VPVnmpFw9qw
-kJroAnn91hGGa8a5gbRQqwaxwt9PyHuHibPhk1w7C1jaM3saZzlvZQhU8Kfy97O44EgNpQkCfvGg9Gef
-OLGRqW9cpYeWrbYeglo/t39uS5tFLR+fZyA7qnY1OyFBCstpBpNDf2uckAkACVtAr8xbpll8v+QVasaI
-IrqPsHjRA/tfhWhczKppkuWU6Aq2FQqhijn0DeZdcsVtoROkjwuNSWNvRYplZd4l2gJBB10phT4jx2lC
-94lh6KhgiWNleJ+XZTfRslflKEykGOdvnLGIxZogrkH66IhwODpCzcYAbJi8HHp7pMimrrebW2Yc5BbN
-uQHSf/fUhaFPuhzGeqaSIXludrLE+uUhf7/BEqb985KumiVsLwajEoVUB1l5kaPm4dWxJaqj/e2tve2D
-o//YPgRQwrgNkubl7tuDo+2ft/eODg7fbR8d/LSzf2QhOUkpX+ErhW2fdg7oa3peDCsOQotO5ficEEUU
-/8InQWopVqgKHbtEP+65FmjM81RODUw713+xAm1zYZgCudLdtrtJy1OO+slzkDoXkyl7keNDgdM8Gw0x
-RXyCD8d4F9IRZXHZwFdcOS0jWIJuejiDt3VkQfUIKFMVY6LNCSZCqSEI43jcS7Y518oKZR8ARRG0y3+h
-TntxAbSlQLfHSn0Gteiy93b35fbR9tufWbBAyXDGouU4uUA9GEaajoCq1BgUg2Z6ccFvsqagwSKmlE/v
-AbnhM4EQWXyJBZhNQbXdz3HohAi9G6gwJd4NXTePKxDd9JIrvSzyIZyO+GUBtpdO6dUqIGyx4gl31+au
-3v2FGnrkUpnnXz10ABUXlLEZZlOrks908f4jz93TW0rYZ0txSDC3CEL5AAmKnzF0zfvbB7xikClgXzrH
-UGrk8Q+Td0FUs9ymGiBHfhyHuOvwhtNuvuXwoPWFB+pX7bge4dWwsrcDnC5kb4uPxx3eXL3eiJgf7MLW
-nhseTxM94LBl1UZ8k6AaM9TtWA/IhRjSnoK97oZZNnlFbL4HB623wLUyzB6dTOnmxey4Hi1A9fTYZSO4
-qQmqUNpl9+qG4SPPal5uv9vb3oJD2kt801ZmmPgE1gev5GmO77/p9QwlzPH5QL/6MGa2r8q8d2bfWpue
-2vRdWx7UDNSCeo7c8OdZQaSTyegGNeKQi4zVAUrrry+yaWR29qgiamtIf3+LMfIcTs1jzuOJb9zwhD0D
-dHL9/vRBWPNkZh6/ZeItHb9pgpVwPJmhwH4+vkH81OsoUNd1g+okn+PLiipj6qLMNxNyTNslNYPDT1UF
-eqBE2+epwc3W6SVvYZjUISZSoFDoQ5WnwaJBweRpsxA1fU5Ud1XuuG8Fw8B2VvJGzhPo11RZrG8TGELy
-ijdT3DiQIXHcTGi/sr7y8ftF9ge9djai5K3YCG0mY0Uh3qznPKLKhHLQRbSEx2mtdqFZ0CoYSlfGYeG5
-psVDIHUdv92TZx38eI+i6YK2xVF1sV9UQKC9EeyKps836QTDsBnu55G1/DUQkyPeqJrWiD7VRtbK/uwM
-XziCWuJrHhQeLpCSn4nonPkUuqUpvu0YTkpeF8UnSu/GuXlvQHrMKlQo+pi3NzlmBbNzjFxxNktLYFQ8
-piJrTpdmR6UoqH9s7mDW5SZmswrYFI1f47PbxAwmof9RmXXpq2olqPrg1ow74UNEY6061nZmlNuB8yUb
-j044+8kxNHQ8l7uNQSr3p8oMrmswdmU4MXPy2QQCgN8fTCW6YlLVPG6MMUrQeyNPDrNRNs0a2NIV4f6G
-ZYXwGWibY0X6MU5hmV0Ul+J96e/Kj7tK2PkMeVoWF5YXebCELvRWy4tDoMYkGzJnRdmRG2rgLY1PRFwQ
-yZAOCo1GtnKnJ5xbiarLUoNicuPwlLEHI+2Bqb225EUI6o0IhbLUIQZGMvVY7jythLUOq3mXPYjIB/hM
-fhJuZfosb1vcfxtELjbaKGhfguL6Oygkmu1B/9BNWjVEwygtBC2AsxJPYaObJOUyn5G34aBW5rjfpKNe
-8jr/hLDDIuMzLrQzhKV2TDtXdQykLPHSYXSzvLpQI5ndjdu08yfUHXBCv0B/UMgd1bRUy0qESRXnpDLP
-LjM6CXjs2ap4BGypVAeFpecsICw10+yLw9361hlBALVqURsVUL2LdCKO+LiIw9feBtxZtrf1b6I9qtCF
-ZXgaFB4Lam7oFGLmN2ZasyD6/Q694gLtW3ErC74VznGEgWuAax0dXot0JESXhSUFIkgrzdgtTKGGc1xx
-2Ime6okU+U2HkFChY15ukdFP37nhpRuC2Ds3vnRb0WIaxSwZ+6oPufNwEQbXLFvdEWsJC6iI6RGXCtba
-2NYwHbyxE99R66Dv8zrRgE4nzVXk5NK3rmnFNybAlD3H0ygl3xrrAxWJZjlFyTAf6vt+PTcA/R/h9Gg+
-4adccmoUfO3scHmyqQHdOQIk/CliOEHA2MtQj0JcR9NV/fIf1eCAaw2qybPoJun200nonPAVWnG/kI01
-8EGIrH1h9jIm9yNlnmp1OjoDl4PT/f7j9SDlFn4gbfF+/zsKhiDN6vDt++593S7U/+GH24/dx+uLuEF8
-u47/dh3/ta7js+tpNh4KUQQMlJ+NaVbMiJlWAXs8YvYwhrgYiygPl00LRSzia/kMpqnmmwM98cUrlKt0
-E7XdJIykFuRcyuKfb4WN6y7BbfzZr4m/uTV9Hbcmks+LejUtenWbV3jH4AHRt0Uud7/mLWpwNtR0AJ5C
-8wQgnZFhQKnF+Sif3uDF2hAOBSNcT8kUDnbqNuCBogdf/MFMAomGPToagaAoUxX0NcXJrPTYyNSQjWFJ
-DzKnG7rCK5nGeDhP+PJSXOaRHAAc8KaB1hNfdVa9YFzYlhlPVdcjtWf3lm6CsW2FWY9WA6HFYc6gt5vk
-qoRTLxvLQ3KaY7PuAU+kGJ4KWxkX41Wxk9mOdCAxIyc4+N0YtMUbWBtKTOtL3hV6Q44D5wveHqxrpf7j
-3evgHA9wyc723/jd0TRXqLT5jIzncONRqharwJY3jHpcO92ENQylvF4h+2Nwslk+Gqo75LwkStqL6Aip
-Kg4iTJmfHTbBhc1I2NbqKWdFEpnnjqfFPtm7jrvUPrAPEgJJucJZ23BwKVJ0PERrnHH+QhkLPGcbUCYF
-utq6yKHCpwwn5ITQwvvPnPNIo4AWuA1ndAWeg4gijNp4qUNTybh0eH1ojEb5Rc4jT3S4u3FBkZSRDkwe
-S1GBnUacBPr5dDqp+mtrZpX2Lop/AeJpryjP1rLx6vv9tWExqNa2t9483yeF5egl6PDbgPdROlWvoP9/
-/8cvejE7i9yfa9+W4B2euS9/bt0wossEVlCWlpVO5I13SkCd/5NepoyBOqx2ubXsepDBt+NaHf84yVDP
-g/2BmjxGRU4zAFm+8MJ3dMOtnZbFv7Ixa4RELboXQQnGt+wrjh1DP02oMlCZp8n2GAPFVrxv5bSO0sGA
-DGspRuYz9+IsvpBHuD3AGcous3GejWlrNbt/RfECWSE3lRA+lTW4lYFU3i7SGx31kMfUS14V5UkOuye6
-UyAr4ZY1SGl3xkV0Bctlmo+SK9Wccio/YX+65+92Gv0MOFkzE2JBZwNxOS4vuxQx8aKr3lOgm7R2cdkM
-gMuRO6gnIL0Kq6yyUDd7+5AvzWExw9VyAt9vWFwZLxRYfp/8zd+5c+61ah5i+r4/kdv9uFNDxOim4h2w
-eKep0mOlSaT4xDiTcX+mZrsbbO1wUmcBo6ylxuYpxCiaP+vtpUrU7CqHgNd40ysdBKQR1Vwgi57n3DLV
-eH+IBro+Du6tAKjor+ksIRxWFaDzNDceicvU1icRYyLUx51GVOCAMv99XOhxEuEDBqiipnmWYL1klxwv
-NX+gCMgvJqOMZJjZJ3SDl1lJPptoXBqykpFXdRbbB7e6rwNjBsepZX+w5hmMONQU3v0LW+tkUeT5JFrJ
-XtJ7l2l5YztPXu6+AfE1NDet5jJFLVYGs67P0MwrisOu6geGfcsIOJu4Gfj4GmNY5DoI63j3QZ6HEV+S
-ZC8NXVRLH7Dqx0797ZC58mSLJrtq1TBV0EnMkyjHnO8VXxkeh/ME26TOZH+VqeD1dOrB4atdDh/3c2vj
-DM1PKcyNugUqxibareIYBFdZ90GB8eUV+oplTtRa0xCdH8a2Bzjn5k5zepM7QGQZx+LkMi9m1ejGXFvh
-3oD8c1WUn2iSzbkT+cesiBgl7jWyeN2MNnE4mkXueZzB29cet+a29G1BfOGCiNA2siRIU6lwNNYH1+Zq
-QMYwrLbTpF3o9vDMCuIYTgBDk0FA8+wJZk4RTXZB98CFhso9AY7QvGT2zAX9bOkIXqWXFB7s5CapPuV8
-JDUus3wPdLGsrKdVFd596hASyS/n5PCXkDY51QMjjkIH5iwb2svgmjvPevYPnvizS5R+q+N5Rd1ra4FT
-dyroIJgC0u8c5t/q/cxxp5XR0+PiOQYX/Z68hJNHcRF/xhh0RPcDwU0AC5L7/e8eOfcCaPR/9C3W0+9v
-DxxPs7OsfDHD3XM/+/bO8dvFyv/EME8i6JKwTOOXpZ4vraAWk07R7Ia4mYRkqc0eQYYu7V4CK1jdK+kQ
-9ZVyWbrApnKMC3WVkj5E+SMwwgm5J+HWZ6vg2zNKDaAbRqs1EaMYZ+TMjK2BdMHDUcmlaBoweJRoYWZ/
-516yz01lfNjiUkxtUt0oZLMhNUdGGnxRk19m5I1UqHxFBcwTMgY6OLM+vq4SOwwoUwLAmcYpu9QjbO9V
-gUSi1zwDayA9QQs7PduhTh/gA0eRu03hQ06H5MHIOUHadB8A3MM/1ZsjplGH21FmFSQS+omwiRx/cRWc
-GuzK9GD6PEkHn7gJRTJVQzufnc5KmL9KOS9a53aGKil5pXnTQB+xtcT0mKPrStAb7lzq9RcxCZpK0gvY
-A4ElhsmoOGuPO+bGAiaMThls03PonY+xNc6gByox2zu9PaAx8pgPPO85jgfuBiCj4R8UOgnEG1qA2ufR
-CUK27ny7gF5+Zt4l5w6Oh/bhY5fSZo2hMdhCh7gQ1WZ4nqloMaKBrLSRyTqyeVJVv6R5lZ3Lad4WwyS/
-zUwup1dFqc3MDaW9k1ylk5aYAiyV+slF/e+1tfdFhDf1Mw6rWP2V4u1or00wXqsRM6PHJ56JUSFXn6B8
-n4NnhTklFw+j5VOtvjMHTCaTuWR1fO3Bs1je/Dqe/0CfPgbvvL0zstDr41lP6ptuGnOE2+pHHgGOjj8Y
-vn3/sQwRuklrn85R4/8rLAPASrQqjZBE0a3EtjqOqu0QlqfhqJaTnWecXQUpeS13SOFj81+rZDKz6vyg
-QIlQtUUrXcbAC6/XME6JQvhK5d28jJBNq61m/pqqtJXxQE8cbMVs8Pe+n8MhJFoAOP9cz/3zp1/XX4QD
-nI234t00Ov9q+1dvjR1WcKZpgKd+nlInk32w3fR0/EgTSElsFrrQS+N4cF7ijoxqlIplPsL7LI09o9rD
-zLd0BcvX1YVQUGRjDs/TIZKa6TXLCsH4oEc6+40zOo4FGKakukiv3Upy1LFKSDzT09PNRLMTWipMYz9u
-JoadPJo917ksK0EzNalISGM97SojUwtT7OIxFb1OXaPQAkRR/LjHfCUHYRBftZR7aoewWjsCA21UwpI1
-QnUAH7IqaOed0Xe0P5l9UyKIoQhU836wKmc2ZbjPIM972KQalN+kM9dOk4KCQY6vOslnejQu6OZZz3LV
-FpEkQdrzUITbPOpGgvl5Ledm55UN1oW0lH1GsvR2w11UXvwp/V2Fiuf/bJrMMEGm6p7ln3lDxPm18VjF
-zGbMRMbCiLn1zkmdBd39JBukCGZis3HYAj6e1jIdhl5V6HqbocNIIViMsFYw19FVim5DHJVIvEDHgBn5
-K5BjCr9p06Ol1UipvPHgz+GToBxPoI5MhcZ+QgEOZBAZdJU/e6lOl3jeRacYPBRwsmOUQkTIXnyrCTcX
-j1w+pCCfo8qAKEAopctzVpV8HNm7OMBsNzIbXNLxRH7Qanr9Ja3SniBafZo8Wsc39hb9YOPU++YFSfWR
-sW/grc04sxSm2dZ2gAxNpUO9CcgGoZR5AjfYQgXWuACmALUCiP2p8iRfmfEm7GkG9SFAnQp1HOu16qxz
-tggrS1QdrU2IyZDYybPIEbkfgnuq8P7dD9TzjtSmj9cLn6qntZ3EDtZcrqKf3bMEjGljrigVsM6+Rrzy
-DgQURVmp0JHokl6bGysKMhIMgJjPuTWs3ZRU1Z7S/u/VHfUSbzri8lTBvW4WqJZX/ezagQFF9Fgrsxny
-tc9EjWJ7rtRui+xaSE5LCz2j52IuUX2sJTEBSmW04UR9Hkx6Q1xfn7PrBhUAysGNzquu+FmeU15EdeEY
-mCsAWqU3/jEp9d/19A7WRR2KAeDXQPFpFEXv2tGz79SHP41A0s0hcsv9/qO/Be+J8Krw8berwt/9qhBf
-0PF94H17H3g/NoXIKS+eb/3H/rvnW9v95G/IjAfPX/STv+Nfe9sH7/fe9pNHT/DX89cH8CeBbO9v9ZPH
-P+CfquaTx/jj3fN/bB+9fwc/n5ifL3d/gSaefEf13r6EP7/HP3/afYPV/op/v95+BU0/ofaoNnWyt/OP
-n/AzocKtfLdOf2+/3j6Ayt9R5a3dN2+eI2JU6d323s4udPLo7wQKBX+l7v4ThkRf/nN7bxeqEvDb92/e
-PX95tA5lfxW//w7V179fudWhfR8/+cazvzvPvi4G6SiLci2q9egYT9QgfJXvcI8uRbimYua82jt4LeQo
-/W57GcT4Upmk8wql63pJ2NEJTdpQzWe/gfuvD/bus3k8mj+LUTL8892SoaHPRsVJOvrGU1/KU2FW729O
-E9+cJv4HOk0oOUJvYHgx0LoMXB7wGl3fRdPhDuPl03U3XdnjyuN7cHy0gpc7+IUOHpWGPiW3BXymNKHV
-l1SzCxQ3Sp/FLvFpWAJikKO+mAbRgosRfHHtdxDEqaLcBWaTiYFh0Yi+5tf59KaPPxP7HqWf7LbHHf74
-X7OsvMEPfP2uvrI9QXzu4ndxKw/a9OkWOUKQBQoHK5672GdIoZxpvJgPwaWd0XTalfczOZ+6X0OhY8OO
-3+WHPch8rsQDY26Mh2cvlnf8srbBx715F3XN3xIg1knwbcPghJ7DGuwxxQ2sBQUUnzymSD0Y+Zy2yp74
-Zu57kGT0SaJ1qS5HKACDqdQ23XfcMeRTyoBZtX36U6J631lA0DF+OV9ZmKoOhlbBC9oAN/0v8Rq0Jpwa
-9svc6/yQVcIL/YAp6q+dQ/6JsXbs4rmOMx95hqMQ5sdENCx8wwPAB8BbNdfyNfwWO8Ibvqo7uhsAOXZO
-MuMkydWjhk/JpsnoZwYay+umk8Dp31D1A377aB1vgqEBTPNgmMWbRqMWgRhOnTziEdGjDSwzttEa+toc
-eM3QGLrdkzsi+5HJ6I7/mTziBgnSujZNL04WcU8ygL5FcsodXOwaDVvTzK6e0tfhLjA1yPnVEcenqCCu
-rjbjJj+QoPxoaCM+Yranj3MNZhxLNjbrGN9w+bsvlKI61ZC5Znw4jwMk8qYFHKpzTy3bFhnS7GdKJeeu
-IFt4z0+OV9upT0pRGNLZdiDpvSSytdNz1hzq17wU+Uyal3JruSUeY33NzAEIzcFoNjQXZqDc6eas26OG
-7nQT9UiJTlnYHHKrbQ0zyOmrcf1RNwdaE8ZIABWWLs3V5/jDi7M4k3WEZVNPmLi0Ntdmlik90eDeZ9yJ
-JykBGIfS26zhlVpee5o88p8o2cL/BdoN2rqDfFhNfaEEQfZjqPDK4E4MZ9PIs+YuLqf1TCmsbmu3D6tn
-3IVRWdPmYwO+6qM/gHNwIhMVyaHSrbFJ4OQmEScAOLqcFXA8Pb/oJe/FZzyaqGBy5hLJnEt0g5fa58M5
-ZuggP42Ma4cddanzmTfCTLaFHTi/tB91k/Vu0/7h+6xROjtqsqezSkb2R9czUUBvNu2mHntIpouwgFVO
-G1hAjV4abyhgCnptC2p2Kdx4ZPYsE+jDZXz25LlyoZm02N9xJgUr1M6Qvnu51H5V3syF+UDLjB6Rak8u
-p51VR0bU60uyE7E1sRXFaX9TbbUxkdTTByanzkYIp/K21t9nLsZO3sqoU0q99aP4zng4arEXuD5SAR91
-XmRn+bgJYBsY0iuuZQwr5EXjnOPKbTHiLCyJriSxlPw1M9wVtRR5anSa26gjncTrTT6cFDmdtmTy2XBA
-D/3hiCy+ghL6PjS+mT1IHn+MkCEqGB0lq5uE8xcfR0RiRrzq5knlQMOL99b1iaK69laqq0yKi39noT1s
-0kA3fJq5CkFtFsvwjF9/qRuHFSEl1RtYNryQYn3fXNzfT57pgr7JtZWNTiNg9NkAXQHjF1cRMFXQTz7f
-dvR1yvffruN+96uTvSylVJrh9UjAMeaJXYl1KBdU9/N9+nG///g7uvX/67cp+2OmDO3hxRj6+AXU4nez
-MtvLcMBv8ut8fJfpXBvlJ2tzW3YmfbEq9/uPHlPA2B++scZXZw0aJgYvmaogeniYAgWZE739cp5loyS7
-5Dej0D4K98qe6a+wnELKwXd81sjvNlRQ/OS0pKwTKeVlqOdE6uUnCqxffrt2/Xbt+j/w2pWcWNEf8pXG
-0XmQ7hTpsKtjPBeP8n9lvEqdCm6ZroEAWTV9Ps4v6M3xK1yf74rRzSk+VnEaaAQ17+eD5dsYWFHLk5yj
-hBmZwQYcROEk06EsSY6wALdSBOaDW0LX8Sspm84xKOOYs5PZjlRgnFzHapRtT6/swb/q44OVaforyTj6
-8xBfVVNjJJkpQin9duMLBgRo04B1+YM1hUqXPiu3y99sMacT2R+UxWj06yJAhwz0TEM9s2CYWB8XQnqW
-snG06d43QNx9xJ06877zMpERaBhEUcx7xq2I53/Nh5pJ3Q/BNaVIA+zQxssEbE+FLtSm9/uZu6p6yGrK
-1HSACXP79eWvtOuXOQ7X4Ha4EG6HHm6HvxtuHh/UYufDbQZfviqGPPGN07VRAxjQTgLOHYYEVstxS4ue
-TS0rBJCWHpvOz7nX48GK8m7HVTs1dipVKl23SbT512ZKrA+3L/m9gyvo21xH3k2YZeqs2od+U71Jfp3p
-GRA1D92ah3U1D2VNf5IjU99mRDp11Q6j1Q652qFjQ7rndofhtZw6kch0vpnLpU0oROLEAv5f3whbOPRb
-OKxr4VC2kPH3kv59mZ2ms9G0bd8tctprvCkbysE7mNPdpXVrUdgEN5riFbS7UtpeGDxGKQCKXSsp1LQO
-6hMYuzQgmwxkH36Eu43N7G56qN2YGlWVtrvfLPA0RIPWGZR1uXjltcCeWSOAnPnrOvPWibDWr9J5I77h
-Rox6gWSqt+nFQOmlhiPo7/ef/IBPNlzhc7//HX1tnA8A+hue5f/27Sz/u5t5WPqcpGWvVDa6hY7WL3ff
-vCng85viMjsogUlJrRdngwiAPmGoVE8CGL/oUuIut3hPWwJNeZMpKFJ3nrGp7pjityOLzflmUFU/px40
-f9NND6690mtdsuxxbgpcUY2AHYC8+gncr4duxTiMQRelNB4/zUrumS8K4v3b/a293devn794vX20fwCs
-pV5D2Ifn9PalItZBq0KfjRWUQgZbePV8a/vozfO9f+y8RRtIWlZ4odFmqrRblea51VNYoasXKaYWbHW6
-yaN1NU7RwNFjaEI2+AC97gzQ/s5/Qgn182Rd8df24Yvd53svj3gYR8/f7L5/ewAA361rwxB6oDDnZ0Oz
-Aows5nwk4jPTSSpySI5hXk1G6Q2n8m0ZeL64vkDegjPjh7kM+JHgJ3oS+mrHwAxkUAGfR/ftpPX47rCX
-V3sq2QjvQEPWBd6ZGfJrMFhe7U7S/5o5LeIpkUuLMscw/34DgPjuaftD6xJtK3A8bnWT1jnA/qvAnACt
-jx1Ve8wkkFVRfHPpZA5m1WLjnKIo2SpGRRnD8AzdKDU+/9rh20+/TXyms5Kwdyk55u1jcAPf79SWtMMb
-Wh1FuNIKKP3gvdW5fwRqDWa4FrklthkavyFQwviLN3vkDazMj/S+W/8teEJ/EnPGZw4a3MDy22i0lw2y
-/JKsY5XMSl8HI88X4+x6qm13ZuiIBq8dEUrJQPbcsBmk1UUqxF/oKpUTA05dT5loUUqy0zP+2fv/2Hv3
-xiaOLFH8f3+KxjPzkwSybJ5J7BDWgNlwl9cCebCEC22rbfcgqzVqCeMh/u6/86w6VV0tyw7JZO7C3jux
-qqtOvU+d97GBPHQIvGj+d7BwvtgungajiLIiLjme5gx/43BMeRAmQ46u0P7xnkZfEkc3shJo4I0NtQTw
-qIJwuxb7Wx48UO6zRSEGY8hnvZPffMN2BDyfKSHDIGAVFtDo74TDJ17ObL5/gxrRlW00ZHd0axP4zt+v
-LfcdM46+QPLOF+EbFRWV9fcO/zkLfBqP/WLr/ygrAbUvtdXZ3qOImgG8/WoPU045fk17uT/NDzAYaDRM
-MztpL6W2H97XcBW01Ph14FLQY0eptG8DFdPVFe5M5rtA/q67N28dK3c2iWXrL6zkpw7V7UosbqbrR430
-h2sS1uV1pJr8Z0s9njHVkyU5tX4tuHRt8/eAsFY88fDr2TMO6y87VazbmGo4hTg4U4gwRW7tbhK6vV6x
-pNaWFxvacfub5m5LEJ/ouBzODjf5qvk05nqLUlXd2V0LKT/lwx03myRtuw523xidbqQRenrIhwXyVsuN
-Weu2Dfo8w93wI+4FuMoNc/DPh9bGkm8rl5ntMd88hcQR5YkmSmzZAEULB1PkIqV2pvT5/u68XAMW8f2s
-mqz5amsjnHgnHKgVHwiFvMNWll09ssPyg3sA/DJiIEnAbZtO5kE/++b73dF86j/jL/sVeMb71fHYV5AC
-W4c40LCWK7L1iK/TOseGyRsY1Qz/21OMsBkiSF+DpAObfqH9l1m+K+/fBvuK47/UyvGSBSLJfXhOCdck
-hxLgqsZQ3IHT+9SjP9roxcdoENhGKNLHrqUGU49hgzbls2i4Bv8geVF6AOlOKAz7OdsMC14FuqjjkHFH
-HWtT7aabqVQdaf74488UjIgjTrJpQ5H50Qqra/qzbV+FTTH1vxI+tmFque+Xw9bV1m9usbnno6bYBWeb
-kLd0gzOPH/pZo4gMDIdw9ShKkf6x4+IJpVCMe0CCaEZOVBu9Mlgp0Tim+YelcOLdBr0bHMAfxkeLzqd8
-jhZtrNR7QkcZL+hgWoyKHIuk3EUAw2VoEx3IJP2szpAx6AyjMIjxYPyZ4ZN098S6VUmRNTYnUW84eVUb
-8ScPMbwAQUAj+yEBvREcyDK9Z999H6zM9HOPJP8yyGwziKoRD/ZV22Bf/fbBXvrcg+W6bSMWSEsMm8Op
-+XO8LF8MNB2B7MdcT1AQsKTppekFAg43lMRNDwdg1iUcbRAYLJzHSpbyFwxKaQpBiZ0DfagplejldTuJ
-BkdMxkRIx32bXUU+y0BBP886iE8ok2+KSJucJs4DaBLYqQ5GITbb0XmLBdSn/rCdapkZ9VaIht964Ldv
-u56a+YV83QfVFGoEgwwCLaKly4ykwprMocR0A/h9oLWv9rJnAJHeNS/OU43d7klGyCtbRyXZbpVPh9rw
-Wi97VB20t+OTZgaFeQ2C6s7y5z3mSc3JQkp9GHj+ZJWDao93Kjx4pwDdISMgjiin5GvjWZVNDk/CSXGo
-aYQyWGkjc5ajbWqJJUR7vW53Ocm4c24lcv+hFpcZgH2GXe1vQ/m3MdmQPhnEWlxtncVxetji7wHvw6MK
-KjTPuZPABHpdCSVqBIRhryx2cdNyS/+tVUMb/nUj4OaCJt/ZjpKNzffG+L0sxeHUBol1p41U8I275hlY
-ibo32xpxe+m911Ldd7oUFE46yDGmsW3ZEhOOtEZJJVs5URNShmyMnv2Pebk3en+iAOHavTtTNSFpTR06
-M3ywjtHzwV5wqGuyaf72X/01SotD2sMb08ne5P/4Mq+EMkfx1C/dXr53KCGPaQ7sgYxOfrCZA0vTe4yt
-f27FnxmPao3gQXQl8tYpn2JfOS1rvPc/9+OSVwuIuFe9Zj8/J/pp0m7n6+fnRD+JblrpzzY9iUX1/Ghg
-nleK0bwPVDOr2lmL7QwmxMQTk7u7p+KHmvB9wpTgHflsUpxfgDpdm0+G8HF8EOz3RbQMnpo6t3omXEwj
-m7AL6opbrbwCOtCgUDbEYQtuIsVYsoD+r8AcUtZOms+02K9JLtwL4mbfxQDg76r9fViWn9/Rc/pulJ8U
-U/wx5Xz5Lqk8ZuecaGJ59LKFrTqhfFMO3lFOTzhgqPxDXvKtxKu3O62Oa0zYiomoDjHqHjehaeUow1Sz
-NZ6QKbN2YGnRZiRBMG0HMjOk8GwxTxFwd7Puq2TdV87aCibpdotzDxeCgtZICCfGF6OTPh9tsmcukGqR
-GNUejjTDXZE8YHIv4owAizSPwZvj/lxvCHm19pnMhIOxllIpAHWyMbiZgH9BzWVDVGvH9+k0eLg986EK
-Ekt7JK63axCaMDce9L18gtnbDO9vrSUpWxn0RxtE6XbrCslIxF5T1qA64leOL7VL38QeD76bwAvYdQIv
-YPF5UPqi0+KsNxfeILEoE9t3MeDUZvzf1AH7c2FZkXO1LCh8+V2kRTytQmb+KaCLxFwmHKuTaNthSuHC
-1wCO3D2OGcRnTn6bt8HVgGcBja0GL7fvBtj/UYExLfBoc6aE/X2DztA6LOAsG0T0sBTvl9st1jfmzGkU
-VCbqQ8a2eQYNX3NczvYO3Vys2ecebADPi+IAu/Is6G3t6lbwxQ05LQaxlXenRf7eIX7bIQUbXqrDdhgc
-mrgFyEIYor63bWPrZbNTsfL8N6wzx2k23XoKpD4s92eBOOKslWk40y9cg9Ol1vQPPwg/PPutx4CCUl/k
-FHgQGjH7N0y8XnrGPh73MoO+WG/nO+ALzeMXPYa/8anSqV320//NL5doR+1b8IDJBftW+fdF+XKmh9gi
-IX5hWKNqQWLJshATj9ZuBHDXgnNYvaxJkVUMre8ApnTzN95qea3zQBvltBvUO4VTSTirYks1a7jP8niz
-s4Ek3u54ZPZGhX1rgjSxhnB6lPi/ap8TEVhJS7lJwkoOK6N4khVFLfYalOO9IXb3suHlzexobN6/yfR8
-J1bHAe3nADjDhuCgmMVSmL2GtK5lbM3pRHrBZi9pZgFVsDReFOSVtVn5QXafkliRCMAKgpDFHRXEu2ZA
-YrHvZqYJg2x1p71D594iHyo1RHF60nqQQC26vK6k4cwiaEKs7hK9tcRh24offrPHMKIW7eSlZdWYrSAG
-b8Or2aoGxW5iRa8/dZEzjWqGLaKKVia1mISp2CTKD7DVPLyNcvVNUQi+2KAd0b2zZG+6zjhQnIdgkte1
-+M4k9Perm9fQVQbfWviT/n4uYVBuuh+Lg2Jcu+UqWksI+EBeODz+1c3rN+jXR/iLICcdedLWTKubNzfQ
-V+ebL746v7uvzg+wCtsHAP7t/Z1nz3fuAaD77KEjC4u27tQAU5sDtNFJtjdCAgPO5ZDbZwSAcN/TF0Be
-zZhcGWSvqrnqFTDUOkfZEEHHeG1YQCE8srC+bhQZ7y+nlYc7V5e7KPzhiHq46TWqE+cMKJ/PUEM2yyVr
-/BSlsjSqZ98/W9vNcefcaFQGxoPX5YCe8wPa6xrOIi7wfjU9zqfDTa3AmC61TIOyEOLjskPy2cMd+Xmq
-AHARcM+A0qmyYYV2PDWZmJA31LL9YOLTr5qdfYUBBXeL2UzW1vSLgnARjDrEV2t6UNIuPMmfYKczUxFF
-4ZTX7giJnz6cUIJ10jYH1gSx8KqivKTH+cnSc/o2NaVbOKXjCtBhPKMn1cwnS3RjKCmwxajK+QSi3Ixj
-ycJrjAkBMZ+zVHXOIQAMJnLij2c+gnHXlM2Ps+pzfnwYBZ4b+BuDQcp0yxoWEkOqzRgQObFxVMdy5i5a
-dvkyjnfz8mV7DS6j/UA1PhidQDnfCVjYSb7LUXCC0yp7QsB0Br6ChCbFFK/A38CQSF/lV/5wNptsrq8f
-Hx8PEHO+r+FmFcAbHKz/vV6v5xPEn4PD2dFIm3Aq5yklwaW8wTnnTTCZ/+FQYL7K42lOoUz9qaIQPWgr
-WNQEi+9rvouXCtYmP0ZVDAbP0HNWTqfFATBBcHvLoh5kFCKCtlXNxXLR0gG0g2kx0RzF44Oagv/m9XuU
-j/89/5BzGJn/GAHHxEoIxPnAGB3BxYBWON4S9TTZScE7lo/5qO7Bm4HTWiXeDultjuU2oN+rspMtcYAm
-1YSYOBQTq44WVR6iiuBKJeCut/uANferj/AXHSr473Gx+76cwR97h9PqSFpu780wLujDHX9rqykfuVkp
-5wP3UCG/hW0fvZWDwSCewYDwoErvVY2d8pxg1d4CHTvHEtitaVUOpdvp3mGJhwp2VtpBi1s3+Ov94gM8
-czqbySGQBX38Ix/Cf1iPoQtyVMEgiyDcja6REFlk6+bWLUxYRGQV0Wt2YVn+vsKKvQqxwvssn3Kc3fnB
-wUn2f17AO7w7hXNkdNSdmdpMHgGzXq4Ny4NyxkBCTACL8hS3BEirDl1+OFf0CrGRDx7p8qjmS0YVGcg3
-fdYlssZXkA1ioR8Z+vrPg1fwFBcjfEWgAbciAOvfDL7eyLr7VdXDOIb1rFq/Nrg2uHrTtb26Mbi6scLs
-1DynRIn5h/IAcdFgrph0SyrwaG9n6907m49fPNwZdH8ZXvllAP/T6/0KZfD/HvDp+/U/Aa89y6f5ENDX
-rw/3imOUMI96cQsaJfx3cEUGNPh10IvqbE8mo+KnYve/yhk1hyL+eoe/v5zCGzye/bKuzQaXpx82DZB1
-IF+LvS5Mr6czqWqaxuN8D2mInwHOT3xu4a9HeG7DRnhQ+Dhis192u+Uz/PFr+ex1Xr0Zxj3QiXU101Xk
-VmCtbf5zvYyq8IHHGg/ubj+B+R1f2WpU4nuAlR7TX2EFPgvhSwZXHjhAQFej6gDOONv8Qj/4Pu0WQK/I
-3/KGoAUGoks4oQwN8UTnJ7q02cdbNzqDLLs7n2WPX9x/wgcVhVeAorhH95YTvgFyji06PDRMJFOUhCEB
-GiLbMkfQ+GTifYJXoEAFpBnS3+f1jAdFg6GxMDQyqsKgseUeHmFEt/ByEXBo36Ho2tmtG2u75QzWAUZ+
-/Zr8jeidPzAkOQ9MwdTAkcCcn/4E07UcNOMu9Ii6tE6jiE8N4iC6NS5MUYl7RUWvr77J7jAn9gDpia6W
-oh0O/30zWeMm1QByyqn0YPgJzK0UEfYJ3K4+dfZvZy79OJCT8yiTlTzDSz0D47BLp2oVkedqVnpKyaUI
-mvEFBSLjfUHB8p063QmOtArjFnej8RIn73DWeJIIfTOQYMmkkJb1SnYDFg5aijxV30q3I9eS630tXG9+
-V12b68k216M2/Aa7RjeSjW6EjWjvuGGcH3zI6aCBg2OGrzgAbkV5jnyXdPWA7n0i9FJCjzHl6WER4Z11
-6nwfHrUO22PVJUqCOwbvdjIkwWC4SuN07hEp0ZHwYSJygr7dmyGPmsqZ7NPBbXl30yialoypFV0yPLVn
-Xxu3dpFszQPzNVaiWnzozZlwG+1b+30UOKd6y6vaCo2rmgZkNu0+kdL02ugNAfTzcB8TsIdkAu9JgYEH
-ixmRVbSx0yCtuzyXwKTmmO+r3syAqkChzdtbb6/ifwdf+crPcLHquJvccTJAYOSI33n7OdA8mtNC/2hN
-6wERaVPzuKkuvog4SsCz/C2jZJQUun4qse4JW5M9SP73ykgow+FY85AP5BNCVIY80Jk8/K8Hb9/I0x+j
-Wr6aNW7cBzJ4NEcECmA/BkDYj4Bg73beYgCAQaeHR8YbXcRnhoHt2xBffJflaUC0Dxt9TcJQM8Grpdff
-bDUOGAM07V0Tb32/ImESU2wsGS+umFCDwsgIV/3DNq4+J9GBPdn5OBnBXeWVvez/J8v+w1lcwuL8inz5
-j+HR6Jb7fAop7RxUYBhIWpZW+1AWcSJQS4QDh0O4VmWjbthu1MeFPMmNQSfeMzMPNwN0MGA9EUArx4lW
-fVH6aExFilTZqJVR/tQZTMhM9J6thm9fMPPG17OXInywvmtZnZ+EYLGCEthXS7U8L5CUYi8H/PYeUw8i
-rVPk77M6n+8VQD8d81z4oo6LYsjV9UV48f5kUoT8i+sCeVfoBh4abofG5GhVwQAJDInMjvElQgBUDZqu
-rXF8C7aK/8utjavf3PzKHp5bN4JFvHUjXrV28Q0K7IVXbKxZ6iIIK/J5j7+8DmYSUnLW7ku1JcdOfNHn
-HTk9ZjYUH7FeZ4yaKi05ZmHRPuug+bk1o+aCs4bNtVLjfgA9PJvXh/Lzp4ePHmV3d7LnO4+f/ggI9sed
-56+yF0+fPhmQZW7yMDJwN0QmnaxPGxUsda51NkuucJ4x6aRo4fOuNRM51iuRCs5aaxUqnTGDuaCx6Xw8
-RlJD+KtBavSE1d8hWu/Y5igWeUEnzbalY8IF9pxwyZkHhaude/RKlySHz4tPk8h80icRGiP7E1NiAJgl
-rRIj6HKW/kdyxOOyLujJW6eHzVzw2qIl+HXm5a5T6GjhvEk20voQL9gyNJVGD48PzJQbODh0IoNsFiL8
-fdbwqdJ5JwDAc3h4SHxDsoZn1TCbiCDzHBOzMKO5iVk0baJ09PSFeQNJjmRfQSo4k3qgWn66LPcxYLjg
-DDBdFWMxyJzCsjhZFP7NYAxGYikUMIOmL1fmugN6HpDZh7I4RgkMacV4KaQuMpD1ovmpdFe7lTGZTqXk
-rIVy4maFhNMMljs/EwbWEWo8pQNP4XLNbX9944sa+XdXI+9BLQzImYz1uOrVJ6uqLTg8mQC9+ixHzSWK
-itbXuoPe+sGW0zvfQ4hoGIiYmmuTUoClGH2SMwqv7bVe37mBdDsmyMgeBiPpSE7tb7PVKEzJqptUPs2P
-sk/cxal0tWJwj36ieflnWfvkr3yUnQcXCV2U0Q3n3TfJDt720X0V1WXFNLoMrnwwqyiJ4D1YFqFRMK1J
-4kLokNwluLrMJTAh9DlH0peL8dsvxnivGKVj0gZ2Ft+TOrcOMPQYs5dKlgSlUGC8KZi0/M/IbUA4QBEy
-qsMQQcOyVGs1u/CSdBhZ4tom27oU58mvgL3lM9PW9xZXOqr+eUaN6iwI9RkV9mCVpi/Lo6Kaz1KPSWoa
-f7YMY9evfXnUfv+7O4LnJfmiycLqg8Fcwylam6SKyYU6WT//GLwuUp66ff6xwUF1j0rNgdtHKF6/rokN
-oUL0ikAJU1C24ne+ta+Yf9SKUiJprpMPDQ7HvTLXv5zKP+BUArtAlplorHrhjGkhmGZ6tPD76iZsEO7w
-jS87/PvvMJnQLhs2/d6LF29/3H7+QgIpxJGwc4rhKCTwZtb5y1dD/D+K+RbX9ZX2ruH/JStJaG2odWPy
-MVkDjUzmNda41axB1szw6erN9Lc1MjqjGl81a5As249zerCbd6/dhHPl/2dj8HWvQ8EaIiytZP0YNiEi
-4NnmGj94TKrrOjjM66fHY5fPjGpFKFPrvsaPb5wa0ifnksRcEsMdw/F0V/E/WB9/rfY2sx/GcINI5iQp
-u8g6mrNQo3Kyw7Q+wxiYbdc/k8QM1XYI+kti0j/g+n5svas17Ovhc+YFn6OJANkkrSPfy+Q1hhVhz/QV
-G4DZxWHsugCN/qBSq9fuw5uYe4w+u9MZf+DXnH84hrUx4j5GkiLesxX+qbt6YYiZkmzsSHsFSOS9WtmN
-SmBt0TxpigbhNZqZQykcarkGeEfIrLSunM3Uie6ZuyMkGshnHB9iwD3Dn6jWRVEBHfJ8Wv6T7tIK5XIb
-Ik1U7qNlknBWUae6ww/HAvvtfAKDLvIj0fTZqTl7qhJlEmidU0i6eODC2E4KYZFtqTO8kmWW3HAotyQb
-VxgucIOaIRHDDbtYVG7CK6T4QwG2LKscUgwSisPde28MxvBqQxVcCTyVqAM/lkBcFkfyjvzKzmn+NNCO
-6QIWbH8L68W1YNneFyeceJxWLC0qf8ijU9DAp/bZjJvjC2E4kL1qPJQgYTixVlAomp/C1hUq//UDfY95
-QPy0xTaTMJLO8bUcu8Fg8CbLADmWFEcEzYeJuVZYtbv1Ygya18xl/0cg2pExsYsMQaon+AjWBXaHi+4O
-1RPh0+3L89HfaLFXIUTgyran05xCBplEa2aIGCWMl9TF443awq2WvJy4Nra3AaA2WEgj9ImQi0UirlmE
-STJ13rR2Fc0h0H997s1BjaieGWqXDNHnN2t0ys2P8knXIsPe4O9VOe52sk6bFOyje/i+pHf+/R++IawQ
-rHFxr5qmBcGyvg/HH6r34jRyAMht7P1G8300qc7hDhV7gJlhuYz3QTmCS1oglqjJQJbQ6Sif1Ihoy4Nx
-Rfexnu/WGL5pzAlQHRJ/4AXGfbxNaL56nCOWNq6ZOTploNJERuK0S5iMrEZJjUiEyYqW0MyQbfz2K3xs
-MBKHk0YX8AwMYB8o0s8jwC/FGK5bB+7hfNLpu+ViN0MewDPuHUnZjZ5YyuC9kGNVyNVAVK639pDMxvAI
-zjJ8PqqhuF9otDZOPgpzZMFWliOsCeArcmuFveD3zzmuSBgXHtzwBzsuCtByxqi3zjX5EH48Y1JjkZl9
-30xAx07by9XS4Aa0Kl0HTjkBXbtTWkUKRVT4BYWOFFxKaHOco1lNdgiUPbDIB1idispxcEJt08unHKnw
-IzasJnIttQgAlHQjwmGEsqQ7fswwJZFePuDRo/5UaQ5HyvhatEIl2jUybQHIAp1EPcUgARFMkwbNtpKl
-x2JlqYtGY+stPR7bKDGiBUIyd0gpWRDtT1/Xux8tYL8xCX79omW+HRf8+qspwaersRa3m0UYCzYQP4u1
-tZNGZ1ljEj76gcsZ/nZUoNLMPZ2SM7yPJbU+t12sBUjk7XuKLrixxX99S635B2UYl5cem77GwjcWMpcE
-nt9uXP56UXkUAMCoxyTnoO8Lvw3yyWR00nW7gv2rHz7/RxsO3r598RhQWy5Q3W+uJovX2KGutucD4MmL
-aAJ2rF1PRYU715VeGIrNcuigpWkQ/ewoka++UCK/OyUSOJsnldDeSiN/X+zY6kiQBirc5uGQD1BRTkMb
-x5vv7RWTGftHDst6L58Oka2YzGf1FrKF+HaPq4ysM4r9fZhULaxrSQ/IZFoCp1wCrwij35+PsnJYVkfK
-5RI+wFCU03LIsRC1X3ggJ0Aaz2omBYjdZQ9XNR8VwiAn92r2L0BfOrgtSE1ghLI1Sh9PHULjHM7iXpVH
-7Euwzl2KkrLSmpqZguZGi73VXp0yOafadJVGWJwkOtUSrYYWNXwyp0ApzYaUFHZRj7hnTTxigmZvoeVK
-a/ttwbcBjPgg0nlL2r8U4ZoKpvmS6/T3xzTAj/4It62aAsm5X34shk9YuryU6H7nY7E3xwHvjD+U02os
-J8Ak3kzV8FlCxcgjbKKlmt8TaEuUccSQXbEDd1QcVZgoWgSQHNuKJoVH7HXnJ9Lfo6PGUY3/+7j6J/7n
-aeeNraxiTU6XcrDzcdLt/N8uRasXYMK1/9pB/69Oz+UhLeqZZkZKTRvNC36oMVIIxpBUT7hEkpvegA/Y
-ZlOOyvosHIYR9juqqmQqqQQSyY2VCSso9KSSWZih5PHS6q/LNyLU9w5jQVU8WzrPhpDF1jQuUStR+rnT
-Vs2GC59LfVVo2LpX174YWSh10gxEWZ/uNECQZkLiBHjSXOycSQSIoxE6nqSzWjd6I5I3pKv9eJnXWFKi
-qTGVq6HSLz2frGRJh6HiazEpZ5zzXLYHGwzQimp71t3ohVZUsl8skepe7cUbR+d5gJvWjQCbwGPuMvHb
-BLciOevsCD2vyP3DM+Yk+Z2PdfNJOYQiw2AngCx4OAMytg5a/Q3vYbBO6hIVrxWvrj19FMr4CG9JeC/i
-SUamACHgNMmbnLsm9E5d7dVNDhYkW7+6eX0Df7tVhYJv8EFbKiCQkWfC0uwV9ZdX7je/cm4nkk+ZLC76
-Z/iL0KNo6cDWT2cSG42lUicwGzyzB4i54PscxdhMkIpHJQGTqENZDSTweLYvq8nK0KxLV+hv5Gnl0E6P
-qGzHuJJ6pMpkIjAwp0hFd1xgF49RdwL47D0b2h2rb/xxwdJLVsayx6wTv/nHFIZNoYM0iwguB2AVWmzo
-dDiXkI0SyTxYQo3CQ6oaGCScEIyrQhlKfAieYbm/T/ElDDzGrY133QiNdP2dMqUvqwYcNoymn+31s2E/
-Qzc8r0KUizIoxh8GT57e33m78+RHzlrku+5Yd1rZiNaMu5He249Vri2yRFmBH91C6s51bK4udea95KZj
-MT0B2FpyUNzbbTusx6T+gz0rPiKfRrKqvb35dFoMt0hPpqGqjrTisPgAu+WJNcLWHJoG2S/Y1WhSeCT9
-fT0sRhNk447zKToq1INOOvIgxfZgUc7reNfebIWVNJfjxlb7RB+69f+xrEaaShfGzkvmtL3rf6vXD/oJ
-jjfkeuvX2u+VK244p70wiyMNY0AZFuqX1bNqQtFb2S9BTFT3UOvHt9GTozUGTuTMDCv+MLmtbvEAcO0j
-S01H8b6VM97pkQ3lqv7GuHVfweNy44ubwB/wjtSkDnihOPv34owA4Pd5/aCgeFVbK4gcFrMUfM6DZjbe
-RiTQtvE6wk9oLKTt/7//b4VDoLDkRb3v2Tkak53BS+NTVwBpN5Fd15QYA27/H3VRaDivYXU0QN3YAJ+r
-4wOK5/UXKFyD/x+OZA1Gss8jQZHnmcPtdpCZAyRP8TqnYu+p5534WHKVG7MFQ/D06iEg1AnfCl1riyv1
-TDx5+nJnky8APYP41FFgp71qKqbq++T8PV47QJ0RPInUI/aHQcXq7B3n+XpH0cHekQz3XT97N6ryIf6X
-UMU7tph4VxcjgPlOO78LfZBJCIZCQR9xwMP/nEZWEI6fon6ReH0x3wdCNuMsIkj5AioeHAyy1T24l+9X
-B4GmRnLsYQBhSvwQ+r9p4eQQTfXtKoYcmYPyUpzpsXFz6bmR5i2jH4oq3PSy64ONwQYwGFn3HjAgMPO7
-83IET+Ov2eOHL0NuLb6h3WgN+joBTzxcOoNbR52HTBouzqVuJ9YKYtASd0Jj0zoTiEEYRTeiDI0fxviM
-RYNUtUpZu3lAXT+VoD8XFuiSqR5QGXxhLD5ISR348ZPKGGN4ewbHCMi/wncMN0zCnWn9cIhi4CFAXrt2
-zO12dJc6QaATO25c4RCLQUF8jAkWZbftGG9BETuzlQ7S1xi0D+OcAMeo5y1TUucdNX+nB3KcPdz55sog
-MaNlEA8dhnrAI4IlguPaaRiDGLBprjM+uWcwnPjkL+UU9eXJ/41P/hMKfXW2F8XlU7UOe+kNxVRk1YIc
-NTSHRAsxFmbknY/oZ8yRSqDDiM4wOI+iwHPDQOJ+6ZKU4jVS86snmvXE38fsjut2zHH7tZ7z7pHvt43F
-FmU3tl8HONaXGAWEqrHFQUs1RoBYjV+rTq/FCIpnp0qBG18ch37/M48C7ns+HKo5+GJHuoYIdpEs5V51
-tAvsa80RLCcja+PI+11zotFqzEaJQh/+vcZYtRj8dB0HITFZ15BnW/uA/53mJxGxMxgMnPTXxHBd7PJq
-ZuitBrP1y300q4SahjpoGBX6+pjGVuWLmoKOoOoDDuN+RAL4hLGDioV9ne8wnWxkKVGWzHaWKNV3VfG3
-tX1wHQemD2XpmFuNyk+VgvQ1ZjJmJe6YD1eA0+4AIuiQwiOYoxfYRpJ+1zp9p83yu4v9xffq97/Y74uT
-xyWyF+e91ksr4twGwTsyne/NWFAGLwGGoy4pjgqcdDJzpojTEmKwnLIhdcLYcTOw67v39NFTchpxU+l+
-2oWGm6TOwRhxQ/7zVA35SDV4Qo7y6GtC7TFXSmG+lzV9/zEfUQDVS5e42mtp98ZaMGLyBownUoj9JL7b
-aKHOMbaRmdy3huEStIJYQTKnxsVwQlpsHK0Dro23ZXyIxhabWfYJiq9uItCrfaxyjf6+dsq1ns5nVE1q
-4f9qLfzf0whl8jNM5EqAKqWcToAkUeM1tiJav/BQ3au/pmSIxDkJpS1hOCfQhdqWunjq6JJLbB1dspU0
-QQUi2ncDGLm3mTkLA9JB7Rbegl+EkIQy0UCspA9W2nsJfsduUFDTKMDQiqscz4OwfDCj12JOJpMxCA7+
-02LL4AauBHysBrrxxQfxD8F0T/cDMn2bnWng+cJgFGLXmWdONA4tBpjzr8Ms5C6KGLL6BFj8I650QleT
-1R+zQxT6jqoaDUBnFCKVY/+h8e3JOD8SCyfEongmlebJa8ELpN45LI7qYvQBtaPP8PEux8YvhRBljrZN
-BzwfdFGBNSunJBnS+Kt8HlfEpNv4e2C3tCH5TIHAmlXT4SAjHrMunAk5jlrj7iIk9q3GC5UTmI57zjsm
-Fo+/gJj7jEYX+CMdEakmsfhHJaWzoNEgSNaYdT7mV691Brxb3U+uG0Xh8RS5Pp+EnL1wBtnzoq5GH8R3
-xk4JF79Cy7CcVHjT2XxCxpYc/hzhTQvWkuBiTBEMMdp1a/wIGmeEDGHgQMTCCjy16FAwRoCUwlqKmrT0
-QggKUVETOYmdRQt2errvaK4v7pS/PyYCyueg+L4YTTCrAldRJaIGUqk3s+AN/E0kWPxwm3qu2JJqj/OP
-5RGQJN5RZVR8KEY1xwjOMW4LrN1PeBE5o9xeOcX8f+hTghQe5l1g6cYeEn2OeHi8/fPbxzvPMR/izrOX
-38NIrt/yvf5UZMekQzuuprBfrEQrhgdFxjGTRuX7gjSALwibdTsfOxRgEIvusvSErSGp6wdBJo/7sJvO
-Iw+xFeb6Z5e7fFT3Rc8+RaUGZ/GeDWI5DivLAfWvM4pj90AVJ7YIcyRsLBN8yoaxIEd7H7jlKeuXUhbo
-v11ptwpNMEWAwlptFcD8+mtWWaIKZ86FlEjOoQE2j/PnUOP+Rnu0GRdwuDM3pk3zdxQirxrDQYG7g32u
-+0RvQgQTq09GnvpkUDNu6xQPTBLePsW18/QevxRjVPSSVU9GxqxkL6yuKD0BpDty6akSshIiz7V+jIsA
-oG3oufibt1u1OuNQLY+LrIVkR3YHSF+Msi8m1eHqPNyn1cdXme43nkHeD0rbNCsOTrLLSNdeJksMeJ1H
-JSbpo2Desz6+9WXNoPBeAF3BmpjRiAIikrcJ1W449RYfimlgcpWPT8hrhaGhccc7eIEkt/3suHoHI8Bx
-vqOBvqulc7YPIK8WYWh4+PBejjgFTmJD8SIBm8Q+lOiCS03wLAwaFTE6eFQRdVw2mCgwqrJFUA32qQ4i
-iza+dinXC8B1z61jRSKGY1xQLOCwFBsCG/JyWorHN40pl+2TQFPZAbw6f2Os87d60OlnrtPEMYjW5amT
-EJ+1MHHNtpXheq1L4z831sYih0GiBS3S1nJVz5493JJFo188eHs9/ZZeMpiTKjQZSyxO7KhSsfGm4m5i
-k4tM5SFs5eLpBDXSU2rOCTOq8juguMeL8JefMSHji0xbtOhEeiv+Os7Z2Iv9YHNPHKh3vXW1p5aH+ZDB
-zSrkOoqcg/0Xk9lhComo2+IjJEqwJpBbcw4lB9P4gBIbcnw9yHEeGPwJqZnU7SAIyc2gL10ie5rbQMXZ
-t42nMeso5TQsioksB02DzLEKdNun1GmY0Q3lFbMZvoAlu1xydTK/StBTwgnOx95E4Rj2tXPWrhBVKs9H
-/MCUNa9WapFVfs4YOGwYLSVVeSHf4tUMPnYVQnNN3aAaj6of7jjENBZ0ibksOrS2KAxSG0lMCkQTcEDI
-FdZtjs/3BuW09KVIKcVt09VE51iKUc+cpT7eiIv5BUhvxQtOXKF5H2HXgfktMQlfta/oZnSAKR8PjyTg
-fTHeozzQU4XPBILcENxTlXdRe7zGB6QylJdiLXu3N6p24Yq822TxZLE/09cb95z8youhr16i3dvdEzJ/
-kzYwT3zZKQjIIaZromD7ctE+IL3h1gaHmXOscrQWgPoZARwAWQZo4KSYGduOnjs60e5tWvEtnY17PAnO
-nkExq7OHZqBcjiveW0kztvawJCVvaJHtel3dvHEdGeClwip8CSv62ZlijhD6HOMc1LMwVOVKW8zdBW18
-DM9p8nMcxDMNJIjieVaV6kwYdbJG6uwumNmfLXDnjS/uv3/A7VCWlBJUJ21PEsKhlgQ/Rv6TquFsTmP7
-o0jEFH1lCdI6Jo6R3K0a9oCTbT57+PPOo7cvXu48Q/31BoulHj18svP2+52H//k95ha/IaXPtv/TlH69
-sWFkU5j+OyPDqqyLTNa1NTicB7jtGEhvkg97zrSr4hWGC46Glu8pqdRM/JAxP8+opFzNKPyd17zVw2qP
-ThSGwUDo3cNqgm7KIyBZhsUsL0ekr6vmB4fkDTKujzmfLTmB0G1VcfEKs/kofvYZbnJm2ohdNjNB4g6d
-5YawebRyQNtJQC455CvC6XNHu9APGrHtAx0/BqLycnY4P6ipT7o/rGSshgwF4zCjWOsGWQ+xgRuentpl
-ThyR3SK08KpVMafLon9ra5IgpHv96hVgJR486F79Cv94uNP95ooEJO/Q3BoAXFuqfQtbPeX8sS8on4k0
-flz9k3b5WfmxkGT8HWoMfV0f3CRTvku9rAvYY2MN/ud6Dz+y/f0u5a+4JJA0XbwDosMASBuDbwYwcPEU
-v7axcV2m/qJizxmkFKs7nHVaxB247H6Fwjs5ACKMLgRaO/kALQ/HvGO86j5+A2sZdH9R24J0Uj4mZqyY
-wlnwoUYEYw2L0SzHQ/zStbO5ryM9kTjRIWnlRlxPyvHPvAJGeoalGJ4IBWnzmrU8/6yqI1jW7CMmrhgX
-vvkrbr4K307stwlu1s8x6GHJgsisi45LWKVuQKXiVwmoJBSm62EiqgleJHLUuG+y3xWlRIYjjRXnThHH
-V2xGeb857iZcNkQKE/RnATi5lXSTjRKsAI826+KfUK/uDTC0Go0DbwygKdJl1UBNk4JrPKNcdeLjhJut
-KT9YGKRm8eS8BJvNbJ9JX2qHNzNgaGdw219gDpAhpy/OuoQMFOfBqhZH5YyD7LFtOYJDLdcRyQQxbtyU
-jFVrPh74otPrxSmrT1gGTmlGGBIsKEZs//v8aMJNGAONC2RL6bAgI7+3V4zEjiN4oel0kt8XJuzDe4Nn
-3J0M4a7wPNNxgyXwp3SNTyTeuemJMMe+JTJi+/nU7CwKHOrJfEx7pSvCoLJsOM0PgEnq6bVhS/NiquEn
-9LC7R4OzkBuloUJirx1K/E2y1sP5+P2J7higT1gmeXCe3ZO87e9Vk6gGHR4WPNUjBIeLfgRkBzwn5fgk
-hPM43yNFajbMZ/mmWdgZJ4d24JQrdbphgo2wKBmNuwHZ1YFZZTrhuCC6uHphgOwoUUEic6N9Iqc9ajAf
-c35GYLY7FPGfhoDoi5Zlmv/zxJ1ePKm7xey4gMfFXwCUMcPRxND+GEqyw+6GHB0qU4xQX7JDreqSEgiw
-0kWS7wJwHiIO/P7Tn56sP0eSoQ/v7QHnG/jh2fqjnQcvZfMVvkRTpLiTGIqLvCrDHvBIINSHTww0LXz6
-w0sXFZI2RbJpsnMdOcHo60l3ceCQ2QmhJn6RCRn3Bal2Hcbp3Ykuws+sL3J0Dinb8VjrMdEjL2osmF4J
-DP8V3XVRlOM/MSCg15mJIebzSbqEcNf8eobu8YgmhZobRON7lVGqPkFk7GOKyLxUuwnS4Zd7Wf6xrMXC
-wpM+FtpDmFZFHqcI93/WaUH+R6k245wjuB+pmevhUuMSuGM0Bc5nd0Ryrt0T75dEORzHf/dpvBAdwit1
-kl2/T1p1XAKsOBjgUVVwOLv34+rY0XahBxW64yoO25GMqTjbcHgSpU+xBlxTGF8XWKpxD8dIuU7x2opm
-hpD4B0CwB0IveixJKUuvaLa2rEufe0zg4P/c0Isk9zfr0kDu04+1q9c2egEcpsAacJ6sby+Cg4BCOJIR
-sTmejcFVtpFLwoF+BM5P5fjr9nld3dhYcl4Cp3U817OsfTwKB0E9DW9Z+w54BoR7uLZw5bK1Wy0rF8O5
-uhCOrJyMlMjf9Wo0NI54cIzpLhJxYPgeND1iVCS8qrPMlkuUj+oqOyproqS4bx8Td0WMH6PzLd2ow5+u
-lhhzHx8fD46vk4/fy+frcH/XSNC+dn2NPWbW/8LEyxoBdYSM9rWbAx4Rl56cohBLOFxEkPgu9XmY6CeW
-dRmf9pmk6wsV19fJUcWfpcErjmeFf/6PUloOX6gwmdZWaVGK+Aykes7PFhL1xzDfKO8rXWPeDZ4MvURI
-EQTA0IkaCBICVGAeJUPveOoc2gpi4ZzzIt2Px+kPaMu/X+VVMqdIi5jFNa3XGv+unFkUdC545cPNdTrm
-0JMAhhvmxsP/Nloawk3+avmGch0/XP3qnD2ahufrUf493PlmcUMnSLON9dZ/uBEM12tATK9XWxraXpMN
-hbU13g4h79rN1i8z73h5nQ5CzxSo/Vr9M7rk98X6rH5lf62vB+QMlE6C2pNXHIgI6jGj2Be2j5Ql69kj
-ILH2TlbYAK7Dp5CM+mQ0rBGgPu1BtdlfOv44t7Rcaxz79Qx2qAXIq2WhvFoI5ucEmJ9TYH62YHhViNLz
-FBls24MHjFJCwYb2igSW7875SQ6I8EK9Gv/8/unzh//z9MnL7Udvt39++CIYVv1qy0/VD4Z2EypcNlI8
-/Ej7CnXDcr+P6XWc2H3EKsHaDVuWjcZgWv0cuIx24fOvvwLonp+3ewuseWX0CeONXw2y6Dt2B2WTzO3o
-KYYFuG1Fllv+eKc+RCEwLGyUcCZgG8FnCLvx4dSckwfA4q9RjFKYIL0X8KCLE4KLLD+UhYKO0Cyg/jnY
-dyj9FgiNO4Czss3sqt0QGAA1eBVcAyhtNlhx5lGfGB9sAnTGAYQX4Ocr/slYYBP6Nb/hO4CNgk+y1A3f
-1d1yLKQ2E9EYXwr5IeKRrggvooiNRRLEOuxj1lQ1r5bXOLxAJAUQhNpHsgjumQtaIyRGZvCGikMC2gfT
-Vr2ncN1enqarL+M+Uj0wG/0tECBGkRfXL7M+nrHxonTPPlX4nab8c7PpDO+8pu+42WwG8tsWq+Fw7Kpe
-TQ1pdfMaaVrjrlc3b2yg+mipmI5fFK6fXaWU1IuGiRwTKqUgLmeoEAo+qd3xYhWtab2golNIoaMVRjDm
-t+kCeSaTc14+0WRalZzKNMn+T+lJL1oSeMJM8grRFpgsGvPpVBYADXsH4+qYs6ZqyG3AT5Sd4nE+Oxwc
-5R+7G/3s6i3gI7uu6ZpbRg5vZBbV1bnigW15hCNqbB+TupuIMq2hsf34xC7Gg+wxToGX66dqitF5KXEt
-hvxH6djunIRPQv/fEoEsWZuW03qmy0qCSzJm5VDzsPDJBe8Gx7LXlkvuz61mX41u1+rm9a8Qpy44SoBe
-byF6XSrC4BdU+ptQaX1IeZl2UMqfxJyyvs/YI1QcXVFAsHuSUa4o0QZNSbFMbkuV1eghAuJLKMk6KIYM
-24vlY3JDo8jbIk/guITiySDDADTGWgiRx9Oy7P59m2DDH3fRbcrEcyLYodcqRs+mseXTIoLqViZ2gQjZ
-TrtQ6Na53aeuvW89jQhZFV/sHR6m8yKg+X0Iie2mE4QCIlt8byaLcFOV77rKZ0brwSXYjpIQYXc94+ha
-321WuOsD8xAI8fqn0VATKVg4AAxrQ5p/oFi3OzXvh1cWUhSouwMZyO73gQOZH5JPWxS6mA2Axh7qWNNx
-hO3QgyDC5LsW9cgTfV2+6emevNaSNzRv7MoXNeIHu7kbhic4DalQAvaQqV/bzaVi4X2hMj87anRKNuBF
-npGOrRr//GplSQOmuyzbFJ4BszCFRGPzu6MV0+GKg9bJKg7Ay+fbT148ePr8cVv8327HZa5Tavfu9r3/
-erB9b+ftjw9fPLz78NHDl6/aWyOZhNlFfyxrkRe7vtOrhgE5YooLb11zFfBa33vx4qWOr+72bPyvee7N
-I4XUuBP+HozzD+VBDjs7mCtPh4zhD0/+68nTn550thysshZK7Xa2zn/9sr7O0aXnObsgrLNo1ZZze0Bm
-wJ/nk0mRT0WmL8Aw2ig8Ouiee8hKC1mFrJoO0eKKW8Ph/c9nP6w5MwS4SkBfklslV+hipEGkKFEBUW+u
-r8PftZh8khqiPqyO30LhYO+gvFMOb9+6+vW1G72BtscIwNfuu/1gRZ/S+ZmPVsajhsm27sX1+6ndSKTk
-MKJXQgR9KGD7lMvrH+2PExu1haq+dmcWQxR03Cm6PqRw9R/RwH3ysY9/n8jfG73OVgQkcYgJ3GE5HBZj
-V/00KeT6I6bTNpleY2wr0fg+y+io4oCs6m/rMFzP/HFGsWFPgm+nEuu1100yIekr/2fjQprHe3XzKnIi
-SRwHbMrX+PguFZWuPcU3mu1zNMj1BwgaWMv8JZpJPodnj3CmGve31oJRonX/zfPECnuuyVtRu8iJ2gQF
-7OWUnnQXI9kTmiQfiGOObrBb7FfCsFLmvWoueY8ZfyGZTgalarUzTMYWcEf0nulY8tE0VsrWcRTPUtGT
-lvUo91nUG57lPixmsn46qq0Ppim9fl/WRLqIG7JzoHlpgrB6Kz34RSZQQooccmPrx/SkmhViMuXSDpRI
-leVDimnkfZiYlN3MrsaeO5iUMTCBQQk7Wmhg7yfJvhEeVjNeUEGeMBPMXOffzzr3WFqPWyHLMKC+NKgI
-Rf3raFIyeJXuV+LXSwIRTSKsbYWtOCYxHjdAZzMMA47GTjDukSRIzDjsrJj/oqkANYgArWG4Me5biIND
-M8quxrVMiokFVJyKPx3B8urVjf5q4qStbl67RnhkqTA5iSP9lkyQ7gEa5cC1VriuvvJ9H6GqmsJ+8dPu
-PlufelsRa/rg8Ci6lwDlsqmUciqXFMyU19L7Z27B43TqhkgBWDVkW0jn2Qx6iJ2fOdve7gxzy884Z0ZN
-VZO5X+BjxLNJTkzOx0xhIagWsGFbpngggbp2KYlNuhxllsSn2e971Xi/PJhrS+LWWL9H8gQOkOuq92zT
-42k5C5oJ0xrM/cTN3LQERtL+5gVuPvpm/2jhZhUCrfsS8+yZLqXkEZDPvebiG0CepbYgezznAO4iKOEQ
-tlwyfl8DpgT/j2mI9cvZ3+tDePWytZ82vr6hcudn+ezwh1k5it5QV0yYxJyoYvIC6O+9w+4UkA0OHypy
-ROh/zIupED6ARPjusEPpLvKUgn9mFVoTD8WpAQoQ5OhkbYxOCkM2jlSJxN5hORo+x35odPjHwJRpBERT
-ZPkWAtU3QJiyCo98P+NUkgaGP/pwGShV5JUrpYmk42qGzfAurEidcFSDsr4vKT0xFrQth4fnAbLOPR+g
-J1g8SinCXAzngR3YLmiGdNPcrnjoza2xJDN0ss0r6jh02SY2Tx1eyraHQ344uBolCSk6U1J7FAMHiXds
-yks3H5M9KR+OnucVNHsO1k0FfuQz86Ti1eEe1VJ2uIWm3WJrzMvIR2FFU/VSoW4Jf7OFek6CihjQlryX
-8Wi50z6Q8F7P6EvQYoDL6Re1FwfqRoz+GAfc9evOHcj697PXcmcs2DeSPpwearEKXsW3gEQqq7L4tBJ3
-ZMJjOTThjINSnXJYdak5B00+46QDuGbWj9CuW5zbNyU/lz92uslnDnuaGq4uA7e+6NhlsHESMgpSJDew
-IWlxv8/oYRqgrYj0YDaOQShBR2mnFRy+w/Lnlv0qa8V/mC/UKXyg/5ryaYBjayc/tnRG9zHjU46T+ZoH
-DG/oZtZBhE5fO32hOpkuRsp4m3dWbCBxtSjGwRq/BnmIgzgKBBV1aoWgiElDNPiKOHej7eDLw53Arpcz
-D2EPAyrUfZOzTeqpMWs+VJRGZHnGigxDmbs58kmr+ZT1gpQ1Zlv8MRW6/L9xyekcOLSITXRHGse6pb6i
-fD6F8iH9pE3D10xaio4DUEH4vCXekqm+a82nxHXdROyE0N/0bIrgx/y1TZihn4ktdwuxunkTVZI3l4ox
-lyDjkSp6SX6LIWGjxTaG2XZ2VH6EM4gLSeLDMTHyHD+hGmLsRjxaPzx/NAiN9sPgso9PHpVjZBieI1sy
-sJfnk1pjUkf1ZvY6I0Q4HTwRoQCcsTd9rQWc1nAETUtAAt6u7bK8pmyVhvIC+K+QFl2NV+sutRcjvKy6
-nZy66/SJA4GD3WHk0EFduxbR3kKJB3Xa915DqHXoBuOQ7e1aS9Nvc+B6aeC3P9EwzFROv6P/ZEfFpW/X
-8+98M9Mh/8FDYArVLJDw+5Izm7ZxU5E6ream33ZGalMgsp7z7g9XGgE3VIeJkT92KXwjbTIjFt6+IWvJ
-IuzEzflGcAPUos/IYQkREl9rZ/8uWAV9vPB8G7SiRd1ZFb0NsT4T11ImrlNb0DgRXcRNViN88vHGeCf5
-fsE5GUlSxZb+2eG02CdFLjcf4dEWb2RG2mRc6hdmzNYxZq7fA4Rorlh04bm2NE7M9aU7+7WOGbcJUwdx
-tFZ5cFywcxTPTea1D22WE32AjaqxQBBBBnJdlJBBZmrvmZltcP1aZ5ya6hktP9d0OemZm/DMyM6wcSix
-iictGdMwcaaZsyk935QXN1xuxk5+ymhaBnpQ3Q1FbFwQ5zJPDUprLhJZeeTkHjBFQPCAYWK1m0vFiPkM
-olX0mzsIrOxYWbHGH7TaP4IH8R+1UyPSmt+D168cFfQkFyjnWd/svs7X/rm99j9v//pG/tpY+wZ+XO79
-+vryoNv75fUvb3755cqvn07/71/frB9seWAPx9j/ErDuvLn8+s6bOwgwBQE2u0Sj9hfwlB4acL/g/935
-Ff/nl3X6jzamc2Nr3ukOLvf+uu7kZzzN4TNkPDg3e5gsWSrId6TD8L8mrYMW4T1pgAv0pzQRVHBQOsM3
-XhkqCnei4bGZS0OY2AmblFAEGw6uycOrvE5YnJkxDBDLmUoNHr3Tff1/1+/85c0VoyBjrZgROaBJzOXO
-oh46NXq0d1LwB5fvNGB/alT75RdU1qUkBkp9Npb9tSzkGyIUuA3LEIAwCBJyI2hZ/itZ56+Ydans9JwD
-ipvLpvmbO49t19sH4ZhEK2L7lHySZybHmWFt0JVXyBKP0Mp6W8o2bahOLexatkQTa6N9p8v/zLu33klg
-1f9TlTia48pRQXUUMAxHgNk3TN+UzhyzdMZBT31azV/WL/91vY+dYgqQ3UXEicZgkzChlPxZzck8lRUy
-jHp/3AitaED20Q238S283D4FSRoDDPyBSExjh4EzAYW4nR6oyu6soy+F/ML71JiK0CCGtfXmfWSDg2H3
-mNX8TpzQMbJ77c3ylNMlOyAEpvjKuSokuj5ML2Hb8rmlC5lhi2Fl+VBUml5PucuOtyV8HbUeyJetNE6N
-a9sdCoGLvIThdQWqDoHQOpU17C2Y26b7Hwij6M2IsB/wkDs5QDdpuPVjn2PdefAM6LWrgIiLRvCaKsJd
-uSrvhUN67kqraKeVyjeW7BH3wuePA7/wVDiMDKbGRc/Rl6iikhiCfITYZj7X3ARZXRxQcGWBbaAiC80W
-pa7ZuOJuDA6jZ71xtmyxPVpWeBcJt1Ci7naBHlV8dkxO4AgPNt/ZgEhZ7pl1RTqMJ2KNL4+ek46gyZLe
-VgxnIPGmNf0vyTr3KVUN6VT3itQ7Lqn5164K5r6TfnftWKTJRj9bu+plNVYWGpw5NWXVp7f9cfYq6SaY
-S7dFNth5LF7gq3+rVz3SppnSGcHyjlnZfuaxgb70RuYlh20rtTiSAo6WvRmEuTHIQAeih/h28x6+9seI
-UjwnFkBbN6dNbbO/YGDneMoebGLO8WIvGF9jnWTrgqU67aVOeoqY5qd58bNsY/CK/4zE/eQHiaaO5uUs
-+xAZA7JUuTgUIjaJnu3Dvn2oRO7CTQhvWFiNt4lEpM2nyUhOY21giP8tkyC7YMWZeJz+QdL0umBk8Prq
-m8WClSTKdci2klT46UlZObGZVFN8nKDtdJvtjCib8W8YrWxIkuJiSDwPiStbqpSkfXapqfl5RVICch9D
-tAhg/nspIbl7xINmvSxQemR3hE8Oa7nON8NOzcNCFTibBAKrBzzFcv+kK7qcT0y6PqCc8ptZZxdDcxSz
-uuNfbxyeAdQgNBapDZBTuYOMiwEgF32J1ouEGK4dyzACscHq5tc3+6v/qOG/txbb2ywV2TMh52jKLXwv
-YswRSjBIup4U71PDTm/gPkoDVZR6NQBLw3sJXYEcj0+n/aijfsS8PXRxpSSiOZrEaLgotInPR7Wx9KKf
-VqGEv7sTtiXB//BL6NQPluQQ25IJPAFSsen9gPytWBN9i6ckAIRn5zsdGxL/nFUpW8WaChMrrdIvZdtP
-m+jjrFnnsto2TwcJDCviWMMlHaid1NP9LjXrXaizaaO39XXVB5zR4bRHTjJP7z9d8TqEqA1uGdycpO7K
-nDQW/xHU1c2b38hlgetx4xt7cYIjjWZspOVayq9ZtFU74718Ai8vp6nBlIXkV6FyUEPoLzYWfS4N20S1
-rEWq0LKq2lppVy6nVMspw1PtzxmdLuVt+MVC74uF3v8OC70LPYbn1RIc5+QWmq4tH7XuUraBfNpZcSS2
-R8Gj224aQzW6ahaDFCAnG+AAFHcl+QGUqvlcP/MWc5gqZwelFvjHowKes75ozaeLrWmei2kaVSHUNVZP
-+8iKZqb8Qmxbo4KntC0SCxUdjJ5pnZpfhvliUx9sO2dASJX1V1BDF0aq6E9TRxYMKshfwTdaQ/pGf5lv
-sqzwTf5K2weJ1WFgGYTeWuPhPTTqS9kG0de6IZ6aVcYCrFMHRoGLrXRMf2KD6HUjjnNXkyP3AMjIO/xm
-GhicPAMfoJyTn2SebvTCiEusPLRWqHGJaH6kUfyVNSbWZpIJr8wu5axikr/TT85cP3dDk6RaGZbOtzz0
-YOTu+Pe04hWoSTfhNpGG/n4gbegb29p4yE1tujdY+7tObCVUW54FZ/gmOi58nMRSpXFc7tHXOhSL0zvN
-G5c9nbC8XTPmOFn72OeNV2As/USu+ZCC/LCsBisOVbQY5B7S7GfKLe9X6DZsAAYhtKlIv6yxpD7176Wo
-OAJB6sAFHyZzDLgNo6p6P5+wJUa2KFqf0LCwPCOObQKNMaYyzIicdp0lr6OhlwA5H5dA1WX5EcW5xzyO
-1khXRk4OvtyNnzedhdS/7dTWSABEFZLazVlilJyoliweTkLVkWwnphBkNE0mPUuBZDsKF92A5iPxeweZ
-YGGC/G79r5+ww9N3S8BVeCpVpzUgBNjHQ+d6qlQqZld1wT+xtAUQ634Pkm8O/ns55YyUEnnaGXpTooSc
-QjnXe4AJAWUCRmMwSy6aRKzV/EXB6Z6aoblnLfrXNrSGDbg/lEuMDC8CBtTCTMp2EO7lXH4QTQttGsgS
-g8CFTQ9En+fGv+3AIxBQAZ91Cue/W7j0jHKolj0n6IydHVQSMr2grv0s7bCYMviXDGtEXaeGpURJYljE
-vnv5RzgstmOUgS11nN3GU34rxKoWwTmyN2z20t1bT9NougRj3c6YnlHeMicYRjCfVRi4h/ODu2RttMz0
-fOLKCWrmXNtL3lmNxcSZSjjiLAZL2nOZJczrTJnplgCMzpOcRFSzmrhUFjTiYbGHGQXckJdZA9g/JNAK
-zqGwT06WOmC6ruM5rc2kLDCchZgvLLkKvFmHJfDC073Dk+glf2nXSagMfaNd9j1O4REBwogaJyw9wEj8
-DM4saF9+3DeuIVr2xHpOEHqXhiJPUXCSWj3YzPC4zOuiDnx6akMdLKaszVi7fF6BlwpjjtGGCwl22/1l
-FbRhJB6tSho8pm17BsAnegQ3XYkHIjp4d/NuNxhQ35mpZ3V/wunqVAYBOB8HKP35tu1dMgka2SoecduA
-yBqzfrpRemhpL35xfI4u6pakzpUMfJ12RWG4FokxJ9WqwvZqdc/+emeGABr8hBXhasECz0hVdsmtZeDu
-5gudr5vdB4Tg2ejYjMnWXLidjBi8bnp2GG+Tm0BY5sw2xH0ZW23IlkpcVPRM9Epc3Tz8e8uQZy4pe2QP
-3lHZRjwWYxmnlm1mcxfOlfJmCYHPRlpA2BbsVFlOw+ssB4T20i802WzFIxIrnq14LJ+akDrrpDhwshE7
-h8TxFEmKP6V3mluzqTZpwUGlMF+NI8SBYn65/FeJESOHhVlPMk0kN8ptQH5rzM6jgXMVUNaOgnOCBXP4
-p3KXyGiwIaZyA0qKqxrXoJ+4BL7Mi7FcgYiztEDFWsvgNfzEpIXrPrpE1oLD7UDoQ+ksGv7GvLRTGB1x
-rArkiscRJd4JTrg1Ognw0yDlrdk4cXYW3kX27GlEnpFLziM6CcvOJOmGaa9DCj8PmhKqxpnHkwvEghBx
-6oYi+nd9HXxdR1G5Nz2FBszD64iDOAO0X2ANH9r+xGrNt1G1aBW0Lw5LIwxqOO8mjFRPsaWL6SchLGsS
-U0vLk5TJYzOXgF2wohSRAiisyHtiGVrKjk5xXEOry4fGEl9GP+3osE+eleZMt9lpr12SmKAq/9jVoZxO
-Y7x61X7ADGfDapmVC4b+GZZO4S29dkp5X2TZQopclbcsBPcMtVu5KiQTB5gjUCPz6ZPG4bUdoQylAWcx
-o2xWDM9Lyvf2iol062SZCqIpwSQnOP2HwQFHpWRjQwDv8F18J61DwZiBAb/8v5fyFLOinv+XSVJKfelU
-2gGvjWI821zlevPaBzfUpngL3UhauD9qqonM9IAyWN8va7ZNv1zwubslqMuwYKq1/43nPiDPNht0vv6m
-jUfT1sudvmsiNIsZm5R0/Qlu8f6SFXDVBtNoPgO0RjA8jBj5KjD9wpsAH7yLGP9zZGhwi0OHZHlZ2hyS
-9XPTIXmxIUe7fZT9IqwnGoag3dStpYKN/hlCb0mgzJ8orBNTvRp7LotDZaYqhaFkmGH+YTKEY8r1SK9O
-+vbiwwv807hbRWXNML8cQIPisRNIlUG7UJD0RJE8ylspYng1jmpFERpVt1+YAAvIRWrfrrg16K7jHSi0
-LLXhn/iVuGqA5VSCHrBWklr1ITyjw23MiDirpq6+MCr75QivmterB5pOtQt1HQ3Il+DpvlbD0H1rnIMj
-uBSXUr0OMMnnGV0Jpd6iwsZOfIqOZxLw1egDvLVpLomwWIqHaewkqGC0l5RubG+vmg5FTEw0RKhz8JH1
-eUhRyDi2yyDv8fgFf14g5BoRM2VUjc6ReEdjIHzK8Df2n/Q5DB19QopmStDDixF40DY/d8NIE17lXNuZ
-idK5jmd7Gqqdg+/oq3aITictN7arKLRJ5zw3ITfMWsSrtcySQOet69H49rkWw/r9ptYERV3e62dlRRfB
-qRV+Kkejx0A1zuwL3fiYCOanrsbO1MjNUa9Nt2dlkC3B/2IdWyP+X2PE98th64D1Wzfw0n47t+i5BSaj
-8Bag/LGL2OiZmrpGmDzRk6/juwxqmN7aWtpA44m3hjc+fnBiYso4fdWx1c4y+2jMvsP2vQjewM7CnfX2
-LsIq/tHq9TNTkmsai3bb7wA7Ks3Tgg5WN7+62f/NgRlvLRXg9XeM6KKqKMRYTiSooZgonscK+UsS6emj
-eTD3NnUUO6Z6IXXgwpgw27sA9gJhYeg8mogwqfgrRNUhHPH/YvsbMg51xVtBgkdn9FPW2zT2bifHAXZ6
-PRvExQMlC5+yXuOZdkJoSk3TtADrDPLuJ99204A57QeDQ+kXTGhh2BdegN8r4ksskQidX+H8R3FapGSp
-sBHF7B5D5hYLfHBSo1jCVUgGM6ZFjoeIpecfJrdaygNMjDmcmqNeeDPskCN3T1d23uFym/MMltfxXMON
-vZW06HyDlSaLxxp4vAv7sNwgmUgPR8ll5xumtkmMc5sSzgKZflTAuSTE6dIEIj7JremmZ/od3mQoOJN4
-/IqDwjgGjJYuEiJoQeOFLyAimrboLbeWjAFuYqve/LotQYWNvB2+YfaLd3MSkVLouKSiR/PYRQ4hxiwH
-xYehe/9lTZSIBjEvTaQhDvAZFD5A2Y9on1DYu1eQ8QsGJnDmsy59iHOJYO2DxPeTgEODMLOP70Jc/2BX
-A6+TyPiayuChms7gralp8ZQ4F++dzeweR6emsaAIkc8EATbd+bXpKVyqQos8m0pOGyxnttU3NWlwaCSB
-18mUhuXlFc0Bq491YircmIRs23efPn8pWRSTXU/9ofC9L/Lfoa66HPyk1d2nt6BHjmWfhSkmE+DD0PNN
-iCRIDEZtRIVKe3lSLTYuEdkHzH++ZwUSWquvCCgIsdDwFSnQmydkH6mIkj75wZoNsoJLl6+OwUQGGV5r
-KSpc2xTPmJWABhWDtfBrwFEg3pilCJSRHF3Ahllwmr0o3hfFbGcwaFQSqCCsnUBjBmoW8W12vednH5gI
-7JGvdDcyUfArlRLNxhYCTcgS6offIz//bvNckYPa4lM1CWOuLjhYzzGrUfvp+pcfKxKyL3OszpDG/2uO
-FA3KH6kbf6oj1aAL/BlT4sCiOKAPrmEqE8Wq8JuY26USaSiTuicKu3Sc0fUUDfFIDDK1bRxSyrvMsmuE
-y94A27lboLwUnU2YAV4Q3+/ZDy++xyChULfT7pPruRfXjXfOlaAEQw/0+c6zR9v3dgCufFsImqLDI9EB
-4DmJhoV9VH2wsTnbpvH0Gc6imnRaiL9oOdU19dZFk0c0t8fTboN1lsfU61GtUHARC/aYEzBhlMujknhj
-aw2vtSVJGwwVoy5JMpLaC8MfclvJzGOkWXSKUkIoQ50nZVQq6u1nPDuknl3yb+Bl8SY3v+AFhcWLFmJA
-x25RBT1C7p5LkhFetJcVxrzZMPhhF4ir91sLu4QjsmKxlU4oxD5xP1prgJFc9O+TxcZzZ4z1NDXq0wWc
-S3ozBVu1nrbVzVuY3ejWUoGVzziVo+P8RFUvLhAn5rNyrisHzDDso6LHsiZJ13SvrnlZvawmn+N46i62
-rv1iwWgwDocdLhrS84vj+hfH9f9HHdfflmOgGku6On6G9XyXTlIfPVWKKf2tM9Ksc+4Daaa9PSIa+UYf
-JeNu+qC/wLoZ3L9pUVNcI3LeLUoyngKagfRp6NPpxkdJKTLyXI3HQlumo/drmrmkuHxNumaAwXDtNnzK
-jApnk048Gfb4xfGHeJOPcD/Tw8c2acgV+nOsdmooL+d9NYvrBv32LY3h7Vs0RXAVtuimC1on7yPvKEVJ
-0vdg9XK2b4Jj7dOti7BNPSdIRcIZ7/AKsc/VxuDq9cHGAPN01tWHkTqbYGxt0vtTiLCsLpAXUUW+ZJhm
-t7rqeFxMV0kUtcqmhKvasRAxPgJQIvCPytm4xU9TNLadhljsLbX96z2ddBSTIGzaXRxKIKxslX1xLxol
-ztMUcQ00DB6dCGAXp7sXEAGZv2XdsO9+Ax6PJnDQj5sErtesX2pxLrfKJ/pnpbChTqfV5irsnYyvWHEU
-DLmZrTBupqnhKLAOPsYXjTv17/AYf0Gq/0ZItV1HHSmpXXiU711kjTg0mXwJardFMHM0+rfWpPy7ELHn
-7Bufj7L3mH4N9uVbqYaUPLbnS1632XKfYbR9OXuKtnX4vZ4Dz8cGvdZHEvmF8YloI0bFh2Jk8pTH7pIe
-198PHUYCVO5lcq40sKpfjL5t1RB5U18JlK0eHOdB1KFH51vTmyDG+0GqMkSLWsXpNh6O90Zzdu30Jsf5
-bvWhEBxCtBzbxmGia0RNxwUlN0K8t/PiK5PXFOvlo/KfJBeA2prs+gAu9XwXCOaj9d18txjJ/5Z1PS/q
-9VtXv1lZuR/kQzNHHZeI1dFeG89aa3xQ2MLYBFTD0Hf4QR+N5EdRPAXfYJeteh9vXTAmEYcQ2SrDcmDs
-vUqzfNFOMBcdaAT7PsLbV1fdDwEKZdfwQVoqltu/6YP0hTv8wh1+4Q7/FxAy7SzWhcLULUUXrRg7kEfF
-/oyyhe1gkjOTBE1PGydBA6JkRup1CvZ+GoB4jBqVshi2Qbh0iQsHR8Us/6+CvEm4IB/Ngt97s+koKKAs
-p1AS2LZ/i2Z+31lzQ7R60fgQfCTQdOLb/LusGBVHLjYJptaq2VaDVWUI7ScOnJLP4ugjfba5RpNCwCxk
-QLTKX1YFgZPnQpctrxEUJ4gQsu0d172ntnnvCKP21DTkAYWjJ0PGPm7z/AiZ9pNqzp6zobsYjczbOzJF
-KeHD6sPq+FlVz1YlQNj6BH7U65v4n4f3V/Vdvv0JK52ufydQyPGe1Dzs12q7MyFdKpOPTJfIDOQRfwxH
-gfrP258+ZTyCzWz16rXrq9npaeY6j3zrVGXK+4O07ITexxHGHArCnHt/fwTjnbveUS1e4sFFh0cw8AvW
-3uTLzQsmJLKYly4v5cAGZxDHWOWPlWhgj0vJMbhiIL0wSQZbRBjpjIoZV51iWKbq2Gt89VE3Nfaw6XOK
-eN+MocekiyQ+7Nm6oVmu1JARBHBa8BWgnEst2LDXyJrkVN2UgNoOAvAjPR0ehTlSmX4CjsI6OOleYjHE
-w0nAt+WfNLOJQPSSBnthoju/SmigZH7qNTRF1ukv4aULeBFzBSbcc63lrUOLJHrEdIerqGedlrtzH7Cp
-YmYL3eYEZy/2UJauW2RlrUkNP+/kHXI/1woQHlhqCfqS4kVTNSjsBszorfFcqIQFg0dNXk33vJ25vA5a
-FOrybEv4+NYCMLYYJRPQbq8XG777oJYEKppN7Etkbe5bt8Z02IKp4lEtc5C86etnPEjnEwmzdyPz3cbh
-2HdnfY4PKTOobgJfGO9ebFwItIrZdOuGTDhRahkMb6w4FSNG0VPMfr6YnYwoN3rLmeC6NdUS9tO2jE+B
-9YaQebe4PmRJOTlehzbp+J9OIISDHVjnDBG6LOObgZwGtY8FSdE1a8qUDBQ8DLPKVoHFerqPf3ZfN2RR
-8Xje9CJQE/FRMOCIm8Fv/2CHgNQncxzSFdxJjbIKuDVICK4ay9ARJxySnJniTotpEx+kpBTrHKkJbv2/
-HKX/i1ji30ws8a/VrwSBZ767gFoF0fRucVCOKQa/cOSsZ+nUQXhhUo23a1zQzh2fAE26Fap+fk99zJMo
-7NVZCpkwWM9ipjOo+/upZKKAmgmdTFDjT66UCcb6Z9HKhIP6bWqZeDMuqJf56qKBVr48ZF8esn/xQ3b2
-06R+COdS+4sBLz9TLhBZFHJMDQJc4Htrh+W80c56Bpz32RnZWaTa74f8tYck3jcpqv7MKF+H2cD2MU73
-2B49JRJfKNiki+WmXvAhR+WBpJA/PgyEtykqrR6iZqo59VQQGH4O8eOQfAOC3GGL0T+i+ouGmPh3QPVf
-VPBfVPBfVPD/C0iExSr484b/+x04Z6f4jg0Oa5eIJ469gxpxFzXWReQ+KEyekMsUWkStDk34AsrdLkpU
-iYlHYSWmU4rgh4gip2jtCHpaFD4lw4C16ewRidApdu0/5lSXQuxw9NWC1Ox1gTwvABkWk9nhGkcygLHu
-l2QIr0RRVYcJgazrJveHzHdZc2zpPmV+keAeQjlQh2wBUORDDmTHMT8qCjJPEWwue+1+7kK8RzYGbkVd
-FmYKFyZrIb1hhiZyD5wO0ReLxntCzc1wbIQIuKf7xZTC++bkD6BHvNBVmHKSKhRC/J8XPw+o1c+PH62N
-yvfQ38l4ln/MSiIvYRvxPiqkD2U9J9onO6yOdVVwKKO8BCp1Tj4FOeVzG5XsvTYIwiq5IIuvNYSQGB84
-s4LtyeT0Ox+rKLBNGFUH5diYIDzC36xSb6kOv8L6UNDegGIqmfoUBMpU/5avkvx+I2GQ+H8l/tN0Pu6q
-/7pH19+bDIcMirUEoo35Vr6vfwevW7XHoTd2q+GJRlriAEv49/ca1gPJAB57fFdFu+fkAUTHck9yDDUd
-zmWbEkwORC5LIh19Z9SnNjzWZLI4MBb3txlHn9Bll3eva6NXfTssPzAdBTvhD9DqdytBKN5geOvBx2/X
-AYQpaQlURSYjmEnAZSOC68CeLuibLKEPNS6F5CCSBcwFbQzt0lnWKiVeW2jUsYyALSFY+73NOpyQ7Uy7
-Dqn5G9xSfJBFeaQx8r+cO8YvuCmCutyDTbAoMqzJjIeEh0kX1ek34/1RJNhWPZ4RHf4bKPJ+q/BwMY8I
-G4afUiFibb3dqhoRK/k5pIbLSAuNgLBNJ9YIX/jVUhGhvvCWX3jLL7zlF97y35O3bDjQ2lB1wbff2SCc
-hrrz4O2T7cc7ULHz9u3U4O23bzuhDDygN70cXFWw3iItoFdVIglAiAs17AhzUDFZ5pnjc1Jn0nAZIu37
-MOnTH0irSc/Lk2yuwevYDBEXWc5Lu7GbrdW0djMmrtjXfWTJNxM2cPQBLvNVNfhqNWtrxLxuGVp7bOww
-ZjWtgVukrsQU3K9f68F9syCdTCNU9hKDkZjav+NoMHz5D+OjZRbHVF1uRHRiW4eQbNMyhjT8vfACutFE
-FpO1BEp1DbdndIScZehzd6q6PROUb6HdrW/Tftwt3LNNO6li+4bxVXSZ5FU217Jn6dpdpdqMIedUuM+W
-qLHUfNGChdaWAi3GVm7aPtC+xFon7PysaUzK9qUYUy9pVUpGpXrOvZmoGvUtF1bYtxODv6UC/DbNTANz
-UF76HeZEJR7eoaJNP9vPaYt76B4pnkDL5rv4U8Avfss06/p3mqYYbY1IlvftvRcvvDX/f8IEJuuiQh5S
-0hWqhfsYzl4HcSe5Dm6lo8gUrpzS97lf7p2mf71sMwm0w5MAhj3GNAn+XFbh34tNl0G32t3KG+n5aiAw
-dwPb2/7K8ia6YZfmrb5Avws4db8TLQx7eEZWN2/dOpcZ61dLBWY09BzsF4bCSUd3TrD3iylqiZqTJpDl
-40U1O58lsdOiwIWL4hYuDCpoQGjguHo9XVthfZ/Xh9pNCEFDTNbrto5rxwEgz2waVvOxtveBcTyz46ia
-tn5B7PJZjcNarm2UosY0DT65zfZ53WqMz03H7p5K6IP2C6u6w1YvgBB91DaBa5ip7ssXc3kJneOZsc7N
-HoeVovU5X4z1xxRpNqhGRcsYX1FSmPmEUEdyZNFHtyaaUC5aEy22mtWXJsSnC7GKjzOKPxhfm+Ce93ce
-bP/w6OXbR0/vbb98+PQJdOBxw53wakm65VRHcajHM/t7ce/500eP3t7d+X77x4dPn0fdtmCHTSH7VoyL
-Zm0EUuI8oe8mFAlHraJFfVDJXascBxXVx4d/A6lVPz0eO6GdbdlD0Q339dqWvyGZk4cRfmxNABekpTsN
-J0cnyyn1RC1EmZHENWsM75v+jeX/zaGasfi/fVh3m3WtmZ/N5j2a0hymbI0XDnVFyUSfAj2TqiYr+laz
-mjQFsmVnXM+nmtQUc35pdg1RecEhwHTopAvNhiWrVDjbHvv4hULifjYi9NPIyc7SY/h7K7typfRErqsY
-NELRseU9/Jq+dpV0b3XlzZd4nZh4bEzZJBBE6tfkEDSkrz3N6e2EoxdWc9/MAeAopb3wOOVDJuPrlxWO
-W364wzX2ZbxgjqnbWmlbeDlQixZd2UKu6pfaB/m22RGtPs4M6LWv+cbk6EbH/wVJuqdOvT6k3PsduUGq
-hZMeW/sJE0ZHo95zbBEs16KltTXDVeZQsnaLqMFDdcxkueNze/mfuLWSI2PrLJ940eWpdEBTB4Zv5/a0
-CMbjsA39twXBEnKVCrhmL0jXF0Bw2PEN55eUKpP44/nxJt2zaBXlhphUF8kxy/fGkKl5y4j/EX1besDy
-jqbTP/vX08SGYLsmzWebbUsNhEKG20x6OWEv85mEbTFxWU2WM5QrOadEyYKEauPEg5ZOOJD3RTHhaO3I
-Q6Eq3IRnxvr1yXhP7TKeShZpcRVCFyGp7QJxaKCNNRWV+3/KWg17RFLIQ0DqC27hqBj9h9VcIXuchxmk
-A7IFBfQrzYzK+E991HHxPuTlCJUl/Wx1fTUjY/bjsi54CFEmPx5CI751YiRpSqZlOM7fqzkmVkaFgwL2
-jBJGuH/bXr6j6dY1lAwLQjLKFlFr821KSnOO5pSMgkzllGqvZV/JeI2FSnhYya4OJvyhmK6hWVpWeEZS
-gtFE0f/r8mgyOuHorplPFkZpXskITAbjkmeTKZ71aUskP7MJhAxrMw0TYWsS9Nvur19/1byfHGYjYGlc
-455p+UnOtcuKnZ261Lwk6S6G97yt1O3sNVpw0deR5wH1YrsiGEdMoGuzRm5JbRx9MCAimttB0qx5p1qC
-JMWLRukEdheWNeDkVAxLOvmyniC56lVdQq1nVn3rp4vKW96pTs+uAmK+kPHt6teeg+ZzVHjzhrCRi+LO
-kpLuJc9gYG5wFtUM4FwOnjy9v/N258mPPCTYRJQEEnFhDHtQepOLlttkyBhz8lR7vuEA7+VYHRXFnSBp
-MdxhMRJ6X0xmikZ9NmOT2E/SsdqMAJ40CqaigxlgksqaZDoaukWmsedTwP6tjrK+9jOzuELnoIOHTc6C
-wADPYUoXtGmdE+V+gJeQZBv0gHAzzR5h5bzC09IEv3/5+JFLvLH97OEg3k4ceiyXAZL3UsQYY5AFc2Qi
-IYu7eXzbW6wFaU3x0I7yE3GL5/oaASVO+Izp557PyQ9X1e76gTNePYtviNWkpGt0rW6DWNC4RphTovFZ
-km3ZbDiJWuaq8j+XBMdPYVTk0+3R6LngMT/y4EMwYDE8bZ3bVlzTkL8OtwQVvJHum63GEF1IGkoyOBzW
-LvNhxYFh5KK5FC9MLzljVEnw2K2LIgtQes+lH5IYMpkn6c1KuDJhmuK9ix8KqdXzpscL5Wta36RXOoNn
-a66qbRws6QDz8ohyP/igOa96Z6z3c86/49Zck/h9xjWXFD+NdQ/KE2uvxzA6qI3D1di/5vFjRNI9azGe
-FVPgHzDXL9vkKr3r8yZm+QGGOZoFixSQ+Lo2Hqp7Bz418pBNJOGqsDDwMHAGKOx84AI0EZVYonmxB8rj
-Q7XSLjrzD4vmulMVs970O8qa7vgZEkkN0LGBhVPRYaJWZx4ln8d0t65GSPA7co+vyDA+UATfg+C1cAll
-KY6eySCamuP7IkrQq0XtmUPxH4uyVKOO//CqO9ErxlGSOQCcXoStOSflrDKIt5njJ9CeV5aiYVnyHSzd
-TFz217PqTZCLzVMIDC8G5dPBB44pgYgEJhHAlDmonM8uxOlKfDj8suBZZkW3WaoxnnfRmnMWTxES2KSr
-yxwcDaIolwBI/ny/GJlAFSxUxJhReDVzisfo4YjHC8/e3To+aBc6Wd+THUFwsjhI2zInS/WZHNyt5URu
-xUsdkkyW4b2Tdf6CtCfB3gwOb8u6+jfbZWTCBWCPrNJzXc4ejPJzzFHDeeCheF+patyaKi5YOhvNzyxf
-GOTvMy7heSgt8k2mdyerK6Wo/KoPq6ImYh6mRqIjmapNZOgoc3nAugFyTOIC1wRXt1H/d9hFHlqwj3GK
-cWl2xl7KHH8qAxxrShfvZGOtzt7Ps7BFYj1QQl5W85rnta/udk7Q4p9Sspgt91kuphCVkUN7eDT0xoQ3
-VcbZPjlWphPR+AXyzZ9UM+AyXnqOkDxI4LTvva/d8mLewpJlcifVfGr92dj+OBiR7pMwXlnNtsx9PLMn
-zPopbiSLfqJG3O4oK3gwz6fD8C4L9VLLucfFm2MHygWirJlnm8Eaw8J9Xx0XH9A2iaRYJLO0iDtUlnc5
-GGTJaHpfE4aiTDbm/spxyFV6oCF7iazlTV1FDh6q+ceJ8b7MgSsu05T4jI9KWjro2QNV5p1NcXC9p8Wo
-hN2WjXL7xEqP5j04qO7mGLbNG/BRQYPRk3lqbtPvsquWmSesHq1arwVTVJOQ+wvkzB53uD9VIqIiAjdA
-PNfscDN062ATu8qpPfTK6fhRMiowey1ZfEjiRicgG6j8Ed0wnM6iJd3tsiIfb+gvRv4/jLnvYUJ2eSnj
-jjZJUmN7jl4Km3vbutMY64Bwb8LQukFa3hZYLohJeo/bkKKBRsE77W+XVt+URYjzjCcoPFjN14eXllba
-buuOpjFuTXbMOdhprwBPwVWTq1zCZZjBTauPKRgwkHEoHmOhtX9YeZOpdIsBwfHk3MmTabVLd7Walgfl
-OJ+pj7dJcH9YVe75iueiJ4kzwzdiQYefu6w+SXCiKgqVGgOmdeUHCkLPwV+GqfljJhNQGeL1OkoIHex6
-z3CYqmEwUPFBKMclZvgdoG/qboWAHY1APg1zejr03erULDQ2cFE8T6bVAOOpvoFxT06jX2Gs+FnyjXwY
-7lbf+42zU/0QCfKjclzUElBYxBHslP6hGmGO390THzIvwV7royn2FPOJ4QbYwZ4XmnuUnEbyWRQj9WFO
-rvYN2MOinuHBc+pc5h3oeRtWx9aLv0o3IJ94C3k08pQMCo3oBOMgcL7vYboomtJIAWryCstTdKZ2+cnD
-Gp5ADxpZbNSgZBiWe1qz7wpFGUR//0H2qHxf4D6yxiYcyMsqMQxDPyw3nlmVOgN38QQm5o0LmRwFqx7F
-dYtOPjpuaXyqkGIy+WSzl6gLrfHMMKoBxDIG6nB00udIBwhVcwq4rOZ6NQbsyO0h80iO81JUXNEdm6Mk
-3fTSJB0UbRi84zAJoxC2qjyfCDZklsiChRgmusIRR6/mfUR3sPEpd6lWFzEP5cChdQz+gcJ6C+GshxHw
-N5DFh3wY4IZcWrFvxPNiD84ix6JgbasmTkYWv8in6IFdYyEGusQ7ZVtjMsoS1S37aBfiqUig3hhZLUhD
-H8wOJuWWoZEAnDJQ97wEEUccJ7wWQPFuHIktoXvWndxtq0muceVLzvrlSeUidHIwEVp/lOIMssc5RtNA
-wyOXhkOiTXBmDaj0HZHwx0zeCa/BuLTDUjwnywvWRIasjhk6A1GXhgfNCfr5qAmmBsLAC/ftqSRbMH8u
-6adoYuPKJFlydVkk6pW2vi5qMt0oWFjaPgpv9+Uqt4/CmV25uu2jQDyrZjyziv/aapy059aYKrw2HkB2
-26zsYL8coTa7xeQnvHTZJWdP6FflYjaFltk4DaWFOj3S42ovFx2nn+nnGaf7u0n0BitsD0bLlJKMFe71
-LNC5ocexR8Z8qxyicJIRjj3P5XLrzGhTyjzfS+KoBYYGDeODQT0qURQVn7hszSyCnkID3agcsV7X0mj2
-gIcj6C9iAvjsF8oumJc5YAPlXET2BQNE2G7RhOr1IHpb/LRkqBWXSERA28yB6DT9m0nNqmBKuuvhMTPG
-lgvnlZ1ztP2otUbLoBMTfeGXaFP+G39VRdGmw2CiOIrqqbmKwQUNSOwDZ+Yf1RBvN7cowefT9ut3FvcD
-XP10Vjc0ZxGjM5XFpDeg51kbMSBj07Yki7Ef8jJesFCrgUe3HAAdnnutlDFosrYevUhqqjRiyeMkophC
-au8Vhi9KW0DtFpkE7g7oVUEXl51AjLWGNZy7wcFAPwcKAA3PBMWhMC2lc51bKwUMIaWTCOQvTrF0SSA7
-U4i+mk2QIHU0LfLhCcJR9yQHo2kfFF2h8EIA7nzBO5tEGI7gMkII+RKqxFKSfjLfNLjC0aWmYbsNRQS9
-DW2FNzoYLQm+2hoG16b5UmXulIU4Rf9rjbfcGgYw/UWMaLtLy8jYmvvhBEb5cMiSkUdw7IoxBh1r/9YN
-ViSUrIRbGJ+47Ha7bBNw/t2qmtWwthNjOKscv0arDQAvUPrLp0ChIZUXSH3cpK2rMDuFGwdT2w/c0onp
-BH+e09AmKSqFqR1VH4rWTUl9XnZfErvSIv2FVVAwoV+7s+9LmBmMnC1XAlwcD8oCDb+lQIcWkskOaHLi
-rZ7wxVc/dnpC6M9YKTlNsT4NHa7UAvaS/3rtASbMn9riD9iD0xaiwAO2gQnMoBt0oh0LWi7phyVV9LES
-kV7RK7GBrrRa9ytv7ksU88NepMSmNqQZFxmgKsQStsQLh8oUV8twx2HiscSQxy4D2DI2M2JSJSZHMgcU
-hHCcmtB0YfHImcJLjptDG7SPGr9/tjHzWi836v9mqjMxaAms0DZm6uQcpknTaX7SOmKxqlswzoYZW/yp
-faQhujjrIHtNtbWV8tpyZ7vCsW/3TMo8M3DNB2cG7FPELdLcL28OpYrJisRoKfnjqUWMzoXHLgoZKCHG
-jL2T7Ln03klQkR10VGSFjGboIGQOR9OygFded+Co/AiE0Wb2OvCzfiNfXSjETTfr1rwoAdhGiAQPwCPh
-M6MzuOpnRGiwPUexlH57lKWr/bBY4y5qCA6NseT7f8ixLYhODfu3X5r9147GFXo3hBxEG3pe7BXoeIYB
-U9oiEtk6cVgieFZ5FI1Oewt6lRhHy4dAEpKKqb8QcCOmbTKAi1oRCqCYXNlw59pes5ZIK6yLchFnfPic
-nvPDdjdEhICtdrgrjCVURawWvA2z3KhCI/bKlPzmGtFArGsPxmVhxohCmDlv+bfi8dHp9TheSODcv7p5
-8xpGDJEbDT+v408SQ8KPG/jD4TgouEUFjQChonCC31+b/BI3v8EfAcpY3by1gYWehocSGkFL3Az4TCM6
-KyYG1KPBLjQ0X938iipF9upQTNNIR82Ar19HX0PhAlT4JqwQ2a+sbn69EVYIWUv4TomYIl8PKIbR6v6t
-bl77qjUbYSpuCUZz2WgPz2q/iKYFwVLY1hvLxH8JQr18feNCoV7OHQrxPLFhbJq3KNqHI/bXbSVtGKdt
-S7cMai0OzmFaxXE6En04gMaHD0Meumvn47Y9IZtd9/76qLIrBiWL33/4G6iBzg9j9I4d+9hxhHcC/2Ab
-MIK6sDETXGEqaAR5bIdYmgXetz2w11rxjaZ/1YJ+ONwwRhrDMZKaHRaMRfZdVG1wBHcnPwiCTwdO0wZh
-iDuvDfKGw/bOjnF4NQIaBhBTTH6oMZrkCTg00eizzBoNjdlTSyoMYj3/VlT/UYFKzbb6iPK2VuR1khdE
-2m61TduhSX0BJQo4j5XkY/xOlvWP+agcxtVCv2/qnRRCHAZWQ9njT12uSTNcHX4Oolj3XUtd6hXvTzkw
-5zG6GwzIe7cJ6MYdCeARXW5RQeTLTmVCJ9hq3dbTw06FAfwwY2N7B2EWyPP0EBvTJYC73GJnwE01XTye
-fpx3ILP5o10y5zNcwaLaWysuPMOiqAGGaRXRUtqbhtKh2P441Qg0Y5uqhkZC0rX0o6ShlAclSnHaR/WD
-zy0H53dwMNgMMjh8Wjz5vjqruFfktPHQrYn2xiVViMRui1c3SgDCNhHrq8tlAkEz6FVtgz/WN/F/Hw5N
-+x+g4DTMD2FBzPL6vYLAv+v1TfxPAOIlFJxGOSbcguB2QtNqWJmms0ogB0lGgrwh8W9avrSTfGLZ/OHV
-N2FqVeYrmlpEGwzgDd3JyQxSd9bfDSqzF4TjK99eiJK5kTpUspMEl215NiQQrp7JMTTnKQEFQ/K9hVYC
-evur6HNwHeB73NxzCV9tLB+PcBGdevOC6QX+8KiBirxSMtjYvksEb8fleEiWwIA1Pvazk+w0iusATO5P
-VCeyuZJnu+kz753g0D6srfvQSz44WHxkP27K2AboC//z0/39uiBjdpdFR/+QwyuRGR4V+zMWkpwEEF4t
-DeFlNVkhSUrqZLcsB5/p38Qp4Um7dYGTJh8GF+JDtDFmpm9thB9t5QsyLtr8XMyLa7Swr0YfLHtMpkyL
-m5nsaUFnvnESqFn5iwTIdG0vHCTTL+dFA2UqhIsGy9T2L4t6dlZrW4euuzb+XJFK3WTkDsMtPgtYomow
-tLOCW7r7AE0PErP35fFqRyeZioKuzQOa5Nptha2oVTsiCBvbejGMBVc8BBJlAo/Gn44hGk7BpZFNzP03
-x1ONgLa2Do7QdB51AwW+FhMvkSTTbzWU3GwKJ3GDgVa5eh4Z48KDCpVuno9uQiwOxV//JnKqiT41vXsL
-WuVETuFaQ9n1pYWo/2ppaRqFwVealjsZUHAdX/CvLkgrtgswV4y+kAQjtEISjDUIVihKYKOJSwpUpGUo
-qGkE4WqCT3YPnWwjT4yKSfyv++BiuA7Q+eWka1v30rRVNAZDUxEBj8v79QWXd1Es7TODaafegeAh4IxL
-I7HwUX6N0zDVbPgTWPDQF+70Jcmr/D4A3Vzun4gDHLmyOfGYk7+kHSQih9crt7OrLlATW2tmt4W2ZuNX
-e5+aplRUEbvcpP9dUXM2P03Hfnqmc+RsoLgj/c0aI9uhuuslQ6kWFNT1xSh3hmAmEMHCYTtxG1miQRfT
-7Vl3o8ehx9Y7kcRKzdxOA5jqDAr1JcpDwJ7IHtrxVmMEIJvmNyyYhna9vo4Gss4nk1Oxj+1xQL6MPc6g
-ForO1Y/7EAApDI1vmz3k4H3OM5PcislFz/mU743KvfdqqndX3bExnNh8NsOtgP+SLxYPZlRX2W4h1r4P
-qimww0OpirKno3w8z0cKAn6Vkzlr+QbZiyr7O2b5OpgXdZ11JtWkw5YQdAjn020/ydvhDcBKdg1sQK3g
-ToRQAP00bsPTZz70q8tE5ehT8jCEZamzd8IdOnM9XN93PpZ2RNZTlLKGmWUYvioy9Yuvg7k7JFpx330I
-5/sSauvuzoOnz3fkAuHeob4EB2jDNQcHzAT+vWTRjon9C59kzjDWnQ9wax5FI8R/LVW6Hd99px+ceYnO
-4M06YrNaBTmbAb4gqN1ONW6FF9uHZDEe9fapnJiFr2fT2DJQ6CdsMds3CM+nwXSxg82oYS8xIqNnbSKD
-s5tiwAmKRqS0kdweHuxZO5So9Rk2yUD9bZsUxOvWXcJzb3bFh2HRHQhwQOqlY4ipu5vddsF49EgQJm/G
-SzlHl893nj3avreT7lXBxeVqdAjvR/yJU5lj6Mq/mOfFLU9gpzwxZspnLMzTZzxCpQLIhcMAPq/ppzoF
-FXvVsPjh+cOuIqifKHBHh8NdJneBXB41vkU561AcH/duYR51PCOzLAeuBl4J5ze6lj0A2mq/+sgBRSbT
-Yo27ByCX0psO1Qf1ZFTCSf1Lp/f66htSOHfMvGcVh5G24Y+kKJ5r51uL8b/riIIzmSDH1nQJckKecKGt
-CdKy3/w/QcueSbq2058hx/bHkKBhnwup0Gr8rJqwXVrxIVBU08+BGKsBEgf2mZK3DhM6aiL4KOhLVnxE
-l5QC4yPBzebmBIqiEf1U7P5XOSP3uWAl0/TNGbRNEK3HkDQNaeMfQNX8jhSJLiM9TbpbF6RGkrD+11Mi
-S2xLkgz5TTsTkSAX25kLkR8yAL04+J0xwCfjMYqZADudIBZnkvnGD4uvMjLu5yVXoiFKlaVGuXAwQujE
-xIglKD4PJXFuYunCz3mI7c540cPKF3zUv9644KO+UA/UkiLt7Ld80RORiDM+4FhPmpggp0wG544glw0r
-pvguN2KT34yCk8uz1FRhfTrHZTWByi9C/rfR9CnK/MzLsJimOf8RjlZm8RFuhGlPHuGUpBxP7tULntwo
-Y3bgd6zGk32bqr4nCecvuc9B1DdTsdeWW14MDCjkYi55WDBopOu6wzn+3RB9ZPosSBGOPfhgQHEG/lk+
-hQ305q5RmibOyMSmZC5H05UrPUopP8XIU5T31BmjUnomUzzweeahRrocw7zRQ2a/2+TzSoPQknYoD20H
-r6Sv3rNNNY+9ayamMMHcT9zMTcv3xYn9zQvshKJuRc3+0cLNKrGzZNf/Z7qUYrMnn3vNxTeAbPZ+00aS
-7Vu4i6CEQ9jSoZsamIwfSKVu7wIGPCsmuQucWD6hj6shPLgNW5lE8ogjqtlMgdGJaP1RgMgp+gHqVyj8
-BoYURQiASUZsdTfIfqgLQPSUQOIy+/SMUDRrYixIPmEOvoBR34ppGJaSiAnB22SRyGFVD4uz88eYNP0N
-I4NubMfpfkfZSQJZTTI9f+RCL6Quu9Sw0sA9DMQ/2kQVYdtExnyPvhckzE86z9oU4t4PL5FKWvF/SweN
-58E8ENGyss0jCpb87K9knVV+OLJU1uUQAuVd7v0ZEy2H4/QogYh0RGfxtdtqbyJv/DlbAQmQbtF8ihuL
-GliHNQy/vr725fH98vh+eXwv+vj+AWLRlhcYIxFV4w/FmGJdU5J+CfKJngMuir1AZXNX8ypGpnPtb6Kt
-2HVRuBc9irZF8CQeurnqXyZYH9WIpb/uw1uO66gcBF070TI0Hlbbf/SsaiYtee/gsG8mvDpSUa8Tj2dq
-TC0PaXr4rrtIiHM7WC5FMq3DsMKVtv5bRORctyEmx38sz6GBpOXjtDdWRk7jc45s6cSy4S63JJgNKwH6
-NH6/Vm6eXI6G2LhlSc4WL8dnsiFkbhlBSszaMoilJLKJuxENbJFsdqF01sdsS8wDp9sy7oZEJLzhiSQX
-Z9xiX2U5uWXLwhNx1brWTRGM5Y0Sdy7klJR2i+NOcYxo4Fea8fMtzNeJDrK17OobxyUsvw5WZJreu2rS
-tnVBBKpw12xc+M+0YWITkxzk52Nyzlri34MBss/MAi7HVnM8ToNsj2qdWwK80Lvj6+sXJPKt7exiC2ZP
-pRyWB4dro/+fvbfva+PIEoX/Hn+KtnczkhwhjDOZiYWdXAw4YccYX4OT3YewViM10EFSa9WSMZPhuz/n
-rapOVVdLAjvJ3Hvt3+4EdVWdej913k/2Phs6+gQujWTsINKFGyMuF4o242A2CGA6J1ECewEiRYuOiuVF
-MaPo6QYMek+lszlJCpwLogn7NjcZ945dEszkpG3j61nfwrcURy5ujoXRbEbpGMXUknCnzYlXyINyUCzo
-VnvD/aD8oh/SAWLTWAnz8VTK179tO++g02JAMW4fGvz8MHGj9aXZKWX76s/h1yh5AFipLNCHEWjdrmkW
-HaB8qmilbz3whPt89iv/92bJREInMeDw0HRQnFklpo3U46w6e2e07CbaKyc9uoaBTikovHFeR3LDhbqe
-JuW8f4FAUskgsc7R48kLkyPhm1ULsj23SXhgIp7QwCgNN7ALWTroVL1Spy4uC1e3eYRl5lO3/IuWd309
-6XQ6erGUM5w197Y7OHSWBF5cz9qUvI5JFlxmg5o+C4LyKb2GsYW8MQa9dspe2mVRHkhgLBmiB6tr/7rn
-/Dndwqirec939KwJDWMXxPhFhHb+iPdWivMR4D0Xci/MTEvLtv7wfjJLL4GYJc9rgJZNx/k/KAzSw5hU
-ad/UUH+dDovT9VEKJ2q6/nJve/fV4e6d259lhAjXMH9Gf1aum9fvl5JBir0u3p30fZEPJJ/VOEOvkfcZ
-3xz2LCyT1xeAbmEHI+PAHEmzde2jbYRkX3/zV+pqXY7IPMWDw34pwMWjJfDWuQQYxNPZnKcd6C/7cHDW
-bGyNB1Mc1eNOg5Pvr1G6pFiVv3Qe2Tpk5q8r7Ren+TBLDtMzePscqKDW9gVsWtZgs+xqsbcMtlagG3N5
-/91J9VXjCLZhNfgkl/ErxI90cODoYOMpvosXsGPfCxb1ACtGTMGfcAfgbxfpH779KSpu83yVDRF8mpkA
-yEz1Uvr6qTNEQmLgTzC7P8nKqN7js/Z78ZILGXFUCXi9Txzrn0wo9E3+E4i4Uv7E4HNuttwUscmfLCOK
-vW1swn+euiw4lgMtSYIHS0HX+pmrcVwC9finP2FPbrT4qyldYYMW9fSnmKAQqwZyQqj5p1lxjCXA3CL9
-jzDsb+zuxltEzDOKnLUciJWcdasYU3lHApVIVBujTvz5oPvN3xD2Sm5E8FS9LNJBwgWl0bMgpYxvs9dX
-aT4bidfrFDPSeHUm+IkGhKB3sv6Qs/4AkhnDbTNiOfmZSFaK6hT5ntoeu25M/BhRP10egVnQB9I9LAA5
-dtnW8IHU0yt5/kRXhIKK+TOd46dbzZQGPsiG+SinqHuNPwuvMuDAfF/zL4oP8hIrdZPHj+x0OeymfN94
-9OgRTfue7aFDc/8R2Z7Sk+HDMrQNcdVKJFE6RQs6/SVxGb7ZPUbw12wqNrEmmo8dtgXV8cdEGHZvTGqc
-6+Q7h0aSbk0Lw1uH4qV8SGz0dOYLlfKhyJScOMQM2dRH8bxfWJRS5l6Gk2fu0fguKMOibqz+l+IIJZIG
-FH0jaO9RMf9gWY850CbbKzURXgtxQ6PhhudCfEfChwtChCYVQJIbAtYJRqBzUJh29CLUtsNhw2RaLS98
-N09JMKJTVgWxsmBErXCyRH1K2gZ/LDfVDu7D0qwKFFcRynDZYEYhaP0rsn4BgOMT1LgAzdo0H1vmA79m
-ceA399Q8nH/kZvTiHYjjpBc+Jc2BqIcuqvePloMqRLLJSF923jfuzk6LAjvhluVFfjazXjvVK42dcAPk
-Go5PGroTrqrzqPAX3CNZnOgUo9NSkqHKhpD4YZilQOXT2HFEx49OZFANDq0Nn/B/fGEaVjhpwC2lIj7A
-G+0kqIhXFj/5V5/uL1/+MtvD4CJmCG3Anq1IZX5h6JrCFaFPm96Vv5+Xr9JXTSpBqtU7NrTOSKq6qQY1
-vE6WVky+BWQYL3nqYre5t8KerPr9la/HBAXvxer7uyLeQuh2Wh/Xw4rX7u9MzLlLR1rO+iuH6KZy07xb
-Rtkq0frkHJa6fzEfX5b39AOZkTZx/b+bx//98/HPJycPW+vuATUBktabPx+b8p9PWuvnzjvv+2wmhi8I
-yoEus/MRw+YSIIayPqFH7dmHXjOceBNgjJBULt5nU1QKZ8Z32+JvYzORZ6VbgWVIXoZxvHHSWr5QwBWV
-F2o22AOQAtkH4HvUqgnFbc4iKX1dN6oXorBJ46DKw05fFsWErArm5xc2ejPmYDZ5kAtJoY1h1ubwNJET
-7EU+YzrLDYtJe/p5dYEsZ7PpNoEAuz1gNpR4JMBXSI84ykhyC9hZAJUSII7VF92ajq7/fPzPn0/WMXdL
-o1V5IRcsVOUKsbcwWWM1SCCbjVJEAUDKkaNtOgAO8ALY2/ecIGaYnc3uhRsV3yVE31/ib0HOUrkjKPJL
-RN/+/pk4AFG8gIAraCHOHywhb9muYMpPSAN4UTt481XCG9jvImizjRQbrPfWxJe68Wbl4m6av1Q6ugoJ
-jVo/7i1ShH0z44LDTkzE9jfZ+e6HSZUcb8HrWIXSVQtsv4ajmbB+OhzJRHw5GhzBvOF1gIU+8InRZ1ef
-pCp0XRbvQtXQ/bjPfmchDxJ2GOFRIp0Gtbr+8VRFitKaZaPJAT2x4ckxu/ddeMqZNwsObBcbbkYIOHt3
-AQZ6eSGeJ8RG2BS1BSWcxYmRvQv2ryBdLeaQMcuNjPJdvoyjnu1i7kTkHD4xBYPhhYk81fw8yziIEo9R
-ALwIfPRH2fQ8Q7q9LYCj+ITrYvyYtE9UfmtTSQXmHBL8ySMUAqzkMvkHCAHoiL+eAtL58D2q9VHiqrIM
-JMkpym2zmY6OjykCoX47CcgatTJcA1Ex0P/qhXCWH4CsAXffHaw8AYztoz3Am5als7t0UH3SAiKwVKIq
-B56Oi+njnFcz23IL7L0TBsU+n5+dZVM6PFU+Cbkip0KucDsUOh8qKjvInSAxmoazd3iwFNQzJdb1YTQa
-3hVQaiLTTr0g4QNnqxg0uKDKaVEgMR99AY+zsThj2aDcsqv07j/DUxGpgqt7UuFq3xuplcQojU/Kf5KD
-8TCICmRo/PcqIiwWI0FptDoelAYeKsQZVMIaVQg5O3P+o8p52wNuZRfRE+3dqPiZX5WJu9OQ6vDBikPx
-sbnZy2WkH13zkPRbSIuxWe+qdJg7cN5jrumsSsvNKNsTO9PIUDT41fZuYXj5w5MeXH9KToBr+6KYjtKZ
-Y7E8kooLUX8VEFXhi6MPqG4ZyBx0ZwHyasiDQtoytTuLoUojWGjXng3B4OloLBAv+eBs48oaRc6hR6NE
-V+NYQT/Z/FdAK3KoiE5b6WYSxbXgEvpXj+D+UuTjpmMx6kipJys5qlZIqVsphkw8RdoHoxP0+T+rUowQ
-0bV7wa2WbYW6sdwAtoLvbQ17KPcXqz1zTVaVbZnJEs3rTbKqP3UiLfctQBTcqI5W4GYeFgqn7j9foogN
-XzD+LMIIHsqKDw23POZGJ8mClKErTYhLayckxYCP+S+rE6gMenFv0UXRgtr7fg0B7x0TO5bo8XYqb9V1
-DUvnDd6e9Es+6ZcVju4S1dh0zi8XcHSXAUdH77E70IhXwrS0spekW4lvs1Pm+Ju8/IBIS+92NFVZm4Eu
-ISrMdno3TYIDBfIku1ez6XX1WtkoBI6qRX2pE9x9SVK7pGExbNLHJFVJM3ZJLfd/449MeNkqvQPI25dz
-fQKSglCQt2RnzOieldqxA8/CsCgu5xMptFpS9/Jxbluqc7+iHjXWYND0mCudVPplTMIAay5dSEiT5J3X
-Kxtoisu7FMFTvAz3V5e3BvknwT87EjsTaLhAyRiujm2/yv0PSI5PKMpR+tPgTCrigg5jjI6oPmpGhhme
-6MAmqyIqN1w3e45QA9YcirqDoaLkIeiO+flYd45EVvdiESO8WBjs+Rbpudy/3xRtqnFQ0+9EUORGvEId
-vgLalOjJSrEXTGaaYnI9zc8vZsnjRxtfrcH/fN1OXgD2Ao7/so3uumS9ugWrQtVKmBE5d1urWQryISQE
-x1dDH/B+NkYHblwullU+P9xZK2fXw8wUwjGFUnEBRkBiNJmcoQLGeH6jUpRDRqMNHqWZcN3NplnWSbbI
-74dchtNhcj5NxxQtBOpOUgoNJwNHH+JTv9vk9dbR7qujQ6/PEqPH2D7NNP8XnMP3+SAr92klk635rHhR
-9OflPqYZpRp4ViixUynuiWvo287ueRFD/DNs/YofHSNIBLrafn5gfBf9rkR0aCNk7+SDfT9zpZfJyKWC
-7KQGjrv3trOmcQrbOdin31qRfxOPEOKPyzIGbgLdxxuP8UCu5I9cfyA/n8cVzuPzDNB+tjeezGcU6ur1
-cH5+t3NJzcmVNh17Roawt37ZA7H+4w7hjKVkHRxro0ptq0hWk6BlLO+JtH4hxuVEenHalDAXAkCoq2Wg
-HF6PYYlhSVQFGq8PprZaBY7bgBoIroK93/CAHpz51emTrbD7aufd33f/a/tgZ/cQiZon7WTjq3by+G/t
-5KvHJ5tkqJCetsUJo53slv12cggPdMaDO9p6c2QAQPvHj58YXyBKsxOZOwbojK2+yw/Eb1MjbKsMo++Z
-4RvXkX3Gdex+gLhpaQ9JQ7dlZ3j5wDgsAF3JxYPfOeQJxho9vcwxq/kZ+lumCQaSR78QjGjSw7TJtDU9
-DqIoTk18RSkWyaxAIHwPh9eoyUDMAzV7p+7q9dijaW+XIeYaYs7O22mJcLhfZONd9G6OcNpRO3NkRnXr
-fcGW4YZw0X1vzfhTXr6GycyKZuueROvYG8MknnzZJoMJCuTdx4SuaFLRd1suASc55jfiqkE6S8nmfZhn
-A4QjkcPH5Jegm2aA9HhdMFb4KWK7fjHFxe0k/5FO0jHFBxlkBSDQyUXeR2AlHmn0xFKZNJPmz/OvHj16
-1KJ86ezSCYAGsGMCb3jNSwrLG0EHOzji1ReWI9c279fcHJUPSpbX//1t8k3l29NnycZGC+DK4vM7eDDJ
-pimVPcalwaApZWJ31W0qr70NDXAG55PirEHNhI6f7FEHsAMc24IEe3h0S+3+tK5zN5izQDeMpGg0lmfG
-4YF+InUgxC2viRWgU13NfgoVqyt0MDpQ1YvJVLWmel7lZosM9nhR7lE+vBsxU3+9tb37fOvNO8FwXz3e
-DL7/sPUmeSam4x20zN++SKfbSOh4bQ3KmhWTl+juSNk3oaH/7nW8Yr4wuxx6FbXxcODzKZ++Emg0MkWy
-QWswNmHJB5I2xvSAa60QiVH3Ti5SQD7A2eTwSJOrFeYHVdrg0/np6TAbdPkVaf5ajJ9rKIhsb6xzfz+d
-oF9RTe1tLjWNmPyzdunoIjju59j3sYDzlgEXRd+Hsc1zX6n29wwlz2VZW8Hivdoar9FRigpP7sko+17n
-H7GA2wGgpWvoN/j4ZXw+nE9rZ776Gu8UV+O77wFUeDupLQWWA7AidFC7B0BnTT/mGG9XQN1mH6jJ77cT
-1N2/7l68JY//T7MZBtZtdoPb/H7bwf39C+0HMdBIpqPlDkbFIz/nq6zxPuOIe5xJFv2+7fNcCPuHRA9Z
-VtKjAXtHlP3fTTUXO93L12k7SQ3xZQFbcjQtSyBDyI30FKMJwYkZwTg6lo3OS8OUDGwMfhNWHymNMukZ
-qD0TfByJMxQkCjCK+toEugojA0yugeLNhkAVrAEl1k6yWb/TooYJJvA8p4gbmGcHpZ5kggo0CjybWZVI
-MfPf5m6aPMldF1rdI050cac/mw6hORJr+nM6nEW+jrJZ+ne0TRLSBLMOSHsg5KQNDBSX6X06JNahSLaG
-s++nFP/Akv1qdeHf/eiIAGJ1RC1L6pgdptTpw3Rm6Wo4dAmHZ7AR4GEQwsjQsbRSA7RiTH5l9fCNd3ap
-3Fi1Mu12U8neGlK82LCpofDil1c56TmqJYgqymw5Mu3K7ZIBOVqpE74xm6tBxad8FZhQb0WIgglXAcpV
-NxkV2H3cQaod4xvYiMinQHmvcb4j9EIfonhpfMlCJt5O9KjNzwBNEoeKUDQ/BtgBYJzPx9+tuuFSLvut
-j593HsQA7Ca8hnEBy3Tm7Xw7WXw7dV3iCOowtrmE+pIArtkm7h/aeZIOe21qVxuX2K5wOtPLLEmykEsF
-nkUt8R+4sHAyly3r7e8dPWXmDANyE3zKGibGvhRXAwY5Tfb2d5mp7PiHvqklVFYVGNkl6+q/6IrJZqtB
-7X6YoIIHuzfbjcKHFKYKv4EnHXckDxoq09Mx8Ln49jkAJAiG4hG6OZA8I/uQo4oQZjXMKTyJN5/YAbsf
-HrBlkyAaorugjiUUFlVCmkcvhfCZIuyAs1HmGATSpMDuo1BkSOFQYLmCeTmzigGn9wyQlwqD4O7N90WB
-EYCJH0uO4LhSfjiSPXu4h2Q/7/MUnrptisJCQ6UYvxRWAO9TDyv1HDc8KSjdmwv12xvAe5sPeybdIzus
-5ERFIMXyHgop6KVQR3xbuXLbSNCw0Rjd+2ZCxKTJZJiyNLwivaJH2ki5oNUFLh1FpEmH+JRTnpX+LHxB
-l93r7wQxVF5QFDhhflK1RlXiha0TcSVQYKoOI3/ELVIaaVPTF7okDVxsiSCKFSo5FPBjByvxltsSltDe
-KIpVh0fDW6hX0aJTswswMriCIsfkRgqLWQmwOV+LMCkQsyjM47gsPV8KQ8n1bOudg30mSMi4Q0FhAxuU
-yrr0d1Z5xgqdMVzvzsKhEJC9nQT+D9a753/3BxI5GMkrJtMksQGfu45/XEyzrXqdREADy8EMa+GT6r0S
-+jcbj1W+7O3gN32U3TG0BM2mfDD7vUMHR05iXB5qTpyFkTxbjYikA+kMSe9Xj5HWcd6JDHFKUD26pXTm
-jT+yOzzUsUVZSIremEW+b6sFV9mL6IQ162XdpqU4t5pLrS80KjRnBfJ8rC4EQByUjF5PDhhjYBifU7hY
-4jqpIZl4AGXH7lVkKxFXqcV4tnAX3LZFUUudwg9V26+R1FI749nWuS1dZSiwMyrcJJ7/2gOK//SVQY/S
-SuWOPAxNlYjInrZ7+iaiFLsWQbhJ+ies7ZPZ6s77t54oZjlCeszq0OyNydaGHntjSyz42T4EFECTWFAS
-YJjhWrxnjh8ctBHaw2VMbFuqAFCsMLbhDPfQLPgs7ZuUpfwm8qr6eCnx80HxW4QvruzBCi+xM2NTLe9X
-nHG8Qbiam/4G7gZa8E7a789HRPwcXRWvUSS3k5cTXg5JEad0LPRBczOf5tW842NlOkUMwn8H6o6ZJJ71
-dKMOlE8RcYdaEXGRTstPz+osFAasfB5WIfcxpNxDc/sfEjnKi1rRMzMlb8naNjGo5wWQnnnJ0SovMwcI
-6VuyOclGneSH4gpFh22XXiO1dCsOsuvEh6dsZdBRQxqLPpwcdnAkuG3CFSBxnbqWlUE7MMxtlFpmRyxj
-bhJB57PkIeaAfsippPmiy9FyYGCieHsTwh7wI5+Vdix2GCaYI64Ax3eULNQODiagcpN034mIpTDqluNR
-gfmQOeycE5dDUfEm630MKZfPRyYaHpPK3+WDZ199/fXGo69iXZhQfMJzQDvmR8RRV4te0xKxyrjQK+sg
-OfGsORVuSuvyF6IzeG77FwF7QN821bvElYh19fScVVNclyFbmaJGpM3aNN8k/dBqVjG4jV8Tq9hTHK0g
-K2IxzDEqjUx6MGD5NJZysHs7f6xchuyRoUkF9t4Z54xVp9n20Rb5t+ScMXYOw2mWDq6NQN6BgpOczvzd
-ZJErUkanyH/lo1E2yDEpzjVagXGAQ45O6MCgph6NPc4RHcxLBIbnrzSmICYm6ukQjixyLGQS4jZUZu1t
-KCq3gYYKN2vVTTbWxghZFi8QD8C4X+DFI4WCoDIlXW5LkjZknQOJg6NM3dOFoGI53oxYgQUBIdJpG4bH
-2OelEyQYprjg5hGCdZuTJV/UImsVIfjv+nRagkmGTwkfVn42Dal724eTr8VVRi+PkKLDa0O3wzCawOG3
-6FjP6XfqBgoLDHtVFm2Gg14RTkTCV5jAoPWNu8u0mF7mP5Hu3FtGPGOpHKjlQmL/fXdW3Hfg0ahlJZ0C
-oZtViPdaLmSaDTNAjLEZby5ia/S99a8r3+TojbsTkUSGFV0Pg6bwrpYzK2vrY1ToJD2bkXLRIMO2pPcp
-xBKMxMRKAIuj7SSvHaASI4Bj2G28+bAqhPXUWTZ2S3F8cic6bKtE2uE92k0aLSYan6EmM3ii+T+oQx17
-KkkHi7KKk9maKCiTreQsuxKBbD7MMdhSN0IorCU9epR7+MT3HvWg4RQXjkPe7JZ99tNE1eiy1sw0waCz
-gahcBxmTXTLmGioiQVu3btIgLWXyZTLooZDwdQHPzQUZMNpsbZRILte3GZGUBkT4SvS9p/mYY0wj7qjT
-5FrGUEMxBnE8+hcvRLvsvbaIHWlSJMzvbTx61EM541V67c3sFZBVnt0l7RSd2o9YUKu+9eaZl7rn0wyx
-JRqLdmh9e9ujwZfbsMGvapaiLQulgSwf+7qiBiq0H9IB9xdrxyNkQcwurgI6EE2sfCUXsl0LjDK/Y2ec
-boTAQwBxDUaM3tiV5ymNmmnjsfLeW9hwtI8hDVOuKB4EpamSwtPbMm/yaUmM/wtl2j41VpVmh24Mn1qa
-7YhcJ7X2razt8yjv/V0EFBXxkwJ2B7LNPuf8HmvMyvIDxjwOrYwjL6k8twwF/U7QqsdQYPdpgIukylEB
-pNrNOtFj2VF367ZySF+0ZgieTy1K26YsA4CLk55nD2tdAQoWU94TmUF3ff3q6qpz9VWnmJ6vH71ZR0+l
-9Z921uC+rtHM1r5ao0GW6L/01cbGo6/X/43pijVC75a0cR5L3NWEvHbwjbA4SJnPRwQxD5VKFBoyt9mW
-kPlttiLnZ2Zvt6N74geWqDhCbMXYkE49qt4LzVp7/BDybmKxODzIJHyWBVUIk6zfGAg1AlzsaDLM8Iln
-XS/q6A0PaPPEEN/nvX/Wrt2whthubrLQnlE0DiI4TpHuxNiFyAXTsvRnc1JcnGbZmOUIlJsGsAwzLSwc
-y/q4KnMYg15cIQXQGsdfYnsgYCxjTJFUEVvwEMhhQg/A3E1OOyLdi/O/QdX2LLhTgKKzhOWqrObH1yob
-5TMS1/WURqJnfTFmMB0aNQYHZYqEkqxcEL8XU9wSQYWQT4GHD3ZStibSlbMs47zrcQ+0RNKvO4TQVX9T
-DnOh0T/u1Uw+7bu5cDhLX87kbm9nol/PhzfkSWhQG24YCj59ZUppWpUZPDVq2X+Yn3bkSWVMREQb0Y7e
-Z+U16uPmGq3RLTC4/5gYm94FWmv+Vx1B3SjqR1IdDf6zxsoLaI3ffAz03xMhUWNetfFrZL1r/ZP/oLvx
-6C/tmJMlFDxp1/lQduFJai9yj4QKG+2Fjo/djW++btc4NkIZ9c1ejN3HX32NXsArJSz77Jb+cW7A24eH
-JpZw1MHXrO/hoYoJLaoEdPGbAAakcIAls71iZgZjmI9RKwPDfzD58MBh/bx8CwWYBv4VtTOuTMWHF8MM
-mGBUF7Tdh++nxXzivvaL4XwEhAb5r5uPZ17DM2515X95TYfxfeZ/PbwATH3pf3slSZHUV6ACfspw9d23
-YT7GTLOjif/ph6Aa3K9+PrtWH6YDjN3pfk4u0nHpPlzlmHzI/f7HHhpHqt9FMTK/mDQ//PH7tWnGRnEq
-bHeCh2F4EPYPO1tcZjspEPdnZ2U2C0tqGvyUDygTLHxk14S4Ulli+cGlHhTTNU7TBk+PiTCYnXdFixhr
-jAIUvlro88a1Z2i5ziz+fCqZw9SbZ5q6ZtKXSU3U45+9tiwNyq2h1nAbqWXqAuHxmI5ifSmOk0EB1VuN
-QRqGNkXdKRJ5W7Pmo1ZnVrydQN/YZ7Nlol7PT3nwzQ3P6PiQ8z+pGQmNiIJHID0wXjqJm/Kxm+rpNaEO
-dBvMpqP5jAZfCvbgzTCVFf1lvqCHeIOXoNFOGqMS/3e/+Af+56Bxwo4onHdQxY9JUOrXRqEGsa2Icd6n
-YzhyKVKGwLZgvJ8J6aNL4CW+Sc4LJmRTStSfc76XjGq1rbNIjuorshEpXdhizg/Iqj2KTAEvaNG5p4PZ
-hFil1YFB7Kb9C5ttDzOOT3i37FJE6pgYryQwC4AeV08AAaV0BdW6BYcowjx3N8pRd78ozfYqjGo8x+FF
-meF+Dgpc7w7VY1gUvpWQLKwmAjKawWF+mUl91kziwYf7PZ2hTlJ3ArwBq4y8ro1fAG7SKbC2l+hK1DY4
-fiq070A2/CozfsAyWLKqZiMDZlYGOTwwwMqoPjrJIdCbwgufzs/Lzi//M8/gVUL1OdACl9lsfePxV998
-La5EWfKXNQ4d1ugPi/5lQ4+X5jsC2gkzQUz44MMJIsy6doWIKgHqFjMcIDCStDlzZ+QHARawRiMY1fZ8
-mhfzcnjdpknKy35VTC/pjOlWCMtYSwAA6tXdJbvc5jHd/TBBbMLhROmBA7LpfIqPtvGbc1/2Rum5em90
-0WshouKlbyQ8cqxsuxgW8tLcE7+5U3l9pH/6pTB7W30+xJWofq4F+ryYzfBl0qD5W00HXFjTDRfWdvYy
-O5v5XeGXmo6wqKYbLKrt5A2/5LoX+lTTDZXV9ENltR0dFRO/G/hQ0wmU1HRxhHLzSgdIuRjY+HelMX78
-kRMFh59Dikcg5P/wAMSIHq75Ih3lw2s3HqIb8K4oklPuRog8u5UvRInU37HuosI426RG4SJhrZQrtKmD
-kRcY5eIzH/Ip+RCSQVoK5rbRiPzTpULkqIKPjygkMVeAkoT7QLcKXaL9tpVi0+0Ak7ECji6p5EcJkala
-RiqYthfXk4tsDBtR02m13LQcZaOCRoNU5wEsod+wUmzaXaXTMWe3UrXlo10KuQh6UBWAjswqTTWPeq4O
-XVUUGoreWvz4Yliksy2KMVMgD9nolyV9a6wSKsgZtgDNg8fJ5l2VuDUk6BOIGNgTEEs6HbTQEs91L7qQ
-ShChXRZcM/HWsWDQBsWGAzQ0Znwy7mvDKiexqwdIecxpER+QkZ6seycbv++8OtjZfbf76kc7OSDliadB
-sXcD8YgEuHHhd9hAyzICYmBB6V0n+SzF0zsQXc5pOviROIlsYPfndTrDqMsJJbX6rntFPMQ/R8U//lm0
-jrfW/r+T9U1hUhlVSXx47gZJV5SvpzkZj2ajHJh7ygwt3bnD/xOQYYemgup08+fy4b9zH+akqtGVNpJz
-UGgzXUqyFFP8gzmAA32O7akd2wMrppJBd2FqJq7/5z9XxnWMJSrYbSRDVryJZ0252mFIvjMX2EgryWfP
-iBwbb8dy8sl7QzEl18kXQCDv5APKvD7KANt/UX7XMA2JRZe/K4iOp06lmKaGzN/pHHvL/Txyov6fWm8R
-k1g2/q7rj38vEDdQsaRAXLopNZcu3BcTODm+PXzBwv2RFv4GcdVjKlt5i7xGv8EmHfob4aOtn+N4q5M0
-ki8NgCMgrh58AWTpF+UDGzYruncE20aAXor1KKdb3R7W6sWwQ7/k4Q33TF/X1f5DzzmPYYUtp5Nl092u
-NVrJt17Y5hqkyje14ke14IXpzLJyxs186DEcUg9+8dpyJzxJv5eadt6yKPcdLc9zxCyJXgZZSl4IJKPx
-hepOmBClhY1e1G7yYQZsG6JdtFYfpZMJwqVsYoFoCftFOhm9CMbIr3EVNjYUYAnsXJ/MGvbT6eV88qJg
-CUDZ/PWKWdHG40ePJh8a7eRCuL1HHJEH2z7gOlRjU8ofbT5Q8N/anM5ymSiFw/mYvQYLseqm1A6s6oTx
-A+sxGpGdSplkaWm87h9Kks2SnD0sxfLD0f7LtawEwoViz5DRgfUtcSp04xMQ3BSjdOWVkbKqa7ZcluhK
-Kc0oQ2kpV7LSbNZAZWFySent85ePE7+xydRAHyvJHy15rG30wqTKNxbVSE+G42CgxxbKiXYEuQWp6S6K
-wx5ubG3VacUkkLMt2jHdryauUiv35bMKn6FZiS+TRldlEfMbRliqmiEinM1G1a3Sxd13gDH2jzF6age3
-c1aKUBwnhls9ggObT4bm+rHTFJkPJGw1zVXFFIbNaFM5i41G0sxGk9m1mP632mI8r53oAvrBWByTmDZ2
-6NHsgVmVGxrH0iuBZx9g0TrFzv2YzF8jp1/eE5os/fhDLsBvcaz19amc7sqNW+UURiHae8JEMgXBJPbQ
-uyqKiq7ylYuvXgWOGoUB96NOwHETS9adGaGbL2DrLJDORdAPj83C8jMloMYz1G1c8/uBzPxVYVUidO5n
-pByAF8YpK3xgzpwHXgikwtC0L5+pSi4NhFVtHOrzWjNQs46RVmFGfy+rQzWdSWQ/dOubCqoC8mOZzNMR
-Fij9bHGKBBQGtm2OyndyNRqtltiSaBFa98lXS81FqhIwpBHaNRIuKPtrOy7C6j5+TEVVIVX38Vd/ayuB
-VPfxX75pPzBDh59/+xsKdr/+LNj9owS7YjL0v+cZshs1Uls2Bd4epmUQDF4VWGEj/A2ko19PlMFcZESX
-+fg96zb8uvbzA6WW3TLaPXrYJ2U2HxRrbLfIBwXxw0U2nCSXWTZBwwR0Sztz+KOEpyKfsVchKx7REoaf
-cfIL4jC/GBnaxgIX0t5z6Tb0undurDVsmfTUkvQItaGAwPhGoYe39QnlmNcz8nikfA2k5mV2lJ2Ne972
-KKPsljXU/V992pU3gDpnlDjiDfq7UokalRoUF0k+Nt+EwutNAkbTwN71paRUzmtSAq959mHmCgC78TY3
-/cHbxC/tgFPaHf8PVkA+qa8cE8kZ631xafanR9t1DVe4x043VBYjnMx8bhy8vVUhWSDW20EmCCiG/4sh
-09G5vWeA94hdNM3dyhr7TJ6eosNMw7aBbV6m6lqHX1TOpsryBx+qVQ0UTl9kfrZi4KSKDC9CQPOCokXz
-0MxwkLhxkmcVxphjKpvKOzYIqdlXMVdPxFa8evfwBqeKBuB4iLCFzOIN0L5lTrEY/YNQ2QS745G0Kqwr
-ql3zTVepbq1VZBHTyhEbK8u8LN5zhqtuyzjdE5GWdo/4mzNPbeznJQdgGZC9BOM/GTO8CvY20HY0pF2L
-0qKbrlfp0WV5rUcO0ROqC8PsXRgZLeycs1sN4T9ffulxEKbecX7CpJHpRCfkMoRXdUrJI+ud68/MFd1U
-j/wbOJfCNZrzJQd74dHD4xzL5rNo7WpXLhiS99hQBrfSep137AjgtZc0U3XDoDE2jWMSqfIU5A7QJfgT
-Hs+jwsfqrSgdq2vcgn71iQSgUomo1CQGfCNy0pEIQHrGCMq/fjZO/h1oxwtkE5RVey396Fu5r56DyPg+
-xDIQmbI/MmsRUV0cDDjoT5dUsgrtvq/04pdZWjov6fehVUr5lHJQagnr0vlAMgUYtgvLTculuYtmt8uk
-Ec2L0adz8zHh6wXA8pj1VPG3DlRPndQXw2W/rC2lhGu1pYuTZqwQ935BWPtDCtyem0Wiaiq4vYvtsrcL
-7Es+Kq0CBE42usHYk8WPU6Vkb6e+zEjcFhXjba1EFz3c3T7aO3jVlYA+QIXT6J0DpWJnmOnCOJYOUTVh
-1qNIzGr83EEBqJPgHe6+hM5sutVmpMreq9dvjyg4KxXOTCyTBiLThov0zmmzslIN5Dmdahfu/xZWMWis
-2+P5b8vsMZoBbsZpNiyu8Mmv6SzEGM0Gr1+DFL8mG1JzUZ4ulROpEyZDcjO2mzBKx/N0aBx49U5Eo+RW
-fJKXuSP31e0Ljl+NI/JdPY7vGYdt7jLREk75JKgKzbAbg+QXjK95mo+Z2RexJzJMHC0Dw22SgY+EKEF4
-ZAwNVc8Lir+CKRToJXnOroU08F30F82mneQwx4RZJAx1kajIBJwXfXgtfpHWrFz1xOGpqD8M+cMsHLuH
-IjQhqKhVir4QGHFB5C0CvEM1ufpzXCtRBo4zbIluuRx5ZMxeNdxNCYiYxcHEOb5nrg6PMrzY0/n4nviz
-k4KQvQs+YA6waUZkVJNzZ2FPQdt0NkuJ8yEfhj6mvJoysMwIQYxhPIZYNnnfxCObvJRbneQnsSgnUpX9
-i4shxw3TwuhSUryhKbpzXckpQuov5uSTEplHSecCBy2OFAzOxlci3paSc/A0kTXmxFOidMKQfvT8y8LI
-IbLh+wCYXQik8WAhnU0/xsQ7h7HMT8me/0wo2/UpHiwTEu9vj77B/dRkS+eUeUn52QSgdP72xrTb7cRc
-DH3dg0pyeeh6h+6sfOTYb1W59QfV5CDSV5FL+T2WGEb2Jzl/8F4pFLO3+41NsD8Tb04eS/iEuZTw1SfM
-tKyUdvjMMTZrFGNBpe16nFcZejGpHzmPlNRrXre+Jy7zbOHIMMLhbUdWXRXDddY963ousETGYdafysc4
-IrslWCFcGHUZuCmHAI25ph02Xxs+hNUt+J2G7mXxJXNMdy7gGDgrClRIA1FEeBWNRK8ylkXCi0GPDQl5
-SkyNYECNcnJHS5NTIGFN5JBilF0UVxyCaMkZ3JRKi++Yvx7VtaBld7Y+y1cECW5ng7t0hDc6nU6FRMz9
-EBvG0dV5OGv26vbk2N7uk6Q/TIFCprgM4iFoXxX7BMwAdj6kSsAcn59j8KgZSaTlAYeHihUU8OSZ+JFs
-hEMRVHX6RzxaNROoknjU8NNQeE8chSer3aRIkkMKSNKC94Ms5Qh/wNYDmbJe0n8sCdKjF60X6IahLikm
-UAksTzIjHfN6O/OrcXal2QPahfOohE1wQJyzQJLSiaWMiJsMKBQoGKy6l9tpSfL/1ER45D3CF3dQYMqo
-dJjDjNgdolMlSQ3X02gkX+LLvllThcZX6vGRGZs56ps1i08B1UvWfekklUKcCvEny8t+qugIKitMMUYw
-Pw6QGlMTtpPJD7tXxhTMTBqoS+honpcXqK6ylCwG9UBw3Jq7Jwn0fxwGYaJCxELT5xv+W77cZiO4Que9
-sZyoZUJFNAmVlX3LTlb2p/kECE15KBiaTuvvlF5Uo0E9Ne6ZgEgClo3vrNmMN4q2adT2Tn71vQ6pEX/3
-0TKQ0KHpRZ7LRVd5BEehVGlZmJnwIoDaeKNrdOowA48Mm5xNKXMI5uYpZ2Vl5z2srjf+NoSPQp1kdYiJ
-DnjMBeDYfOz8XK8TWuacPcdNE38J4wchpKZWXdk7UFQ157S+0BOS1G3lDzQ+ypsTQQmYw48t1NJAnAJ7
-YOI48VVmP+IANZNWjtuFef1iC1Pl+8PIh2aQJGdBhZncgDjhi2/Ce7NOCko57Ve2FXuSugC2uporkNZm
-SwQmFK8m4dC7Q+aEwVI7Io8iubVlDKWKLNUok72dauBgRXaHwW9+e9KVevTySVAaNnh2bUjoZt7JOm2K
-O0L+XChRfCIWkppCQjviDyly5QbYlbw1VylRrRxU3shFiAgmNlmIqXQcSCpWYQTuSSxsHBmHVXvSqeUO
-3PLu7f5RvAFlcv+mbZ5gEXon6XCEgQtwgTr+s+uiT6QGRoAFRHRA80cdooldLa85yTH0nTRgiOBBesg9
-a26IT9phNxyijkxti9LPZs65BfA/5+fXIoTywqtTTF8206FM6KXg7wE7y8GTC3ByeolMStLcsL0GVjqZ
-sEFCweDQQQTDsTJOm2ajNOcIYmnJwRhoiTHe8uyiKDM9LJjEVSpB7g2mVGhSIUS1JhJ9FMHT9okIKR07
-mxIHz+yJhmtg5RY6P8T/cSijBbxBlAQ5vliqu9cRkptINzaXKA0szE8RwW+kXqUEFkHYOeFHeEXkiAms
-fwHu1aMi4qyrR2H+nnxrZWzL0U8dcv/9sE+gJHJB2Jc3Je3TLRugOkvhugPRRvv32VAtKuQi3Ag6QYZb
-legoOeIOAwyNAzHOJNvv4Y2hJsSsBXSacQkLDvaTJ18YbDHL0SBcklUmkmBzPkFTQbzUVgTeoZ21fD/i
-nRokLGwZ1PBxo0RmF1tpyz7TxbcInfkQGgdjWXKaluG1VVB3GmUHGFkR1/Oo5yUJ9A00ZMYpPwYacama
-NNchFsL6EQpNTdJFw93T8A0cDP1D4anwsBF/SPE/Kcg8Gnpl6LydzxrsB5ECfzGbDTPE7Ok1qnHJaMEA
-UyqBMgN8P3DgW53kgFArL9soA+w0ALoj65x32hzsvuVizggGx+GHB2uMAUhFs8E3xD8XmCG6yi8QiVpP
-S9bQjzWMR53cgikWYz++SNrVR33zEn0oVgnVoRRFSpJ1aBiUJAKe2v7MkhT4XOG1OS0+0CGZpoO84NUv
-WSvEJjGU0gPjD3HInrQ/LUo2GXSxYq/wOYD30pLDNkasXBYANh/P8mHSw0eAQtvDncJulilwrXaWhV6B
-itbMABW8ofqWJtSIaDMDKbdbx99LyI09rijjtunFVQxatpssJTjytseBEHnAV4DcFj2xEcfgpf3DeAVW
-qRYLQoyh2RFBMsFGCXtsXls2vhbQRO4wIKjDl8nEdIL7KslKcMqZDcXuRJ1WrwdIl+LErQkCaGL01F7V
-yKXX4lpIQkKblH8xEnDenaFR0+eIt/9HRbyNR3uNx3qti/QaSCjoK54Nffuh07bmD/GDswOOmp743bV8
-F7qoxYY2eg065+jzcZVbrRNYMF6AsVD/FXhZ6jSp4d1aPLmoruI2k3PNa+e2Ooi93c3VVyTWrLIesZe1
-dkGWbqSFYZ58e6yCpn5GIyPtDgBCvU8VCVkKPLdHXzrvRpOtZMjD/7K4OY8MohKGORaIWY3q7hkF+J+f
-V8DbcbcTwblxsw8KfpulX+hFGD5gq8Watua08Pmvdw9B7dm9djf+9qRdNW7tbnzDVtyh+Wr38eMn7bh9
-avfxVxvVGNR/+2zm/TuYeQ9RsEYb+wYGSRGPg9BvtYbfaL3lt2QXCya1IoCNiTDRqX5RvYa32suXXzpd
-afWGRPp1QQa/+eyL+gcdNKB6ty/y4WCajT8uyOAOuS/7Zuz8zTPc38eID9QjIyxjox6a8UfrWYv4Et5a
-OH/kHhga2vtld/F73aMYKcQeQ/+v4DD0MFJMmtBvot4nKHSaSdHMZF2BM90L01fpiBKuVfKa/iTGATeM
-ZWfsnwp9V1JYGQh2RAmtDbePN+MY9DcJDSvhi57Owp4k+QrA6ixwUuW61OXWrOmm0XYDanNHVq7xHHXQ
-k2HB/r/pdJpel4I7SKZmw/71SJCVjl0gQIZk1C0MbpoNEWB/OC+BCmEj0h4Pi7M9SKQNipXS02anlP+F
-snAypB7qJMYDmgw1avVUImhO16lHl7NkhGTyElh8OGRQVt3GqgDK7S9xq8jMiMKt44iIvXPL1vEGTljV
-rSP9VHVtSXlM63JiQqzcC6yCgrhKpHSwgZXQz7MvF93Z9URvv2G+bUCC4bWYGOEo0FqXw/x05c53llUk
-fp11IOpmdoNb7HP1xsFHeaI2SjsD3AV5A1hggQFoMkqtILoWysHIoPgL6qvO8vP5VDmYU5xuOKWAItmI
-mbMO+dfbyIxjXs90rJ8ys/7tjek7eSmOoNGeOzEQLC0AECNaMQJgoPAnMX8qa32eZT1k4dTDLaNqK9ja
-DZeLN42Qdl/CZpHIhNch2dthPRQacmBmdEaDaGBuR5QwkqUK5kh57pUA2kzI7qLEZmdZNFq+kHPxlIxg
-Bp1ghIMKWKrg+7Sy96o5A9ajVbuyyqY8M7WOcy/IE391csn616izf/Dj7rvd/9w7PNp79b3TurhOVoLy
-ZpfgvApSiquJGyJNgOLOCP0Uqczvgq0cRyQa8IkPhhsQR+uBQBmnrfgx/tWJN1LNYTb8I9xsdZO345Ql
-mEDaoGaEJvcFXXRjpMiUm4s0aCCdQstrihJZWk/3K3jG52Nz47EYM0PAsRO9xSknIwuACZpvtY3SJhnM
-eUwFMor8wCVPZ6fF4PpbFsDyMzLDwcPFC+CNs5JtTtNzCd3/FOW737aTp5NvKX/E05T/y2DGxXjt8Mfv
-nfA1gEe54JKn5fvzb2X7YFGm6BuB8TtmkuJfFg9fvNKo1Iw1UQCQXgw6siit7H1R9lykRrWBdHz0d3NS
-nHzA83PX+95qqeNUxRvhF3jyOG5ttMGx6fik2lSVqegMC4H418NeBB64GnYVL4Vfgh6DYg744K1KVPaB
-19I4qfCLCl3F3l6swhWaCtfLiAEBs3mhGYbDw6wANYamvVlBM+9xfORxSVLvmVPQBdNwWMvi4l8YF/9i
-cbGbs8HJv/jhBYJqx7+caNTFTwKTbNWa8VWzg7nkwVxGHobLhQ/Dpd06kwNcoXU/NkKZLULxe8APvjl6
-t7/15u9vX3fVhfHpalWQVPF32yv2z4Oc2A7vOp/bdgycbK0qUkK4hHOdbK48L+8B/DTzCi9l8AaZu+ke
-wd9jnke7/3n0bvvgFTLZepo+AXureZpxuuafaKyKnNBDhdu/NZximCJDX5nHDlMTUV6i9BQ+d+p6Xh5K
-LcpM3CIUhUgLuhuPNtqLxQDdjb9+FQtJUWH+u4//8lUkUsWTz+KmP07cpHLrLRYyrT/8pRzm6MaTz67y
-UnKs1MmfVhLw2C2lPvfT8pJNk9vYxwh++l7qrB3/sytEUl5+WB9zNaW98S9s2yISVcvNekyVVfdjNl0M
-B9inPMXWZF4ynBXE+yrOUbJSJSbr0hlyvBfFFZuHl2XRz+lZR0LXeY84S0AKemlYLH49XLya/beHR+/e
-Hu6+2zo6erP3/O3Rbjd59GGjrYtevzl4De/Yf2HJYyz5Yevw3eEeEvsvXuxuHx1iwV9MwfODg5e7W6/e
-/bj18i0B+8aUvHq7v/tmb9uVbDwyRa8PDveO9hB/hXUeP0r+6VUFLPfm5cHWzu5Otau/PAojZ+HGkBmj
-F9PuclxcDbMBOpafFpIAAsMh8/WcUaI2swsJs/kMkChkxp7GVtOFl9ZRpPNye17OitHWDE7r6XymYpKJ
-F1b+PiP6PTU1OGkfscXG2mQqYcmR/Mpn5k6ahMheKOfkfTY9hfMy6qBpkTG+w/zfaw9x6/E2rMngbJcl
-mwSx3Ug+UpYjSTaejyj7nDbcNRUCMHx022r6r92iyPrZuNzeMaUpQ2/FOLOJ07B99HL1bVAbmA+KAjpo
-3X9NZrV2KGSHiMiJcrTJ6s0kGCoadFxN0aV/nMSjYMNPu2USGyUYP/NHwa45YLGlAazrLxVJFl1o47nY
-gj18iLLGKeIFc4Id0mBI/lBfK0txzPyUj/IhBY6pzoOdIoupXn8Ota7263rh8BaPZV+SLe6zSWBXnQE+
-8YKdbeo4k53RGBHibjI0Y3iZl044zDaoQRuD5jiirUrmF5XaGeOaQTGybjJ8xTk6AP2Zork72nieojG0
-JY0IWeZ0FNW6c3Mlc6uA1hI3tSDPqoPoqGLF9spTE2xmrH21VhWMd2DqoPiVqkCCna4DE1YTQJadrDaq
-IE3HbKlhdd5V6r2Q9ZdYipaWXaGLex7pHfKQeJxMcGO3P58m4OB9Pae8PJTMSthdGNzbDKOlAhDWHMZm
-p9NpdZP/KuY/N9CGd3rNcVjl8ProV8t/Gj83vih/boiiCN0qUmEcJAv/LyQ86yBoSsHKtAVl8yfhmAeM
-qxsRFBGJXs9y24DA63Pq1GsHtQ7QVSHNBKGQ1B6/AI080064jMW07MosoNltTz51l41Qcqz61semusvM
-Im3wbBGup+y7yTM7QOBgX5rvTcsP6i7IqoefYa8vB+5EwbM94o2rYIj66QUS6VQ3Y57Ph+Sm6ljWVUbt
-AfYHXgPG69dbYQ9Wre3ayuDcgjpeuGY/FMLUIGJ9Ll735DvFh4etFGjN4tdtdYB/V9rrYGI+CG91qh1U
-j8CytV8AXocvvdG3BivJq/1MoeRq57ov1NACVWz3OrpJjjd0fbSjlGinyjVFr6p0a35/sl4NQxbtFLb5
-EJDy7tkZNCs/ts+Q1avr8jkaGqZj8rj4FH16rF1dp6+QRcn7n6xTj/ms69Skz//UncdZ4LpRHAC7NyzS
-QTb41Ctfx1+7B++TET0LrqWnVY01ql4qRRup+t1kOx0jP2PYD9atnRbAyDsuTceXw1RldyIdVpvOapNx
-cZ8/ZrVXQ0b1a12HS+qWOmT5iEIrMcBJxiDYVgYZyrus9q2ns9JkPs1S3w/7qrmXWuFaaVODUxa2WYoJ
-nj5LNuo2jD3kRK4kQphTBtOm6DQMWX2b+uT5mEecGHHqfMZWU2i+c5qPU07YfosLdetlvP0i3m0JW549
-+I3kL87O0vmQHQ23MSaiSaUqCgPVUWI0Juj2P5+IWEkuiuzAvKRw8peZlSigUJEgYVo6n9OgVEzHjXzQ
-OOEKLC5cUPWsKE7TqaluRSvSw9YQvcHOL1hpILwYsleEMdsoRQN4aLGAiQHI5T1HL71xNk2HVvBfSiTE
-7vr6LyXWp0CIl9n1GoemWVIJvdDJZM9ZqtkFFPu0vR1Hdr17tbW/200aJOekIIuwHL4MeJvVDFcXGfmt
-poHwEV0nE5PVOBAfsZ4i+ZVDBzmnKJ/L68KO+116Yn8r0otI+p3ECvhnVgfAUcQESpSTZMDgoCLm9DEa
-F4KF9mG8VFVhXs2oa1iwJcMPhH6soAikmZyyMAfEOlWiThFgUo10KoebrGRYDYFdiI0bYBU2mnxoW3Pa
-lcXT8Xi3O8zDrRyalaJ8Quxy8Tz07Xo2OZcGRUOFqpPgkWNbImMblH2QdAVnaZ/8YVpLN0XxeHeYRFWM
-SsMlPQK5ptO9o/BCCM9W7zNZdJpRILgSE9hcG92wPakY0qJUC73KrvhcXXVGP8lN9DoiukBrq5AiM1Za
-KTnRihx+tSsa0mKfYhhiIxw7o8ERScfXswsWfqUmFGHvqXlPvsWb/FRssL7tLTkhAaFTPxFUiqDc3lqm
-SWwaDIJb+lRYOe9f4KpKOB+2kQZQ04x1xaUkBZLXHwMOYCgY68NvvNM7yU9i/qyiDQvSshFacgmRgMeM
-9Vh+EKsFc/dJthX30Kk+jcUDIR3SEKBylaJOSirjpd1rguCWZ8iQRgUZV3LW2VSQohRRcOhPOVxN+9xy
-uBPha+PjtsW/8QRi3PWKE9E0FA35bJiSPucqA1SYSnAluRYdMYqTJ5ZHTIYFiDFNsCUOUJpImiTrL2+u
-VqSVfbFJ3Ou1tM0oEjlaNixdjThhWl2PLaeirSg4mi1HTCquzBfq204X6Fa6yfHJ7UmrPkFzJIOZM79W
-iqSq1Zc3PbmukVbG7L9XVRJF7cM5UG3YZsyizlXABgblEVjBTLTgVWn8q5ZXtlgC5kbSJbn4dcKSBDnb
-2dnG18GoYHHkbqP11q1Oso+Rwww0lau60UDUwGniHcOH2vifCkQX1+Jb+5DtLprw2AEemeX9+TCdtpMe
-nvZeC9f7WnKCULpuJm7pKigrbwLE0I4Odg66yR6FyxH+A+7Z+TQ9tTHarQGDotHokpKPI72KMlFjAY0Y
-6n2RUzYAUZSxP6TTW9mqqKv6TlM6O4r7e1FM3RPtpQHmhLY4Iq0MxhJpTxrUkJE8Nk1PnO4Vs7KLS5gL
-f3BfQ3JHagFAST+sejcK3hsHlYSFSKh44B18NRZSAEsMY148E6jAdGklLBoY8dekGXOg+Ntm9eRXG7pr
-kBv5ZTcq1ay1WjQ1b2GruCwZ1sajR59tDP94G8OPdGn1ZA7ar9UVWJfU/5kXM8dmGFQgOUT85gurGodV
-yd7rtzQpfT1bRqa79ihIIOfPHhOmee8iYsnlMWFYSatmc+wsErSNCU/8+c/JfYFW08iTtdlGefkqfcVW
-lK3aplE9igUhNphPUe5WB6FGbGdhuOiz9Fix0+QtM5+zDwaw8tixSaqVWBcKtk2V/FbOJeMwm+2Nx9n0
-h6P9l7rKZXatf06zs64x8iMMbA5ANlCS9GfGbMaUvh2j/eJYnVH33igKCWfqhh6qf7kmrJKrIiun5Ofh
-WGqBhBUZlHstXJBfq86NN7GGEubNc1p4MT0YR6wkqDaGMGVzR2Xf6JgTK4fbBPYXuN6SDcaceE4kb7bn
-UgnJoF8j6vclqyGx7Q9X6/ODiXQj4GokdOGq1/dxOzuR0JRAnItNwHRa0RckVbhqMwtPYkgiqEw+o9Qs
-JvoVFdOpKBIlENrUZlAgYMPi/JyJLBRbYaw65TMHr0R5lhERVR4V7I+wskJGEKTZI3/rGO8ZBURDro9P
-C39RdpIdIAPJDAlQCXz4ruFiVI2sG4cGzYuVcLI7XrSbBZ7Zgywdeo7ZnuS2KvBWzZ8FeZy3JbacyE9N
-SMi9nYUGkCY2Gczz7Tgr++kEdo3CXHvBxEy1fe2A7FhEJvG4EIM27Sh6Nx8E4UL0iawI7pMvk8Yzp05a
-+D4i6AjnE1mIdKU1GMv+uZKHNy70r1qN76LL0TY2yCZqsD1J6GuKZOIwH9SuWYxTCN9ua8ejsM0irGBw
-cRQZBHZpAXZ2YbXqqIkIq9po+IxqraGYxki+tdVY2+uEs42TJJ6u+u4EAb4xsUnFTcju1dcxR3jJ4eVV
-lPfPxTJb/JaMfSGBDqjPGGeVTTH800eP9RZEE/6LECo8oRhzF8+4fGhSQGsJhlNNjCnI9SCL3XIdOwXr
-3AkH4MUts2XsfTv5I68u3rmRp3CpXrrAzK5y6/z2+lT5JTJdfT4ikflWQSGUCJujyoerq9Y01sdCe5tg
-bTjsi1Zf9fjtNar306xfjEwE594xfz4xYW/Xn7Q1oKbJJIS7PCIXoXyWFPPZRByIhPwB6uaQzlezteZk
-3vSPTqunTluOHtuJ6rfWsNKzVa8cAK1h9LefQ3+QAufDZJj38xkroq3ADy9daAO4pjWghXHaMdDsBSVR
-noSSxmAuKS7Kpiy2eHhwkGrk/ktNZ9ijuciAJ/IgJLJLuNDKwIfQld4/fUY42xRmn0SBJMzHPzHtQO+Y
-ixTUqO19OD2z+T12tKVjpH1r/ZG53Cam/MZD37/JUzF2nvUBgNrjVT24fLNjR/O3fzeCZ2KH8Mjv9FJw
-jv8Y4oo+C/8vvAfWjujj8XX0aC7Hkb8FXgytu6qta6T/zeC220DpXsQU3wbOc/3/xHhPT8LHexVcpKt+
-apS0DOv8thijPnRBldm+TegCJRDuPqF4qouFvN3Hf6EgB0agC7+jWoSNz1qEP0qLQFLc1YMUZO/zoch0
-k3J+ujhYQSycry/pjwb8NboGliJQKK8X02JkYwKp9tEqRreQjSaza6Ov9tt5RaY+vhUE4KdpGvTjFd0q
-2CZWPHi9++rd0db3JAV6t/ufr6HB+n83nx7/d/Lz+rcnX7bWJXTo7uHbl0fv9l7t7P4nyY4woScJeVnY
-vkaRERvO3naXw9UzMdAzmNekcDGpRG1wPXMIXh1g3ANpbJwWVXObJ8M4EaTw1znqs5FuxnQvnH5/1r9A
-cJJ5nLPDX1KQhjmsL8cfpIAD5ES+xrazKDoV8RVp5LFz7GKKHteUqHWSoklWOZ9O8Yyb8QHeGUs4MZPC
-fMpGF7NkmCENjwbdSqMfRCg1tI30fRhZm6pMkGKQkjBB7E0wB+8wt1PoLLDvlVwCa7iuTFAF2fVeyYJL
-FCtPhyXg4YrxUJobJrBhhw7BwVmzkTRaLRefwwSm/TUwlUDUZQWGFEIBozBgOEacE8VJoyiStkrpxYpk
-41UGxq0pPwS2tTkhAMDWsCzYZ52tTSh8LJwUeF8xOlfPpDh7mPRczK6e0lcYlFUff3L14JFu3KGslyE5
-IhigGRA2Pz7PSBpW4k/WRCJT5HA1/uTdHS0W54m2Mv668GjsHG09gugVsycBdhKT52TaxaExu0An6E6y
-jxeZbBx7V3DgiivawaRnLB96dGHT92k+pNCF58PiNHCLbggzzEjExZJgLcg4n5ElBF3CKfnIqKZUs8Mj
-PhIek/gcerSnzqS6w6kBfa+KJdm1W07dZnCe+8LL8/z6lc33Y81FgOH9HhZ5YpYQGF6FNDEjbXKFLweG
-0jVZutpM50HVxsMGc8YxUy53ZuLWWh/jquNgH+falWnxodkXc3tBOg1p5y+0B7rlmb3I2lXRnKkdr+xe
-WWdNA9Oylbq4jqZpuFeezc+CQi9eYX09GKWFI6PW0lzWlRsMBzWPle2SK9iCdTynI7gNz9mMg9Tbc2An
-lo8rI/EFHPfD4gobbZp5MfuKMVyx0MpOH3Uco3fc6xfEukSaLFwYVA0GTnHVyst8woTIRTF0sTdLMapF
-xMvvDEXUhWIHK58JM2LeAw43JLmWuTZSFbKObRu0AUNcs1cA0yIGoNjrAh3NYcEJSNtkhuTsmFMJr402
-oh21KrxzXuRZ2in13W2Wv3h64UmyEKkT7poCG8jp3BZ5p1BtjGp7ogJ20hK8npcXkn30f+assBZgbJzr
-cRsVwtOH5axZEwxBNHPEgqUUKRemsft9iubuSPvgouczH5gJhDzIy346JW+7DDe7aceFqd0m01xiSpcF
-8rBAC05bWrK4dEHsonUkVrkfuLBCk/uBC80J5/NjxkxnGQac9i9RFM4j73gNG/++gQ9ZhJZHddQDLNIn
-CT4+SBoKQismiXAnmzE1h/Q+p8fIUT+0gCYoEr5E5Enjnij/lCMcYpxgoaJsVOwFUef4lyIfNxsNFaPF
-57dgsDsFng3yDDFRkGiMTzmp5LcUGtiMyjlcx+KrqvHaF/LLL38JA3e4asST2TbHv5z4EidXhhfSxatS
-Ukj8V1OtWdndls3mZRq6PX6WfKngaJleBM7mvWjvoSgp1lC1/LjI1Uly371fixCWf2cazIN0E37yxL4Y
-bzRH1lkr+v35BJknhsGXq+Odft87drVRtPy5u0YhPnDrGWJLlv7w40NviYIi5419o0I6jajZAMFlGIkI
-Hhkvymg9QfDls2RDjefukkH85yHMZnx7dhjzUuZlGyWcSKxuIxZ+95XoCcwe1cpKrRmV9a1V9BEaikyK
-CaXKGhD2BMwlD3Nuk9SP4SWgjK0DFK4ZeEw9fCQvtYAke/asut+WuXLLNmDbf2pNzo/AilwbEuNMg4hx
-Jbfs357qTzFjc1qxp8oZrsx015wJeVgsO40x6Yk9ZlrKssxfqOhTteAro1m4RgtHbNdG+HrXLOotQs9/
-KVoxQ6SEwjGUI+UoX51PKRsEe4DBQ4b1c0w2WjI8G3vekUGSEoWWSIQkyDwNszBvZr0yrhhKTgM/443Q
-LjVZN6X7/XCb0AeF0rMIEe5i4q8m14imQbECDjNUI5H6Y8QckTEulXcoCYOIPu4q71CQrOjjX1zecYft
-4bVrr7zyodAgJi34iOHIapnz1wHy8VXFPJpANS5mo2HjzgeHL4/x1j8LsmU0niL0b/k+CZtQWim4SVyL
-h+CyZIk4aXvmY2A58/D0wClbx31GRkLiu8JTya5acoIlcgKhEjLYNUFlFJjKOUkOMwmgHhyrZit6hlZe
-VIt7OQnflcnEEuchzAnyeIPW8SMhx22vXhYEWn7JXCUdtG1VNout03DSRt9Cpbkw42Rcu9R9/OjrdkWH
-BF8p86SvKeo+fvwoFsK9qgJ9/Dnf5O+g7WSNv0pLejAl7UaNApNSgvraPc4SqtLoCWhyNSaBAZpP0S0n
-SqDn50DttbmmC21OucIxC/50lI+BgiFXdIkXgkoU1b6HihYk8eFTTn7W6TXjibREqx/UtSE0zsiOGfDF
-g9t5e07S/mXKqddxa2wc6pFGZOxseoHOow9NW4FJgZOmTPXppWcxHU0Nvl3Ta2ncrQ1qvEdxEqCGnd+V
-0f7k47PhPBv3Xb4GHii/lqzIeZj03mTlpMDTqReF8SHyllPzBPcOMb5H5tWSvC+0PuyogcCNw28hWXlL
-yQZjVhjmGAPGqXin2pEhfrRQLHwv4YPU/DU2fnanuCFeWqpVOozUOUoniyvsIoX3MkvfLwFUSeobGxBF
-wlhch9P8uZzWtRW34MZfwzEvF1fbL07hfh+mZ4A4Xd5qv/q9k+gjEN8Km6zYS/S78eirz5j3t8e80dN4
-F29VP9N0YE3iZ6EWM40w23SkjSo1rWxi6X0gG3nQfsNIBWvwwUldRN1TyfmK3003C18YcoJXub1x6P4c
-O16xNWN5gZmuqDP0u3qmxtMJS6UblyddnD5HNCvcta51cTqH54kVNRy8SW5qMd5Xld1FTiTeAeB1yoxw
-LMSqN2acATU/mNsc5TUV3rMdQnLCPL6MkQ7VqmOUyr/TGIlGpdVlU5BswLn/8VEgHz0ayIk52bErYgw6
-3P501d++oAOdF9PhCONYGMkUKtZYHE9ZZqeSAaQt1jNGr8HhQXFOa0OcFMPr0fpiWERhhvn3HJhhTsHP
-jzk+vECYHKChO95XVc2+uRR0BoWiJEGT5UgGczTCRz0Pv70qQe2oeG8MfwicTf7BsAyTRYZO0BWFe6LZ
-IPwztlkKxwv81ZgRIjrAUuLdeSkAyQJKzdcuhTSNSG+MEEafBLhpfaBXeFw9/672OlXpD+0k5eG/CfLy
-k3hpSDnpcCFsethQolQ/HEnvj16SSEf638PBmGQRGMJSDmDyiv62a60WwhnUPLyhBwXOwAglu3jSoLPS
-4EazsdIKDaV+9QnijhwGvhkuHJD3WQmfItevciVphvGvezvOy9XOVJute7tJvnO1F10ri5oKHHCwJOSW
-jcQ8xqoQj4ZI/Vqhx7iKA34TH9H9+hEBna8GtGormIcbhfGOkUh5qb17KLLiv5GdWEty9HpS7iuR4VsZ
-wVU+3qxOhtamw7I2f52pxBtTeHYTcraW1KdpIkD4CJsRXRHu9Bt6ptcKPofaeYEpqHiCVxfXSa+4GmfT
-HSv/IzpqBIcaXah0Em/0etGq1UHRr/Tc8YBp7wOorTU4PGz42DHW6Xl2hUcIP7GQ5Ceabq0PAEO48iuF
-e4KHEB6BIrIxS879XG0NYbn4IuMBVEG5Q6pj9esyK8xl8SI1mDMVTF9GZIt5GPFj4FKzcCuas3fuXony
-GlWOac6WJph+aZSOgYceOKRcrnIPKJcjJhym7r4LiLK9HRoGCuWMVyuTf9QEOo40wOFydVt/iPQDNIhQ
-pxwioRhmA4NEHSHRcaRUWy1lFFuy8JCHSN1J/uekQUDoU8Mr5o3liesCf+Of0Xm0M8mQeLnrTIiWci/A
-0nlQZ/486FPDK9bjdJ/DWfAsqULIXHTsQ5k5am8nLyeocs7KJi1Lm+G2zQ7Q+I3QNaAkjx9R6g5sthkt
-38BygudrzIJ6i2SqUbrUMtMBz9XdePSXdoytgoInbZ8F6m78lbxDYpxUd+MJlQW8+l8+8+q/A6/ubeki
-4eh+jmYGFfaVP1sW1hwDmMXri7QkFtPWav56Oj89havMXBnmipzACTW/b5SkldlTVLSnVwlq0NNhycgU
-52bI1H46xz1nQSRi/oToeCepCzlqNZR7RDc9H86Fj23zBxaR+Z9QIuV/wceAVca740Fd0SHaydUVcvLX
-oHSMsWj3s/E8+D659j/MfbA7xRxWtTrMnWl6XvkQDpi/WXZef/2QzyofFVetviJpWflYnf8OnA7vwy7u
-hfflBdBNpfeFxIzel79n1ztAZIXfXqO3Svjxrd/hyyL1p094qAKNvu4XwUwNVRT5GM6fvgZ9v07LYMff
-ABLyoR32p8Vw6H8yoYkjR/NwfjoKNgmTBVeX7KiY9y+2MfT3sPo9PBL0sTJ7+lrd058uMgPz3k2rTnIn
-181dxq7/E4FVcEe3+qnm2fK608JfQU/wqPyFHpWvfx/vQ3oIqq/H1oQSKUhJO/mR43IljzuPgCqHkgdS
-hPgUQJjMccijcapKNMRGJJ996GcTUlkgYTrMcWddCleBQm+eyWlXnCJdS+krJtdG0S0VE9KVkAaIXZuu
-rq46KQ22U0zP1+WtK9flcVuDAUuDt+MhrI95FEilk05I1INqsSFgb5RUncMTN+BUfZSllMIMlcXZ7ArY
-HFKKoSiPzB31epnhwax1hYKyyz7YOkz2Dh8kz7cO9w7bCOSnvaMfDt4eJT9tvXmz9epob/cwOXiTbB+8
-2tk72jt4Bb9eJFuv/iv5+94rILWynEL7ipcbDhNVMTmTBKRS10MwcahEmdeHqY3P56heO0cp0lh4B8pc
-QM5w6AP1EKgEuJ8S6aoyr4WP8kuSB1X8NfmJvq3Lozm9byewilk6MhHhKB4tCYCG0l0n2TF23pgsWEyc
-+n0ScJvJIyigromFPkPzoxnmznDPrjeBMHM0f5dMrkYTN5hPjQyQaYRkgve9zpLKk6UxMU7AXOhblg5D
-72ZicGpqpGiWoeBxU3QPOB6d807S6OOjSlGBG1Zi2AjgGGxxk6AZAhmMb5s/TFkoSzMyOI6MbezUemz6
-25OEBy7IFk9CicZ40m039rbt3JNwsUQCCEtvS1Ss23iFZgRu28aa9PhfHahpRG+GHaRvripdca1b96YM
-UCte9WYSsxlgLE/K5yboypqNYoym+bGl+8i5DbJb9VKd072KReOy2yIk9Ofroq+LLMrt78v9pRfmTrba
-tZbaja3ZDJE1P45Du9dL9pnjvjS87FkNwxRxyhrrrDOfIFkTOStIGUzNY01vSADQ6liAh8xQO0BBz0XK
-7yz5W6G/We3l8Z6l6G2+E1qiiHe/F1byOltwgc2FEgMFv/+bBWSs6f4Whm0x67Sq1dlfPwfe+COFLFYL
-ttjcgau9Yd32dcx+wavgGz5Q0dsZ5uSpa0ilVlzjZJR7Y5Keq0Z+mekICL1deOO2bOHAb1Utv1UIDTlq
-e2KyDiuENl5IfPtkamlpTfPleTq+DFLUWSj/M8/mmaV1da6QVM0DNjSfIqMhIlpShAt/cpXmMzGro3aV
-uhkZl/JRX3dmFv+bejZJf23uPN2HzAw7m6JEveTwe/QeGk14MimKIcajImZrgsQ7znoWBr74zjyUDPPQ
-181K6iM7auZ04LTm79NZ5gbOU3GD3BoP3vDIdEDuzBFa+Chmmu6KgDH4TR3DTlDFut6/FgNEvIZyl0y2
-ldMMjRQxqxqa2pup4NYo9TP1z0DkCj6L3S7SLahaLwo21pO5OIWZB+rPf/ZAh5Nwz0t1ARa106rgZQuV
-lXts58YjbYddGQ0CkTSsy8/L1/bUNJUymkuRQIGXDbFYRw6htwg2M6Q5wWtJb09yyf1A9pG9bnJshAAn
-nqGszaw4LM7JT3s2TZH9RIkuXEkgRDlp40WeTdNp/+I6OQdiY5zkA+tYbloqopUiERfD9/rG+SNyt86+
-aRS6mBCarth08fCpgugyA2iib/e/dmQu2dFVQQKqJdWcfgXX9a5uETRK1nI1glEiuaZscclCFl6a+0iu
-+U4ABESCyhihu1gFp+jCcpaTxhvGe4YOEg4FG+tgRiaUUXA4lHxyfUrBjCJ9g5l2vTZ4ocnglfmOi0zc
-//1kjy4xaM8zDek55qFpYlX77E3bxbAmRNjqJg9vGFSSvJHj2cEkW2NtAyUzI+EaVGhLnlAOMugHyTHA
-jF1TaP7CZ55IZ6QRkBaFVaIHiJfIx75mmlan2bPMjunpYMLkStutPKXeQ8Mn6ZOsoifzU3gLkS8aiTs4
-+Sk6IzkDUPIrIhCbvDLGApYdTqJbWlrKAMb+DDQ0EvfyvRZjEjT2Qvu8HqVcJN5j8CYoI4Mpt+ceMtO7
-zj217SNk9rsVWa0UD1apjytyo1N8Sy6KK/fwnmMsZrUryfNrA0zsMiTYkeGMkajknJKIgi7VWUdPfe4v
-8BHgq2Q8BErnIkBVRqNsgCEQMEgFxuo7HSrRIO5q35es2eaVEFAcvZJj20ruIDJ8d2aPjklWuYXEnV3A
-xGzF9mQapM11lWRwiYFpoFK1bvUds312VL32yr37yG75MPz6igeLA3SvYuUliTfY/BihQN1jFBjyyOqg
-FUjdbD5SOrHaQCxrHVuCm/o9JH9ut4W7gaX+8k0MW3SjpFy87vKjZe4nYaGjwlCe++lE8tKmJqMu32vm
-mI0RUN1Ay+eS83aloUrte1Z4QIgJPxkcuE3vahmHV1dbySEcpmXKrAZSTeUgdj2yZGXSM/iwh/r3nmbD
-jkM4J8f54KTXoQx3g2w0KYR3WZTGg01ZEfEqqq9GJBn2l7ySMH4WZzdJPtkrxqQl77UCQN85oaRtckQS
-MxFMwgEgVtShz8ncimq8LCHtymjaFubHe0/ft8P75z9J8qoniWZtDTMU5xFrXfy/KF1dpt5S9ZIiJZel
-g+S8YJmuTm1fnVLQN9ULInqsPlTPz/QUThBwYiGJYC0MF5807Ky5pApKCQS71XSG5xWNrawsriKX/8NO
-rE3e4o4snlQ6nQN7YN05hYdjxXOqmffaTViy/LyqMsY6IMBBL1j3yGKbOOipWx5rC6SHQGD/BfCKC6X+
-uy89UgA1EHQGTRzbsk1YGJIe85JaRsFqx7VWyVoj2DiqJh/TXfbGLenWcGhWtYzmZ7IxpSrbh/Hr1BpW
-FmT5I1a7LlvMbRgmCmZrGA/M7046GIwLcS2J9Il3FO6Nj7LvjzOO+GOUnz1TPolnSiUeyh/hf2IlxKUz
-ADcnJi6slNJN/4xTYFGgMOA/UsFFFU2eJsMguChbuovPmJC0osuwntpoLeQy5ANxNwUmLR9l2vHBpCO2
-nmvSeZAp2q8WRnCrusr59X1/Ia03jW1J/bYs2ppge+zXIGtBMFRfh5iZ4fsqE5aglu1wnguCauH/Gqtt
-Z6ztY5rdMUmT8DUsQ/k+hRuwcZadUMOGLnuY9ITU3LXqCRZCWFFGBcU8vDEz/KhLxsOuXLLMW1CrSSgD
-EbVRpMSWmMpEt18uyKaitC7welUkd5Knm5eSBuutRmVGlYWMsORw3w4BjfYytdrwAlB6PRHPChi8eRgb
-rRAJYkbC0lnGefhGxdRGSJPhInqWRcX9zYcalFODuG+7eh3dgMSZoKqtEjwTqPOaMYAVDYRWGn10EKD7
-apcNU1NZ/Garm2w53a4s0hW6vtaukh+cx9v55FAMJ5i0gRtykbJFxXWGDnOZEmTDtYmF2VHDbsUSPMLb
-Wkow/CxDVEtdZeWM03Z1JKYmye/toXv3bjKfnseOWkQRarp8905xAVgeaS5oR0NZ6CniCSJvE4UnosTu
-bnBAnYqeGgq+aVd10d2NJ1Q/omzuPt74arUoPH/7bA/xx9tDmCNwl/AQtzMosKF6dMQdT6PViUnZTWQX
-X4GvwBmdiC8t1ICVzFDgj31ho2+1gMT7aDI3eb8EBiVikHzCFyrejuMyzN92gmr5nYLfnuep6UbNk0KG
-WY3+/XAZ1KP2UwpvFZKFQ+RvemHNnlZ9dBR+YZSi6Fces+HO/IXRtHKgzfcrHjsoJyE5beLyhkO0uTVc
-249+qHSP3yZrG04EVz3xNgQdL1OoXGUjPgmhgHufj/3cDVm43e2k9wWwd1Zm5+YVeZvCkbaUtcMC5uNY
-tVPpzfzI8zcfuYqBlYRiARavp69qJp2o0zXjKQ2UyWo5WdXLkU5xFa395IrLWT9ks7CrLmpgJaJOM6t3
-FbPkd2o1yAGDaLUDFCnUB/Jpkk4IUAKJ2eJoWJpbC3o9tkM60VyYnk475K78jHIqs0X0JLxI8yErtY1S
-nG8XbS2ujFwd/8KortynYNsr92jx3FeZeXze4axbLd8Uxz4Xr6ULZUpmeAhA0fJwK05QYq5ZoRxPMDQk
-M5KYgadSsiyUNfRgPtCI/4xwpgrHQ98iNJDFMU+aamzkOqec6/omOcLMY5KOnOeIIabLeR+P6NkcI7La
-pa4atdk3L75b/hzb/oa4jeDLcteLcv82mrswArobg1j9+NR3N9lHRhKj+FGYPzndqbYtNxfBUmkG+zV4
-PTnrqb4Q+gQGjM2nmQuf6NvAUvcHI2d4haRBIkQZtzCpNOjUVNwU8qemuCJlpnoWwS5utBByuFIWcCsU
-nMUBoPwsDvnYglKpEeREhJU9xB0Fd0ekHQYowf+Vi45G9UQZOp+eYK/qFCZLJrEESjtCcbTvxWbQ2oyO
-9579RN5Km3G87OuoKMWcMQ8yxlfAHQEJdXatMbWIMMSTUDA5NSDVShBttKMU0r0ae6578TR5FT3Jm+p4
-C2QUPwFqX4SXK7tYVTv/fri5xhaiqhb6DVBy5bz4mDkcwmoIeuUJ1ePllUFEqNhV4O0oQ7UY0HvhZVVk
-r3obOtrgTd/JN3IlnFWgIZUokC1eMaOb06aaTrTN1ycS2uwmJjtQXhy+md6BRNU12RVDbl5ZmfCHbnJ8
-4gsP97XYwT3heK4GPpHmJPC1VkW/3iwAHkFegTWU7aHW2Oj2Hbgp1ULf8aI5hl2wiIbVr0riYza+eWr1
-X9hJS2Iii9Go1ocxOHGdyPrFfIK8BY3cmjMatTWcnHk6rJwuO4Bc8kqkw6v0ugxCQcP80umAPDxIACdB
-kduAQNYA+NoZWZxKj4D8Zv3aBJqL7e1CZUY8WF9oqxlCcvH76uz0KuaWdQKlj1ANhCDvKnJR6k+LuJWA
-oBj32WOS5PXD/BKNdQGk+AShrlQ1RIxvwixwXP6ofqCyHC3rAbM9RBieWhYOEzN0ErFvcA0HF90iYCSU
-vd5I2iLSyy1KqgOLOitQyNopUZDM8vravTEUT42YMKLQMFfO9xAgigXzZ/rnq8dZVdQdFJnNqYTpDNXS
-zq0BYMWPXE9fiNfK5+BUSUwxNxEmsKM7do4F+SxPh/k/RKc5VfctdsE+heXoR99AMRldcAetCaqzkIkO
-WAtZ89Ls704OHAh6ezFZixVqRLaLoYokOV6pwvA4SeyqeS0DsXC8n6p4WIY1XnU4Oh5hskD4TLhLj0nP
-4+MStd2v71UzXqvgvdkVEglnZxklP/IFz4EPuNM4EIVapUX5X0VKVsF19aNvKXuIBSsbk4vSRlbOrOHO
-3FlxAVaD2joo6wI8F4/MUBSXwMBNtJbGRokx0rXMmSvFsQiv/lZojVCx9jT1Fc5kdxvKWzKo7TVit1Dj
-9RjaZGjEEIgBxXygE0pgzCqvyLxb5vk2vIbd+CWdSD0yCA5D7fqyG8Riq4mGAqy2WqOoWOeuCO5TrNRC
-IdGJWTeNKOswmmxf7GJGpDxmF0J7pg+TohTrB506y9oGGomBsYBALbr30kWsGWo1uP72r6B85BVY+aXQ
-yyNWpct0lf5iLVAU2Tx5mFba3s06tq7mtNRVX6Q2qmvjr9EdJMDVxVpB9Buuml2Jmotwu2sTrEPMhrim
-pb8aNZWqyU0D3BhZk5XFLtXztIL5jlmQW9jwLDeu+eazcc0fb1xDNlSfKLvKHS1uYKm9pBs8GhdEgBxA
-62MH4NJQhqYp8oWzKzS740yLA+Cw9nasdDwxohdKpjnNiLbta5sbJ6vheHTiomoiWXp+qxXBBX23NqqW
-LTNpX7xqjgy6pSfmx/AH3gjQwcb7gIEuMLVdXG++wDVXkiXavWTwfJE9VqGB3uGSEVI6syGifE5gtZG2
-KoEnbp8gx+kX8nJ3DETQhRf5nhfeaHRWC4n/duJxgstbmfiqd2nG4VpJfq1mgnFZP8VUEM5dRoXtcExq
-SBQU9lOMCePw3mVMNABaqHs2igfGXcfj4SySN+/d8lLWwKkJguNzStZFyTJL7ypFm5VWiNEq9eGjjvpv
-Wu+VW1Mn28tL+m+z0knL9ZIPljSCnlR1+PUyQ3Rp232nB2qo0a43+u+SDfjyaLM6XoYVDP872evKsC30
-ugqup48UIbtVeVYZHqInWQVdCB9icmZCpIgtOYwMG/33orLfu3UqZuRapbVHfhtl0uufmmBApkUbptbq
-AR3yvsBYOizKQfoOH6dxkZR9oEFJJfHQRFkasFC2Zz1o2wgC6JUcOIKzM3wg3qPkmwP6UMRiszEPyHhO
-wmVdYnDiMkNZx4zUORhgCKmzZJSNhHhR+l+x4/bumZlN/9SF57nt5Vrtat3hsa7BDCpm0o2AXXY7K+Yk
-5NDFzlt1lyLw6bK8FwZ5cgGzDwE7TrJB0+MoTqdZehnKGdbXE7eiqPgkwgqVGVeYRXSK8X2GnJhfxY0R
-HRYQp+X1uG+cw9wxrIz+OD9p6+2A3/qVr9p+uFWSGSwA7kGWHXC3RKIwOntQ678UWG56TizNlmfwYKOp
-0a7fJP5vDg3HcUliwU63XKBNCTpkfLVjJhkDyh+DXrA52wqkJekSZiooQXCJwsFXsAHB5KXk4yLp7202
-k4CwNbRYkxuaC8VPOkUGUvjcboytG+/DSGFsNn8LTGM1eNDHg3Q6WKdAO3AqMLkdzXJ2MS3m5xfW2LGB
-KVyHQ2E17E1cvDaV+GkGySxBRG5aVXyj5lZFMrbwE06RrZVLuOWEwSUJxhmmXlKWCybntaw0SfOn6LDF
-8Qbxm9zp3HLORuoMR0/02rVQry6KhooqYQFSFwUFLydfN35xgnootp6Sl90qe4X4bGuGlqB7sGCa8vr8
-NPwf8TREUTs8AiFOl6chImj2a0SkXSu8IdHCyBCqCfuCk2GY00C+7W446XRXO823vQHh6Z8Sbr3VzVmC
-qFZCdA6J63nbPPuIPVKkEFFk9cChjzVJSSrqfkRdlMkUTR5MJUJpKjir55yL0c1mkr0b04FOKbZeQuG1
-O8keh78eNzByNZtHpJf0Kpc2lYO1lSdTSuhkliMSVgFdm5KrqIXP7mWWTTBuZf9SMCJCk9m/5xh5MIkM
-479VkSQj6lyCcsMi0ouOY5IBsXM2ZoYH3IGp0nFMlWXLyhA/H1H4EzcI8mjjXo3SFv5Q06rDtNiR/9w5
-L7DfAPnF8PWt0HUttr6zBehiXGzM7uMLZmRkVa4v0IEv6aSlSCxtrm6XiJnl8HMVd7WEa77FTV6ICew1
-94wp68hiAlVx47A+HEAIzEenmGDgzIu1rO46XOxzUmtP2ZzqUXBuL9Kycra0tOn+/fqj5Mb/PX5Jhwnc
-lmGOYUe9kJhncwrgSep14ps5/DCT+q+tmWbV6NOE36b7I3LHrv2LRM1Ghtd1f/J3I0jrqr85IFzs4HXj
-n9v3KqGHu+GHSB37WlQq25JFrdwbU9/e1UFI3i52/Z9OIp9jfEpn9QjfYXNYqkpGuV02UVqq5aJtua2b
-ejXX4XLN15PPmq8/VPPl8lB+Is1XNOxqPLg+lP3uofVr0y4GypFKFe5UBYaoRHXoqEKn2Du0WUAoql8p
-gfTRW1ZoPmdbVjHt5lBhtgLCe0B2J+UDOh5ukGKQfZaPB6WKNIb5xSRAuEl5o58GU3FrRpOkYGvyPk6C
-BdDUczTUYMzGqd54JoB+oh5OtYzx6G9eEO70HF2Gev6z2mOiUHkHqSjN2+aR4vxwNsHKwwTJbBsJeU4S
-JLQD5BQGRXIKi4vENBuR2Za8SUTNGkuyh8k+mhh7Dj0NDJGNT3lZ34NATpOrKZv80447mTGbtUmg+DSf
-ltrF0wSQs7nJ/L12t4gfQMI2ijAYcErd+eQqnQ5KOQd3JGzZ3ssJ0Eg5dTEtrpJxdpVQGstmw/RN1soD
-Zm/EWBtJqUbLZxudAdozM0igTCv3tCOMSBJJh9gxGVQN5egCbFaugiyHuQ0XJjIPTi0MUFpPMcrcY2GY
-IvVV6NPNGGCmMxcDgzparKjFutssFvPYNsNUAgw4FcD8OdmZBFwaqG1aS5BJR7MzhEfBd1qd5GX6jxzT
-zcOp7pMCA107kGiPqTKS5CdhZYdFMTHSOwTnbhCH5+BECJyr1uRAcFcNuN8OgsJHEU+NqZ4S58qMnN+M
-rgpN00wyVyn9AFdm/RRfPzWOUUqhVgRlO0QtaZDqLphJb+Bu1yENKJoEBBVYt8GdEZs93/q9Evu62aok
-XvCyg9uO99PppfMJWYIwPM9P1tnZA2dOnHt8S8JPxXwmfjvseTU13jk2ARccikuOOUCzZ8FB+Cp2kkN8
-d9Iy6YWDDJe8R9IE6ZuhIYXAmFmEwD1//j28D5aOShnHqqeE4nLWYVaLTvHlys/HGGZ2x83zVgdgUdjT
-6iO8GoyqphlarvDiGqweor4VkN/d0d/dEWA+qIn/oE+kwoOcIzWkIlgUhVGVDAJBEOFxWaj1Muz9gof4
-47BE3SGJHsrodZfs4lropG1oFqE1L/hgJPgdF7dXQI2tzZpOd20OGNUtpfVvc/b+NvkP4Vs9Kwy9cSfc
-6Hpi7Chg6W8E3a5dVy7hQfG7jaDuEUZEbLjC1t9+JeOHR3q0xz0pRxS9MZsFDAPylujpgJQevadnGO8P
-/qBkLNAN2iSk0jwdcSKLh+x+iw9tyggNX+pzEg0R3Sr9WJIVRcqDayOH5sQnD4CMoK5IaD1Fse6sjKcb
-G3Jzih9IPjnE5kk+S+nLYWaE5yJeG+p8PR+UbKex9XqPciNnmCGfnEDg1xWTKQClDzw5erjNMNCgWHnY
-1aIrBlctkwDEbD8JiMGXIGt9IxAhmGWaQImK5yplzbfQH5okCWdTGvrLdSC0kSJwkAgy7L1KShXn7R1n
-7gpE8rbofnYXlrbvVQmFeNuwzG8Zu+bdJeWLpFhuih8vxQpEFvD5r3eJslgRfm08WkX49VnO9ZFyLqNY
-2h2/z6fFeGQeZFjZX8phTj59+bBLynX6/sDJwB6IxAiG9BaO/cE+HLD79/F9aEqOhqt8PAC+FjniBi7i
-WQ6opcGJzbisMyjgKMgbHnzqMMe/y2Y2rXvKIvyQDB2QJoJFucpo68WUOC0xNZ3VfRHiyWbC0EhisVn2
-AREKQvqpwHe+k/yQDSdlkqJ9W9LPp304nVPP3pxTOLJwgk4IxUVJS4R6KvTzFVA3mAgesAxlPcdIEIjE
-KVk+98S0hASgugaW/z2mgRr3h/MBE9ajNCd5S4+M07lRz43kWmkKIptn4kPYPem6P9uugMECEpGN4t/h
-RqkGdM1drHw2obL7DnsHGy/bF2baRa85U6TSaivgP+bZFd7obghSmpVwENBMkhQbe2MebDe572qvrycv
-aNGvJOFazoYj8JqO6HD0L9Lxub0cZ3OUdHTqHFoi64qIsv0rIaaNz4jpt0dML8QwbRvTBJQ5W5pwsJ9b
-B3d9jaHfBttDtHvzpOCqwMnaSySA/HqcKrzDRUbEDtTyEaCRbcQm49kWhY8rpn7DeB3t2kLbewG4B/ax
-TyMsOSeSIiQZvySEtPoMis0hJP/6mN0xHjJ2MskV0mlesgjHtBGaifDYGWpEjfTbSmbJCtiEj8LNIheY
-K7KwKDMjFRI7vCHSnqi7pJx20NMpnVyEcVoA5s2RXNVjxo7xo7RPzMaakwEXuQOYHQlDObMmUwOmEeDI
-+tDpNJsMU66jV8BkOO+7E9OW0OcICo8Fdm47BfSNCgH6KCBHWTUNbpjUAq+Mz7LWHdUmVmWeBW9V5x3d
-tmcEYdN+LFE3i4cEvc3xi5wZ9hbnOmfSg1SzxkF8IJt1A3BBOto0Cusm+z0smdkEeQphDyZz37vcqNzF
-jpS+is83DiTiKYsMeYPMRxq8p2bWFfsnV9R5zwabzvqqUuU4fo2arXh+H46DkwnLgVJJCnkyP+WJWOcv
-jn1MATvgVHHSI4ZRObVkKisLJt9XXaqddJbWLFV1c2vWSVcxK2VFVSU7rHg/jUVtcMSCWi+NC7BrY0z/
-bEUgOrwfHmTvqNoqFqypboGy5ABNEKlHNkHkP5/qIcnH0PrQDfOY/uQQGaYX+bbEDFEtHBwS9KZ65i3G
-ml5PGmpGdTY2saPk6TNpRj+XDdDChLrBYN06cenqw6Z4O0dpPuQVTr5NNsh/heAk3cSSbwwhikHszhAw
-HnfbQQ4iFEbP4M09zHt2Tz2hSPjhT7gBR0UtUmrFKK66ykx1VV7g7sYGMZn6/YZvxGHWPLndx4+/Iurt
-8Wfq7ben3n442n8JD6fxTN+W4HEeX3maz67yMmPWsoZyUzB8wkoVWLuKODekrSsiNSzdt//28Ojd28Pd
-d1tHR2/2nr892oXGqhslJ61W3fRBvH5z8Hr3zdF/LYdgajKAH7YO3z0/OHi5u/Xq3Y9bL9/WD6FS0wE4
-3EN184sXu9uwdYva64qu+au3+7tv9rZX6N+r6QC8PjjcO9r7cTeEBDijHla8kQN68OPum5cHWzu7O+EC
-LQRb10z2/CItD3/8nn05Y4ejYxlMZ+ARuP08S6y0wi/ZZLM0gA91WGYeNJX07v5XjOnwIkuRPV1awegp
-Gxez2aS7vn51ddW5+qpTTM/Xj96sQ88bG+tnXPXfnqdl3j8kESj8dAk9Nzob4lPoJPK06LEbbE0Rt8mC
-cWsGl/V0PoM7/CY73/0wUVHhUP3dQZsQHuT6fzcHQAj9Ew3eWmvH6do/3p3g//48eNf5ee3k4b8jtUSm
-sa9d6naTIsJl3DWOLqqWFK0b/UM2UZ745sv2RTots0pBWf49u/Y/spGg+oIs1Qv4eUgyiG4MT/yzenFV
-4yPAuSUwEyi7iTU3Vf3RobNDtxbufFbgU4kRPXSr9XUqegEnkiQgk2J4Dcgb3sj1MTKTw/wfHPNuy9Ta
-zz/k40rbhR2/HqbXtRX62XD4Gh+c8bkeGH4+nKT98DNsyyFuS92qEJOfDboR1Fq76MRF7+3UA4WZHhWk
-UqCqpCCG64g3VdJEoo4UTZLGGZ6RdEpKEHydOtDKnvpNA41VJcX0klSleHMsIGhYkPQPc3QxM1sm2QfS
-H+3tftMBEmMM3GVO7LQdHpRYt4NSckfh8JAVKQuTz0sSx+O4yKjEjCtxO2q75GxuASgKNmaeVxikX3tQ
-QPd2SH5AUSPnJBWNZMWANaCpA80MHXTcXnDoQMGF38UuUGR/ZS8LdIKuvXLxV8M2hRPn3WXh3CKfdoFC
-wgw8laIPs/1sPF9wQKHS1B/kCie0KKaD0utrWpTlAZBiuTfgAfGOrIEWCWfvqZi5rX/bQ3RVkqFHOe1z
-vEpskh3luOB1Qx5gQJPaCwyknTeCvMR1GdwO7w2KqzGGMe0ufLul7jQ9Pw/XHnAlRmXQn1AaVj8pLN0S
-5L2ozq4BvKjSPmVwWVznVfGj+KfULiVWY6nRAlBTuBzPC7aVr6t0kaUDEta71bgglceCJvlgcNvX6gJA
-en1Ms7Pw98vUx+AXs9EQjqX/aTbZBZr7vf6Y9/1nNY/hdLEUSE+zoa47DPpELXb9zNFw7la3EZ5oDX2U
-jvOzbFEPI9jTfPyD7IBuid9/ygezC//zh+AnM/4LOsgGebqk+PtpMZ94cOXQqi8+MjGOabdanBG8J7d7
-fseE7V234+UXBV6g8YLCWT6ajzRIMc7wPqH49qIY0j1SnwsM0e59mWaMmdynaTrIi8p6onHFwXh4favJ
-T/2TawJR1U4Ono4FuAif4zu+ftA0fP1KePJPC+8sUtSOyof64ZbwTg2HARFXZukI6YLbIRvWJtzybJUX
-aTBeIGjvuELYNDpmKabVWwpkAoTtNhKp3qim/eDnTtGvxXdQvJAGJuFct8qbm9LMO7YkRdIfgK6hbGD+
-N36V1Jd8FjQLnl+M8pR6PZHcvn77tHSBG1wxZqyb59WoGNRchpANfFWM18olrKDwM9vpBEg7ZHxY70Wc
-0xTFV2xdxSRxRjKv/eIUJV2H6Rmwqfh8G0CX2fVpgX1d5CYtcQDc491cHwFzls+yEQ63TX8d4mXjPykm
-FY5Hdbqfw21DCtAMspMcZlkivH4JrNEoJV5/UPTLdQyNCW8xP67STf1a2+5vd2nNUBfyVlhpb0eUfdno
-TXZmZladEhKvV5iW+HQ+M+3JohQuVj8/yzMri8T//+mHraOfvqcyJ3XBRTFNcW1KWBxciQ5W61wBJ3N1
-TsvE7196nq2PzDBoyf7N/lwbFKM12FI710VcpMxt4VI45qpUJw1X4gCevO+n6eQC5wdPN+pyz+VkmVbB
-4dnbJS234vM4rKFwIAAWuf7T7CJ9n8spmo8ZzTJ5XR3oPdKekQDNcrVkRt91drJaetLg32t9/tBoh0xe
-g/5uBLRhA2bcqBCHJLVawxfyfUMNxEic/HEEl62BH/r2QyMmFuE68rMRuZpSgX6pcpGAUCmtaSOUfVAR
-UBzXDbs5vf+fvTf/b+O49kR/51/R8owvABkERcl2YtByLiVSCW+s5YmyfTOyhmgATbIjAI2gAVK0xPe3
-vzpb1anqaiykZGfeSJ/ERHfXvpw6dZbvMTeWAqQeGK0UO5WOMrJnhG9ATnttvNgbTuVtSTEk+T3IIubo
-kuSWcUWcB6P5zQ6CZdGyhcV6Wm5Lxf69qSGvGyHv3oCfwFI3qtxPAx/O4KFRPeAa+IAimYZ/sjXMD7Mh
-G/6BBm9hlWwJ7GJF7RMVMlqdj5bxd7/7rl0nwe/u7t5D1c6Dz+6yf5C+58d88jYjlaIAxW5spYPmbjDh
-gsipNDf+t80wZFnQ/5L5eVFgA2FpGII3LyYN0kHB6m1ggw2brN/lY3Nq6Bd0u9ZvcON4LwBYXL8oF/1x
-Lm9wO9iFeGJoplnlZKMOQ9lEY4zbBSIjew44S8oOi1Gh6OQh2Y+AUZ5OgtycTkB7ucHRHni+kzTRZSFH
-ldisneToNLkqFtaSGybZBiJTGduYypTZNyfTFUgaf23MvVyuOVDHhdkkCTqrRaAwbtvRVsuDIOXJ+FmS
-6bmom6jbwIVU2mYb/h//4X0sJo/JhnHFBPlDlwqcC4Si4QLI3MubLDtLevx1pg2mbMUcbdTJVuD6wjPw
-2M3z7zk/vLxuO0PVLSQFW4YxMl12iiKbTPKvPWG2MJUb2rPW9tpw8lZhvIhtblaBPlRGdif0Uh00VFeT
-fbrArABuZwQbBOdiV0zW2QYm2P4dODCyci7FdNhtFROoht+o5fVNx5V7s6araaprPCfxxv2FXXcjbEHS
-wy721EUCbiR8jxjRBcUwmRihrDwvFqMhGrMvyO8HWY5iMQesLIH0w+MZzLCDQsgWc5JxwEOyWg95BcFQ
-B02k8PtTOeq71iyKxQ2WwcMxISQGCudp6/LDwrC/PSZ/Lanf+EGYkgqD8JqGHFiYStpgsUe/ivQw/lW0
-KrqVldAm9O96K/xsMQJU1sY/aK8j7i4gPvAUQ3UYb5Wmw1yoR0Pra+xFpcNSetKpHq/kGSNnoNvbLEP+
-NUXRKN1FsUA8SYKSCOgRauMV1M8wsJzpNRKs3pBQVHGwe53kOagTwRyoHZYEetosR22jah5owmSMexYd
-XkcGbvMPq0L+RCvn/4TVwGPwf8B64IP9k64IydMNrhwdWCFbMsyBWbGcAZjlsSyYazr/QDOriB3nEKPg
-u9fWdthC65HtMWTlnqDfJH3GSSpmSK5d9FZD3n8OSKDifDgiWYzPdcuqhrWVARKk1lghtVbaldQRk+yP
-M3bCfMBdclHWD6Lmpag0/S82ro8rJGLpyKpzuDK2VcZ0yejqA32d8eX0H3eEHao0MzMCCe0NqqVvtYvz
-ud1Vn3h91nGCPHgOtvWjzVkdA7diumTM9uoFYCEvtIE7biAS6e7+6d5aoHK7X39UKdnXn6Vk60vJCjOz
-5Ew0S6dserdMGvaIDLIwy+E4ByFxRC4WSfW7I8etLY2z62qWjYsLD86D7rn8nq4weF+ojhpfGebm+REB
-WuH3rl3XOrRLW3iXR2mZaQ7vBhIBvI0B0OZiArgTLcObPGWMqDG9Q2B90y7Ga2j4l+kwv4rSfAQOfZZI
-M3NFGGhw1QaYYpPCXMSAJrt0p+k8HZFvMgI0lVIeLkvwoZCTElY+h1EGpcdialrQTnrgOtdDcDdZ7VAf
-6SJg+NG1zvn7mFse4vhzw28eKmWiYkg1InPcCWeXsXFf0soqqWHUHxosQ19i4VEmKgRUAO1Tt32qdXND
-vXXF74LV1Vb921IMKHnRjGxHV+Kg1WYIkICYFdjBZfeYMdwIW/YSWW1EjZpk6QxivqNzpykS4cHQxNPZ
-l6NCkcoiayKL+oUI2ZDWuX5OsgxivKATt6zGX8y14KcJ7oTuEo+3SJ/coRyBd1kyEkQtKgG+qgdtdYFt
-etbGyGx39/69m6BdrHNOf/PZUenTH8lk8kEWH49Nl94qOJObKLE2g4PFLNl4Or96Iseil8P7ZDNsGLmO
-l4hv3GIh5U4BXofEzuDPjTQvGcBICOIQ4MoVk20M/Zxi0CbEI7SW8gSMNM7SSYlBRs+QZ6AiHNbqsLDV
-Ed4Q2MunM5hFKI4QxMya6C8gOv9FMbpAHHyAiwCfXcDYh8EIyhUof+UG7i1oCM6fE5QHB7omM32qGLNC
-hf2r2qo82CRTW28OeNFok9VLmtR3HEwcyAJ96c0S5+FpObHnspUmoB044iz3JAVC7JIncX28gHYvM3Oo
-D+my1vNXRK/j5w892m05NIivLEqq9aYnjgQ2MY/w0uZgMUcHifmf2b09/33YGIlFTt79dGw9w9/W+V/Q
-CULBwL6CDGOwyiDyeSm5AJjzfYB7lr2DIAwU/Ll0Ltvea3WZXcIB+D2MvxWEtET31DsaNwhP2FJS8Zks
-/oe6ZFYIyEUWK6BkgKvC2oJigktcyx39L+AprElQ6JNcHxN42Wq3Bh41iFI+1evev0fn4bc3PQ8/X1HX
-Pw89P2t22HV2ddOsMKxiZ1z8Zhg9Mj78f/9ZAPddzE5Pd7Ly2+3hLD2dk4FSmZnD0isvPDEd9B8BWdDq
-a/NAlA7lUZY46xV5wTrQZNgjDJzs9UD2xoBUsHy5STA6vIAcMarjFm8muCRTGVyrjaThR9u2yZTTo58C
-8Q5swKWJISxoiEtgAu7xe3ManaEtowu8ZL86hAEp45gWyUOX67VN/cZBkeqk/qiR01I+ceJGWz6eHrb7
-rogWQzeYpWBvDe4mYG052dceGIsZ8BTv8ozABWcEPAOLXMoZmB0q04LziFqDS9SlzpSxJG4MhJe5ZGRk
-q2PFcsiXDoHG0TvOrOVtii5ZqiuONLG8GvfBVrIsCH3rEr5KUX3ERJ6Up9lsxjcbNX9vM/SRgyHyQR/8
-SacLBaRqQxYv8tS8eG1evYGYr+Y7/o5SVBXqFqUgFepKi1tBUv3ps+XbH0QwFQRF7aVgk1Dnx3jJSKZQ
-LODxH8N+MP3hmDFgBOWj6k8L6FwO4colFg5BhwpNSfYTsYI1mxXhSG0FaKyalujfasUABOyLsgOHCZ3x
-9hYsz56toMfQpWYDTyxaKsKEtg3zZ6apRzHUQZZFBgRZQlhc+bzMRqdtvBikIDsn7FmkBOnETDPIuihE
-HEkb2uLzaiYayhlv2bgEIB/DecvNCFxOcCaV0YFhYfe5wS+o5yrwMtCiJ6CcLJ/Y3Q2Z/s6IYdAJQbfH
-dx1pKpTFRFvTafls8kbST4upiM/oK5IMSdJOgubs+foFSoVnFqk63uvvcBhioWGnnBICT7nLon440t12
-kt7/w4eBm3HD7qvcqtvnhlKs6rj5/4N/k85jU241ALYENwin5o6wzhiY/39t/v/Nv9FY2Cbdeky8ktzY
-iFPRS3N/T0t/ZKSa+vG4uYkhD4/8MAQUy2YDwlezKyS7gDeK7dLU0vxAgw4g6IQKqCIxAFMaM+mrqa9l
-g3hYWmxOOsZNtpfV6qemF4u0dkkYVpe+QVuP89+sJia2KBbluRtyf5YODp/s//Tjq5MXz5//eHJ89L8A
-pmb33l7l2+FL86FC+d1Zu784I5CGHrA/jx1EdI/1CjSqKYnEYD6SlLLAdKDBCwLD8eHP5xnCgKGMfXTV
-orMN8TDwSJO7Anwmgxg4oM2HoAVQHNhvAj4j8ToAgcihNnoyfj1rMEpY42iWY4NEpAzT7SrsuSnrJYVb
-Q2XSLKbEOLVC/MMn1j4gaGKif5MrEnFWi5LUYGgC34mXxewH4cjkv+EQ0zt3Zms4Mb0Rg3a0OaPbl8+y
-S9maQVpYR/LVW28m6es33ldz8yPeznzi1n74ECwv2S13bK5wZVc+AKJSuHxl89jEss0fVgjSnrscSOo9
-uy98nFNogB7CrvcEpKWyNbrVV5Cuwi10q68wXfV47cZeQtrqKdSNvIsLl1Q/N1DirNa4/PnzLeoPukWh
-gk3w4ZKzUdEHpfbJycvD/cevTg4Of35l9srxyV9/fP5o/8eTvz1//veTk2UaGD98ZjzAHn4V2wUynDrP
-R0NzcEZsLOSTn97KxiMZ5Jufo4pB7N4HZRNIeaxk/OKnJmHM88tJ1EJEf/byMbR6JAt/iaVmPIcQ6zia
-xMtPCPFhFvM2TEUAjvWDGybx85Ml6ZEAwcXyB0m8/H4IlNhsBSm83GjaEcmD772UL7LZaczLzbz2063r
-EmdzgC5oMshH0bXgPnp5joEMzV6iIQUwK9WMQYrNAbMBbZnNRwKTIvdB0gJzhXvOT2lfY+3RuWQEwKY0
-z4shIDYf/OgHGNhT6Q3RRhoZTc8fOf3ILMG64tU305wNIwUua7ndYGEXkqUdCLPZniTL+qFyeR1iAy22
-A3/olnVnbJgVAChs4KtGG/whIZH55XZDh961tMUb8y1CbsX9YwzQEx4h7phXFmYJjCzC7/yaXdTRJCVI
-gS8ZZAaBXuzq2mJrcUtgugHBZ1/1rqVY8OKFc1rxNyd8ZKBpwwH6AYeF3yCz+J84HnEkjB0eVx0/YDEw
-iF6+PWk5TS8eK1119HTU+3a4xLr+YztYFF3vqR2utK7/6L6zY4/t6RiepYM7O8ljq0iAuK/FtJOAGZG+
-SEgkeAKtmwH2OxbCeAnMD4/JqEcGQJj+/cmQaFY4mbQGl6RbUcyjK4CHWK8oSEtcr6V0Xf3QRrZ+guhF
-9Ne9eVUco4a9GyXUHT+Rnw2uoRDHbDFdmdklhSIWZMRl278/pyar3saT4FIvkWDYZeORRP8jJAf3E+Zp
-uh7v01FfxMbtb3K5/a/j/07KKTh8tFnHQziIOYnGzV17jtYcIA6CZXJyQqm7fF7hzQLMLif/lFhTM9Od
-fGwFKsPsYl6AsojY0eQcqvZhENlCoQMF7VNkGAxJnPUXZ2cYgeacceYxM4QlwerAU5PeAx5JBw+GrURi
-sSxne+PBdNbKykcjWhc0ZD822HV2nZxN2rSanexWGVAiqQGT1I0yV5T0qaLOTx1RdpxKN+RrKIHHA3bj
-3CPQA7Il3vD0vR2kNMkFlmMKq4hH82KKsyLxbrLRaYtMgsQ2+DJrUByhx+ezApzWrJiHAhzxWh1j1EIK
-EWv9PgWOUYoD3w1oH8imUAgCDpsdpzpOL3KMDganzWz/DLctqJefnzYbVL1ZND8k27u+InS95fvQX75a
-PwoE1NzsO7h/PIe2A+6B8zZNDrKLVwXh3oAPWh8hVGAgslExxYkyd3ZD5TKz2rqhC5kYNJz2DauyM4MC
-t2UMY65joaIcioZtzEDMcBC/5rRmfMvzfMxgVMk+hPo1VA//tr2Xzm4AbcbqPnqMTPUzz0zdZ8smGYYF
-QoJAhCR+FmmcSg2AzfKZefi32ZWNZktnjEpfTkf5vPareR4zeBePS2rHhUsnFiGo8nSWZb+Rhv/NXqCG
-zyloRJ58X5kEaz2Rh3EZ7oRJX+dvYgsvq3hSPp8gCsIYzBcOj7/Bqd3BftjqwXqQliQqKkHee5HmI8Jc
-WmPdXaYzsLTbFrDm2AKsiQnB0Uix9g6rhs34NO51dh90HjRisjNMu2nUPy0w6e7e+/NSKJ52bbgGX5LS
-3b1/v+0LQMyrB+2qBMW8/rodij/My+/aUQFHd/fBvbaWLpgXu+1a2UF392vXjopkwHz9ph0KRczLP4cv
-ncjDfHUtC2UF3d1v/tT2hQLd3W9d8/D6b964Aav6eIX3ePPeDVt4TTcfsSx9ye7ep2ly1+nu/QdRkeh3
-n43QfyfpJ7sX2HW53D1sXVlKxZfML5+v29bE+CVepUqJxkU+NuJdA4QOh8zaBEdjIIF5MW2Ja7QltnbD
-dsiD/Ml/mkanI/4NxwfSVXyWIEr2zhR6lXCtqttI0lpLnC7rh8NapoabBfbC/c8BSX/nvaBdbT69M0ag
-PPjbol+rOoBAt9UcLynY+FVtNkngC9VVJ2VPVqTqYRopQQJnPs2g40EXg4+bC2zzEis+tsaYvvla8NWz
-YluMxyktrF7djPYYNgftOUG+wSsi2U5eFdPtEXDy4lCCQQJLKxBCB8txUc7Z5N13FSgJpGKLPP9Bb46q
-+mIwWIiRGAZZRdWkuVMgUinRPLMJDTko835u+NorNqVLEjVT4nsmfi/6Tk9OmFgBW7yK3Sk4nyDIPZdH
-PWczZ8bU4CZgW8FLhXTq2Ooy0uyOG65fgO3k8B7YiGG2PVwY1nwAQjMJHF6ABR9qJ4CZlhGD6XxbHbE+
-Ws7avXtJ4WErNT8pZpeAi4vmfjIZXGHTht81q6oY5Cl5x8rMwl6WCeWKcVrzeQtBM/3t1rPjbRoEBJ8C
-sZdv2a+ntAF1L5lOQXmFeHOg7UXEOcQuOLBoDGqkKtD0mNkism6kuQOXIYq5zhaRYxRkcc1fuEjlX7Qx
-Gvu/FnARhQ3hfKJgqo4OSjGeABtLCvQJz15MzromYutUVHSMx+F17rlhBi8MIYCa+ZbCjk+8Bq9Ma8ay
-977aVv++gp7gMfUBr4+GH4B/H+zrmtSJS8b/1OuL8HVtlW7PqSrNLxtWeHlLkrp/Ou1XNe2ty/LVBwo8
-jc36sF5m9+0De3vhb9fsD0uafVHf7A+69A82szcUXOqyIdCF6fcd8/8fPvgL7kOsMHxJ88Td+lD53AkH
-yT5+5bf1A2pRODj9h5qFUFvYh1fp1LbE/E93U1omOArLWvY9TrWeLfM/8rwncnP7bibm7mzOGIgr/mHZ
-BMQKC/7VzebKpbHeEkz+d6yiNXeO+/jhEBxId37M0otM7ZyvlmWWCr+q2Tl6kW+w4T8k6ZROR+AroIVE
-W7wy3etKf5a9jjRsK4Ag4s5q1sLcFwz15zH4K1A3w3K/WMymhTlWaUXzCBwjvSaWF5m5ERzJVz+Kwyaa
-yr2/FgbuaTHJzVYyH44xdoOg356mozLbY/WtaYDhuKxX/WNEp5ihoA11JBB3iA/1Uf42s1i2ibi/oj8Q
-8E1Qwtz5EbN/7GVGR7s51VIob1RcZgwsYY66SdITMPdeq52ACBKtJefqPSMZEBiBZW2gLG4XRcViPAMX
-TH+M3gHiNY0D+dTMPRk4IIRIMX00WgBGed/8QQBr80qQjBoEiWRfgyMjgpznDIENL13I0sPJEL66F+bi
-HEl2TAEddEL0J44k/WlKgVN02gW+c4lVlKoGx6wyY7ZwCaZX+GV6ZV8tsPqFrfGgWJjpku4N+yOvhwez
-FHDDIVCTfkW9hbeqm/QBY67wp3k28z6+y+fyzfzUn5A08LcR/NYfgY/hb8WFX6SMJ3zzBvIAYyCY98VU
-Xgm4uwN2Ny+PALTJvETwJnn59+wKtA7m9dvsCjQo6sOLGcY7gS9T+Kk+/TSl9wtb5dPCLFouagy/dWH4
-8WmB/caPAGfhfXyOTcNvhWsdfaIxoW9qUPAjNgQ/uaa8SEtcTVP4Ky+JMABcO/6wryXcu90MNgC8vyte
-ofchjeBcftuPYJXwGASgUAP6zg/wyUtASwm/qrWEn3hs8JseG+cMLV+9qf/lPMMaL+Fvg5S9HHDfXAom
-YJxiCBAQrVOz1OclMu8JH/HWo4pos7OSVkpba6U8V5Tz6MCsABDAnyBRVe8b5qAj/UjzaTo/78wMF16M
-m60WR2C+72EjnWVzS9AN8T1gMthEjfs+u3+j8hoiCrSTHn/A4ANpcg43Yw4Th9dBDiWI5uM931+xRyWR
-XGYEYhnSmKzwbSXlAVfbrg6C9XvkJK8rKd6gNCF+9Hz1FWk6qofb6/ry3vDBR4oRlgtuVoKGzV0it1CS
-CIKNUNdaulXZO14HDk/Ey3iXwsXBCjnqsYfM7pF2NRvjK7N22knDbEMiycnYusaDjNOJCumEhIijeOtP
-ky9mJG5KSdsNophmvBz0BaCqrFgO4T5QKsvrvK691h21+f66XSPSapMu24qZSb2CRv98m04Hb7OJE/lW
-5S0KEMOG+I0EiA1hJaoFcUoKEkRFxWqzkt7qR6c5rH6DAKGk6nnF8A1OnVg74+deBk5vdX+12aq1W7s9
-/VJhI/l4IscZ0D2O5FnM2A3zis22hhYIslSYrdkEoWo9wT8PeR+s8tPJtaRJXgGItSEmK8uBuTDjdjjh
-UJN27DmVhsnYYDSCeVovU8c1xFZfO4JW52H7Xu0ziHUqvc3LamcDjcadO7JyNukz2JRs0lvbEHMUbdll
-F3TzFwGEIckh4aIleOgqkCC0Q+GTSlyC1TJ5koNM9F1y8efOvd2kiRYqhSEcAE5Pjr4tQyTP834+R5DX
-GcKNSjgjtKSigpB+AwffKyaWZepJQyDMbTHO7O0CIO8J6Ui3j0pikB7CIyqvxtN5MYZ4VDPOmSPQPmj/
-EQONijG1ofcXwhQUgJPGwXfuIq5CClYtyE2g6DSdU3tNXwHhvYlu23QUyJjBMjlDPKIWqWygKHegOHEh
-5PX7jPAFCOhHJjzJ38zN6gKE09R0KovOAdYX9VT2+KS1SaqYz0kAas6NgRmEkhs2AEkjhjNWJYkIOx0V
-YPQGrFT+/Jhly+3kn4tyDsG8UMmFGho7ZLF+zotYs1Aw3ob5BU+ouZg+SbugHYRhh6byptWyIPRCg3Zr
-ZCDexqWLqwZBl6jdEI4OIU76o+JsJ50NzvOLrNy5f2/33s6973bwinSChZ0Ms5GNvsZl7o/KwjBmeBPo
-7fTkpkA/4QbQE0gsvitzr8kCDKbm6LAtYdHukmjbpbTRzyJ0WPCY9PGPMa4p0LUTQCfNrHPWQQxt6Euv
-VQPNxDGShQul4y2RRxYCmB6R+Fk2wcwSO6oPHNCU+tRvWzteifYhZrbNHHHRpA7fMS8tt2cSr2KlXb5h
-NgVF92SQO1Q1X2/XYYIctv5A5XwdfhQLps2R2yCXb/fUTsBRUTfU2Twl3yejwPTJ69VVkPN1/kajQ93R
-Zk/mzFGjGLD+TVdkCyOAuJSv3ac3LVVeKwTV162KQV3h9c3Hw0ev5EDl2OT7XctPutmBX4f1Kf/ijWsH
-qbgl4WteZt5bZdXl41VXeocU9t+ni6o5N+2n3weQLTJbAAAfSPoHhGDq+bHTAUzyCQUHp8tZQcPNZXuH
-CP/O3JxB5Q4V5mJm/oHDevD8KUpsjp38ZbOh3QoGeZ0dRpVZI2P6F1+FIhfCyGW3XoceQu3KEaNmVoas
-vOlQxVbhJ53yzTqwSVN+OXp28PyXk7/tPzv48fAjrw4Uk4bRSlZlAkH6WguKha9/xHrCjlVmQ4f5dP9W
-rqZP3VgY0EpbrZ5io6YuPWaw+/mkesZg1FnAvUt6nKaH/DQ9FYu5vXYRE0gM6qa0OeSv7/15597XO2L5
-Mzk7MWzl70yolyyTfPJ7LJSNWhtdJzJFNzlPtrzpM4fyOH0LxkzmWIblh2uAwg0H4k6UdkiU4nRwjo5O
-g0xTBMUtRjsCYlwgDHvrZsK5quZSKz5Q/S3hZ/0N8PEmzVXhz0bQMs0/+wmrk6amTE1YDTMejM51INCq
-BFJI1oukIC8C0dXHGLcNkPYVIKSSXlXI7e/cqSipv3WvrEyO6kKOmUUGzB7D3pxlJZgCsqQD5HcD2IyS
-BEPvMAhmygIGbRGB5sZgyEiQf866DY3TZvnZGWEEQCBpMx5aCPHs+avDbnKsOPXSl3NsIHdRPHvArRNQ
-M2rxlFmDs3WISFXxjltjD+HfmE2vzPidmy0TGNB2+IvKWd5ASN8ZUxtUMU0u2e7pesMNt43d9sVBQkEE
-WwJSIO2yG9gzd+oStglz1BdckEF2tZCadFiG0l1V8qlvmNZKZiJp1TdMC8zAPKtN7n9WOfZHI6vjq8ml
-k4Bb1CqPAT27q5Cs/Qrh9bftGpNw8+1PKzynKmo1cCZC16XQ0ru7+x1mq5hod+/f/w59GXY/g2n/Tr4M
-6F/lnLVu4sfwckNQF/IQGhkWLJvEMY2Cjy6CEy5gMNX2XfBC4NqaZFIOg1KgodKygurTaWeC2DCKd3OZ
-nGejqWhHLMgGamhmCZpKoTEZ6BfZnH8g+B/J0Rwj2UKIPFgwi5xU0lDSNCWo2xz0DuaMJXe9xWieEyoN
-3negNIz9gJIqOHaLGcPnoE/BBKLLgYOtr0QPu/Iw0I6T/d8c4l8kX5D2CCPQf+FAfzmkE7gXjHJQ1bo+
-0QIdpKIYsS4YB8+f2uHtgVG6KprsRkhzADnGiAQR0y78xQZsyAzRHGJfwE2qTJ7hC2oKALaUYcgGm3Wf
-Z4PTWvsayeBsD0Rd6hacQ4ax9CtsSZtgvFP8ypqFd3OtSxi4TRHshEphrUAOP0kRIcIW4Tsa23kIrhiQ
-y7tc2FaAPoMzvZ6QukDSmKsX+s+J+51t9ZhDjsGwZkPCq3BGSikybFeNWaZL4vBknWoLjhw4a+3Gpo61
-CUfetdBvuXTlSOGx+rcNy0xLxghjS9RA3HHCrhN3S06KKSK76qUEI79szU5n2YUliC/MQ14sSoiKZcF5
-hvHyYsv/3fzZ2ltAh+e0O/+VW6XXesn6eTwt3LsweqfaU+FgrLGvyJQ0sqVwrvRo0a0k1mn6onccvgh2
-HUUOcDEDyFKX8Uwv0yv26hq8RUVuSfHoAIY8x6AAFIMMqGIxsyEEoH/gv2U6bLZnp5NjjAA4wn/Lp7h+
-bBZyzYITl6LowZcxCLa27Pa4kM0B2+75wfMuoG5AuD0giSACI6vHYduC84MloF5QrKfma5VpixQHTTJn
-3uAtWEan+aigfkUKV2H3wEd9GZmqzgRvTLzpeNkh5ItuqSNElSDT164BZksr2id0TxccgCzoTysJoB+D
-wompoWrbVoBe1SNsOqKffYIpOR2GmStHZ+yc8DJUmGl61F1+3SG/LuhvPfPSVM1o6zK9EQgYN3OvGmT5
-ReZKUcIe23ivuPhBZ7MpQl3tiR4eOwehdgS6aVNFZGOq9SEcVFM3GSZfi6vkF59wTC/5VFOHEJiKoR9l
-Pm+U8SPM9mytY0x1QI+jfm22wtojWKk8duSZTnJMRHeMIYEguJAEbGaQoTWXJlFselsuvnW9uanbcQQO
-Jf/uNEOysHrLLlux9XNuB6kCoQP/tbjsrvIYI0Cll2i0U+EE2OLIWRSKRe4ADPHMyWZTUmmMxKXRB9CO
-d6JXVR3fILXfkHeonrs8cNWDN6xJhr/Cf9YlFBmW+op3Lj+1T81WzquX3Qu2uQRhIbjmWFlJHXpJeCft
-3t/dbS+9bXbv30eZyZJrZPf+19+gwOP+Z2znP1wKgpuxRsbhY5crGYH64OEmPJmlZzXYxfLJRcycpYCO
-BLK+uDwkkkAqY3gmPz2/dBVE4qmohncq3/eWxCPxMlZTOMGIHrNZxgeIBOiBZfk2y9DXTTngo3GmIfCG
-oIxJMjIgxNO7MkoQG5jBDNOLIkdnPHHKs2bNdrIHKgjAE0Iqe2Rq/jvVrMH/75xa9H+GNLNBT+0PQ7+5
-ba412GQvjMBf7toiBCZb/qoCmB2y2Xecf021oc2gSe2gAiKvGIg4bPzDsDt7QUoH5e2/AG8TPdcanr9Z
-bWK7uso8pyEu/NgkHdGdrinLWkA0k4FjyNpJ7oAOTyu1oarByw3dqqYLh4Mcg+TMqkkctidvac+bozmL
-vyAu3OLsPMI2cUALCD43zQZgWzwEuUcPwmGUHUnfc5Q3E4HhUM+WiDUQQ69F9s7WmtpK26AEw1TwuR7G
-x4DFaBtoqQsGW7emsN7qtcfQXah43vK2g5eyfplD0/xPwQqXj3JflBa2dWXxRa7lWGHswJj4xkZO9JcL
-hzWvrmMXWaO5rC24i6pkudoTteDbYSOwlEgTOMBGuEG8ZfiRqeuYjQ9uSFufptM6umqWiSn8ZVYuRnOG
-UJSlJ81FuXSGMik47meYtuzUkGeTailp5q7ECbP5uIQoh1nVkvV72LRdauv2tFX5iia7/j90Y7Gnvipq
-rZ50Ckel3cNSCu23tx07zD36bIpVSxXixHMt65PpsVdlnERzOj0ceuWr0eHEb7Ornyb5v1C7fMd+jt4G
-Bdd2AxjdDaJiMVMll3TbLrmeNzxOEnrS7HQ6rW5yOBmQ3yjcAC8LR44tAhFyqqbAtgLkbPS+LHsdItnw
-rbQSiAVWu8d3Rl1geY4oPVQU2jmp8qCi03xW8j3fHidwM+00XPjnMVt1JF0nT0cqCkNre+35PphdYy9z
-XE51Xv0juPo9dupySTTnVq6h6mMcPRdGPp2WH/lAVk2XAxnH9yanMkluX0nALICrAKErgKeSb6MpBI1m
-epXF1BOx7QwvWVN3xTenxRVF/MLFdJoO5pI2OVukM+hEepbCRZXiCysBwMfmFfQ8B7xChPLCYFVyiO5A
-pBubHhk7Hk2LHcx4ng8+BlOhKRk5d0dZjeDwUCyGOkb8Zq3mL2oJdpzNCJpQz2L4JmVyWWUoZNdgYkaW
-sPcHi/H4ar3Dw5Pzu82M7vY42zZ27Np7G33ka7b35utd1iS1g4BDq20KVt9AC9HUxHkrT7Q1G7KSOLhW
-4Xm9FY0S9X5LhR8JWG6g9xi2RO0RiiiBYPde21dJ0XBXrA3ZrCUm3d1dBzds5SLd3W/QPikm9uje/xpz
-iIjDPMeQge8/+CxQ+0MFaksjTi8zuomb7FRgtD9hGDFAWY/EZgs+RoNyGSK7JCCX+erl+jE/zR5fDUZZ
-JI/9Fg229WNBtrDLgm7ZNEtLABPJdUrBdF5JJND+fxbZItZ+9fUGyK5rRCTnu8HTHGbET2dfq3TPTytp
-np9uJER9evTfR8+OT/5++A+TDrM331OcH2KUr1ta9Gn2cp4xdqbZG4NZbnYYuOMXQ4RL7bltcgR3A8O1
-ZT1niXVsjjIs5Ioqo/40PTTsV4hqKmUKLCoFrmBMWVA49lUYWDki2ZIa2C/ogUN+ODh8cvTs8OT5s8eH
-Ammybo2mnn7BF5pYZWDjoOIiAQpvn4BH51QGQY05hvocwQo6Ya1wC+JTE2QUlbY/3X/2j5VtR0cQc7jM
-SMhBQAclN9wV+fznw5cvj8wV8NH+8eoBgULLfJyP0Jdat6edZO8G2RRZclOBuYOTyzz1g8oifpfs4QGm
-GfxastkZpqQLIJvVSefJTB5iKhMQLIwu2ltReXK2lRiwHkF/yY7OlGWRpRD87nxWXEaH8eTp4cu/Hh5Q
-x8n4mPcnQQqjmW/JwWndwUlYcJlTZdLQnJvTJpsxnoL6RviymCtjjCvBk8PjD7BfCLrXZbJnbMF4MGxu
-hbC91qYQt1evjeaSYEDoL340t0QEDchJS9ZevXQosh5BcOCWYgJByA8MwGEjLPsV5Nw1CJSMsKyYKU16
-pK/s8bqxILp4d3h6pc/FSlOAAtxN6FpC8bh8V4K7rAvnNfL9ML/44W/ZaFQkvxSz0fD7HXixJ+mu6ce1
-gmqK710GitaDSKGbBxTVW3YA9hfMpuxoGMZjnKVIXRnPgXvf6iTHhtGO0kFgVlEoWcwyRm0YmwvvueGO
-YBlIzRSamlHRSBIENBcJjaO0zETFukUozRIOxeKnWVAxD20KWpZEWrsEj8pPWLGkBf4M4uHACBK2OtOA
-BC0mCSYSpTxEH80Y0nqLBjjApf8eC7zmVxI12+5tOa/c8dLRVCpsXVG5etcMM867M3ZwhxAVJVOIjW6U
-iZYcKzO53PTcDr7EKZc6WpHOsoigrrdUwJrdPbBHEDLIpp+M1UJILrVxJdZqydTFXty8LaIp/HjN4RI/
-UosCs5UScLvQ3H1exu1e12sj5Hy8dkN3wOn7oW6nrE14H8C7XRRvQRsi0e88kxsxuEnIg8pB24tmgLgT
-tKXh5d1DIT0KG3oE9G52A64gBmVygolm3jEXs0VJRvhJD1yW0fDTW94kIMT2E4I/NZitzXpnmblSoFkP
-BEbMeoKZzqj+A0R+R7EgcxYTbmJJycH1wBy1/C6bUynRaCkrJglin1BIIrgv1E4R8xHt6DQQk0pNr5+N
-VwHj44mvqUCyLBeLJ04GvIDqu+4kNl8PZNUv764+TNUbcIF7DoZRgFPc1u9Pi+JR+lsXWQrz40lRNFvu
-+7X8vL7ZYPut3WCwN6jjsdp4G9XxU5kh/Z6a7T8rxv62gCWKU6C/8Xo0px0xBQ5gjI4H8OhmpjdK7Z4V
-KOA2N8IsIx5znA4lTAFub7PUzwGv6nQu9nWVbdVOyoKKy+d0u4Ddg7bfJQQozE5PkS/3Vk6c+5JptpZw
-DxM3Bh1rsnw3zqK1k/eQ5PqO5dKWrBVfTCLzOSmgydximwM5VodeKW2vTi1c+8yc+gQVcQ6ym5JT3o0j
-h9sIK4EDSRSwgT0LR6cbqUwAwo2hlsQsTGS2DFvah5v1lC6OENo0nadSEZUnQaF42kWHhmaYIIab4mXc
-Nhli+7Jlp08QI2eoysIhQtc5SZcMF1ja9zPwoKEhwaGClylGt7Ba/pSVJVCchdSzF7yJ2hR0FHnhsnJ7
-pMVQ2n6SKLVQq9k+yPYvJhBtxswW8H6wFphRhjLj3eCx131paktU8pMhq82WDRNK2kkIchszd9WBu0D6
-icHFoAmMk1i1gqgE8qqfxIN8eIM5jJ5abChf4hFAO3/tAUbEYQTuZQ4UDOiJnTEL2foLKjIJ00HsRHic
-SxxcJsmoGUaHedPwizz1yLNP3Lyl/ZK6w+e78mp7R2c+mfx7Ng9M4Lz2NL3TExSf5mIEO7VEh3hbXAc+
-keLnB0087Wt1mLaiJJJ8/F+p7QAE0HADMBa9WN9kwMyNx9y6irFcdPjy4uYA6BKB/Kc89OQYQ6QJ1ZJT
-ln0w5wf+8Ch/sXCXgCRmTpo2igVgUkxN/RTQHyFkLlSNQJo+RUJhai+2K7TDFw7gWvTKn9TNyBYoBIaG
-n0XBWR6cztriHRuNevaU9bMoc4HSaI9AAXaTtHlEDWnYKWZyxVh/6/Qk1B6wZD2iS2aIISzxwNxYU8E7
-dRimbl4Z3NK2ZQebsuNMNA2rSSw1HqVgN6UCOJuyqK/+LqKRsEe0xEqo20G4COs3k8DumhakI53X7ZEW
-I2LJJlNpuXDHdC1J67TIkFrro6vcyIpFGPNKxKYs+f446khYQTCOrjo9DTF5QHxGavmgtZkcy85QA5Lh
-IiMirtYYsL1Ulia8bZ8TRvQqPe7IHPfsuEJ6O4g9vvZAHjVyvfX3jJi9mXMTpoLOGTrT0EkFO0Mh3qoo
-Kv8w9OvuXbps3r0buVE2Wz1iNSz7tQkBu/3auYlX63KyWb9iNuHzGmWEnXPMH9PNT8uVRSoJJwQsjJZN
-CHxfNiHobRWbkE/NyH2cWfL2dR+ED+iES9dXlFSmyF0Rj36RyRUyOrlDAJYurjaZ1WHmgiXoO48pluMy
-LuV5et6I8I3G3KdA2OILkel4w+sWLA0q0DaYLlApI4RvcCXiOpdMg75m7g8vQEM+rL9masd7T5Zsnbbl
-plF3V6JyHl2BeDpFkyscfauaKUlo3yj5aiJAGTOmHTnrMUdnxSyfn49FjVlMTTFzDPk0NBfLHIsCVvEy
-L89xg5KikWY7tuU2IlGeu56ZBSqconcmnuO8jJI3B55Kc0uHnpHQU7jCY+oSVEKa/ohih21mMI9VNrLO
-ZH8EeFxnqA4GfnmGTOMoBZs9jNIl7v9apSB2JrpWKMzXgWDTslKuPVV1XZuUq3TjmS/INgx1AVt8XrrL
-UK4UPzVqCV8nlBxk5ZQ1k/0MWkBFc6UiYLAqkMUchRhczhesk/gCtbBoBEQdzBm2nrRZvuqDRw8LVGAt
-h8eHL38+PDg5fnH4GMwSjtnkCwJujtIrsNhQ7OZj17m2TiJmjup7R30GWG73xH6wTpEUL56+amwz/00I
-HE744GwcYCHDNVY41girmOwcxfMzUuvrPObVi42OqBTi7a8k1F3ZyLocZIEYeD+Dcg6yU+fjrWu0Lys1
-u09x456OzsFpWxosQc9rpXQXHYezvr+WCpfmk0SVD1u2fhrxdQb7DxnnjYZ4zdG9wcDGxrR+OF1sHKbA
-gKMUKmGYmPCVCJgWguBhyoOiCr7IMmuXLubFmAnO7Aysg+1JUlHwxOcwSKanUXe3Jpk/JmF3HrJA9ykY
-wwzJ+PiJhwSzogQ3v8GHYEJ9eInlTQre7PmERql74+NlE/xuC97WuHK1i+Rr2VK3pa25ziu12xfBCrcq
-+/i48Wcbvc2cCfhmxckg2dhbRLs9haPo5ZvTy3Yy4tFpiU01HV7Qi2eMeMBp9YTyq9BXSXIp8ArDBpNU
-USwRFfsBcEDWHrIslBGUy0x4RSWoukijAUsJdzqIrczzycnB4c8nJ5zjpj5PCfYSWBnq2WvpyhuEXG/I
-qCpQ58aXZjK/LMkwC1yaWPMGw75nNW596PupdaJblMS0ISfqXJjEx6oji7bsqJrqGJgPH8Q1C5jDxor1
-j3aur2W+3/gb6Jk4RwXuUQI3cR1ZWE+Rh3vOV4AmMpKMOWMd50pt7RmxHIqDp/xly/bCT86OUl38Tk4V
-dNU6yEu4VF5q00czm87y0XKci8kII8q/A9jdfE6xiYpLusDaQGdYLVouLQVUWnu52XUuC04PjMlWd40J
-XPC8wSBBFTL883k2npJQQV3KApc7WnTOrs+/jXSSQ4rOKZZ9M9EsTtCQWhXGuMJQzyidkrMftk8Gucbp
-zg3Cmr1vORc9Pcf2vmQtZ0lXBaIGuHetnGJcqr/7xCoZgYvwsFZya5Sw+WKgy1VlKRSTUFbijyGj/Fhj
-WrGIXnhFoSSY7KxvPuORYdlkUGSNKE9JNDgkeFJmGQnTu0ymVChTMLHdFveWqqErygXwjt5fmPsAjCkt
-dNxAZVZxy1t5lYPynWfeHfcoihC74m+6BPkgg5IxV3h2qdWDi+bXRmXRyBQ36Oh2i4ToBuq9aNqTIzrO
-KdYcH/Wz7GwBluIci7CxFS6G+ibSbN6063e060snL3+GeeZHXIGt2w/BrTu/upEwCFuCq2colTk6A5aT
-JDZy+D26ogWOJJEM162uo2pF3AbL+ExoPfk+UTnol0AIvmCyBPJbhrlFi32itUjt9Z6lPdWWgKZUFDcD
-6BHGqLAWBYbBTE85JmKZBdbMuCKESOPvgEY7TxVLqavCog5VXt13/MEdKhVwL70d7f5cAanoAypySAjI
-THZSZvGqVmu++JeMcTgpVDITKXElgeakViCJ1+G0X1xky2oVzh2aaRYNZNaAY2swbnuu+ZGBXT4Q1QxU
-efWCCLnjd1MzLMeGDE7twigmTkLZdYnAaPK0gFOd+AB0WJU8LCDA2AeZkwEg490HV0BV0K7he95N0edD
-MZy2qCYvzC+sofwXrY7Lfb+T8DAOM2eX27T+7NZxxWaiQImKxyQe66HPu6/BJO95Be7TGjpg2ezDpJ7F
-0RkBbjobHpi19CifcDZaPQjcySHHTyiquiTza1YAH0zW3Qr0SLvORjO0b2aG6rW9V+UpBMU7kRHzPwf9
-1x/9PvIC10ii0owwVuIdGkPuvaR6mk59QM66VNZxnv5db63KoPBBaQD3/Cy136PwoeGY+I1e524mpENl
-o51XEsy53WhCwBArYgGOxf2rGmKjyro5iy1LJbImkjBm03qMJ/OScKNeK33Lq6XlhwzyuIufJpmQF2R3
-mAP9khwJQFXyZekJArAEZDnxHDhDzHNnDmLK8GQD8M812X8/cZd6bKbHhqwzfJ9+8ITXUQvsSeEoOWuP
-nJIouKa0EVcEKXT2DlSVFN7YFSYF9cWBYDQS1bvYRMFOaot8GFGqp/B6lovBjay3083GI4zp5u/hJZJf
-lbByXNI/FWJrvRFfoymPySl1k1ZsBe2pUMUlFO1GgmE7rfvDYWIoSh/8KrVEjM3dnLyNr3/mLsj3ODZF
-DAskt5bTnFZHUYxKP5ieCD3rjzfYPcgtRjSQ0WEJdJFh3uSrpHFiCIPCA68OvDcJW/pvVWy3qUhZXVJ9
-2bS+p8Z4aD91yJPSV82W6jpuwmsLS0WXeECH5pZUGdK9Wwp37rh6IsKYFTKY1AoatAzFkSAQzLS1w+Hk
-Vwoyz0p8T8PeIYcwQOjWpZHBTWiBcCVS9z0bXd7QSvIyqhoLqOKU3cBKCY8aG0vUaWqOJuZemM+9uVFL
-7iNMiq1h81n5SJIxLR4VG8n1JGO6+S0msGp0ojTUE3eRK/1lIT62YrM9Ky5hbyF0ATu6ephsIWiQmLeZ
-XtP9Cp0W6K1QUjB4XMzZwK6aFVoBWUuw+Bly3ij2FVRCgoBcu4dQ2Qgnzi0GH5AriteQIyhcKHKD7gM5
-+yWfnz8rDhYUTi/7e3ZVNk0tiN5KFOSmSwzaaqg7dA7+0DEAL/EEoB411CdIqD+x0GlpS1vd5DF5NxI0
-wqSYbAtuQlWGdNsW8Qa1tBv4UBpdT8t3WblBmoSKHN+GhTetfW1KI/0amAshf6fUa6uGy9OevZpZMVWw
-G6pYhN2EAAj9fewVp8TdbifvofoxnRkaDBI+NrLjpOg8QTaFl4VfWODd2GyBECxQs5uXwqrSTkExHPfA
-Kw27A3JY3BIgoNbXAfPMvysLJjLaLcvPue/Qfvyp1X/WSt+k83DMkHUslYqTzi9yQCxxLKwBHfvVm/lB
-W8d85uN9hOTIwaLCwtZwqFQ60acoah8RokgWoks+8pnL9CTeh3NaTykjjPoWgQASlV+grbCHkFbP3vs0
-SXw7PHLGWZqah0rN1JisHRDJXhEUmW2SRKCBdH2awqXpYHOnIRqgbUvfk8xB2n5t2lTbUWBkKyX2WLqD
-B6ZZrfXS9Tmd4BXiCXi7ZZifTcypXl2H/79chuHVbvkKHASpZc5XLb7li86bLxB4oRqF5A58a9O28FHG
-xLFkDknm8rywagTgTs4KZu/6Gcl6a2aGczy1LfDTV2fl1TknUOA2aqQBTNrK8jBB0za3zVmcWQQWJKJf
-KRCKcJluhvmrChYJ4yN45QA7bQV7SzMEjVueWMCXwWjKhbRCciC18S3XzfEgbtTisp70SSStK+1b8fPO
-3SQrzW19vm0yI8ZNf1QM3m6XA8OpDLcv4HyeFNt41JHBX1IpCQIDOJ+1S+QHzEBarsis4PLh6zft/3ly
-cu/hLvzZfWhXtVjzwsfv4RP+AsteyNaZLsrzpk38Gr45612ITFSShm0+u5IFC9PfgusbaCz0UttDjR36
-iucXAKsLNzhXFNEy3EIWGeIL2INfOCfpRaU680bwAaiYUQYOAmAX1VEichkXWHhu/2EEI/fBPxpuY4WV
-KOAJ4XhoYLqieXf3MoXWhghWsoWFoPhi1YZygOH7MQE1fVl68lRvydr3gVWUdzzewRmn9fDph0FuswJf
-n1aGpEpQw6GgocQQqsjEwpJHr11PV4YaSMA222Z3OpQpBEVdplewjjA7wG2W6WmGaGJon0sIOqa02wy1
-40zcBvYN4SX4kk9XkYLwmaS+VI+uxM+6HuVclilKPZdl0BQUVtOez2Z5GS0VsSQwmyAFZDJ3vefz7F7W
-yiEMOGFm1rc1wWHl86c7kJcenKU6BH2r1JTT/Z2uq6599To6xL9eki684KoqWn5or7HM6bLiXqv8NsyX
-zeB9haOtyjRsVfZGYNbpcFo7CIGuiQY1MbrD6s5bEDN3QMysmuZ2otwlA1NQhAJHQ9npLCNTqYOM8EqK
-GfvqZBOzoGewLhWYkLn1VpFlcHR9YTi6kwWmrvhuInavdsE1NhQfBrTWo7ONL8sOmvOadTvkvgFEE/gz
-flkyQPAInRhJbOogQd0lXDU78morQuEYKZYu5XYlEhfdgPY02to2fmFGFD7SnPh3I3jvbMF5p4O2hISY
-dC4oOwcFDcqaXRSUGqIA4DiDq+TUXCAWMwLFw1hVFowLZFWi+QWpiDiCjYthJhjrjzUFCeEDyU4vhA5U
-gQQECMtNhXkilApCVLD6Z3MIlQmEhUGqNSnB5EgQDHBCwZAfOyKIYsQxLFiVbbHn2sgkFRQkBtkn5zEy
-y8wkDrIQUMswQgxCIIFkrGlSABdsluK/4O9LVRBPsxSiLuhhafXlPeaUXJbN6Plu6DF+TCHw2WILYUgB
-0cOi3fkYpxqtjPLXgxdwujbtK7I9u8xLi1wA+i/UhvOzWfE63Hj5lPJHiMSN/EhM3xhSu4Kj3WH32j3F
-7FLij83RYqmdE/iSDffB8fpldloeTV6iL67H4xkiA1Z3A3E+tQMCLCuYdOdz8eE1AyNjVGHvbAqHWZIm
-U1j99thlRWbp4MsgLL9kCMqbgBBeSF5ZwDFDWvTUOVxBIYCfAjhVaGguYZohlke8gYZvXIB6H7p0agjQ
-U8sxjoqzfEBagADGCNtbx9hbF3nRd/mWEjQR5gwCQtxs4Tmyr1yul3GgyycRIwKFMZWdUEwcm1Wo3BA+
-HZqF+9en57KOKiVYC6fKF1icPtB6x3qS4xCaqZPEdBrtVSnEcUaQuABkPVeuwtOol7cFRgDheDoiV7Jj
-Ly+AWOgCHKqCE7EI4UoeU8QZ0g9NLciTB+2gaYcpbNEfgRM0PrjzwlIW05LQzU83dn3afcwFMa3Vhfw+
-tJsPDwuNvXJSzNHybzghfJiGkyKN3fgw1ZMihXyqCUHeyudlNLS1PrpMSudFGEnujLnbNsGyb5Zv2lKY
-/ByeAngYFPgilEAEq9zFt0PeLwhn4Xeowpc5GXuMRaASz/KLDC+OEfeiJSsUDeMeR2AZmqR0Rkc6Vtc7
-kO2AFXGr1s2Fhj04daGYwgWKCB8OZUEtR20PDuOj9PJ6oqe8ZD10q4TNJfMAFboUBy0wGe4DuAeEHkT5
-spXtQ4BsRn+lYiQd8qZlmQG7PTEMnDmAz4BUW/5McTMbG1fdhskhwBE+VgyJibrqmrP62HIPLBgiEVYo
-25OrFt29Ugz9hdFgZuHh/1/H/21Pe5AxdZPz+dzQlJ2d035nnO3gDX2bbjNcypKD/nrLjfkvOZI4Fo+Q
-tE0NL95HlxsHx2Qb6oxX1TngLLb0sBIgjZmFwgcbcNB+pcvQQ+cQyR1wu9nsWxD2gSstuhyoBVkqNxbE
-+VggeE/mign018iBVTFCregIAbIFEZP3vmLIc10U3/TDGv4Sfd2U2dq7zSInxwtyJMSZtfsKcxKsH8Gw
-XYFbkFPHQ9XOKlBZBPpdAjMLq2FvaFN0O2FBxzonwOcP3orKzTcbFCKSlw5QsZ8OzQOA54AQG/ZaDlyo
-+FgjWhJe2HUpcgoT605kydDrPANJuEoazJAe8dDK8HYmILXDpwxY7uwD7H8nL/FvU6dteb7Y1dXS9SKq
-pBb0H667pk8bOVjLkfc4vCxEHdtu3CNlDe3tbJ2IGZGKoZhjF2DSsssYu9Gs2pe5bFpE6LuciVeQFxxF
-YkrLfMZ8H0mnicFldHktuXds1WWMOE1yarOQjyzeLqH9agALa/FIvCpwyexPZXHA2MbC8oYb43gMfcSM
-JfmbrYpX2IYES+3+NJmnZ2RFMET7CE+9x3y9k72T2zawG2/brjDCSctB1YcGS2RO5JgfhHTFz5MCccTM
-eOEjR/RMXVHj3CxPsGYoksusYQYayA/qgUi9SEZXhPfkrFzllyvHwSejKEqvwlkGU09aT62VXGvKliKd
-dLT/wz5Y/JPtsHPZua6pz+2XgOLU1VybYd028Oq5OamNt2bmyZ8aOhYPhfeNseRIUpfF/CHC6JPFZfW3
-tM/hhpvj5gzrnToKyPTnGOVgdD30jhlGLec9xqFxPQO9aBnNVic5yIe4OyBqUByxtdn6S2DWCBd1tJg2
-lO18lpaCOmyuqiWDmpJAXLs9QGqvGOuChEDcdBySJkELyCoeCDXysQpAiNDll5mZiwxhzqEwYONR+Qc+
-FwtDKs0lpszmgn1ZRsMSJUrdR4MsMDQRDzW3ElANH53T166UNyt3qE4csD7XQWd/JP3Iuelb5UjlQ7hi
-436DJQ7x0lyj45oi76wUhVGtfk5ZMJhP5nrdfBfwx0eHpHo5TfNRUjA+oa0uaWblN9vleT7eKc/NNX5e
-FK3YGPFCCwaBpFnESZhmdUW6jy+QsVDXbzy51ST7/Aea2FASLZxZFtMVVs8GAV39EI7d3d1v29U4nt3d
-+1+3o5E6u7sP7rXDgJzdXQjrWg262d39Zrcdi6tpPrgMLnimef1de3lMy+7ut3+uTYKfXQk6qmV390+Y
-z0Wl7N6/j29c/Mnu/Qdft220SfP0zTqxa7/+HLv2D41d67Ddl8Wv3Tz+6bphTVeGIxXbFIfbRDgupQ2l
-YUNF0N0IYMR8QxVlW+J3Oiqmiwte6oQuznomqiBhPdo+6aMXgoIMbgkLwpO+K2nAiouVbXNgvAK0dADv
-hTz9UaZC99eFQfHyitbcFJANwYl1dCWCejL4u6sUjH6teGVyPrIquEx65SwMzKCbVhP7sLptLvC/DeBB
-TZwtDBd0NRmcz4oJhvtvY9NKRps1NWYXZtYILw2Mh+CwQh7mDNXWnQQHEbYJr3XCmqaNhYERRX1BeAw8
-Ltm7bLCYe8jYqEspEmkg6WCpZiynAH537mjIL+co5tb8FjdhqMtpW2+7fiasIjj3FWbDIug0RjS9y7wb
-Wic0MaiaHpUW6mWDUqxPy2KKrmy8thRKjbUnw2CDWTsJFr+Ye3CsVSY2w/z0NJsRgRAtrlkfdy2bia5x
-zllBfF+UdmimAnCgIxq5XuPSj/G8bbbIyCmsjN9aEjUGsSquMiiUFgsczBkbPOYV232SunxwqgFW19Es
-P0MNGL0RaqI6Ny9c0NGEmDbRmnl5ONYTyhT4Os3haWirb22iWytl/TnVmlOs+fYThtDV6YtsoAJPSaH6
-HqrXbok15Q2rJ+9iQK/adM59mlMGSWxgYnOLsdEX6HYKsodSi/VOefSwhX2A+3JxKwB2yGE62Tkm/RJR
-tuVl1aNYrej8ul2Pdbx1MyeAm16LvSbc0WNfHX0gvRB8GENeOY8yEa7KfsKR2NPesbkoGxZkdjXI7A3Z
-iQ7s9fJ6a6kqXptQ6dbbcVtXi7xM6+vZ0j2BFpcuiAi7EfJRLmbouQ6+kKM+7+2kuJwgfUBKTYF75hyC
-4JLs7u7epYgjaH0LYP8KI19oGww8QvADtwUWMmQIkJc2MBBWxX7kcNQMswyQ8FIUpTGr4p0WjZKnC2Qb
-FPeJIFb1kZ1S2Cei9R4zbQkzezf1onS+R27JciJSWigjGhCKYr9UDXt6IYlfSVGZALBpGxzjN6Spaq1q
-suqvsLr19UQtdKsB/CQL9MAZS+6/OLJmnfCbJL5mzSBWCtzskcXOBxrUMKNTG+XECOCfJZe4tjG2AfrN
-cwWwjsaAJtdga1Br6s2m/9kYVd1yOlPcazQO1WanVvt4mTmkBMPiEUgcbI4UiHPOYmmJ9Eas/oa0ES2N
-7QDhmDzkgQf58POnELGlm7wWYuVeWjlZAxTSBNZr2jrkz9pk3dqeYQ4G2lWmjbZ0+84VbgdjjJL1BRnt
-YkxH4PX8sJCwR6YZeYHAfSor52i57wE4VAOXkK4TmWtAToOLqin/bek32DfQsW3WryPNRmpEo8OBT9Mz
-8OJnPcW8mCYYJT9aF1vWhnXh64ZCdiA1vsA0DDPRNzj+nTGmm2XmITOIZcCZocKLPkh7d05ZdkB2Ajt5
-WZph3Hlw/8G3Lb+NzoLstToSP9Y4bLFGjxYobAIlu/vF3pqdZMzKKc0lY3Jq3fa1uDAuLKy1MUp0mU4e
-GDfZp3+3MRiJuDihgB35C98AH/3uRzB8/5VepMe4AXyS1Qk9ixIcldf33kRe7r5R7yKmnlZ0abmaiPrb
-whdWZag3l6BeyzKwIvDTiYi/fbKlraL9L6FDC5WgfFnqlhenbAc1vabXb0IfkBoJq/ORWlvKuoH8cZV8
-8Zt15IufRYkfU5T4aFZcmmE5nFzks2IytsLFnbv/ROe0JLvIR100j14qdDQH6dHBcwz4hodbVfAYpBCZ
-In4jE6RqHnyvJYv7fVN3Cqga6WWKwOCWuKUjCFDGojwJjSVMiDVzB+bacJdnGYb8JOFNn8bAhva0TiwE
-lWi40VmpbPFnfIIRMyauJrgSMIaqOKM4NUnoPlM/+GK3yVsDQ56bE4hjjHUdjxmMZmcIbP8MRU4vonlR
-ccNHMrA8ANbwNJ29XUwfXR0drFfyy7rsQew6EOo6pJUkU/2zE+EuODZwnB//mqPXk/76SkY0oUj6pEki
-rHDgQRMOMNj2Gd6RvWhRYdMFwMsArPIgRWkkRp4+OhAdp2/mKpAMEryM+K+jgyezYqzmTJ2vEjXw6MC7
-EOAy7mDlRwc60d5KlVf9UgES7QhwuLu6u6TSUduou/vtLpLZbz+qGufrz7T3hrS3QnQjxHUd1Qwn5D3x
-kDgzz8Y9UmPFQvw5i97RcxzKQqdkvXcNZwHMMNqLm11qLjSgPu8Anz6Di+KF+CIIQc3mgxYANKdwc+8m
-+/ZDeWUY7TFtwtKGgCd/xiHebP0wxmaHIvoQGQTy/RS1EwPADp0A38z6AGXHjILYief7tnwfk8Rs9aBA
-t5HMl5ccmhDCcdLtLB+nZy5i4DgfDkeZDS+NlwdYf7Yx9RR589ZMXdhDNDEhLAkMhcZyFMNp/8LgE3eT
-UQp3dBeH6+liNM8fU+A05XPADa05kVwra4wC4oRSLauPg0d2R9a/Mvepsf3sVltmLkYDBAgcaY0I4gXG
-LUVtdQ6Dq3andeoWnALOztZIvrqi2sVUU1Nt+tVVxZdDTT3xxHuBXYjnhbfaGCTSqg1uLeHlpHoZ+dPn
-y8hHPhBXnYewBl8sZhk5ZZKfVM2xaC7e4AJwCMHh/ZNRf9E3B8OUosQpcFWxQXU9dfAX4G37RTvJOmcd
-K/PmdDzO2L8ZAr2x45TtdeCZ27YKbhx18psHep2SGJ+s/5GUa6AWs3RKC93x1PwOm8420egYHPTBqlLl
-7OVHklCtHHXYxndZlMI7aGWeBhDCu0IzdNjb5nspTYKzvl5ZGgh+7rpGwPtQjHXXl/Z8P8wvSJ4E8o6H
-751lSMe+vf7htCi+3zEpf9iTAq7pB0qEsMZnBUgxcT/ieTAgb3teV0kGCwtumnziBj7YYsXN+KfO/uAd
-+VaTMd1iJtA9OCSoEcLJRNZte5KdpWA1TWYzpACCkkS/D0G2k+fQOMquPa1LGzPpAnAToOrqgiywOIyM
-7+nweoEWCqqutJtckEjPVHezjS4qPOij2iXPhZTD7bddZH0fujq5o/d4001028Xqb7kQXcQeVLPwULhK
-nC5mxYkT6Zy9jHnkp3v/66/xLPnzZ8O5P/zG5fGAyy3oau5LoXQqlswTaznwqWpW/OKnVsansSzaNlXn
-Y9PUSBYxWo2k/pniexSxmsIkXn5l2xrJqi1fdS5r+BrJ44xidY5nSAG1Y3aYL0jh5X5hDtJIFnjtpwvN
-amOZKqa3y0pA2901SiEbX13SSyezrGZ3H708lu+usfMsrXyAjK38hL6BNJcLGOtX9MVPrT5I2nUNR0vl
-S+FvnZB5q0u3tgmqJaLoMQSB/XG40VR7H5y2h4txiFCmkWYUPBgbgkmstxNK8uGDdSywoDPayZzihoVo
-Jc6DYOJFluAjrUGgPiy7RmrMtpuGPvYkjgSgffU6DX1DciU0tH3rduUfeqjDVtumfYiWt5YwKq/7alYm
-rdvat6vr3PhSQaRhHujVeeaJYICRMI1EWNoOcVvbEQ9k+ULdl6fXA740Njw//PKN8G9hkopjM4WIhDJX
-5RGQGpWu8k2xpUnC5iQvziGcUte9D7r3UttUNompJAlDjpZlMOpsMNjShURZJWXVGNZTTRCOp3tbN65J
-YW1AmW2cYu/e1NRrTXvUwITNInnGGhPGKd9UJt8woqaFxVU2fEMrsWlftLrRBdcfpRPDGM2yUZYysjtd
-n2g5AOoZ8NZ/fSyMQ3XZ3+Kf6NBIZwURPcx9CIgIkC4QZirLV9Oqc2X6q2zORHIpLqTMs1OuCTLuZM9N
-8R7FHrKn6X9PJH/AKplbSwRORENPIwEzjMlTkkAZOrbrrtD/OcoAc/J9DU/ljBGuI7cCL2kcO47cBjTo
-R0EuwBHIkhggiWZeAN81G1sBsgduI5hLVrZp69QSSspuLTMQLsI/EUwHONWeSuPUO54LGn3MHYSUgnvA
-sIlZxXhM5WM7IVdxWDAnwJuMuH7UpNH4dU5bUEn2xDeYY52C6ifSlWyoj3LVJx4wywGHjcHF/XxG6Fv3
-vDEqXxXTH8G8Jqi3Okx0qa0Y3VU7I/Z3GuI6QGZybumMC+nwv6mrJcZbXEzbTEPOAKbQvCVTLIyTao78
-KJgT3DEmZ9d4TzLrAhQe5o/oquHyBPqPTmQ5W77vlbMl/YBfjlHpQndQU7j6fq0NT7lMwdn5izTlJU8f
-d4rt4M36zGZks8Nok6atMYCoyh7C+VQydk8nenTQ1m2qYO2Ei8WDSYmsF0WivvqqdvNRzSqWEYEGieM/
-LxC6ajsAqgrfN1VgVK4Ud7fzyuHX8ZKkhzq+kt4+sWsNsJD2AeVpZmNKvGFmImOV4ad61AXqBAnMbFuA
-OjH6hHK7coPW9vt+C9dvwUVAwSrFOiJ1lDkWmLLw9VMRGDJBTodos56ls5FCIZB7AdpOF+h2ZpiG/uLs
-zMH+3NzeDQZGLAEDy3pn9dZNnhWhPz2LMdhTmjZhpvB3fEdvOR265GhuhYLF7KyYz7OJintl68k54FXc
-iT1hZHBbVjoY5KDCRSXiXMLuiJHjRA60IGgNgkILtG4lTEeJkc4qKFAdD6JFXgYe6u5DBBtYqd6iHutk
-oeMsc8CXz5AyFhANtF81rhyCP7OoOTCwUhRJSmcSIp3NjOC2jyYlUDJaRAOglATARlirzpZdINYP0e2Y
-PffVUTdvE6kUAKMJPIW7Z3tnqeId4Kc6FOcYLBwMk0g67BA8bR5ypCv4JdFuUD6DmHAyT+WkqAJbmp43
-oRSKl3SLLU+dgK11dMp9Lw9QoL2cWLrD4iOAsRwjfgpbox5bk3kdohR8PECdD1ZCEr/bB8RyxSHQKS4Q
-kFqSg8Wc2Pmx2R4ZCB1KIlUd8rIEZzrMgLtxrgI4UE5cZJCwnWD0GO+ODdAMbWugfmuqdgdXXYjepQTn
-se9R1BNFDMPiwPPEehdNki/BLbBqDszOBAF6BgHn4IW5XExBTs7mdTJvpGUamlsR0P6K5iko0EPw0IEd
-yX1mGkRb/IuiX4L7paBn05XQGh9lbjTqTWxuVqLi+HPjFfdvNDeEExjADalJ8kCbIvDAf9AETVmqW6pR
-tu+88a0L7YnjLjiJ3H9vZCKD4Wr49xkJJtLhYOjXm4+HNxD1i8Or5N9hSCyQHA5MBFAIS3M+pP+3YBQ1
-IzOhIYpaSzm+CP4kjnDpcPUwdH4I3+cCJiosGskXwPN5kgpyF7m5WdrHQkgEfMSSvKvQBJJZXbA+r4VF
-jIz0UizET4SE+PtLpzBuGVkxKgabAgyRyONQhHbuSs9A9w5iPVkfhX3PMc5rpGaBAXdJ+XvR0tUEw+ow
-tNtWTZKm8oACjFSQIo+56nYUxaN/FfjuYjmM7mGS6eJ6NdPYs9yyuUqenaEgCi8i2zTWAd5qvBDfKc3b
-mb6IRmVsugtX27tetWJYp/xDrwpbuNkiTtKgEjTDAoPVYwuok1Y0dXXW3wxlaKMrH8d8vWVTXaQedayX
-yurr4zyvqFab8Q62lwiXCCKNbuDJ5YyNoOGlJ3ZCFDgSLz4MddwdX2Doi7IqvZDGsDBREiuRopcf4Txi
-s9n2FoEFMo3vP9H5aTg359BvFo5zrngJGiVzArO7d01BtFT9Q40PSxqmiFj6JWmwSryPOlcZsDLimDpm
-H/tj2fNE0EultmxrHJPbap02CwZ9OYQSBVSJEqvy/GgubgXz5+WE1iXySW39rqnm8MhtiMdX2+ymQtkL
-d+yGtcf6HAUlDPdHODXNmq3R2lux/X1FEwT5n1u/89M8Gw3L3/+krqSKKWhWKL98KSD1JBkWKIYx90yY
-M/THI83GDPtN+LlonMZ8E+qLpahRAb5uArBF6F3Jal1WROUnJR5kgFnB0t968RxjLCwVzYGUGxW8ZIiq
-JXkld5LuRmBfYN3oUD3FiOgYR9T2FUADPKkhiwdZNWCjbMblguSG02SCZkWR4NCH+BQ2igUZpc7AF6+Y
-aEEpNswKU68ajO8sRVkNP5AXVrKdp5MheGNS+AicfHTV9MSvbnb872WFv/e/V+c3jKoDLoNgVJhaDCmG
-J5GRQyEMWeaiop7A9HlK5xmOyr9nBJ7KyXAi8AhH/GXt2Dse5vUwA0i3cf6bac40nZ93AL5fXrGCH+H2
-ztMLZB6tCqLjFUaSI5QZmIGYpW5YveRowBYwaAH5kFiEAUuzlOBoy4LOwFCJbH8y9ELXMEXPfN6Jg8e8
-v27bZjLP6kUe0myI1m4LO6GQZ0LsGH+FPslHqJJm9QcuZ6ZzZomS5REZpidvsysLDo36Xiqhp2UpvSVL
-ldPxR6te5s+SJ3ChPRmn5VtmvtR6CrTBxDaWbzPFrPubFlYEk3in/Tlz4MSV0DYCP+nNDwRmWSB6k4Nc
-isDh4CGSz7GM/sKM8HY+KTuW9+Grcj2bjPdmUrs3KoZ/nrLH8QQUfFtJtR7WV+CJ3hwve0e/XrfecNgF
-y9wiWnChAmsRr8Mr5bXK8sbp9r3XugmWIVZl/A5Lnaw6KDgPe6cjkiXQzQu43C2j2zfbDL7JwGb7ge85
-bjsF99Ubxlv8GNYIS+wRLJUTXH4pNr6ObElgGysG1KWGoYmXoFFjvIHTH+K23R01lX6Drzdfo5VlQiPy
-OLpaViyWOjmFXjZe8Wvc3yyl8QUjolrS1YDAL/ZeWzZ7V+uP4tXry9EdQruuiemjEk5WAsv4De4m1ewi
-VhXthKEaZFVpaIkn5wZBeKXEFbqGpXLXWsnrRl1ueftq46hKq7bZ6tZ4UavVx3V2nEpet+3UCTSRoye6
-2m633hJb+mY9rlln5uBJvviy/IIumXO9uirF+bFON1hF7aD1bgi9dbV+x1qVsWcSVZWqe9TQvzVVZXC1
-lKodnct6iiL6JcdcLy0v0lQ/fYRyM4ZOVvLtScW+YE20QC/DulxMHHdhfS2pIGIobLhde0tUfEVyUGS0
-PghmHKMECwL0nsDbcnFonEclMLeDmMXcqFKAjPOlERslwuZmJ5C2lIzE/LxlpOc/ivOwuvSbsR0RA4DE
-ju8axA/yr8VrSJmRxbpPESXrFtiSheAMCcCdj4NKEw0E5aLf6PoiSv+TmBiPuJfkRg9rYvpFO/mCOSz8
-idgk8qJu4fnz0PUDd9LgC+K4VKllESjhOQbMxgtioGA3Ykfn2HUJt+diyqubopQiWTkCKV/QeBU8I5cv
-n2gHFyeoUG5NkTUHi9K+DkEAJW9Lr1EUdYCBmdN4+dJtTxID4pKJRY9Bywtx3ZvbMKSDdHF2PgfE+kZp
-fWz98voZCGpAbFM63R/6+gLpQnFJ9m6QTZkupSYDQsGOze5Pz7xAhbc7qB00t4zaaxmmNwFCdwWkspt8
-WZI5a+/LskfDgxO8B/d84QWdnSugXVMgAz96KWCRgyiXjJvsCu0ENfrrxJ7lJJoI0tb7ir6WtR2AYkqv
-1cs40PnKcWp5KJq4tjiqRpBPQnHIi7bfRbUNlTZWoDazd77CV+rJ3lVVt7QvKIWKTovOnJUwm79kHrK2
-oSiZhCaggPSI2JSP85HsnJJlAa4MD/MVteYQdCM5aoydTTAEv50T/iB4SfgFaOPoIhnnExJ0CmYgZp4k
-LmSEyo1euuyhasZjmf+qNsV142Tp7UOJIR8/b/yRw2a/wAZmiGQCQNOIzDc/ZwlYf5QO3oKDCzqZsaU9
-4Rd4Bd0OUDYKKWu22JM0B2G281WVFQnbuAIcywuqwwQn/CgD7L2OosiCB5k5C97/nj2kqzYfc5+0c5Wt
-5liOa4aKRCfQmGYYVBFiJOC79cCXiPgBdKCh2URM+i5pQzGXdQfCVKuVgpiClB1VA4OqzYBqnrzSXVSp
-gkut6i6+iYnkAQxzgpAYvaDNvbZ7pUxqAJ3FfVDaU5LGw2llriJtq3o6X8crMuZGFnMTq6iBGJGIWnB0
-+iyDxZ7OrtRyUKXoO0B0mth5Jojjr7Te4bLzWftAzLKmakflqk5+oN31LwvK16BOPX6Hg324yqsz57N5
-G0tnoqAcHeSK9ydDwH82dT3Fu+PQ00ap3sVuRN6FY0slr9k6y8evbsjX+RrKipZOSWWLQUPhtjO/LOQu
-AV47fE5xMHDy8XABkiAjXQ1eCqAkqBkRhQF9sBHAYVtUC6hwgnRDuzWSqDOL2hhYAjg1W2EYe/vrdzLR
-lcRRIX8lFY9Ktdxo9kj1ivLix7+DsuShoM+D6iSWq1MWMyv7RdR75dYV3oSWiq+Ca1KO3sDmz/eqPZ1R
-Njmbn5vXX33lSw1AwvZQpXydv7m99XZlkF+baohZrg4FflMSZ1o2BGSKER4wB7+Q1ckL0VwntGy5SSKl
-Lt1KLkr828KxgX42vzT3xctzgECyxlNfBmbYEDKhm0CghO7OzmnfMAoUGUHW8Xb/apta1FKsRbzD7vvK
-Xpsn96CWgmzd0Jy7Km+jY8YF5iH5AyMgqAMOLQ7r0TWs3C1q/M429SQbIcN6jpHdNDexxVi0xzbozKTB
-lKJ8m0+nYEBOhjzjNEfawglH+Wk2QFSVagUcHw0dqaks37THjxZmT+9HVyLTa7NlkARtLuk8sMCFZDUx
-tJDnORWbjs7MdWN+PmZrj+Nieg5mOWQ0OBjlWBRemPLyHHWmF4a5FJjCW3MSUUwG4KFepMFJVJcYwdwi
-iT0eJflPbjbgJ1rSHBxgiiZvRY+xSrvodaUFLvVPk4hiEdIHH7Zg9a9nSCnYG4EibhCasVuQO0kxJbkg
-JgCTM7K+WsC09rP5ZYbRDkkoyAvWDFm5wACYBBBIplELBnJJRBwVDArxOuGYOILsNz7qFl/JrX3iXSkv
-6l30qyVM2T//NiwWAjNVZ6/Kr+Lyqfdk9W5e9Z1tV9JVl438W2bRbmZ731JMlGOS9b41VLMfMXiXCinq
-DPxNGRG2liwNsbRsQoGMQEyITrFqsZQoOHQl6SpsDIah+GTBLegyzfHkEosctGvD4KAdPYNV01xN55c7
-ROiUIZ7k42C1eSa4sruOV7of1JZqy9FQZhYWudYw1jp2kjNd/PSyierTROEzo238XeP1swiyrHjAWdes
-QGlf47sGzDpDKLiYhWpxBR6D/aLA0Fvkh5Y81UG5JB4uBDYBqCEQzNxej191WoNx1v0OHeJXWUmTaJN9
-ctgRBykeiA8ckCmH3MMXTGR6naAiZLHCMd2qpVZt/5Ov0HKLy3tV0fpHb4z1BM/6yXiyN4Av4fhxw8wc
-/mOcdyJIytSPTQFBk7rg4LQA3TCHwbtMJ4rgUZTfEBdXrAFNObP5YDFnZq+0NrgcM9Ib1gokVGUc9/z0
-zqSxOgp7eoNbe2YZfe+rtWaWifC++rVUdPasoo9QtkC7FsbbXsuc6F/sN1AjMnEJGX8+TKodC27udxBx
-YbAb8s6/fMczJgahN6nawtLU//gP6h5fSfFSuFspCJO8vvdmb9npouwXpPS/2JyJNloPdYk5wl9Kll2T
-lq/MummR27JYrT/katxlmduybHv7kXDDILh/URwLJyEgfsIOUWdRuKy61ZyhxGdLDa233oNb5FMwfiiV
-iYSCvDZUAQw0gIKMAF7b3dVMp5hJsrdHppWl3vFLweaUvJgCU2ex+42zvxAWl4JYk8OFs14F2pSW4ulu
-Gh2a/OuCVDTsSgkSwro2s/C5S9vBU7UuPNmS26Cte1Gl/lGTk+iZFV7nKsL64LwKFnPlnKqcUdXWbXKH
-w3tT5H6mv9f5r1c1IP4d0NKjiOtaKGquSbEWi6j23ZJjLuI64Q6eRexoW3awLTvWlh1qqvYFIwcHTnBN
-T0kVNKxVO6oW2/QGDqA11xNbZKefT4ZMHO2Cabu10dbLoNX2igvJY1UgTkGlMy/MRYhMBrRR6ZAAkzAd
-DIoZnJ2jq4+vSaqbnLgmKcp30JBwvsDDM+L76G+plxX/7Whx9ZrJzdzHW77Aplr75t7j4VUicOOOdLId
-q7t1E+1bdKx8jj6se/kd4AaO2kuuB+QDCgqgowOSvpr6yGRH7JjuJI8WgLNJVg18VxC3RLhLdBSnBK1D
-b87QwXNPs1PemBzUr6hq9pXOvtGSnJDr1s7+q6asztnInwi3vp9u5ua/0tE/4Sn4hKvItaEmClYwCQdt
-1dN64qsD0gvpWxLlTV236ivTwSRtmrUif8mAh6VvuUVAibfsyKzVnzjV+oUsAjFGxfNZ1e57g0tkbG0r
-GM7mzf2cyFSMnKjTxbzYHhfgd45h1U0+wj9irXFjJqidCC5qbpFCJZTrX6SpvmzL3BrVJUf1AsCOn5ra
-n/AAabmmMt407eqnfYhNl4KBOWwCQ1bAXghDR1lVt1iy6jIkHDTptvJSA2A6a8x63AD6fh25iUXP2+ri
-CVj6uqWjvQMiVv/RJeEf8ICy4I5nHWZFIBr29C7yvjhteYWUbC2hiKE8v+LKop16dQCXoF7moJX5bmRC
-oqRzzT3YXA5AUx2NYEC9c6umIx8PN8tabe9Pkl5eYh+BtPWccSXF+HUbA3TisPgB9NCsOdFjRQdSWezE
-v6JIyYnbPe9vbo54HlThODRol9AqiH2KBsYBCbe2xoIM3En+odF6PWn2rCL6Bp9Vw+zMTHIwbQXsBcPe
-ZDMxZ+arfOcjgYJ9vGm59aRsNiVi2LwB4fox/S0fXVmYnVIQPKzbDYmWCgiI5mC0enB0mIvWaW8pCnx2
-ajogqBvgbRGIVmx510q67UYDJDME+etV5gM6hIQ3nc/TwbmpVkOzZ6fKdLvucDbr5QVKhoQN1XcbxixW
-+MUPPQRjs72bGtz4/XVLRJvwRubltHxt/vPGi79TU28wUQfZnKIIayQTN6b1M/CMvVxgKXC+dcZxmEXH
-0T+nTsslg6c6PiR0GNv/SP/+CjK4BA+1YVYiIiuH2/FxDjgeMsZOkyAhiPQNwdNzlrDljEks7hhWsCgu
-YDJAFhWSYQU7dbd6JiY1PB7hH9TfJtTlWoX9CJlCELr7b7QDo7e1NfJiCDXe1HWYIrUTpEqqQgdiKZM1
-sk/8fDBitfz0X7O5gtkBGmPRhkLTIImj52Z5O8k7hvO7NJPNM1omEEGQQ9zgyiNjID4rJO6NMJzJY1oi
-SGvRIQKkTaOsZB8uB9nj7R9ZH/7xda3xglwjPeSgmkXjb4rI8hH9bOV6wMMJsOeLPnCUtXddUsdSEGEb
-/q0zzlLQAT8l6T8smGVBauDkXOp6S60NUdQa/gs+gCtmSY3gDadbwiQ3ar/BiX291arEMfSanHDMHexb
-d2nPVwZf9rJsEHrZjzrX3d39tr00omJ3975OQb6C5uV37WhoxO7ug3vtMAJid/frP4cvXXxD89UVpkMY
-dne/cdlcnELz2qUPwxB2d7/9uu0FHDRvXAeivl2Q5M+1SfCzq1CFAuzu/ulBO4z4Z15iYh2vr3v/HpYf
-hr1eGnKve//rb9oqvJ55jkbK/u5zdNM/Nrop3zdrQpp+ksiPK+MwklE4eicogF6e8L9n2bQEid7greVk
-+B7plI08sQ7eBwDFFvNiDDDgeIedgnf9MBkWl5OAG0KL5vI8nybneTZLZ4PzKygNjkYoxZ24F3kaWM2A
-OLiGyJWVcMcibfADmYUmqsgGee71GHWMetzV4+6zCq/Ml8K0Hm4iGbozlrGxYqPw7F02WKCcpxwUU3My
-MFCYPsj3gaeB0UM/5wEEXBvjxhuZnWY2xdtMJa4JvJ1QID+rEjGpQnkL+P6K5OH9aVGYc8v8t3HdTkwR
-D38gNu2uLYrvZ3fVuQ/hvH94L+Vf26DdmOo6wtorbAOn1L60QwTOmCDacYGtFEqhp4Om4hyonj+Yif0B
-NmSLCbk5kyHjZQ0OlE9WP+CF/Xv/5Q9uWaix81EWrBOJ36Jb4C7c3KCPd7Yy2lPNhi0mYkc2gEdDUpRz
-oA8NULmUPWDRGBn0qbGAEIgtnM10fEQY7xCcyGL+c35lirflNRjuFrNFVrHLoUD+H1WeqMx7gtLaapG2
-YqI/aIspwZ/njyPCu/YFIdLr6+Xcnmjab8fixRiSFbzFg3vr8Baf2YiPwUYoJjrKS6w+uynmsT659QcY
-TP+UvjwvRBELRzgYlyvIWLLvnOF4Woxl02um2XT51nMH8F1TQx3yyRDdXJLz4jIZ21L9AKKsJe5b1z92
-+h9B4MnwnPcjs69z2AcX5eqh766mNZtO1Qk7r/0e98PuZ177j9wk6MTFk4y+oiXH7NkG8JhaFlxfO5NP
-Gqd/i8AipzE23r7GhLIeEFgXTuOUhpwEiQy3+7dXT39M5umZ2DOqqMG+RBXSvDL/R2lZEwF/eoZl67U6
-lRjDdnFSJWZIn1DVTVMIMScb8zJ8oNV4SmM9uhI68qLZoqmvg+HC+Dwc9QARYFwILBkxCrchi+ax0xBw
-fKyO3UpwIRmVReVWQnI7k7nnBh2lZj5xAsOth27KmzAgqWG4UxQmpf3+DB7MH3oeGn66LOEV/aK3plfw
-yvzhZ7OkRxm+wl/0FrTN8A7+0pvFMAfmHv/im7556tMvc7mAB/OHnoc5PA5zfirwiXPlZ/CUn9HTqBi8
-/deimGN++0DfiuEVvDV/6Bl6x33rL+bzYgLP+APfGRpwkUJ36Qe/Qxk6vpxahKBBjvXBH3ousLPwh59H
-+DiSp7NZsZjSK/yJ782Kg5GEP/YZkFP4Hfyk90N4Q24vwwxKNv/lp3maj0p8g7/o7Sm01/yXnvJ0VMCQ
-0Q9+d4EvLugJi+QSsXaqNxub39mYf/ezIT722QGHQPQzSC8/+f2ZGzT3IN8MF0/vzQ96Z6g4XBwb9IPf
-zcb4Zka1n++ap/Nd+n0fft+n3w/g9wP6/TX8/pp+fwO/v6Hf38Lvb+m3Yfzhyfyxz1g3/aB3+My/52MY
-G/iDz7AqaU3mpzNUZTToB70bwzCb/9LTZLqAwcG//KbEZ5qlt31oivkvPWVXZxmMGP3Ad6O0j7ONf+lN
-Zr5BNvpB76BRo5x/T97i0+QtPoPvMQiYzR9+nuLjlJ9mb/FxxqmzyQKezR/7bFb4mN/BT36P6xb+yDMO
-Iv7FN5MU1pf5Lz0VpIuCV/wT39M1vOthseLG4X1TTOeyb+SnvKfVVbiVVSzmNNz0A99BRsqBBxA8wV96
-Y05cWor8i97SG/tUnDEBlJ/4/l/mxb/w1wyqmFEdM6h9RjXPFn2gPPAHn6EIymv4B8gDf+hZRkaNS5kN
-uIP8i9+OaLToB70bmxsWvIK/9AaZH3iFP+jdNMXCzB96ns+KCSxV+sHvrpCG4196swD6bP7LT+MxQrk0
-+Be/xc7wvMzBr9o84196wyR4bmnwHFbvnFYuXBT5NJGf9B4oAbyEv/TmHB7P+Tdt4rndxRDqFF7kvA/n
-+ZzaAX/pDSzP+Yx/m9sIPpq/+AYWPa34BQzmgkayYU7NRpf+wDPwd3AO4V98c4kHyiWeKFukZvr5r3g6
-zOhApB90Bozy6YsU+yE/mYKfEvk+pfWRjcxXPAz5F76FyTqTHZ7RDs/sczr76ywd5qTz8V/wPi9pn5dv
-eTdgO6bSBvMDbiX0Cn7R22J0dYarkH/Zt9wG+UkrPoXjRbXDf0FpaP3O7OqdF7h8zB96vsBFeXFmVwev
-DHrmZYx/G3DLqTCFrdobkkmzgUjiBuogxy937z/4NiaduP9ZOvH7XbweIVdXe8faX8yLJ8VgUaL20r/5
-+N+8e9ajWXFphtnXfUYuW9F0XkkIShvJie9jd7vlN8Etxrd5mjNAokprX9uExPJWQpV4dxnhi+01sTCj
-+KPhSLNJNkM9pMlvi8aLRDF5bBaRoTQgNcUzeXJQmEtIVnn9FAo7KC4nlZdPi4us8vKnqX6FpT02TCUe
-39G6Il9tlXXfoOa6bz9NvS+Gjrg78ZHDWkmT72nUfjAMECh6Q5OboYA3s+8EjSrFKyqhMASTMnfhEk7Q
-YY/x2zqVS9wjbwYpRrKKl4zTocxUumyWYHPycX3GHx/99OrV82d0io1hrZoj6bW/D9pLdsAbBgGMK4AS
-QbQtOUYIvjSHJRC5xGIQ7wkWLLyhcRnxckMLawq2IkODZVgfV0A8ytn+Y+pjPlg4NnwfguWajC3f4Lp5
-R6WW2sCk5051ByCwkYezi9kYAUo1B98sM4+mZSNe1KoFotRqieB96RFHk0sSwCol6363215Oobq7u8pQ
-AklRd/f+g1oLCUdazKH3NZ5yDz7LHP/go8/O6Urh487d5GxU9E0b/W3RRaCLmqPz8fGxpHtu/ktGJ/6J
-E00ih5ppoXz0c6kPkbR1dUWTxA7tQyCyh+McEaZrj2ydyj+wZVQloTM6Qmv7ykFen94rFx1CI+3B937K
-xWieoyYzltx+9PKASVEkNVoatTY2+SgH6TR7Je60k/mTYsa9C0xA6hNKWQ5R3MvpTI4kXYkzcmxFpn7y
-4KvkMnTp+WmFC3p+uplBCtrbCsEXKhtZJx0/JVVBZ9erYlk+SUM5ZtlZDqjRYuhF27pcVkBNFmBOdhIz
-6Inp3ODt6MrcAOcDdGW0RhhAF9oYixAwS8wpycRojlzE0LryA04sbvvnz4Awnbz6x4vDYzjIJUoZM0tJ
-Y7IY97MZP1/zIB6/+sePh8QpPj9tvmcpA+izrmWcD388fAolo5geijfJdx1/9XxqkejzCaxKOJGTwpED
-4AIwGIYg8pHehRimR+aOb/iSowOPfjDCB9dQARzgMAVa/UChOfDaR1BRis1AkBCP7aBTXRQHZi6eFew5
-DfQU4H0fPuwxbBYT51M2nk4wRAj7bHS2HNi/4UZSCDiK4WGPs/nRxKw1VCAEuGM3d6XxWY6EvSza/LUB
-1sAY9gAjTU6oI5K6h0jESxva68QcReKVihfGrePpLx+6IEy99PTkBCW+En6hNr8dmhUdt647AucO8JW9
-91RNN+l0Otc9bdLSeIFxnJOLvMzniNBZBhCdtrvbqs5tMzHmC2CKQsG6QNwk4AaST6ByCk6xJJj/5sO2
-9qC1rEZtY83dTW2QqD25ar+/sg+Qtxq5uD1T4VB6uZtEDsw0BUypORgT6AF+EhngNlrKLabm3jfAAD1s
-qQ9XvNVbRMGD3bTfd3hzESE/NCwpEk9zm1mx1fe1hX7J0Q9dCQQgBi9Tc1fURGCcTtIzciVQg0PayeSI
-IyhfJleGXQa+fQpeqf18lJuhNmfR2SIFZjljAMEJExlV0vy8KCHk5jDjKLUTc6tAh2M45YohxVUyczFc
-TEeEX9qxmlNVjvU6gxlV8U5i4w9L9aZ0iMaZrhka/FvvMvkaBqVrgJVKD7/2KAYN9bWqUMYCHAIQDCXB
-A7dtnxvQ0TShQ7uDzEH2LgXZRZvyP3z/fpzOzvLJS7icdEFpMIAavkp+bWTjXxvX1ySdsAUuSvj8X8f/
-jWMWI+qbdrtF2nN76E4Xc2GqmvmwXeGQ2lZC4IUWuKFZAADHHf4ZrkJmUST7L47wTKZY0gMU/wh6LpDw
-XjE5HsyK0ajHSUC2wxDAFCy2b0OC33T7hv0lGESpuAEjGnLATdAjwcc2smHOwRT3QJ+5dWzrr425mCJE
-e1SzEySwq9n5jivG20rH8CxDawSI380yOzowc4e5MUibfNHCIUMeOdYrfurA9n4l0XCr7KHAh7kMaDJ2
-wETWgoTZ70REhdtuVpeRaYDtX4Da88KtQcSHc7A96gtNmFmiW7Fpa6vqMaQ6G4kQi458RDIwOxfCz6Ix
-iJi5mWMGZiY3Az4Csmc+Ykjst1k2BXvX83yeYaQVs1ChOCKOGF7O3OkHiCIOBXaI0S7o2HoMZb2Ceh7i
-HJAlh5N5khWGfp7pJzBqUI9kD6BenHupQRmuH1EXrl6wtlu9Qd21ekYts3omFa56wYpO9YY0e+rFpe0C
-s+PPXx12rXq7UbrxVSGiebjaiPTIHpMLjvJntvbYDOs13bQAIWKAskuwH4RSKMK++A5jCYZaDth6EONn
-0WUGzZsBvryfm1bPrqA4WhGXmY1ONHZYpNAMxLY0pDw9pSUBeRjF/fLysnP5oFPMznZevdx5efh4+914
-tPM/nr3aRoR1XAY/7/94dHDyav+vJy8P/3r432YV7Pzv1+n2b/vb/+sN/+2e/Nr5dfvX4Zu7/3NnD/Wa
-AP1MZ2uJkeaxpAvxKzOr6TE41rKAF775EiX8EAhf9xSll5IOhC0yJfoWXnf8zKTHqzSgDQPSusVNKBic
-DtyOsSHmJD1iR3nzCAF3GlSZd+7VZm9ZN+igxa/N1zfK0vw6aj8G/gIvXRwwXgclOO0aNg1lisiRpVO6
-HZ8K8YMjC938oTwuwXmoAEdGK7fkU8yJ3KE8MBfTbIUysqWhsCBgaMCmZHHWDC3ZTg79krtw0qCaBlBp
-lWbGPGZzku6aXH7dJhP2HJYxZJvwX1Txu4xi26u5Jlx/stJKF9bDMH/IPQmgmTnxvQFJJyi0JdtI4bcA
-mLiW27KSWe39GxfLiodOILzzhQ6VrG5L1G4YWEPkBwt06CEsUffKghk4VxwB4hD4anIDOIY+on4mTOHg
-qeyna/aY1S3t+DE+GpUEjb1YLlGootW0HUWlU8p8HPkavMHMwbFVHKonIAcys4aCfBijMaFRYWgHcw1c
-zMDgku4MjH4HLCGYMSaZWTrIfwv2J18J3Tb0HKEDY+8QV8A0wIziK1EqwBo0z4R1jgUPQ6yHWlC9D/jl
-GNQgs5cS6WEF6F7MjR9owwIOJRoUqh5M0UOfZafjwV4Eob0COL7IyqFsRBMrsrUoBMCUkfuFaRwwG2MK
-Cxmb13YDvDHEvdEwJLrx/Y65uKid8VXS+KERhwOg2pH2Pp8ajjE9I9Sr/clQ8XylH8vqq0huln5T7hrA
-Qskn3SGmO7Jy5TDg5SqQ+IYKIdeI9BqgHNO5mU/DcGSeU/7S5dzPkLNhPTSwJ8zDAq3QpRzNUYgKdNAs
-NKGNihSaSk5TyAdnxcRwKl56dGazMBKlueYz2/LPEsBbAflyx5S5fVFum6TbnJsXrOwEBbHx++wLRqsz
-lB/GGs86YLoSG3F25UpZHv9Mq8frV75GUQES1/jeW8+sVfciyP6dtOIRhfidqDKc83gKbVin+WSR+cpr
-1+if4eBLHrLim0uwqm6RXXOqanyMuuIJRTuq3Fin1VpwEBKftoxN23XAFx/YxoRBHaU7MLBwNUXlhh/u
-w+txGDtTD1j9eev5AlpBSU1ERr/QqOKVDUNoZZobJ9ZVqla6kl255BlCMI2qsqi2NawgnBo10KouGKmx
-h1hI/2B5f2XWNwiauAnVBno2FHyRJvV2MgWoGoDwNkwzIduY1cCsrzl6kE1Glcti3EmOUwhhOirmgOxt
-RTdXQjzJekQJBIiBelUcY11Pg+ZbT8m5Pl6U5+hYhgjPwLUG8+igsoJDgCqsTQ0XlW9bsN45whJeGwQI
-3pHsIPk9iW/goB33kRbKzOdrhVmJHb+bIQef5u+AxjYcbqTiqUEINyJ/8IbD8fHYbkgCVuRLPluLZw9s
-cn84pIDbOQLnm3sfWNjaE5oFeOagPZsUM1Ipnuazcm5T2iDFGF/4e27nD+3ke9OeHzBe6PdS9Q+g5TW1
-fZEuDGMwY19JAXz8ItneTswh7coTRRToeTogZuoAttDlGcobxnCJgc23U16Zq+e7DqT6H2Y+QUy8DR4I
-2/kEzMAFSNIN86+T6lZZ50SUDXvk5PeiYL3zEIPCXRT5kGJJQ+d+MzelHQreYutRSqHlaiu3FPI6zWvw
-uUMasVhcKF7JPARfJWGewFbMP4hEAmv6/KoA13dHoT0t/WtP3C46GmCJA+1P14Pv1FgNXL5fHffHlIN/
-ukFx+vCP5Vs2DkssR7yywjMa6/LaHKmMzVgn86MxnBGyuOhqw5l1UC6vwLb6EEUXTjSxwn/qsAv7qZrR
-+WeRT5qNRiXKVCWfI+Y3irdcg5G+TqzljWD9w4CwXiN0+GQvFoNtXuTIYrAikIORWS1clZ2CMj2FCwco
-yoGcjWaArm8oJkSyY0TGobuxE7QhMQYs+ekk9vQqnY0f1AFX8HYgGfDOw48Z63DNKIcfMb7hhjPDC2fz
-67qOt+CYnhyZUFtpR4xfK5x4kN1u1eWZl66oYMLVDbZ/hWGjKHS/yAoHcLWdOGkiifvcosK2KfMjOFcn
-Ev2aZUlWqqcxmtG6E2IogMlzyZM6mOUIWoR+OsigcWgTxDcEn7dx/htZcOgbPqKtPjKNPQd+CJS1CO+K
-zK4E0Rucw+E2lA6YPo2zMdixpoyAT2UxAR+DhSkoPFT1zYz1S6Mr5rgJXQkQE0yLTNni/d3qxBuGeDos
-eYDTaTF3eNMpqSLnxZT1Jd9992UiR5hu27BAwRv1B7uWJmeGNEyC0KY1bQDup/RgMLmzQ8KMGaTIMaxm
-foVRHaUlhSe5MWVQYS+8PaL2q60kou6uhvtcR5bANzT3Au+acNsOXjEJ3nNSBiVhsO3yGSHbmLoru+aO
-kzu2lNvIJVZe0dE209R0TNYHdfdwd3RjZ+2wSHcxYfXqbz+FfbAFtEKhgB5ewPHRjx8+oD6tLvlrW+ob
-dVvxB0T/Wi3jx/SOpbqFCMa3ia2XwsQ4ubjAoZObJhuSm86G0BYrbPJWUZDh8aKcF+N9EYlGWxoxEWWL
-XilIx0Zw46g6E4hjTA1VJlCzdeEGsjvFD8Emr3X4o4qMTRY0J6wsfhVtrW7+XVQ1uwejorw76+xo1WrT
-BCnwo+xdTVT8baRGai3Bmi1FiZZCad+6+0XvMKEB1Raaa+pPEzCcxT1bohGgpOyhgQCcZ/BW2tbrqOxx
-KlStxm9CPRXynZ7on5tf9HeShyWkLKx7Y2q2Pj3zhZ7XwchSaCoeWjrWmdMhNsIN9fJBja8uf+VtNqhS
-se6bDY3tv/+koxmpb53hjewKM94vs1HOa5g4FpIpA53s4Tq29vg93SJv8IO+Sfsist6Pch6tqRKQZizV
-CPx7nFE08rc4o9oRArr83MKgYmreXBPrG+iWRiWeU6RxuvQtryWR6EnBLc7KsyzMJpjlGMpdtWHB6we5
-+eGNS3Jal5ekFjP0Y3P6cp1dyedHJTjrMvw2A5QtcnORG0alho4d15LD6ltPeihNWqsGx03oGqpvbQ1e
-J/4GolUp3zWrzmXBkuaVST0RrHTIq801cWVtK5Pa2jBDzFXoztquQmqGnYGPnvCK5LY6ncE8KkMhNa2V
-cqqTpqTz4rtdmn1Jgni0u3KuaIXsO9QsQLcu8gFhvaZthoGV0tDmYTSUHP6SSEtu4fMZTVi09+bktAvo
-zsPI6o2UE+s987rVcv4/9v69q40j2xvH/+dVtPmdGUmJLAO5Wg7JIQZPeMa3B/D4nMXxD1qogY4ltY5a
-MmYmvPdv7VvVrurqlsCOk3mWvdZMUHfdumrXrl378tkigtnZkxp//Wswq6GSWitQRc2FgRn1mi3vVIrO
-gun1Xuyzot0q3btTSqvc1pGJ8G/9/ox7M6dPtNouky+9KlFLiAzkl6h5xU4Evd22q7TaiWq35m2OVOmi
-9vz0Bx5SxhIKUDWaKaF6Ru5mAPJ0XaJvEPl4aimqdP5mNhNHsutgKmDr4Xal1qzG/AiOUYlqG0KczoCx
-HDCbfb0bHGqkJ7XuZJ7LmJSTedAJ0urjcHdGI+WjFbedLwvRlq73d5+Yb1cv6hqsdY60cMkHdUlFKl6Q
-3Zi7ZBcnZpXUIcsThxCKhEvjXvXDNFLDvMAQ4ehrm+0kcF+lF5hXpFqJHN7zYsJxB/Rbb0BFB/qxl9HS
-azIe3Rt/qn/SstTj6epObgEbFcc96D/EFB8a3qD/8GG3Frugv7mBOUkaUp/EwAr6m1sb1dwoEfQBU/Cb
-rg8z0N/81kGDKCgB83wrnp6kKcS/v7XxMJ4/pBKvb1487Nro/P7WV6vkEPnq689IWp8OTsQs7LgWRwuX
-E1KLQlKlAJnDfyfQC5CnZoSvzB1pGgHQihT4N8LgwsjuZmAtBDVVAFKHqKEqJoeLAQR8cRwhBxAmLw44
-+DCj1NNkHEPgcVjP/b3vMVQIfN2gMZCluXg+lxo/QJc/imHOvCiz0Tmc4ZgC1vyPHeCSyxTuF1dA5wsM
-RgA72xzRhgsIACovkTwlJIqMlpAXO8ewk7y00UrcZbomacJ8QHVKKG/I6VRnu0dmdIodopWeTIUos1ya
-CiNzoFehsJ6oGb8NENYTwZZ1MFhPXhw8C0Cw6kmpGyPlJUhYNinmPuWggDmnMFoL/nzKO3N0jevK4Wgw
-VRBpm/xSXJkJmXWludNfS3OjMpN2Dui/6O2YzqiwYSJAIcUMOBRIeaN8MudQnati9lbYAXg1S3PMd2bZ
-tLBkBeV/BUkP10vG2YMFZoUee6nAE4W1pezulUWuk/iMVDr9GQl/iBPb9plIz9D+UyB9iA7FXweGWYPA
-BEx73tLC2K2bov3XRchT80eLI0KbEbeAiizeVsDxzEGOJ3+Mo5nj/OuPCMaFx+I3n6G3/uCzUsuYK6Bv
-/VrCljQcLh9RdOrviLkll6gGHK1qkY+Kw7U60tUqqFWrAkiVHm6JLqrf6CQHewBoR/fiUG/M3BiuxpA5
-Fa9V7DFyWlHHD9sdB8YfZPUKshzAQPefU/Doy4MXL/cOjv77ZO/g4MXBIV+WarSHZLlt1cKJ2Cs6WB75
-pIlpOcxIPRRoiZ+sra60+lCXIqEFsMlSiOSWZU7DM4bHlLjXlQ6eY5wCw3AYT+9yFB+qeRFi977TqmQQ
-XAD/VeNTKCOhdYRI28DPi0coUfkTylXN30at0YeF8bKGh4QxsbVZhvMhRCdwjhwcjpkkarYXr4HZMyRV
-uB3yBAENILYq0rVt44sb+jZMe0Z/cdfSUG0+1qrZSR3mgNJBI3inA2BQsQrf5MFFQDpg81DgIT4E7Ole
-ze4JLYOYe9diYkQsaL1er0PB3VyopuFjaOkNlvEjwG81kk4noiZ3zoRFVc2vhULWzulVk8a8wGac+dxJ
-nPkkHb4DvImJhjwCqdvCwwDBS1sDeAR7Gb0OIMbA2tfwdBxkl+m73AzSjAwT0RcufoETCVvtMZFaRekZ
-j4QxI8OgIR1SRB65msQ8HXhzg6QfbGizXo0aYReQ049WIHW7polVKEvGF38Iq/CYhBtQA7uo7P6qY9Tn
-3f8hu/8DiFVvgOXUag83krvlZDNcJ+UDAOgWXHsR5SMtkxbNwhccHjYjuC4Ee/AgEK5twsbFhMCqPwLl
-irWdXXqeVeCufNiFpqPKiSoBqVI7t6TSeIilYlUcXknrxF2stEStUoUBfdTtj1ZVczNCGZhZfPOcedJh
-MG2XypK24qRpUZtnBltZdV4wraZEKIIsOrgWrEl++pEZpvR1pDpeMmVKFx6ZtDPfArrivEUvZRGrKU3p
-mTKZVjxlpqP0LDi/4EaDiW1Lue0KTiFxCx1WuWRi0ZZvUY+wr2HN1HLcqQUusfgbBcv+GGkBLdiGozNP
-AAL/ona0dwV/K8zka/MZFP7pkiouKxlZPD9M+YPWblnvvJTcY+P+sEo1wK8Se7LZGHxqIlid2Swz9NQ7
-l7tXbDkpyTCx3B9v7C0NrKiuJpAXZu3iL4k1QWv8o11kaYF/0ut6fq0m5yV9hMwgf7VaFx5kuDgWACE3
-y7PxyPznB/vlo2xyMb80z7780ome/PI4f9Mj0/Xz2qWtFLXW35uGFefl4C+ojNuZIpcaiPV9VtmI9WM2
-E8fuaBF5RxLKVSS6VvWhMi3rA7UVPlLlgkOkFXmqSlf4Zyv6nBPlrbKHW6sUC9urI7zW0jKtJapi3wD9
-0YzKEa1d/+H3y83LdeZfz8xbNeF6WrP+1tdbMQPtt58NtJ9Q6YwJCD+baFc10VLCxiVGWs7qGDHTPi3S
-4QcYaVFl+EXMSEtdfmIzrXSaOiutNdl+ZEPtvjfvtzHV7rsUm85Yu//kYOfZ3u9qrmW7Js3Rp7ZsAp2Z
-U35k/rOaKZJm6c9ijPzu8xHwCY+A8cVn/r8y/x9fLGP+44tPy/nHF7dn+2Yk7PBzF7YPPX4Knu/m+lYM
-n1MoK27/7G+fhNWPL/5wPn/XhtBubVrK4L8rHhnjiz/LefH9Z+eVP/oQAWz2j5gx9UOdRp7mk7fZENXq
-r+bg3OafQcHbf48D6A7OMC5ENizLb26fyG0V3xkuCNiJSw5LhPR3NSiIpPz5GithJAQm/bRb97wwFE9j
-3z/H782GzEPBJQrcGcmpATaITUWoUPjy0tbyWK9quC3sL5qmdWIOQBh1XZpWgI26Kp1l/DIrM046D9rK
-LwiOBiDJwbUrGwIQOZqi4I9hdp4uRvPH9hWcpvIU6dV6Be2fuyYwfRY1goc++BhBdo4RWOPaHPH3wPoB
-dLpwQs8SigNCZ7MgsjXhltHxLMOgOjTzobHObO2LC1NdOeTgcc5L7MZnnl7jeNxY4HtgdJUBIZuTlAci
-zmB3pjiOsLlX8YrtWpeGkhyQGOVHO7OusV2y0h/ZINg1S0PCx0eGObnQVyH/J5k9DZnyxMGsh8vZwSy9
-YB0xVJSNp+Ik5BWmVe5YhquQjv0EDeaQ2nrwehezdX0DR9bW5sbWNw/Mh91HAr0vU3OJoWWhcKV3563E
-K9yygYD1/OWroyUZgEOe21sxM/AF6AxxkgE3NatJEawnL9HZc3v6zSMtrtmIP2qdF6kfqcuvIGYTASC7
-fk1suu8PwQW5eo8pGJnU/k4+bHL5fjyC+LhTN6hTcM2+wri5FmMlkBsPLrqL5J1yYLcCLvGEUywY/Uwd
-jVqdQ5cBUXp6x48rK3zBhm3kvB3doFR5F0zWO54lHCnynp6eZNXpmR1srFv+lGrHrpr85TqXJ7HuuUFv
-2orJY+ItEj1PVxl6+MhL0YxrszRD88qXBTwshxVDk8D9mj/NRkWzk7iJVI/W43woeaYjnb82/O0VhTHW
-9C/hivL1utNHS0YpdbkgGYxqxhifGTqp1dAAaIdhD+BP5BX05+Mq8MHysQeZvwNiuYvzGVdIbOcuArjF
-zbfco0jfwn24jB9dfet92OBQB1wnNSenSBTKuQ/+NreWBaMHO9+fbE5ePhB70kteG7JW0L8WeHh+PWXI
-P9A5I2afwjrsJr+Ww2IsWpLerafYzmzSwpG1ugmGhHu+fpacvO2qs4S8C3wtaA+rAwTzYbnNH5vvF/w6
-nHKpFuJ+C2uV98q8R3oSz1gMeZ5AwXNFQA9pmaILxFWaz40IYsaAAdsiKaFfJdBSepFiTioEhJCGwH16
-DHdkEOkxSR/5aoNDCCR0y4Yq6Q9KL4gfdQ2pCaQNwXa+MG8XA0zFcM6XZ0o5+iAvy0VWPtj89uH3WEnf
-QXow/nZMside2VFsf0JWALU9JhZvMNizc0kC15qlw7xoIYACJcILCH41lkDl/neRza4P4D6+bevw+BKY
-WHMnbtsyyitAwwfoNmJlXfS/20Mg7kt/FD5llhsC7Sf3GeMBls7q9+az6+QUivUEUfO0qzakJCUri3E2
-z8cAXQqOuBlEmsyA/jBhMmggMWcctWmYT4ENI4G41qgfM2F8yTP8mzMt2X5QXEaPVj4QSbFgXU9cY3BB
-uELRJp+cjRZDlms4hgKoHRgPGJC/oY63T13Wkh6pW11rVi4ycilod+DqAduJ0+mmGHbWTczx0ypdnBrU
-otz26iNxoQ7NbMLVdQcSHTpe6MQxQ+eQx21uAUCZbC5mBWRDUMsdtucOCLoVo5voNrCv/3P44nmP+G9+
-fs0oFwgZ9mXSeoOAONvrSOPrb1odS4y+N0uXhvAU4VjwT+fSkvxgXwYOLszrAAuWNwcWPM4V/heyNVdi
-2+0LH7gqce0gAaOXrkfSPoZZiPQXZpjA1lBFUJEvbEcKou/uHsJ26D5qh38j6id4Z7ngZBF0z53cp1+4
-OELI1gHeZZTF5lDhRrnJJDVUKbHunFkW/vk+wzyujvpQNzcsT5kZiohWXPPNx50h7qdpnl5N3k6KqwlP
-itm282ICnoB/KXutbnXKGz+cu9OfT7xyzmFBqT7HvB6RkLuoUBLwZeIUwGh0a8BmBcbRMDARx6wXKDE3
-SrGQiw7BHIGgNbjPpXV7gDM+hSPKfAj7kOK/VY9E/8tDpDV96wgEF8ASadbow/ffwrsoUN32H24u9xyq
-qF77m5tfrYZY8QFWgiafJdGE9je/iyJPVD2UHn42N/zB5oYXUwLEq7E3/Ln1+FicYUr8koJdIoVIbbxE
-h06FtM05UFdTgTp9NfRZkuB/WqI4AgplutNVbbIvvBHdRm9INQPF4YuXR/svnq9snH1TVVLUqUgYLSFp
-Xy8mF5BUruz0zaAx5EoSV4OEpL/ZzZULOrtVFvC7Z+v2bvwypDCzvjlLX7F46muLtf6f9H5mjX6gZn7U
-0Xta5GiJiUJNQaGmQEQOL4m3vkAvs4dTQ+pK1qD6WnY0EfXc4mz6iAfHEkShrzc+eyt9Os5PN6aPaGn+
-f9pS/JHtvwyKACuw5FCiQngoWTmIxGXayeX++csM4wFpIDuT4V2suSdT3YifMtZ7ZYaLytNHH0lbCsqk
-Onty4n8pa/EqSkhlX/4HJWRGA7eydDubbtQK7MNA2Io09VgM3HvE6gD/eY6Rn/b8JNzxf6059HyC7mfY
-b19NxkzdAROqWj3K3+aSWiDg/w6E9ZgJwv+GzXcqmQ8hvhTdkNrqRor5uNeTL+3wzZ/rdMg5q7K5ctnD
-TkAnDPPBsCIYynpHHXvt9VMZLYo5QFW99U6oVQ+iwuGD/tjvSUpz7hm6DT6GVfDnif9VlKgu+lmK7jz8
-acuebpSIaHUIhr1XWLBGhIAvICX2DtsK0G+wTfgmdmxd8yEjDuxyxTDdTx09+5vpzAFd2i4lq7nwJt7O
-3STvJkisTpYGVmib8JS8PS6A+jNKP6cALSM07vXFjirwHPVurHMbcVINLOMp3EaBps1r7Rh1fLbicf5G
-Z/n3EoGojvgDGrvRkwRJAXSvYZw5twcha++8/Kuo8XMvbXsg7soPrcuLlXUlQw1GsPOMIL9L+ZrAyMSy
-ak8YY5lk70HDZnEkcnSwhezvCOMKGflnRYkIttKcNUdh5ifU3Uhutsqi+gsRX+BV5j2YMj58toMVWD5p
-jgYcp6lOoNcfj8u1LS1v1LQccgl9nXSMqdH7SfydNEQISZ10PWk42NDSUeVlzq/HsURozzGRSE34plhF
-ORt8JqQ9q3h8Sg+7mi9VovKHyCfbSebrfJMTlXU0ksVzY8rneCu/pSuVsD3tSsV6yxw0hADLUFJMu/qM
-wJ1K3y0DryoU/S1KYLRrHS9vJ9i/v1JaO5D3h+Dzdq2GLWgx8t2cfY4dsPT8VrUVh56oehttBdUMtBWH
-e0/3Hv9efk4wt+iO3eeNqmeoH0p1XZZjY++WX9A/kV/REgcZV/Bd1bGIhSj6tFt6zjTphZbcDW7ng/Ox
-PC6W3hccAHyNZ9tqbdZUrmh2VvO0+T1mYunV7VaTdc+O1UpvKKTcUxMRinUuJXwOcTFn+fwa0kikhv1c
-V9hW5cApLi5Gkk+j4pHQvGB3WbJIjiXMsAQIXcQ2iQMO0rO3CJJhznBuBgF3+GAiV7dVx2Gn8qfk+E1i
-+GIs/fC/tY9NA6dwYlLVXreagsM6tSg2d1tLHR1PNvwmboj7g01t2q6GetLNz3rST60nbTKS7b3PzhZQ
-QuH7BxGekRJWDcjAJoZTmt00M51msxfn53BH89qoLSZ6SlNAoXTsnIFu3XDfsJFIGY27ih6HyWlePi5G
-o3Rasg0rfZfmI1REcLiknZaEEGvoBnJmK0FbsXoHyIeojiG/vc1N5TtlhodJxMbJlZmli54LfzDNn10W
-swfnsDkJshR7LHAKSgyOkLVlwZ3kakMWMqaerw5Rn9im1snlkf6muQW3BtMhvcA/6TlxROY5rjJeRG0N
-UG3qxtxb+v1IXQ3/ltExoxwauC5+pvvsB/TFhvRzvnjv7+kAi7N0fnaZYNRqzpeo/b1WyecXfPfOy30b
-P8s0b3YGppZmMk/SQbGY29zV7vijaYNer9LZEIxlcB7C310WetFFkkJsyREuwQzPxeLi0pyZLVAfXKXX
-tgl7kdkjdDFK9V0SwpxhaZmVXLHrCX1QPrdedrZ17HFUmNNZ/PSSbMKowCmqEAE0k5Ehx1k6Abgm3keW
-iuAuhEAOOAzTyfwKEthjQPFVATNXOnZBGjvDIVxieDNgfCeBEQ5pWNEdeFbtEQUQQF2odSO7sKCA9ezD
-R4Fu7oCPdFuAr2T4nJwtdfGnqL1Q+iIs14N5F40LYlw9SHYXIK8BCboB0fICi0XLL+0umF2glIHpF7Ob
-49XXjYdHAKt5OE9n80rnQ+mJhmsL9qCTo4JnFlgWzZRfyMzg3mT4ssghZZX566jA562u3wvdpXAq4LVl
-rq4hbw6oqCEeW1BX+zKYz0dra0EgDJbu60p0xzQt9l2zaxi4UtUgN9LTT3UE9Qy3ynKiusonw+IKZD7H
-vw2Xijxud6z+9p5r4bff1OoiDTymEErD2TZqDRw8EM0nVTPu8SOvoJv+oKhwTyYuy251SfvUKxZp0uPH
-XJaTN1b3l5kgfLgzb290ZLfsK0RDdySJeqV6MHmsVB9O6B1rF6BXfwSTxhByU4BfsOGd/gm6xh67pg4H
-G/JhSoEkpe3Ctg+Ojeo0VApcf4G60TeayCMrEH1hN4HbnEhOlklFh/lTsmGuSXp5enOz50Hx2O5oJgbt
-zbPxVJbQq3IGGhvFJG1BZrUwaJaQSsd2dClgO22vTdztUCk1p8ysm1RfsuBgh5eXR9Ji4zqofv0+Ym/1
-UrhXhunUVtMMKWCUOJrIIGkVXBORJbA8VLin4Ztqfe1Bk81BdKwVNES6ENodYnnzUtbVHpKVk88vCmuG
-rL5W0qupBAtdIwI+sgv5Mw8TBuS3YLd37TGhav+EE8YnhzozvCI0m3iQLD9CfjN/wuEJg1fHSYDuy9JP
-aPwOpJSulHMnyyxchRpJJDzmLYV14TPsOcMJabmbHlIPRJ1YlXyro+cOFBBcFH8/khkTmnu05umwvMLJ
-j7qfunZxdK7VSneezmjpoIJW8VzEKWySdlwBot+WlVpBzIFHqli9QDRjQUg3CLTtNQfDvB9pFDXHHU1q
-+xORzsE335xAYgrsipQoroGDwrBzuTDA8Sh72hkctHkG8u724Vq48aU0UUbvm3T2caYu7eMvMemnZhrN
-B50aYR8APDlYi0V/Qt2F+JXcfsn+XpeuKNDYtCjLfDDKGNzH3JMuzJ6B3DFoiyPyNhcL+Rp1vpkrxqLs
-Wpvo/h60AVl0c0QM5VCzOWfQNXMHFzLIGEZtFotZZF4+9t6uCozB/kbBLyIX+kKeJ98tETTd3h/JIQ/9
-Hsd1Eu3Om+A0kf31LJ1f9sb5xN/QXW614x8+y3lK8pNV17pd3K/2YqqqPlj020s2N2E5y8pllehT7tmy
-GxBoAemSyZLFviejfBqhpbKr7l0gVPJ1FsLGRnwJ61Wk9B73YAR74XWKx4loZCYnYG+KYclcQ0F/jeEF
-4LZmoFmq1UlZ4HVhJbwiK9eEAduDQXdqPsq24+m9l0oEikkSK1XN9tR4+UnhTnktvVKyhp3RCFsu22Ko
-s+P0Z9uTlod0H207ZuwXoHVr2+/r2bmIjSkwWOgTYNUmlo7OejDAFBs6tyKBmWkQT2OKzZ4h1lclJNWn
-BOwt20cLuK1FN8eX99otzSOwBPGOzlpHByFoTWyyzZ6NAYJ29e7MkNZg46Bx9/2v+MnTxphtH96lfdjv
-1bhwLIOC5cM8orJ+RKU/opBZNyfMPXTKIkEUi+me+5ubGERUr1Tub21tdus1xub1V2iL2Ppsi/h0tgha
-CbYoYcGm1HYRU8VHSSHXkNY42cZrTuB73ZAGWberk4tFHK3169ujbTWkSvZrN+VUVnYSzElBdoh3eTEi
-afCsWIA51/S8GE+VGxMFrwLoY8m6dYcE0Oc1N5L3a4gdQqgQ9POCHowkT/5M5uvNhXX4q9kt4CLmegcF
-0zibXdAmgWbU0AwZcpo8mg0AV+LRILXv73o7y/mg5S5t3NUstUkDgGytfh2C2OHrEJ0KCB434EUhEjjS
-tqHsYnKWj3Lyyib9PQZuioeutGdHwrlZ32XlPAegBchlAYyApoVGr5AUeCfBeUNOTvbz7XY6w6iA+l1E
-B28ZkPeanx4hcInyWgFtsnPrsC4dEDtF8zoxE7iAPY53oBwtVhd0DsJ70haNrmnSB9eGCsyW7iGvZ/+g
-aL8qRXyQWc/zSIY6Nzgx/LKS9MGOUPkWaLAXmzNX0gQa6QouQkxNSHI0RIwW7+IlKvUiG5QvAH+vC8GA
-rh6pAkRu2Cq7jlIJGctLm7NLVYrm/ZeXuK32DYG+B39T56FkJ+wxyot02eRMGeSIl5eOpNh+NOGjBz4S
-ptI0i6QMNilqDVzvzk2DixlCQpQ57kZSwtnN7aW08ZdN8rTAN5nvAeJ2yXNw6+JwImt9wBsuOzKHU0lu
-iL/hm0M4SmcH6MsGs+veG9pwP7hNUVbJSJ7VzkkdTeGUW1JVhEUf1dWdct4cR3CRJaVqzquFOPWQqaSB
-b7dDotJCuxpEj/z8UGtijtJnXk4TpLvnYCUFur/KWkPijBQgDxAYp+U0nZwq9820hBMAfYKGwPchydtg
-MXfNlYhp7ELsy3y+ID55hRZc4nWE4jEHyGDTxAzvGWZ4RuIwF5COQiORiyL4W1mAEDRewhSlJXm+insS
-v1ez6Hnt8GuJuWj9AF8HcY81OEJ036I5M1PP4FT7uwiu8aOOl9Tr9qVt/gG0/yPFSnYi+1MisOb+QWV4
-pUvVKJ/asLEUP5yY/8dBgN0cfniVV9xasa1T2QnmEMzyd1lsL8ggvN2go3/sKMHfLsY+Q5+/CnOVFuTO
-h/A/5vdhhcmGJaV/VdKNQm0n32uODopD9ARgaCp2/mKfV0G2NsQDbgcCzPBc8Kt0Y6bsg0K8u915a5jt
-kAQjM2OLVDCacPPZmIWza5QIdHOI+aB97cFR2GmAIqeP//0uZKAik8by4VazhGGGQ6VxCvmcBu7wu7Yv
-ap0EF5NalitLtEwg73Eb+7tPZsVYvWiHA10t3NiTVuydtBnZosGrrukq0d/c+qYbvSz0N79CF7sm0b6/
-tUHOdV99xqT4E9xyza0m/YixyZ9RsD9dbPOtsK1XQu+YM0EsCZWWYn6w9B8PdJ38ICNbEerawlinEzST
-hYFdyB88VzmUfACVibcs3BfArDTICE5J8CUxaKlcSDwZHM0vH+/uHO1YALmPF7vlQLDRAHYH2Gs1gA8B
-vobWoMatgK/vFqmVfRT86wiytQu+qxIEkKrNGdxNeEw2GaVkUW0PQY0CVvhhpxrhdRTssdvEeEndIMrr
-aO+/jnYO9nb+bfCsV4W6qUwtAN7YPe4ivGy57VjAlYuzsOUqoSx3AM75EOicxIflxovZqkA5ChxH+FiA
-y2c/s/Cmy0fkUwA5DqfwQwD1fAKo4AAZNnNdLIi/VMKSionHvbtw50DvADiILcfU+D5uALF+O17ktw+D
-YOPw7rSUUSRBO0I2uP+wnWx6a6sOJhDlEFeUnLbnlLYIQhsp+W8dbmKsD40iqPaA/Hm88cYt8Fpsleg2
-6m8UF44dnVk7aZWWdPVbR9gFEPeGQ7wGcfadRHQi/+EmRR9+WrmBnFpHADoSXGunc3cbOU3ai4kFcWbl
-0JUcIxya13N1jyojYHk8TcxVY5TBis4kPIMH1wKEsmv0c3ENoSQjiaNTnZs8CbH5cWHadWjzXmAdTbvT
-5PxxQbR330V8Uriks4cq8WrITVqnNQVPnZMSJv4q8bIW44L+3lqp9040BYGfYcC9flfzfBUIfrNOOxS9
-gSjlsrFZjMI7HsH1gnvY/t7D0N1ZYgYcgITZcXj5nWMAqLcVIEoDV51jQEWbyRPmBTJX8xvUpAH4MLD7
-jxWauypK9mf4+D9zaCv8/y3B1xUd3zZOVUTs/7dBZZchyC6HEPz6szvKp1LU0UHzM8QbmhU5nBtayi7q
-XU9WVzVBaWVh8QurF3dwAoEL9RPRDPluH/qVbflg73Dv6OTnnaPHv+ztnrx6uWvm7JDdz9yNve83DPLA
-2agoY1fSpqnrgaM/PXLz5AA10BkABvXk6avDXz5gUB7XOh8tykvsNhvKs0E+GbZ1qY7t++hg5/nhzmMA
-mz15fbDz8uXeAXR9HB1TNz5/b7R6rmlC1FrzBOJZOcvcV3pF0EOdJcG15rlW9ZQPhamkX5AiwjyktQNX
-OPf29SydTiF3R3WRLZePzZZ2rLQKTo/aIVh15WnxfCTj5YU0Qurqy21fm1kfg88665oQRsgBKaKRm830
-kr+EnOrhPEJ92ik5186zU2oNY5dnOTrXgKPSgAjNXLcxdW3qOfJA2g57RQL+KRJJPrruWVvqwKNVNfsw
-CHCh7iZpNxl0kzNzXdfiQzoy5+fwurrFbrcnWRi+9UYmvIs1dXeTE+Nqls/nGGYMP9JrFPTeFfkQXMVn
-KWqHz+igtvJL/GMcAcpktNVcRN2GtfsD22PVTFJGlGoblh/VCCzxebH2vwZpIhQBNL/vbz5EC5/Pq/tb
-G3T2f/PZcvcnEAj2J78uwcv4OTNUlmGOBExp/HK0uAhNYvEyco6TbF5bufLa1hvlEJSJvhzgnMLuYLpq
-pIQ1ItIHqnZfoBXANyPGC0kbe+Aa8jRL39WPPlrE1r8z1gjUBr2BugCYO+55HtjeokWk/rNiYGjjMD03
-PNnM1Nnb2o9oKvmHm0d/Nwfm2vO34sscL/kh7tC63s+UmSVaid6FNZ5AHqFoeXgTlqaE8pHC5kWl7G6d
-xT1WolL7fEaJymL18F2lBudjjVWAV2F5m4ghUoHehTUsGGKkBr0LayjjWqSOvI3VWkIBXpEPcAHA3fkU
-naZChlZ979W07D5Sy74LahAS8i+o9onRRFDijim7Y956kYqxYtIOrWYtk6u8dvXI07b+nImVsLXBf7P+
-fKi8tvX+8bclzD1Wwt512ZvCSHwv04ushvBqCq17LhfpYl78DSjFFOVrEpmQ4ZLlQSc1mJqVJRmq9ebF
-K2woLbM2O73WWhQ86HBN9CpLiMX+hH++UXD1X87WIDZAJSDTfVRdn37FOGoco78/eriae2O4B8x6VLC6
-79ru4uE9XxPkBnuJo4YTEn4JJcpIqsXoHaiOwTfkMjeLMzu7vMYb2pSoDH0axPKDd63YKInmflkMeJyh
-pNOukYBwZlZp0N/97RhHWLkt5AptxyCCeTqE5GIuaScqeHk2SkmbiOEYAvXYFsQjAMkmNTxvDmoQsMs6
-d54/zvfI5F/Z5/3qI6LEqMTYjz+mGhUJuV99RCWbBLl+41uqX+GR/eojKhkX+fs1z3mDVSf5OXpYOa9c
-mmVkR/mZfUx8Rt3klTRl99NKLXtHcKTZINroNk2DBp/5J7VZx1gdB+CAo0mWDUuKSDGF4KoHc1jH4g1P
-Qr/oq5x9PdBXh5qj4AN0UKLt0KuOnhg47zio2q4X2W/x+f6sZnIstCjvYKsfiLtERS3QYKh3IL7ymxzl
-RfWOBEh5O77Qr8YX8hzT9Pd9+ZLfccawfiBM8ltO3dIPBEd+a70V+xUxsUtqotblfDwyr+tWjd7zWdi6
-zNJhY2F4L4UHxfC6qTC+79TuMSVE8FpVxIp29CIZY9yrNBYTXKIj2wPVUEhI/sN2a1KUZ7N8Om9FmxAt
-uBzDVfFQbfDY6zUWAxrbDa9+7aVaxTreYeVH2Ta4qL5oSa0vidaXMIiYDkTgFHyRts81YtJstEbdN1RW
-TAUeLLuC19BU2KS+ZbZjV0+HN3ErjztERphB+or2UjQEgUURfW7vcpaddwBVT9yUoPv2g+Of/vpmBkM8
-AZXs/wwedHqGcuZt00/H9yPQBPPSlK3XNcDbdetbEL4hEJV2CPtQUe+SjQnms8//RTXwqjbpGrVe/+FW
-N6q36z9EzXBUK9d/+F23QeHW39zARuPqNPMWbdGN6AhxXZh5iw03qrhMoW8+0EJ+m1gXVur0N7/aag6B
-8bQ65uHX3TpFjHnpdcHaFvP4226g8THPvutW9Czm6ffdqjLFPH7YrWpM+ptfb3RrNRvm7Vb4FlUm5oX6
-rhqtmin0dVgIt4N58W2zR4Kv+OhvfuN6c9oN8/ibbp3ywrz8rilrblT/0N/8Dr83emHvb36PU1VVP5g3
-m9067YJ5SW1W9AfmDX5W3eW+v7XxdczZ4tvPzhaf1rYCRPuBsB+1QU8xTTb0t2M+9rrMo7rbaqk76upq
-ji45s7AgWydhbz0vrvzS/jtfGzWDdXgyKtI5OOR56icE+TofFcUMXpmJ3dzY6CQP4D++7sasO3n4FYNf
-u8nb7BozZVBb5tGxeQKJxdr2b3Okb3TI5e2R4CdFzmqof2IOzmdZCs58GDDUT47fdOHqNQLnv3yejYVg
-MN9bThAFHOULHtrQBgZtHs7Ts7em+sYbEPNP6HzOhtrOz6iTFY0ZIohVhALbhBM67LqwWAVSDN/86Bva
-lVbG9KLjhZdX+wqmoWdR4jYerfk9ZxOAweXCiUslccOfWEzrPG+i1YOcNRfZ/KmZeX9J6lLzLv0M1+50
-lk/me+/NLbo0l13V5FgVl+b1MzNE76ehrZW6ZcS2xXgM7uTbtTsb/CTtuA6puD8oag3iqgsjDc5h+trc
-bm8MXo/yKUCtFSWsC2doOa0yQbJM6EIO1XpeNk0VoXFUzM3eRPUDDDABoOOkPS47pqLa29iGLdVRDdhv
-I0SKxgay940NkMa5sQUqoqs/c70aPoWBMpgJctkQDCPiiQHNpWrwQI1ilRZ51HXNicRSupVYTCTcnHMx
-3khMh43vb6ImXLIjMzyzXazru8KjYBdlaXCyGA+yWc/fKfuTP+dOseP6fXfKiyuwxP3o/KOWbZP9lTYI
-vLvz2stnjQobM8a7E5rtt7o1l8s4aXjz1psXT/L32bC9hXghybisIIEANJ+qwtP/LJ0uIZAPWFr+Ht2q
-fCOcOW6ELs3aJ1nr1+Z8goAdtdB2dW+9uMEJRW1/kk3nb5NKpdoF94f0pyBOnDojTf/JmBXcrz8im6Io
-jBIsYS5Nb8KPjrUid3/3ZOfo6GD/51dHeyfPd57tgXhMR/QwqNaCy0zLvodfYYl0dlFiif9z+OJ5j0Jg
-8vNrYmnwsvPI31xU8U/Fvk4ACW82fD3LvQikfNhNzieUT3wujXcT/KgQe82+5+S86EBrbnvnixFHC+YO
-chj3OqQJMuN7MMvOAWHAUg+4nILrRc0XWxZSIcDjleX2+8nmG9cOdUjrQH8f50NYUfXDbIDjN2GR3nRR
-Xratt6ohjr7MlzzL0UfAzh0/hhns4/9X+Bwvnd6reNemVZDVgJeBtK9vAZi8GP2KTRfb5sr2HycnG9sb
-8J/NbQH3s7mM4eUP8Ar/goTGUI0+zhY+hndvLC2jZ7h8ln44e6d/MWwzP4GLHH0BAV2fkPD3PLsCTZCV
-v1tmtu3qJImuEYkIaAUITzvJuiL/dQYvyzAZ+TgD1UOZELmD/7iZKEM0lwk23IMA2qt0MtftzYvkwkgq
-UzI+cgMIoJ5c5IDSjlUhKSQlinCg2Obu/jZJGWXCNai8ynGTWNgFcHcQtMjC+aURYAUiSY08+Cmyn1Jw
-LOR2mI3zCdlIU7pMmC9ev6Lj+KqYvV0XNYXLXbnKXdcjcviXuXviv250zHY+qXkhzjD+U+QCZfiUtlf4
-VIE7VN5ZOuwnG/bxjYrzFsRwXxPTViVm7xgls4cpQDmozuPeK0zVsRrUbXiRrfam59hodbiS/0ANm08U
-u+dUSglvoxFu1ji9yPYn8wLiH4NN5pgM1Yip3b2d9pHmdLXvtcVX+TA9SuJCjBom8gjeeVGm3gX+Vh5v
-vlEjii2dOh7b3FjtyXi8oVurWRAVN/2SNNePOWQ5xtUIjxFQVIw8QMnw1DvuFDB/9gwrc/IRBav4Dakj
-dsfU80QlN8WcyRvge8iYeq8CZSD/bFOq9HYSNuB3cVPX4bxYvTspazvjB6t25QK6V+tOlXdduocrdkvo
-rat/JaO9bidMqMfVdt40dd1My0G33PYUgp3mPtyfeu17J9K/ioRT+RLvubfdbqrbJSD9QVrmZ1XCv8W3
-8R7xR+eLao0fQnAoNhivV4L9hszK0HQ32eysRT/vZiVeHeW+KA+VuUKWbIGZ3n1Y2wrfIYZnu+NgIz2+
-42MwemeALhYgWkK5JCK4/QOgySAezpXsdNAJwGPYnOckhgCKxERt0s0p4HtKtm06RW5chxrAAFl+0+f/
-VGWj1m8EqSHAlXzkdZOXrOzcXnFywupig2oY4iP/y5wpJXK57ilLi9+VaSl6Hf8dJJjgsJY58pfVmqxw
-YKT6MauqkEbNhooeojxpfnPus0lY3YiTxh8nsqw0C0Q2/izYvm4xG6SBGeAbHqaeoGLa9tive3esiqmL
-cvLldvWqFxm/vRbUfALMjRpWp6Epe5FYeTYa5vU2jbllo7r62nHsar9h86j8Y6bWt3gp6Crd0WdIAXrU
-fpwJnuDLINlTQ0nXg8etADQLxvijw8O6UYRYdwD9KyzRvAEY2Bc1FsvDbIFxxKB11/sPH8bcXqytvr/5
-daNrCvvIfIs+MoGJvb/19QY6gXz32Qnk0zuByBKSx8fKGBhrkHOT0ORnGGaOSGYLxAQamQsS6EEmyWZv
-a1wm7ZTtcsl+Mk6HAEPWYWeS50cnj3cO9k6OfjnYO/zlxVMQAUwlC7V78uLl3sEOoh4c/fdLi00RvUn2
-wU8aEBQZ1gq1sPtmZQ6OTp7tHPz91ctoiWcv/rF3svdf+4dH+8//1ocj/V2GLw728BV4aZrHlK0LXwAa
-5cljM3azBNykulpgEZbJZP8AVDeMj/HX0rmRngaLOTXXGmajrFqWnoZlqYVDIMgyaBWptNTFLMKXlKx+
-vb7bHmSGe8KeHGav8/klAfBL1Rm9bCET0Qmja/TYNmsJi7ycyBnJwJA4ac3Kn0CtNBoCfY/zcpSlQ44a
-sjW50WRopmcKOkDwHSyT9ByyrUtKmOwnXWVUXNB5QWYt0xsqDW1hq7qbF9P7o+xdNnJaPmqHXAooJQ1B
-vWaQakWSx2qZAl1KrAY3xyfmPz94FhnR3SY56GudMVGVCWw4xzlfFF1fX3olet4hD2xerOLu+Y2/UnUm
-HJuFFYwgcCSQ7vx3/ChsdcinHfaTI2vT30eaRScsVd+hUr+iR8F3WsbCr6rqRXPIhzv0NJNoEohwnWNs
-E28/aFtwP7vBlaTP71J9h3ZX5hvPK9ouGY6wslzNjjR20czxMMwFGoTUQ4hW62QhnOffeTXNZWN/V8En
-8gc71a9ePid7Rl5aARDfdTSRENkgegx2p6A63df6o/RlQiAYltGsqOXm71iVBZmx7o2hAEdInmG9rwfi
-aENp2jfcU6WYV09F+a4e4YXLacutBhB1EepTqSp8pCb/mq8QV56Aq7g2fAmy2psd/qodOm+koE+vpWXd
-2rlctVvn7hV067W0rFu69K7aJ1lMg/5cE4FwvsZXGcwKdEXAnqmRFWeY7Ad0WZyODFG/wRxdyklk3vr8
-Wm8Es0/cALV75vIV+nE7Jpy5Tzf9Eketacrjc2tUHj7IsWlAAgpsogM1gPtJ6n7hCdfRic9NexV+2ehO
-1UVI4scAZbuUdVrC+Ht2/W/OO6WLM/hwq6cqVdKqYFqQqv3CCebYfTUh1HqnICv1BPsuuUu4tdcvaEnv
-BV1W9lkBGfsWmds4/Edw0i3h/ZbnQ8Jl38mNMxOwCwSMfwyJ15T1V+K1306KKwjYxrxarj1MXQjAZORC
-Oc8RcDY5RPtyenExyzABYeB8ivsaE5s7szPkFkINA4ip4pytPzIlv+232bVDVnYUa+ZAFe5RU+BJ8iOm
-3NLvGo5C3WJwFvqvmg5DXVIhHZDtt+l8+wDe740O3dh+X77v9/fHMX6PBFbh/JGJugvX95q5I9ufk74x
-xT9WY/bL2JFcP58o1w97AexaZw8GHXS73HwWJZx0HhymFZaVDEdAWOrEZVLFTSrjNCs4ncJ7yq6YzyDT
-4rxYEwahPD2I6UB6DzMQxH6wGiczjnwGaSIGqCdai3LwQNafza+fZuk5HSOsrDH8wZsTuVFJrfDYAcTz
-+EkT3z6d4GyMsHoynOzmFC1koyPsyuC9PMhgIw423aTMUG+ehiXslVyaSlmdkFD+RMiJqmYvfn7rOYud
-35wgQJUyZ7b5+GH2/sW5ua520AC04V007ZdKGAn9G8yy9G1163OojNQyx2CcaRjGvVF7OLPvm+swuFpW
-Dv6aCCIVmwU9Ra6f/djD7loSE736sYdc1mkh+v5Pfn/k/IP0r9WU1/IZDfiQqGr+/qMCPH79Wf98O/2z
-zePbhPDMuPkxHD564yPxkSxDDvCRKur17dGeV8otBgjFB//Y2z15efDipWiqDRPu4+7s4p48px9IzExJ
-r9PZhAROSFKASPgydSpjtE0fSkk4bjgZx1ok0a/pEifWUi8ltnpNw7XQ+tQAhiASd+Evp9KxYl1xFTjD
-6OHFDJy9+zrRTzZZjDN+TB+Nj80+jkJrIf+TRJnFzNPaWY2xZJdwMpoN/7EVMVKS+R/3WXp9Ur4A2/zd
-8xoFWY1au8Xkf1pz9FiFPfCXErNgYHgsp1hG8oukBtMpjVqUdOtahzRJnjPI5M4o1aNrwlmRDLXSaMsN
-CBcf/1VSIIXzZSj0ncuAgPIaA41JYnrOJiwwyiD1QFK7/Jy9a8cDwzQoW8TiDKbP3J1kjMToKDOY2eDP
-mLifSSUrDnzgPsgUKwkoPuyzbT2CGALccEYnp0zLbDEsnsyKf2YTlxRcmY4RnK3vwnVYD2eFi7ckeMfb
-8bIKRXajyr4OG/KRkhLis2cjVQ1/nhspqv1eOfnv71E2uPM0HyV2PqSzNT/B4M9pmXl55dFd2tT2KLfs
-WXrA5E6S0x8vLUBa0BT4LXPOuWR4PUnH+ZkNKTTbAePMe+auQ1J0eYm2nxHchgsGrXZOW/b8oPX+4gb1
-81WG95tQguGuQQXihPoJA+zVZuN3WJcud70sShd66NLd3CYZ7yZ+kv6fF/lofh/IwJEQCq2DbFTAzWCi
-d+4a70ryq0okYAWfvcWr/FtQQVm0eopsPxeArgN0oGOmISKykTOmkLbYMGFKMec4hplflxqR+UHBByb+
-1yJ/oR1tV7L84ZI+lg9m65nEX9IhPTBDQbsc8gHoXG49s2JxQVdY8oawPZ/Z853/uhtqDkOgvyMHLtj9
-56P0gtAIKUf0zMa4G7ol7vIuw+gBwHcFoL2EEm5TlmjASkfSSAC5HAkVeKZNysXxAudGiPonOfBfXRZm
-sokOe25QuZWd2Hgq+RhN1+nbZ+nUdItJ20VzJJk+wdhoB2Tmdex23BDMlcUUiTRzMC6cil2xeGBczLE4
-wVFh5LncfNZLeqpufUTCNwpTnvc1UFWKWYL07jBUlJa5JK3MSqSu6WI2LcrM9GPmBypLW/PI4kyKyX0n
-KyRtylFv6iPldPlaTvxB2mHUOIBWNHxbfTt0OFtMcCRw/+t0aakyfEIGY2BMtqGLiZmfku25+hiok4HU
-tFIiInIVbHV9FWVcJgrlouAVXMqdwCSSjkXYD5n7TbjOPTscdaTyl77OWmZaUZ0BaWGJYYOoQhuDZHVD
-WcyUXH43M6NZOuP4FdR/kIIDdR2ugmXWThYwHRiqMGLTUJQkvImkKRq0vf5GDrdOuBq003TeJZEBA3WT
-w1BNtukbH5Gkjbn2cnTPwNsOpqsC5xW47DFSoRUeCsvZ7IkoMl1vDbmuOij4DENUw2GOW2GRl5dI1phP
-N03M1gdIVOYOurZzURYAD3A9dO/VZeGWbLFGAIp3TkKf945EuNrTkIi9azO3OZMKzJS1OeNk0a0X8RmI
-F2MqCoACWfMT7/2LGJCIUi6zHMWKntsnfErQIMKkaFSQ3tGJaSbKJvc1Ij0mGuyrEkRE1CU/xR/xepRP
-0haz6qyDbGyWGTmhO/nhc80dmzZeihjC9LVyexP5UaYNNbeESejdkrjDy7Q0N1gtNGKtTvLXv1qGkiT3
-/JtobS193cJhHcurN3Yq3KN63fVjm8SWTrtxgcnkMA1olkhMoggOsN9gXjBdyHk2A9cac/4rLamZJrNB
-OVdJNvSmzKpDuc+nAu0Sxkkm95OtR2t+VlwpvA2ZRfnj/Uy6ybaXK1Q57wZt/OhasMPBsAPTgp+FlSp0
-HtVqIv2CgRLSNXycv9GfeZwbOtx6oy8K8U/Byo/Uch0gfnNmkYgFdFqc/0ETiRI4F3jpBFwvEfJL3reV
-so/idB1rzgs5qNAgBjfIFmw1k6tufSnRNmJ6O7+et2K2AsEf/6joksSGpl+TNOu/kUnu0IkUYbacfD5k
-to61ntsC9ayaEm5Rrh1m7bjme+6cwyXmM06aFIc4dx6JsJvPeVdTOyD4wd5EKR83rrof7vUueskPT4oi
-efCjCxUxv3t8obNiMZ+aIJkYfgFHwzA5VQfuKQeh45VlbDYVl9TntPALyPxECgd9syJuk89tbUDkpqS0
-qh8e09PsIj27Ti7BFkNXH1RG5Kw1zUv7wWuJzFl4ZxNvbHobW2VITLszGbKXpeweu9jFaLgnYzeEqbYJ
-LL554k7jON26BpRrmnpoqVk9s4StntGVsPpYbrz4Qka4Ji5ad7m37Z+LIduKoHxHgTlHwbarrpqY2Vde
-yCjs8KrSsB588DbwmXTt1C1cRBSylLa6NPRCPk/dTItpXhGF1N0sc9KapCFeTawigSZzi++LUpmjADs6
-VAWg4mgAvID76BoxCF26oSKof8wFbJYbqWbCDYoKIfMIaImcpqQaaPeelrYcpzejOsxHdHMHvemIbeHn
-JFwjz8L4x569F/jinxwAMsZaBq4PUjU2mMWasdEER+XBm0apkCcQlizny7PN+/BZGvwsDX46abBRDsoq
-h0lVHlJHRRY9JwK5h1XOopH9KWLNElArTjx/kxyxzeGUCp0SRgiycqf2RGvrf57notH1OHheYnhnhIdT
-k1Z7i7WOsnKOKYuR2Z1DPkVUvRWLeQm71kiqlAQB/M7JDQxcskhbDnpwTHZASS3zOavNIbQB0yjMr4pk
-mJ8bUoehiHIcOuIWCmdNpaam6UXWA5Wc6XwyBytRKpMtKgbXII9CYPyuMCZnjb0kUPeWkwEKOkwnVe38
-mnhtuNm6d4/nCW4GvPECTYXImRTmKFXBs0+qqo68ii5QREStCapSzGYH7SKId9fFAvRYixJY5dgI+fl0
-lCWGiZaIuGJnzoK3gJoJc8jn44znH0+10giKV6SDRcItL/Mp8qFyvjg/7yVP8vdmxbqJaT4tMy8M5XXG
-kSqspLxG02ACi8/ltD9/6UkTca8FW2RlyPOGXJdiEe9vbikkcG3z7m9+tbFK2uuHnz0j/gSeERyOXszI
-RYKnNlpC0usCU7yiTC7mXMAhKT5B1xK00aFuXSThUu595kydpiUb9bRx2W5M1J+yLmuA80tsEWwT5kN3
-9/5BHquiELVGjwFwLAKXptsnchq2H4zSycXCcDi0yuF2LxdToDPSzzc5iNwuMd2TWXpRU1xe+UDSZk6O
-zGifSsbcSMVKmcYW0A95hVaw3Ic4t9h6QTacSNWghHVmAf+tOUAJFbMngYeM90q6s/n1/bIu7f6qnjRe
-wFp2NkoJzmhvNitmO3DpGC7G7EICh02tPK/lsQm5hteWdRHaj6z8P0FJ+l/2WoGMvWWkW0O6fAlBTjTO
-jBCLp+kp3ASwqy+T1mmvFfXHg+wcN77PA7lTzLIWuKDjrcIwWsPScrz0ZCgRiDEdnYLxyLOCHfIIsd2A
-zyfK06DywD3O+xcNQKXZsbB732bZFCRu8yW6pUE2v8rgVvcFu3yUzoUDz8tf0vLv2fWrUrwXRGkPBUbF
-xUU2BDJ+kuajxczGVODb56+e7R3sP8YryN7B0X+fHOz9be+/TIkH////GX75Hw+caPi3bE48SQSGFmE7
-I7MARgCpwqn7GgcRESB/EoeoXXJ5p2agFbnL+U4jQgTSsbrGLwZmPQQD1YzayldGwpG/gYheegWJnNDJ
-yW8hcIC24xGtBN4hlPlpOxhBT720Xahny9oX31ClCNPxBb/95r2a8DPVzE11uSyKPBBKq/QCFj7F0ul9
-vev6bqtoHx7hEiUAj4HuQFLHrLOQh+Uvekr4hhPb2PYMFjjNS4iURzcD2uW45Umoo8OVDj7at1KZPJvT
-CXGAHro8UIQAnbcXs+KKRNlLM3NvYa7ojJ5lmIwRYlFA5LKbHY9aGAoMiXPAmyrmjVOhASNhNj1EP/Yv
-ePDJuhnzunN1q46+1m9Ln8/WeyuRo5w9wLFLEGgoxsV34UF9DzAaqd0q+aH5Q1yHFHHI9+zxfBsW5nR3
-rjV3pmR1KkWz4kqfVrU8ikka9lhtIyp/P2yGncnwWTHJ4TAtZsRdifRaeL3E9dJLDyub8/ErenRelMUk
-/19zVXZrI46B8rV0I7df3ES06MxWGoIANi9G0BRvZ3zU2MUfYBJLvrhZPPYvyLmwkRZkl+PutmlFaK+f
-qxinZdTzOIwg+F3oRwYI9DNBLMFGKroXP/Mo/dZEa9x8wmkmCtSu8RKU3vqDTw26mRgxAcWAsrB5WBP0
-rJNr1u2o4vAyhe0vghvwmDGNDX7i/Qz9gJhomhl8sPBGzi2N7I/cbABuiNILruMFnC3FYj5dzP9wIqhb
-Ff6CBlqw0hMHKDadVaKipza4glhH3ZA1AhvjEKm3ffUjPNfVm4nYJdhDl3tzQ7XFyYIhIa3jIv+n8xgM
-RcJjnhAMDCSabS8pCGJiZ43DVSn+jvoIVdU8yJp9I7WOudgbzWndpL6Y4bTLVYKBq91X/5S015uk/HUj
-3bvSXybrvXXBdVKrFrSCFCsR96DrYWXWD9Caqmaa+9G112qJZeZVicFoVUFLcvgU5+eMRwbc2ExiPkeb
-5RS0FHLkA49mlSJPaVcspGxbRUNqMRPuS4cPbKdWGfqVsk4RD37kMHO705wOPJhqzk2ozlhnqvCNR7XP
-wRaz9M4H8ZTqEAnCx8TG6DzVSQWEI+75SvwX/sbFXeqPSAAqot9riGB/bniH1a2kPKeot4XFD3oherL6
-+bvFKSgPQ2Gw5kb6l/IvZXKYZcnlfD4t+w8enA964+zBDCbzPte/jwcHOuEXGMpMKJ6Qmamlz4lgE3Vr
-ZmDNi0JwR8reBCF9cDXYnZPJIcsRlTu3EwaSj+iOJNdll+QhaEkFyQYStQrEYPGli6LTRIkwIxSk8GTC
-JsimAKeYc/hbJsoCWpKRYOAYPMRhImHx6GmxUdN+bU+VmnMImlhREkEh4O8Q3gmV4uIHYaDmJZmfoJzl
-mzGzExSIh0HavQCOB6aUhS5QCpi4oYWMXp5hMSaLYynvIyLGRWd6a+rQ+0yxKQhtqW2IVEWrbROoYh2c
-hlqR3RsH9qXjXLW6zNORUVHrKbiH8J5SvBT9Lbp/W+p1HrKuXd8IrPpDaGIYufnMmRds4g8t2VajJJuD
-GpqULufZ1D25ugRFdvteGx6rBnqT7P283en0hsUkQK1etkrQUo8isqpw11X6cMWjROIIRf/FpOOWi6Uo
-3KgoPxEPaPmEfu6UxJ5muMfeFfvn8sSfuTAISNrxwoMBVVzaC0QbiPkJcUGrtw4MQpEmMIarZkZuqhtI
-sd8dswdmEktttf9w2WfPmi9isrqH7VBzvmKCBtDUysFT9mIxW1NWeJcJBkGQ5x/d+9BP1tOK1zVQxgYp
-uznJwBcNTs/pejdZZ5M0/omsTB6wHSZ/Z2ba57Voo5AxlG0/f5L7gq5EV0jPtIqWHrQrh62kfWDswxUc
-MxAcGKZXVtpwEyhPVhUVZAEiHUTeXEG8oRE4S44yGRYYnkG+75A6g9AJuCmMFlNC7/QSr2Ro4IZ0MPkQ
-qmEpMoALvAkA7uXWBQeyeiA6n2nK1MfLKSqWMBYdTHhTGEXLSMGjjONvaLDcgA7I8EzDvgc/6hvE6uAu
-jGfp4uISAi3xEHWWdNvaIDNDyiHGzYG6kA3QnN8shrwH0VmidQbZfJ5ZknbJOFaWzuwwNcy5cnrF9Q8d
-X4USWxpjzkhxfQgyxQU//Ut5StOCS/8IhflFOSc3R6nfNXcNuj2g0KnDThPGLu9ZOu+1/JQfestDbnEy
-tCM8oley3o51LBvDg3OXb7WPQE5007Ty3HQU0yPGsx2r1eZNKg+6SbCf7e61oodE3mTvNV+WPlziAg0c
-Q2+VpwNarcgNAt/1RB7PJxGDSSfIjfMCLKusayEKPaeSFDc2yAyp084GYdrs2ZJMs2bxR8WcubNuEO3Q
-NBD7uDqMY2+o3jWa/mHovLvkNBnqvJidO9xn6CqTtGBs7Pt1hCiNcJtpdRNvqF07qkrsc3AQiiUyG6IT
-q8TJWJOVVoimA2AQFDYornoUlV0avgCPToWsTsFUd8ry5mkYwSpnlD0SQOtFD0lzRi5D1AFFGhoOZTpZ
-qvuqqqqeFDPvy9qO8rmOU06xI7N29xJtDz/zFVGVJABmveakeJoHKqdHEf1XFoPRziqI2aEprVdnreq7
-qCBlYCYgLDvV5v77G5hn9QfJMzs40U3EKCM8nV03DWrcsJFjV6uineKR7Zsrd0xR8lyZo4OiyQ+RL0se
-/NjS5kT8yErj9tOlaa8YEqi+PYm6ZHCdtAI1WKvX+nhaC42qQI7Pvb+UPp6C5d9/KRWmgoZRkIOudWs8
-hYTNlbYFOiQ9P3CJfKegLjtJIDAIXIIMvOU8IR0sqFrDrj/zVaUJgNWNIFkYO4CBdqQYXZ/no5Hb9Xmp
-sLVgbVOc+dTTiT1XhJoMsMBAqITqIKgRnFgDH9/IVP/y/oauvpmASyF6z5q/BoFtmd4MPFMxgnClE6cq
-RxmbtJUl8z0nLSLHNdNIkSCO0/GSge8Zmx0wuzlqVdF1Cc5JnpUOyr+Gdc7zs4U5oVSX6QS9oVxInusZ
-I/YkCJmivVtzFy1LgsGA9UoIBUh0DO1pN++Jul1Zf+UvbsnNccA7k+Fr4urPaFaQr7Q9Vo42J9/qqBZv
-L7KNSQd9Gnr/nqrA4BmStDRyWh94dPooKd/mUwveQeOWuF/NGoUh6Wh0fSyQ8sV7bc0iQdkpvWy6b1Xu
-Wqvcs3AqvRHU+9p7ednumV3o1XMiaDd0qvekvRXPbCWEgad9Mb3GYALxk2cWJ7FbGO11VXgUCOFUQL7p
-RcoQA8h84mOWKOpmt/+mHS5aDAkmk21+XoA/kt0fpAyg/YR3Ft5KoBfD40YC1+6wgbQihe7ylW2jRZ8k
-SPMl10+0cLObBJ3tPUmA4O6uvgiAcXeoNgrUJAQvbyNYrKfl42JiVhPsuiQVwnjYnxz1ImeSY4pbIA4F
-l31kXk6VsKY8l9lRFG233HCrVLPOyKfD7KxYTEfZ0I5rTUEf4mjSmZHLuFOCahmYkYPO2xp95BMf4/i2
-o16IIN099goayo9FHqyxTVC58PkdVL2WvLcTJdMFryqqmEDdw2Q+UTDedS3Ie48peQmAK16iWAZLdPTp
-y/J10BFer3TArX8B5vHfFdGqubMee/njy50p7MjMQua3wpF50EBwBECt/Iytn3RcEGE4JUTLbXojyL0q
-M2fumWqHkGFyqiN8TyWMocfZkfWF78YDOaz4S28TjFnDwdpveon4Yt7Z1w/hEVjb4McDMiIHxQ3kDHeE
-qjkw2Q4VK4FS2ftpxnxDhEsr97DODi70ALvlctHOMopzGOVvAaVjwJd9dB2jfdz7IGJB/sheT5YK9Oo6
-3AqMIfajfAE/QbvwgYpOynhgaIPMcqqkDSc7oHtKmHEnQURXR5ZUxLFGrymH7NqpUgr+Vnev5sjqIMUS
-xV11FGaOBemVuADoJi8vCcN0XJy9paGfLYyIM06U5I47xgEHEfgS6nUxdIUDYDlSblIkgCSVzSyoC+NB
-45zLyJz9yH5cmL6SJfVM4lDoRKe7mLYQbpGFsJKIOjQThrZJFZz2RkWj207qD2ae1OoAb9Tm43j5fixc
-npbVGvCeRCPnLUeIhdDLAaAoneDHEourftvA8aQyID+G3K7YrcKoQ3V5HMFIyajhIDz1MibQ109881wD
-jhH8q8N5lH93h12Uf5FOcdzehOYgSjJ0Gbi94hJwCDkwDHu98XXh3BSZNtBSzH4tRvbySK7XCmpV1I3N
-i0E8hOe6MkkJSfF9R2/u302lB8HCZKWdKroW+7uq334fKJ2boQMNOym/uV9e5uMH5aURwOdF0QmuBN7e
-DYlNbWKlTelHguprztAKFkJtqH4tw4ZmfgcGp7AIPF6n6zpW50pzwQgSAc7m0gBCy8ZuEUlYHx7ohU/1
-NyFGMAyS6m9+83W3Ln6ov/mtexkPbDJFvq8tgq8xhNEPM+pvbeJTF1DU39r6foWAxm82Pgc0/qEBjePp
-/PqxS6/28QL6xDYAPgTVGuqtDWpbJUhtzbvHPlqT+/8su8hLOGoheKp00VNOJQ1g+qFHRJl4mPYguoMe
-DQ6LU8jZYN6Y7QsRFAkGS14WI6AGI9dfglh3OinKs1k+nZ9SwkSoZ+fS9Hcgg1KhVpFZ35/AMQTUTTBz
-Of72y2ge7L2wN2QVRVgPXxRW5vtY/djIodmTGkzpmoIqp7cdzm4+tJmZfdGDlpz89lTwVkgecOl1hkor
-gD8D9T76KQq+JIrg5Alcyk4Q4wJ62qNzBd1ozG2OGptkzr8U0W8JlPGi4DwSRsxYjNFF6QpfiLfFOEsn
-JXadMtSR/eLX5nx+NaFUEqKSIsTLGSeysZ/tItXaOflHiFYHslni1QG040K3C2qV7ZzIBnuiWg5nMm72
-om2SzZ77lNoOa+s83YQNcYsl1xPwkVf9bxkww6Flo7xo4DcLBQiRJaC8W04RcILfb5JmNsm5Py13dVax
-X8umuaPZNVOuQmgXHIqZckI3VIivNF9zPMTa1sAgQfwoI2WO7wlia5Cnh41ctKnzH1nmgpynTjDUN7x2
-zSx2nOUfcp8GStsUTUTw7Yqb4yf2YlZ+Q0MuL7zh5GopTwO33lpyYLChOq7v5f5wWnizM24z+j5wG3qI
-/iNlASwOSk5Qrf1Bn9ZA60NBKsU0t03fqD/utmNpwJPxJwiP+Oq6PtAW1rpv4C7u3Vv6FTWHIJ/LmoL7
-3q+uPbXNSPqNZzwWDYfarz5qull4Ld/2XhG9PWhxzFwgvo/J8lXZffNzRvBPJaaDohhRj7AIHCV49HFe
-8HUnsa97spytxiSMi5HAciDHhfC7i0U6AzMeA/ZgOIQ9nRy8CMJ+6KQCw2ywuLhgEzLOAmA4QqzpZDgC
-ZkUo5AiNlOZDV94lTRvMiquSwtHxJWNVfcFnuwWFL8H3/pr3cekjEdqRYk2qLlxI3t1gqeSJsApyw8ve
-Z2cLAuSrC3oFpbGOd8W54vLCuGwn+PyB+X8spQR2+KObaMQKQaM0L5wEXrPZ7RJS0iTceFufN96n2nhA
-03vjHFyMn+XvwShfc0XGki9HC0OWvywG/hXWf+cDqZi7C77+v4tske1Pfga9Xxu3Els//cpGHvxfKIlP
-Syn4qFqOubVru90Jzrjw08T0ZpnEoZn+dAxM4pwxBiQhO211s49O/V5P2R99ig/Ya4fdXM2CIXLQYgI3
-aqu1FbcVaOdUXGTBcgwTwXaXL+J71IznKQwHL6mcbQNFXRrV44JyfZanPb++xCnY+mQ6h90+AmloQqHT
-NmUHkCgG8CwZBjazvwtpBQFwxn9eN4gJquhwwAmp67zsCTjTPctbiLseccuKyYi1QU1JN3yG44g/3d+V
-52o8WqtLhGZIJCRHcqFgcvw9RiFGHeKcDbtlKSsNCd6moQv2Z39zA/PQffPVZz77KfnsU9x82SyQcdjj
-4D54LzSzX2kgwn3llego9/D0B494tdn8apESUvsl3FaG4kajKqkXUW3oL7h/YzBjQQmvtmjQwjr43Cv5
-aioJs8Oy/Ob22fUgryTMIHPJEGxMvVM1Xk0GQB3Z8PBsVoxGL8H4TxpOv3ZNuXV12X+SY+iExRIOXYyR
-1wJ7rrj0f0GRvz520e6LZ2rIN8lLahRCztIcc0cVM1EB8zWU/JZzwHPm5ur/sb/WJCttjjV71J+bL6Hu
-VHiq1W3t21h/I5QXxRAARVM8J9OzSwZbn4O7IB2+i8kkgwM+NbvZfBO1ZHgxyPHpiJQ8UJPxRQCDFLxs
-8dQeFqIZHV3bICvyN2UIAusoWgyQSc2gGh2ONgEZ+H1Qml+b93iCV3thuKR+Q77u4jER49owI1csoHyo
-gM8PsNQTc6CTxqA9EVWbxeCiJfP7g1m2qJv4/kkxM7Wp0wDEozLUJ/mspO7N57VtF1q9RTXF+fsVX4oo
-rROixYIb06W5+6Szs0uMNzWnXEKCE9zTIeWOIwo5yx/zm5/NcfH3LINExW3vHK2ezJScTAtB297R+0jK
-aBljW7djS8i4gXMcozKEQc4bhufUml3WFUkESMTNIDZWlz0yNkr/pR2gQEZDbDwd+GZtFNvtmSMMfpoB
-HhUgkDR8AUgbuur8qthhqzE+nq15orovfO2bS2l74BpTITu+XNlMYviJPh/VrepZQRgW8KS8MiReXAn8
-yNMCfZFcJjdLe11KNS5RaK0SkQ6INykrGDukYkimuWobucbGbF6B/xvBjAiiGeIlCLWwOwaQNcEPcUpu
-XxfQtdFwgY4AJAkjUElQiJmPLmIxkw57TTKLAbAJsJ4zcNxH9YGbUcRlHGbEJckU8P/b3Nz4xqZilx25
-HawLUA+Hz0sZIVY9/47wMLe6LUq0qVpX3N0rdOPn4QbCNR9p/hPvxXo3JD8kI8/JoUJW0QYsAESVEuOc
-2S+D9NVidMyqaNY78TeBSPx6KB8u/dfQv9wE2IfU7ssSZYd/gF8OI0C1zwZuO5ahCFIvd7RpZ2EfZ4O2
-XzN2f1YSJ3R3kk3A/WrokgqfhDc28lkzb17vP9998frkl53nu0/3+lGBtGf2hzlf4KD9iTd9oloos/kv
-dfdBv1shoeULaj7Ef+B8gkx3e/J5zhpNT5o64CIIVc5/u0bzstpmoCZraFPa0VqLo1kKHgC+rqKEMAWS
-cIgFDRaDAbCs30O/IPWZzUEGV/SBp6MNFYptQkA4M1e4t+udGtUA1bfBQRA8it79YJeezwF/cCQTEigl
-pYUdC6bDwfJkWXberRxFhS5lhbkJYopgaqzyj+vOrZrE9evwd1hPYQTR6c8wxdkQv1r7gXpCTTBH8sBT
-PVijIb0j3qQDqipes054uNGU5BMRfYRwMA9vL6mMix9HaFF0VfjUy/CkPrSjeFc3Qq5pRbkW0OtZOjWf
-8Jlgf0+CfYxz/CemWCKC7A8iWcY6OHSnrZqjWXY+y8pLL88KC9xmGqontO5V6tL3RzcpHX7dpEUttbq2
-dTVA77Nq1y+i4MSVaThmwtUKHOVnWmAxX9t0Z4LYebxxNOlKozKP70oO0Wu4O9EawEiwrPPPhp4G7uz6
-zGxKghh3tU9ZkcAxQqc9TS6sLOoNSAnPP9vVC1BXf7pNi3vOAH4y2KYJmWWYzqMdaWcVfa6sla/Ltdq+
-vrkJdOuUeX1zT+jWZu7Qqjzz7LuKsVw0df3Nb9xLUsn1N7/d7Iaat/7md+I6q5Vm/a3N77vN2rD+1tbX
-qJD++qN6y35WUFcV1DX6aXGvXvtgB9nV8iwIxElmM/nj/gUkkswBDGO+6Nk7THAE8aejETuSBml8SozY
-LWZy9TeSfKkzD2C0OSQoSdEwpxIcIPg5Ij2kDn2twEz+Sn9gvQQmZojEioKc6mZI0xTgr81WwOixxRmc
-ZIykbr5yalZzCCreOUavotrTUCKFr5lFhwBEdmUViLd/wej6HFV902EIdLYqpjxVFtJNRYAhaLY3DgyO
-xAF28arOvlUPbp3ZWaPUEchJ62SmCagl+sdhjuGLXqFdembLGDrmEMeDIEeKSyXuDgXD1J+Yb0fsTrPO
-EgCNqTWhFKC/l+WCVclCgujayiAKlIyKFDhhLFHPnXbDxXh8XfVhVGLLJnNwtto1Rj3960aOPTVx8uhf
-1ZgmG4Bjbtg3cjzeppuWoYxWtAO8tFOcFH6j33z9ajj8SRW248AUDLm8v5bxULzTa7MrXJoOnfCsC4Qr
-Exr/IinlopMqH8HP60O+PiTYC+jn2NGvBx3W2gny+VDOMN796APLIV+sLwReBcgDAfKZQuoIAsZegtGN
-4r4gZaF28u/Z7IZBa5fZyLRWqtCwSFBY+FlqYT3ixnKKVt8g1qMN3ZLZKL25J0DNP24B8jGYdAAd2luK
-ZwKcdZsl+LBZjE+fKYZTFEBYkHRvYZiQfw154wRZ6blPsz01n7ROWAHkJ0V7M0AbJ+bhNMuUgpnIig4X
-PF4vM3P/LjFLVmFmY7iYWtcWTCko2PjmqH5X5OaQnabjMT0pkvHi7NKBV8tgHoPOmk+AVhD6VgtVWqn8
-5TYW/jJp9QH3SYAKPCTSDrzutvyot5nEJ7xGxIwX5Ip/754/y8dhh0zqy0p5Cy+4Q2F/vLxar6pWj+2j
-r83d3B7oNmGhYivIYAWUDVwEr4sFHGzwHwrxdZaJwuEZEXHDspDQ0I9nnrxT2K9CjOAR31OwtqBkv8fP
-zZ8+GjT3/bGYRSUot9UgVBFEhGDTpwDvdAE8g84hL/ZYkoLWswJedCrob+zlMMR/6CxAMiC049NMCKKB
-CPgBoxQLusvwJ/P1QbNTI2+E8NFE/MQK5XlcbsCSXU/M8uKbG+PHWeoKVl2HPDd26k4Ev8+rWT6vyi2r
-jgafRwcT8lF/Q9O/ZrlMRg7imGv3JvxcVqPsvTcXUMx4SvWqC42Pg+NtLUoIKEIE8ETW4dnjghakyN3N
-9OnOcAdrnGDW3MjsTCgQf8mSyq58/fpD8458cAVCRg2cPbG0dOAv2YdhFFRFBPeJPmxBa2eCSDnkDu/N
-OWOiDDLe7ahhCLEKmriLPVzYeM66O05BAVy30holv9DABlFQA/Er545iVBsU8aa6gTh1k0KejwWsz6Jp
-ODIEWcq8KYuukJXIWXYie8k+HMXUllf7PiDkcKkuIfIwQFl18k0rFxPMhgH3WGqMEQGzeTYbAzDjFVR+
-m08wbpBXkces6N6Bx/8hOwAcMDAxjOSMcRu5624ewRR6+2eF7bPK6mtqAZhjcB25yuHiTeiMGdhxLPoT
-JbPhBGMWHoKewimqm6KPA2CyFFI0QwztnAhextGFSFlSR9C6Z+lsdN1LdrNzSWTObcFUKJGb17BXy/9j
-kP/LQf/9XP3wr0lk8SXuEGBFQtVHRfG2RBAoT6cm1G6BmVgNialU/GZwUuTre95bbTIPsyM4YtY8ozY5
-Q+32b1DJP7HlPiwobRlqxDefHbM/kWO2DVys9bzeffHM4s94+mz1wnpd3ypIxiVYFlVzrbN2bTG/narD
-tnvulawEgFY0+l4Br+7PFNamww0iDURKfXhyaFvbzH1TVf3aT4qdzc4j5eGxVw59cw3lv48Utu/u6Jnu
-0x2rARQSRu1a93IdZYuL2lcL77/WU9CvTlqlsJByX//wS4WQHRFCCSp4JN8PY2uqZZlQ+rWE5tcJKKQf
-B/z0qsBK9x0t+C/tyvYDKvCL8YL2fVuyLdJwfthVt+ZczUT6D9F+GonW6Tbtqv7mlgNPEnvu1lfdRrZh
-SrhWve3S3/zK1Q3YQH/zm40VwZdgR5knrhO3bfqb330TtR7j2fft57Pvk51908X8MBstOQCFRGzJOMO1
-rxWOEUYXlM8x65Wuo98IFz0vzhaRovaxir7ZOUP/kZg5OnzrB6Pm5f5k1zSI4qyLUXEZye2o2kMu1pM/
-BAsh4VAPhQoRmU1mDmZVzNOktFPHfCEBLyaEqbU1er+SGRsCW9BnPCfD+ABwgEiJhFI/WlLxAnrJZnEA
-TgaCGSwuDCm8N4dQm7N9Y5JCgvgxzc4LCVmdQVSLG5U1k3dwu+xHx4yd07UfSUUdZfrDJcrWDM4+e5xO
-00E+ykEdHaDtBb6w8Ajw/0Wv0cZCPZhySuABSub95y9fHbWgGL50GUEgqVZLgaJH6h7t/dfRzsHeTkvS
-afcwGZdZ3iHPMbU0W2StioeYIS77TfsuO2XEBGkJOsPbEzlle4TZ9pH3rPXDVerrH87qxv0fwBL214Kb
-m78WvbpFaKuWO5wEpb4V/dl+Td0/aGvoZhXxAv3PGXrvZ2p/7FNeSkdmKt8nZUssMN8WelqNinLO4Anc
-EqT8sn4ZZkTnixFh1ZqzFNqBizOcq5xaBxUslKye3SaHxZgaBAIh6xi6dCSYEgwd+/JJV7lUwC0bPp4V
-WjAkF7hc/T5LE9NZXsxidOM5Ey5mT1aiF1KJ50VQvLaTnlqwoIVDj5YaG/HJzvl7BsMmbVEwOKVj8Phv
-WDBIgXArem5oK07TpaZpT2fuN9QN3/mT5gwgoYXDnlvVsSn3v2CX6J2GaGCcQ87uEfSfKxnEhJpMgOul
-5lTo8mGD2ZHRgclnbCrE//5/YtE+xFa9TRbTaA+kQoRyUkl4VWn6M5VtpUP43U2yyVA93JsMFWaI92F2
-a2DrehvY2gqQuOX304KN6FVEpZERbDJIK0MCMrllyXzYKeqFXJRvX/yUPgtr9YKPs4XwI4Mi5lNl9cVF
-1Gb+tHKE69McXNRA7FjzPmp/73suys8wupR3a7VlVsQjZbYtRRI6+Iy0jmj/HGToWuJGBDY9aQ6md38P
-NZWc0c+1M0Ax04ivNt+A2rA4rh5Fj1qmhZ8WrFbd/NsVuE9NAZvG2W+3zi5TUPFls1Y3uU8zgiYyDifr
-+Ia1odeEWZ3lDTgtYWAloJVUa/KYtlWSyb6CjOXmWNnfaySzipgMx+qL83PwVeR94Lld815zTRh5RTbe
-Bu+1jehRW3p77bCZiciQKTs1bBc4CIm1lNQgVHZEaJkHfqFpfQnv0NtP6hf02YkYGaEG3lvgwGFbSMkz
-IClBzGx9YdlJ2cBOugk37/EVmDizCvyqh7/dcWhmU700v9wJx04OWAJ2qU0goHYqVVdt3tyKe8UYjt9e
-pcwe9vgsnV/2jKTTNgNg7u9T9UfkRprzUHliNkdmbQOGQ1sP3HXTaZm1QZYO3tXsbPziSNHKDoYJvx8t
-Tt/V9r9cvqG6B8tgDzriWc1H35cqrFaneifub36NHvPezbe/tbHV9e64/a3NrW7sGmtekIv8dx/VRf6z
-zuTWOhMvOOIuUC63UzDfDjr6cO/lzsHO0YsDcLmDpIjew5One8//dvSLeWcfSTy2wh59n48XY/CHNBIU
-TL9gbthoeDBgQsi6LPS0KMscRXGKnjeXJ3Mc7jo1wbOd/zo5OtjbO9nde4ndb25suB4dVh8EAu/vAgTI
-ef4edB+LklMmWmDgABylDDFRJovxIJvdmBmDud0x5JiDmRni4S4YecdJsRQVSE0i3dGgdficxjfxgTsO
-sXobO/LUSG4NvqRh9OYFF/7qW09x9JiIBjO0WPZmiXIxnY5AB2RmBN0Hygy+EYLxOfOg4YJ1WV7zYcJ+
-sjypvfpJIjIU7HA7DAhRQPjhFXBM3dgrA80mQ2nbjiKW8Twvf4Ztms6u2zkeZuG05sMe9LMzlymHY8rN
-tRGP6HNQ4Bxauo7Ntje9nOoHR46ZEirzttIEdwHdZpCtMFvLO6ydIjTHV6Ffc5ZNUKlFt1k3WxvhTMGB
-7+ZSZsqcp5sdvMDbkmt4ra2bwHOA+RDinDikCPBBMcv+v4uUM2KC6Gn4xbBhNqXy/m7srWHB5vwYpogb
-2zi9p66h08i4TnVLp01bPS93bEsvztuu2a43Gm8VaOL16x5SpFe/Y3Oc0jI5qtcVu2pG1A3FWw2rHWCk
-H0Ll80jbp8/TfFibFTofcv3U8dZelFu6bqhfxJMC3xiwFDFtN9AwxIdivSgV/wSUWS4Gppm2ueQAcaYl
-nYVmFi1hdsA7DDzKI7MxMfKoHCScAxWEBoq8sv6Edoo8iiFyhebce0D9ySeoBQOaAbWlKXONybCQyLvM
-2/SVZTmdy3QrAkWYF6ZS3WXNlnBF1Jqo56Lviazhc5gjNz9uasLZiA+lZl2h1V1FxOGucQ19GCa8Y4N6
-WwFXsy/83hg7PjbEv5Td5C9lB6w1Z1mOOQkm2F+FLbNvuPom2e6up7UQP/7WQyWc+bvPTBPXWm1Ger1e
-R4xXYGAap+ijZScFzUlTXHPKTW+x9BnhyMEz9ZI9zCMI2+gv5Skbs0KebN7cdXJX/FqaVMww7fYfsOEI
-UbpQDluUnMJQ93MISYZtzlv7GRJZk4/H2TCH8EwrAvUAAAPkZ0hvMc9HIDxfildoOinA+9AXmCDpBvog
-sogXZwmeVqNyWBjJMxT6JeYyhz/QezC3OgYEY/K6iCWhggn0zytX3vBqpXIfmA94qy/Qdlb9ThSnjx9t
-kywFwwqcSeNC0Q0zvKsC0t4Is31VUiZ1w4fN+9IIr4BesaQZ9kbFnIa2BLTGlxDmq9wV66ZkmUfXUOws
-A3zxNFmHxAfZbB0E+IFZWD4MK1cU4cLmkI3LO6azQNBxjLvuQ7r2UqDOYViyiemmmW8jXttjbNFtqDaO
-rktjcbG/43zyVPDprPYJizLFcA2tgAK6UfV0Xnf+vlZL9hj0AUc+DecZTqhckxEPjxAJk9Mftum49jcX
-TMD63oun61YUuixmcC+xckmAVIakv+0+q4ngeUZyZuD2OX6wvwHqPiGvKORo7kQYJwGcZtA+a9xXOGFE
-vNQhgqBRq257RcfzgUeNHGBB7+psiROWPXLt4RC7BvVNGT4VaN7xT5pq/DPotuHoDQfo5XIJXmouJIzb
-k7JRVhpk8ytI1S9coZ3lyMcXU9iFw+IKUv8foVRYsgXlC0lEK/qmrkqbC0WS7H1eQoxVtCPDy0T0RBAA
-gZ6RnAGnGCl02nXaGkxqMC8whiBgQD8JO6Hzw1BMOndIRvTQttOrqVlMw4pwVsWruUQFZ4NEQFIwXn/y
-rnibkcu9aQ2Mdn5/9oYH6f2RnpLXlxlONkrMGCaNqf/dldTJvdFWnqbNjcBe0W3EuKawHbrKvDRL1S7J
-BgoT0zWfiUkfu27YXds3bedSK/kdJiHO6zb9xz686x6ltqE8joqltOrQSeR7nE5wKoSjUlKlydBe4kFJ
-ivdIJbFhF5W953fcsZi00vYr+MZAfKOJcyr9u3606gNUQmEndpE69fNBHKqbNMyLJw8MCycEQ1F0sfI2
-sjdd8icmYfcnbuXBd2zeMG9Yp1iGrm5Q7hTtvKTKTYG5zPNxJtIjPeZzVS8PRjbbgfyk7+xmtJFLwyPv
-XHW2sOTBF3ww48llttAjei3No6qPPkgZ62bZ3Nnf2vfc1oc5GTJdAZ2gp9g9u6n1a9Uk8nrT6dkAu3Of
-hnvUy5SKZmwovM0QJNzmtrSpDcEv0JSOH/bgQXpuxAzz8F0OmEZG9KL578VP7ruTd0LL9uWXRmL31eoW
-/mMpRe9mcz540ekKEuCDBGxYDuHFqvz43BYJ1pl3QrMtBo1BDOw9hIAuyJ8zAkMuqq5LdZAL/Qvth9Rf
-92Udh4lqjRST9IIPZtiGBG1F/pIwuhmAOZTm69n7S/CCKqA+0BhYukFrTSjoZ3xzQEeH2ZAU4XZnyB03
-A7TxPMP0PQQdiKlTXI0yHy9GcBPk5A32DPb0jQ34+X6qEoQ2BDCfUmK1xWDBRv/wprBTMWuw6ZxdRJwd
-ox6YNGrvCBzjw+bgYbsTA4KMfIIhosF18muRY5hbKoMVbEQAZmyCg2SY9QOuhdrLqcDdOyVmbfqjHVcM
-UJYRBjItk3OzboCuNnTpn0M4x3CWZYIjwItqgry5psFH8ybxd32J7yIzaa/HoubUeWBd3gDxo7BWVbnL
-gjoUXlJrYpGOtmFzWVmFqLVS1C5LPlQDS8Mmw6m0IuXtPqZ2vuvQ9bWbyNC74Q19i0iNvYQVKz+ClUT7
-RORytRtabb+t2jWFH7kzCJXcWPzH5P5mRdlNVi3DEIf6oAjwM6voquqOYqbP4fJjiDLK12Wg8j4bgFyA
-3rciw1BrHJl5xW6yqBE1K3g6LgyP3IOZPsXsEfj7aWa6PtUJfKiR5y+O9swZIwgTLOKjmVAkf9Y716ll
-GEydmgMVGXCH9Qz6z4brMIL1UXY+Xyei0J5oNRQ5gqGSnpx9d031Gs6A3eiy3G9QfNmVppjQrYZrP4Ae
-FZtwGTwW053ZRSKw/KoFb8ZwH5o2uZmy2g7cOm/TEg9MNxYDjUXqwqXH9Va7iCe1KzNGtx78nK6MRkt1
-Wvdar3YKW+04SVA1APISl3S7MSL32Na0clgNkxHXlIfSTV13Mp6m7nQv3qzwdHQZgQ27rXcDPmTZoWR2
-LTd6cti7Ku5PL1OMVye43AeI9S25BwQktrolUVJzrV0CvgDIRhPkAgLyMi8WlFSFD5hGPGY/IxiOlsD7
-I+nEluyYKkWnK1LzUvI9uipewoxpyFxB5Jeruj4R5GXjWrda3aTSSmyBo7VdTWjH1o7TYw3PBwkJTIbY
-PyeoqDJ6uJchJ1LK4l7yRJzEs/fpeDoyfQI+B95behu9/5gVV/c3epun9iiAGAiQiLFJM3K84kCnkl8S
-2Yqpe9pVLZySkstr8jNdWnoQtleuQph3J8GAfPbeU6JTIAzIVYhgmhZ4XSm8eLQndby6X8vFP7zDUMMQ
-VTtwggWtJOkHv7GIlcf6yrel0anTu4zdFuyhGui6PNXw95+DXj+xA+ezdBr10ORJPg0LnyZjc0uim1Jq
-/p4iGDpnnp8uBmaKzJ47g4fgBArxaNBOzi0khLdiLcXMEwJNRcI4mhq8uOTWoTXIjV4MET6YkdugsQUE
-vHDftj9CrEmFT0HHZtAuuA2aQ+bFA+GmGRbYZm07yCQoLufURHuH3/aTiuLCzBAktcquEvNXO5YakEr4
-ag38yp2X+wr3iA4VsHhDZvZTDIe9ylpDzrXK2ZlLRAEG1Oir9FriFAwZlRxBkJUZ2TLQAousZ3+PkjzP
-5j1Q/xPOqapkfqhkC1wUAJ4pcMZwXSCy3Iv5A+d0xbwVsq35s0egx/s8wzIPZhJsDIEXWhpviO9/9e25
-NoyMc8c2UMKNjKoMR8XoxCt9pcU7vVmF2RryUBmgH96VH35mhauzwqf5efYYkic0MULS71KtsVK7wgy/
-5ZQQ6KKtPAcTDP4hsymddPxJdKNGZ26Ifp/xg8WEH3kRvQwWJ6DtE8yHQjyVj9MyaRcWbL7s0DgIhQtU
-D2YOjGCSQcIBAVxH375sfggc+gE2SPgTMk82KfszdjgX8uwnu5iehsQ8UWVi7Bxdxly35A6Ps0q64xxS
-vwM3XmBfADpKYcaOW3NVZEB2fq4zw6p+Ka7MxW7WRfQ3NUAGzEeCYhyAibkA5hk0B/xuLNDH1FEOkdR0
-NFU+9tVk3PS5C/taPjYYO87qHHLWyNDhuAGKRfs2Bg2AVh2JyM6D+ra5xddH4tcLh3HZAqkGfqaQMJ6n
-MAe9LXmhkW+ZIyQiXekcUMdwfFcpyeyyKASzS22eowcNhImnZcbnoD3F7F7hiMUGQqH8ZUnz9GK0fD1X
-tN05nvjtxmcZ8RMxRnCSWUzJ3X0xrg3kSSEd0VdbfkwOP/TRnvwGBfLpl73Hfz989exk5+jo4OT5zjNz
-F25B3ob7eKLeP+PirSC+M7jsjrHthLpgiafGXOGVIWlOOoEEVrPcCFsZp7Liiz3IOWYZZOhHBbWhpALq
-38MycN/JsyGFPMgLetbjTBpi3mz9aK2FLbBExiawV506wP/eXofytnfzZP1HwhSN3YNr5pGijvwikFOZ
-tMw3NvcVkjBZKfiRN+mlik64CpxNLjPpjA0aQJrOYJRODjIzhJqJ7iZBii2MXwXfIfMVisJELQ6RhLK0
-MserTambuSTaQfjor38F61uZGVGwHb7sJpsbCsBiHO6H5XTiCm9X+16G2+h/qw3TlL3a33z4PbLYzc/B
-lX8o34Vjspbd3gaI8SMCFT6mY/zFVSXxfuW1D7G4SraksDT6LKaUcLemmi3y0QAdPyx5v3+/r6tp3vop
-/0MOUMn97xW4G4gjhJ2dGVKMzaZ7GYFx/L+YdK4OyhHf3hH8Ed2zGfXAK61eSNO3w0/Thm8mlf3JY5VD
-3kdJaygrLZKEP8/Ff6GGvGqLuXaWRy8T+IsZhen/l6NnT/2i+o0tjSojmt+m4dWXk5aWZisLI6tje6Jn
-C3AFJ5pse5Ca+7t4xO7//OpoD9/TIMAygblFJOsL4sk+3XtmGOoJ+oMd/fdLaGvzEXPDx97jh3RlB/Wb
-00zOnCcECDahU4Vc4XriiyQPyp+vlROFjGhZ86dnQkGnBKpl27Uv4g3fEnUcxnFysrv3j5MTjLT3tbHB
-oPDIY2GIBsROho70o4O6QSXoK85OR5Bj4MU3nF/eJz/jMgNHC7pSIzhYPhla2jpAHxWEgQsfg3QHwDGY
-DcX0dvzGaVue5ACgQtdrFZVNHdowa9QsmJtqi7QG7D+hnMXRP/Uih8xoYRCK3AkkBrzSlwvmvkLndBey
-XJpj3FzXLwJnaBzcbn5+bkob2iEXMKqx2eWqW2EIiY4f4bI2goSr6BiSSNQGN1SJ2JDW/JgKaTMSVSGe
-OdGAJX9s7Aiqh5f8BL48fRmNhoWM3B7sRiAvQhYRUOsAPpT8Ouo1FfOZ2knWLbmvoyE4j7hcUWQqiI4k
-ZtZCGrTt8NxyqX1CfhsNB4eqr0IsdAvmjuAkPU7Qr957UWAujRowk2PLT9/ARUoM3t71xhbpoH8TwglB
-SzLPpMen1QYJ1ox2VgBsYGGETVTvU1AYxvWWjoP3QGCHhmDRCC6Q7QPJqR4AHMdksUCxGroz7RQwSLFr
-UHAZhf1JmktT4leIALHRUJTsoWU2H+/hAqwziGiIFQ29mJb1gAzZL8DGX3Ia/U4l5sNR4W/mz9dYCv4S
-OD74G7B84BtuqE1pfFkguAqWJm+70yqVmXV2eKvWTVysT39zBSRizHfSs6djmMXAFPP98c5QdwG2KKly
-nA9t8gdKVoElgCu4IdG/u3tlw797HHPEPUD8eqeakglJv58cGQ5NYU9AL4sJASYQcTmvS6BJiLfwvKjh
-n6VN6MU+9/2pY+PpSLZH+OdNEU9ZI/xZfQVim2sqmB+3sgNUqK60pD/bPydyw4uoEDrnj5C82IJb1RU4
-MazSxehGzNeKKeowEkl2plVolYhQkHiaEDZ3duF+y0nWeeRrNv2GjqTswtZi4AunKbvC4K0cmVTPfTt+
-jeF0uHe8XmMPNdfikB/HAy16G5LOfeuj7jYfcQjZpnXnDg7p6NLtaWZ66Gw9GiHIcDaPQg0YCj2ymQIt
-koubhWCvl3aBkd7sfi9GQ5StGrY8FbmH+C2yK8kMrAgPC72ReFH87jI6m9j9o7U6or2JyFw0MRWXZ5r7
-C0i4DfkhV0aGiVZxjFSvj1sWr3fgqYza0QBKhCijMmWYaaqJXWJuPuEM3twQg+CJD2dNHdsg0eJP6Pjn
-a0LyeOTLTQH3vcVssy/FAcNn8w0lmOwvbpz9aae+ihKZPupsg1u53P/a0mFwtoXqD9j0rmzvBMQeck0X
-+o/oaXp5+dwwJ/ub5jqw61vf8H+D5d+xvH4dT791zqWUl1Y2HkJQSKosnWJUtNKlpyuFNEsciGepyuY3
-FKeRQRY2r+BOvcCdgQM3M0VxjA2ylOKsRtob5mjf9eWmODvNJPjZcfKqqGUtCBKuaj+wOl3RSaqCRwWc
-WWQrWfa7C0CxA56guKw5R4s/ryZ2Bgjs/owcoBgRxMo6gTXCD/Gu7dJKOZ7yoYaM7fXlCXqgMzGzrGjr
-mqPbyw9g33BCgMp90iWHDWUjTm6ugLXShYRwoKgGdGjuLnBtIOs2SDkVCaDhAKLYc9dMQAvThXmoAJgq
-h6xsWgoTzaZZiZv9sHiSwiwSw1EKgMlwl0ph9aH1wBxPRx7yjTBI64usxXRX8E2I16Igc+wz7dTfceJC
-ZbBSCjmUFmclYRlvKMipNy2m6HlvDRq4HIiFDG56izF6IbDQCXdIaciBd5WADNVLfsa4UFy7c0YYKaY9
-zbWZCvzAxgOXCI8/RS40vtCG4HEOouRU3IDDm1ft0gRO7rWLnMSVnRUXZg6+Fm9k/NVIF2uSeJ5SJSwm
-Q1Zzh0N51Dw8mU1pQe8r3OglX8atNoQCo8oM7INw8kw4wJ2cyr0t5euLb1wj1s3tSHvCgHci8pbYtuSo
-Pj/MDbWTlbNiBY0R94Sj71WGbI0bR+DemLJ/+9z90DUcOgJqypXxGREn0Jt8WHAwF0wbW2R9SsPhODnF
-DAvZJBJCZdqIPjgEkosIOyVCckPlcPlwbEqhSGPdDo0+PX9IdUORYXh9ajvNGlvBK1V7J3l5VEyfZu+y
-kcoGrs6YExzC/ji9yOyMiBlfHSDVj9NU/DOE8+A5yaT1b06hH4feBjQrzz4q2TVQmaIOITXJ/FW75UDk
-fwlfOyRAatVGj9OU0Ejjm4fGJO5kv882EuJe8YNmZnlTxPS2zzoVjsvopsr9E09LckHM/0mx3qGO2gix
-GCqNlecluQE63/h3maauUjFx9lIcEyNPdfiTNUeR08XkmlJ4SGYMaAAykGq7lRk3YJTNimsnBIgIzFvP
-2+GkV2/Tc7VEXXliEdcNLfCWMXcmwDOXTiHPEJSFdeAOfhjm74wct71uC63/mFT//XD/fnJY97mJtONc
-yqDF3ldhU9DKAZsHnPXMYiZIWyjn9Hq9yDj8f77RAnt4YMbxI3+a/A0/92kBQIJxo+8qk5f1tpLxMV0p
-s4eDmXbLwh52YCjU0UfDbLC4uEA/1sUMHpfJFxRyFDd89msMot1qEAMKZJeQTYUdW8hsyLpIGn4Mv5og
-N6ihbFKK0y1fZtMp4xmUZ7NiNALX4lyuSWDB0JQrd15q6+wS4fDxBz1pZt1wSujWyDHbTTsx8XjcHJWy
-oXN0KweDhgvnKPjwGZKoRTVcJAV93rPCsIhiplze1Bnpd+I0IPppO+bnd5SiJtmHEgC/4RFYc512QcuB
-yF7YMREnGvJBl/73hycvJMp3LghVI7vtkLmK6SbSoNMrZO+t1SzSGFi28dtXWVz3l62Gn+vX/UlFQspa
-KsQrdJimnHUwHnNjRDlSgvbIpxv2h8rHaReSr8beHMkVX32pPAqOscQO6APylEd9qHroLbozkbz5z8Bp
-PhuCwqxsq4H5uVkU//coV9NrBVQlqTgYmYH+L/zXGlJJndH2ZympDkPsWP6cNHUhO6SuD9tWYP7pqDxM
-t5xvc1qACDEbOjFSuLnZAeBjD7cvuG2j7xKHYpktx/77+K/WS+O41nL9Jtm203Erc3WYeseboghjOcgu
-8hJG70W+CBexOxWi7oCdIPZRKZwczSnUzpiIx7lt1zAYoINGBvMxeUJo7XVygU3Q4Pb+jGcitvO9Uatb
-z4drHD3dnLPDulXFjDZHkh2y6kv1229JTVnPuUpyQ1lbbqv6wYyhd0RR5m5kHNeTauG114roMqMf8zt9
-ijMC1znA9lAUyQ6RVv8BpPrMEmlb61Znnt+UJxfLDNVttrhodaxaRGOdJh/PLVwVjG5OpOgUQ1Ij29Mi
-CKxw/Kor7cc+cxvuwugDIoGxFp1SAgfo6sMHsNuujTxDb1gYz/PsqvG8rhzMy27LcJl0ytV/GNIuFiUE
-BczxBgh54848ZxU3XCPXulTSLatX5ZHyeZ05R7qO5LXliK3RqFQTDUJza24+wJAgoykBNp8Rwc+vRbpG
-IZB0vCUJyqvzInYO9eIptCN4j41Xyfa2ur8T54jNfBsh13HoEuLtIqIhvHyWOVFMwfOBUz6IKnLAzLNH
-Iqmh3xR+vyL/Bfspo6Okakfok7kVJQNGwNlJBt+fzq67NmaOW5W2cm9Etq/dnH1uFbuDqfAtM6Gyarve
-6ViLY11paikfirBqe3xEtSmKymncPF7KomgDKd2MldeTs8tZMQFSH2D+vGs7N0hqBGiSDBdyxENb9kbY
-RWlIxvI6B2ESbrCFmh4zl/iwa501WPslraVnIGjh1ZHYm9AfliNMAnBEuLjuKcYv6h5ujH/K/DQq2LrL
-JnGmb8vBvFo0yAj/cBP+5xM5/aPp4wiZlQmsPcvKmGOnvrOGeaN8hZDVA+zHAfYw47KReIFlozOUuvmH
-2hmEgqBsz19IvmUV31xgEDawJPLOxjuVu1qXjqkQyz6HJHkUtgQNIvTVPI4buOqR7eTkyuHdW+X0DjTZ
-6vzufaRLc/2Z/bgq2avVqC4rQVFAgUDstszy/2Pv3fvbuJEF0f/9Kdre3wzJhKItx3nRdnIdW5nRPfHj
-WvLkZDNes0W2pB6TbA6btKwT6372Rb2AAhrdTT3sJOfIu2cidqMLBaBQqCrUQ6mlodJ4cfFbT/6AL2el
-7KtWWf3rfzGYmgNvlyuKjMO1GihfRyfXcyFOvcpY9Io0lI5XxbwDlsVVlk4obI28iNO5XVJx6vNyiaiT
-R51oCC5H+7QAylc2mILzn8o0dFRF9Dp8ZYk2wVjNzXhqHn5cjKG4LZhD2CXn3+sUyn2RJ+ZcR7niPz2w
-myTmoH+jezwg2cTLLRIMWmcPxqSP6M2ynudooMcQRKz+ntLhdlJgrMICaGi+Csc8LhZ55pL9emMzrzui
-x0XUr02J2XMs8S1+D+s0mnpLhTrpPFi+RzO8ch74XsPBGz7o+b02D9XHPXUVRN+8pE1JzJ60LBWx8fm1
-0yPWvYBU9OOKQIAPmUHZRz28RUMPP+ty58xUvn+yZ5rjZBAWo0cr33EntHVFtNoLBj4Efkd/T0sV0mgl
-h0ovfnBEXsqlDJNTN/zgEpISugnWIfjhQwW3ASzhXn4AMReaSIIIEW6BkXQ+gPv2E8ox3q1+1fNKgVuH
-yNrZqALwIVxcn5N/lI4xeNhxJ9i+SyrItbJw/uxpbVgZ4trnmBBwF/d5FgKEUnLr+TIjzyMKAYBIjmw6
-dQlxZoXNlGs4ouOVEXhmhlfZVrnAyws0FEAelSUGamOw+XLLoshJGDoeDKWr6ccqib1sHPUjTgaVh5qS
-HKyzuq1YtYs8rN1YZv/cDIy2VVWzoh5G9XFGZ5OriRotpp5rxW338oQSDVp0e5Fc0OMGo7SXZT1iI+ar
-VmpTLEecuJPVWrz3GIHoTtCWrHloN6k2/SKSV9P1V1E71LtQsqbwqDO2cOyy4k2/JFaxGsp6NdL9Vcrm
-doiPwOEU7ysjFjc1EX0aZMRYjhHk9kzyhBZPrItA83NqVD0Ysoqy0JrE/8rJK1VrRF6ZIOblhzmxOkd5
-5KXfSHKM2uPNaO8jkF6ltKqtHfExKI/TkqnJ24gAwX2/hQhdLA56A1OEH/hp1ji2WP/pS5Q4oU6s5ri/
-zMmfAk5bmT6krBz1pVt/KW/hEauSsJHnkCtxepQNrFLJEXNB9RHqtFe7URqmMTpxArDh6rCMkDxsBIpk
-q/qKsPVPBfNaBxQMNCUmUHGRQV9wMEUKAF0vBUPddAYjS1Yww/l8cx+SCnHL/ag3zLpaGvtVnM3SWoev
-KFZk+ghummL+I355IW0rrtXO3GGtPvBsjbuH6JiPyYg81ZzIUMra+YMahNK/s1iHTtZ1pSw8hLR8gFJ9
-HbbPiig+5DndZ9JJqFrkphhWisl0PXxq0j6Ed3y22Tnu9zinHt18sMdevIRJyCVbxJZ20uanUTfGkLgr
-FcrTwORq0yfBOGz6QrygIVDVf2oAdhPEdd2mnfBnu6BLunuYDcooP+XC6CFov1OZKU8KAIh6EYTGJ+qW
-hXMIcwrICTak6CMO+MKMC9wWME1AUxv0zneKXfhOsMZOcZ4rQbN26g7u4leC7lZLgftkV4J/RleQmsX7
-c7qDnPt0DDXrDXw63LF6c1y1d4axUXKSNJj15Fx0OnOow9gAt43R4/abnF/3L2qC4z42u26MWAEk3KT2
-YAxCnihbOSpbVMKyvo5GqHtYjaN6/3hxrZvXEZGR7w9zqZMRlM1I/p8yyxixJuvuWe2JKGSi64DZEKDK
-6fgyCCUKoblAbmWD3WjLOYOPCkt1X4pW5ZlNz6wLwvFqtRjevv2vEm5djUYyo7xxhrNvpVvQKzZkE6uD
-ivWfoZhdpPsBEQa+jn6iglh9UnOh/DWLjCe/lJcLaceqOfbi16sxBzq+OeqgPnPZUm2Oo12hPNK8GjGA
-0dEtYlolO0B8CCg+WtsDUFlNEHG02FyNn8rGUr9VrMOg5s3Y1EX5VDUJ1HlYFmtQ/i2H+jmgAAe0KQAu
-lZ14OcGAtw24P/sR6ijiSaU5SlDEQirf/jFx4jMfWM2aJ1gdxi58b6C+8iPVdcIr5BARZxkULhy7G1KV
-SyFL09uEEq5NKknvlC3flymucB4uMgubz4GXo8gjdmRIHr/EMCF87C4TPF5Mn/z1r2peA9X2YZiUxv82
-uFUivd9nesdo4UBPU+zNz98EyXKIdxTTiQ9Juyhh2NN8U/ejPCBKTCBmGAkm2J8YXDIqEwNJ8VHmngw4
-S0XJKtmEEg+XrC1ZSJINE5x8jrPQsmie4omjqXtTj6lgHdXtT3iLe9mru8jFXf1mgtU7gMSJdBDyjoKo
-n8KoNyCRVC7ZXPDeIHmGDsZie+r0a7ib+j5yvRa9/7LXPco8Ej2Ca449OvSqGXZay7dK6VabJ4dNJy2n
-qD5EcYbt9/GirpFjVFLKtB+g50zmUTXnVvJyOrOaTR1VqbxHRhz/GmyOWf9UjkU797Fp/kwl19tx0jDG
-IbVaj+QKQT4Ez9J0KRu9pv9IBbrKbbr2HFPpYCQ9jBNoYSduB3ZFVmh3FB/juhuk7AbL3qLq+QmUdKJI
-myxMZZmjKrp1pXuHKstGXeHcNTnWS5qQspLVEJcLUqkYgpGai1KOOCVo1ZrjDbWPo/QQE0ghIINMCGd1
-CykV+LAj803NOuIhKuYnlbVPFAV+BTlu6M+KWEaPfQeSRvcM+SLiUsSvfPebRCFYRSKi/9ZURGaOWJdd
-zGVKkejvYLkJykjIgdJZJhBGPC3yla2zPE9n6FZNteINdYgbLMLCzMAE6d/rfPx2elpnalZrnOg+kz3K
-LsyqOnCXovBqFi+bC17GSdK7uYzxb41PTZo0fxpreUyFvyra1CN1NSw1tTq5bonJgpuzKCu9CD7Z5Zrc
-d5Rdi1PIPHKZhzbJjfPhg7cqDM9D7tc7r13WGgFRkUu9T2w2YUjm7W1Eh/2D6CdBrlMWh318HJDPP7da
-GKawwGExUvxcdxw6dhGgJzFWPFaWAWEH3LwiLgNNcTQTslCX+w9T3E6L4i0m7y6wyBW0yctjzJck8RIW
-1Op4WayPjlE4K8mHqKTiYOQSe5ydAkhOmVQWfOuZsdEfssEFAOFG4l1e5pyyF/FKj4wEMUiewzl2kkO9
-q5OMbvfm/+euu8rAmQhkZxCYEZzUzppBOMhY6BgPBfDInWZWsMLMcwPtSaVrEOOBFp3ZRK9nwo087yyS
-qi2nDg0NfqVUWT61HcP+SPWRZUxF2dE5qVQVdy8/qa/YSorVuRRXPBCfZl4u9HGTWl2cZ534zwEUKQlB
-AWsEFzfJuwx4YdIuBg9kc4LJuCj5QClZuUJAOqX7wNco4vs3ZDf1nyzW5THNseINvRpvuohCJHqnjM8Q
-M4gmmPR7xXraMuug3feYwoFRRsFrRh9OPv8XZd5L16tiZs6GMXpZH5zi9BxQOChmvOXCfqMHK2C13418
-ONab3qhm6xWpIe9XS2x/UExOvxsxqqge42GbHR7CtRtm7FWQRoNcyjuMsIgQOAomzxerfAaFfgg/0IPy
-+TrDauo4Ez6Qg6UZ9jH5WoLgeUAyKFS4pttaVMExj6LwDr3G51sutVhjvQFj7o22rdvYj0N+C3ySLbk+
-Jrdb6gfknlUGxu2iaw4xiGwJpXfmGUQPsI/McTZdkCTzt8dmO4ynaT7TUIBnkRsqb0hg09nhKuG0JNl8
-YsUm3DFuHmv2yR1l0ZH7C30ayUSp6WqBdHGboGcc6FTVwMFg0E/+UvYgSSXMs5X95cKOHG5sUUJ11Sm5
-SEn8gvv9tc1zaSOhJkk3GxyZPvwN1+trSOtyjUQ/WatskCvyOeDdRbtzjQEeuD/LPl7E0u8j7w4Xgzge
-gID6XT95sPgO82g/SOm/a06yP9/a+8ffnMcN1UZ8UL47+s67XUahfKAf7S8hqwmkprTnHm0KIiImFc9x
-izQjc2pAtk/nmeUnEEyqgoeWxmKBHFT2XCnvkRRskZpq46ZkbH/iTAU1Wfmar6cp6e0f51raRbNEVuXS
-VSqkB+VJbovi+bX4LLVo43VVv62NTQlqI2o7YaxKn6P/jSr14Zy5A0pDJ8Om62AzqKEVHiBaH3wN3hz+
-dHbHey8vNTYvNshW5HTj9VCESxGRxqJlafQKPtW1BjUE+sCA6BjaNuou2D18CzBHTRhxlDTwrut4K7l7
-p68Q+dz87vlxav+cG3rGOAyEq5DZHKIa+OVuxyIb8ma4Ib1LqV+K9T9ByVotTzngXHy6/MtdzGErSbbp
-iAni9WgOVHI0jpJxmwRO0JzCRPnUlXMxAEUH72mxdkbQ1K6ld+sMAyyW4ss9DwFh97jmzksC0/1ycDWi
-jKfmKXjLSSxQFR9y2YLQnnwGzloDMQamc0pMDkoYy/NwJ8MHfRjSuCzKcoslBLAlLd+WIDe4OWORzM40
-mokSs0iV2SbvMVC3UTA0xJEvizl+5EIqx6yVnpIvoHU9DoDBHZNTuiUJhVSLglsvnsTUxhyhAjD85zwo
-ZOI2nNvV8TOngUC9G8ML3HGfaxud78YJCmXAjRk5Wy/hNHFJXDz3b6H+6mVT/XagChNYNNylwYdtkEZC
-zTq0CyvbLmWjDKPlqlYw0VfgMARAB2j5RNIV8prDc5J6UY2jabBKZwUa3Ju64rtAc4aywBeZbp5PCqT5
-NY0LE/pPIRYOLSsVYCxrHpgxHObk1RqOdyCh1n1zRhwBCYNloALp5PhUzwolRHRzA4lCLX/hieLxV5Fa
-MhJV8o9ugM2vCK9AJt2A71+M5x8Es9qB9Zvkk/k/O3AgZJGF+RntNfAeE0XmPt/pgOYAa1VDx8xCYzxz
-4APay/gykqOf9os9OnV7yBoriDXKwpvwJV3AUmcJVPWOKyEirCCtV/k0X+VZKdelN5LALXMY/O5zE37D
-D0p6UGaqBWlD/Id+qAucDGMPsTHXERjKH1iBGRGBWqwDw5LghHhKZ6G6Lurr2/hOH9lwbU6qupxJuI2i
-2l0n8rRzA3MZ1pSHhuZQFbpH0ZdAeX2pBdp5w1uq0+tx3WhdcHj47bf9plLCw20jvUWLBQ+3v3BvWFg2
-D+99Ez50FX/NW9dbUNF3uP2lAxcW7DUvv668hJq85oXrL6i5O9z+ykHE6rrmyVf9SAXd4fbXX/RjRXLN
-i2/6YS1c8xBHIX51sD63hnfv3O0HdXDNM/y6pVzt8O5d/LS+EK1p8XXfKzlrniBor7Ls8O49BNRQMtY0
-+bKvysOa3waOkIj5+fXXWEn87iaVxK+Lhq+uoGj4errK0U6ITUDAJ4EJzfj5GEvE1hYV95d3R8nEkVLf
-kWZ+UWuLCtEOHAqxotDRdrbScLC5Nq9djRAbPwxaYJdMhIgJnKL+ZZU73iFLi8R7mHVZL0tIys4hH+Rc
-ZtQIiMnE5O1rrpyLacSqHygZggWsGRrrDw/zMQhRU7foqLJJvVomg/ydAWITfxPMJ9g7WYh5UFTH2wyE
-M1OZgaPIx/fhVH9TOtpJDaL0jD36RtD1CLUbrDovXh8Q6V2/kKMA8xTs9A8I8HdNA5Cq47ogsB2Bk5D9
-ANBqT2RAqOmJwMR62qFMwWW8J5Vwn27aVKJ9LB9cV42IzMWeawLnNPfzxIffMRZscMKZt+HiGCtufd68
-z21Z44KMJU/QIJ5yDUrG058WG0XFuZLZ2CeIi5DWF5i2cOWz5/s7wwTqs1E6X+DLRhbPkuN8YpRpStlE
-cptaYLpaIs1T+hjav/rqOUkzLlYMFnnYwGkGu4Z1v9x/8/TRy/949YK+IdwR76FefMKCxc9kK9nmLjBf
-KCZf0j0fouSHMBQ6BT/iP25QvuizCEUZrs0JuCBWFv5WgSDpnC7lrpSMhA4s5skenVderesQnfORUnCx
-sQFlFe8yRVcWtz8BaT19/g+jSv7n7t7+7rO/RUhLgWolITfuc9IR2rGDVG7AiuYfm3Z2I8tNJxgY1tsX
-/iW2iy39H3fFX+7gmoMu+5HWGxrXL3bJ950w5Vjxd0ydXPVBo/BP9lU/5Hqywa7edwDUCiuwf9w13t/5
-z/03j58/AwF880VWPzY5HqLL/IIUJgg/mJ/KTNoIYiXwR+adlS2cnK6rY6mnzPeWq5XeBwxK3AyCFLgK
-opjC1BFKNiEy0I2nWbpkhNCYo6s7TtFH+/zj1EBxJNURiteRPtn1G11Z0KMCNBCP0wU6OJjtsmSlAHbc
-DFotpplVBSyaSKBRtcsrlxtRiLAij7ZvvWBVjnOa2Sv5dJqvxHPF3j6g+DejGvmsvFgsCZxF1SZMkwRp
-I/GNtgQwssXJ+jylfZeTSMJSyYHB4eA58JopnpQSjmrHCNS0KmDTuTDUp/n7fD6ksdvRA4y/sSUZ9Ixb
-XCkNDFa36HIGdZHAEd2NcXduEyMIPMi8VB1pXyrDEnBKS0KczykV7L1jUaukQaLwfuuN+4xzCOAMzdKF
-uFEpV2LURM7QLIBeStAXp7bwnZLVt9qDmGdPKqNxz15+W41SULwPOfj7VcRZlpyJY7rwQFmPBKy74tmk
-O25sTfZgJZEEc2r2HlpMtG+us1iWrJe5d3noXwgU0oU3czCTQGprhqhvtKxLLlBMUAcavgvcO7U/sXz2
-K7R7rX0UwQtxPsVEroAEO71TEDVEaI/a0sSIx7jqdSnhsDxhhdTFTj7HAd4P2rup2rDmo/wjv1Dv0bIS
-dBkpmme/99bZW2sLXko+8pLh0ulGap1ZBbMPPGA5+W/XXf9YLzoFj2Mk+hVW85IKWdERZBV6ux/QHyv1
-ZCxmEXWMQUSnuRKd9ixTcXIafxXd2MR497VcEdSMUbKT+wCNPG5eMCHeclks98HFd66qcNJKngbuN5ge
-0u3E+BZ1k44J5UF0j0+do+QoR5GkAcJNdOc9r5P950+eD9GEZiRONScJ7FfSOF3yl2wOPvD28woz8Lqp
-5FTVbzdgC8LG9Fh+OH1mGnqQiFP0EYfAqbn6lxnwHqRNzU5CwV536c+ERxOuA3/tVSwZuF9hNompJgFF
-Q1tbfvTCTfWuOmuqn3B+QtHPjrfqw50EomvzRJ3V7edXtrpEVredbSyyo9Km4/396tnGR/w56wzzVw08
-IHq6hxi1nfCX5g5M5h5Gm+Px350i20hydwZXM5kWCED2zstimrIWb0TP44IdjBbp6tj5V5mFuD2GawKB
-djAtIAv9PBn56zH6Q5CyTVKT8JXUCh1bPMp+c4Wkfe5zCw8DYJctEm9A7DccSbjO+pEpvaT4q1HTDrg3
-PZxtMufISRb42ephK3kR4koAJMpiI8p9mc+NLIrWO6tlEWEAsen+RxgsoSBBPhwN6YB83eAxC+gY98V5
-gDXmVuAFBC0YLc/LglVe4Nku57pGLxT0vbnb4FiXWJqqfBnQm1QcUMtSPfd9F1qLSrDWYVtPGkEvcNva
-RxYpyeUost/03aT13cR6PExP91PDdAaz9L0DoIX1OgjRxjyyXV+6j/BXb4yReDo6yj3q4rClkepuRNWK
-1nMjE1F9DEl76AepXX60m4h7jVKeLZTF/JbLACvleiIjzCG/I1sFBuFyvwn7f0TY+hqdJRjCySOIBh4F
-/yKedYn73MkQZ44HsCpgJS20SqWY5iCBPFXZUtIOD2L7t46bbSSVhxlsbnZDbtnOASK76oJyfbuIarPQ
-TacRvYkdVqxKI84CILlAGSnbUuBxJpq81MnnSpcwtSIYxOXNeZ05KYgmqR5azSfuRupf+PEGJyWnOIhO
-8VPM5peGhsokTNMvV2P+/NRk+iNgjzUwvuHyv7vA3WgcgGNZP1VPUjGASqQ3ZpcHDEeDFvnLnhY6BS4x
-CkZZc8AgqTOV7+VBcIfoUWXkZNj0c82vOfo2X9mcSw6WoWzMKBTGkk8KDtQkqkeuAgWbOAuXjawmOcPB
-s/4XxZIA28PCmxVn+vPOrAexESfe3XRogetXjVrurvp+GxN4vMzYwB3Q6CWokUyJFXKyXiPONvjUurHQ
-tLWRDEGuIRplpbNT57uLVKfOfROZxl6DtW4W39nnnDX8X31H3Th6lQQyHH1lxHybvQGx1I9yD8svAkO/
-gN2x/cq2cay+ZUkNt3JnqwatbVHVka8q1qkox5bErLy9w6wqcMqGY6c7Y7nUglTdyJUCxZjcs0rK5gdS
-hyHmSXoAnuOnl+L96wjvtuZfEGxAZLCZZXD961h9rlwpIKqckn/K9mwAcE793N6lqiWvEyfDksfBJQEK
-lcJUnQdD9I6gordf5V3Jee5JLnxHEtyPhHcj0XuRGuW/9S4Ex6BYboTR1u4jleLYFy1BN/rD7SEWA8+5
-i9CKEBcMPeEnQupVkb56npFO8ZteDC8NMPL9psVUoimsj/mfs/rwCntDDjEWGEER9UYebt/d7jc6W5sW
-Lv4g7kU93P7qi7oQBfTM/+LaM/+Te+arBar1xH+bnT7Nwajt+63bx9pZ/WfKSzN29SicVo7V9CjlKXg/
-mOXgoqAxH3CcCnIDJ48Y5EgTm5fJebBzIGueTvP/yvjqBZGcYL6dI+fMs2MOPHHtMISBjmhFCUpMhh5Z
-tcg4d6A2bxo/vMBOETqJeU7AziHLc+B0j5WXn3uo3cLYf6speiqGlt3pbv2Gd7+4hzvw3iY7sGvdoLp8
-RVGzLe9db8vzbctnhtjeuVoutRuSikT5u5ECswb0SoJPbFSV39YFW0lkC+SbEr+nn5eQvHT5GD3K5DyB
-Rhhim48tfn4LtC5irlWo+mRm922WLbCQ+/gtzN4JgRVXSil7OccxY1qcG5QK72i/qHTx2xmhQNJ8tH+3
-G4Np3MX4ZqDXh+LuSXW5xuNsQVI/AuJIhnGWv+MrXIOL6D1WRjGtieug6yRmS8MqOnMbeDs3Q885D1OK
-GhNFWP8tNn1eISD9QmSAukn3W3OYamxwrMV51aoJ6VX6VgYKbdj5DKNLbqgiPRmmCcNEAm4w+5WFaB1J
-dO02HobhVmBeoSAgVNAsIZXsszugQCHT0rmyUSJYWKYUF9EMG6TMfLyeErGRLWddSsYHxCt3Fb8xUx6t
-gZTgohnwh5LVjz+zM0Cbsxsh8X5S+cabi8y8XELisGPYTWUOt7gnmdRKooyih5xtmALxIa3UWMpDlQwH
-ZGdKWZpNM6jdZEfzyDAA3vhqILxnDQMH3mWHUc8s/A/Y39ZJB3/LViIcAFq64pbmDohnCtWkxvmhmXfa
-Rp5vuY7CPbOO//u8aeF7q8VCJiXKAWBAk9O4OEO6spT7Lgk2YjBW1Sml1cB3AoYcad6i/VgspcpnJgn0
-xQWaa86L0XUl6ZVc0XmZXcZNt6R5lFShUL03fOuV+5HFiNDZr+YZXvRRJn7/A+KkdrvWfF3ZtOZBHUUA
-qfcE+7BGLu/4s4A6+EAQQavCui5DGypAxJora8nBYbApRRDMXf7O6fEeNVw0MUT0ICB1v7OPmjEmCbNV
-F71aYrTxaXPYPBeaiG6ESRSi3VH6NknznJ3EkepqwH3bjRS7dQvuLRicKGRA9NYk0OPjE06GGrP/AEjX
-GXsUptXTp8v+QTX4aNuBksiqOeB9lPLSOxq7QSmtcBPYQk1w1FRQROTq5BoWZxrY0LDpJdBADcEO617o
-b7w5H8YfQ/tgQobhg749g8z0DZsluAZTRvDJOXJG+JLzcHv7q1hCgmoigS+vVFm6tmGcU1nCVB21KtJG
-mo8OwEFwZRh8AwktQSg20hVlAkIZqziZZ0EEymeyUio2JoB1+zZmKkK5mqGhXRSg6Zga9m/6jHItO+Qa
-QQtI6iCGIJ5pYF2cuP6BXt7OwSESo+pvmRe3ZDAvQaCEftJ3aT7FnvCuX6JFsCwqWXOSyZpniUOVUjkc
-ARBJJ09PNeOiFD9sZUZm/Bs1TVjpCD0APhPTN/HPrnuSJA8m+TszWY8Ncb99+BuaTCmJGz45+063Na0f
-r82qzhw2ZtAPb43x4a3kttf6wW0DWj2B44/+Bsmc/lJd1SHNVtzDckDdaPS6MZi6MCcXxtkQMtdzz/8r
-04DpjzN8YteWnTyMWpFBjmny9RiQBW8piw/P8CL9VNojVR5g4vYM79Q/w6uGZTFNDDGkNhWDy6Xnyp50
-SmpzOC1OYqFrbk/bQ49IP4xRq7iX0n/5ZW2VlhE1G+ki0Lj9qmUOqRgLJvhBFNQKEJBAXr9502ZdZSXV
-+gWx7F/wObNaGS3VrEAg/4OnkN9yksVbchZWVhD1tDyaTPzykpiQ8JBi+UNGs0l9SQfJuzmxDCQoLyk3
-J9AnXpyY/q0ehh+JE0yl1IVGACf8jPF1HZtDVAEbF8sJW3sPW6tUwmqag8rVpCzNtO4XMZ29DwD71Pnl
-Ewy78Qw0NXUJvE3jFsGNUwE/h+2WeseAWUrU8XHfkwwQyU/tpx0MksSZ/vCOjkhD+yhBewVtUmQlpn3D
-Hi0RdXmX922qQWefl9otkj0iVnD4n4YTjIjTj7iKcs8MZanzZ4ZOZRawAki13VbFYmuavcu0ssgpCsG4
-c1xMHaXEEsY1L1KPr9uIUdjt20UyceJ9ZDsqh5FwR6KL/ZXuSbDUbbgr2azFy6/cUM67DxkIGpRgP7K7
-X9tO5AtNj+Ahhd0fZjvWIfhR9+SS49mut+W5tiUUz4SsYjRHo7GLJQdlBQvL44HDNaxgcsWdzzn8cUUT
-AUgnr6NvN2tuJEbcAr2F3QfAnkYMwqjcL9YHRrQTXbjbQwntV/M/r6lojN3tkbbu7p/AWSEA+I2u+Nt0
-r4/zdA4VuF3Z/epa2f09lV3I8Ygvz5FET2u38D0JvZyXOHn0/EVSnparbAb1RvOjOXu8UmZQ7RNF7AxA
-0YKTBnDMlVOPzfKVQ3SboiQH2WG6nlKHYnVMzXydluBRaATygS/fY0O+mJNz8weS26l+FGieRg3DwiqC
-HhX7gFBVqMABkXxwrk6oa6z5tszeZXPWB9LxOIcU2mbU0+LoSBIrwxAhYXHpql1Sb0+pk6Gkjtao/d0w
-LchOzmKsTJcz/0HdDzNlP1h0+lziipsySvNTQ1/zIyr8cpJ1DNkb5r9c5hO+iKKyXGQYg1QRQUcOZzBR
-ZBOL85t5wX/3oxpTVGEKBAV6B6JC7Fubxo5PCGhXWnT81LIVramvgPc1AF3Y8pw5wm2cNdz8mQWIQBXI
-N9W7MBTDfH2OWCwaxq/mo9fCdXVqXR0No0asnipMEEq/8gU+tk9Dr+6quPlKLjGhgPsyXSSS6x7SO0vN
-LsZvkPzvbFkgzR3DTSdGwcikx6RQRRtVwpCXh/PqO3epAn8FGrp9GdKPTzhELQS9j28uQS7ohUm9TH40
-oDyfOXott5EPY3EoqigJrrjHNKrhwDd1X2GgWYCHA+rt6popqIvzkgQUCvbAjGd6SnJAki6PMA94GY20
-kuqv7V+dyR88XQPDpRfTFB0bzWAY6eTzpPMGimkQ7vINd8OfOrnGQ8DpVOqGgGZQeZ1WKU3xWut9SRCe
-VshrFi5czSKYEXFTLYSpy+29fGZmy8gC6JUgBRHVKTGxnfYTSZhOr7Cqd23+NLvl6jdc/XaLbzZ9Y+VO
-jKat5q3KWZ3gCdO2sdxZlTK/vvYA/VQCpTn10F8fTAb11ygbe5phcvAfhaS89t4raf+vIp+zp4vfWr3Q
-dzQuYmnFWAOm5tERJ/9FlXKWLY+o5omk8eMibF4Z4c+SETbcYwCjAcSWpfTdCfo6Ze/yYl1OTymyt09r
-R5X8ITymulc171GgN9l/pKLLYsiXXQ9OZQ/iFsEb9T6IPX0arz4Vb5LHVqOQg01EhkEIzNv8eGmvmYdX
-170SFBR3tNfXq2BwT3GdHtYN3VUjNvKxzdFIizaycjLpH1hMigWmTpl0Rw6jUZIemMY9mCBFCTe4oKiR
-7ib6Q0R/RK1xb9p3uPs7pUtKi508GiUncCNC8MDLiDGbQBztj9OcypeTtzI4aAzcErIn2G9nZoDmaAUv
-BEXs+wGJg5v0xOxK8Es+Lk50UR9uybXlR/Ib1rvcLzDhNQdZGJrA8jNg+Z2fclLv9+BeBw7OZJdHBlhS
-PRcXA0zFhPCmh52jR+NpMc9+NmcYdtTtjUDJMiOFArqnTsMLVjbHze6pes8yqOdh9/Ro7IVS4NE9tkHB
-Hifp3/CLxhMAmPER3ijBAUYZwmFmgHZ4/WcKtDQc1pGiYke9hh6RQri3Ltm4qOiu3BXVooBfDuM7REsX
-T7FCpaZ5EciwWqXQgXA7zowOM26zuRDJAzD5NORiohwigcVezLOTF/ad8DV+6XM1wQjKfAijkq9pS1u1
-DejuP0h181vYDBr0MORl/J3iZ77CxslxYgzIkGGVNn9lgK9VdcPKh3/9a+TLdsxCODIl3NLNjUWi57Hi
-eo5e7WvhwcGMGAFsLcFanoSfVRKT+oJC6Hjg0R2xJZU33PObIv6bVnkUq6mx++CIlOIMRz5i4Q0xHTGr
-k0IKynEsCaERWcKIzmttJdPJCwJiBDK42GCYXbwXBzdqEBnG2QQuX3pxGLIGaBm3M0UCSz4P1GL5iOzo
-fJ/MRVmwHJ+ZX5JEQFZEYAhpMlB6tPmNPWpVmgcSbkVX+lfvWnVIyYc99eX9Niu0v0I27iTub+VLicO7
-d7AsjJYGh3e/oDixb65t0b+76nC6yH4qxujnw/a/pjIu0U84rOPGOU04zQBvCAMcJh34D7u6cjDsEO66
-8C95DrLFY/eS4/qpiRVhG8g7xOESyu+318rv70TBlwx9rCXzagggUaZE81mqtA88cmwL76t01xDa9/Wd
-a+r6xNTVwhNdUeywFpRUnNMVpH5cpkc1zeWV176OQYYfR9tZqj6PVccRz3TK8XaQW5yvICgj13RqtNdJ
-ZiQ8zp0L93DvqIgef8CiESfwAoA2CYeRlzhExzm2Jjvv0xlkxF+X6VE29FxOSd5yWHf85emIgyS5pz6C
-qLBp1uycupCPh9oL06j/YC5YMLVxIB0qg+Rwe8uQyHiZYwN0sOUP1eMhoTugj/t2IALfBTVn8/XMAz4G
-EdZQpYYszwSsma7nh91fO8+yk7LT77w4LlZF2XndG+TlSwYc6dMbQp5Oi6NbEiaJ35RU4YV8F2D1nmAj
-PUJ8IFhIU4MKtdT9V91gY67AyWAwqDq0PiJ7Bl5ZT21wkCUqMJqYXQwFflX5Z/BtdRSDIVnDh5QW/wO4
-jn6Afj+Q9P2BcqV8oOX5wNNpcHnd+6BGBf5BBA6oHMD5NDf4DYsQdNWwe9/fUFXOsHzzu2x56m0Tko7Q
-/GnFWpekBlgTyNLpCi/25LYedxsZDJCWeS7IUVjvOgFpdtOPkHuTdpSdGdoeP+Xzt417I7o14jvDdPLq
-5a5HXcfiek7fwc9hxdAJ/6F7CcsBnkm6DCE4TpJZLP4B5jxMkgmar3z6+r5qSvn9pOlNugdUfrj63cOk
-Q9h30LHXwoB/NxUUFc9jxtjzcfMiknZQKghAdXYwpBOrpLvZMrsMJgwsJnCTJoOBqzU4oNDzygPjzY/3
-rqcn4Mz+LX/ZzRfbe4a6qZ3beH5CBDw0Hj17/uyXp89f7UGd/gcP0nkxP50V6/K77+T844gwoBZ03UL1
-nWhqp/Kqy8ea1PINv4BMO6pp9QgUvQA3thj6XizzWQ4RQ7qnDjbpoKEPGEBzY/Yup+YwTc3Nnfs2tCd2
-0vwFtaH2xIWa27O9GtsT7TS3Z3ru4QVrOrdz82h+6s1/Xybv+aFtQj9VM2iUSdhZdYEpykv4ZBguFjSc
-U7Ukf8HdLLjvn/PvsNlc9QFJP2Lv97EEE7V5NTcLEzQqj1PXYA9+qAZo9QhucB4fpzm6AujJYzbLNzPu
-C3gN7brq/E2a2Vw/mbKQJkYb77WOy8XfHz64rXj/huZ3ih2GUb+cC1lJg43q7q/S0mYPhj7U4VbJDe2x
-Pvuve0u+SG4Zrub1/7l5NLrls71bI+vhyoc9pYa51fMqvHdv4Yf+vMDXg1u9G5ojCg/0EHWJlPzrMH4v
-S9tyOqll87w3pbALVEqePBZ6wBov/PfgIJ9PuqgbkisaAgi/UKJE3ceQW5/qnUv4aQADrRwBOUd5Rsan
-EzwLSPr808ET2n5gq0Y8R+A4y7/c2dvzqdwWfq8ifQV0DheStmfIDY0+HE4U7hu57LQP4U7Z7WV2ZHDo
-o2sIymPCqh0sXDfxBkSttDg8xDL+KNiCeTk3JIi1BYwQW4K2Q/nQjIh4jOp0OnfgOqAUw/gl4qmj89Ux
-ND2X9oE3nTfadm731u6cwqg23bYWMXrlEMG33v7t3tKqX81O7tvlpRZ6sYOt3ot4Gckm1wkKNuDmsQ0T
-HptevHc9TE+RHoB29RK/KXHz9uJdVY5fTDDBf3/SjYkXU4iPYUT4X0VBV7bbzrH9Pw65BoRpWzrSPR+t
-plx/rYY67d1ojkUHzH8euKXgOoLm6eef+zOcsalSEYObn36Stx1NqoCJVmRwFiNHOTasuqV+jM0V0wuu
-iMyRgrXhTaJNJJlLQPdXR9VXSaia/GLE2kyNnknyk3HMiPhvJQyVOuqKlrgi+ioK93u9UrblgWYY3rPB
-PC6t/4HoQ5v4vFPWDeqTHrWBTmeJBvnclVHNRudfjFP76MTZtW97eqjEVPrq1/x1THlSjurCcG9cmlDh
-Y/KX5FqBD5P/d+/5M7ZF54en4QTfv9FEnhc6ZbF/946mJZQJzysRQooCAxvaeeMzzYRWK+mpLkiSEVPE
-7yaTXUZZslakP+Ih9xGlMc650CKOcYSRm8X4fm4LLdpAVkOn3jZpbVN5LS6xVarFfCRuHVrXumlFfbky
-nh1jx5Hu6kXosTUvRz4zTFkLymO1bJshWbG2fWTOfnUsukZ+aLWwXRV/9cz8VykW5iWA/p8j5MNoP5mg
-FtrNu6U8+LRS2n/7EzFyBF7OlsZ2ww0PxHBZq9zUtcDYDM1Gb461jET/wtDbs4iVY3zBU9Oemb+XSUOx
-HCVFlCc5JJbuhpfP9rYnLbNELgWH6hHf4+lH4GJ1CCZ+fqocfGVOqaFcYgbNbtredWPeCEM1j+2WP8+/
-nKQjdHDo0ixUbl+qihHeyhv9uM1GU9uzri0s3WiW4blRDbL3kLN8tXsoTyImRkf/NeJg9XDBBr++fd2L
-CmZeOeLamvJuIJxcIFg2C+UM3fhvJzv/XueGCDFrj1HdibRGdM0A/iN4eZZOKVEbaEaYXRfWEj1R6OJi
-4OU8jjBOHFDAYkMqBqw2IRgeCV/Fy1bziUKJui+zo533C/nYjPg51G/KDt7mqzLppqsE8lyvEqjJOU0e
-zSfLwjDXe4M7PduRS8AW3qTgPQqRPKWyoCGl3Okg+RnzNszR6YhqT0iRmrIgP6nbB9P0tgDjyGDnDMQ6
-hzdu7jDgMTKx93ld0b+SsuuVdENE9SuoBp5apdGAshVhHUesrKtvkMrBDSz5DjFTtZd+o3D9Y3dFMRKo
-P2W9M/Zh5IytXe8n9i5dTxrw2I53V7shwTgQROodfUUbn/1mX9zSL6QjrpzD7Xvf9CsOm8PtL+/1mz0x
-h9tffRMPj0Cv3u1rr95P5dW7Xv0EebXnGRVwr3XufQEn6kQyoyuPWfXCc9X9YVmcmHnegYQ1O2b3rbJl
-xFE30spV79gkSttt4uh4WKnChKJTflHuF6aZgfvrayR9DgiKfg/pvVcFcKE+AuLifKqZ8iXTBfpgc2EE
-XOUUjeAyWKzL467duxbMUP3dVye8ATy0PYQn/9D9Sbte5fBbOLzLSDXamJkhhm6tmUHayQxHPlbGhjoK
-GCg0nRbhwR5UJyZsEcxP7LWbJE8RoLkCNlHJUFuzfjQhUkmdvjc7jRPr1wHBHrpsSsCICLWZBoYlwE8j
-vuwXceJsiKEIWrZEqek9bJ593W/ansPtu3eQS9+95tKfiEvHalHeOGfeNNiej9Pp9CAdv0Wa8Bmr90oY
-+adl+hbK7twwgL1smkUCNCIN/AiRgPJjwSFBE/leTa7/lXpxzsOJqX9nDskGKP6jT/nGpUJbn2PYZbBL
-KO2WdDmvoxFx6RUYOLiGRA6D70ku6okZyXp5QIkSOL2dRMurApouecHezk87j/d3nz9783Jnb//5y0fw
-d5i/wIbs2kk+S9yC5HOMSGC4klXapugexlYRkkLaH7sOgJd9QKKLVa9m+PGeWYoF+R8yAYxc/zrJwrQo
-a/Ax6wF5kOwDL+fRegGFlkCtwYR7Ruc6mK6Xtw+L8brscW0sySORz9PJOyiTjcsFyaKgkhamj5f8EseG
-MySUMxSKGs3Seb5YTzmYrotFjVZcCymfnlLKVAorxxJWuOI24QEANFB6bkl3/mGYy5u9Vy9emCXda1pO
-lzocysagYWeCnGNNxc7rtueIC38TOEogGiTFj5BB5dRD25lQ9g53/7BeDshLbtTl07m2pTlIpanzKXW2
-rKBPd0hXSM/OkMuWI4juhPNFXybt0xaZsaQYjyF51gCzjpTZSIAxXZac74KQoHveCl2rSJIAWZnxTSas
-+jFLJG5DvOBDyVAkyr8oKY45JA4yx1RS+2N1W0xKpF9RwcZRMubTppQaC8iu6liW4VWG4A2/evTklzf/
-36udVzu7z/4W0viuJTyaOlvnaFTMzddmIianI8J+Q2plGQ0qTsKIEIInswVZ6g/htIP9bVjz4dRI9OD8
-ns/fFWZ7Q2FOVcLIw8lORv36NqJkjoH88NSITl21cHh8v9p/89PunhE9dl6G89Y+8kWoClWG3ohl5XOt
-e3SrJLbzPhuv4ZwDC56ISONiYfN6j9QxPLK2j4ERLTFX85JD4VKMEiPH5VkGVmWJjus7AyHE983o3gAT
-GUHjk2MQztjjuSymmGAamC7AyyCKDdN/qDRALx8923tEZ+nPLx+9eLHzEoKDfjUji848HHXR0xdeVHg4
-PIwS/o3XKipVGBuEtSVbyNa5jiyWQqQZWWak+1hxVO0yINbx0tCCoUIGUjn9BvT8pXCmQFoR0fXHNUTq
-Db3GycQcmWAVug1ZpKBAhlnUpWEcCdbKwwOQEp2BfXE9z7GkPYrYKMcaajIqBQVCvMtT2l5Yvx0/xUxY
-NCRIjpZnJ6B3JAcge6ckVBtcdiHwD5EA8EeQG4VrLWLQoNkDE66DluaQeCgFlWKankImQ04mDGZjqPzJ
-QZW2+ifmRJrkBniJCM0gmdoBMHvJjhhLAFMjz98OjBmxZtqgsczcLvaawOYCo/Ec5UejRS23MJ25S3du
-eAhkbp9nGUYxGyKgaMakW2acSoxOtT38/KV8OOrRLtJQjZ5RNnxS2baGaXEuee4zNC/nqw4DhEKZXMkY
-VoCvB0qXHxllaerWkMZjlyUdTx+Hk1eba5SwzpSvsOy5X59+NBi5CYYx7Bd7qFs9TZdv1wvInir3ETF2
-bN57uhRmQ0d1iTzf7Xchg6zT3RWAnk099zR/n88rYh6kq/ZICp+mByVe/fBPXcNA5EK8lnhA1urvzhLo
-H8gb/HxIWsHEuwvI7ZFNQJUZiLiy//zJ8yHsHhCBMUsSglJYcOXE7+zhZsZTfR0zRzF2MT4bk+DCNEPA
-DElagcKIJKzUnLzIDgYaw5f+qtZjF6MBhx2mwvfXswVUSBaRkY6U+j1KppCq3NZA7eNGwbSRLHrg7mY5
-FIOnpdwBAbNOsFhlFcRPLJhk56LRjOVTOpdf7camhAXymh3DtY6txFrZAx7ocIo07MiucpfbIG545t4Y
-h9UmX/0Yt1yfdl67rS4Gud5eF2ttbXa+aWb47Zf9qzPk9WtMKsPtL7/q1xpMhttfb/cDw8hw+9sv0Sz4
-xXUeqz+ErbA+/S23O4zYxsxTz5zGN33/II+PMGFQtIk2fP09my6oOhawWtuxKzdUSsIHqb7iF1DuJ6WR
-0s1ZRKUD4Xh5B1fdwMckGVFEpsUDfWuJzEU0Pi1due67KvuWjxjnB0em8oYNCDzUnl/A1M14JV1fqJR6
-9X+ADo3EiFJFXxwTUENkwdveWETT+L0MCiiJwivhJ35rWy3JUO/uE5SjzX+kZBKQNASmx8olxXjThxZB
-70wvR3A8fy+ovJQ67DQFfACRdI+6ClejMLhuVH/JF+PUaRXOTJ9noa+x7EveKm2smonIF4IY+J11GwE6
-D7xz5vSP7q8BCq+P5pOf0+X8x2JJKVQpkaG7LasgHNBw9dYr8YwvVRGo2xvw7WfX7ZJ+pSPf8kYTGJFh
-XtKBXmLWXsO/kXuWsrE5728omOtd0EgK6/nGxOBZyoAF2NJEZYRw2qZV1jpsFiIUtSCRdcyrkCXpRcGr
-UipOX5oXaLKCFJ7vNVFssPtjuzvIC8qkX7c+kFZU1zVW6xOdv77GMr7LbkCdLe07m71zucjalk2lxlUd
-oQ+PBvTXv2o8Bm+oPNnN0PsdnI9yEKl5xSgvUz6bmZ2KqixaCjGLNgLAQnPEC/sOBAfMT7Bk2nFm9C8s
-95OvTpE+ACRqzVgkzwCc5XN0EKI6O6ZPB6pcw83QdA2WZDFDGyllF7KAy0UUahAlJuMmRizYorq/Hh87
-cONjvKTiVNqGmx8dZZjNh/No86lCg+PSaFSUNSN/LAdq5crXg+MZeOoaWSyHmxRkc7bYEiYpzzhYz1aG
-K3MmboKWVwZkWkp1fKkZZ2QJNFukSuDAETs4kyyDmhcz4qx8NFu1yHI34Z2fjr0r6ut5vQPJQzm2x7g0
-E6dXHA5omYi3IFfj3hVhC9UFrCDpqX2hoAcjaGWaqicf68q2DPlCt3Xbt6J4lYdapDrRj2Dox0PMiOew
-NbF/lErT8xXA3IBnb8qMK/yWL4aJCHYPn2VAlenytJXvhnKB4rKVxavrpLvyVOCW9MpOmj5Hpb+4IjLc
-vvdtX2s2Rm+9FykD+PW9a0+WT6adHjarpVIYO9Qy8bmfl5Y02N+8nFV1dWy9UquYAZ+8npfVMtTWJnfY
-bS5piaSscyiZk+On7CgdYy1cJ1RSrc9YLeR4OVjr6W5H5VXLvPSorBl6c+RrK8e2jCCm8D/UsrgcEXxI
-O4drHIIVDcwvcbCGF4HEFSx6BSoLaurOsfZw1NjpA1IfjbZ0y+5hkuXsXC/iDsgcUGz2cIS1JOk4mPSN
-IvRWSo6Ytkacz8DEToXhARY0psJcVCqXSh5jVBNs4BEZCDyfAC6OayZlkh/iB6ueA2ZY8VGxMtuZnQ8q
-EFHeWpeZlsHkfobAsBy3QMJn8yqIIbbSLL4tzECYo4KuYpgG+iWJSEqgcEwDNMnDAgKLzxYrKh88zaGa
-dDH3hMEb9B1dL+yxpAmcDCwjwJVEzPueLj2lXjFWByb5lLG84URDMycHWMgGtEt/6fVZrPBM5wyNoVC1
-mfIkpSo4WILHTBPIyNAzT3U5SPAYo3vpExLT0cQFsh2DAtPPBKVwmL4DI2ufGpSnxfyotJ9gD/QV1/fk
-j5HEV3kFdQA7MX8NVFo0Otqjioun3sjjDx8qH9Cm81sTmwBbmN5QThD8CBs94IMbbPQ6GePQD2ig02W4
-/RWZsL+8lgc+lTwAdjMzxPeBO2uL7Vq+2pX6iZWr0EoBszek/4W9WkcgAPQ40kSJyFEQvv1Ing5iTQ2S
-URC+107lG4Af+8wl2FdlJGvmp2kzODRgS+AGuK6L/cl2Ra0Ld3zDBDZ33CdXk4tfJNu/c/xdzO3aa+F9
-Tc4RGFtXrmeRj/0G3rf19wjJQzzGfEj1zf0M/3RFGsnvTy8Ehfqz1PuytpmDw9Xmw++kCL26F4tbYjMx
-vajbErksAQr6+/7Tn9ii7t9nOT8VaNz1ztjNjFLJ9w7/7o3QOBWGJUsHZB7oBN33hskvRkhCVyjMDZpS
-GgQfZAdNCUPV7SY99sjeY4ufyVU5GClOlfk3tyawgGgrjPmJ+BavPPf/FkpTTjnib6yvPbTRic0i3UjN
-ZfSHFjoSRNFcXUNrMg/9xCpxAkeuquwNUAVweGeVTwKTmtocYdbJyBZGjZb/3i/oXZewEHOZQvMswQub
-6ak+Lhvm1zp8VGxHZ1e3i1zBYjIxm864FsfuE9S3xjw+ANYFe/IRXMem6PNM3NpQ+FFW9ur2o/Mb+x13
-pULif9jedCmif6+tKREIV7AZP9p2qsiFv91IgjNlGPzuey0cgQ2jT1H6vIgt148wD+WP4faXzsMpkC+M
-LuecmxokhuH211/3A8FgePcOdlp/5A/v3v267x3u5sk3MdPyhWt/37sWms+nStYv8qa65XnCHc8bShl1
-pPtdgxMvWglLxQKNvKGOamKDoh63AEnF/qjIRbY6/vkigTgcxpu4Tx2LU+38f1R4TWvAjI7CaGEYYUBg
-9GCrBm3UQ+3GIGwaztEUjxB7/N8vPOEs2QHiplLuGwQnXIcaXIca/DFCDW60y+cu5sA0rkYdmIf4x432
-wIP6TurDD+q/udIghI3jCBpSQ11tlexr+Xpz+ZpuSpuzRGHDn/LD7PEpFR0NxVv7zpOLOYy1zvdDv45F
-J5zTwP00XTQYt81b7ysadswczm/OL3VvYimGdifpck5561UrfujnvmIfNsKoxsUZS5gFb9Dy5C/MwCZL
-QLZpugo9pcnz4STrLLPAze1ncxbgV5zEb9mHy+jOSvBDNwCSVgSSuBJ4c22maw2FfVUfBxTAJG5/SZf8
-SuloQXfZZcGJHik21QW79uicQljpAVr7CnKrpSbgvXeSng4EEiQOPFhD2avkf21/fe/OwB0WjOCgZb6r
-HjzmcN4NWuGB9GOxZCALz72ojwJDtrSFQC9nMdQ7SBZYsnazufAvJZZ5HZqDFp0o2JeXdTTwowUPXDF8
-sn6GjIgKdQKMLvgJm9UTJWNEEzzqDTjYwxaqZd/hAyCJBXjF2JmywGzVZFg97HMg5e3t1MTtlE2jVUbK
-ymZ4WOETINwGK2MzPd6siyS4gCewBGvD5qaY5pPM7KF36KVsRztInoOKdAIl004yNYdlPhUnUwL2MxK7
-dSsBEJgGFVLIMO3KgY5uQLRLT4Almb0BWDhYK/RFkX16ApLdEmUpaMbpNym2yUx0aRjBBLqaGn4yxkOA
-llyQ25iMmc+5iJabbiJcTjePbJMCc4JKFAVaWFEFpT/RO8n5+HRUHukOHuzrkhySZkbfK9HRB7ucJH+B
-ODGs6y2RJeah9/1Y+w6x/30yL7aKhRCtT7ixZ/wICFqZkGvyNNcx9IcNDP3VfFbD0mPg+VnYw311ARPK
-BVKc2YUSrJmjk8RfQl6k9VSCu9IErAAQpxgmxzFC4sJZAirdhGF2O/Z4ETWLk0qAbnIANzts/KDICwhF
-JKMT0SojSbBw1zFNinLCWp/nuA3i7Vni8wbUA7WWs0aLCTvcydkVBNl973xULPaPie4IXZpJoE/EcyLf
-V7y8g52t8wNFDhdoIut/0cMlkezBFnPf7VTIvBOgxnv2F7fFRiWl1QVPR3NsLKZGLJffbqd1oBmYOTLV
-TH4bohsZyhtnkmWIbFEBTShgeTn/p2F78B4iXQZUuNI/UJrH1+NNWnOcnPfUl6sqw3Vf2KRMwHJP2P/R
-CEF4FFNpFBDCjPA+Ad5Nh4XbrgLHGfIoJAmAMZtHl9R8JYxKfD/70GaWYxeiIjhpzfSTmxdyPJlDHs12
-QnufyQlvpamfgSuUBaYagV6NHAzmAWcPyecRAXIAUpiA8KQthhw9gcV1ERuchzPWibqxqrku3qQaVcYc
-RchclQ1ob0tJYO3O5N78Gr3tUMBCKjBe6xPEOtMCj7LkjGc7nFe4KhOSPWSPYW4g2p5kqCUwsdXaIT/o
-w/w9UJQhkEle4mFgRAuUGQv8bJqtsimUVhBYh+k709hsMNwqaP3Ero2mO8WhQtbu6WnfRsMx2HpcRGKN
-5r0h2tlEekczWMC1ZC83hhZfs9arZa1/uo22MXW5GLKCYp8lgJJlSJbvUajFwHiwsfIWzdHT++28OJnj
-WhCgcbZcQQqrU8mphYGnn31m9LnPPiM9GgL//as1K9+gY0h6as+HMclJeUldgjwMHRLssgDDUZZBeocU
-6i2s+CKJMZF92SlZflHREHhR5MjN1jwnetUo4TygFAfv2co80p77Ljqc7p/ylbZIsxHb4xJCwqBWxpIT
-Xkrkw6k55mBXYjtt4tqPbl/VSmw6svlCcgaTdSAHym5VW5s3qoqqrNVz42GwdRtHDRMSg2PJjwvul5fE
-nDBbgFxflnJqnKSnJYe1QIaxpSY0EHownJabO7q3scyZWUAU1wclfWNo00Zu+6TJR+e8SI7WKVhiMyYA
-73vRQQyQbAKuJFZFNZJWWRAwznMGt+Ve3yj/i/aOAyLxCvepvcrJksLgrlKEXj3xVsL76SwnMeEZGOT0
-jNbS+kt1rDSpJxr8R6Z9fdJ9NOJH6KK7/uoN73VNxgj5VE/ZZTfOXga5CJJyfWD2RLBzKCEfnDVo6HNB
-Xt4YEneXR93xhwvnFDLLlkdkJoREi0d8FnG66vRdmqNEQGkLIKEiQFtQSckcbL5s1wVt+RDTXwxYcN15
-vyhKq7UTOiBpou0KMM35Dln5lQAyn2pTmJ9WfqU9wU/8tAqA0YTrFG2yZ/aYfTXsF93zR94uwkwvu1UA
-PTLaPGwTudTmsfpdvUjob7XXLN79m1PQGsHPm6yr3Upor/601IaCfLLnoTFH2nO4NNIWAminLWz28WkL
-u7ksbV1c5apSVV7uF4ufIHV7Bc0mTQutxVohcil9WHWCBC6euRjMy6t8a5FCcr45pTbhGxCUIRQwM9EJ
-ejagciziBInpkklmkOyKPYfjYW2Q8j+NUK4VP76lEdnC8Cl0DbdGfSPbUx3rtGSEODOXG5QCR7GrpKBw
-zpWo+tc01z1lCXsKDFPSxDmjrY1YPoS+6MzqkzGKLkjgA7mzspYk/shtDiq92JAoiPuTq2ZlYXL/2tML
-SU80fQ/5rrj725mLXZUKoXq/tcgG7v7b888fT82iPJpPXipdXyg88zO+YKfedrsacfyTckKc02fZyWY8
-T89KE9/7BAxPG2P+4ExPoxphfJ5Z6Zr5XTO/kPn9MXkYN+RON7LvzrMTLw/IRkNzH93fGMGmJEnqMvQc
-kTVxd72qi9Vw+wsXO9MYiQPOUsPtL90L599lHrvkS+IqNdz++ttY3IxzaxrevReNo/n2OiL993f+qw+d
-+eiVxS7qIvgiW8byHMNjr52XxLeaFVleftxwnCtzDIRGmI/OmsdLrndJ+KSLcMHqggJ67hP2spi4Cgj4
-6gDKXaHizfYe62Ot3BMh8xElowCYl3dok7vgl3Vp8SBXZ4gZe7ppAEMKhaUEGugRyKC8kFMKGoELAwHp
-3NTEzFUTM3shRHsukuLZzt7+zpM3r148MRt4b+OAoWDxf5L6lMFzqeRJB2J9RR/MLVYPFxYvDtlzcXvk
-mJf4BWHZl0wI6+A0qV7IiC8pGPMdrDKf5dN0eV/CDQyfBPkuoYlyEVYnFPNBIyv72v9hufa93ErMy2VR
-s9VIDpNHnTKGGBy6pbstLxS4H/rJDyS5sjuaKgRmLzwBLL1eigGV5Vg2+avhcifiRhfONqRJH2fdO/2G
-5bcxyVic6geqUsf02Y1fg8YXVeqcSkVpiXEL535TYh0HoR06tK2lypT/aW0lrLpYMn939cMRvK7UF+bp
-wqyfF6nK07g5xcmkOrJmBm07jnCXh+fgmZVQriB+pWbwbcErODVXG4nVXlu3bY4bA4q8udchP+GiOGgb
-znAQtxStEWJ7iy+o89Kkks4UOavmgTTZPoV39pNU+e3vlGUGAe1Tdgpm18RoTzY7QgXeSMC52g3qtDRM
-jZku5L0mGZR9k2VtQxKRrkiNYd6D2oz6uxHJ1obSgAfDv2hI/CMVHXLDcsw128ELzDjwuazQj5nFfnLQ
-T8b9hFMVhyISYBGKBYNNoGn/3EcQN8nZwrF6Bzjm+qkMSjh00S3Yhuo6RSCeqHi8bbSIZblSFpDTYg0O
-59QTpaWq//yuOc3MAk3avpcgy/kawoyhXgT+JmvMuqTk5Kc0SMeDBqVRBru9oOIHjvA5DPCxnY3ueNvM
-2d0ep7ugPB3bgzeubbJl3usH972gDiNABKeoTq9hMxyaQxMvbh3RRpnT/UvIxNhFrRDGcu/O+wVVEsbz
-XyP0z45NPooQtsaOPPjI7/4FCt8WTu6dwcirzTFUV38k/u/mEf0Rx7EiPTcPiW1kNtO+9R/HagmOsKDy
-LmTYn6sUrcd5tkyX4+NT6/DfNYIDQUvJf2RrK4FgZbI/287ZXNPrkWTndAWzS6cTo3/23bMbIlXOlOiX
-L21TzEBqKFUuqnHegEtWJDsg5xj50gzESvabKfJq81PAlp4WcCa3gQyMn9gWJYO5YeHgeCkOVeXK/K+A
-O6DaAxzyn3HAFsvLK/L1MgcdEFRuiKLqPEeZTHmOzEd1ucMlkKJYOIPlWCVoC2br1/y1s52aMdeCPYYD
-ao62XBubNj/V8j+oCgKJ6AAD2SiSwJPjYS7N5LirVw4r4z7EywcBrdLyOPgYeakbnPIvdEEllQkkKaGh
-QSTS2Vkx6lO181Ho0jrLuerJMLHqlYl/eTGuemZaUv0Xkeq/DKm62resiSb/cnQbduwL+5K3X5mkLbRf
-//W6r5835fG27ax+dKZ1G1IgIiqTzm9rU0I3CQZaMMIthVsEqSEgY+ZFyErB6sDLhSQq6qlWmnW9K6st
-d/NBNujf8N2krfLak60rfvlUboMrihTo2E+FZMERdIXlPwygWO9WAR7r7Y0mIxIyYetS+o9ujUL54YNn
-YdJmhza7QpBuzLeQV3WVQHEKSUxk3srRTrm3vBxdFkBjh3WZt7xIgtjgkxqjmxs2+89U7Xn3FYBzGPro
-o3/HVGn4F1eY/q10JGsOiG8Xa3kdcHFc2LueOQ5lhU7ka3wReX5DJSaCxCfeKQduxYa6SSaQS+Q+2tAp
-pJXqz6ZT5cdegBrwmU0yIjtc/NIqEWUFXVZnFj4Vdi8D+dO/8LEo1sn+tNn+YUQhSPQOLgGYZhyMXHjz
-YANtnXANdWweO69rgvCGcOKSGJkTZXrekU2qIJjEOCDa/NccdvMMikbfJ1DvjG5wyM588D8dviM1NDBI
-unKRy/fBhitgSp9Vsdiawp0mMw4CJbPSN9MMWfHZaaZvOUk/UR7J/SRbjQf36To1xyhMzoIuVgB4CBcm
-tjMljxqcwDt3QsWvXaqVQe884rYXjLppRLW/5r3h5jHQNvTZSts2Bvq+1FsCEqYF0uXCeK9hoC8vJnte
-OlAQP4NX5IcGAB/8fVvGiWEKpFxFZFdPEZspkgNWb7DnQkVzzcsf+BFvXOFybTquN4mq9AVzHOcIgdw0
-PCwo0kN943TjWMwopaVZQxkRyghqZp0dWmSJ7QUABjeDBwMkJgCAZuDzws4bBF7Y8DJOe8WHC90o6nKU
-hk0rZd6rQ3hRjbB9BSK3IQPABEOp/6mTNszdWY7zxEkfKBEDejxYEtHDB6qjgfOaRvKKbkAouNKVk8yK
-f9V5k/bq6CSvaV20k6GHCeTpIihmulMGtmht30uHe8WgWvebyJ0V3xg0X1rFfE+i6PfOY8bEszxWsViM
-kjSNPwSLq1PpV668Lj1/FZCbTZ7d0PoiL5yyKro8X5eO7qtADhhgXdRf+5jU3Y6d94YwvHMi8hEnoMIC
-qGtOxLfZFMyTKhQGAOWClrnRfrJzzUYLVjIfkdvwCiz/birIMoSKpHOtNO9c8Z4yGdXuyRHXCl1xOAbE
-U5xaNY7AgXRLQbkZ2AsMyFNXO8Lz1qw4T9b2K1UoxF5tCWYY/O7fCPyehv7PGgl/GHsYK3oRcvQ+HwND
-/N9WZ6ryHI5UV5EMrd7jCn1ThttffdWP+J8Mt7/+oi5l2rm9qr65c13o5uM7UO39429Pnj99wVvycTE/
-zKluh5nZf5XTHKTKfAV+m0MUVGpcrBQM3/FHvbDOP09f7e2/ebW38+bR/v7L3R9e7e+Yb1TDgdv21aYM
-I4Y3M6gXNl5ryOf2eJovXqSr42Gka87a9L7h3Wn9u0nDqwaQkwaQZg2nzW+fL9JxvmoCYYTNH9NZPm1p
-s4d+D7UtGgZw2ADZkO4kNwSLXICuf9uavprnq7K+GRREyJY784bZpiZP89YmRodfruobFYeHRtFveN82
-9+wl/hjk/daBceO2VoXZhk3vmZc9wlD3l2CIqG+8bHjVsODLhiGXC7DuPkXLQUOrVbF4XEyLBgSgSStx
-G8ZTvG0gW3r/JC2P0Ubd1vCnfJ6N4SBubrYhWj/nkyY2Ayrgo/n4uGkSVu3b5l22LFG2qG2QZyc/FA3L
-+X674d3dhnf1r04bQJ42gIzO6Q32rzI8/pFIxJCGLMLSO/AnBGccdyIssgO/tnjXdqrssQM/tg7xVyfk
-jPSyNH93allbp/KsE+VtHe93p8LYOvT3lhF9OxWWJi9nuf+SmZm8LuFnp4EPdSJPOzFW1NE/Ow1sphN5
-2omxhY7+2amwhA78vTWGH50IM6DX/jJWNnqHnmxN5FEnutGl2ZQedKLbXBrFuuRNLk1O4GenusE78GMr
-xV+dYF92+K/OjdpikDEJx2Y81mLV8NtvUV7evpaXP4G8nEH69J13poMX0/URJIuqEYmxDRQogDItQSCB
-/0485wmqWdb0CHwoYt+ot0H64MV6RbhVvP4jDeTbvdO5GfoqHyNw/zP/nRXej7LVIwxvi+Y3Dt/a4IFy
-32wGRCL6XfW9fPk2O30eBEngI3lvtjpYDXb+vTZU4DXTbyz6K45j2z9doEXDX4mB91rq9UETaQ+nT4nT
-KCfR4jg1JP8yO8rRSGdm1zuokuRgfXBg1OwhDaX7WzHfYwBgnDjric3I8KLVehlp+JheSHtsfsaKhNhK
-UNv5VaxXehQwph+m62W/7uVjMk0/zebr2jY/FuN1Wfv2P7LTJ8XJvPb908Jsj/YWrxa17y3lPpaCx0ny
-2rNUpQFRkoNJ5c3uE+/dNC29bePezARnFVHCzPNv2QovaKmEo80Jkhq2l/97DbeteCjOV6m9/VOXNKV0
-5zhpJh5+5D5oc0odAGudl5iW3nQ5XhYl3JdBKpNiKVmnqbYJAFqdFIlhVXOzZyGvh/Rjy1cjh4PPxUWM
-cqjaL2hAZehiac4aW78Ovr5RDXP2r4wME7Bz2oUvXI7vjsUKRZcOMF/E6K9/tTcNFW41MDvMEUC6SA/y
-aQ76PQEPnLNl25UkG0GTgd+rUFkGcpf/foddv87QDErxBoD3ST6fFCcDPTIdfl0qEoo07XoXgs6FAkWD
-ZwaBoaIK97TvtXvOmmnYkp5L20PYqCFI+9BrVQWoHkdnYWLeYwBsGZuEJcYyP0yqraR2HjSomQsKumY6
-GxKsgfewaxklcCtpAn8rriGPD+C0R3doy1Om2eEqfP2TeaZGqm5gXxRTtYXAOA0+lyfmQESXTfAw4IRx
-4XaRyH9KD4nni+cx/L1/sAZ7B/Y7+g0oQaOrQFlnrh30y7C5TlFqQoHOlvqjmvx8W7wu3W37ZJkeYRak
-9AbH78gwu5TGC83ZJY8AEofA+xEdhDbNag/i2LFg5Zd9BkHgDs35y9maCsj5arYy4WKWyixoavBZl0BP
-mLy7g0nKkPKM4Cjx3ifsjXGC+ZMmebkAnAbMRRxvtkHfAfsnTl73Gm6CQkmlG/IRL9+05ECHsUkeUpk0
-cCg18Mlb1xEFeUwS09dHjMcYPLxc9nb/WPrwIbmphZmu97pf6cSOJDzdwob3XbqjMpQEfSpVvljCPK1U
-xPu8H5vq3Sfy2NsOifPH9DsewBWX6Z3Pic79aJt0CXkWHvpdMbxQTB6kY8OO1pDMe/+keAHC2hMmJ3OA
-+JCDapz+y5BDUGox1AE4mwKmihiJ1Cb7BbfgvFjOuOobjYxeom8Gn+voRMn0b8/gvfUCNDe3M9B7YQiv
-tnhrdYExjaoC9KhHrWTb0a8xaf47RnGCGAWtzE3yw0PwzUT/HN77UtkCKqYh1JQkCtaZDgsppASe6YzW
-j7z/w77M5smmkxJLMGRmc0LyQsAXB2u/kwJ96QL4gScs2TaUepBYGkBw2dgr6pmkY3fkOlR/B5WzmIPb
-grxKCkWfgiXlGk5GvtYwCnK/gMCEXSCdKjhEtyDvTVGm85L+k9KL4kgLOgjGCLLm/xvZcuQ/D5GJHEfJ
-M39xcT7kMzmmPjtDDVs2Dwuydksw/cpXQIO/qWn/+/pggCWLeTFKdFumTDf6sfK6iIj+FXUARxh/GuU0
-PVp8w0FOcogI6WroXvjv/pIyBWe8q1Aoxa0L7B2Pp4HV0yCBZ1RDGnITzgxc2ZNdH+demLTEfz0INxBe
-6sMtXEd7oVcOvwDM/bqWqAuFs6hbx7UjeXtm/zow7OXt/cbpAQ3UzU5cWatH0n/fjBYj45b2CcoQeHKj
-3xxuAXL51pKRSEWc+EeEIM4anLCkNINUQOPSZrOjnaR5ejOZWFXYTYbWNMkX7BzzqpT3YXvHrxbxbj3v
-bVUXukUW1bP8+HgJWZdB0NvdodmeF3MwSc8nqWGcMu2e6JS7tMtdibqhiTawVjmWWeKM0mYbmmXsqToy
-cBYcFu+NvpHBq6Sks9KBJ9B9zO+OAQSuY3BXXZcOFp0o6Bb8Njs1muFqeTpATu2+sbFH2NY0m8DkYeIF
-DFJy0Mw7qN/OQQzUtwFgP+EDkA9RXC9DT8fFFH2/qY0HrU/TYDjxKjcHsYVETBgdGmHGKXk1S94ZoWHm
-uJkiA9NKMxWxpae1kSa0zalJuEqdRTw83K05PLCnDrfv3OvHTKbmxbf9GpPocPtLdGoJ7J7D7W/QL6Zi
-2Rze3cZOIqbL4d0vsHQgGSrNL/SA8eyRw7v37qHV/u611f5TWO2phmTKtfvNQN9vUnib5x1uA4XjLwUI
-iF9Gj4DqJT9nRqubQ8XXDAwME5CfMS64dEHNODsAbPcJhSApBThFiTcvczbUybY2rSAlPmfpKE+NzDhD
-2x3AAVGS0hBTSjkSyNcrtgpg6FQKcjkYLyx6f3sF/QObOCywuBElzZdM9ifF8i1lyCtnWPspKccp5hZn
-AftvPz3/4dFPb54+f/Vs/82L57vmf58++k+zOZ+mq+PBojjp3u0nX34h5vbYxLMVnW1C3qv6lAsIfpzl
-0y7+RbNsGn1Wg5HKsRHhI1WkiJXgjvziOpvX77VNUc38mJdrCPXVKp9GL9fc28tdydkj5PE0XxwURvxp
-ul/zG13sas77AlWRps9cg8q35uxuRdhrU4GAgmbT565B5dsnRgZv+tS+r3y5X6zHx02fugaVb1/tNn3I
-bytf/XycZdOmD10DfXlK1HmcLh/Dvg4vT7239rNNU6+13ZVulJrtKm5ID0Dbu/j9KCqLqAs13o5CM3s3
-Sq2dCEnZmXKoNHdhPB7T962IYLtmTJSadgl8NJR2rFzrFtwWp5dCCj7fAJvFaTMa68tcqT+Gr9uRWK8a
-cZgUawP38SXJ5omG0oqTat2Mm+F9l0EKP2/HxjRrRQMd1i6FCULYCBnTcgN8jEZ+aYwQxoY4mbbtWL3P
-L0PRTyyIzXAyTVtR+ilL32WXxIlhbIQUtm3F6vm7S68egdgIJ2jaihI7Vl4KJ4axEVLYtgUruN2+BELw
-+Qa4FItGNNASfgk8yEzejgi2a8QE7fSXwGSXvm/FBNs1YvKWjWIXx0XMau3YcMs2fF4YjfIyy/QfFsQm
-GGHTNpReXYZ8yaS4ETKvmgl4WqSXObx+ws9b8YBmjWhgldxL4LFD37cigu0aMbl9O3lWQMAjllqnQlgY
-h+nuKKE0HShtwDrl4s8VMaB6duywUcxJvYMT8jb/wFNAKg5A+o0RvsbHSvHGm0t7K3GJuXF3LO3zY9s2
-zhFi9bS41Nn51MHYDCto247V80sxwacWxGY4PW9hhZZMLo3TZge6bduO1aX4j9yebYhRCw9apOXqMqT0
-gr5vxQXbNWKC+YwvgclL+r4VE2zXiEk5XhYQ+Hhxp2oG0IoLNWxGZn0wu5TwvscA2pHBho3IrMBy9Rjy
-wV1mevY1lFa0VOt23C6nDu5bEJth1aYQIkqX5NX7DsZmSLXyasTqslrFvgKyGV7tesUJWCkvgdPP9H0r
-OtiuFhPrxi/mRXIP2i/EV8+L8BZ/koT/KV/EA450ANcIMsBUmqBVUNpoi5pu48dEYEswc0WgmefSBM7R
-WJP1ilt4liHVQtmdpCWaa6qwQDlVTXDfRJqY514rtG1EWpnnuh2aGyLtzHPVjC0AlWb4XLWjM7zaDp6r
-ZkzQlWbWVx/bgfoamw5y8DZNWCKuNEFJm9uw9llpcyjhLaYN64WVNqhvchurrwVt3rpAGGrFSlSlFT53
-zV4tIh2izsRtSO2otgFthpsoqddrMtPBN9KOeFu1HTzX7Z6vKwQhUqfX7F2FvqwkqNvJOMN2dpws2VTG
-iRITt2GZo9IGZRluI8JA2IakDGnEh3SlET7nRt65qRqpU1m3lA0ZtnQbUp0t1WZq/jWzr7TTe4M5cGUY
-yNmRsdrktuhdDVeyjVy2J0y2oc2vAOr1QMefQc0KfGqTmVXuc8/jjbsHV8YpJXKcZIfperoK3JD7SfZ+
-nC0gRSQsQ+m592HBPXAdIGgUWZWX5OrGPn4SymAzZ05yzNw+oSxy7EzByURjBSPFuZauASlFnwQrUBCA
-aiweA2fJowVURMExSFJITtpT4/o7KWa7T9AXxPzHVgEsvGTN9Cl52GL+T1kw5VSBk9NnzR7SjSJgL4gH
-5+wfOFsPK5fmgwB0HcD75yz76KWwtNm3NC43a7JsQQssTY/rOiL/7JQ94yTfMLp3QlDeGC0Q4FcjK67K
-I+ZHc8wyH1t7Z+2YpfO1LVGhP88GEKMu3gDkwmJIKxuYfgGZJ0TD3V4fqDpdQBqsZS55KsNMleRB7K3G
-Q/bTdL6/5G9a6fa+9zrs/r6W/25cu7//N3d/l409CQXpNv6uEHl931LkzUnkqIB/QdSUuIf7zjpUFoeD
-ehpd8uOepSSbNTZBSamxBQmKjU1IxGhswsIDt0HbJ8TC0WBL/Rip43i1Wgxv3z45ORmcfDEolke391/e
-Pl7Npl/ezsH/bAB//y+iqq079vNw6iqBWedyFrcCqUYP3Kh/LN67GCbQ2SCAuWQmCtKD9XYz7+DkKfgE
-XWYzI7F4w0Wf+jnUMTO81AdVDhJUOYAfHxcnGZiTD4rVsTkW8jmFODgvcAQGLuT25SB5Ps+SE0xMnGG9
-kGQ/PeBjV5yqu+DbiDVutbs4EW/oYOMHVgKDveMHVlSI2hE2IvgZcGQUPJbF+uiYdnjj/J/be7qJAjxH
-rHNRgg7H2CiepQkN5052vtAFVINDUjz0SBF1daZDKGqDXpWoMtCr0qdDFa1BFQGEDhWc0qcJRQIDQzqr
-giq73P2ohLBx1IY2GDQ2rMSVNDRD5aO92fM2VutUv/Zmm5K0cw48Fy2huaS1BShnGzRatY3I2kpaW5Gp
-pLVZ+yQ6Q0lLs2LDibaulOeaZ60NtzdsnW+nC7c380bfPDbn63muwbHRYKMu2Cv0XPBJUd8IvPMdPWc0
-2OK0eSoft21qssBshKTvuBxF9Ox8emAlD3PYvdX8KqaFYfJqTuqexJph0cdk9BejoHRiiWWs1uV6DbuT
-xMnWydXzh6U21dB4X0Q+h7xOGFGXO4FveXMcOyLnZ9bIZFHqirVXpvAcSYZ9DTAIuFJu9ObFN42RWHVe
-8MPtb+71467f5lVToFbMy928+6Zf78I+3P72Tr/GRd282+7XOJKbd3f7MV9x8+KLfo0vuHl3rx/z9h7e
-3a7JlOzHkbXlTb53HVH2CUJV4nQbBJVhnGk+3oKQzNpYlnMmYeOVoyzoh2apEtdzraY5Fiy30kVe3rbh
-XD72uxYkh2jJ2yfpKg3NiEGAljC/jvcRJngizvm9PR/I4OK1S9yJw/mTvNeOMdJNocxBYOQJbBz0nzVd
-ZCaYX8boitKISEmMPxGbK7d7irk1E/4PZcs6PJXSiHzUULx+SxqgOsOT+cgoA9l8Uia/hawkSA9UQ3bd
-4MgJkO9XjUJBehfH9MPTqxEUWvoDUOn6CCz0mFW+W4Nvv47wetGDqk7g4MMochAgI/zymhF+SkbIhb4g
-i9ofmRU+ef50CwWxrS+2yFh3W+xuYzeEjM14wieDwYWccoIMEswD1/wpSg1wPFTl4oC33IhYrz8Ro9I9
-hbgDXrUEcKOFZQXftTKtr66Z1idkWlaruBS3OmfIa4Rv+Xich3dZFmVBxHgTJScHJ41rHlVd/EuIT25l
-r4Y1KXhxQcoi3Y+seDMvUia/kAkFCi9yoq+vOdEn5EQX5kKqiJPPfXR1JwnupgJQfju/NhSHimezxer0
-R9kyXnvvlbQXa8a+5GaMxLXTuyuV3Cz3i3I+mMmhrXpKDGYYoMohMJwak9EH2sjYi0j2ruFW97GIZolL
-DH6fwMAgNVWGyZ88EEN/BgeQSo68VcpnjA+yLjTkORzJv7Z0D8i1CW4/1UDyWba3SmeLFpWceKNtDXlE
-n4C/ybw4ITcQCiwkx5AX5CYCPrvST17uL9elfaRPjb3AEQJTUDnPJ6yxi+yaEmQalmjWApJMT7Hsm9n8
-i2KO5mcqabyy5XHps0k2zcidRXx4VLbsUnLh2DK1lN4GgMPqLAzhc9jSMpusuQbyIVAkVpUzW/ooXR6k
-R8gvIPUO55ikhF+UZochU/KuUV6+gJormIh7hCdgZkhtls9dTmBmP4S/K5/LHkTAKthhC9DjJGJUgVR5
-jCWvIKM3Jh7E5DyUxGfBfU986Pn8XfE2S0b8emSThoaL08UKvesDrLoJ5W2tHx0iBH5luK+SL9h9IXn0
-YtesIeUHosylWCqVD13Y2XD1umdBSuSY1OzNp6ecl7hQneFSQ2922983u6ZcgcuWcomDSiL5oUMfOG46
-LQs7BOTedXmX/6wCTExCuaB0gtNTcfjxH9wP2/HYHgbgbTs9hoe6T/RXAx6s2S9+Mla3JPYlALQOqeAE
-ByEGwBScFKMq7N+0TyEL/POTuZQ96cqnPeU0VJhlmUsGReeAZLPvGsQsvF8FgPJusg0dTBiIawojlzax
-5HWUK73+W/dF2D2FQfBMhjzZ/3RQeX2T812TjbWx6bDSJOpkCEhhyvfge5kYXN+8fFJFtPbc2+fclt4k
-XQDOj4Lf2Q37vfJ/3DNnyWIjCGa6SejpBpYBszSrgq4P0V3Zd6GMJCmjjVQdg0vnqW8Qw+3k6C/j7nV3
-obtnjT+nT3lZdWV1ks+zS68huo4GnqeRiTnPsANoG7q5RgdOUtMPKEx5y+AN/HxE4w/des3+bI94CJvW
-MtDIp6pRqbOM0qRMi2LRR7VjfkTgzAezhAuZK3mB3ZwwMLt0af0gZWhSQMPUFeJl6QGgUfp88I6bTEAq
-q8LFduCsxEJEHXFrAegCs/SY9Jn8UB24MSGpDkOCYr1z2RH8LIHuLFSGx5VTBGafCD8pQEqDQqZuzHpQ
-DQI7sgt/PCOlXI1gGd9SqseR0ez4xBuBwxqudY7X5GZh8pVgVTok3Bc1+2fzY3XTgzV2MgV+u3ERwrWK
-iw7B+0BkcAUVzkAHDJiuHqavzTm14+/ZdAGithXsDwooPLwArwjS1tB1ECumW9k0FBRdHAYun371vQhs
-zrwLi9RgOAa+KouGD/rhdKOlcL3A+YFZseKSPWXMC9bByfGxi83dKYScjk8qdTRhd0Gr4Fny0PUSea0p
-yTTFt4getdMrwt3/dtanwbiXesCuj2CO6Bv9EPtRu2hgWNQLUtz2C5lK/X51vMyyR8sjrC2DL5ZgOzNw
-aqH467YJuAbrmW8527ygtm8uGd6983W/ahMZ3t3+Bo1u31wb3T6h0c25CF3K/r9xAsmI3StA4ULGLwcj
-tICZ4wZCnsQmdW38jyz9Jaz/3MfVmP4FWNzu7/Dtx9a7mXdpD/3Q9K991pAFfXvNgj4hC6LE8X9khwlY
-2ds/P9mqciBY7y+2t+98CQDwn3WlwGj5zHeicEO9dp9o4U5uqv5MjhMOa8AostwtzhLuizY3iW/vXDOp
-T8ikPHfpTyQqXSxftv7KYB3/wLwI25oR54c5VlRf1XTjNalhp9WJupBA54EJmeXb7HSox0hZDcdsgrMX
-h6vl9D+gpTwoj/PDlfcknfq/Z9kq9R4ss4XRR91v6EVfRBokvEkZxqeTr1h/yo7S8alWraGkDq5c3UWm
-+WokbUaw6XC/ZCXkgjC0nyYS0cqMGu65bPVdak2JH4pDgQfPqFqjijqFPsxDuGWUdhaylzuAivrkyCIW
-UzMIvNfiupqnGZSZgg0KeMyLlcCyN2CcXWB+mszSf7kSvgMqLmm7hJJjMuxBYCUltR6yIUhsbacShF2J
-c830HYW+Ir5jr4ENtLaV4CZ1CwHRh7dfLTZfisXxaYmFh98yucMfbgX23UrNshSzBXANFgJEuUdsdWOo
-41V2HKxpelqs7RJQlWbDHtk6CPe3ZsEnhrWW5XpG533KkcNmPK/26iAJj4QaKeuloaHSfGxWD+JzsMTK
-LF0sAB7Y4gwYmIidNdxapXNCciCgnqwzyuIBpzL1DFVqJbMHVibzL1BXdDbAnX4jYUB1qA5c+EferRdV
-iqFmvMCNdIIT2UQl2ABpBK9t85Qso1mOt+SOiMBa6vY2LSau8dxG8cJ2Bcx52UWg+hj74ZPO47WQW5Uq
-/hxauIdyv+acbhZyg6D9JnW8MUxMCzPm2Vf9WlHFvCWheftaaP6EQrPzqfw0EjN89Y88O4GFfJoBsMAh
-MHhZkbKvSAYOxn0hAdjBCKXfcmzWdv6fSq7FB78oyXeam+/+M3zwy9XKxueQfSmtRMOZ+XN28DYHux7l
-vzC7a+fbzz3JZZgk28nd5At5KCDv4OOuVNns2UtDSWXBDJp+uhv3DkJ10XmVg0x/cCa97u48+DZECzay
-EXqzSRU18//Ch9vJPcC3mGMOj/Wid6PSJ6XfSL43/zfUj+6ZR9vmkRNF6GWp9RXP2r2Bc6T3BZzzEsbY
-ZWkAEl65qvIyneVyLA+/F9GgkCec0k5/2qNxOqdLMyW3XmACMENXy9NbTisC+WaRHmX/2YJ/BxtF4iup
-c3xroyrZK4I2RvJ5yCYGUhgeEyD8ZERQiyjA+WUTVH5pROWXKCq/tKGyXyyuBabYifLnkJYcvv0YR2+W
-k3QmmDYhKTzZzPNN5KHr2qufUh5yiRN+BwviFck2wRhqZRtM0rqVBVKN+7oSqQBvtN8/MZf98DFXy648
-bxVXNhB5NpRornlxQM1/Dl7s8O3H6LCZF+uESpsqrDU8d6Pqutc894p4Li/OJ7havpIgMI3uhfRGBhCy
-13dGPqgTYp3lD1rVWPTglShDVsdayTD9sXnWRYDO7cRJ/69/FXZFqU5Qr6AnrncwfdpYtJSToiTE03wE
-qVkFuUkxTgTsoDiZZ8snxRid3e5bA/vzJ8+HRuk8gkzHxRri3U6TkdcW7bhlYY6TfJaVTtlDt9Kdb5wx
-1nRXmTrzTJziQUAD9QoeGf5sIP+MI4r6cPPnJ7rFDRuttkrz6Ua6HTWFXv9nWV0bjy3eIH/8hDGMaL+y
-pZtPKpuXr95/oMH98tvr3Fmf8nRyWdEudUBdPv1CgMiFjh4Ho+LdlE1XaZs9x+bPotbammL5GbywhhTI
-l8up/4E5jbDcwxNsM8IbN7Iq8l20BMB1iWINIVBakneZzZHcURC87kmPGajXYIYLLFqIXZulyB/kL3WD
-/GWDQf7SNEi4tmsb4y/NY9wEB0IBrKIXQaC5/7op/t+isJEV8Qc6EUpzWq6O4Qy/ha2ewk1RgomRgWth
-oPo8WaYnVO+JQMHfcPLPuXbjvIDDja/TqVIKSh/Tk/S0TD6/vZVs373TTzDD8fQ0gY32Lp1mVPlikb83
-h/iAID1KjopiQkUO3uczmz/f7J83T3Z+2n/05qfdZztJd7sHHXz5F3j3jm04SQkzWCwJ0v9/7w6D7uNk
-Owh7j1/u7DxLund7fHX+zdeDCKSBTNxT9G74n+Rj6NhenTTgeNafKQWKw7of47rNAoLOrLtBFpRvN0oi
-17VT2+V0nNdiwqXFBMxZlOK01soANleqf/K7FKrqvB8pgCOVY/5gCpz9oHjPHkDmGfiEmX1+skwX5K2V
-GeYKKRRwz1NuhnG2NPrF3KFASTBmKTiVoY50kBmOhSUNOPITxs6gTC+UvmECsDDxMOhRULkGaxoBLeWU
-T96cJCfHNHvmA+EagBtErBK83sDshMJwgyWAo4DDVc4DXLlx4xLxPCfZ3OA3hsODHWz0UGiMGE0HJwI4
-HKFHVjCLGN+Vr8psekg1dcr1YmEOB3OoKJoBWOl6VcBJMFaLBhz9tFgnW373tBAw8Rp1CaMEYEGo59pO
-Ea3f1OA9OeX0GubtIPnF9EKVI1wiEiIBykqBTlyZC9VEpzZ/rIDsMgManBmNNl8AjYBW3Cd0FwVshRwg
-4xqU9rhAMiphcVIIED7EON0Vr1xpVg6Xckn0A/Vhsllm0CvR7yvZukFhwTCn5ilTuXMKQ4I4KSw43k0P
-FsvsO+uXX/l3In128/m/0BMOnMu89e7Vfx38+9z+sfEnH+wfNZ98vhX++1D5g/7Vdfqh8uRdzas6HD7E
-Ufn8fBDM59iap3wbHssoPt8Iwoc4Du/8obRAkH+fh3DOAwE//uAGc5fG4qalFcKHBhw+bA4h+r4dAlHA
-uygE9+6DDK8W0ueI9uc86s+9ZdHvPiieCsAMTMNGZl3D254S4zZNaGgfknCp3BP5X3e4ALDqFvnuwxaR
-FhPYdx9sR0JyugV9UzvIjfH6Q0PYeKnqySaf58DYQS+J/BtPixJfbM7K6v4hK3twm1k3/HgFlW4gYcAQ
-fm0lL0g4FCkAw6zM2cIZrIyGNz/KSpY8bpPUYcSsYj7Opzmy9gEh+TIrjeyFgfL24wyFkLlSSgqUSNZz
-qrYE+aegdtaAUHmSwcn4jsLt6aqTT2FziiwBE8HSKG19SaKCeaSm62V5G4uuQtVHRIi+PFqnIF1mmXXI
-xjGcpMsJnrOCGCfpQgWXsSB52eD143RdQrY2c4D/e52hx7kk+TIjgPRTszUVkLQ1FGFfJa92QdwyggNL
-aykh5k+f0dPemglG33+qFHhSoFZHnzIKuyKpgQzj+h7ZandP8smrxcQgPbL1GyU9yBIKauLKzLMTQgGr
-EbOet5V0f6TahGsmjd6QJAj4xGiORsJaT41ofAjTkFnZbvQSJupnRHZEE0PAQXdnjYMaluJ6M4LEYUpu
-Y5NAegJyipnHXj1CP6HLPIxtzOXzyqo4DEuxxlmwUosSuCBAg4qGPnqxy9T/KCHVi0gDwiQMgiO3PUci
-XuN7MjqVuAgQwGEmnxeeEUdUzKrABvY/TccghFPYQvCpbe+L8Anln9CiOauC0BAATDMMIDFSJCB+mBp6
-n4S5KR6ZfXP6wJsFlg6/O9PCMD8U0ZGnpSRRMVWFUv3ZgQEz+mQ0kIQqqsezZI9kYS17O9kX+YOQOsIg
-HSDU1tCLM3/PNV91XdfMzOt6QWqmlbfLQsdfmCmHW6IJ9isJ+gx5z9hJLHlSUNG5zxJMC8dqGauuZkwL
-Wc1ZCmoAieIFhN64Lq22R9oCgXOzZZaK9jek2oGmE9MnahyQNWM90/qN6W0G2vN6ATwY5okrQ+7Dh6wW
-m6eH6yloeaDyTLIx6GBm980MR4FHS5ffTrTwGU5gSrBu6ewSLn/MMnM4q0WoS+RTJaJS8sqAw0n1bVfd
-ZN7Ediwc7ZpuoTiDn1UmfAtVgV/XZkcLWw+m2fxodWw+ulPJRvMmL3fnCkGd0orSDYUthtQgKHOaHpRY
-gpN/yhaobD238fAVLorqXTQotxLx+VP22ip64RIxMjdvRkccSay0QwV5iVNZKxQYg5F4y/QwW51KTQ2U
-JpCwYF/BF6tikWDeTYLGOqSwTwzvMnCmYJtM0lmxhjMej1PLD2+rwxS+QiWboGH2SqrOyHjg7Y4URub5
-r2Tl4Z37NJsdGM4GVVfHxQJ3ChyVQX3X52wjpTZ70pJTY4ILbvSD7x+eGW37qBwMBokkgSnDysqMSLdY
-0C7vMaj6f5CfqBRJx40/J3PYDKUBl/3J0h7lmeKwNiqxS32PvPxcoDkomqEmfRp6P0n7yUE/GfcT88j8
-PBSSunjxrZuc+cujwW7P1uBSTwei2JgJNcf/Y4qzU3Kzb4WSo3LpF2B2lhvDMY38gG7tKAmqnly1ZIdw
-DaZe+S6QXPfRnOYeLsVxYmWYt8eXIszGZZHDm4nHnBSYIJfIxiFU9GB9hILvrMCUuIeHII+t+nBQnYD5
-0khouD8dJIUaph0G8oO8ZiwwmUcrzjRs3lAyMxKgYD9w5jiCRKrI1hYw63zVgXtUtMkpoCzfY5zq1JyN
-02L8FoMPIcaydKDAgEeiY0k1xtEYmZ3IRZZG2p8bWgu79I+m0+4dW5N6iQ4zRLp0XVBHvvejHekkhnYQ
-duXUKrJjj/vYL+gJ0QyHdpuRnbVEJeWQgi7L4+KEOJpZMrj5W4LEX0DJWjRMIkwf4MGpM9GSlGXGPhqo
-Rj6CdrKkrZonGuAYb5lgFD72rvJocKYyJkaCM8ja0U3yCeQBRJz7QIUTzAt4ktLVXWkk0TlnSbbWZx8g
-bFVvILVoC2rV1dngGHcAvKhJ3qZ09HmUpdgh7qxdqKWsk+c1iDuRV0ESvdw0dWDvm98PYgBZaDHvP//c
-rRMAYBkBN0jls1/z1/ejhHsFzMWG0DK85z/s7bz8x86TNzsvXz5/ie4Vlr8ATZ8sc+Ex8jlo1lNZATya
-NEDDGhR3r+M4Qbc+79HgAjYkE6fYiM994tKjmVEz1fpY8hG4v8HXkZ6/V4Rffe0uPXuqilni1QqObQb0
-DKxD42HTMCqcbB8tQYLQEglYSC+nicObJaCl+4k3p5kPaZmBKQQowV4QQMID4RDA+SyHKElUWUHGUrPW
-mVGKlgE4VspgyZflihCQ7AFwwIBwREla14sN+KR/qOTJ58n2+Rlmhc0EEjXabsxmw9SdhsxHdcyC+J9Z
-rpFlQnCCQEpAdLdYFQSQJojyJYAZDe7v9bYqm3uBQUMnBKzL6jsniTeS+JKS6U9I7LVEwO/JzEBXc3Rb
-JnouWi56TsAUbt7IUi8uTJ5DlrTnipMkSbCpiJBzvGYEHrbI4pJhu2D45zgh6LLbqtQ1jEO3rgi8H+GY
-0bCuSIq9UjmWIlWZZTMN+cdIvRhLHNrO+c1GlgxO3V4/PvvxXmkvGQEfEaAaJN/6A6VJ3oVTAhFoOyBk
-ojJ3HPiQLng22OPAh3Y1Z4NlGk3HwuaHwkbmKXIpx8guT57FpPFo/BzSf/wTZt9w3jmMD/JGkylGFxRh
-NxCcjwKSyojBBLi0T3bD5Le66s6e2Wjjus5h7eFKdeFvr+vTfQLXpyCetdb9qZruAUmvEs49TO70Ky/2
-i4U8N1qvmfljeo71AkotBuDjF1zGUPZQaww5HI3el4P399u/NFhVPzyVvOExSg/AkVsf0upGFcw29t27
-d03AGxOwK1a/i3Lw5d33cJ4tVPA5g/pPeKLM1ub8YFcwjLAyjFNHKrE5l84Zc7RQMYXASQsudfAOlK9y
-Dk6T9F2Ro/kxRfs/FF+gLHvm4IabtNXx2kx2Ol5CsSK6fIO6SWMoTTAHmVjsMEzgI5lkRJLlIvJRA61B
-ksNx6rjPqJl4DoLRngm1T1dGdKQWUtPNiD7WRV06HPLEjUBm9NekCwa33v2RplPA3hVlKBfpklKbwRyZ
-STOzMnJAyCEOY8NQhMRhuzpd9l7lsw84fQ8+g4uUucOCXcJxHan+kXNQDlDl4fSTefaevY8vqogABInE
-I+WjE3RGFmxHahMmtUYqQ93D1zx0T6RvgIDGQzEaPr3w733gG2Z15N1fGGED9BvoFxPJD5Kfqfz/MHkG
-HoUoPSfvIZO/oc3uaY8UI7hh5O8Iknir4oZ5z9nZ6F6r+94QP695mpDvOlmBCKh1Mb1BigUPYbekzx8S
-GKNk4X9lhDhiLL5kxlTXFtcTKwGouZHGRqRW38pMcaPBYl0eD6Bq2GlAHvf1hPIrO6fVbmJwu5sBimCH
-sQ8HqOMYkQ6ccZbFGi+U8JrM8AL4iG3CDPpXhv1aFtH2fkZns9eKRvkaXeYrR6FPylcq910XJvgUxyZU
-OvziLnHD258l/yqNZrwCgoLCNUNUfGuO0qfPnxgC+OrLL+9uw5l5m6YQg4eRM2+ZUc+cX4jlv9wllKEx
-pwi7XFB2TND7IHvpoTlvlm/XC8lzeoL3vHBXAOYs1ugPzKTtKicJd/kLMMbL08WqMNO4OGbna4N9MT/q
-i1NzWpqJPjB/UuDQyqHg+gdAVOlxRd4cOLFAN6g4Os9qw+SY03EjSjc0UAcMjbo7sf4LWB3VzOC28K0D
-0fI8Y88dsvFMnDLoGXUAQjc1Gig2kPSWj1bdvNdL/gKLRFwFgHcPTLtUPT5zuz1NPsD7Bw+S7a96NXud
-hqDE3evE/Z9gi45NKzCwBhGrtGWBSt4cny7MEfwiXRnBDawBt7e6g97tIxeI8hhAoB+AWWdqjRRNRy/F
-uWXvU9iqIsAlyXe2524H5LyjJYxna1xMi2WHXfUfJLfcq8fw5lbgXyaRafRfT07jV36kmO2T3va4qAh+
-QY8GnAi564+779THN32XXzmQd1ze5VXxCgwtj82scG3aGrIXjCzd371z4Vzw1xrd+el+D8YEVcOiG+CW
-O5hu8cEkH/oqnjy1uUNmpdox/2drVm7dbt8xj/f2sNIZFARN5qZR6+ax6J93F9VB2ZoV/7WFtntcEQXk
-afFf+/Z5M4gyDmFW+gCQMo2aNp/kyd4MJPRyfXSUlRRb0FUh6+A3U0ILI1PObh9MiyMo43H39p27t83O
-MLOc/9dyC5wd8vfZ5Havj360Bo9RQg9ZOTYCqTlcV3SST4uTbAmLkIxMw9Bz9aKcxc1DhMUE7MfyGkss
-/aRjJq/Ta2EWthMb82npb2jOTeQiGyXHvQ70vArWUpvoocp1psU8+9nQMSTLrLeDom+7TdOp+cz/Ze/d
-t9u4kX7R//UULc03IWlT1MVOJpHsZMuK/EXf+LYseTLfVrTEFtmSOibZHDZpWUm013mI84TnSQ7qBhTQ
-aIqSHc8+Z8trzUTsBqpxKRQKhapf6TcCkovPgCCuLtAZJ5FKfgErp95nV6/P/OL4SIhf0vHcL8EPLZHd
-n/Zf/Ph279XJm7ev35iiSKH5e+8iH/SNBksuo9cqdvXAQvDAyV4cdwgNjp3UzfhQ1CJSEYuSKWVqgOfn
-TCITp3wwANNRHxzgQYqi33G4pvXgXTNd+v+Mx9rQbvQNG74n/8wBYglUI9ipdfT/fpUhoIRddej7mLAS
-c5Ujd+Ol9SkSHGaTcwhRlFgE6Ivvwe63NaW2oK8qNhjtGdQAppWPgqzbPq81sV6b6pBQgjP/QmanW9+W
-M39Yx0v8tpF2Z/Z+/L/ZoMOXpWFjW+jcrvuKCWE79hSo3CydWeI066WwmMyU/NIA4pI8M4XYE6GGmROU
-A4GmRXllIQ4DPocuCEY8EMKTuo9HU5e1aJBV6BJbbpg/sto6OEfUNZyANjWmQ7OxzeYxcGfuqhcdWUBd
-ujimUAIUKHiFhi7s8uUwn7i3JlvJV19xHzX9uVVk1u0HpDWwEUUaqQ19eDfLpkGwwsIxGlz0Jhla7U4Q
-lAuXxgkGA32ctnli8hJhu6Zo0QZSUhUmVi+KDrKM0R3e0iZKbXR7rV8WQ6P5Fy2FDmUdld7V7bgeX97C
-AuUJ6q2Nx9+2awTx1sY3COFEUndr8xGi4YuE3dp8HDFfba7fQ71+iTNCgdGp5SvT5drzcV4eGvZ9Vcnm
-5J7zrU+d8UtmyaaUTpPzHEIXIZ5sBHSlHQl5FKQjzP1s34fb3A/mBXz3GnzOswk27fVsGtSIFM9HIy6+
-D38FxWuTVXftZ7phU7uWZDfcndTYNi2BtmuD26OWXTf++CNZDkrYBe+CZ8iHGKq6muCCWFdz6mfyRz8Z
-O3uORmuBD6p67muhleCmrjOkINat61BHqATEqwVUQ+YQG8I3BSYxvBu3YTw3V1FfS74ia1/g1V0Zvbjg
-VYNkTzlqTRlBuYmS8B6A9UtIQtw/8Wro+aQYvoRjdq1EnBZyO6bEIT/UADZvKMYGTCDZbJKXEJM7Naf/
-mPsQad4w2it4/boKCvdKx1pEIJJ29N5obu/NPPxXUV7M2obu/8wgSqmcAkDTyk+o1vWL8+TZrN+/SAGy
-aTbJfmBTBlbCNGh51t9KVl7OOit60q3ksk0rSSsDnBOKuMJAWGofE1/ZoiT5GLiLhxWb4D9z1Ti3FOUG
-w+pkwJACZYP7j9G2KQSkJj8dvnyxy5GkcHzPOucdEtao3cAaQSGMjwAeklSR8tnVYXqO9olWS4Zvf0r6
-sF6ZaHiifcYNODejpOPOc8NGAzNt4f7z4BrKRfcMfw8ww4VM8QrHqmkqedaSplxB4v2LUbnXsGX4dHnZ
-lBal1hTBNhLiDLexncAYvDDto8fWpxk4/yA9Syc5HWqAhc3yAXqQCYuqY5Ks4I1QaLTUh/81w1h3HBCY
-RGgH0W3QfQrC4mHXXCW8dcIYRupMs1FmBPj1IR3EKzhtwHk0gI+HWaQQWCyfxmmyg5I0CA2hYQ+4wKG2
-YaB/N8AboCFWxzzD/HP7e9+GY9OBbx9CvrBlMxSjGQQzuoGQkx5+FAMt3ECYt+axz69rMjdSwr9Hx97/
-8YciKitByjdACc8yN1Zmurgr/IRTlCwh4uC1FTp7vutmZRHi4j29Ip9QdvBFNxZ+ZQ9iZg5xs9i1wFs9
-s7sIPIEjexaAOAklb0eB2OiinJJvCkZV93NnGXCGXzRAR2SxkrWN2PsGbMNMwy4/hH54PcpeT14Wk+wQ
-vF7KJjq/kFLxAIeaHsCOHKErxbeldKfDgBzXunvganRJth70dzaEMELAVG0kqRt4SkdJYAVJafqeTkrZ
-qMwx0sorCjmXK1m26vprwEpM/OFEabdNHlRurT7Ao6VsWhBOr9EA6mUaVg+twLExsnINldmI0AvUrCPz
-7DhQ1arrI9T7zBItQdlo1mpc3EWsPUfxinTBKmCyi5tj6TeofS0EMHxvZf7TVLLnZlt8k55nu4LEEtXK
-AhMzpOrMzmAR9vIJoatgnCj4vZcg/GgmCbMGPRGgLWChtOZmRG2IWJkJzUEbphcyY98a/HCXse4SC0HD
-4fqgx2BgqI89iF5ksxGG/DvWsaPGG/iTi+lw8L35j5EA3+P2+OS06F/BLReYoE4xgqD4AN4akwRthUAl
-S8t8cEXoGr1JUZarAmd6CSLtorhEzzYM/IAIKYFjBP80+hM1Q/CBTPsfTEfNfAL34CA1yhCxB90UDSMT
-Ho9p4jSfDggbb5iOTF20eCUHGNvyK3j59fmMljCyMI2Paa8znUoYf/QOzDQILXrwX8b/8yShwzpAUTzK
-MYACtBOj7gITQ2/QAqu4tCI1K6zcNN9zziyswRjRgCvxaczAxy+x4raYZit0pS7yKtckSFbGFSEFeQv+
-8C/yyXwN0K8DEOBQpoGkKt9oJA+hepsSGNh+/2zY8x1xYQQt41Pi0RLBBpGfjb+WRj7ltEUy53eSZ2wi
-h4sEj1VhuYH5aepb1wHGwLS9LHNmWqaEjrIAt7GKcBuuh6U5ipzzQmrzSmr7BN26akGKuxz9pOBhBoFZ
-4NlkRE1yWBih+DGhuKKL9AM6cxKSj0ct0gYSBSNcddzeknGoGACAmansNNyAUdiNY9qOmmcu5HvE4oBL
-6N01TzV9Jjq5ks3BY2SK6JN7gCThGE5iX65R4a4aW3V8Gd3WaE0ifGtj81G7zo59k3vl5vo9zPC/Vy/A
-8z/qb+RqGNMKrEk6+wApSMA00QbMJvrTmnMIksd0Ym/0IZ8Uo+puHithd/UbjivguxApISoEJPHCHkAM
-aSUDj3sl5W+jQ/w4Gw6vxNAIooIvmFlSgCM6OWqS6Rqz0EAVsieb5Rnrt1loo3dlBqf1H+zGG9w/Nfr5
-h4bcIyp7GDsMYTuMFtY1PYRvgQDqujaM+JH2L/qlfPCk+cvlw5ZyMtr7iLBQFIHYlVpdOaFymCJrZ4Su
-RL7yxZnqd0wfYPfZg0hxpxT8IKVxuMCZSb6MkMqwMenRtaJAdbpJBZwOIJ14ifGPT4XAEH42g3FBaSpx
-CF49cMTXD442js02/wLcgcRf7zpQNJVtgPlFsCBHaGgjlgFvYw4TrXQSFChc/Qy+BC7DUqR7YXa/QXbQ
-m+TjaVfDXqHDgAS3JwWoVegQlvYugNiTEqt8b6dRMOCkPR04K4+K2i+AyOJWIG5lDV432mCvKp8jw5r9
-1nxu2VHsZbTgvI8mIY915ELK6ZO62YxkEAyDG3ppXad6Sn9iliMvvj/Mn3KJwBE8goZmKeGchvdVMbHK
-DNr22umzq+FSKzSAI++g4S0vWwpGk4o2hL6R+CBV/UaAKuXotFw4C68ESvsVrjyrRF+S+LWlzRry5K9d
-fTYyCGpYFwIwQuPFEM45oZKYZWeUZOaOh/Ro83jbpfyCJz8aXryQCusMCEDYqk37fnXVKVk85vhFo8hM
-d8EarhQrbRepNIsa4zl2EFOVQjJqSW9QoYbtO1dij/pPB5vQ7GVdaOIcSeFe7xzEbUVG2PUaw5fwGJkV
-0qhRi7vY6piFuGfWoV/T941BEVljQAyuLLAmz68/hx4v0TEcn4fFgsgD/Pg8fTkYwFtoy1G1Z2tjY71d
-p9Rsba5LTkmluhgden0xrfo+WP0LKNDWKIJetv8gpKjbp/PaPTgQxypfF1UvnOmrfDcCK05ZvsKbFVND
-FeuEr5UdTGzfjLZoo4PpQifplQA+lU/R0IRFKO0FTRhqZV34f4ibVTdLg+Icrh5gDbJ/eglO1abnZgwg
-DSoavsywduc1s9apGr9bcXYn4Fvzka4505v1cZ6Pup3ACE+99KrSI1t3Y338MUADdpooJ7QSj0yqiv6F
-/dws3xLvB1NSiAIFIMIWTXLRRyokntbWzEemfL10mTU+OIthVvbScQaqx/Niwmmumi2GIkToyDJn1Doi
-dHlRDDIXv0qqIFHpO3Bmo+xO8tPZNCP8Zs59wWhNCFmVWRWU700kX8psBDCmcNyYYHwXtQJTc/QQWJiT
-c+L922luThNmocLQk6ceQxEbaoa3CFG7uU+ht+TqZ97gom5tERHw5y/Zob+4TMsxpqHLAWmoM74Yr/3z
-4ODkeT4wyvvJ3ocU5uJk98JI0ZODiyybOhpMAiw5Y4hMn3R6RWf2fm1zff1vaxsba5vfrEFCE9OkbPVj
-Wa6aNbBKnYGbR3FQpFs30JhMX82nExxuuH/iKyCzpmwOFOy2pWEqljM7SzAWcK06gDpws2QkFcj+CRgK
-e7OyxAC9U8APBwxGwxVmlM8LPEDIJE3ATPvu7Qu4BkQwfLOrAkh8JznIsuQvmxsbX3+3viEbal7uQey0
-ERLEwBx8ra6s5fnTpMFX73ihrR43RFdhYsF1Er2/dl98VYzMos6MhDOfNT/TV03ie0dHFfG+te6uckMJ
-EXqgwoKCS+VKwSN4c1xppNEb8TvbGBOeku+IhGy4yObqqFCZhoNWxDcsIc27YTNUJKjIw6Qx/tiI6xMR
-CWEvzrTQ3/ruEe7mC8F53O/mn7abI8rAcx7mJR+WAWAn9vT7ptl0PBeUOkBrUw7ZQ1sKfC8hQdwHuzbI
-Acj4QOktym2wsoPEGAFWKSSuOjsDjxXr3Q7UxoYHjTZY4xFA3jlm55/kfdza7XeNcj82soVyV5CVgBMe
-KvhqoAHV2rzn/NdBMqCUDShIVhGEAT9oKqeG+XpFGmyH3rjC8JiB8J51YA98yykLnlbHeru++HN0QIrU
-YZP7nKrokxqrCSbNeRVfgQCNVWRf//ovwpw99TjFXribV9vAJLWVBatbEyAe1JyWRHGCMn80bfjoQsgG
-96b3P0vWvOac5jXho6oMwFvBzN4yDocqd85MR3/LmopeTQiDKrHw4bZ69LwPxv8SDGTPCLuUIscdFWrP
-mXsHuztv9k5evH7993dvODlJ46vGlvm/dDjexgvOxvf4+3zKP5/gz4H8XMGf/5oV8uCXBj75y8fNv203
-LCggf+nt3n/u/RNuHY6++v7JSuMYoQDcvoBdmDTRJu/tpV5Dj/D9seeZhzXLBKKBOIsP5UZCkxJ6O0wB
-urzipmCOhVgHxo3VNMBoRHLxg+DOyB6kOBI32Nzq56E5tZhN4qaKaig+tgG9eqjaMiZ167P+Y06qbywE
-CnAv1f+ERXlmTqgcInMXOxDdqVMs3GsMe4t4TanXnvPUPif3eRlefoZvvVov0UOjWh6f38rdSs5+1eAm
-CWxaKEaYWVC0wSnlUCNbvb11OaWoP7FTR4N3rVPDH+5G59o5frye8DNv1euih8Jq+G3hMvVJJQXUxDer
-n7hjDC2MV8FsUJn7joLlItpUdDmAV7t7KG5CH++cwPOsvwNmibfZWbk/eovz4PsPgetuD6jC7J5nUx4N
-8H5TgwMGEzjG5NbLptnq+E468liZGNNkPFNu+biKMYKWAqbNgkYAJq7gUSPPHmqZQuzjLD/Igbg20Zg7
-Td2lLGwpObi7RRrXtibEiRmPTvKy+MDJhdAYSo7GLt0drTH0oNe0qhnxrEexcjWiOYD7NgzEQOf1HUe+
-odyNbKjxzXPnAT5fs/UCEeIqzFuH18cY/9fKphNn/kpIW1hCkwmFFVh9FiDqZFaHrybhJkXoxAjIR+96
-01Yl2WFnEGdiIxdBMijVl1/2AkYYmdF5IDbR5vw+uyq3kr+WLRc22aX6NnufMFcDDZNkr2QnVtnFzCrk
-D/DJAMjGRmcpvOxbsLu37CxdG951CpQXY0OYFTCrIRKAjBejLMewJH9DMCODAasokJp2ZO84Mta1L6aw
-KdF3a986vdFvbTxab8f28q2Nr124OG3aWxvfbMTuCO1WvLVJxG4IHN+4h4X5t+mRA3BMGu0yXEKtwjid
-pGZ7Q/D3XYe4oEMpqwVuq4lVs9QJ0V2CY0jkv4K7cjEpZucXUiwd+B47/loUbBV8Kjgr8tKruOzdCLJD
-mfiKmdUKE3JB0DAA2RDoZzSglN4TRwJQQrnhzaBDjLjRTuiKgS/rfpbZ1YEEklFTAGI5ceDTcIzEhca0
-890o/xcaHpepcPReY/su+uJdFT3bKOsvEvAfe4rsjXq4nYAGDmn3LciHzZuE3G3IaS/uRvevZbeTIC3c
-xghOGLKC4ke3+YJSEywvwFssJVIIk6noOY9EwXyh+06Earaa00hcsQMQFhpXNw9ffcVklkM1ByaH7pIE
-xcRGQvHSeE7DVLqGUwZduBq9Ggvap1yD45VzANYCAuYKvwv01IA6yJl81BvM+m4Pp4ahdxztVN4BZllw
-h3gKs76jajpNZyfoVHyB2BmXSu7o4iBdovqgD+0SrAawXSYxaWWptucv0TbT0p6a9KRm3/W7c4u9NyYx
-zQb5t0U2zDsjrdyjMd5ib4Tc6WxhqdrNg01Ln+dHdP4cz6ZrwFJmlaaYjwfoBYtBPoEOY3YH2N/7FvtC
-MVEruxjoBR4bVEFyp4KCPikGFmKK4mSMNpZjZEx7ycZ1Z4D7y4mxEb8M1OfEz7jMEa4o9OArnRW80INb
-t/dGuQTgsyXOXwZAwqUSQUWBOMXmxP2BsMXOJul5TrE5AJ7QWdIpZdA3Dj8hYaGYOkYyx8QjQO1IKfPj
-PcrGF1kF6EqpsPqjSmKwHCgOGbxYVnQyghWbjcAha/HJyULlFxO8H5a4KiiNSZXhvhk38HGaT7K+0wW8
-fAnMOA6ZG33IEJI7mU3zQT7lTOMc/k2riViaHWJSmw1dZU/AKHCghS6l4miPSJ0q0S5052MKYamYXglq
-Qn7/PuUD1ZjddAeOyS4cRkPLBVRUh92/jp0Y3fGU8xK7rdOPyDalrPnC/G29Yl3FIKA7dSn9eqd+5tjJ
-RMKzY9e+1daqZbpQOPb9Mv20ZXqeTXcw7aE2NleDqwjoEiEgSxcJlOqa6G4GbuwlBeAYwb1Kub0w/1vH
-TILZoGizAWrA1Oizx8nD68gSwAwtEgALI4+yHirTdu4gq42uJCo3JQMB/Zxxamz4MMRsihHqKgNMe0oW
-shQG8HjD02wlaw9+cJv2gzXke7dJsepX05c//nBvoAFqG1MZ0EIatmR0hwubqFbQQoGL9yvok1fQHtzt
-7nJCgdveq/H4dyUhQRe9Tc3YjjjcjbGUViwgO47uCipMMAS8hoAq5X00xMj60IHLAmkXHOVKvBfgEyse
-dSOJSsemKvpY04mPP4ponebsnpF7dt5XLW5jO5Goi7iaksMsNHEPzuQdWamH6ekqmEMIw1pAf0bFaNV+
-mTzNRMPkgGn1PXIqM8erIcZbK0XWVnKtNRRX3diZueUw8crdu5xMRykuJ7ywf4V/S5UEb/FvdMhWcyme
-3VW54jFNU33TxVwJGWWc2eWQIFe8w09tqp6GVEP4oArhxJIN6LivYSmjsT9/7sYT8nuqfmFmRDOZOLWr
-aAZBfk/Sc7D9m7LcLM6nQwd0+S460n71leuQebDxyN0IqhZuPKoJeOLzjm2gTZ/iMSZsSrYp6KhIJ6AJ
-rGp2kQ0HxQ6ow4AFjOeARXH5UMwgiFKyf7hPr9mvtsVnEj497BDBl5KxSr2DCIXRast9wg5uZykYwe+f
-Jo82YTvxxtQNocrh4HWFn6/HjRIhV6qt5D5a5wtuJX/Pru7inRFOXyXE3HurTegiu2zmoT5sQT08PkA4
-4de4hrqcExvbVmaZDYLoA2QFyLnOsPjNaF4pBkNko9V3B2tGiynXfs5O13be7K+Zjp0WhtuxIX8xv07A
-0lcuuXBwlqHmlbh/7ZU9cOAijypy6ToYAzOlE3ie0CNIagk/zQmmuMQf+Pjd2D40f+KjtzBz9in9whc/
-Fpcj+xx/0ONsAE/Nf7Ipf//nHAu+PqCfL7PRDH6zKRB/4oud8biMvqB0mvCKU3IaBZlpFb+9kfVvBgGK
-vBsZFhlN0URLDmzipA3Ay5ytD90CBtl52rtS8s7swWr6oM7rkVh8DV+jCIOYaZRfbbTZIEwi5JkeZyNM
-5PSe5ywZpFdwzDWC/9S68f1JfDDlnmWHheOEb81YPAOfOZh9HK3vzBOjTeDfG5sw0IPMcAX+fAQ8A/KT
-fn4DY32RM19s/I1nxQw+PQDiOwN+C3TfgEaBPzfXobA519hZ2vybz5CPNi0jPnqEdc8zZrdHj/m3ZahH
-X2PL+vQD2vWT2Vzo198qHPzo24B/H31XZd3H6xW2fQxf2Ye0mVzkG5+HN3C8nm802uZPaPPzTfwTmvv8
-Ef4JFJ4/xj+h8vOv8U9o4vNvmAY07vnf8Dk06/m38CeO1/Pv8M8N/Mg6/k0fxC9u4hc3NonMY/joq9nQ
-ju8Gtj5YGpubUOxlNk29RfD5lTeUdDfobYYrqyob5h30FTOVe9B+Ai5MQO8tcSMhkeqUiNMrq58m/RkB
-ahEBPz8cJ5S4LCbv4UTcn6RnU7nKoUyoHafHmS3gefHRUSi5l6AdMaBr0g1ET1fMuELF6SXqUNAcuZGD
-7KZaWHVb7SQ/H0Em1XxKWhZrsRiB7kT9UTBqx6DZBM+cGxnURy8NTzBWoIlsnWulx7EvalRrhBFpJ+Ni
-cHUG1oMU7gHNuRwtefDrEux2o05kotEqjqFYhgacS8qGdpFTquVcvd8p3nBMykT5Q+wKoweM+L7utMB0
-GfqoVD089QBZN+GoSUwnYR6NAaWR4FikoabHo4KxcSv6LTusdWIqJSucyQ8iZpOtJHLqtCqrd7tZO3h9
-kF/h7HslZuOGWlQ/Y3Q9Rn2G+5SCNlan6GGWjhi4g4BHiI7bMnE9wlYIGKa4I9rFj9slH0Zno5x8B/QZ
-wkKw690rZG74DDK4z79BhF6jJi5PCSAbj1fR7LY2N75Grf0+Ku8Lau0vIV1Onk0OwN/zjlagiko3ZKK4
-HPn+Li3LwuhvsFvYwHFusdriRDHjCOPLR6iMHb5d+/H1y9UXoKmtPlrFhpdrfwHWXpUOKPhz+bxht8MC
-PEBEKwddyWzH6QA4kVRX0afM4950MrDPccs2D4fmv/Yh6WLmaQl/wGPa1GsP9QAzoQfYzDxIZLyiuUqG
-6ZhznYolCkjpwYMblnFRim82DxX4+WaDs7ayc5A6nIDeAdK37AAl9nUbXLUZSutXpwaXbPRKdi8mYCYw
-Az/E26EIKReuqfvynxmgPMHGtiMxm4BndpGTfpD3gdVtkg3GcrkamV4YniJV5ykF6PFLrQQ9DYp21Mvt
-iDwOBzp0q51TVHqgXSzMI+abCi8dUfFj5TIhpX9IlpfVl474+bHZZDjXwvVSVSvzG1PRz/gbkaGfL2s9
-ssomcp92+QtK18N0cp6F11OLilUzy6QFTJEKeVqgfE2Zof1DAsiItIfOZAxgsIQQIVZv7wG4vDMlYwSI
-Odh+JpMy3C+5Pl8nh67VNecRKhG3InOffWMvPww0rXLSU1dVhOq/zRZQzDKAQBlnZs6o2Qiwj66VhIvU
-xGwqh3v/PDwBbz5g0UcttniGGxKBtELGS9yYfi3XiOSJg+/vAPKqW7jUZgfeDyohKKD83CWaSbb42fx1
-fchl7IK+jwv9Mgt6fwp5wovJ89Ht13NyPihOTXMProanxUAMRvuHe293Dl+/PTn475fPXgP8GTv6c7Gn
-nlc/XD3Qi07OTSGX4+c77/55UqXV+B//Q8o1EJ3jWXYGp1omDqfuTjQwTGpJBESYMcqlSseScOxyPpNA
-7BmAAaGrFAMnkp+VA1VkXxZbPcVLNViSLtECw57YQacl4CahObza5/ou+QGGzNgiKoeCTw0AVGwpcv2I
-UVPJFFw6BetxLVLSCJfTTCp7EtHBKFbkn+6Hru8EoNdzrwwwAjkZN8NZN2+8okdBATzC+SVi3HPMSTs8
-7BbdoCAZi6druXJzr//3VTGRZZv3Mbb/RgHnAPGWPhf08OcAAwaTSG8qWUPRVQcuMEcZzHw6ufpC2MB4
-mWoPT4YOnLtAznctfmVXYRf3jDIAiTYtUCtENPRTSGaJjgB0EERQT7hgbSt0dMp8Iyio6KYAoLCIJUzu
-QFmZC0qqzciCOYPa1e9ySy2kMjQAvw6fhmOhLdlsPGgopzwyozHqM/vqPi+AT21mHrC1HvzjPx0J69OA
-fktXwFUM8tiXGNU0eWIG+Hv218VSDmXXAqcK816aiaN4FLxAYf9C8eNnfHhU1hqQSWOQNRhGG58M8vGb
-dHqhn/Wzs1L/zgamUOlVO9c/BoYXw9/p5D8naR9g8vWbcfAlsMmeF6PwUUgQKKWDGEFYsfp3OS3G+jfs
-l/zbIkRQtimetKONdtJ4Qo+SIYQ3jAfZ0xWosPJ9A96t0cvvG8ekSqAR1auNT7gw/y1lJ1LwkSv4ZIpp
-BZ5MJ1Jn8r35P3qoCFBjP5z7Lf1wLs2Cv6TYUMOPox3ngek2VvkBeYnq4F/HmASgAV7oUubJMB1zEfzr
-mHgDbT5Hm9WmS2tNCcjYL3XtT9cNYojs3Cwt+zFz5B70y2zKtdzPY2YSozzYwqRCcFH5QQWnk3jzeFSD
-AaVeF+MpNtHUdHzQ5jc5sqJ+jsOQyhs79TI8Qsp/gV+uPj0zG1v1KeSe8J/iY3w2UcXUb28lM4OEi1k/
-5vWsH7klrZ+eB795HYaPvLWtX46r33YrPHwaIV5Z595LWur6Ea92/YgXPD/S94nWVMBgzijczVZ6lp/P
-Ji5pzVTDojtAetlDXr0+3NsiBWiQ/gbIZ7T3lvWbryUruaZ5h6zFHRX4agR7R49yvx1Ke2a3+pd1XWoj
-Kjr0pgcMVjUyxMCxUYB8MgS4ahNuhGiauSX2N+Yic7KtErAoLdawy4wTbgSgvphadlv1jVTQcGopPUVa
-7hbSNtED5W48Mfz8Pln7vsF3k9qjrb4SgPLYTz1MGkZeVR413G1nojSOIykEQYJu4DoYoLjrggbtoSNa
-9Qe1dajHotmZg0n0ZOLY5nNBUd+IK725UFD4/Rnnk884iGFRTHbFF+D12VmZTedaYTETOCRfYLAcBhaU
-aNlBlp7RK7BrwB2uBF+GMjCeAoFkYsWSGilYkW8vzKeDeDoF3C5ZNtSi0aJE8PRrl9QIfSmv9R6D3R6B
-5RRi7ggVGWIbMIqIz20cjYQ6+mU6eJ/MxsItcOzC7OpAulEKEb6ePs3wmHNhDhDTai6LhQfvh0VH74C+
-Xj+AocjsQM+5VsVxIyyg5ZoebmfvDcOeYXw5ybJh2d4kP/UznNAezOOsvVlSUw/ZeNExgxWqy1Eu2Wum
-4o8mW7kqoxdfSE0g3WZClTQcmmWhoJcC42CaTsBMsK4f7o369OimmfGs634uCqLhPkE7UGfq4N44SwR7
-sjiyVP7JU+6PLCgg+P1Tr5MeK7gH1IAt/P+2ekpVt4TuqmudLXQtiEPXS6ovMkjcDGEyzWV6kGNcPifx
-aO20KgvdnYOx7zeqW21UiNzx1rSQV/D+aFckbK0r9Y+vd/EG6+Twv9/smfn8bl7kNoiEH9kCdq3MbiCk
-vWRGQ5dyyRnGF/mXCvyIlxnVSpYH1/Fv4WZAkAw8BMn+jxjMDWpbVcufM1JN2y2V/jd4FoHeWhLQLtnS
-PPHijXIFhUuqiHFRg3FFMgK7CuE+XLNA5/RWrdL7YPEvs0oVZugOguEVc2BiPzGLH32GPMt907Q9/YO7
-lZjNGZwP77m5LlzbpRb30Xarki/u7/PJ0DE9B/9JM9yV9RgZk2aw/LgfZj+db6RXPpNvMD9yonZtul40
-fI+os2J5HoKmbs304syE3qcY007UwGj9BGipTGr9AoAnpIajDBf8FPaOxkZ0uuLoKz0lDdU0jGGz1wuB
-KEh+4A3dr7ElT+2XQ+9K97laD4HI2FuHy9oTKkqM+7j1LyMx3o1OgVbWp0CBN0WZc16EagR7HITFrvYS
-KSRjJlFJ7ig7q9k5ySlGR57rnBpk05tJy9rmT8rZD/qj18wun+7AIRkbgSFfnIdmlJ2TkxAkBvzYQ+iH
-C5vpN0Hq6QR8kJoWD8MmNp6VmDEE/JXAjcZMCnUPzlqRc83P2CEPg5bKVy7h5ZoeAHzR86D7sYvO5t2r
-bkL+jaEEq5mjpvuCk2fuGSoHNNCBViAHgo9bMhHj9Dz7J+nWXnh9ICo6RB3iauT4cOXR+O+FaRwW4yV7
-rlBChdpmWuY6UvnqVeQt0buulUQ1Y6h0lPs4/y8gcSh1WNW1293wn8wguzpY4lU62+bRzur/PG4hsDxP
-wU9CCXKU9MzHB1BH4NvblA70Ywquz86J53vXgGbjNO29h0ulUX+3GBSTRovKPElW3JvVHrxaYQLmJIp5
-n1yutLJNd+6WLGb6obS6kjWKLysKyKZObnnol0WrH268zYbeN5THRqXIPwJJAoMDc0t3WNbmTJOsRtVI
-LF+CuC7Ta8+Pl9HuBaa+MvrtpLH6HxuNViQfbmWZ2Q+pVXUf8vwlV5XlvkX2b1httqav+dvHVt0fljq7
-9LBcXbt5KVby+d28Km0Hbrs8a8m8LH7DeJCcfMMskdVh8dvq1L6ZS2NY1pAoAwrInmVipsWMVP7bxCg/
-5+dZCc4s7LQ7lFedXjGkeN6/8MLvQ0LFES55lgWof6AvIqR05OBA+OrnkwmulxHhUJEcVk5YdgD5YBrU
-uEkk2O9Y/d/x2JbZfFFYLBRpde9u9ydIEEK4nUI8lI/cu3RL32KX4AIomJWROUDuSJqLSiEvbQXm3ZpX
-3y/g1aUAgXmVgxJW1KXmCHAeICwzXDi9snkwFsyXcTMG81pyWCTphyJH3OGrnmEqBg/IRr2rNkbNotsa
-X/QBa/QGpjU0y3ACwnmcN/w/oxffJMxMZlRm6hW48M6v2jFMMy2AGdr1ZTsv84/5CAqQKn9Sy1pb87jO
-nAmUL+YuouIw+hhGsqL5JYNUdYAzmLwfYVZ6tgFhkU6y3zH/h+iaGNwKhJjtGX0aGk5lA3GqULDNW0+c
-cvbMa+eiDhFl+ZnFaRJkJ78xvvjNy31+a7sM9lx0bfZTCeEYssezC+HVTvhhCTdLFOANQgtR2eYX7mAS
-AbVeFv+O2RV6mb/YvMpL6Lx9HV5h8zpFDxbmbkwyS1jbZIDHa1uKOkaIKkl1EM0FE9zAumRQdMlpeRVN
-54eIYKSZIOF0IWUx+IC2grzsRI/uO8kou3QNZTsH2xUaBMhluAIEbkeE7RTT8AZcUMf+eDPWjjVb+ePz
-5y1i1UjiySXzq31AuQv8u/aI+OxgXjgvW8eS53FvCTZoJLz4fDGrPOWLenh+a2zzT0ljIw2AaARusGTx
-iS0cm4HW/1dbU/LDqnw4CAMjFEswM1EhuzlLXo60DK+eyk5NSpdrC19wwPgy6JTGSZ8FOojHNsbX0Fiv
-9bxyF+qcnQbz/R3cjszSnk0wYTFL30veRsiBu+TY345cVcuieBrdhdm1vSL8mpnK1yLfB6dqMuC5YUPL
-YE/iiK1nOrt8yGpGZ/bGJHPEBN/PbHWzIa90EdiXlJpbEgupVdnR7mWU/iYut/WItrxRFCjcqVlcxYTy
-t6Yf0pxMdHAc6s3KaTHUfbTYzhhKLbmLmaDgGhJ+CW9yO28PO8lrkpqFaSvaTzHZUF9QDVEXrKE1Zf9F
-AudI+hA8jKODGjB4mkbmF2Sg7ncwh4FTXlBxvqLRbFXg6qJiiNlWpbl278iJxLL0ooxJpZ4XeONBHgqV
-u9K7ZrLxctkAKJfKtABFQWsgOWFzkcEK/Wtp0x2oXi6J8IjkqFGi+4uklpCILEmTYTfAGi2iWmMh5aNa
-7QbFo77ibDT3i3aKUMTTnOy6BeqLdxblQQaKJRLicDlCHmfu4yQUgAikykfwzV8ByRBB5iFRP8MrWi3C
-TPglQuZUBlg4dEkQb2ClXxYJ+fmTHFBwDXDXCncNRl6YM+HZGebQHJwXk3x6MSyXWChAKCasjsEV4A2T
-EdMMHl6JAACEoVBg0LKTWW0lQE85Eb4RaxNxGcP0bQi/7RpTbQAuskvAKfZ6e4IDvW/2m4/ikBW+G6bn
-mbuHvgPrO4J5icmYXlmX8qeCluCXq8+5ZosLF8imYWuXMlZnM9Br7XSCrsAbE+bsxsgojEmi8ks2qQBE
-MqETqBGmMtn9Pol7CODy6XTuNCSmAp97OfPq3kfIgwL7jtvj6ko0pa+tAEBKwjKt/hqzG9WqxrdwgfbP
-7FsbG9+059gdtjY2XYqtwK6wtfG1y8sVmg22Nr55HE/EdUMikc37vNz/PnMXz9W8i+13pSrYbLH6mE2m
-LM3oXufK9AaW0TkcNkm9lLx0YBmQFryhBiQlYoOd8WieAX7bNGki6jOl17RIki2U1Cnng0e8UMSVJjr5
-iOrmNnMCqrGnEwhvx6BE+GkaZwTzBGcIkoT0ppzaWK7dnQlrCNLuPLPBjzAcols7qUFYwNMLfwip0iQb
-gicwZFoeYbw9pcm0oEAg7wk72tHruIs/bU2zy8JIRmKONo9VO0lNG9pJr5302wncKNwx7SpU4OGHvd9a
-SZxkowQwIGD3JpNi0my4FtqcooYrM3hph0/mqxGKPXE2ou54J2cgsL1go+hrT1Wz3Hn0ZT6iFFQOP7zo
-4WGpv23PAIBPN5SC/eyDmSzn/uVlLpVQKtijwl4CZ7ple5ENxpghhASeOtxGzwJoZJ2cg5w/CmfzeNsv
-5O36Nw3Avp2gf+QFAYBteX2isbW3GGt/LdfgqljbQ3l7gvYdyfcfPjzeTq5bQafopI7t6ZxN4DL4sHiD
-IE0biG+BaUfAh6uHac5wkVoWapSQuzXBekuO2ywv1OT6sPXvnrh/8x6g5UvsMCWi4xyINP+z3DANwZ/S
-8rk5vBqJu70EAmQRH0avGsITJM5jx8dIlUNUzWuI8BM6XNLwfjq4TK9KC4tKNvIRLNpMQb6lRj3l+Sc4
-ggnDZAZIR/1i2EF8GNjULs8R6ugv5uGq+Z/fmlXTmjNqzYKNbjYghLnRwg2DsiVf+/cOJQYJjQTrTm3R
-whISbJrJyGuhGolmtYAB4BQiOPoDCl4F+XyemQOI2ToZHkrSUAMu/uicMzd0YaFNu+2kOyjSPvwXZUe3
-Tc5kFFht74mfFQBsXBJcl72errtFxu/CZe3BzBzRPiYEuUU3+FnnvJOswA3V+xU/5ae9GWGMVP/WRh6O
-L8CeqUexE79fObT3Kllk6CtOv8n/EMHhbt8fddY76+ZkkjR3ycj2bIZRZ38kL/cPwxsZf702gzFoSweU
-6/ANiB7Wwixd/+qrZLnZMMIIP/UCMdAA81U554YZr/2jJGoL0i7w8QUbx8NwuiRgKS9tb0xZV099zp6V
-l1XhuFV/LjoJ7YhiDTR8uTM1rGRUxcyNYxvCyqFT21Leb6BvnD6y9Y4D24xn3tLthvH15Zp5ELIy0rq8
-yLKBRsC1VlrJwGKkl+TPsy7Yog91sXpXmBKATr572In0aBHhQ9ihHWqRGSLDso1WmGlCka05Lgfcu5iT
-9aOF4I3uNYFP1QReFZWERks6JZi6qeSASzia8Z+Y6thUqZGSP19kU4Cdxp2DTmZcEa+idXCFJ+0wAI9K
-ehfOy8v8VKF6ieH9lTWuOwvrD/ZzbM+RcluyoPm9vjcMbpMZrc2LahL7fX1Rhxggt1eIElbjiJQH+Ssf
-3Ue4fxHeh2uUfcjG6lLj1eevDNGWnbYH2l+5dpmdrqbjcbnGCtcq6E9rhCRkjqVrpmGrmPp1VbYiwL78
-Cz0CDlpNzb60Ws6Gw3RC/kuI+yNiExsKLFgKsg/6F3p4TWa0wt/TfBh9tgoZJgceutMwzb0Hw2LkozQx
-23s4TmVp+tn3QZqMHuhhMWXppHfhozENasCZ6HfQ5tnEK36ZZe81lpOSHOGM4r2fJ0PggRIf8DNYsfuv
-3rw7RATJyNgfYQWYLoQHjFQHbNSdt3s7DcYFjC/4sKFq8d9HDX+xxV+7+ZGKSnuK5z6HzzQS35+8P1ro
-3comKe2PbZTBFmpYubqNJY/m8abbkFyvt4xShhx6HzH7BTj01yIf7YIbJafjuj0m9m4xPMXsGAJnR26Z
-KKrEGQhvdIsRspdsb7+aDW1yhj7g0IgeNWIVrI6rH+C/k/QqOJx3Oh0bEdtTjZ7r56162LQtW3vQBiRZ
-U1DHwcpbm+DQduQpJtJwaPQAK4JE5aRpmv0CIStMUXthYVEsEk5vLGW+TzbkG3CkaqIgyMlsav77xJGD
-3w8fKvgM+bD+zlGeW6Mx+sFJIQ2FoTvjBsJor+7vh5B9yqitYAt66PdRDPliznchsFI7vs7V6KvNZ6HQ
-1vtrvz9hvb/Prl7mYCW7i1f7bbBjd+VCn26IjFKXMewdBn1RJo1/QSoduq7PJzYDjg00q4TJQCN2X794
-/faA0mtSV5q/n5qK5HoCqK19+vNaYKMxeOcKQ2hMNarfgSrqfV7i+3+gB9JTcwakYkdcD5aXvTE07DxN
-AE/QMA54MMCuamYIxFkxGYJNlEx2HMnLzrFo0UR3NBgMeycJlYNxkBBYah3qb1tJ8rt5vLEFRDfaUGQT
-/968plKvZ1MsxqXg/6UU/H8IlS1+vOa/Mf9eey6wY6xvJE0h54Q7wZQAv1+LIOR0WXd1EDO09Tn6tT2I
-L/u55aEN5KLUcHxgJHprK9lhsWg2pBInxvAfKyboh+05jN3me+RXhgIb02khWX2Zumx+h3B/kLxNJaQt
-IBPELNNYVGYEjzBZ2VMZPCVeJ5h8IHYPZrt9C3+QG1HvHt2HAH8ZIfz6zLN/mTGCuwmGlGbogDSxt9SQ
-uzd5kcGdKXzmFIz4SXlVTs0xEwtRGu4ljqmFe9ZBUZTie5ae5oN8ivbc/tUoHXIGOJDwmJ6N1bS0VJlZ
-wYWqzAYfsrKTvOE4G7uSOHI3gS9Qh9ZMTTNoRnxhPvGpOEQAFwM58MNwDrj4WZyRdCpEzKCZM34nQROu
-aRl6bqSEKmJm5lTmGptISQWRTMOqIHCPgIjWxZla9ACegq2j0aSkhoCQgMoopmEb5Bitia3hBFlmthsf
-043NRoemq/m7/YxsL2EXqTyxAmRDNzQ7yVsKpqANT3cJBr8Ad9wUvWkmU/CRzOGSwPQT6U0y8liAwcCY
-jBk5PGvp/PrMk8wjSIP4WstnFime1PJLieySp3eSYCpTogYsJFTP7RisJzZe6YT3YdJfQPIM0zFtcbU6
-nj/5Zp6sd6OEOvklnMZHFy2cJtFGmXVB1EC4cpe4HcOf096FqISYq+RyFGagw7EgUYNpKJU2iX4v/A4v
-cwmZrDTHz7KDCpr6Zo6yjHKe9CXPiZkAd3iy2uWqNJzagWIm9mLEweX8nFtymqGwnaSQzhGdHZbI541z
-ELmQBnJSTc+mzGPQWAxrtpPTddfjZuo/5OCmCRQx46btWyfZD/XM7GNeYqYpl/uIwkXw1rzfdsWBGphV
-OaLaDZg4v9mS5EaboWzy2ojtwtkedur62sdMweAQjYluaJC4Qza+g39bnj2fZGOXm4bG92U6brbib+WV
-dxOvFFzi9khgo3Q6MLBx3htPL46DbtrBYBtY25JsCxVlXtAWtAjEnajTwMdWo7bWgRFfW/tEgK6/HEkP
-lOaMNM50wsSPRoziLI2lOtzgNn+CSrXZ5YE/ux2zABDV+PHfjpAS9PfJRL+EoM+GRf5bRpl0X9Oh/i7W
-vZdEp2Qh6xCqSEG1S4FWfA+cLiEuwup5Imbrw4u9VSjrrSZNU6VXTantFJ6e2Vwyu4DEiUQYTkM8iNkP
-KoRKD5dTeg+UOqLH1cWDBy/ucyuiGnm1cb3XrJewg2rd3PvK/7sWEywSxAFFbqyDe9izjkIVjAZ6c6cc
-TDoNHMGbI5i5xHsOwMeNz4uCco4ak1FD4OhYSqAnm5vykrx7YIlaQhRrJaRIhxKHvsATkmcFSFlOsy7/
-KAPkOEfUzyH6ixUNOZmmEkFrXelRG5lN8Ngn8w4e1Yaf1F0Uhh+clpR1KUX/JNDLzNEp780GKcYeGxVx
-Rj1VAxIKH6sd2BHD2dXDaUn5l2p+JM41jhTNCow5jmzXL9NVOfoc/3vklYCznNaUpt05PQaKH81+nbxE
-86ZcWtsvsB3NftsaznjeUjdOqEBnH1P01bQ8FLGrLfRhMqjZhPD0NC4YbeNuYe3y1t7WxuNvF0r88Oje
-OfwLiFQ2loPZdSl2NR4B6vpEN3H1RfNkUS9xVYt9xAXrUb1gN1d+Myzf1L67zE7f59M3uikxbveJoyqz
-iDfj43tvxi/Luq+Kyyj3Bvzmc6l64SVbpKSKhjEZgwBRFavc1hkVl80WAaaaLvcyCqA9Y33UbJJArfsj
-gDdw0RxShWIUGsTioJGgk/xMwcg6wfxz08Kz4mPyJNn4mj3xsX+C5GwGVhgEDNEZ2Rry0dkkDTY0VKwD
-Ll4OOhFZYMmPCJ14XRlBM9D+sgACnVMzOE31sBW7J/Gp2IWkZ2HLCH1cP/cekV9g/fxrVkwz6x3/DzhM
-Pi8mzygiptYumZW9dJwpNG1Xx19ecwrq5baHxSBVCzeEj7UYYEp+7ZDxZYwsbgqZpVXJyv7gmiv9Q6rS
-x32dUTxWzGjTa0FoDXS/uePSxA957leNFYx8qO+u1HkIReMbzdxv2rUyb0i3Ntdp7dw7FH6BtVNm033J
-6CYXiJzJvExeHuyMx39SkN3PP+0f7h282dndOzH/PUQI1KPkl8kvo1+mv5wdrxHM36vXr/6xf7D/7IUr
-9aS5vLr6B2Sr+2NU0Ir6Y5hN0z/4b5ydliP1y9r3xwpY9SCTWGvJY2ft8IXkrWpTtDVddqaYl3jKyWkB
-/UFYB8hh5gCMV/m2PjVTFUBNljD4UuNzL9UCZXl1E6Nv5Kh9UI/W7ihMygev6IpsbS35OR99C/kTyy26
-EUZsZCjBN5L5qEQU1CWFBUSzXsW5oxcdCMh7NwLwpxfgiv2cG0atWbTVyXxiTR0+LI5q8Y6iZazlLGCL
-6uEAIbL37RY4s44I1wnlMqHGSD5jm/NNsQueyQdZ2ifYJwhK9thjkgHmTL8TUh65y5QI2SqlEUkN8Wol
-HMRBWaBRIR2XKLpsVCcsuvclIOGib//pDG41QEaugRN7/iEr1zbX1x+vbWys4Tdh8E6MxndC/vwMqiJB
-XpQT1AzgNnXXjBR8nhAhkXPwmjsfCvZ2gskEGN4PraagzO0tHummivspJpOGeDvWFAE+tXFni/Mf9vdl
-CmgKRhwYCbmFvSzJV6APiVERWyEjxBTIez29hPR1fNtmhC+jgTlyksQtG4k1hdRZblDb4yILJka1yhl8
-rXTE8CbyspiUmWYLHPm8mIAfwCrsF8i0yFB2CsyGkp+fe8RI4mHeUmz6EC7PztIPBd2Xxj7gKh++/vE1
-LJQU3Qz6BeWYMTwCNmhq/w/aWdTPgaddRoNXAhpABiqaIwsPRiZv14qfM2J/az8Es18xeZ8iarWN84W7
-P+jSND0vIXk42AXTCc0DnGTciIDBM59AZzCrOo4ccvsF1BmV7HU3wVU9KhJLGe/yhE4+WjUzBSk46O40
-bEMneWMm4wpPUgUnPHejjYDUQgompoTuXZH7PcVIX1W7RX2yGz1qER01A8He2oGV0yTm9yAa4dHROgeD
-PkEZH2y4qqqeR9PYt1nPiLmJSEKPha48aD6zXwLqLgnBK5fVsKPJ/TJ7vvf8OTgLkA22mOLCM9za/2A0
-IQDIMANM172/ZZNi9TLvTy/A+EYD0/F5zBMhRBt0W9oy9HfpPtmcElPoyQeGHwGooqQrorfLsDSUyq/k
-xQQYGqAxDAaanrUQm+P3Gay4DCNfyUOH0IhFStI/kpX0oXi+TjevUq5jBEfKvuA4eRutSkbCDu1DtLSk
-YsvRC/BD4J8jbwelud42xFWtpZrac3Zn6+fNGByRU4MW2wtapu6DOb7M6UAd0mqP0nc/CnzOg3hEa1aV
-9Rt9bJfzgM469tlOBIlLMYahUeMCAG1np0YNnIKFAMPf1YfNDtBO0mFhPgNJzuh+rZxR/hLQ1Mvkyenk
-+yR3CILQ9oFpzMSwxSi7JC9yI2LLjtI2SF/DS7YU4GUZ+u4uJxbryRI7sai5qWpfznslTM4KSAXmF51a
-FlXg0SLYXDglW0upiAs2k0tbtuEC8wwkWFnu6+fKO1X/ZonXXsBm0g6ZfGvz8SaKyvu8b19CVF6kcMDe
-g8iLef4vfFXDsSrgQ23UJVy/U5IuRp0953CWQjtIg3ZIhjrORoI4JnTaAL0KfHEvrMM1qywa8RivWCky
-hM8yS+QTuUN3zae/Puv4CPxI23cIBPsBtg0o+1QrySYlYNS3SuqBAr+yHXQGe+YWNbYItBr32JooCUzI
-ebexhzAcUgBWBOTpTqOkFtrzGx2lnnWqsRY72m8HfsdclTXaNzrdPYuW+uMPpEiRF8vcfPzVqmQSV+Cn
-4vumu/BMujDM6QiJHdiJdOBZ0IF40yD6pK53i7TNG/yoPFNz6hyMHi8U+3HvYPSnSCPY39+hheHTk8/c
-nGzFT0LRhQsP3nc5ESPEfsqTNtqXJkMM8hU0KraNocojuOIW/NjaSox8K8hAQ2YoaJXp9XRSXJlHBbi7
-okUBAZHRsVsT7CTPIEms9dRGISbJYRlCmnA2rZcTsAtCbpr5wXkbY8xdgh495o8BZs41M106byTITmuO
-+2Rz4C/XOQypkYq+V+MWla0+pJcYGOtHcG7yjHquaap2tnWjnNhWJYz4o4wZkE3flZXHGgwLalFkv8DR
-KDrbthhQ8Yspug7n01ELoOz9FwGOvcsM4z4T1PdfSP0oEqfES7viAUIP/VPddEkjVKcqiSb8KrAFhDVQ
-1v/uWRYKgN0uX6bTHsSRawIn+KpCgx5ve0SgGsd5i3e5ewmV5778MS/NkryKlrlDMhOptqy65r+tsKP0
-1XGlVzocWirdOc+mb2anZjuQBAbN1qdT6GgE+mA5+LRovD7DZzp9NwHbHr3ryqBVOWFel6ulbztoi1FY
-bNAcI36Gz9x10DhF1EIdxtgn9Z35XQuW0Rw6n9DeiHCdl9Fm8fbd1Ka67y77cvjG8aSGIjjVLcrDqd2o
-Ammka9C4W8qS6CKNpEBwWaSieZNuuRijXD7vq9W+3iQ4YzUW7aw9ufn/rqNNqO95vAmL9XzhJtw9X5b7
-52Wl0f8aT/5aJmvfwzmE1FdK+kQa6ymop38tUWU2/5nZeGvU+jXqtiIIqkDzr2XLOyGgFtxJdiWtE99v
-SVL3dFpHbQqZMfrF6JfG1No1SdVlBVkuCJVu+SEz41tDb4KGAkge9UtjQhlYzDllQoq7+UCP7B75tJO8
-MRptmZF7e29eAzl7zDSD9AAjwGY33TW9x7wBcHyh/F4F22rJ+7GOXPqec1chppC0j1BFG7EZDKWdUQ8b
-70Z0N24V5mhNu69DlSOuc1z7kQWLBmphpUSQDc39u64VyteVCx+rIyu1ay6MD5sR4taCumPGLXzxb0gE
-8nih6O+FbQ+P720PC9sepsUOQ23VANTdDvQH0jQnCN61ih4OdJqi2B14WnrDvfNmX4UPUSy0GWdYPvyA
-jOcWRQeuUjm3KSAEwXkeiF1mZl7Z+SlBfaIkyYL+K9jB55Ni+BJyTIeHe2rhH8Jbf8AQDszJvIqOgx0I
-7KM8fD4ojtz1AgULAkZWw4PCTArBvyOMx9QG9dLEkh+QaHYKZraJsONw4Quw2aaTRK8YYKwXJhyCQTpI
-z8y8tMD4eNegoQj0jagwTQdC6x+WXf45+8o2XQKMeKgAoSdgD87MkvWrgUS1jblbU1qUKeyuQ8OE1V2+
-WBfCPjKSELjYw1Ui15Crymo/6yl/YpMVxXVRXZv8cBVcEYgBa9rPWgP6j6GNGyza+ahvRF9Z7UT1W5Ev
-tWyytteG8v6eDUw4p0SXEoNXYjxxiaIjCHJPdiiJJa10Iib2vGFm/tsnHAPKKNKfuSRsILMJcQHdd97n
-YzCeprhxEAAXUZNwio675QjM8aLbTo04DwxTxLIOMQO/aiPzrR2qh3aeZub5uJnxeJJ851IGSWwG0vwL
-tR/zzrlhYvGhtnXqw3PoJseEmArjKwHeOb0iQCEUwSJ+7VURQeLQJVKbKIFCKIgUTss08rMEf5lxMZ4N
-0qmVvOJTSNBgtG6JDVoepgEiHq4z4uEgAncIyFh5fkxyVEAOK+BYEZWFufhzImM9vs/M/2/TUBhUxYzM
-Lvtr1d59LB6JbUs/n6TnNcXllVdeTFA/GQkyQJzssFpQwjrRn2fTfbw6LibPg+z63qvPnl0fCh3svdl5
-u3P4+q3kfw1a2bEFiOjBu2e6SmNL3YqTiylegMaCvjE31oj0PXKchlDhHMDKULjYqcfzDwDRTME5RWVC
-g2T1nC+u//fsioJ1Ji/MQpmNBZj8aWPL/N867ryNDv7YoB9b+GOzgQ4qteTeZueYV2vt6Gln63jtnMv2
-8/7PZugwq+XLdFy6bJZO6Ys3rzl01m2WUPP6cYTFj7208IwRychmjKFBx90+eFhiRH/qso7i6CIUQJlV
-MDYQy0YK7qi/CZ4MN3YOTgesuXQEvgawMWgipIRcswcl5SKjBpWUVzU/8yqT9+3UAkBFY6F8Fdrwvj1S
-mkFq2oa26avu0sp14auvXH/wdiWwOJlNa+/j2Mi/fJrIKZsbAeAD7/x5aXq0Wtvukn9/6Ii4e1CbwJVG
-RaRZNl1SyT3Nm860ICiP5qNvvPz/xAjeTOKEsw0kp0w/nLF7hlkYTeEJLNu8coAx8wyVdz1SFDDCAWd3
-C0ijl+FQOYcruYZrgK8uPrZp5XC861ddu/79ZEnw7HmoICE2XFKbTnU53C7jmUVvZcYZ4wkBmuj2Tler
-s7y8gNEEjwy8Wh5KzVWHx4paj/uJV9F2Xhqlm+QcsSzAQleXNgpG/h8OHgdas+qg0LALNuDw5oUR41R7
-ayhRgP/hogBjZfVQ2uvqByH0BfwBW7wFq5h4QmBZ+gdoUAfFcwj1AvnNh3LoNaRIB/41p0+/qidAuC4+
-8iBLMpUI3Pw3HyRe6l5Fr4oclOy62GeGemOgCsCZoy0JVZFOOAiiWewS9hXkO0VPhTHhXbr8ouznVXDm
-T65HWbfkGObNp+02IISMqv1FkYHZvE5RtQqMClWNBwQRLCuhAEvIzgb8cOMLvywQ2VIS9nLJ2Smm3h29
-Q+BYctc8dBRVkWV8vKbn7NegE0mB/sp8kZ5COm+wKJt1jWnK0SUE5DR51NvheKqA0ETW23dP+RrF3rtU
-7rhiryR7jn21GCSJQL/z+Nn87v4YimFXz4Yc4CDYvqESaWGZNiwvRtJDLLe05LD8S/MX4eH0GWKVoe+F
-oNsUDFU0eJeoa7MXSYS5zsG8JDELbslS7FXyg1IFH1Y3X+lRso6WaM1i8M+xGT7gwyyz/YadQUTioo6L
-bZz+Qg1CwKKZ+3dh6dNZ0PSXfun+eOcIASayK4f5xbcTVSbUnTr50GnOnDb6wx4+NdQ+CY6nttSRA9lX
-XhTuNqnpRnpZRto9eugr1FtuElrqpiM6G0YNChKvJm4oMSOqlawPvSGVwt4wP3w6T7yorrvLCzuD3hP8
-vHukJU50zfhdQEOFdsXBudGnI+9I5CbUuQ+50i0vsa48h6GxRRjk0KfCXDjNxvLbp4sTabnEzMnE6N+h
-0w6bMeyzyws44TaXm0BXtaEDg9ZstTp9M73+zaiwGtTooI6jL3+i3Pa5OG4e18FyUAVbYaMWZsA7MWGE
-EfVoVJ8GDIn1K0wJ/+KMGXSxNnbqjl5Qn3ZNHZ5K/Q413uHVMx5XzXbitAw6i11l04RQYlzy0GSfglyq
-N60NMMZPcsR8GyScSpa2oCGar04zFzUtNz45RcxViZXZv2YZ+Dau0UJAvN8zUBnNfqe35FLCZGzqbJyQ
-2G1o5IjuOwu4a1DYkEUcoAlTssfDYr5Kjt63Pxwn09kYDDmm1AWa0FLGuFRxf3dY1BgshV+pW9jASVgi
-9JMQeYAvjzaOt6MroCIPPp9ESKJnD2rO+nErpBRWrhEo6y2vXKvarVtJlDvKlKhUqZMrtZKlTrbMky5B
-l6vX9nw1TxLH18D5dstxyl3vg+wAwJZmM3VB/Q3Xk0ZkJDm9xp46qaUjpA45U5DkNmYT81Loi7+2Q3t2
-xUFEGVlA7iH2xK1y53uXSzWNbnl7+Zkz5noW3A6nVqhqAFYx5DANodAKRL48nx+VYUcXzIZcA6NKvuiG
-Xms4mLtuF1m1/4fqAE5lVTmJ/V46M8shk1YbsQVENwuak29APk9K7gF5zeFiuOxI+S4iowIt2nERSeE0
-U3Uk8MxakyyO/WrSjS1eDGP3v4Ip0FrdxSoNsrPpm3SU2ZeuNl1NZY4Ej2mXFYxizBdGEslhDciCQM+d
-4dspMHRPlJEFdRW+UpqxhaacAiRG2uvNhnD3SPegkHvkCuzh5yRB4TNAUDxL0EZEI3qWY3zeIAO4gkq4
-R719jHOK3GSROrSGqNnY2XOwYdYmdaM1Sv6LYcd2MP5cM5M6/zss+6Bhyh7vbDSe9Z2bt74drJi69e4+
-2mgYgTPv2zUXvlXCnwoU618Abm18/bhdd79nXv6tXbnE29rc+C6GOHuzM9znRfq+d4Zb/KqZZ2apDoMW
-oUYE9iqAO9CvPMiCfJgDOjUZp/lCF4CvCZqqAIQYe4XLRr1eMaK+yxFuSNd7NC+BLDQkGHcAI+qzD9mg
-GKOczVxgOr7rTQzRHputzWmnhAhic5IT9xhD6Rz+xhkHseNUTJ8WHqXeZ+YoJIDWMLwUepcivA/Cl9mB
-R4L0RXfJ666tvbFjUIFbHLEdIbts7AC2E7LiG2Xyd6vqQZLRp0fH7f84OVl/ugn/2XhaydoJL5/AK/wL
-LINQrTOelRdNl3oT3h0roxR/DXR2azR36iE5JEECmz3MWOf05K4c+ystx40WPt3qCr9pjvG07SF4z5+7
-7DaN0OgWtFNQaZ4kG+CwBaB+5S8/Hz/4jzWCEqJSrUU6ADuQtIkHwbmc47EfrjJH+b9m4DPE19lXtPK9
-PjARcTBv4/1nShhYZqUSKOeHTD4Cx/UtoMAPbuoxKqqvz5qN56kRD326NoUENOicB+cKQ61FPmxhyCHa
-p/fPR9AUW8/duwIBPLFJOma/Act2an17pZks0aLX9VlGJtMcBH6mMdH9tHeta38t187bicLgS37n/Ra4
-5kjIP3x4vH1tlVu4SCjMHgqj3eQv2Zfapw1tKKurq8nP2aAHzqtwu5qdzkhS0NnNvNalKTIX00ZeIkLU
-BJztU/JcGH3IRjlahORiA7KiCeAxbQRTzAfiCJpPosImihxOFydZIykI1YT7sPREYUyFTBt2l13xmuBp
-oFT9uKrBX7mFeuHvDFub63+LbfoLwc5XWmM/21kb5KeknkCkK3/aPgNckm/hO18vhBEegmGsLRGkG6nd
-ELext2fdltE3gUI6JDsg5qwLklARqC4cZ80mQBdlXU4uRm7aTVQhu7LlWVslhISMJ0aUfqSwaUeH0xAC
-te7/6sLEDyE+pLQGyyknS5yuusYFzYJPATTaJO/3wR4xAWrIVCmeXgj7N/mAikNnSUHbkeckfai2J+kH
-I2YYtY/hOVwHgBqdkfYOvkkOroanxQA+scRxvNBlez3rfYHsA847/Iek8b8gdbO4KtnsIeiuqbJ3WFet
-PWjF3jAH1ysrPMKT0HN7tDkbUY3kApXeCTuYoMO+f5RBJ3ubRss7xTjXEoHO8Wo+kyh5zBQH6W+MZpBP
-8SeWS8e5GZb8Q4reE+oks7fXPBvZpFttrEFCFo+wZ6CrnY225be07anUsG8KwiEvGLPchcVIPqR8lA+N
-QuqNHmIrnQG6lBxsh8UAYSfPUzBq46wjVNuvGK0Qr2zHXgW2ul5j9GvQaUUGkFeTtQfmK3T+hSNyNjXF
-E9f2n0yj6F7acG5+Dm5MmkQJni/AlbYhuJf9/tqmPgN12Q2+rqucnU+QvUE2Wf0nzGYD/vAQwwDsKC2h
-RTHAu23TlpARD9gBhYodyiri4DinaCCBfpyrEG+hhOWLmreg30OwiQOPBJHgtUIO22XyO14t//HM4eRU
-5qZmTCxBpaK6rzSxK21un/POyD5MCQUAxMAP8sdDHoMt+i9uV20lZp4SI8s0QJSw+n1kiB5bBw/5IqsL
-y8uWzDaXcE9sqaNjeWnfmQXmXuun9Cn/7r2dDCB5vS1FKqjpPkUiX4rHeIsu6QfetXyWHaFDuK1ufnZo
-ZWtDQ5YxpjK724EUAaRIHDm4qMLErecwA5O7MN9IeT/h04BTLM/tQ6gECV/M7NAwimuG/NG3DercgpNQ
-Hiomgt/CP+mG+d+m+d8j87/H5n9f35KXLF8seywEuRsqPNQKIgf5O5rTK3WYVwfo8BOet4SRjcrKf+a2
-PWqjA71cYmXsfJ2NNLCSe07bALaDbixf8CsZMk2i7QRWG+8TW4w9WhotAwI1BtlI54MzGs7GloyCJkRa
-oXsiqSDb3i0lEti8DQGY4RiRR7clAmwSI/T4LoSA32LEvr4rMWDeGMFvPoUgrgZNlM9lKJpALG0Q6/ki
-yDDq6obIoWwUOAjh6SqH0KZjzc/WT4g/4bU2HY8HV9HmwgEfxVjoFGODCV0ttWJgofy6HXRm3Ta56tbk
-rRCQnosvEpK1teukZqW4tRIQCqYOHspS2U5OjZr7ftsnsXkLErhY4mQe3ZIMLpcKKTME6Www3Qru85dx
-Imkqfr2Jr34VvvpVz5Dirl+r3PXrsX+HrH6EvQqYze+W8Jscd6v3Wwwgp7U32jE5BzNuUcIsdEthtsNz
-RBWzG1v9PvoqvofqkwcfPKx/rz6m1J03DiVq+KMF+farLbbRonlX5eWTxaCOGSreVwaBZtk/jaDHrFld
-Ld7WPmErbvm6naLy+3WylUTOoNsxMryBVx4pGQP1lByKk8B9t/oULaRCiHmM7+OqHzyyhsXgnZM7WOK4
-cpdkKnjcudNHlcrnSjgeNBgons6qeLb7ZM7M/62siWdUxZy97NPZk2X5PZP+qUyK1y3ZHU+7YAgTcHra
-qusZ8/DCnWMtsIocecGYOo9R0fRCX1AaPcar0VVRXkrZmww4TAUfBOfqxbjd10k038e1lRo70J97CKJ5
-vsUZyC6OI3carzvFnI187U2rCboU2gTPRva1aXITxx0AZYNDkVdIpt2Uq2im5ALNEkXuVpSqQh3xl1NF
-sfBdaCvmgDoFd44WWx2JivoTDkfdgFgdOCgZGxWlQsUGxh+amsExNG52MVpbW+K8HSUj3mNUSJtAU3FJ
-5eQzB7kf4EIN80ZieO2ooMsyvdyIINp8PqhDtwUxiAg9ryDlrTCLhsusH8t6KSuHFspHETM7LSYcwTjj
-1lAxod17eqFlkWibziNqAY1TCVJPigZfPCsmtxdQO4PBi5iVr/qWZkCh4vubZyhR7Jy1ogN7gzQ7tnvk
-7Tfl6EzhTdDOIE9LRtoo+SpGkoSOs8LwZDIpcEwZrQsvNerUmbMz056FpP92HY2031c7RK3eZDuAN5R2
-jgSABM9L4EMGy2dek82afJl+jM538KrpBWuG47j3EVOeYwodnI/YR+F51rdTFqvM14LwAs1lKlxv2dnL
-qBQ1qHKRqD+KFx6MCv71bXKi7jBd0G6FO+wVr6y5fNQbzPoU0gw4LIRi4rxbmASpGHDDhiG5XYZywtJd
-8iIJHHQqfYK8ydKNhdJTBgT5BS+L/gFcfto9O3i5w2AswdsJym/FHzZFHkcURL8RSGfcSqLlxsW4qQKq
-6qhy424mKwU9umoUF0rjE/GbOvF9pWbTfFCuMC4UXNkGqWN+LfKRed8RYOz/Mr93oYXFRBn1yaOb3a1J
-fMONQEn4+ymHatMvs0pyvE2zLos2a4zL1AClhnSdTfEpJBaDPYXvw9QXcWPhr7mnS5JpZZiO0SnxhWqi
-4ojI66ajotG3SV9yxiwXPfv7dfDYIcuitkWalCJrnYBB1Tk6blmN6+HD3DPdCTGrnnm1VUyk1lrxYsZW
-PY6Gt0BtV8Qjr56HihL9P0+XC1hWSsRL8ABIk7NBCj6GOMQpORm6XZ7mgAQR+74RdxiJSonb7PAYNu/N
-JmX+wShZLTxZ9MD/kGAwaecDxzrzx2A3LSuGhSqzlMgt02K8OgBPPf3Gcgw03jysYZjqWzXspWYYLom7
-x+/Xih9UvEOkqlRXHK6npwwCGmys78sUQqNu4Gc/oZsaXWmtHfvqB4jZI/2XzwfUjVrAm2s+BCZQHYJV
-D7X0d+xwUVYQ3WfXWzt++M2/0yDaqJKA0225F3ooff7/exAdErTDaHQnnZ5RRafpIP8ta0qd1rFQehFp
-5bW+gZCbOiHrm80ie/YkIzwvcC5F4hYcpLrH0ieQGPx7AJ67kmwqAUEPSXM0cg5t5xgGZD7yQuh3ub6Q
-kRVEW9IfuOFVhK76qWwjZQZZVGGmLLHqzSxUN1vobEB+CI4TpTol9uqi3gxJwMjx0OyO0tI1/O9FWoq2
-t+X6qZ5GhLmwRiDQ4d+v/k85lcSeIusHjMmCvtroG+R8QBXVmA4JuwgxOAZ7S9rjXrn6WOcLDkfWfj1y
-36F7G4ibX4P1Af+0iQQPp4oVTFflVUdNhD7Jd2omKI5YXUlo5P+rQ+atoPHqZD3t6sLxvCMZxxDVZ2pg
-KZ4L/iambl1sdAoSDFdSbC/asW5x9kKdZir5O6DioJ+3uJpDZLGNoqHWsTcffYjWAxE5LF6ac9RW6O1C
-j2PbFfxDZ1g5Kdttb/5uFxHMvLH5lKKS+XREms1JRzoGSgeGylWhmweQ8porwJI4ourH5gzNhLboOW4e
-P2AztrQTVIV9hWCM6XDRyaA1/b7gF9quQW3dHrNfrPxId5Mrx2LcP3KND1+7VmzPZeMIw+5STiKJWWBg
-DrGPgUsvdYCRNdGjcwC2Gq0kR1m1Vugbft3hW49Jgq8DDzChJCsdNrFOdRsgw9Af72R2CA0LXZbZOxhN
-bXb2nIMYeDSPCjg+mPYMfd6vbGpqAVTe1ewLvhZN/i5wexjwCEuUFSvc2GtWPO3dyOfTMhucrVRNjmx+
-Ounkpc3krnR8+rMiET0fDPya4Sj0eeTsZ6l8m2RE7YfVp6JCOnyD+1Bdc9w4GLaDcdCch7FLtrliqII4
-uXzSm0FszqAoxpWGRjj+ABIKzwCPTO+Hwt8khNWnxEppL/34PPlFeT64HfpDbKIuWFGHR6JRVK75SzYm
-ss/vjSTZJ2J3EcpctkLbLlHZrnb8sQZQ50tcid3SnKIkyHQ2MqXcasMsZm4MsRQiMtFmR7kc1HBFt7DI
-9qW4VkcpBh0PN7W+0TNP/R0F2wu9Og2VO91bC8ysX8q1kq/fRR+i0uf4+qSDoRD7Z6TTzRVJajsgH+ps
-2rvYp6T0B5BgwhuKcAC0nwxMjGc9oD+btGlxDdyLVNgnrHVHRI9W1JqmRzvnYBqoYsN9glENNjs3MFzZ
-SN3VjXaycjjJ6Rzk3YYa3WKSpf2r5ByATdHA5I87FFteCT6C7SkBgDCjz/jJuu1gNfX1kPsz6AHDoCry
-ZjVszeErNwFblQ24+iW6tKofNRcDrwvU6rZGbI1Ld5gksBiORLi7NgDyJbWikYJTLvECbGDObtnEngjt
-bjDvQMip+QLZDvs9RZeSoRCBb4xyANrAZQ4Rr6DU+3oATIVdVJ4cCd7MORtWJEbksFgvDYKFr86GOLk1
-d6pMk9eOZ9zDFphd27zoBOpJvA+KXgd63awkNNHLLlyrLby4wCXIcXqVNbjoslPcWj2+3fmwRgwNpzG/
-CZjgZjjG8KLgjYP1i7PJzmBQzynmZTNkETMeaQ6v78gVfFlgyYjMrF4TCNmj9ePoZAYTyXfGMIWO+Gry
-qbNZq5Yhbqc58nVlm+52kuewX+EBGbcsyhwiNwNwqVsC2BNhMF5gbGIX4vfVBtdlDbaiSs2VR462skgZ
-yrYhmJ2JEWl9ugvpU2IXgAFETM1E8qJ4vFXZrhVr3W4rV2wQtudp5QmHmxwFz3F3D575BmR7rPbvE7zD
-SaVl5mt1p5dgLivnBlTLgCU8zSSoFbI5tAgrhR+Gh2YJRI5LOGzytlmvuNQMMfvwwhnfugBH5Nt1eHgP
-QevqyLsAVmzl7W0Anoov5iLlaVkxWBEC/CQ/PycIKXNUy9JyKm6ZRHV/amkRjkqfQj3RCQRKZ3jaLFhu
-IKiKmsZwYXU6nTeyLMtrt0TLxD0OI8ngcvF9Vl38889RbnVikhFK9w6wc+47OAAcA/QFTj0WiN838HnC
-Au5bDydGMqPJAi9kmyswznBH+++f8bN8cj/lf8KUvzBnGD3jOM7//ikHYveT/bknexcvc+xcm0b/CTPt
-5hbOUc70Q+/NHFxC/qLT7MwasS4A+Xpktp9U7K+5IEbcT/MdpvkA/W7sNLMbToudeKxvzdbmN+tt64lj
-fm2if8/jz+rfM/+SGc6kbI6r+IgpyBh7dF/QQwy18i6Noecztuj99U9F8d7eU089jV7C+0VcMSMjFyBs
-FFGAb/OrLnEiONZ1O2SvEDWO8AARJT4rHVwF2TOElPoYyMYOLKyp57woLFcWMLBw0CosjK1Q0QF7GArA
-C5vx9woCZ7ENO7PpYjlw2jK4iGXf3DGeZFBQnTD4CWi5EZ1xzginZ2Ae7nL9LoHNcNYVvoOxbUHTtFvp
-ElaNewiZ4l2MMTOOWT+mpIP7sMPM2WhB2+Z4bXsQwqQhiA1Hcdzp4DK9Ku24g02hcuNatS5N2bpU02rf
-LmDHXtsD7EPv7FCxbMRsFWIRLNU1hZV3MNupAAKyHK8xxD2PIB3e9fKAQ06OBB3yODnNR/3dSKgUyAON
-/sN7kwTA6uF2DXQ5QYDhlVWUxqAS/O+3Ut8DVG4Bms5MrZqsJ0X35Kn3i+9j/bMvtuAnxlXRwf3qeZPi
-Sn+vnEfTUwTJiZ0nGbSq5kgH/3r+MVO1NIgQRb7SNe153X/MrUmeavMZ/ENrVMZOzcpdm24isKcv0tNs
-0PZ6XTU415+g3aerZr5hZr8dRA3Bm5s/fl27tkTzKB2+BOUB7+oed5OmmSu9GSTp+STLOChUGI7Xn+I4
-flIx/XFMsRVw/iwY8SkGQJakPMF00g9mlmlhJQ1bl/xAr7bQ1rEjn4KU8y0KODCvnJsQPPaNN2SBtH2u
-tiGcQo9JENqiwh0hV9baJSBVA+byoQlSyEsFucyMULbkgjTmT8FOeTXqVecBH99yMiKWrROEjT80355j
-ETKcyV/1jUBe91u1jAl5s0jWMRVDsGekXF4O+ZgFG5zZCAHk30WhYuUF5D4aHcP6banI/+jgbmF/c5G9
-ZTFAzElP41VbO/+bZOXUaklOPbEJtcSzG3Oq58WsDOpLx0GDok3dm2ps/s9mKNQ822dWyIeTXQz6h0yX
-lxh/JkCdnn6MCkjqfBjISM8qLOC+xSxgqMYNgdeBpJ3aJtYzWCD8kfaRtMUua7PA6c6SbIWOyZP1Visi
-IpfcUSM4XHz9J0eSlBQTcZtjwu3jSBZKVH8TQTcn2OY2pS73oCGtNxs6bSGua1gE/oGY5eCt82yqcOl/
-ZKTMAj1quAgJ9jCDsebucbX60+SGDzRd09oJe5JV1JTlKmVKiKHyddcWgYukFRm0FYzFdd8MAfmxBTF1
-CPTJfFS9AfR+4oQcjTgmwX2FHnVAQ6JJ06wfs7br0YxQigxRMAr/vl5LC2p6u6QIiBsAlNnWkUILpUu+
-ZaQQHeRfsjjwynmvpLy1CkWrhG+l1t8zzPCiSsITm0c3BWRjiqhwB9+Ntndo20iUgz0GLpF+yMcsHBhr
-/ZBUPEMM8zMrHXdnGTwEDLQbXTdsdBeq5aOOOjKj5UNrmsMUs+x6B1/xG3YMpaDtYwEsqqD2uiD7jXtp
-Q1i06UVLPP286cs02ZTlO3rRAPdLBIv1WqS2aSrBZaWj9Du3GPx7t/Qrt23BfxxCNAyQx1Sh1F1mRjhK
-j0HChhNjHle1Wx+GdmWXXBV5VrJk582+WB5XkoemDehZOUreZmeD2ceO15wOXHqbuSZjj3LHxoBpoYjg
-mVK/shwk4VLkjj0Yjv78HciORT8+Fv1PHou+jEWq2AjE4JcYBiV1VRhS7KlysMC7YlckhZPDW4w3iTPp
-DaStOrYiQf/9lbbZHtCdYuXYh7uuCxZ0QapeNBg5BsU+WuMnZPWSaFM9vyH9XbvBeEJA+Z6r5juI1hPI
-3ZOZjch93x0It5IV4ogV11U+QG4he510fJhUV+zEnGmx6hYaDPgcVWHeti8GPF1t27VXZLqO06NHleMi
-6+aHlHyVS3WAP8ypeoXfrpiz9Yo+da7ETSHF5EjRO2Zdnl9VzwrX3GQ7qLakniiHIcTFYQesxAiDgyBX
-l3J+yyjOrKL96tmXo4K/f29tfr3Rlo3X/Nhsx/Zr8+Jx5A5joewZt9Q86nQCjmA2O3cQwYyP5D2oUVEV
-RL34LPoF2TfkOiL5EVxV8KBzKx1DbGNWxUCNokbzKJXqscR3H6H28XlVD3TAYSDyiPrhBXmgg9aOriIh
-5fHbIHcwi6gmMDtIMjqX+s2KEjN3U0LhXzAPfsXg5YqswBv0p4iWoxt+g5LTjGg5QDloDOg+n1v50a38
-HJv+mrwJR/nPUoqqY9ePjl3/08fOKkvE8p9TV7r7sHmMieKxWd1J7frD6a7snBEX6HQyCUxfYViE9njV
-BmZGwwsVhLCwVTVM+RUwoJ5naitWElzc6HSnND8gOXhV8R60b+IGcXjTjEBGKbKD+kBHM0DVaN54BKXl
-blIITc15juK2fQMd2mkq+QBXfos9lrBKCO1RFl+nHS6KmFamBUKdUkb8T/PMHLVd0WcESgXjHdjWUWvb
-CJWSUAyb54/mKiveNmEeft0O9ADz7Ju21SCMVrMR0XEWShYS6DhRG6AgxZijxMmJpMEyB4vfeQwHs4zV
-Y7hqgDGl9W+jTzCvHejQjjlWJlhktWd6umK02PXOo876Ck887EGx8+xcc46rc+s9sU6Q3bALu5qL7viu
-RmD6UDX0m2qNA+bWSgV8YRXEOXg54Fqe93y0HP6EdvFMnooUB7P5U9/hc5tg5+zrFLG+QCzAXcFlOukj
-7Jz50CliO3hfYI9CR5NdCv12kI+SKyROSn4pclhzpdBjTenqXBRXRp37EZfxEm48DUS+YhKjAkNED5Tg
-v5WarWLz3RGvYEsXBb4C1hOASVitWqmzTkKVgfrr0Oy4ouRWIfnk6bSpy7GDd3hcAWJZi4kRY2NIMQVt
-ofZhfD6quhHehFGL3D9SZEl/1gsvqfCR2rThFpcI6a2Bxcz77KrUZVsd08m9tHehvuhMcrGrCCN8PBXW
-qJW2fBCYxM3QBRyDeMYGQzR2fRo5vgangCpQQcWWIjuynlk5bpgJQuzvsKOqbGSAoLWRXVcCI9j+CaXq
-cSto1kwZN1uR+IG6eAE9uh/ASWeRYbVUfSXgphuTuRwWU38kyxkVsVN53Wrq9IvZtHSX/ywD8CqRdzKz
-S3pLxCziQF64CelNP8pILyJawNgx/ejB7djmsP6NbnCw/uky3a1dCiWjO03dPPEc8NolD6VxJ7qY/Lmt
-N99Z6WFIN8eD2bmRuadCQX43qXzL6wTnlaaOsNBjaRVpPXYvH00nBaB12luCtQdZaXaj6Wo/L9HZc1Ss
-YmbREhSH1X4KwaGu3ycn72svZiwpjsOqoSTNh2x6sIhEAVfIjuhohjgKkmAG24rnuZriMmKSUS+jM9pC
-xDt4XlvZO/g6KS/yofStz88vptNxubW2dm5k/ezUaPDDNSPh8/fZGqlYv5Z/ycqvVygpLmtyRyt9C+8h
-i3W7aumolr6FKe7zaL2eNmQe/q0daDzmGebLneey/M1C+ex44vcFl4pdAySDKudUI/UJOVfkNvpUiwdC
-bVrYxfQ3OrjWukrjgQUcLUwB3Cs04Cg8p/rmy4bk+RWN5itEoBNlvGTn7xWnZbFdHPUwfsFKGr8BrY9f
-iH7Ib1KIR11xqtiKFgE25BKDdju/ll01kCgWaDCD8asg80p3kp8v8t6F+wnJQktJmgc+/A6wgTzD2cHF
-GriXahxBd6QlVtZd4pesRiVx4+QThe1ln/NiFIX1KNnDz72TViODCKRmRRkPHD+oSpAyW6tjaw88RKZO
-x1D3dZCBh5RURrx0qg6UiiWbgTfpyHM4JrNDzaH/KMKG9tnxsefqpzvBRgj/ZqNVby5wClqM9wI5FOHC
-unX8b+DDkWsM7/3EiKXFlPl8vBcBTv1k7nN4J5oNXeR3mNAreZJsthOxVfKccCdxsRmy6NQ1Sjb1p7yQ
-7wW5PPC9c/HJugsIauuXpJT5r89eeJ/QlTg7nVcLuxDCb0RIbcUexpvK0GFxNBSvxoB5fiviXCjTuqW4
-QlZSACYRNsMwy4CspRUwAYvrAf/CHFORHkathbeAnkGE/KjCf9ePc/c0EL98SMBoRtml9QnP2zjFLYah
-qTl7mHXbxGLuNQ0WXt3F0VbKAGbFlEY8BbZyAKjE2DSA3rSl3RU4nKidew7oI0tdIhsFcXHYLYJPDkmd
-Vx2SJ/K8vTDEl0tLCsN4TuN7foDEXEd+tPJH4Swk34jXwYCpFfBP8kMF/wf6nmwlqxvbEel1A+4Pdv/W
-qD8hvwofzrGqcxHDmXGT/xwQIUpYrb2KhUP5G/C3Nv3vccSUzk2GZSLrir+BrysJzeZU0i2KrbFFmQKE
-IX/3Bj0Hzmo1PQXBEqKacMo6rBFui/7EGDVATJVbUUNJ5Z6ODZ20dgj4RNDnRIyrKGkMju3E4Gvo22if
-jX/ZTgtlR5XB2g5y1vn0wKB6MzkSmkKxXlOLWXTqpyEapRP0wpsSXJrYZLOyj6Q5kIXEdjayWuCqb/9s
-x0PP5EZo2R2snBtrhT7aaonXLYVo/prlxfk0jOXScOhIJfDmV5SzSdsNLffY276u+eQfO3sHp+2FskHc
-JkC43gVjmH8EU6A2S8PFQ1GSc4wbSDy2aL3Fus3oh9p/5ujYM/sC6dm4n5IsbOoW/JidNdHPJZRHQ07K
-ERp/yR2XXGMqET3qXcTaa0T7y/BT8s9eleJ329I0WyVcl9G9I0LEU1+06V6GOma3p3cxwC83AoF7uqoS
-d1Knrx25cix4kJp+fONZEf7xdoL95CXeQmbiGYd2yjfxfl3OLN+b3VS1j7mCru1vwh0Mw8D8hvqfqw4q
-3v/HjP2j+dF72LmKhmjdmiu9lYDA+R3mUnP7LAaFkDLZ8Zv6osc0uS3LMtZJdCjNLv+B0R5PkwWCFoNG
-uMrxCMYjKXAcLhQKcsDhmxtaFRlKFdo4fzRVMP2nMZEkao81AQAAtDSxb+pDg5PledGZt+KxT5Mdelg9
-GaCzj4X94NHV5fGcUCVztF5NJBJ4eTCx7brotW/ukkDoM7hc2AQ9NgME6nfyQxdwCOasAspPV0juDE0B
-+dO9pM0B7wbgD11LzFhYT364AoUQLQKSKmwYq+44tpYifADDYEn8a1tpYirvRYmGuuC8QA87vYt0sjNt
-rrc60+IdHEIhFYtZXg+lAB4ZmhvBecQDfaeSbc6yZa/BKE2azZdmDtQrxWhlWzfCZV6rzJW0Gr+7hsZL
-Gfc2D3NbjWhbDxFFvpqDNYMggVTDC621QdFPywuzMwC9YtKHU4Q9qZJvwdhQzkY9sgq6Hts7YzwLO8Vq
-Sr7oHIFmjQECLoFvK9Fo7jFN+wrmVVzG2p46TUqH+iTqvl5bIpAWziRhm1kWs0kva2OA2nZVBd9QuSRD
-a+TcXJJEN4klcbcfgW+iTyWWjSk+nysOU3p7p1hMPUSR7TIukdBeAzXakY8uCi1oiBxBbRDC1Ar6Wauv
-qcnVU37t8at1kkTBGCx/5tcPdud3DKqvRMM7eXsQQZcAdgd9hMcRqRG9ZQ9Dws0R6jAfZsVsqiBB1vmq
-3FajxQFJJYzIN031MjQViZ+8i06SjrWJbaFundGogGwQwK8w7kDesq4/tOHAOjyHxUZWrfEVBATL0MWY
-SgDsp6qht+0VOgyv+N8Xsxv6pQzLcy0ZAq8XsqqQRcWUBMnj3E2u+fDqz6Q5vK7jpr1QvrrwfNpQ/otr
-g/yUNIEGXx3UeyBW6gVFGzFArGHmIK9smDrC0eLJByLW0+RthonuQKEZQZbT5IAyaWhPkpIhZR9EAjiI
-FIIsmP0E0rhMKMF5lk76xeUI/vZMmRhE/6qYMkibmZWrYmb6p26fuqa1I8MRQh0C8/sFpNGkLNMXFG6S
-m00txQj8EXmtDQa4Cw5h9MEnZ3I1dUmliRSYelNzRl6OB2mogDCcEZ2RBMCW6DZNpXS1+BCYj1qAf3wM
-Kzu4P5sWvhsNi9louhVOdqcKygyJMsNiMM/AlDfwA7tNRLnN6Z0LQbIBC38KYwasjbOg6iuPD3jViLgy
-1C2aeSYeDTsMOXc8CeQEQADK666om63qJlybzuQmQGD96evolgfkIamPd8ScQ6b+4yGucO2eypROWMNu
-Uuafo3mU5xhIVDSocPuPef8lsXrdoNrVhifDKqfrsh6QczvpAZJ8dcjMqZyuqrIptjlRH//QilhAHDbC
-MDOziw0G4xvsLpYqqqT6vdH/MXnOZBbFpYd/w8w2ohmO8Yfj0Kjmjyb+Ckr4SZsUd/dO40haN0gdtKTF
-ylirNUuaYIGC9IhIljvJn4VQW/6/L39Ax2onZ/nA7A0gPqyN3z7BnUfJFpBYNs2XK7Z9ayEWOUr8yVKs
-0o85ZFzPNFj4PAm0qHBbW0ueI3ELjT9OrwZFysBO6JbViZ6MOG/j009vnAw4yZfmhAP0l33RckNWwEB0
-IIiSIRNK4rmDMWfisN4nyvSKN4YW6hH9JRzxCIiZvEIpfxY9pul/C/Md/NOCOZxjgEGjr9x2fOeyjiNb
-pRo2qXbCb94xAtyf2h0j+TO3jD97z1gIJMsLJouJ8YaOkOLzg7cp8MNIOZYrsdL0qhGLKbtx23F1ZMr8
-4vI0VhKiI+Ol4U18oxLv7TnzZfsFvx+r39RPeIrO1vIxePA3/QC+Dg+/bftxe5tff4dzuRCQ06fu/97B
-GKfhLAUEiStCp60egF/isZnOyRjjLNFZ5ohJINnqpOqNW7cNnwEHxGIGsK8fOB3VMB3NjBy4wngGOTna
-/KrdinRV2WE81IJPSeP3IEzh95nT9/1ZqfuiyOc2Uo2MG5JCNhWrgO/9Or1QPjESDxd6v9bkoI7pc9Yg
-x7pF3eHSGg/gH4bnSGvY/iJY9ZnVUiZgW8dInaIHOaM7yT5ZM7QhpmqF0Z9hvEtynLPAxjBSLoWRy38R
-gb7kjJN2lfAIqG+s3UkxMJ1Fc/oQGD9UC2qOZuC4OjxGnSkEMxgeRyoFFWtK0O4Ipj9wIW5MCXCfsAss
-RF5j5eHw4UqD3LNpxQdid3klvp1fR59KX2I9WVwpE0rxo2DImNFdPmDLO9i1sGKECW5r38KGuZPmIvoB
-7hsLwfD8771vDP7UfYNASjl+HsB5QELCNyA34mxaDCF8xFLGAIGu3rS7KDPqQHO0z3Fdku/PLrjLWsld
-Li66y3tR/X+mqAam/t9CXFdy1P//VEQvhCKytoaNe2fjaTu/lslG59vOI3kFUapba2su4vbXslNMzuV1
-s9dKNtfXv1vdXN/4Ovkv0//hVbJTXrw3o1u2kx+LHq6Y3UExoxyr+0Ykl9P83Mg/w0ZvM2gJyJavkr1+
-btZQWW2ToIydTbLMjHQ/B7+L0xlYibFZuAJf7h8m4AcygnStS01vYS2hOepZWmYDyDRihNtsTM9WvX9c
-cK+cpg5WflIUktu7nXQv81G/uOzKDnBq1kUJLrhGMnZ5WLsS3mVemA0A2AFtE0BHzCz0oYOUXTqEj/jC
-taCc+92TLtTMJSsWuQ1QSTU6T5F058Sjeno1zew+NTQS8wxCHppm1DBG+fy3fDyG+w1BeGHy6PH/BiJT
-q3GqbfAvkHfsaqBewqFD3lbDo6VxhGeXGHY0J48JRMUj5oZ0k9PTjDOMNe9hDFmRYDctqZLHAlcGuJF6
-cuCp6gM6mXJgLcbe1pVTEbjTgg5NqqB0uyPvqKTvBOuX9N9J581yTh482Nt9uXOAl7HJ1w8eJCNaCe7y
-3ktIw8Dol1lyYWhxwCJRg2veftYbpBBeAGmn9LgQ1f0SO+n1GN22zH/bqtzfs6vS77C4++lSzyDC3ZWy
-E45OlrogT3JAjhzQZSxepZD2SMWzCCsgA8wmk8KIiGzVzvpqeZmOx+Duxay664c9NluMgOeYLE3K9CxT
-pHmzVmuHdSH4Jjj3nWaD4lI+cKKpB/5NEAim0D9OWhW/JjT049bqF2wpp9bkpOlCtnCTOrmcQMq0Pnnb
-wQvbqT2ULnM68ODBq6IPAvzBg7Zk0DH1BNinXPWQfawyVwz6SVeU8lYX4OYwEdVl1kBERaLiCbu030fh
-hHrj+aA4NcqYRXnUN3qiCoJK0rAW94YeSbn7I4eJoCC4nvhapdOTnJpZ9SHQLjLi9HMiLzwrMolO+0oY
-yPAfnBpYOkKvTjr/2Ht7sP/6lSnbwB2yITNjgQeUA4xZswrVJzs7y3t4DmkiqBCTz0bnppullcJEjqU/
-pe9azUc6cT7q7JNsjGgcZi/ElFYUL00amWMNImaD+oSrISPTMP8t2/UM+/CHjeVDD+FdUFD0RFnMXjNF
-H4q8n6y3dGAXDbkEXAkBUzoZzYzY+yF5lGxV6HKw0sZWGCJWvWlQBcikbxvr2/Xt9QpS3qyhzCneb/EB
-qRH/zqPa73AYX68YDAge5VbfrNaOf/9x9ftm+5wNZ4MU8X8/rTGLkQpa5jmoRc45+nOco0SxX4hrrAQh
-eFeV08HVal5dd5CM2yjDIP1l1fAO2ktHmHiQFgwLtYKSj2a04SICuepRm9soJoN+VgJ8i9wy/T//1/+d
-ZDkwBVEz58HR1EjWNqVzPM2nk9QcCd3qTd15yRz/exesN6rHpPIUVmeM3L7dsErFpw9XXcvdYUrj3Obk
-XdfxlZvdxayQqP+oT8mhVWk6Jx3uaFMvUvtS+q3e4lQbglOcxKy++4FLY6/a0v0RIk1dtXzuGSVVvvGR
-3soyP0dDdSg7GdNG3qu2gar0HGWo3bxejwZ1CAxam2AUhFrPZi4E4+yQF8AbBmPRg3lWwfzOd5rjpje2
-+U/lKA2/q+DvMYdpKBmAB0CfTSHpOjsnBxgMAy4RdCceXjmIBE1DEXQyStgTVh/9UcnyRpxHBq9uva3K
-PRT/Zfilzv5L/n8rfuq35SJU8HQK1HxkFkI+ZbMUI/UKa50aKc5qq2Irq/16vvVqvbkCrdDBAIpqddwp
-nuqhIkC1QKt2pzYMjbAnOJkOe8sOPdxFIMpoVWBNb8VTRTeQQRadM8/5ZoGV4+ZI6Rk04UbZkBmPzd9P
-2WDMV1FO3rtcVDov6eVFhpqV3hmIiMvzyeKKLKk2l++EfxITUJ232QAKboldxSi/5rjXGRa/5YNBCpaV
-tf/1awGWzWJydraWld+s9ifp2bRzMR0O/lJmvdVpQUuJGRG6C2o4+NqaqS3NSOavD5Jvk//aP0xOZ+dg
-jNh5+3L1m8ed5C+b69895nF/ufPPk523b3f++2T/1Y97/zTD/zKdXnTGxWVzs518/aiVrCaIpQCFz7Pp
-C5FQVmg3qB2NlpTig+WL/L3HxFVtw5N4lnazokv47urW7TxpkNc5Hg8kNs8IEfXzydOwg97077o5ly2w
-jBmEPLMQZS2dgCV8Wowy3Oa7oD90gzO7efM+TbocHgdpyJDl8D61TCapiAW0z5izVC66C/LN6sCMH+S0
-hQVagr2RuW2cTgDh25UB9qKsuVfmtIbWALR+oQcQ6DXg08atCM6ybWHZ6oaqtl6lBlSLO3mQS2SOEzyK
-FYIIIIeaoeJ5ACllgUgeaQSQNDtBG76sMU846MT3EuINhHzLMYJRV4k2x4/IWLA9EqjRlo0q2ral6t5i
-ufItsztlUTSSEqFfUTcWFdROTqC60qQP0zH2khfSnea8N3+ueSSXwwkm2HM7vE4JsIOKOhJvz60K8pJ0
-mI1UrOvwhyu6zPoCugwqbHTQ/rvVH8ilU/QZs0ngH9tLXiPk7VN/gh0xM7Xuh57m6+puV/qCRyxTGEbt
-ZcmkbyEAWXY2hd1jkp9f4My6mEas/pZCsPu5Pb8ayq95rfaZUDFRCTgFWCzULqWy2IlTBQNEecT7mQiB
-/5e9d01v48gSBX+Lq0ihbjUACwRFuf0iTeuTZbusblt2WfZ136E5JAgkyZSATBQSEKWS+X2zh9nArGWW
-MiuZ84w4ERkJgJLsnju3/VWJyMx4nog4cd4HSwyyGjbJX/Y/+2yftRzR6KtFvNFm+aziE+FYRlnaECPo
-gioeTy5vdu8oM7N+y1XOaFB2dXmQW65xg0TE2rr8bTRLEyTjOLH0lnh3kP2rG8hbHcitj6QPuQQwz76A
-dXlIdJVUF/JAdtBXjl6yGlrmRuFCKDGoUuH1pkPD1iRCy31ol1hWS3FsenENJW+3SsuS3X63WiJSzvIH
-H/BB/OADoLOK6UTi/OFBmyoGF1qfNIaZOtDVQiAUJVIBaHpAYr8FkgzTyfSMkTkHWpA7HF6zhXopvtgB
-JtjvHxoahdDGLnCx1bhg3YKaIcAAuFUYwLSushcluocBdqCOF0HHP2ErrvdF3OWu79NcW5wSmFedDQlI
-ion06XKxQi16Xi+H2SOMOs/U8hkS2gAE7vqiIBf10yG/bVxgcxgbZvBv3mByFLahQJib5M6e4CK3tn2Y
-oidsdUANmyq7AWG9u44hxeOpbwCWhiRwPEtMF5BhAoa65itf5FoI4BC8whgYGNf51MJ4qtGs+f0toexv
-as294soz+dAKC6ZKuY8WMSmeE4MSLzLfWqpkX0fD4WSskOmmn2A9663gihcS714LV4rxVesReX47wDmR
-F0Ofi2Mcb1Ry9SzQ+v0QbG60XzU5Uhi4KAvc2Enetmk/QEXZDOSiSXuBk0VsO59tl/z9k4x/LkUoyY11
-C24kEbxKBBBsniAKNS5aYlmhLzjP03yEV0WZW6k06elYlMQC1Y1HHk3IeIkxcgyvcPn6v1Y4ucJvs8A+
-wF2o6NB1Ty8wW5GJdEhWVB2iUZdKhnVAj8yyHlPsZ0dHR2f9xAJLenRN4CePsuauSaYZuKR9SLGGswFR
-K08Yv16uRotJFNIjvlE5HOop5/G2fLVR7Lom4arzghtYcenAf3exEbzSQsJ7pkbYJ1YhgPST8mX1Apkr
-icDc46jfTpmEojBGeAThSNvDkCu4jRg83KK9BteEqxxkD6x0pE75F0qD0YSBgU9dj+EGv+DmpN2HOtsD
-pruO+fHkMKR4uZITktLjgVW+ye1qYkPd9COZmU+RYwhKBOFsVpVkP0G6SHh5BhM5g+ZzwFUsDHdZxwnK
-8+kqiL7Bk26KfQ1EjLaIfMvefmx8BcPwmPwh+1HUHIhQjluUE4QfMa9FcVGMszPo+IDgdAZkF5C6PJlr
-tL1pTGa0XC7qDde/Kse47DtNqZzAhC5zTg3qSXErer7FlLC9X281LQ4BtGlShlWYjV4Vs9XM3XI9QIjy
-e/ecsByaq6xYotpX+dar7WVagaZiV5WBA0qJ8JiaJjMb/8XIFESRyIUMT+FYdDlIcL8hDryrajg9powY
-G9fhQ/pwkMKYsULs9sJR0fxmLBs17LDTCn+RLRopqjMPIyrUphCLmKDtBIZvQfJnDuxWQJOoEU7QVfoi
-XF8MtO7aOzKrjUunM7fvt4NNFu+icK8E4GsXDbYeDRjJrY9GUb7l0Wg9Gf/rHIzP/xc4GJ+vPxgt5+I/
-/Vg8u1pdXEzzyC7Hu/HMqkkONc0NCW+56vE3lK1o939QAu2aGzrpieI1L4fXxYtiDpQ/q13xaY+r/D//
-x/9JlU6lkpwxeWoxCyXLiXx5y+0dqEKhesADUZPc6WRbzcggWwBDsAWvhMWIKsUf1ax3X7ZR33JGVAhl
-VPzJjcarSNwbLOpOV/iWp3ZsBbXBumvpcOVHqFTNPvig/OCDjMco4lMVqoa0OzIAF1K8qMnKXcgcjIZs
-EgKJfJZbNPozEZ86u44z4lDOHAPBydtrzKG8rLLravGCPYOI2JUdwmOOEXGZYKdKhys9L2TlXrdht6xG
-8dgtqEePKKvvpwDv9jQ1JoE0YSOQKQBQW/i7jGi4Z2qFzLRlt9ZVoWBm4wXiJjyKQK6jpHhC70uHslQo
-sVh+2RRHvKOC0huWIX/Ra+WnWlBswydNwrVyHY9Wsa5IEUzkBp746GAtclbspxwWAcI7q6Dmb8AC/JDn
-G8HE8eNQu7EqIDQSZF1h/JXUK3R+z+OAvCMglc5x741SFr37h1Hhz7nweaqwz7kRqVloxIyIdmWERvZy
-0x9kXYJRdwsTPZdKcXR5uSCxada5XFSrOeyvToac4Siw1eNvZoOd5+jWWS3WWBat2YAR0eSjuLy3Cz/W
-arKeoWUzVUGmF52aRLhxNrrIKB9GF27iso2No/6GoKu3OOPD7EfUPrARLOVa4xSXS/HP2lErW10osXdN
-28mT96VrmtAE1SM8Qb96xqGtMVGDW0/R/8aV4VA0/ETKlUBZwOSb+YqKjZcumPhN30uXAPL5NmAZwB0z
-K6YjCtx7JnM4G2TofAU7mJsjT0kMq4hKOAYEPOlFLu2SCh69dMviH6tcJVRQ4HYwCWfniDY/N7Lo9Y4q
-bHdSBtaLuoQ40XyBgoR4H3BTrZuBHbnQJ2DzPhCBRbgZqPJ73wz37iW3wH4IoGejC3Q9HHvzDQxtOkXl
-KotzxaZTwl3SqRWvvdPhsmIvrHZ3orv0LAdT1WpqWs1kXyB8NlLHUOjaJBpSkjQ1Bo+vzYiwSPGmLMDF
-/eFUThR81Pj/wO3OgczXOU/Flsuh6DdNQSs1c2CVCoZg9iTKfApEmiUQ8UKpsuW12NjVB6Rhub6qaqM7
-QxVYDbdIffHau7lzi0b9gRRxS+VJxWSnacNVFMHnaLFkc78/RhND2mDK80bqSqOkXXf/vFDthruCmmpX
-X+Yh93JAPfQ3al6PsTQPJ1Rr86FYb3+pN1K+NEJNlYswkqIFZQyER48ZAEKci9gw4Sm3JshV9GpS3+pW
-rvLRRPQqGFL3bEiMwQcfEJkOrY+v8vELbquNJ6Dz5jTu2DlS7tgw/cBm7RagQaQZBcYv8XlhEuxwLTuh
-GbSxAZckwShXyEZHu2YwOH6hTGGA2ob0xduMwkOgvhKWY/FaldEC0a+J/aIYF0C8XaymWWWcCX0sBcEc
-a1ZQNfRu4WwzNPr8Faq1VChAQ3qq1yWbIm0GdhO3SsGIIYoglQA9HJF9OB/AOYVQ1G3MILv9LuZ5tW9i
-mjAV+lO3VgSQ/Xinwc261GGsAWTMaK7Zc4IHEpvOHmMgUqZyjCeLaq6G2Kl9uWFLwgI1VsTvQZxf9rR9
-Reg7n/qCzbhwOO+yI9dsuQCEPy+KWYYRbfAEoWr6dShCKe0wyT143Nw7bUosGUtETtw0vGJD43hWYi1y
-DNiC5NPZxRRIxLw8c70qBycf7HiKcr4CIq6+IrQ7kGQs+Bfu1SeWiyJX19USist1WEyMhjkQKfvKCE0r
-YPauCdRxf42YGRtTQTMVNqLmmDATne6//Iul7+QljOB02IzfH8TS3NtT0Kgj8RTOCNkCec+TaC8H7Pxd
-AWDfDVka1Ms+ArBhNXGez50cXrmJhncXg17P9j0UXpj0dtdXxTTPes8ZmKFsmWsew3Ldu+e4lePn8JAQ
-Ivugt3dlpDZ3QqqlUFIRmuNT+WAPfyNwpiNUusuGWRy3hwGR9IAxksB5fWJunq/EioeWRuiA5oaWFt16
-hKIJWZSw0IBtTJIU+igShMt+IPZqUuUsFRU9MIcoUQkpQ6dXi6hbI2FtxAWT4kKiK7hhJi0i9iPk/iNL
-B2HEkxXmO8VYDxjfJTkBikfgp3OFblWcrZUbO88xVTYGkpkMiKe+0sAq1VxxjhjAA/A40OH0sgL28mqW
-MK1hbluIN3wgnM1vE4tX1M+k63bxpbrtfVlVGEmop3XMuXb+9a6ZhIhJ67lP8ozDii3OAsWc08Rt7+rh
-hF3KQrCGgzawvmrVzXnUyftmS9TJBEUReJom9F5w2zUEY0JP9NUQJsS+Au5ICHq3QIRLc0IhqfakAoIm
-b4P/CQhi3ZZBRjq2RnfeMquHrXhVahQrGT9KalYtYLVs60aXwo2m20BWEiVmams0gSsTmjp3okuLdZwl
-Gp5GOEJVecCOSxjFqYCD5OhgpkjIpNWTVj4IBvPt7kyGHHQDLeFh7Xn86dAQWu3xv234KD16YzYGaHhB
-qX6X17gRhDcRMUFyuCTMrkUQ0YZRW08cWmR9t949/H2dQpqfP4T2BCW2EJaGHYTvi9KfNzeY5yRGy/CW
-93OA50SmXNO69zl/fuL6CDId35hhPSd9hG8+PLlUezs9888a/89faG6J8RrnTYG8xMjIwDjmil9r9MNC
-U7LAJHuO6XjZjpeoAs/AcKPCU6DzFe8XM4QNu2VpSLfkLvf5w1uo98jQMFKFRZgjCrYSGQj+b8U8W1aX
-bJs+AwAXqAtFtVfN4jene+V57+5Grgx0skSsUoog/LJybTJw/lnMN5x8KBGlDjdGdcqL4OpRY8PsF6xB
-oTfmS+u+LdR0TatOImcVcSP+om/d2k+hKhU1wMiBBKoFVUUDbiyjCf2A3ZLJNDLIskDuCKukk5iUxC0d
-WQdEiOEWRtQiCHcaflWnKrljjAQ2nSoyYsSYTGYXiJllQ3dktwbaNB6TxJE1MWeKXuHYEHmNklyMiTqd
-At8jyyQUY40pMlWosEuHF76wT5fKbu0VM66Ah6rnVUniI2aPeatV57GXB05DxlQnEbfqBbfAyGys046Q
-nXlS3bylKUeQzwoHZQy6bmRJCOsc36dq+rR/crtr/m8cX8e6l6JihxUjjNrErYqgjU/fAcnNby6MsDdy
-av1RBc5U8hvcZQvr4toIb8S7sV1svr3gvDUggVya9obc0iPynXxaQ5cEuY05AIvqf3lkTg4XeDRESnjV
-zge+iz8ZnSvfRTyYqnQIkGIMeB8zcjnxEEW3k53MetFlR+tWkq8hLu73w9oqxsXwl5otwGZ45uO9d1Fc
-rjASnsgG6xliBTcjDJ6IvlzuXpFTbYJ3lHVOPFRdIftHUZ8LZpEp/eoQu6+z86LEcE51PlqMr7wVS+5m
-H2/Nd7Jo8Rd3IFky/ujWgKy6ZjRzVVy272CRumDhz6lkSPzNCuSwSD57Ma2qRY9K3pOSe86nIWQsZXtC
-5ZM+NCtSLB4QtngPSUDCRzI2eJm4O6DC7bCMuIYQjpnqfoLnNRgmQiwGd+DLQWZWcwPSYVeUYvIqIp83
-Ud8GfuIdQ1JJ7xcTYwFBM40kUrgVJoJYHtLPAy9Zx8d7MgoYpeUNU/lz3HBNi9xWUUpb+95p/yCzzdPy
-rmM97QlBDDh55YO0BJMl6awpbUFtZhDoHaASx3givkXh0LRGkv06Y0NGbLDRc7AVek2Buwtx0ieBd/10
-9LQ5qmhJ7sE6pYbDIUcm4TVShNeIb0ugZm6NSdudkYBK34zt9vcDs+BVXSyNRE4cS8Ysex7nosByLlUq
-Jt1Rr/bdffV84zIshRRHtElDX8J2nF7aB+WnQM7nzHyx2I9TNS+WjKAHrJA+Q4ZHKEWOh6pinw8+kMC5
-KSSumOQogSb2B/aGG4QoX240i31Sbey6Rty914/QXM78zjK/zCUEs3XWIYiiqDJfFmM0q7wEsozCkmaP
-MooJa8lZCSf84+vlFazZ2WJUXlJYV6ddyZ7laiCN5aXgRAJ1k47GGUnD23o4pxJkIz0tzjGm4Z6PjEdh
-qagTkRzTb3sbknoFNQmYgLpe5nMrFMW3XgGp25nfsmLGsDuZvHFqHN7J2CaVhj9QeF94n4Cmc8iRfozz
-Ytrjvne5TbzgaGyc4lnr62TW81YT5azic3rvnqim8MDaqWfcspxVLqNTCuhvLBVqJPRO642u8HhvDF8V
-e6HWzn0cQwi9yscrMmiysWJGlKsYThPmNHDHeATHdjGzJpkuL4LLhuDY7h2Gn7T/ZbUiW3O/JygCHwdM
-PMeP34TxZxH1wtZ/bIJO1oEsvReWsCGWXXvGXMp1l4g0WvetdHuKh9gH4zMjHcah8gLGr9EFNhW0H0XK
-FI+TfsocU4cNTYTstJqfuSWgyVLoMPEdFuq2x0EsyYMYA0ufMe/rZUSyrKQeQVV4fwhbZEp2tUQCx2HK
-u7ViljPdchT2+wzmJaT1y1Ex9UZv50W45EGE4cAQ3scWh7uOPFK5LqIF9y2KXoivBNDc8FqtUyrt5gXv
-EM5sgYEMf4aV5TzkXRrMDGV15xxHNp8QZ+Rm0+2H8tlsG0fg8/gUNCzP7XGReVElczI4pSP2iZI5oFh6
-qY77fRuwxrVPjYVib7RHI1MIAmawtV7bYJaqliN+EBVwVyNkmGZ0/RfqNWsTpOS7FwVCbuDS6oyvRhjw
-Gs0j62zyuhzN0AOVtqdOcJidZiPnhEu4aD4djfOrakoXPWlA+VLEO3KG97mzKnCdc5hsPwRjexdaAtEm
-MHIcAtCjdQu6v92Cki2eEk4hV+D6iJTmZi8lHH1aAqamPd6wmePixHZGj3CiToHW9CJ2HSIqyA+CwjGx
-KMyjm9PnDVVEn3clCd6TPcQG6O27nTe53+pbbmY6tlY877h9wF4mtiftYTXx+YkE70FwtB2XXsEn4Ae8
-M8t1Z1HXJBZA6yFcmLysVws2PxuxtY0LP82taXbsyhjLUroBzjNVLD3WfNSIxGK3aDFoDxY8CEMg+ViY
-+xbRCZLTrhTPse7Kn3+acNf6mRWs0GndeOyxYIIGu11U+eC7PEeNmHjSGj0vDo74fT6r0KwYo+m/mgO8
-g6QZgKwwo7AiF40KRDCdSc3GZQRozMSA50S4jaLGmlzii4zGV1hCyg7pOTjEkwkS6FCk2wUmsMfdwLnj
-H3Jtud2twSkOAncNubDQdJ06GGizqHHDF8fyfCKDbWk2OnNh1eBYBfMxomwT5Q4+B2sCFAPpR8IQyD5D
-mD+JM8DDRZ0Dlp+IGJ6ybuEhkQNSLFN5tuoVx2sXxRj211zH61GxvHVoDkdjLX8uZjlcTyZlUHQtG/Ai
-nxLiJOk+gssFpjTycAH6BIA6WU15g1LKzRVggoulZC9SQy7KPgak7PgF7hZubTzNRwsPggsJ3iXXWU/A
-Akwm3047gYDXjgFx04AdTzjMCd3NqHFHnSWggOWiuLzEFFYoNcUg+/BlLMzihPGb0pmcB4lkAgC+YfaU
-eIPp6wFNB5HNcjm16IS1nKvS3eyzFWrRyHR6PCo9lYCq9uyyws5mkka1pHFkGLH5DKF9hqOhe/+Qm0O7
-UDgxr6tVd5Kx3LpCCwNKzUrKWLpriFAQU9p8RPqefHKZs/yAWzp7I18O2KDmBo2/fVuNdpYLoHp9Q5Ni
-Sald0dhTYJDerwOhvQM1UsCWDAKWAD8veaNmNrK2zQnlGWP2KdEO5Ic508Qbj5ZhDPtAcaINSt2hwgtp
-CAKMqD5Oh2V13XNoJjVE4y9+sZ4Fk4FLI31jEiVH2jcaIqcWCqwkMXQ0RA3pxTPEQAVtU+xbQJQsodam
-F45sOKL1JENw6G7X1XEd+lnYFOsyI4eZ7PB845+jCPD33013XwToTms4kFnZLiEOxW5a4tAUSK9WZrLn
-pQFwmxW9xZqGRks6OLNC7rDd9UvkJ+xnY5A67fKBh19bENd2b8h2TArIi+g3QWJiB6NEYohiy0qi8Fg0
-y34lIYr0HCffDtAyCqpq+IAzl2/OjfBpcLWSoeRZMZthVINlfkYhV4muG2ivLMPVLhmLcVMhSkSJCnqr
-iNRXQa+3EJLA41bc5kZgsZusj2I3z9LCe7jyZnOP8bZBUvxZLOvphMPhc225TPxECGOxz/mcovoBH1G4
-vu3ukQOO9fqtWva2w8R2hjFAbn+MbnWQGlFKDtOhiVPmp9tgKQfnJn5lGnk6fUrI100cAa+DT2P7TSsQ
-YHDpoWkasR0w12OhndviBq8XcSdL2ClNCCEhJCQDHR9XEcI5mQZaLqMob0JmYoZmRVpxXE1yTYJLorwL
-Agz83NHoXpxIgKQ5KtglG2g4+IX1pRcT78Vonji/lH8utrrw1KZ8Z8OxtL8OZj65pGwYkS23t46MvCK5
-fBYkPtFwqC2a2IbszEfpXMsJbcLtkqpFvNExZ6vXfvkwzk7/MSBjMLcC9WqmPmjqTGUTeoadXFTkO+id
-b9CfNIHqDEsTnUMSWYtKZOQlSt4QZT15VISqh0iiTUIk+nyygbcU2VCxu9uPqhcnzH9xTRF5H779zSvJ
-MC3PIjtdJCsTw1M9BUhIEm2MfnoR3SSEwmQfb9xkiHJ2d6kKXCT7IdqJmcQ2ON288xwxI3bls7iyDhWp
-msSEBVesn7EKPTZtFzv/yCBBwrNvPX9PsNYimsKqDY4hDq2/PdQswDwfm9P9MgC4YQhd3AtX1TW3Vl0s
-JRYDsd/FMhDtTUf/fK0B7Yt/jjwCrUoJz+45cYY5Cxm4bdb2bFLTSWFKwwro8cnXsMk+kylVmHPb5hg6
-f025ZUjcBoWHw+GZSFSATp8ViGPVhe5qVH9drmZfrpBNuftG89ceEKxvXETNJ1SKQxb0ulrKJ/Ypq9IX
-wKS25GPOsVt+uOgOsm5R/6jaMX7hGgnTgfn/uqnOsWaYOpfb+q6ClcmlxROCrTfuYSf/pzxGGp0LJxpw
-1zKLJ6SubU6pEXnLKEEzDjZn3ljum3Mehwn1TFHy9DPPRpGIrN0PkjNYKDS2mnUdFxSyih1WKd7n0HaM
-F3jXNN21qkaUHUpogWpOg7Dm3GwQS5/6nPCGJPf0QoYieN3DDRG8lxFQ7004HvvygQcBVSg4WxeHBzzG
-V5yvnkAiz+sG6tFOY8gBjt0JTFkWRS7+YCzFj1QDmARBdmORqx3/ttrQH3yu5DNCCxIMuzXUh9EBBxE6
-bNgPn5U50nv+e5i/yATfbnhkCXqg7qMNwUFQPAS94Bk94a7QuI1R0NCNyGOS/oYTF1wl+CbG3Lwa6tPv
-8r81l0Yu7un0398RpNuC6T8XIibCQbRBw82pcVrWKIna8l0FNjGNtIzO0nRjGqYNGkhnkp75tC1orZ5Q
-83DRVqbqtnmwlNK2cY+flMTuLZDrh+KcJqsI8jO74vTxh4bd//sITmfWJR0UP0suzaAhLmBBbuAh6YLU
-Kxp8K8ePrBk7/zhOrqMpskyQ/Oz2ebJaxG9Rrix2HjH6UvEiUi4sdBGx8avp5x9yPrTldzke1AafjmOb
-K86dk9RBoUoBfJ4weHC703yQBkzgDxYyygdV9tYYJRDJWWevU3Br7TBr+LisA2Sr/8t2qfXEYyWASRZl
-gN0yoPJIrIrdrgkYcb50nOmSanX8/RO5hosVAd/0TgpAEFADg3YIcmcb7iDLcQUUpWrM4XqjhuKLKoAH
-l6DgkqGQ5utXwOtMYnMxTpQQ3sgFxzLxQhsu60IE5NzSUZQVuedubMcD8ad6bachDaZGwYnOuUkyUK3R
-QhXDK2C1IIUq2q3u/Zqf7/0bLC2TbXs/5eJUufe3aXU+mp4ymq/3+O8eW8z1zdyADaGV5S+pmb6w02wK
-A3FdA4OPrbxpGPv+EdHBmqfVWIy845F9kcrR3EyuwjYfLxrpVNwwbpJneFzNX4d3OwtHjJk0fgKOZZnj
-QYfjGhNN86KRciLH2DHVdmHS4caVALxa0ddTmq49vJ0VcjVPtosXEICTl0oOUxDtN50qMDERAWkjgRlh
-qabfMKnU3J/9VH+hv3AQOs4spWCzw8yFSWXQGSLdIq133HqtKcKt61QVZIOOnJjiyQQRIrMgdMzaa2fT
-nlUbB3x1Ph2NX7Ts1WpW3Ib+jDdUYj+ZJXRpx1yx5DYJEAYGj9xiwwwyltFsuXMSYtQm5x8EsU2sAZ/s
-NhAFl983KB6ktD/Ni0ii9zSWQt7Xay46CSgRWmnXMdoP0797IymfMT2gOp5cuNTQo6m9GtEu0RnckxUV
-J4AW0SdUyieiZ9qRMKo5yQ0tUz1uTzXPspa0e7MxjI+t4VXEAxXN/emCRfCnww00mzNw70mwo93xtCox
-IIuLEpSQEFCZt5EPVJrc3wdFNNFWJegoB0Xvk60Lz6snd0Ec7wz1/TWHrsZYAphFUC3aaHc6szflPLH5
-HRd4fr4oZuicNF8tSBVEqKNQy1XSSVVZZ4nMK/BBHZ/nanwFx2XgwiKSTxSWhR0DmHVmgmNnlYTWVqWs
-cpQ4UKG5qDUxYQo1hHzE/PQcjP0rg9pb7DiVSnJJDN0pobBOcigwkQJyeOn0REX9PaU5TNzmQX6iJs3D
-n1tuGHuDkwAgusTvxsxgfKnlLlTaLUQlLXcXOfThaPkOQiGps6L9/ffsbs8wDbfKOrgTRufzkfjYvbqo
-qzK05zwr6q//sRpxykjy6vmHhfxokJ0D3J+h0SL8pL86P+yIggOOoScJ/EAILMcGh9nZfc6+c1+iYxMm
-g++o8ii04lCbepazkOz429FiVpWvszO4x6ZnhFuqejR1bmuYymOYj2ejmkh/YQlerIbzq/nDYnJ0xfUP
-sHrfS/Q4Ev65j25JUCf7q/1sTwLl469zLxqUCHkWeOjgmI/zusbDfJ6PR+h4eEbRGqH+qhRT8LOwYxfO
-8byx72RcrtNfStLdowsEq+MnLqiGadK4RJ32KYHAaHgq5f1WP4/KYS6B86icBE7BdTs7Pn48Bf7l5ORM
-eEx3GMb4/umIkk2q9kesb80V4UshbMNy58mNXANugrPua/rDg3uC6sMVzLbGpGW+XE3J92wufpLwcoI3
-MmPgcw7BxvuQl42VahzJUJqm1GrdY8EEP+WXX7+an3QPfMf8SltB/Ee3Lkc95xyyZk/0nlbL/IANwvdG
-ewWtaBd/dfvJHnlevkcJUgW0PqoBar1LikUUv0RJGjbTcIetgMnBcTokJeEgO+t81EHjMNu4L0Vh69FT
-gAfRg8L9s2FMqdFceGvSz/PD5ESe0rpEEzl7Onp6Fg+OUUBZlbuL/GKav4J5Dm0lwbPoeo1nLBwvvHVl
-cZ/d48N7b+S21L1zfnN+aBt9VCoWCU+w3J2Sc4LjPJGeqxibGDEBQO4xMO5TKNY9fSR8AcSDPN5rAdNX
-sEE9kMJvEjQwguFj2nS8s8ONDeDQoc51x+igqaPm3qetZFs3VoUwPwlhJRk2MtzMStRCu8VERkHkzpqa
-tgO83XEX0FDQmMGvZxtkFXY33j4QKj/i6D9HFgHhnlTo0fcToxm96yqF0jYJyYAbJ+uK7gURsrw/t+9j
-RLUT7VKBhUbyWlr9b52YMu99USbCycBrl8Z4VvumiXHwTV4sWHi5cMeEIPKY9dUjq62G5uX1eVOJLRcG
-FaBUMfQLtbAhV0klSI/MZc3FMZIqbuFa/gvaO3ftncft8ac1rWHs3EDxzWwdvG68Pe+nrHX8/RIGPmJx
-JZweJlEwIxqh8tdjYAMybni1wIOEJLuLIkqFOGk9WelScW3Q1yJ1/mQ0R3aMVvPrZ9lHw/1MgwPuw8OD
-4YdwUZ1DJQzG7Gj37OzffgAk7MgqNUihdJ7kMIL8wmKENncxQYDlUY84QT6J0nrWBRqvXOcsRStzZiV5
-Go5OI4dhOlweN3KDTO3hLuMfcEpUqH2un84bn8JoZ/Q5IL01QA29srYGMP7vgGQiB20K3JD9yOgZtwvC
-lJQXNQbiJVpwIVyzWFs6NyTfnARzLXMSv5hltSeCRnjMw2HvSX+bnDc/htgJN9JkksWpWJ0F6IYlE/Cw
-N2Xfgpbfnfe9vchPJhCxIPXEIhrasIn/DInHUTd4YnSXTIIM5fCYz9vIXQWfX+XIydW4JRKqcb6jqXNJ
-Vhius1xMMoFxIdlTcVmywx9SDP6+s9KbtZtKLoP8H72RLiOgSf8z4mjSQ0zmgIzHHSxskiu1cvi0GtjA
-5Gv0NZX79xwoE7fWQWBnvAe9/52RH4ldoKwgwI8WVLGdPQAyunMXeRCXbN2CrYF1DBNS2s9yHJ8rY/hg
-WYXDcK3EhuVchIeA7Wn1WKdwLn/jdVu7cP4IzaqXefO0Olndtue1mvei0+rfJJhxJutFTtM8WxjzCnON
-UACd6yrBQos8hBj0mCuPzFoRVvg2lFp5mYvG7yaif2AS1Oez+fL1wx2llzv03LFim7LKcmcFhrq83ViM
-CkPEWmukdCm1iU+yn0puFEXQp1cSP184F/vKh9QnkYkVAur+JvI9kgjGiYZ8oRQMWdswyr764Xs1SXmo
-8xcLlTQEVMR0t+ft44ZlNckxLAUzEq0LJ53KCspCBfZraL32kTdaI3gp3GR4mqKKizyRZ4DdmtGG7Dt9
-byO90+NeFLRhnAxQYZW0wAl8iQgqSprHctylwqzrAnYoIc+vlbpHIvduLKHEa5siWxQ1AV8U+weZ2UOD
-zBOyFAFeDk1RM7+Lv74iLWxRs6QAf5HnvWjPMRnTcdc1iHau2iD+VuvZrMsN4i9sEP9yg/iLGuyemDC+
-pRGQnB53ixpZc3x50gLNrRdTG4I/XWWn2Cq7b/yekUzAIPsc/CD2AFFRNtBPi+q6RgEFxTFS67o+uSdr
-EOAlkapFjdJAFHYBgzCHkdCW6TjIdWhdEapO1u+PuomIokCx3zfBxNhMdsnzLu8GfiRq5C1KWBSV6iKe
-EbU0Rzy4QFH7MPsVs0ONFhQ0hPaXMparSzJ4qKaT7OWn4hQE8Njfz3p/2f/4AarVkIyDIs9GF3Bmsk/x
-w2cPPuvrrKWlveEeMal+18MGl29PyuWnfKYDNtYDxUWW2rRP3JnLGifMyp8tgJ40jD9GGSWWVhpFD/43
-/HItjtRS7g64S/H4enBp1vk302okWp4Y7XiVGyNMEj88zFCgpA4/zA0x1URRQ33WDLpuMbhEPr3o652G
-VdeOlQpRe260FeVFP8ruxXgncY2ISEehI4Kg9V3ywhzR3Ylros/stY1ocMNBd+Km9aNjgJCsaTrVET5d
-tQYviYan3h5rLwYnLNf2f9EX23XiU1mZhOLAHo5Xy1DHQVQWBWe4aNFIOTvlSbGAb1NJPYIMEO0JIE9K
-kRNeVwuMcoG7hqJGeVUp7xtsNlarBdk63Rzu+nT6oVuEWzuvH3cz/GVZkNxig8+JOp38tCozBOuiHgNf
-MHxOuOiDsnpclRfTYrz8gHKsawZttbQ5Oz3zy0QBbKQb5zEOVCDGzvWuOgs1wFIe2PcaqHN91ylvuEVV
-LYen2ZHryLcS0gDixerA8u9IW5PtsKSrMjHvGCmzDIfNANSSQMlXrRPbMUSL5nOr+sQSYv60e8nBHhGC
-znJwmP1AzkeaiGy1rKErvC7NkuywgyCJx5abRtDuIWmNWPwQEd5hMrL+G/3i9vyR2/5x5MpG9JcIzweZ
-XeOzNAx6ofiZGw81H4iHwXAxF2UyXo+vaWx/2lzJvCVdgBG2UVRzUy3a6hnqqiVoC/8Ojn+gsaYHKulN
-KNDagAsdJhc6TbiIjpxxhK0eTX9l49pJljtyzhMTgGIWDrdcY4hE8ufxeDVzBtKJRPJt5mtrQmCntOml
-KNKpPzamduZcRQgpKpJYdMRJlCrfxV7VjB+zgp1JYeSI18fTFYrXfIRTrGNgMkPzCyhr2Vms2ghsSi+x
-dRVDYEdROFN1fYQv92w4bvopqf372Qfcw66Ui9NrPaJQbXVxDvQMZ7zqZ9eY1abCqNRByCHvys++6gIL
-xcXoxI+MB/207GB0OlFfiOV6/eElu+9by2MW4bIB9rc/f/9dRugUxVEUPa0ej+YSXIIMDPA5/56sT7iT
-7r90D+AfDOwgHoXdz+nNdOlefEEvLv2LDr34x6pyrzrdDr76y6sHn7hSZ11+9fH9w+6OHA8cxKq0w1AD
-+Z572Y9DsoZzof0jbbCMnhXDy2qPhEoEBbKcmVdT51VKinQygvqa+gk8lmejuT1peaoEHPkGMoB6x/Ql
-DPjFSmzKaY4D5yvu4jVHeUJZHOFu1A1oSBPuceJGwEFP0Qex9/AA+UMRl+BAh8+roux1fwceAxjGfter
-ANDYmTpGuDIvK2FWg8CqFPcxXQ64sctuCzZkONtYwguO0qM/3A3S7WaikedPhxGf44Y5xJ+u4YdSfChD
-7AVDHeiyoMGYbVfRLjH/VMRZFMpSB1uLc8e0FDRb0+1CiZkdxBtAdn2SnekFe8bepMZl2kUCs+HX5DLj
-y+4MsYIg5EOmb6+L2pGDLnBhEHwjsMjS3gdOKmA3sTHPNSZXAGQm3QGI/OVYmzFeklLX0fmRA1p25HpM
-4NdAFSmE1EOxAVHiGu25bDq5OD0BsmiiSdIbBMbRk1fGpg5PFsrcpwVi3JrNUvpi+2d8zZf5bF5hhG0S
-IBaTWnFCMaEU/XTa7+vuwF6eTEIjTuCOghyolNTh3j1fHw5jNzg5XAezevOPe1gHw/wHM/7S5bccWLp9
-hZ4MX//05W69fI2MAIwfI7ZA2SkaHpBBDoV9zf3O4rgXxEhocaCiloIdKUz7aErWaSSs9E0JRSKVnmkd
-vSNyXCdsDf47yPY+/2vv+Lf6t2cn9x72//rF3iWje49xcy50lCol5y5zTe2GpSxkfqVAhqt6Wc1YG3sW
-j/BsIMHpUAHbhYM2YpuViYjLxG4zuAwGOh2SnplrZYFoZoCqW9LaUmADDlnCrWA2YGgfvyEDStlMlhzr
-np3g1XRyrzfs/+97zvIWzhbqjmCxUO+MkjnWClvEjxlSqDOy0xsDsYBxQ1fqmLej2m4c55SosWl4pfvF
-ops4k19yE//2W5ff4S99t9B3C/eq1Fele7V6cP/Bp3iR8w/7+jN9/VnXLVt4fe799tvv3d9/W/z+W/k7
-t8R/Ptu7jEo/Bui03rdqFwWDh0MkEzYXr9sx3ikJqLfxotqVDUMS5Bo2O5qvAUD/rboq4eKri8tuHWVR
-VtThT6JvA/iTcjIly5DzYknYxB5HsshZvKRA8PCqnsPdpZGIgGQhczKUb7glQwIqdybC5gBNKCiRDOXp
-lwdwZ0wnbtOzMC1/RZm/ELkhIr4eLSZiTrAszgvVOPpTbaHLTIDihkFmWg/Mux32QOmaLVN7FGHei1mh
-/+aN/InX8h02kU3fRmnA6NMWP0l+szJP2CBmL4uRR2tqR0HhVxx7KDTOsVxiPR2I0gtAfcvx7Q+FEGoU
-tfhtm/IOaTYLU9mTkI77/b91lfgycJgXEmbTI3Sh0yLyV7FCbSXj09ceGN5duUlkdk5P5/eOuh3+RNHC
-lQQTGA6ik6nE2MDuWodZ4Vd1cQGQMMQi93XviJtn039JuSVlXZ+CPwYGNXi3F5kG1wHAMQ5WVb6Wgs3L
-la2m3I+h0733W9nrnZ4uj3odh1LgR6ffPzoisWu3e6DkJJbr96GGQigIp2gAsF1ndiPFPUJHbf0obNs6
-OfytpKnotoOmfyuDhTXx10gZV8Ed89+/r52REsNSsC3fUHNJ3urS+aHh1MUFUO3DmBOCqkIMymLFg/Mb
-Gz1wvIxT0ta4hNkDDkyPlo+jzKf+n2KkmgxQ8jwfNjHUUJvrG94JEStSm7///uam/wbgihyJjCrr3sAL
-GZM/CXguYBUGALijbhf+PD9ina4TNdOxHQCoBQAdOGvl8shIzQjoUDOOkNyFw33DyxSdCriDhXY+nR/6
-YS3hgvGEN7JueCuhKjm/dsxxrwECxDioh0KEctodZAELeAPUBVl9m42UDx0A+IfuFw5t7vweDKPZvFNw
-uRoMMo/YhirDYoD/vchMN8WP7HnlwsMVUyKLaFyaOuVlXhYk58ZbDy4ALhehfRcS8ChrB42iOp6Hn7/T
-uvXICFubQka7uYG6YexHba2h8x5lHfL96dhcQRRdjl6zXEAdDlpk91Ryjd5ebTzx1jW+Qvp6eKoNeMsP
-ZTSlSDDsH374cSel3HAssRkmHGANrBoGc7WhQCKHCqJ2uTGhdldo+fPDD8zvoBEoNCvG9hkmqaj5kvfB
-DzkYg00SvfJjMnqAX0NPDkBVFPqN4IEBvbjut/kUe/IsfOXizvpVSnl4KR3e5NMVsIMsoWqN1uWhrBqv
-MznFpawngNVZkEc/s0V+mhs0P7PiVevuEZMJE3VBHNiTpg88WQksd3qsBhCoCKDfijpOPcY8blhJ2DvM
-BGE8RhThXGSMjRoaZkoIPA5x2wyBFzk/CrYxsfNOJVRpw+W13zyvlCs9hmcD1ro7G4Dunfbj5marJSVd
-ZEOBtqYCC5Z5RZYoOHf8u8jJOg5/1lfFxZJ+VAv+iy6c9GlV8sc2yxUOS0i2IqJJoBBpdu22XDnvphes
-mpO/UycCe1aVNNYMb3AaHavJeeREL/t3PDPV7wemYn3kEPIl7fTj+yeH8dVj9oFHiJFhja4PJqmu69st
-EKcJQqgjSUDrQIP942GfmmIA8GBJLOjTUPj6FdnE28hQbKDp0TZxsIwygw3v6SInGmzRYzS2idHk8tW/
-Ivc74mqg2VfsIEAGPRoChi4JwGQ5pjvKjf+tSEgk84HJ6ofj/rdnPzwVPgkozLGJNBmNnhSl9u2yorpH
-zaJekeuKPlOBeBsESCTeDoZH33+F/G2BDgp0B11hGbw8lyLrnHjvN2XyWbiMVafVCHHVjlh4YYBO2Mlk
-YVNCtTHKtV4tdyU5x2yEPqDktzwDGn+KjhdfvySf92p1eZWxTn26I6YHsDsKobsawyxUizEqq/L1rEI/
-OHMVc1k3MqIRWH7OHasn54DzSzz/+ypfUH5SFqqjZ3wm6RFDgRwgSJYFlDRiySC0QhHNhL1WANaLCaYz
-BzhBE+oHJ1l5TH5HIEEUhDDef6yQwQESBJ3F0WMMBSuY0/jSZJXM0UKQc36onNHNXwSQvb6hjYwFwkjW
-ynW1ExibifQyMrMEBMgfhqPZRDeW9NP14AY0dGwRUFOBHVx7OzeeOEfksHMzeHNzMnjwyf2DY9cGDrNY
-5ANer0H+Ch1B6v6bHc5ESE+ofaXPQ/+CzB2wzDfFq3zyFVD+PxMRfpRJi73uBX7ZRaZgl8wPUf6BNb6v
-Li+LypYc7s3o3fB5rYV+ykdkyuLKLPCF/3oxXb0KP+Mb9x1WhLipsPrugt5rqVNbwIC5L1P7rijRJ4cb
-G+ITfHBU5CU0Nu9Ri7AmRT4FZKxXwh1ZEJqFxI0gF+AefLtDhER9kB3zNIYUz6T8ueo9AzIip4wN4xc1
-3jn5EniJJYzoZAD1LvOleDDR24NwJ9y5o70GzQyJ14aLF1fszp0bbIhZt2Z9WvMSIyvqEsNLuRMJt9XY
-7/BnGZ81qZEWqIkLDv4yfAK46Zggc0It3cGDcKEl70hPxxcnjnXB1zf4L25haY1CkDmvD6nEn/ETx+ty
-q+Js+O94eIyGGAz3Ol88hvPe6w9J3JCL607vPPzKLYf9u2gu2GH7vDHP+sxA7g6/EBAwUA7twOz2EEv3
-XmdadAbZG7SKwfI3g2Qp3IxQalkdZLIDua8D+XtDEf7CyUinPRpBsutJ8bIzyDhdE6/RmmJvnOvoQdaZ
-Taa7gOKqS7j7rl7v7k4KoO5Gr3c/zPDLuJpWi12UDu7uXi7y17sf37/f2TyzDtyRWKzz/aouxh1gWzrZ
-F1lHjtnaIa6m0URwr/Cv/o77l+AC+w0BdLOzI9ht+GixJPn7kZzx7oheIBX4KPpF+MrVm56vZrYaPlPZ
-4AfVQWTcMXivc/Dgkw8HnRhndg4+2h90CHlBiX/9TH4LIuscfPIxvkEsAp8/hgePxvDFZ4Tv9/8L32+F
-7//b6en9I7lP/UgnUxrm4MvVclmVR1hoyL8HP8OO/gYPN791j9Le42q6miFrHsJsyO8PW+8YfK/QjarS
-v9I8o2Eoo/vPvUhcO3Ig5bhyyQ7cBZvuIiQOX+Z8Fz2i33wZwV30Fet/KHBv4y4RZEPIkS8i6OMEjxuc
-uRtuYO1lxoIviuhFjSCaP2AKm8IiDfEjZionud/PxRIDoWPBUT0+oOtkEPZNj+wKixYs/BXB+WsxWcKr
-+wMZHV1TtqMaKDk2Czdvgap9Mnn1CA3V5Q7AAdFthLJGgnBX8Msdqreao8O/ZMMzLfEQCRc5CVKxFDBR
-iosScNqvxXT6Uz7OYQViiJdiTZjqB7+ZHrBJt5INmFN14id+4Ta0yuyFXi/pZebb0ZAIy5hEwNB/IZVy
-3B1NJoAUl8MnXw1/efIV0Qg32iNu1uSecJcs9aYDo5HKfTsUQQmy8ZjMq3tCHxkNDX/84dnPve7eaF7s
-0bLusaVFV2/QQYMqalYczyZ7OMBuPxgzzOfWQ95yVBYstLUOrCwSs+Pb5YhnkN7QSrpwKnb6jody8kpa
-ZFKlffanUPEh/P+oS+YvUsG59W6oTDukO3D9AvqTfmU/SHMIYM5+SnyMghq558ntIeGX43AzYNgUaB1w
-3MoeM21xDPcOXvRQywkNeky837lz0oRPs76ciTagnNiW0puzdf+k4MhY1UGJKNXtthKfc0Z3R5mpGGKh
-N4SO75oq8OImsVWCSjIsbPRm7T7AgnTDpSexdn2d5EaxtIYbRvyNoShe0B3cDa6E9mmzm5/sFTdVNJnE
-0rvwm0wn6WGSw5N2a6IPy6zYFgWNWP7ZgqQFULpPfnb3GDMwcsH+bK63BF4qHamAEXS/+uH7p9VE2gTy
-Ci4mUroeSmm0aeYEpnD1lvmC2s12s3LICvvv8oulucPe2Lv1+ia+zr4qJt+jcV1jYNIFnAMUWS2/I3oE
-jgGcLASHHg4LomCIKZAcRmC7brl/GxfuL+Vs3SAX5AB/q3FiFzyKg8iZyd/inOENaxc1ASmf9PrBptWt
-47Ylrhf5LUaL57HeRGHjvyKYdNX5lF3lxeXVMlrob/nlbjaRlf65msPTpw+oCm93EVT03nALBxn/venb
-kYpiqlGJJfhcjtUOQdBIf6BU2M8zWiq5i5CLqDP+pifXg9ZJMxoiDE+f3Hk5ZKxfoI+A4aDNPIZMSBr4
-1qwpkw9DTb9j5Q5wHcwE0G7sGL0adZ+JEdlrCNfkJQlUhkTqwsjkkVhK80hsKT9/NSQlBHDffFtRnsLU
-yAKhxHJIN84PF726D+z2bgwBMvMn4heaY1gINhSi1+3DBKEcrpLgYzqeHiDY3Jev2wGinlkCjgWZKusI
-b9EOLiUPLpIX0fr7K1ZPD3d4rDM+cRSEhUHnGdkQdLRJqUiXt29Sgw8LhTDp2+KT1iW6MdOfxDd6X3c7
-+ubj1dO33NdQdIy9fsCT+IOrjBL/vfHMAjNMDno5YrvmTfRGC9J3wC8LQC6sTrkxN9IyEDs26bUGC+Fy
-aTimcfJqcwsh6YSnmbL7URvouvM4R2YKJQDBeOrlQuJZsylLs/WNYrNUAaHRfqZUam+w/wPqQM/zDEDU
-N6P7Nh+lxLMtFNmakUnHT8ZosfGmZN5fkqrnMGQjwWPAKzXV6+Koun1AuOVjpIRMAf1200ZTzl40JoBd
-D7JldMN5rCxpRYUECq+2NaRbDAq3B9Dvgg5MRGPdAnYJKWcEI5FCJiBEX25kwi1Qou19uz3oLj1EEn7/
-LCRiWDekVNvEFEELguiMLaKpW1YeN2qtbky2kjh5HQDJ6OcgezPHyNyYRq97v3tzI3LZZMV6PipjAXNZ
-XQGptehgqJ24vf0H81fUJA2yf9umueGbdfJkkfdlb8LFdty4YBpYLThqLH1y7SUbTJ5M4otHi0V13blh
-KXUgrN5hgTVjCaABbo/EiKGdjS5z3Sh+Fz3B17/89J3ejfgYSRIdEIvZZQxDgvwu1cIlWowthtO2BWMY
-rm9tN6m1Mv0YzQIrFT5EpUL/ttuz2ToB1+0H6kt+W6QNZd5qo2VzuCd2F0gdv9WeM5KP97LrEoLOh5kI
-zpBfRYHEht3I6pE/904N1ETUf8frwNw63QzMNmTS2N20pJ35Txw0dr95zFiq76UdSFv+EWN+Q5teR/EV
-/A+NzH9Hq3N9hRl24nfKX7gx+1c6ZsBmj/UM/G0NAYi46dLw755+U2R1KWQ0ktmBdmKo9slGqMNfGjIW
-ip/C40rrwCkBrHLBhhqVd3DN3lf+0/Eunl9P0Sp8po7eXe+65hIaZItRUecTUXxoVgSHE+hq6TiVaef/
-/r86b4N6JpO4I7StQ/mI9gNFwm7QmBqjYMmbXmfQpPE1aV/WEe6j3zGIhpbwMCJ/klgrCdvllYAW7W7b
-S6yh84StbSOG+SNO/i8dq8TZdPOY5aYJrLlNnGLRkFPXLFmzwHQSL+B2HtzQcEkKrKWIQ5PUf0FN/oJT
-4F+ddTQUCdZgIIv8Aq9M0lLril8R4f+tyIA+uq/vAQkkXypCMIfefK0fs/CtfcO4og0Uw6Kuxgetsg56
-fjJWlKVv8Q6/mFbX/wF341UxmeRllz+svcxZ5TtQ0xft3wODaIt/R6MP2VHuA8PUI3xRIjGP1XOlu3/p
-uoN3ZxxcEVQj4Ddu5D5/iyE/uO/HjAHn/wZAPsg+DLp+HBDuaA/ZSc2TdbbbzpNKr59iTBW/wzQ//ig5
-4pkZcHIEoWxh/eQ8r9/rv8NQ95Mrsp8Yv7NeaZ9Bk5bbemU3LKB0/ifNVCxu1kw0Jv/e2zyp6/c1ze2P
-Ewse1865ST9uOylp3M0qwQjskM15YIvyHZvubWeO8h3R8JstUlqsIzfZk2y2jVxDFd7+Sv9jDee8RVz7
-NS1g8oLd2IDzJrV+zpCSKQFeLrFuRYLnj7NubTNKJeq6uWyRyE8oeyOyd5qAY5bUm+z/hnoMTDSpnGiI
-mj2utXuV0CrW5jXjFP1zZ/AqbVEoeqzKahdr7/r/HxNNmvi6ITb3p7xQu64D2m8325prkkuo37LWUpMR
-cmSn2SivNppC0HsTTTIARPvMB4M/y2LzwbYWm+1mk1sZdLrTjstFgMhriYdjMhrS0RmXKv01geWK0pTi
-k0rPx8WJ7Hp1tFRGHP4HLd07Yq7QhM8hFKQHYFwGK+z4n60uEy3c2crewJX4ljT1i+FqDidkkn9VzZz5
-2xpZAdmM4kTj/5OLtaKZwfiqmE6gGTYU1acBOZrwO/o5uMBIvEV5ye/0aTDHJKILqS0PA4orVR/1vARI
-40e8oth7VQnDFPdVSUFO0g2KiZppCj7j5hRGSz3E5VTnaReTj93G0Ukdw3xTKG0A002clVv80rM32Ai3
-y84oOkbNRXDXfnQD5+99ZDJrl0+Wq3JMypsb7ye3PLzp9xAygzcK2IN9Bi38VRjCTwHcwf6NsyHgXU2o
-EbYN4W1Ch89r/8KpVbQp3dqyj4Pau7taanc6Os+nXavPojFJbfYxuoXoO+jm9JTqI+rlRm8r9iasapof
-l3wZrSNfinK+QjErfzw9reH0jia9daPUKgp6/QH9cFy0ur9WX0Mw3AAJLnODMbN49bVFgswaGpXFXNvg
-FC7ZuSUq2AoFQEd4bYgJuT791+Fee7gVTOmjfM7rKuc4fFoU8/k0380vLjCU+6G7juwFaBrZ3QWSQDbq
-VrK9c9kpjUPSPHCqVNK5ZPpLDyH3u2PZSIVBYlcjteLJDiIjPnyfjh/v5qGxzvfikfhje08G/0aYCMki
-LTEMjnfuqIB/QM6Z9Bv2UHkJX+jYo31/F0/wlKhAfBDFRE1PyAit+CcTnvAT7cR3mO43Y3EvCBLCHUQD
-NTzBSAJkOFfAsB3n+h1MjL7RHkHqt8kDERpxjJR2YexvGP8jG9UwLjTMElUgnpfV/4fu5XJRXGL8Wu9i
-Hjr1e7pbSUu0ODZQkkcfVHGEgtTzaoLJcVbkge9ISQpZnWOcZS5FXCN5gR9k3XnF6yUSCWAMaOjdR2NM
-loxh6XCM4um997zGzB9U4DEnudpF1JUsphDCQR2Q4/hQHcdf9/Bln6d452KIQT575uhgptA691Suvhny
-Nsq+OEK5KKK8+Mvn2Yf374e6KcDBswJKQNFq+jL3zcfa8UZ5SuWJ6JlRc9QXEr59u1YwEQoI5GcCR4PH
-IvuWqU5GTtTmAZITBM+fyUzJBygm7xhCN/gPXR9mWRVm+i6g5i+ImJ9UY9pOCdNewPqT6hrDLPiRUrNk
-o+Dq0SnXjEB3YQvrF1o8I6jh3ulSmKGdnGbDzdGH9HE14cYpX+iHDw4ougAG9MPLYIY8RneOTut0O5zD
-+UTzQi78CRWe5hdLU3aRv0wU/YyKkp7fl0VD1KCsxNA7iIYeOUwgpwR/Ear5EPtDhoZr9vRM6kFE5f42
-JA2W20DQyI0KLSyK0XQXrQzqlI1RkGT4TsjgpYpFtGqa1kxcoUg0GoIqIArH5U0/sOom/JuQSxaz2OAk
-zUVCuQ3gWYaSJJTmZ3vZfv6ZUiQoYzCxyJfw9eP7jl6p469/1a90uABz7CviwKKd+6hLrbeBnXAPLCSa
-DbLOAdrktJIKW8gj/vXd5RF/nhsnteJ9KukP13xaLb/BpBVRieA1l5TTFRW0b9e7b9ILkShEQ5G3ChHO
-jGLKyBuZzEbnWSr1N3Q4DguRD7JxsBVZvClB3vO+wI9CLIWFlISyBYWQigrKWzss51C7nT+t1Ho0n29z
-QqFYoC7YQYo8KXFm0UomhCHdZC0ldbaBdHpNeb54m6VlX41eFpccmNi/pGLwfLKVdEqF9ovlr8963vMB
-Q6UcSbYWuHuJlupiHv/6YA9vjeFlsbxaYdri2d4iB5Jqb/a8OAciSPYOvJzmGPxkD4PaoVYOmgmpHiBy
-lGwZIvmE/gsBVfTc+ie8hOE8Hy5Hl6eId4f1ClPx9vZFxI0IzX9FhpOH8d852psTkHvnFhGL38GZGiN1
-JW6lPFuwvWT3ujtTOH0HMIqr5Wx6ulpMuZkbGwPBmqJvoy1yeiFZguQ9cM0+KNfZr/n5s2r8Il/2ute4
-EHj/iSMNhpyg0ERXFXlndPeu6z12H72uh1U5w1Swl0GIpTzpK/MGLuES0IOa4JhAFUhhEFVLOb16YhZ1
-qDoUIfmOJ0OkkFU+eyd63ZsMv3LVAgc9vP0rYA2n1WWvAwRpTbENqTZcL1y91VePZzmeYtSdozR7k5wi
-5d+SOdacIQOOUc8eiwHqS++3mVmzGNBwQ/ZcuR6l1MTtD7J//Dqqu64qXrY33uZtJUjL1A7idPjdI7yr
-kBKSNGDINx5m3aPPUWXFA8g+tdSEDf6Yc5X8OFIXOOJc+EY7Iv6IXHWoEUxvt9eV5XBDksx0AcUhHx+K
-4NPqjdgYjBNECCRgs0CHafcR9hRpkrMpp9Zl9SIv9zTcVHcLwTza6QA213lbD1S3v2S+UnQbG703BWzK
-jlRYb7+bqkGA8tXudBgPZlPECjIsxL0U+IsCji86ay16O3O1bvNtooR1Nqeg5ssqw0iq1O5wOGSzulQE
-FA79g1s5oO8ZXlZsTdcP09CON4yLEpHNZ1al3JsBmzDrvy9m/e0wbjFU9Kd3o2EkFe2sDyaj0vhoiOjw
-2hzmnQ7PGW5otHUESBhPcKTFs9AEkiH2tYqq21cHxrJ6AivroxGVo5ff68vQlfHFZv8WUeJSnKEXgSsQ
-yddLR7ucnuLF2lFezuO23kt+QL6rgmuYnzDy0DDp+NJPbK3HGM9pNDWOB+H3X2qM/T4LXPJXpdtQ69eL
-5tZZSROdLSbpVzExhvUH8XzROInJciMc2RUbT3Zik1JGl7gvj/nnSSc0vWfayG0FF+piVSZubEKDi+KS
-wsM6CkQJdaJCiEZpEicJlHvomnRBCzoPF8KsHFF48hIzG/zy05PHStb2uHdDBRqwfgud2aXVYAgoOviX
-K/lIsSUSDafasXRjE0Lti9HYJrw6yV1K0CHIkPZaIoZss686sKBF2ekbEklvcWE66sT5UKasTkZoa4Rd
-ccUNOjh1eTIbC/hGyx9gEQlatiXO6Oiwd3Htye/gbXCIkdErdcPoxLXfScQ24/SddxL45Y6D5vpbB8aW
-Uiv6IXtcIPBuQcvMkiSWTgTzDHd+uK26F6YCkIockjp470qc7ANvyL8J2TRGNsQlCdzr9QOdpmCit7d/
-E4LnltZwy2q+O8No6mv10C3GTQwtVfSZJwHjrpjqLEbXQFZFjgm2nBJrXFI0gdsRe+kxnZ5Kp+vds7be
-B0I2djZcNbfY4wZjyosNbW89VIeSzGjnHk3JG955O2HPyY5pe6yB9JjVQJtAnVivOTDgu83qd0R8pI+p
-5qxgb5Pcut9XCDfoPZp6+76/qKplvtjC/A9hjgMhcsTgrZ1AYczhbjwJyWGI3nBASVagddmHGR7Ivq+b
-IdPlSxiVqpZjQ+E6LkkeLr7U3+kxKjOSiIqulIZYjMtxEEVfjJ+x1IkqJwkA20gRueSWgsRY3LeqW+R9
-It6b2Xhg49n6yErpWFlG9bN16LyG8EpH25SDxKzxswq4xt9/zyb0i7zt7gZ+cPpaBq16RJYK9Hw1d3VM
-hqiW55QYhmfsUzyZr6ejOYb9RrXJx4cN4crESzwp6AlqowhKqZhM2DNa37rwI+FrdtlVgZM011qObodd
-1mDhs84m7nM5YmnK/ahhzKL20ZcfNxtwOks3BPnKC5W/SMtK4nUiDjsVEYhofk6gdJR96nwV5xXRQyxy
-4YyK/wHz01ROe1kvFdpJPluNFTb0QRaNJC2zwe2LM3oIdYiOrxGqZe2kN9U8LwkTpGVsQHKXdYFv4QQK
-BgkFP89CeEUxv1qWSCZKjH8gT1zygwToMW/IVNjJHhtBJ1wwKAr4/agsZoQDvgFa1UXT0tE2qdYBWg4E
-FCWC2W0TOOuC1b98/WTS6yJAdwGg3X64tPcTJ8IdvCgMlB5CWOFUaSajqVU8mdFaw+rv55/qePEL5nQh
-zxXZHrDIf+1uFtAt2YWesLnOpZhdtpzsxKkeOizEsUIvqvSJdgJynGGziIvMoydyGynVBZAJv4p/YPej
-+xzRAodATTIKATRaeMTjRlHQjKE033eDDDNM5Asnpy+837f6FozEYH2jMX7kU16wO7lp0XPihXGJVw6c
-R4LoC3Gf4advdtpryTy2GV7spx+Mjxt13PEdnfStnCEcUO2E/EdsMuLjNuKLO5rVjLZPURO/zlknr0dz
-yt4w0jOPLeWvsxFmTqLM0OPRtM8Jj7QpsbSirOG+Hlo64g15fVWMr0yeUu6UgzkOqQlGTIBsaa/eNY9e
-QgPtjlGdqJtdij/MuvsknX8ga+v7x3QsgFkoMZZU9itBu5Zz1UowXucb2/0UpZ8DwYPkvWZfATogBI5U
-23ldTYFMki/nFTBVMwoGwy+mLE2Vx5vwOETRSe4AntgQnEQOKg9bIpIUzWAkocBsTbPh+ddmYyYkHY6E
-pT0OX7OqIbnlcInY9giXieLBjIsFXFWnFwVmmuhqI+PlAog/syKtkBa4fnT/r10NiFzWF9UCYU+/UQP8
-H71dKNDnEvHSuLX+xC0sUt6PpsUldoc6n5zsLf0cz5dl2+gW+XTElpvUUDXfOLj/YQbnemBS/HYgSI0e
-v9mh7H+spXQ7u8FR5tdnaCgGb9kPkz+oS7j3CA+GSjZg2410oTeKDqIJe2r21iTH+3VgJJUW4ouETN0e
-AbgYGyJurLa93kzJnfaOqgXiRurqxjPRcpq3mItT6qTWJJOtwygtsycj07XC39EyZYnzsV6RFY3G7G6j
-kjAxh+R65N/rBSZhy34zrheQtGjWHMnu4nDpUU9HIWpxJGkIcAIbQ5IbEb1/OmO3Qmmtf/v5OkS5fqfu
-s7fj7QECTA5aqc2hKAYnaADFBUjx6P4nKv2+4cVj8KB668mQPWtqKu93vPWLYn6KfRXVqn4fw6Zrc+0S
-kAb4vc4Cb+h3HzpZBf9JEMe+3gO0AelOqtnWO55Kv/f5XK0uLqZ5AzsYAn+nIfAkIaLohloluT4kH7o3
-k/caUAKdPdQNspD3AK0UeRKpVqyhKGpgtBIaYfrQCDf91gbiYTjGjgdCz3sHj+R12D69vWXbddx4bZsl
-81JJb3OLhj27Jw3TCxi2fojGTa9v237d6CA1dP6wfduB8pEb11d7Bz/6j64ffedUsLfoS6XoQXtqTqs/
-bjF4ujqTg6OrG8mk/g4eCJFbL1ZinVYP1Ar5WyhdLV5/J5YBXp2c9Zyag862c+pxSmU+8i7JAo+XydGk
-Et619yZS88J8W8VgqAXq9tX3gR311eIZHfPvo6u+2C3j8/5gsyd/YOyM7z6id96uGd99/M6+/R+9T6e8
-jVbdf0Lypa3TKm1tBa7OGn+X0HkbtTh/5x2/OXhM0sD79uFj3EZpBJAhsfL6LDDHLi/NSVpwvjYDTD1K
-JO4xmStgv87mcIM6c35CCh1nfHXX2Ny0JBOIbEnYZdFGY1ERelVeFItZr/MDMMDXC+COYc9CDUryKrUf
-dvphpJam+YvCpWHNIoyGzyEUWrW1JxC6bSIgh9oZ5qR0Y4uvEPb/s0QH4uOwzrHe0XaheWTCjLJpJNlZ
-b3SyVdO4i4mGg79rB+opppZYRrJJKFa1o/E5JKRayCdIQee26p1etlIVT7cMUvWH4RmcizuZB4B3drxe
-OI176MS6o/oVp9VuHs+mGnF7hJU6P40YSw7W/5OeqvYJvb+DNglX591Ohkfg7UM/GWQmQvyaA8Mk1i0p
-qsVtfAw/fp900f+XyJ73kE3SoStlBrbCV56v2AJhaeFbYyzVKrM9yMtRMcUgFoiaSIItXiju+Rlscfbt
-6V6SdM9LtV0yQUn+Mxlk8eicT9MG5TLmCvZRUChzsDkZbpSUkIbjw2EZDunr7R1kqE8wDDURKJoZBwsH
-lpM6K1d3AqcNfdqV7KLmOWyt9aQYneMw6SMSPz58gkbRNma7lJIs8BB76VW38DvpSnOn4bDkF0A7uVHT
-6chnqxEiCUZ7egr/dr0aOQEDiXsXjN/5hBf17siH5lZz7XXezNaGlXAnmR9rB4FYF2OqOMxK4LoZ+KE0
-TC/4ja7UVl4qjWsEF1ADRpnfQZiZtzMdddDePR9Zy1F835R0BRe3wRK8uZs5vOVYDmwsdvhSjlEIEK2e
-1DYFXCVjWe7PKAU4TNolSpGfqms1IzfeCJ3fSmdKrq/tQrN0Aw3V1Yiej6DEPvG/7dlNmZPoWRXnerdt
-33L9T0/nozKf+q29fr1/dBN642eZGJ43k0hhruNEjZNUsEQxjoGugJipnY/BXUw/9TVwiq8taJ5ggCgq
-1w9Ma9icvsGg+eJRJrWJxVs1unMXkyHcTcWy1/2t7Ap2CaJdpsG+XDgPjXq+waR3OXHkYD0/vn+ywYI5
-LL5/knC0EcTooXdbc5Q/mM97RH5o2ROKEUMDRCe/9eoxDdje6N+HsFQkZoJabgI9BuuJM66vWdGwXFub
-60d5eooRjnd3y6rcLVezfFGMCSh6qIy9+XvugdyEVOtwZztlSmeJQWoaU6ej1a6/0DO8bdKRDbzS+9hz
-T/NrJ5xWEOsVyk+1Q2Dh4flThve1CqA89dtf0/G7nYatz8KWJ+GPPgd/wCn4Y9rmxKp/XOsXsB+vItfK
-tWuaOLyO3jHndaehbzTBUrZm2Dq3sPHHbpVO0Bz3LCFG3/2BkQ4lBcfeFXDHTCnICuUIMNuVIQLECVKZ
-uIR0SBrYY3FtW5r1kE2hpwgIvX7Sp3gNKxhn5rHBF7ZEqv20SOwkICxD2YrtJMz9nigX8X34jvKHB5wf
-idVC1s+5BkiZeWhTKH3UwAS5BK935sfQMAYfbCbpTPOKcvqcYk4ircheInaM37SzkFvkniLyDkbWEm58
-Tfab7icf/bUbZLphUKWS3Eikd4QAbD6NCiw8JYZIFcXjjWzRfgvDmEhOKcD+4dFqeeWtVOUtcUOb5YPs
-VFuNVoTVrLRwPfcYu3gmR0RCfA4D0WnQF8YC1g55i9GS9D4OsUBX8zq5/yDzIU+tT0uoE3gH31OexQaE
-DDzobXAyFOdwcHgxNfDMGqTHNbqDbTEsQTaKY+djL9IQ6Pa63RioynsdxNvJ7GOap50zu/29npjM+kv9
-nbuRDLDvvYv2495ik8WbbPtEkFvZV4nWb3si6U+bLO3m9zzbNFGYQCJbZYW4lebjk200Hxik9KqYZRiM
-e0Wxt2APYqxVjMh9jtnS8gVjOH3foh9xqQuFjGFp9agoC/KOkphPRHawjPDvnOfQ1VNxOGaG9xkkcLeU
-v8yfwmX9M6wVIQRUfjSbxrd4gdrmjWg8k/90jEExWK7xaNnj/IrclLu/gop2kFTKdfuPlv5ooNRLT1vG
-sLVugva7VKPwtDI/25TqUn3HGjap4U0YgU16NkDD7Q1w1kZg3PDSzoFrXF8V07wHb+w4LOi01mEDwLgJ
-9B01k/Xu3TNA/DyLmk0tYPw97v7YN3hCBmf9w6D8zU7zV3Ih8b82IHDVaNacGah9K4aOoM4HFJZeDtKw
-lMUxBHiGJLvOmVyuFpcaBvDRYjF63XPRs2WEmIG+7zd/4/MX8NnA0CRZOcr2D+HP51lcB97euxcDHgdy
-XGBvSOi7KseFWeUbAy2GIlF8OHiMBIBzo/DfRPHqiC24s6MjGBWF+E/tf7O//akZZPf9uYIdDQjt5afZ
-tHiR15gGZlIAYFHcUpHOrvYHzw4JIKsd0V1wQcGg4N9D/45K0dwXGP/tZgcbMJkQFlpHVjJuUIKgM5Xi
-W8Q9cej2hHP0FtTb9Z/kjTu7+j4vXwoK1jcA4pdyAPWVBHbJKNcORpDGUG6vMw5UjvHcRi+rYgIY5hKw
-elbU9Sqv48rN9D5lVc1xnmZTUx/43gxnMtGY3I1vgHfz5suLi8Y7JkVa2+HPj6ZTLVE3iuSzYuleeqgC
-FyZn162cmrLx6mnuCg6O3o0rFjU0uczq1Rxvw3zS5QV1HYyvJ/G2yDSb8F43M8s2vpoUi6AsPG8cB9dq
-GYUWWs1GdaDjNaO4f4iDQPrj5gT/GRw/+ORf0aTt/w0AAP//JDSWSsUsEAA=
+H4sIAAAAAAAC/+z9e38bt44wjv/vV4F4dyspkWU76eVUrpvNxTnNc3L72W6bbE6+Di1R9jSjoc7MyLaa
++L3/PgB4H44sJ2lP93nS3c+JNSRBEARBEASB7mRejOpMFSC7db/ol7339kvVVf1573026d4oXqs3/FdN
+f52JEsRuvZhJNYFS/muelXJ3d900Xf/qK/1xB9vMv/pK9EpZz8sCRFf1b2z18HtmvmX6G0Kd7BbyHPbK
+UpXd9QeiKFQNk6wYw1SN57mEzvotdWu9s97bqU9LdQ6TwUiN5e760+cPf36yd/Ts+eHRo+c/P3u43p9c
+Irx8F3HffS8vZqqsq+H7y8sdHMPrrTeDkcjzbj7QRX2DfVfyAItdqrj95rV8s6NRrbrF3WIoe5f9vO9a
+yj7T7lLXwi5NIWGRrUSriSq7WFvtbu2oH8pBLouT+nRH3brVq7olEt6icdnrvt8evrYoaxB9plJf9917
+v7Z58+Ya3IQHarYos5PTGrqjHtze2v6mD4/ESB4r9a4Pj4vRAGvdy3OgWhWUspLlmRzjdyw6PM0qqNS8
+HElAikNWQZ6NZFHJMcyLsSyhPpVw/+DhRlUvcmkKYaLmxRiyAosR0JPHD/aeHezBJMul/gylUjWMs1KO
+alUuQE2g9rqrSykHcK8AMR5nOFqRw0kpihrBqQnMRC2L2iA+EgUch93Ci3uHe88OD4I+KzGVrk8zzP+e
+leosG8vqKbPb/VKdV7I8mM+QoA9UiWPYXFvrzCsJVV1mo7qzs7aGs3Yi619kMVbli1JOsgs5foY97Jop
+73YGm8kqnZ4G0OwLduH9GgDNISB2evrfHyuVX8JhOZeQTeCYG0LFLSsYVRWIIpsKpFY1oMabawCnonpw
+cHDPlgzBLva4qNujrgF0lzduJJHvdmw/ZiwAl/21j8W6LkVRTVQ5bWB9aEsaWLuilbG2/XwyxnfGS5C+
+83AJ2ncefgTiM1lWMzmqs7PPROwsySOHrihN7ux6XOK60ljD5c7aGksrIylhN7EEdtYu++/XW5bO+vDO
+3y7f9G9/EYSfXRDSMEe5qHB0VS2LCmoF8kwWdQWKW47VaD7FHkUxxg8FzGdjUcsKBJRSjAivkZrOVIG1
+cMOen5wCbrvHYvQOme4mvMilqCQUqpZQn4oaRmJWz0v5VM0r+VSdSZjOqxpHgu0kDoWGWxKlFWRFVmci
+z36XGlFZEoJTbD/F9oK0h3kl5zPqsZTUZWsHtYKSW9ancjqAxzVkFWRT5CSB41A0LpHnDVAVVFkxkl7n
+WQXyYiaLKkN4SuMItWrbcR4+f2rBHZZi9E6WVA0ViNGpHL2rWnego1EpRS0f0KztgtPtcJm6VTyWk6yQ
+L0o1k2Wdyapbi/JE1n2YlWpWUVVVAmkiGezC1g5k8AMXGpUEslu3sCLWGctqVGazWpWwy7VeZ292vM8D
+WcynshTHuYTdlu8fPsBE5JUM2o1UMclO5qZlXc7lDmQT6HbORD6XHcgKr3rPb3peZnXQ7Pnxb3JUD4Kx
+L+zIvZbv5ML/3dtBWWXkm6PoA1VUdTnHNUOEqxUCrfpQ1aLORi8MKRFdV9xrEt8DNKCKONE+yB6POYC7
+DEqIwo5B3auxA5c7cNnrouJhR3REi/2ByPMHyGXdrKhqUYxk329pRnTDFoP5Q02CiliTNXTU5w8XM8k6
+fUfr9LR8hJYwogJhadthkjNL76HAeaLXdahMBUVWiRohLrnVYx6VYipfqHwxyfI8bL+sJoJDaFhdVvUq
+4JZWteglVndjpfrbuSqeailSn0orOVlQniMOTmypAsVzuWDhAyh9Bj6QPdwGKq8215vP4BxFN9YnYX4q
+KpDFWI6NTrAGju8T+HcJX1Pj5ib31k9+3SvGXPDw+dO9nPq7uTlW02dqLHnk0GBE3AH7qY6RpgBAW+Tg
+KKseluLkJCtOYFfLEq9UBNPy+CHsQjHPc7+KRgNFFP/lF+p52NW0bBYhdXcdpeMKGnPYbX4bHGfFmEbZ
+S7T6eRa3+XnW0mKcjZsd+R+jdpdraxDsGd0EkfvwmqflnVwMoRNvzlWnz5OgORaM6oAcq6CSNTIY8q7b
+nHF34V84WbXyGNaAsFs2tq6yk0LkVPdUpvi0qUPUp7KywFzPopSoO8kxiDrQYAbHaryAXJ7JnPb7WryT
+FWQFiIJ1HgMrK5zmcVKKY1I/RE4il5ebVqMBaI/ylOcG6bqweVPRtnRzkzoxKwBYxvIEUglNiHonC/jq
+K/ALfp7RZ9cSwCt1zXZDSTpgmnQDzu9Dx+otnX6TUXs7qT5+nl2/h/kshv/zzEK/XGsSwS3u5kjHMq/F
+S1JUUiWvUiWBsCAFIapwAbs88YNRnsmifhlXWEQVXjn0+V8unJX070M5EfO87upBXtK5DfyF1dAkkwtL
+VtLonBXgDqom0eJSRcjUy3my0Ws35ME2Frw+Bw5Ype72dpZWC+RymtOWQ/p5loIT8FTLjnBjl9v4I1mm
+I7TAaaC1dOtJoraM29u2uZB1UzRcNDpuYUXXRYIH90mbrFBnqE9lCaqEQtWQMR86SU4iOqvgWJLgps1k
+vJwZXb/dHmzeRAOGFMXNTUMCrcjGdGhdUr7sSowENYzKKFgzUVV0tqwVjJwaSxuROdRmRS3LYjVh7/e9
+RM6jStgqarAwIWbWEsLv1i5cwIZhgJ21hBS8tQsLW2Wxs8KC2E0siM1NOHTzLBY01zARVc12DzYMWFAw
+Kcm6oGQ18GH8XEl4u1Rjfgu1AnGmsjGoM1lu0CRkxcng6tW1FG63qRY1dx63kCJa4nQsdj5Owgd9dvpp
+tvHreLJ4JUniq6Ndnz36ASf0dtaWb5/pzXPJAvt5dsXyQuX4j1phP8+Se1ZDLDf1hoDU8fYd6fbBvL7h
+k4eWRwmleWdNn64bBs50ZbJwBurT+vBOf335GXV9eGcb6yxl9/Xh12ghvbOKhdQ7hc5KNZLVF7PpJ98f
+BZO6qh1PTmf14pGZjMDKEBSRVUFP0M+zqi6lmMKZLCtspiYsoKxuOICHSla0W+PxhleiGI3UvKgB7xSy
+STZCUIXAcwpRJhc1Xk8MCNWUPSYwVjwxtk1kc2MrHs9Lc3Q7nh8f5xJmp6LSpgl9ZzETpZjCezQKYKND
+sspdAlvnGBgbC9g2e5LRdmMGBqoYhICQnMXJJaOAtifGG9i0JgcnA+iM8mz0rgOq1GcS3GU6ERyzIC6d
+5eWB+cOUDaJ7F97pL7XBEc6z+hQEbna4U76FqaxP1dhdt/AgPLlmzk3aMmmH0Lc4GFFGwo6qDcR4HMyM
+t3OkK3QTcPusT1pRaEakf4K2igfHh2m4T3k9ctm1O3US+FJLXJB5JYPB1rUYne6FmlSzrNtRRQduJSn4
+eYY4ltfqrDm05vXdVUtIGxG+rCF/DWmiNG0tq66iG1cuo2xiN8WBLM4Gz54/3Dvae/YLnRo7s1KN51ok
++6dHVVQKt3+2fN+raxTffMNk74GummRQBQhA3uqYS1wycY2NMNdXugn2gFdqXoKYzfJsxOo4gSG7MbYs
+5UhmZxIqNZUajUGnoQm1rpFgL0ou2o8SQmiO+bNlUNDnknVq1o3W9gM0ghLE53IteccdoIOaIvshkZnb
+7vRHmtk6vZ5WDwNqrw/vfNdfN5XWh7e/++7yTf/rL/fgf7xCdyFHc+xzrzjLSlVMyTiMitzmzd+qPCtq
+kGdZPiSWalPwRqL4uZIok3fhxo2u9g07z4qxOmeJMi/4im/cQXMblwzsfXvz04DN+PpOxdMLD7LpLJd9
+yJEY5xL/17jSiarKKjxTs4Ale7isJfMzHstGqqjlRQ1qgpB+VeU7WQ7gJ5nPKn00H2XlaJ7TJfAMbfHF
+KJMVtRV5rs4r5gzacESFUI/VnGYpMh/Vp3IBoqS5ELonltrasrSAAi+3ICtG+XxMN/MwFewK8HZfilHN
+jd46TBae1pqYNFJe18DNxdD92XcFDLYagp4k/h1PktcgWN6VB5RM9ze6euJiWYg34KbI0288wL9k8hwX
+cQOkblaNSikLapBVjwtGdAg3XO3NTXhEBD9niwBkFbPpBkyJMUanojixC2Iyx71ksNYixhI0paPs5Zv+
+N18E0R8uiB6hE9RDUYtD9HMYWH+bxgFz8yZowXSc1edZJZfLpiN5UctijFOsfSZQTpwU5KJhDQS8wTZ8
+RbbZV0SUJySVkv4imna7rhb5i1g47+QCskJXMw4HGhXrIDE4FdXz88I4cvAWyk36CIH9DwjJ1+/k4g3s
+aoD0i306rFcH19shlzTEICQtyrvY9yBVw/gMPFEjkUeOn/zN1CB5FVagT0H5A+M39WtWn76Yl3IfxVr5
+NLvIikTbZdUDuL+eSpn/JIpxHg+qUWzaHYxKhZpSVN9+ZuYztUPa3J9PJrKU433cDZbQ0K+3DN4Dlc+n
+xb6sst8lo7kMarP2Mtj76nwZsH11vqw1k+NqbvHrpSH9mo3r06sBedWc+0uuCkksgN4/YeuwzHQ9uohq
+XZiSsTxW82IktSeyV8cvMbWvY7TC+llxJspMFNFCsJ9NveqUVIm9f81F5HHjl5ja5Geaixr3uxeKPU5f
+vgrbpetYEiJ18HyA1KMlMbBfdA1ecKdZPi7pppFrmQ+6Uknr71Ad0DkadpNSZRDW4jHsPX1x+Oro+f3/
+s/fgELWUS/58//n+w739o5/2Hv/9p0OStVbJQ6hwUqJCZsQA63TkKQuqhIqYDjuGUylwT2QFD5dH5XZS
+CblYqHlt7pXHCJgd6bIKRAUTRXrdULd4+/Yt/nNr4/r/3cKGH5gqwOsU/l6q+QwA4IOWOdRzVPiBG/7E
+w4j+a/sOrmH6v7bvtuEnjPEjezTE0SNiMlQRcaLCP2aMt/7wMd5X44UbYTTGqPB/6xgfKVW3zmNU+G8a
+I69n/GsjtTKZ2YbaEUWUfA5jgcJ+XSiG1HyGEADUxEgYyCbm6GZVXxYr2pbFliwiB7c9k2WdoVa3AFXC
+qSqz31VR4++BxbBNRnhoauR83Dy0uCsfBbrOPj/NcqmxQdntcOnD8VxX8lFiOPYU7YockEFE13DdBrga
+ml2F10cR6iP61aO7LlFS9GBQrUTxFzqjRn551yOIj4OHmsPXIWa+pTBqkT9taH3sBG0m9D+rUvi+me/X
+AMZZNcvFAp++DKETNmIbxMyoKkO2bgRX8i+yC5nDOaqNtMFjswE8noDIczsaTd9JVvdNO9HkaDwMkB1Z
+zGZSlOFVPfUwdIrUoJhPj2U5yKp91sLG/RbcTtlA1UAODd9pzKIpbMFL/wN7GVmd3nI/b0GV8HYqLn7S
+P81DEH0bGjsscavmwJqjeSousul8CrP/BaOyNVcZWHKaOhWo80KWzqo0Z1cPEDAVhTiRY39RMKQqq+d8
+KUHu5ws1h3N+XQNVnmkTH4GH+QwmpZrCsczVOX2fqHxsAOFqVvMaTsWZ9icm9xJR1PlCe5bobYqRNm7y
+jBHU2eidIQg8LqpainEfDRULHs1MmxiwITcZwP0FzCvs7C2NWxO6D+fW55h8lli59wbC8mEq3mHbaq73
+zwlv/aiQ+5JSk8kygJp4kEQp4SyrMoaq3a5G87KUdJkvRpK2PNvA818+y+Q5ZBVUU5HnhJ7g5pOsEHmf
+UZ/k6hyRbLKudYXWFt555Ty1SjxoYzMcSykNEDN4WUBWY9dTRVNlTG8MD/GKOfsw4KdJQG/4AQzDd0OO
+74UM7rVpZXEcNKL70q+hCvl80n3dOc3GY1l0+tAR81p13vT6QZtXK7aJ19IzQgDUhAWBrxaFA8DiB2pe
+1J8oU6mbeZHLqoK3pTpnmvxd1jUazrPKSQg9geTqaaCNM7LQFDW7YjVQbKPwUhQfT1yn/QZS3awYy4ve
+W+/dCnK1FKNT7NG8OzTAGGE5ZgRpfkq0mHpw3xKAmUDxShcXpTpvGQmj4I8HzZ/NIRwqOJE1E7dWZo+m
+6cSp5FGF47Gr0Qxr4FV6C9WpmudjEtlTUdYgC3o3WSs4ZYuXqBbFyACZyHp0istJTfi87uYO8MJZlSjL
++ErdTiZLIqpunXQJULw7WLRiQiydVk0TUSx80/iDgwN+8CW10uQGyq8yagUCe7Q7IVKF1B9Uedp4ooFw
+1OLqKWzsaqdS60P6yMAactgRlbA6/fH7Zwrw6VKYS+lu7W9EXrdqap4Pa9IxewN31VwE/B11y48lIW9q
+IVT+tjqxHu692N97cO9w7yFs4BagATi06PGhFJYD2BAWPI7Tbqe10o1hJOndLu7MsqxSCCKQhkjHP7uv
+vY+0nvpeNVGWYvGm9+mTohFtTEo8+qsn5ReWg5Om/h5C5m9P5GSlaXmMC9CdoqFWVpdSKbiHis9Oq8C2
+GEcacBru7Fro4o5xNa776nwVqA+Cp5huUknLcadkuklTJVS1mlV88CzkeULPQ6EdjZm3sYhFVcEH071i
+fPX8L0WyqQHoDR+Et9fzirZ7jSpZQjut0d9bUVceWKWtkOicgpvPsRwJXL/6rX6+MPBYM5RVjb7LstK9
+VaAm7JSEG3XlJHJRy6KO6fGAPzMOD+gG+xMpwyPEj+jfFm8wqtjHLSYbvftc3YzVHMnQ3ttDqvB5+iRH
+vY2xOtcvG+EUD5VFxX5mDaFD/ZPL+kN1Xnym3mVRy/Ka3e8VK4m8lfrPpTiT1+z/Cbb5xP7pfCRLerp6
+LKV9fzf2pYCWqoWU4wpqBcdSn2LHTc53t5t7xdh0fTWSv2rvG2E6yypzeMwXWhtkXKM+s8rrMytO/K7w
+vZZ2kzuR5j0MFvsRZKISeuhl3kYFkWT4R6wzbGnNMKV82bLT9Gd/k4s+0k6yxe5+dgSPWVgd1KKW4Qj8
+ksQI0KA309ev9IyEfuzYsjPtyMMYmqAZA607bdifbhT2o08N+7FBC/9ReBXeSuPm034d3WWA9rzZB/uB
+Ifcj5BsVeCvRrpTaaXZWDSyZ4ydNPnq2VqORc8P0Hu8w4ge1mlFUEXcj3U1U6cP2t1tsnzJhA4LnhCOR
+43mwljypbtq8GEsj52KR50/5PO48jhuF1iWVbrGddhFwxcArcETza6OnmXU0Q4evuDB8osfDKdX5oTrQ
+FWEXGr1cNjDjhZ1Gjsua+Ok2rSh65SksWfgkEfV79Of9PPReQXZuuK10zdst+mgev/FZk6tQwcvWklfe
+pCfa+Y/QmqX42pOVxpub9IYu8ZbVPlNjMlvjEz25NKYjRy0TfMa99tUvFQk+7MJTUZ8OSvQr63KXbqp0
+ld1d2FoBoC7gRj/Aln3rzQ/yeHZewo+whe5gXO3H3dZ6P/gfp+KCJ/rlMuq+WkrdV59A3VcBdR3+q9Lm
+30PsVysS+1ULsV95xC4lR1Xz9GWf2onihAwLymHX7zRRwe16xm0z1TAs89uwocO2SaFwC9qaj9KY4PNL
+cRHiEWzBu748c7MbtPvqK7+htT3DjxHSjg9GEeHCes3HTo48p2nQEQ6embu9U2LeqbjohqD6bYCSzzlG
+7SxwauaklReNPMcxhoBwmzAbg/89GmbqwBftLEtqhn0mNIt4cA0mipSBh9m4VRcwZXYZ6d05tdBa9Ix9
+fqMT69GtdfxgA4W8qE0gshZNxFb5CyoiTdz+3XqIxbWQ58/dpu1harfynVTdV8m6r9yAArh2PcRawocP
+IdCWiq/icX6M8tTAyN1sbW7CAW3Q5lqiYWXUvTeQNTCaICITmAbQCyaARYusPVU91t8tkfueIOr10sv3
+Zzpdt6xfLrzeAmbrcvA4DD+0HBUJt0CK7iw9RtpC79znLQkSufNK/t2VOj7wmkROJvrlULfh8ty3jU3Y
+BwJyxOfTTt8WjsytyxBGF93ObH6cZ6PNSQBvU7fquWZjsrYz2h562MRV0h4lXIt+uDLjldEAobc2W5Eu
+jryzP8Dvj/nTtvukJpNK1mwQcF9920Gg4roqNFDrJNRA5pFXHMN1nuqplgdxHd36stcQS26nDXfleAdm
+v2p1bj34UYjFWjopnl99pb+rpBBoAYfduz7TgF61AbI4Oa0vRPWue5AwOHj8P3swhK1E+1euva+abDR7
+2IDbcDP0r/aWGbPsc8MXFl64xuBuGwOG6KHnmA8rhn7LYhvbc7h1XavpAb/R40YuQgtWmChV++Bj9fQG
+74BwN0LEdItfLdoNUqbMUK5vNhsVtcis/gi7EUamnxBCZC3yFNDmnt6s8kMwwU7YpYnVbL8RtN/xDI8+
+JVlzzopuUNBvEulWsuONxMB7O4EVMuDZJJoR4zbWv9k97frw9oVgfToiNZq07Au23N8P0ELcZJO+/wAe
+d8iDZDW45ckI18ZccA1tCJ5fAhRdzZl+uxGK5Fft4tHpJ1cR6GUYosuhTm9uYpH0aolIioWaBeC2MtiI
+OzBNEwi3TM9PzZp9eH/FRGhkbjVEf7wdDlMsvWzKYnSumrSX/TaeYhzb53RcipN/FOp4JXWm+RzMESlU
+I04DTtb3lr/6WsgouAjBLvRsfvjgNIdpVlzVyFQJ24mLK9uJC9uOPckGT++9PPrl3pOf9wwY7SE4hBs3
+GE58f2Mq5nKiZdqSHrFSgOW7Qh3/FJGtqXhp4tF75iXQ/WqmaeOKawjuaJ+8++pbEwUW/0MulvT4Ti7W
+DFcFG6gsg8hqTq0OhHcgIZxvhpXdDWeVuy0F3R4MG0U7a94+9CnaOre/rp6uWzX0dIfgcs0X66VV3nCd
++RRtaOob26uq6uGefLV6jfUbevW11P3miaRdRpX89tWcHfEsiM9cu4EK1muonNefc57x6GT2Uecy/1SG
+Zf6Mtx/GTlcQBtHMNubVm9VIN05d5CZnJ8WQCCvFkK0sgg1aWSSUP27f8782ZUutZgenYmxMX55C7311
+0kbrM54Vx7Rv4YzOODvrBNt+OPXRnFtw/jKnEAlDeI+dDaNDwmWaxR3GoeLOJw138GtV2V2dtH0/dUkA
+PybAfvgA8Z2IUzEbB4PPSEofYjs1wyNFCzX1rVAKKd2AcdM/3l9PrmPMFB9BbecbJiyEA12WGE1SV0rI
+BbhEixg3XjKgaEj+oK4ifHDm9AeWwDZxPr0KabD6pfviHfD9XS38jX25X7zLud925blPPgfpj71+2xmt
+334+WLP2UnvpZ/ab8K7P7kLejaqVvatbKq/i2fYoD251jdkjaN+9IdAr3nxwgr2ssJreM/Re7n+Ma4ZK
+bfh12XaBgi+1XYSc76wlid1LxfuW7zjI7d2XoE7g7ufV9L4H9T33PK+2/dqsq33p4sr0uVlbe77Ftemz
+qZ3yureTGJV4bcyzFluVP3g1GsAaMMLXGhHnhLWv1BxatQGc6VZtAI0BTwRx1n3KDsERdq7Wl0p1bgJQ
+GPwTblEnst53FdfcnhHFjjR+t6HHI/m7G2dE/cZI65dq4jwAT0UFGb8PuWn9FPGAZ9xSQRWUGkO/b+GE
+NKaiKMYwm9e2g5EqSzmqGVqudPRBFb9wIq/Co1iZ8kM8+yWcaMbIqpGaHqNN8FdDUFfkTrPhdySFV/2u
+//1pVrQWiQuviEs+cHhMREMfNLk0GecdFSSOAISPNw6fdHumxD95wy5suF+Ni2l74WVaNn0xfaZrnneH
+tk/udej3fQsCesIGBMQKGDlR4uwcMS1je0ZM0JSNINz+KSb+MAyR32/UeBXWeOXXCEO0pwNXgr/p0znK
+zmyYL8HfW0WpRcGBrDGQXvV4LAvaqn0ubq/VVbndYKCQ5/pvn29cDR1Li2+x5Xn4dbm7EesgJk4X7pM6
+rxv9+QM0OtmBW7eoMEpJ44fc8VB7TXXf8A2lP5KgwOP6FJp+RgpbbnKzaILP1IxueTX4e4XGgPnbkXxZ
+ve7IUJz/oEucqo9EoAXmO0uMRLEv51U0f2HmjWY9gtis7M8p9YTnHvP3YBTOPbT3rQPct3OVHWADeOyA
+swQTJgsW+L/b0IuHvCKOMe2DvpuGFC59XEyUjotkxpGmlYesbdjQ7GDX9R6X7aSbNxSBGEajQgJQbJDw
+YcRlLc2X4pGskAAUW+p8GHFZS/OleCQrJN3KzPUo1zEhuLRCNMuz2ivohhx91QxHYAek8l9jduP2Tk/c
+WfOwR5qvhD1/5pPST7aRG1VvNYaJ+msdVhuzxO1bhoVTeO1hPbKNlg8rwX9Rf63DauO9uL0/rMhutUSK
+JaVHi0eHz/AtVXaWAlu6hJbVS64kGllSarf1r28ueAJPZP33sKibkMsOGH+2zXn+PQghGzSARL5M11j+
+LfhcMVPpvkIuu848tcCLuc7pN2uJ5RlPwE/6myfofL3Itfa0o9AJzteHwhLfR1SrbJs375ovTg+KbUA2
+NmM3cJcOXTQ+fEg4nXgV+tB5peYclKaSNchMP0XT0BT+bdt2fPv5yIVcfP2GiRqEYhxMVLknRqcaPVO9
+7wjRpW99SOi2VAKJlFdM8XAOfVpQwwEFpT068q6ZiTmPjpAgbVWOjvrQoUIKL+3FQ/ghdXENmz9SrgUM
+4p+sQF3C5o8uiL+hwWA2r04ZVaNW+ZSNfJkC/dYSB8HoMwhqhfrT66037YN3dGz04LIuegpeYMvzRGCg
+n4aV8EJ6pwHAHqmXQtC1QhDGJASV7zIXa8uxT+6N5MsdDcsX5pV7A9OQ2666//4K7VXLFPf4mcsyVF41
+UXm1BJUD3+J7rcd60JhLD+KA1t9O0lYb1VSeNcQfhF/H+JQ0dveUD3ogjHrXHS+Np1YYiz0F/d8+eICx
+zGUtU+73jdXWdFXchYZXY8sbU/ZwWsKP1phLFLc8Zz870m9uxqGQOA79uA91uQhCNuiY7OcmHthEcQwt
+B4mbesrr53tt2/LO9q/w0taXXCus2X113g2vTSI+/PcvYvcQKslaPg0aDBYQaOmDY1nvh9W7y6jcsET4
+5tX4yXNsno2PE37btn2qrZfGe6wEQD+KdAv61U70gfVpb1m3vSOImniGn9YQ5QMx/m1e1Z56QIVV16lp
+TDiyMkcnxQp29V9Bf4NR6mxljyPtLcxAW4m5+lBWGEWbBYs58pNslTvxZtd4y9Sy321uUuw1mctRLcde
+HAjB96H6Sx/GqujU4XMcVrTcmYrF/O4yG4yxKzfydDbw/XG3CduhbRxpapH74I1X7VZ4qswM3Xa8q/gS
+uhlVpVQVK+CMtvBeeClhnqgtaY1JLbwmLSjfMjAGHtv4iaiC8cTXk0Yqt5Byo0nJkD54MZKpedVOxZhc
+SQyuQaHG+TkiUxKj6xFJnIksd/utGZTXFDZapiOkjlkc3jpfZUCrT8ab9Hiw72lW3BuN5Kx2AzEXwLDb
+QqUkwhtJeuw4krl3lPhef0m/4QS7o8qSJsk58jv8MTmUtp5SdRNJ21KKcDwdDcEcPQq/6j04W3udzuza
+3m2YP4YBNNeeeJDr7Gvftsh52HrAJZTUWwn1/VbqIZNvWrfjs+pvAomdVV78N1wUEq8hG8N8JuWYBpn2
+81sdF7MY27foE1kfIjj6FhvRWh6D/KID6u6mOvsxkCBXWAC8bbm9H98h0U7Nxm70lMQeMhpkvLVCVctY
+LZVTjwdf+nECtvopYmwktJzGA8RWGGao3rg1jCpCICu61hzj8Asqv2pWftX33T69ubiR8gbd3IRCpSJu
+2/h1fVzh7LqClK3gXEJNybvHUCkb9ZmOn6KA6rTMincbtdqYZLUX/t3TfXyJ4TABJ1Iasx3bH4PV3Ki9
+bGH7r6gbx6FfgkNnN5igNT3Gw1MJ5OYEamKSymrPn6zSgWZhSgcnzgV4jK4yWXECsb/c5qZn9Y0sD/qw
+X6sZJ04rpXiHPIYbAEwk5aiu/Ef25txrEpgtcZRJHrbianDZ9zZ7rdf3TVh9e1AwjiJVP3EIGQa/lvnm
+NL+1OD2u5O6YdnRsF0XDJWXemyS9/obQfCrmVtYw8awv9N0eppgzdMGLgtQkPob1X5o6kSvfK/P9VX/N
+rVLOX6Iju2eygqlYULjo8zKrgwK96UNWeGvcMgX7vSFfNFblsMHsznk0fFyWCGXX+og9XCPD6Lez6W9u
+wn1Vn2JKAL5Gekuovo1u/N6CKCWcyEKWopZjOF6Y1m/DnfVtIp4uReE2qUMkm+fYrXhgoDwutKColXHC
+8iPj23zHMs+rPpxLkwwBO7FmPZTP8twfiiqbIzFVTbrQU3Em8eCqTYmDhqUyvP0J3CYQIIoGI1SCj7EL
+VFij32wUuD01SmOXDWfk8e6afASjgQd4JsrS6EYV+60g0sg3L47bICevPvUdpgHn32C2XB0Hd5ktdfBW
+k2IwG6dMc/dtP7/3t4sHoa3IXCgG6SwDGwFXTRsFInivM0w4GebbC66sX2dv/Mcl+NqK3ipm3jM9bVAa
+elen0eW0v3NYuPpM6X0NX+vhYtvX4a/bGnuTaKriJVmYrM6BzCo9ETI3iY8vA1czN+EPIvNbMO+eJ0xz
+wr3CxkynJ9l5t6w2wS1z68x2JqfiyJou/NCe4Heo71tTHBBPfmpCXnjnvVVmoMkPLzwuCBnAMptfUX9c
+NqeuLBfHMg/b0ye47KWm3SNLY8o9L6HmlHuFK0658/z5k6bc6/CzTDmf+/9dU85J6IJlnJpQb9DehHpO
+K/5Uep/Tk9h8Z+Ttje8v//ApjMJFxqkPwuMZI/Y6Qcc3cSjBEEw30aRnd8fwCuLqjppL78MH6HSWOFL7
+uoWbsMjNK5q3qPQ6G2xTQ/i0DTaCpzfY5ObVoEdAh4R+oskR+5j5xIjLVuXkSeh5ZmiQsuV/ksByDn8e
+IahzVyd86KallW3TzosNRNubNsn93n9jNwwwaL65Gjb78mNyH9nHqv4LIfrUCAv7sh9/eeW/qqCFmlUU
+nFGO/fc4SPWLVFjTl768IIOTOK66BvSPEH562YvDVl7hnnOtG/z7i24chfZVL/KovMIUZy6YQ4OcN+qG
+ba79JVLqaWbsJ9BP1LWPMxuuAv2G/f/VMOkt0KyYtBwkRuu3TBsv4DJeFDbG78uW2X3ZOrsXaIHltjve
+x124oNC/d2ELhnARF/2YDqAMd1u+ByDaJ8vaTC78Ma4FjhJB+PSupwbYxRgH+QnXZVzqL9HK3BItW5Mu
+gOcLVbkYl8GajLw7EoONzEMIyg151ZGGEajCcYZlf8QoX6WCUa3imefJCIdLb+cKiq2+kq+zjldcxddb
+w9eeyqDcn8eo4UpbRRxn3GXmcXUA0jW6TV7up2a+ubVerl2aTPU/XRkerC11bKIl54+dZhcZHsVeE5QH
+LspwffpiXko+mDzFSm/6cbpZiAONLU1dBl50sasr2ghjS5LQQRBe7EqY1VU4flQ0VzXHE5SJMnFQL+g+
+MQ4yFl7FRQ/FPY5BHOHSKY1ZUXw0dEeajjiuVD6vpQ25YfavoQvvuxJOdSmKKhe1fPj8qbn0f/mqm0Cz
+D1thkHHjZ/hZg57EUY0SbN6MzpKaso8LW2Igpqbp0tW7Ir6iu8C69EnmGxAAsur5TPxrLmPbDIAqM1nU
+Qk+1I0EnqKRXnrUt2rLLXk//3fNiifDVwlSN57kcyAu8Imy6yO2sraEFPr5Hc68azBVLLQs4Xug0neTK
+SyOEbIIA0GFF5Ji09rL/fn2w2R5CZFAiJdeH3/Yb1Zqh/kzl7e1mbd4514fbt5tl++rcNv26Wex79a4P
+txOoeG4J68Pt77ACRwNYH96+g7/2Gfrtb+yPZYJ3fXj7W1vRD929PrxNwN3y12jf/ht+Di1T68M71Pfo
+Yn14hzr2U+isD+9QH8FL+fXhHYJvn76sD+98jx/8O4b14df0LS0Z1offbF2+6X87fG2kqUlE0Gfe6mve
+6r1fo7gWN+GBmi1K2uy7ox7c3tr+pg+PxEgeK/WuD4+L0QBr3ctzoFqVvWs0qdwpLEal5uVIwkiNJWQV
+5NlIFpUcE/9z1sX7Bw83aPmaQpig5mSTFN+EJ48f7D072INJlkv9GUqlahhnpRzVqlxwpjrXXV1KOYB7
+hZ8E9aQURY3g1ARmAjdLgzhdc4fdwot7h3vPDg+CPisxla5PM8z/npXqLBvL6ikREq5aNdQGH+6MTuXo
+XUWp6Nc680pCVZfZqO5oJYPYzKWr6HY05+Ebo2bqegyBQl2FLVoqtcK4ojWPoGP0oNFFWH90YQAH/BtW
+CopM/d9UVjzQGWqD2l6BqZvm8LBZuo5F3CofVm2zX3SN9llcUdNrB8AKX0ODawZjulKTik4MK9d/vqr6
+F0ZoilKuNmqfroq3F6zp6rpLsj7CSnkaYYVsirBKykNYJS8hrJgKGa6RXxxWzgcNqyTSbomAdAXYZblq
+WyoH8Zyu4p5EMKcwxyFX03rx1ceHT8kkaB9xWZnafFVkhal/FvXeFXlf46Xdb+Y+6rsXyLZab8fX0d97
+0bsO1b4+HEWY6phVlY9T9OAoUaLfHvk234/Nucf44Fk6G+2r83s41dbA/ukZfI5plJwb5DCb0tSgKMmm
+Us1r/bSBs3YyQfqwvbW19ack+eE8Mvpj9AwvxSKcTsbUj3kkbtYoD1qfJtvEgfmvx9aJsfSXINxvoLOE
+p5fF11qNwV1nEX83Cyx7exnjWtjJ0WqUS1GGfNWs3cxi9REM+nXAn0Ghbynzv6+wKsIw2u3GtPQcrDgL
+eKrhbscWscuG9Y/PofZo1Uj7kyzvJhIMbm7CQ3oVJcZjiE5n7LeaVW7xwoxy/A7aw1oF6/znYrpMzOni
+pYLOvNLXL3k+xoy1LH9tU62wzxYSRR8+hFp4AKfZnL8ElUIFwa8cluyEEbcN10SpBr2SnRUo6Ne3j9ha
+L2lTtZt+CaV7T+s38J5CYS2dkNlJ2t2EHOsaWMFD4FKduzjDu00a+q28Zqeiuk9haFnx4ZYa093dxrvu
+Ddh27yAaatNOeJ0U0pfv7z8uon3WiBNfRt7QJny5ZSc33kYwQ+8dQzNGfTwF6Qjx3jVLHLahl4xW7zXw
+Z6rXFlLfu85fJYOUj0QyvL0q9BHFXEvEUWixSnCW8SomgtBide9Q41VuxKC1VfXZJq4bhKC1lfUZJ64c
+RKANbMHecb3blEkeOywJlY0X4PsUzruxMO4mjc1hpU4PhhxLpj0xgNEMgqd9SdnWTejQ/mumKroKSBn7
+Ua9eZrWvrJ0+pZbTA8YIXfMwTcVJDK5rzzeG8yo0ladERyPAtS8OIwcmJya9a1kd+8d9eN9IB54OM3B3
+SWFXQx0u05VXt6T7dpqdpBn8Cqu0VZ/Xh9vfNGzMzuibNPB6q2d9+PXtK825330x5/7J5lx00byeGffx
+dDqnF7rPSdcLzZRRobFwXmX6vfKqItF2WXUDN7ypCIGEZbZFqwV4VYvuivbYh3uP7v385PDoxf7zFwf6
+4BpRjw4vIs9OUPjmclKTmDvNTk5zZAxygxN5RRtX6HLrffadr+kz2Fv/Jid8lCkYG37EnX/KZKwH62xh
+qpDPJ93XevTQGcmCchBBh9ZG541Wdbwd2zWuPEfngGopM1xIwHQNn5YfZ8rrr4VBruOqulxcLC1fyS7N
+B0yUlaCDuvP7KFJpOY578ynWW9+h/G38EAsozDp7nbOXr8NAFIt0n+/kguHUZSbPJNQBHnRni5/eluqc
+3l+luyKn84gv8M/u63i+fSIsoxCyTgpfu+/bwTN+BpMEgs4Df4nJOTkfpFB/jhnxfPhXmJNSnX+GTvWM
+XTkvbBRomZfYdv6m144yqUafjPMql0sxBiYrHJ3VKR9CkNmgmxUJadqDrDJJDwygWpkQ9ej8UmrPhgG8
+INMOFEoHmsCmqsgXJtUBDq4yQILkC2Z88N8zUYop8GjCKPzpKihU0yXn7Y2M7GopFRftpTrVQRwP39Zi
+PuHo+8GkxXkd4vufFL/g4PRRGbICZtmFzCvWw5iaIV/kyWsgd/OiQ/7HhvWoJGED06eBYLv/nLa01oOb
+2SSCSxkvV4Qpwb8jHy3PlAGXXhd2l4Xd4GA8urB21vQRGFcDZ4wKvYyW1PY27I4dhPu2AoRcVBzQ1ba3
+X1ZoTarIPikZpjl9IgOW1j5WBfOAtZYEHK3P6Diq5rxs6RzErGWHyGZ0OKeVuJC5vjbjx4sjENY44EvM
+rvvED3nD3/i+C2bxuzBnZPB/BhHL4phonwODqD9rtWtFsDWGGmFDbAy77RYcmknEQ7sTd7x7F9aXs+oX
+kWdjY5rQgHsp0jfe9VFBwzipv2Nu0XTGTR/3y6uofT3nw9ZuGgmZ9e+lYQ1laU8CMfOGgj315k5DiB1U
+0+JNF17uNMNjeEhclxyrppBjLgm7XJZPrjlAv1JgCE1lhSxt+VUeoY0UhNceCOatW3EMnvtnK3OQV+vB
+NfarJfvStQ2E/tDNn/0Ws2GagT5zIsJ2kXNeitl2ku4eBa+YfK9thFJMjCtRud3p+b1d2V+ix2v3eSfu
+MxY9zr/Y/6vXMO62LZ72LGGuTrctH1fwJMNv67urkILlV+U7Iv+LUarDj+Ki+THYDRmX1Y3BSFVjBI5s
+TevD7e8/3oP4Ko/gyA58+ab/ty+W3n+DpZdeOF/P3GveEMAuMKcMRFWh/vrhg5cNoRblicRlEV5mb/Nl
+tihP5hTby95kZ7duYWWspwm162rh9bWDg/ajrNDVsBGqDxqVWalqhYMYnIrq+Tm9Y5dlvRhgmLQuN+kj
+BNTEgJF8/Y5fvnMp/drB9QOX9uaG6u3QFuMJB+oSmVzN6xc2sFNXHf9GXVRmQNycQw745MgKPOGaEWAL
+fgb3fNLNehitdqtHsi0r5nKH6txYaZiEQNbzG+uR0t24Ov6NCNocXsoKbKOOt3pNcw1jFf/fdBuQtHe3
+jtPdjKzgHh7G1mgIXv2eMqz1Jzp/+yG82+4allwLkNh4PJ19/P2AhfAZLgpiaw+7ZKpJa6qTlKn0Cv/d
+5UZTa1qk7/RtosqpqI0AF1Bl01ku4amYabOWgaRKEIXDGRuH+I1XN6k27KjWeJo2Z+nClcxp5Ypu+9e2
+qq5ySbLmJ/T/3A8rl3mkuZjtvinBr4B2WL2EaBK7YTiNwGLDTj+eT8ZWKipHH35zQWXcDgk/wG9mo/yo
+UDQ3vKoDeleFK7Hal6PFKEcp9eFDA8UNzygIP+zGkWqXVr/l46bb8K4WhqbADZ1MX3l+hBmIsp0gnJGO
+eaYdNGkiEW9tMRgvsft4KS88TPox1qwQxKHxokouVPiLOLJ204Bkgghbrzo/rnQqZPB1bLXLXtuaPLoe
+GqbIrB4Gyb+ueGyr3XY2tuGmbxuGrd7nOGsnzQy0L2yOzF/oItVyErf3H82U9PpyO0pJT0zjpVL+oMPs
+GONhkNDZd/xzX91EODnic1YjVzR/MRmdkc2SEsi3ekYxmIKiRtCuWFDpiEjpqF3YvJm7w/SvD5FRax0Z
+ylX1DclRXb/Ib+DZo+MGrqiJjBddObZpt1izdUP2LuUgV25gb1ozg5pK1gs4pr8r3Gl2FbW+G33oBgd0
+jVcPhstQXAto8biifVlw/PCQfq4kihoT7OiOtuF32G10cLcdinY6vHrpN7U9Z2vUDh3hTpSdFP2IsEP7
+V1zC0eY8oq4lI995v2wN34odzrB14wwNfX4MPB+XdLjd0GPE/+Vq+B4j/q/+mmfq90z8XnpzD+VplObc
+S5Lu14qSpcdqXvi77x6nPDQ+zv6YnUoXO0WfN7sOvJ5dSvhmnvNob4y8LJPbppdE+zxMK3Lt4GbnYRIQ
+L8JZsL17/G6/X8JV56NPOxt9Jgcq78jyk46HPjGpzsJjxuo+RHyJ/mTZicLr9n822EtDFXB+mo1OTe/W
+Y0OTQ44H8HMlxyAHJwOayndSzrLixADSAaFFMQaOZQlZAZNSFTQmRbk+8QlBOKw/6tBwtOzU4CgEu3Dk
+ezJzxsXG0aPVmMSN+/C649p33lxXdYRLbzN1gNxCWK4Auhas+0WbVGNTSe4ed5OXVitvKsuDtfg6Yn9t
+5UutSNv8tRQzMmUtD9KyxJoRhVhpRFdZLoLXwjgp7bcV7XcU3RYV1Hyxgnnz5l3zzd8n/M/eJmN02A+m
+MNqAr3sdslRd6TaC+TDr4WhMJMHgmMmDMv/Y4djNsw+ffEtCM5z2l3d2wfXhd1cFgPm8tyz2PmWpE/33
+q1ytdJ3tflaqkay+3Ld87vsWTjT22UKkrGDqtfOneYldJTkuE6VBAFHXZXY8ryW7wiGeJiMaIZXQb0z+
+tY9Qbqgpazb89MbqKVHyavYKs+JvmVqDFlcXiooPGCimjaKjxyTz3LrQBArCR7mYx0jYnYYUF8pfYfiQ
+qdmp2CyMeFRNh+EHy9zVWx23S2MXoGnlzbPiedF5MPR80rAJNUcNgwS8ffvW/GnFhPngH8jIebjxnY5j
+vmM9ldpDhLZLByVaI/Md2Bmkd+YKO9MnDG5hPveGcPc/f6sumuO42hE7TdQTPsK/tWTgcR0FuweP6Mic
+5N8GxDfQIp925bmMW49kOwGPJ5yahIXHuB+1NnrySOU6pSb6yNtRp+wIbyErqlqKMV9IcDQ0wvNtaJ14
+GyNuOptrJ+qxrGU5zQoJ56eSVGyLnA6+VsqNZU8C2kK0fMLLAPqF5xd4oUnGLRczaaBN51WNw5AZ4fyW
+J5LzuzAXvR3AQVaMJJxLnYMrs0Tgw8ei6kNWW1DVTI6ySSbHbolbQRnd1qz+NqH5IOFNb7nfuSrqUuWV
+zkbjZVcl7ZZd0fmBLlr09Xb1EsRFFokdHTc7frbyBwgbQjE8xa0ofHQ6iLsN+bJc+rQLEiuXyPb7w11e
+zD9+qqgJ02msxuyfk6h8Hv6/jKhhwoqriXp/PnonmUaiWNibWfcmJCtqZRaMJbwhULXK+xmzm7U/3elU
+mhmYzCFQTfnVdnh6oMCUs68UEvJm5dtTD/zjiX1SIvjJicdTtiirKzxLZdP51Ecm7H7Zy7VP6FZcXNHt
+kgdxyZ2dMvsL1NahlLmoM9xelLYaGRshHKAGJ1gznuTyYoPa3Xvx2C4n3INO63o23Nw8Pz8fnN8ZqPJk
+83B/c1RVdzawzbG62BzAfVFxasY+1OKdJI606XINNHlRl0JPsSjGMM4qrY1DRvoAHs7oyJIvQIxGqhyj
+UK8ViDw3WHcsbrm8+DviS9t9NQD9EKXCBr/LUkG3UIQi5oyPNgPddhV6/uorAjx3+thEc4qbUFaf2iRr
+cBOujJ8avXYSNWQTEPHOISiFeC4vaDr7oHD7Xqi57tdHiJjJ6DKVrKFWsBUO2bs8uXoX3LuYyTLDY4XI
+TYrImB7sCWCSVcaUmaozrbnBWI3Ir403azWvq2xsoakJnOlMmSD0QpnndJrxTjl2hzdjAp2GnjvXaQZJ
+m6rLuQS67a+881DN6fBYDFrUDbCZKOtsNM/tvSJPiiilQTfAE0Xumc5wSxZYOvAbYKZvBXxW1BgQk0xx
+XWhGpiWASGGCvThrqmcHnsmSnGtw9selmiVIIAMK8Ctn4gY0Kdtkz3AsTwX+xUZlLLPnOW9zHhtdC9dW
+hrpgrooTGlBWmXHHZ8qGc0XMY5/vJZm7Wkt0ah9+eyqffvW9yqOz9/77DDQKDWRxNnj2/OHe0d6zXzgl
+xaxU47mOdeoF3UJqFfIc9spSld2Os0G0eWWZA0Uhz6RxoOskk73pwF8rWu90mv7LHntH4gLpW3vKkR5X
+p9fTxj3PFGcKMfzxd5dv+ttbXyxpfx1L2kf4Lv/J5jQRKOucIGq5We0TLg5d+6sNbFTtf5+VreXwS3T9
+A4/A1zpYMDKrHS9sDs3rnTG4j8930vjYk6hmb7tn+gTQx3zU+bO6U9G+bi0obOEiHP0Y/bhDG2CkM+nt
+V9iRRqz1Rx50vblZ7bQLm5v8CZcEdc0yQGvqYw2y+sRTcSKBanw0/vyqxV9YcWCJ+QdrD+b27zOoENtf
+3jl9dm3hUJsOamVN1+YIGk3nAB7Xxv4gxpBnBQ2PI4jgvoHHSYrQAaqwJ1AuzyrPaIGOAqLkMwsfcBAO
+tq5VeB4tS1nNVDF2gS6uo+Q004ysqus8fP6U3is+VWfysBSjd/ErnkQF83qFk4iE1fnbv/PJjo7gJaaz
+OHCXmM6Wx+v6qJwIzRn4BM3MB/OZfLv00TNWYK54t6KXCoWL8R3CeC18lEOYB19HdKRdnbwzjjmupa8m
+9nXcYjL3uBszUVs8eImapgWMs8lkAAfKGglLSTYxz8akI7uo8FqkrmQ+aQZ2WSk9xHL/ORISgiwe8iRT
+hU/GQUxtUVXzKV+oHUvQA63VLNWIscQoQj99bFSvFAkFEbEPlSOirCpZ1BlS0r+3tCbnlLlZxyq6lvHV
+GJEZMPU+LsXJCcUVYvUoF7MKKfLRtmXqR1x8XD/XMCbrtAp6jyBPIhORyeiojVBCmmZ7WHkVTf9XG06K
+zi8c8DuXXiAo1EJol2+NibRXjK++MeEGdHlqrqj1wjmW2LW256aOLp92xfkZElhEGqrWpbf67vVOpcqH
+MkdNfWvNRgH57CkSzhMZEs61e7437+jwdoMdIKfRpjvIqofIpllx0ghb36w8ErN6Xkq7c1fp/q7MxWkO
+H1HjX8Nw1QEZk3UbIYg/RxKMaVNxwRNBQmPpGqdS/NZPupjuFeO+tblT8uHe5wyQ35ihUtLu5s1Qb+eK
+sZkjyUf4Icfuv35WRY5LH/jim029kUPDPAVDDmZVD7eZwyc+S1Jfg9IPGe/8MHlPTT6y4Xa5nFzV7HNm
+bHzvBzqZtGpk5ZOskKlQZCu145SWiF5nCDe8oWndzAt+0086Ky/3NG4E6lnu0dwcFvLavVKKRG7K98t4
+YS0I2RM6IiPvhg7I+KWZJ92XiW0cxTVhFzb8BNaXlrsLeR68pWSO9qQS3ApSX+s2TAnTks4H3WR0FQM/
+GWfFeNlvbgb3k9w7d2tUadStZCmLGo5lfS6ljtHIT0jzhRc/Ea1ExZiKUaR5d9tpSe3ktDeo5C5nxtJ8
+ZJNQDdr8xzGB8CfJt5RDd5SVuBnuxuoUvetaZvxjlXHPTmwT68Pbnys5JnHT+vDObeeBjXad21/sOn/y
+LRA71q9qDvl0k8ay+5r2oCHxVdUyaFcDiuOPXCucOZuFHu8fHTz++zPYhVAow1186D2EW9s7mCr3Hl8L
+zGdQK/hmduFf/OOMmft+UUrtolrV1lG1qDI23eta1PODvSdPjn55fPD4/uMnjw9fHR0+f7K3f+/Zgz3Y
+hW92UDCS5W6iSpjkC+xubS3Sgcyz5y65uPS8p9Xv13ijoQIKpVnM+a2w+2IzEhsBpzWNTmdnLdJZdEnQ
+2w6nSLfXgMx/MJX1qaKjvbyQo3ktQcDIRAcWJyIrqtr314GT7MzsD6dZPi5loZeOvk8ZrLkIuO/v+m/V
+L4MWAPBA/2xrbch3aTHSDR95Ma3lxYjxjv1ata3URFQ+DV8G2KmZqHJPjE6ZQbsGw77tkqnN6o1BeKAb
+edXdrTl987UH+jDARU7z2LrCeu7CoomT2Wqa+AU664r9ua4MGI22dwzq7SzjlqmYWZaoFSkD+ie7z5Df
+WMV3i+j7NS9qXQHB0TUQm59p8Z3IQpaCmM9B4lo4pKyubGejU1Gc/PW5jMbGlzoanF6U1iUqyY1TMeMZ
+qlpZ0SiJutgmb7wGi6oyO8kKkT/wWdWHC7sQ1HGq5KFZ+Dhq7Z8uwglT7ou+QWVrnl/JgDuXUEg2LWY1
+s4A6k2Uw41rhLGCmamPx8zjGgIoZx66/YCTXWoec++tMF1YPiPWQOHSV6CcWswpupecjuZJDTOIV3Zyf
+UbRYo75g17JG3GTHttA2Hd0Cby7boUN6tCYpXxhhBrxBD2bz6tR1Y7u/dHnUNjfZgzZ0A3CzjD3rxT1m
+zYAqUy1eTPPZLF84aDTfdqnbsTZH4I/QY/AocHEwN2F0WYpqEM5dEMYYLB5DnxEuo6A8noi+Bks67H3c
+42l/EEjvNb86CgM7Pbaanhlzq+zqktRfEkgPsTFa2ND+hcRo1ZCG7UX9NYiUo2H0u78WraJh+BPLndQc
+en/Ts/ErjmE8rJaXsZ7Ouj78vr9+pXa8PtzeuuKg1gwtisevO1+OX3/y8Wtfqbr1tBVWXXau+bynos97
+TkuAw1HrNWwWUwpQ35YTyGE7UljzkJ3Qwzq0+JqdD85kWXHwuM7W4M5gq3P1GsV26RVqVt03/c+0eHEt
+fv1lLf7pa/H8SxDfawTx/fQwt3+dkLVXi7YwzvMKwWtXzWz3mcLVeiapFMkHpnzno120WSGq7CsOZMPI
+m5DDwmp54jJsG69CVVvXMl6b+QKOFzCWZzJH7hzAY35x3afFX+SLRAcGGr83ll43WVHLkt55tfmHY2rW
+jw24q9t+/pBS/9ui4F4nSLB1iWCvcmObaTqQP/iY+MGfLRhXW1a02AXl+inOVqeQSwidJlMik/R1Yy2P
+s6qmF1/+/RrdKMvxiQxfIZj7NSyeqqoG/YzSe2JnBIG7oTP27KxwkFKjSIc9W4r/r/4b3sZUf8wD3kdZ
+KdlfVfCM27RyDZ+gnLJsX+UJlAA4pmzcbXCDHN5XQf+/NlkeOy+0gJB5/qenvvvsLiSJJPZLXUiSqeGu
+TAe3r85XzwaHlcNkcB4mJOjgv+A2mYO21wIbGgXB9iqPKRSrQW2J38kyP4wg7535HN5um68tPiDLsri7
+/WV5TGmvO79RbycJqyXjVYve6AZOdjz2OqGgOFXnSqYIg4BuRTFDGwOMo1XfDgOyVkEPfmtTj/WEaJLb
+IqC2eix4QU9/umpkLqJRzIdr/rR60eQPTsVYnccxzf2yboMwHpTGdvpp0+nAfdycesVui4SbgWUzDhva
+ZOxm/RYxBBtXc83WMq5pkO8vzTqfzSXu4+SU1SDC8eI3P6In/eZQnn7bQEsIIHglHhz/axNanP/OwLLf
+PUjuWwucvYLCUjUBUUEMiT+2gHoixZlMgaKCGBR/jEGt7hO4WpbGhnMg7pro+xqmT0tJ0IS+7ooCCdaa
+W+0aUZ1h86aOw2/jOztHwr9GpOcgtYA//kSOgUCIR5FYcWR3Q/ULNRIsgh/9tBhL9CeAxLz6s/gwQzNh
+2XBmTTQL5rLTJsh/hC3vIi6lL/oRvvuNfJwN4Rcn5VxB71opR2PDA/3ICq/A2xE/rZjAj6pSeb8hofvx
+ThF6XAaiz+/fK1gNC7/Bx+HSktvwmukMXfVPwEKL3QYa9P0aeHD9T0BEC+0GIvT9Gohw/esh0hY6fp+U
+wo+y7/2Fw8X/9WPBp01ONgKRSbgD56eylBZnG/+yGLO/jcwnQXes8B6q2Z9sL/q3GgFiVdyrwJ/gbuLb
+EDeZVXIRbQVLylK4928III9baKnOPypovLbIR+Hi3ciCmPGOjawHq0PR0NkWJYPIr+RRv6/O2yOcB/e8
+f7sqzPmnxjOP8sNeGeJ8+5svd85//p3z/Tm+eln5ztnb0mAXvDBZdMNsfjEbeykw+OJWJ1NoXEZr5d88
+KGtcRI9lNSqzWa1Kk5iCLqLd54Es5lMd3Wa35fuHD9ph0S8fqWKSncxNS/LxI4W+Q/7iHSSuq97zm56X
+WR000zffwdgXduReS3Sb86Hu+FfcjqIPVFHV5RynkwhXK536jqNCmTerJkCLLu41ie8BcvfyPsgejzmA
+uwxKiMKOQd2rgVf0cNnDV0Vetl0SxXiR8AC5rJsVfB/U91uaEd2wxWD+UJOgItZ0kWZwHzXRZkRRqJrc
+A0Gw/OcwjAaTDpOcWfpxUcsTWfI6OJBxttuodOUYFllxJspMFBE8+9nUe/r42dH9nx892ts/2n/+6wHs
+wh1dcO9lVPAt3o1vti1hdi8+JRGuB0335vpFQ0X6zjFVRSVscxNydZKNaCUmAqzQspAVqHmt9TzUxYzC
+5IV2WtOv4TKjDxpVqxq06Mca31h8rIFj/pY23Ti/X/VAzYsoPcqYwyLt28SRQakJeOmX3TX9Ekwu4Zjn
+5swQsy5FD2rD0l5VmLnuxiiRB/JWHzqNgum8Kv7Z4bjn/5qLHLYgayVHx/TExqljj4UpG3DEu+Eb5kbX
+uxB/8qsbuu2r8+q+PMm8zKfNCnvFOC6eiotf+Hp2X53TrMEuPBX16WAks7wbTgtsNnDpwS3Y3mmMdt/w
+gH0jGi2oPncyyZUqu62obMLtXj9ecgG5Sq8j+3dUweccruZ/iaHZNwsxBe18hB/8uT6RRGfYDX4OjjP9
+StNHXReizvbzbCxqObYLcGl5BI2utPz9v9vClX14/X7NXU20gdcKPO2zYdSIZPVuz1kZfRtbKfHSMStO
+7lt+gF24DTc1USM28W1gtojPL5odp+Ki28byG2mgLqMWoAzMJXQj2D+0rSLfLZ+riPF4X50fKj3sEFC/
+DU6/ZRVuwLZFDaIR37rlShJk3NiI0+k2CJbsczVKuPX01VfJSfwxTDL8lyeOcVG0o/NNmZBYEcsXQFdb
+FMy+NcnKqt4PsjY2C9mXoWWl1Ar1lbDqTlBei9zKnlrN/MLSTXqAiV9HFuNwJWVFN6gMt1o3g37MFu7N
+TPv+k8REs13pGM7i9eFDMMgf0nIX+bGVW69kSZe/Opwxh0XIdT5Ct3bTe4kF6rW7dauMBk7vgQ5qVUp2
+FQMxqfUpNhdVbXcTHB2IChHqm2hWHE7cBzTOqjorTuZZdcruPjUZzkQpoVCsC7rY2oUFHhGnqRTEWF+u
+XXv9RCRvWUfxxERLply2lH5xaCcr5aJZJ7z6KdW5lyI+0s9wy/0F0TVVmhOMMHziGdUj1TPdpiewdhoT
+w6MYh3Lsg2t2catFvboJt/089MH4zEvtr74KGuuRHmA6wB5mjY8R8BfTcoKVcpaLkXw0L+tTWUXES09Z
+klR9aFD68opR+UhubsKvEi0ynRpKOa84J4SagLzg5eIOP9qxMqs4y9Gvkt/2hYuMtSlS1k3DFQlyIutn
+0lZ4pEoiSUpQuAX12gP5JrUUw4g/+qUrjwBEXkoxXkBWBe6VHKTTx9UMBFQJWR2MVhSgJ5JAmoicmQ7O
+2SDBNVB3suJN8KavRUfFOLDd1cyptgEZVeMz1frw9nYjoog9+K0P73yPls1vv1g2/2TL5gFdfl8vvMgX
+4+YX4+b/ZcbNF6WcZBcotMozkR+WMnrH2Sy3MXiX2DepQmib/CZ5Ge6vwmvY+/xmDZPfX8ji5+MZmuJK
+c8CqtC2uSeiuHUvT1uVbhapalXLMBQYaPeuwAHrt/lSmSuRJlYD8OnvTagG8DEfmmcEaVrCZU1kC85+O
++m+PlxbMzaVWx2saKa+0wcHdxpchxFzpnUZa6HEtq52tyg5ZpIrWCjVT2G0pSBvyKln/EsCG3ZaClvbU
+zf1F2O39xbLahyqsfaiW195X53EDfNjaNp79xoS1lLSaNh9EjJX8vswwGivZ4degpTfrc7JN6rXzuDDk
+724ZW+BV5lJfdkQW0+boW464zYro9HSlaLumxTpxAm8wXDuCYb1uq1yOkVtpWaWNa8HUt1vZgmrdpstq
+ZJIIJFi7baKFMdqMFG189CnWv+sY/bJlRj1tSkMYP+y2Gsuy2FLWYijjoVqG7WbxQVXNnAUs2puw6htX
+NQsttJerzca9Y3UmrzUhQYtu23yEFE9SFI1+EVW53o+7sOWI+GOzmX8fFYVA4uilu6uSN9yfb+1y+4io
+GxurEtX2tJySDqGE8S216LQdxthft/BsY3//uLuEzfRy3YotOzpcVLhFXG1j1TGjvBvbFGuaZm/i2eEo
+TqywaRgbyyEkbDZaicQN0bMsW4iN6W2B7GMRNwk1s1u7GvGdmKzh58u1FM1bLzvMdrr0zsNUWpVT0nzf
+mMfYsGxoeuLRtDcglGBjNeZIbYlamWrbCXVxI8Ry05PTPPVjOQC7Tczn6Kx4X821ZuJWdW8nAcFTcMyn
+aLQh19hKodRtuQXiWn6lWWy1NB921rxalsIPzDpZcTpxWTaGRv4U/gr05VzUVUo8nNnbqEhKtC2oDeja
+LjYapHaXV4gtzTP8qDG0KOp9QJfyNpC6eGrIOR/qDw3Uw5ohJSKJb6N3u9NaiyXY1dzYbfS409rf8ppl
+4qrVTUtiL1hGkpXFAf933QlPY3gZWs79aQm40RB5I5oA7Sh2feZrEnY1tvoxWiYhKzVRaWWmjU9kJh9Q
+s9udNONdVdEM0ykxSxmqSYxo574WR11/HtvXTbwFJ3nOl6ZTcdG4LAo39o2k/r6zFnVuvKi2+vZb34ce
+5iTxzT1OykdbkLvpv2IvW7qLBfuOAxluP21bnld/+T4f6vUpPBhv2G32tQGNjW65rSA81EU99HbaYYTH
+khjnUO15H+r3Q0gcJd0LhWGERb+xoQ6jmfeflXr8Nkwy4WX7uZlVwHv1XjG+QllMVV1VZeQLXBNsl4kK
+slDzE+3fKpC0fOskczmq5RhUIbWbRDUvpcsz6ECem/A5WM+2s2tD5DkDN3FviMZQnapzHSFZN3EQVaHv
+iyn2TSlHsqj1ex2ObDXHHmzkaE4Djy/Xr6cak7Tj8DneEisTvj1sR0iJs6j9m5TxoNV2EHcei+So3Bfs
+FOT7iuZthIjaBZJ8iRmibai+UF6iB6949khLaLoIMxB/aFF2d5uHXy0GQuncen45VEvPL4fKX2hWXieO
+MCG60bRwFGRbnEv2VS9AldrruVawpZeJ90zvWMJknrtATz5AVeDMtVk7ApVkJQtufPwNdKYoQoUvP4OP
+VwvN64jNQM+0Q/vxOvv9klnIKkd7VcIJWa5LnpepuDASjhijb0KW+8AoHv47IyUVy69jVddq6mWQzIWe
+Uz2Hg0AZLmPfUntP5BnQoLm0UltCq6fPEtXlj9VdrH9xUonxvYiXnd+/KDN/dWXGu/nqJGLyUaphqFVK
+aeCH1PWpS7SuB0OPckR+LhY2CNoxPuTRr3ywXa1MPlKz6E0KZVFrKMEr5DYZvx+GvXB2R/dNWUfjWPB7
+K9ieJMrIQdtf1JY1lOFFbrax9OjU19Xb1syVe27KOGj3uJXMZP5xXPlc3soQ3gXvx7DF8YJnnBKK1lgc
+TjOlaBU2+z5DodB6nIqEvO0mJjRgsJH2TZ9Wmzy2aQhsdL3HE2IvJ8o1JK0yR5yX1TYgAMM2qXNdsn8P
+ARPkL4IyMNkSGtsI921XgszVOchi7DfuJ3HgZEjHMtm/hcfd+ZissHK8+W07j3y21aI3S/apXkW9jBoa
+F3oNY8WbCW0+4cY/RMIzcRUTLivTspdQZzQePzauqa5UY5Kq9kr6QAuaja38cpmsSBrgr3RE9W//V/VF
+jdqQO2rTrWh9ePvrwCEV/U+/++J/+if7n1L8j+u5ny4LR/4xmeL827VDVetcv1cH67JvgX7143VdM1qX
+B2BpwK5Lt0JcE8q+0kD/US4v/l6q82uMwDT5lEFYGKlxTEzhhw+wlRqPaR0OCR/XlNnxvJZYzvOyFo+q
+v+ZGNTHV4k2EbsNMYXDQjlRmG0VxFIaD0zFiQh6xdL00YwqeWnpkbUzOyAta2mhkOMpivLPWkjnqM7Gh
+C9gJ/uy5NMk3+GM0nT1LthQfxzwMiSxQUQYqPDlkhdmFLyPcfNJ4r5WTqMFmYh5uJsjcW5JNt60THWEv
+RkuDCmkRArWxe2LUNnYhOY6dZn0GbRs8ckyyliRxmLqKG/XhfTrdLgbTsX55wdqwK8N10PejNHnDvgyX
+sRj/Nq9qL4cK1aoaS5nKgvUsL2ak2CfXtH6rlVoQmV0DDf7nTlKLoC0nntfMSbRkMscoB5dDLsHsOmvx
+ms/gNvBxKGYcnLS4aJMxV7UL2BztLQG5YSPAypr79Eq5l6a8ZQ4mmC77iNlwVDFZP6KJcOtW25sTOfWu
+ntJrTGfYTcuUpnBfug8EQHs7be1bRVLYwzWkXlPsHci6JlPJbnLnDVHtJzHsXS3gAoSvFm8hfA3ebm+/
+MUP9Bj80hzFobni/BQny7kXrsxXC69/eNNPl+fzbLmhNGOqGtLV9nK8udh3Cfa+McRjGSC2Twi0CeLns
+9d7KXymyfPWGbqD8yj9ABDvQxJKsp9GDZRKqZ6YrUjDt12SuQu8EokMCBiOJVL6+V254eNj40l+D1DCG
+qY9Ytzk1w8S3uKa3lQ7bClZJa+hRwJyXV8hC+LcvZ+U//Kz8kxSz5rEYq1EQ1qfz4kR+eab55Znm/wvP
+NDdv+nm4b16CiH4f02+zdx4rlUtRXIYZs/U7NQzQKUpRq7Ir+nDMG5BuKeAHOLZ7Ba7ApS8ysUI3q+WU
+FCIDdvnrSGwTPpMiALAL/O+HD1HEK/RYsTZbqmOWXvB00PROurL98eFDc9h+u1MpZtlk0W15joXIRg+v
+5HRWL9zVjE3n06T9krsAAtJ8SegPeHd3icP8TM2WoHBzeeczNfO6Rh6O+21a6XdSDoUyr8OJeb31Jrge
+x3v8vbjSgPoPL3uxa/TbSeOUjPJkOtTxTvbyxqOFKiveURjzrV5bIBmZt7/SQrU2QWS35hCD5YRGxRhr
+xe/WGHc3wlu33mj+D8lyPD8+zuXPM58Y7oIngTPWWMIYrN0u546KI7G0cmY7vaR89wlMKeW7T+fKAFvH
+km3+hHr1t/kQWuFgO3bagO+twSdSH99bsN2jeIE77tHW1o55PdVkZsuqWcIZJYW54Yw0g8qitpMNLs1M
+G+0dn2XNp2rNRZ6lXPj0MMNZwvaMzuMEvTITf4hJFXrvuKYNAfLaA+nkDbkXZROv0Q+IO6oAnsuX4yu3
+SXRtkz626PkjiDnN4zXqsDoXs6Rg8nGEXSdo4nqZqWGRiB7b2aK2WDYRZxhW+jycETHmipwRsgZqnTFX
+5HJSk2XKMAYGY/Q5osEMJT/OaW8SVseJMRU3tnfWAg6Iev/BE2/h5AeoRoMNgQSO+kkus/VTXAYBwiHo
+Nhf/YEgxeZaPydWOBhWBuXpUrkF6WNjIG9ouzgbF2FsGyMfINn7TgB0SLUJ9J6h6eRUNWXicSxirolOz
+X6Ig+AkJEg9oZYmRXPnp0abFhSuPhYoRFrbGlWGvULlt9yvQpf33aO/4fhV7h3dOmJVqJKsvRpBPNoI8
+nk7ndHp/rrMof4lS9cX88ceYP4hvTtjT13Or6B5d9OHo4jb+zx0z00fiRGSFJemRaTA0ez5XMNX54hJ2
+AWHNNMnp5+0+PVzJzsgAfXRxh6fdKj1sW+fAIzbzyY7rX7OK4T7YNdN7Iuvn54WZ3od2FrsmdbhBQxOV
+W+96vRjkLSIO8gszO88nGp6GYuq6sJCG6B7y5q0jHDnlbgdpAbsWK/x9B3YtcXZikhtnAUd7Zk/rLBet
+DA8V/Kn9/RwuOFJLaapxImselPkcEyc1Mv2NmwzQstU1Q9Dr5/KvbWlzqGXFqSyzuupW82MSpH2o5jNZ
+0t8GJ2QCNfEK6JV5x4LF90tRoWGMJKIHWBcvekpZVYjGdF7VIDNKdHUsqTGo0sO8DziyDtyCBi40JoO9
+EymOlXmX6LoWIbq+FHoPI0foIbw3RwVHHCfDh7ws+2Bkr85RCL4Y52+csYjFmsPbIX10RDgcHcGuh9iO
+zcthtkeKbBpl5QjKrIPcqjk33snF80lYhz6Z8qksT3QypMjNzi+x3dKG/RRLmPb3Srpq9usOUnW4s6w6
+lOU0K0Qet3EluqODvQf7e4dH/9h7Bbs8iO57OHr4/Nnh0d4ve/tHh69e7B0d/vT44MjV5CSlfIWvFbYD
+2jlgKutTNa44CG1WnNBzQhRR/EsVdilWoCbwNiT620FogRZVJcva1ulm5i9WoF0uDFvgr/QQdh86kXI0
+hHt1jZZV9iKvZA2TTObjCrDrwuxCJqJsoWr8iiunY3qBRjcDnMHLNrJMVAmlFJUqiDbHal63EYRxfDuA
+Pc61skbZByRMSil/lzBS06koxhVkBbzV6vNAFmeDZ88f7h3tPfuFBcusVOM5i5a3MFVjCbUCkcuyJmCz
+Up2UYjrlN1k1VAoxpXx6N3HMggmEyNYKELNaDuAgK0YaESzJKjiT5YKum4sqO+OXXOJMZWMYK6pBiqio
+AeVpVoDDiic8XJvPze7vqaFHIZV5/onGZKkQ5cl8Kou6gvd08f4Dz92Pl5Swz5XikAqgKqAmeob5GUPf
+vr+9ySsGmeJEoiDts8f/RJVToprjNg1gsMYmEf+uIxpOd/ktR1TbXHigftVN6xFRCyd7e33oeLK3w8fj
+Hm+uUW9EzNduYRvPjYiniR738typjfgmQQOz1O05D8iVGNKdgqPuxlLOHhGb7ytVP1Nj6YfZu7Q3L3bH
+jWjRh5hddho3NY0mlHY5vLrh+olnNQ/3XuzvPbh3uPcQNmBWyoksoVZ6JdeZqHUIa0qYE/OBefVhzWyf
+lXk/mn1bbXp60w9teer4t4Za0M6RO/E86xpiNssXqBE3uchaHdTxb8tCBiZmZ58aViCI/vEW4yKEj2XB
+eTxrxSfs+WyWZ+b96c1my+O5ffwmvbd0/Kap6sPb2RwF9r1igfjxzIAo7QsgfZLPilqWlWTqosy3E/KW
+tksCg8MXugE9UKLtc2Jxc20G8Eyec4eilECh0Mc6T4NDg4LJ02bhtYw5Ud9VheO+9BjmoBYlb+Q8gXFL
+ncX6EmbzGh7xZlorZkgcNxM6bmyufOJ+kf1LWc3zGtSEgNBmUmgK8WZ9xSMq6SkHfUTL8XG7dmGfJlkF
+Q+vKOCw813R4CKSu47cb/lkHP96gaLqDrKJ/u9gvKiDFmcizsVsHT8VsUMnacj+PrBOvgZQciUa1bI2Y
+U21irRzMT0RJakmseSBeTSn5nojOmU9n85qm+LJnOQmeKPUO1LzWuXkXMCrnFSoUQ8zbC29Zwey9hVrB
+yVyUY6BjKrJmfW12ZKS0FPJyB7MuN7ObVYNN0fhVnFyCHQzQ/+jMuvRVQxkk7nHNuIHwWd6qjbWDGaVv
+ICptPDrm7CdvK1m/vZK7rUEqi6fKDq5vMQ5lODEzvLeBAGbz+rVtRFdMulnEjSlGafS+lCfHMpe1XMKW
+oQiPNywnhE+yM1lo0hc4haWcqjM5/nP48bkWdtHgYVKqqeNFHiyh+04uWnlxXKrZTI6Zs5LsyICW8JbB
+JyEuiGRIB43GUrYKp6c5tz6qIUuN1GwR8JS1ByPtsyImlWvNeiPWQlnq90CRTCOWOxWVZ63DZtFlDyLy
++p1ckJ9E2Jg+t0dZWSJyEehSQftQytkfoJAYtj+em7f2nhpi6mgtBEo5mpd4CssXILgsZuS9i5ksM9xv
+RD6AJ9k7rDtWks+4p6IY5xLe0s5VvYWRKvHSIV9cX11okczhxm3h/AV1B5zQT9AfNHJHLZBaWYkwqdKc
+VGbyTNJJIGLPTsUjYEulPihce84ahCUwy31xuNvYOuMRQK9a1Ea9WoOpmHlHfFzEzdfetnqwbC/b30RH
+VKELy+Zp0PNY0HNDpxA7vynTmqsSPCV8Js81t7LgW+McRwrwmUaowxuRjoTos7DEn8eiMozdqUAWOMcV
+h50Y6J6whTtwESHV8W+98PWiuXPDSzes4u7c+NLNPmJBMUvGvup1FjxcnM2vkK3hiI2EVce/edPjXSo4
+a2PX1OnhjZ33HbUO+n5VJ6Zi0MnyJv7k0re+hRIbEzY34R6eRin5VmEOVCSa/SmCcTY29/0D94rrH83p
+MXxC0xNMja7fOjtcDrumYjhH6vi3eIq4nkfA1MvQiELcxtBV/4of1eCAWw2qcDe5SYb99IDOCZ8BSviF
+bKwNH4TE2vfMXtbkfqTNU51ez2TgCnBaH97eaqTcwg+kLa4Pv6ZgCL5ZfX349Tf9dQN3fXj7u+8u3/Rv
+b63iBvHlOv7Ldfznuo6XF7Usxp4oElWVnRQ0K3bETKsGe2wze1hDXIpFtIfLrqtFLBJr+VzNUC02B0bi
+i1coN+mD3m6AkTSCnEvpl74VNtTgejt/9WviL25Nn8etieTzql5Nq17dZhXeMUSV6Nsql7uf8xa1cTY0
+dABB5glR1FKCmhi1OMuzegGihrE8kzmuJ6izqdS3ATc1PfjiL6tgXsnxgI5G8qIuhQ76Kji3qB7bGGoF
+spgonHO/G4QnSqYxHs6BLy+9yzySAzNZ4k0DrSe+6qwGjXEhLDueqq1Hguf2lj6o88I369FqILQ4zJkU
+1QLOSzGbsbG80a07NpseagUUngqhFKrYcL15HZlAYlZOcPC7Il/AQs1LqIJL3jW4qQfOF7wDeFxo9b9W
+IEaneICDx3t/I/KLOtOodPmMjOdwo2GaxephyxtGO669PrCGoZXXc2T/WsHxPMvH+g45K4mS7iI6QaqK
+gwgjbUI2wYXNSDho7ZRzIonMc29rdUD2rrd9gp8VRAgk5RrcNIMTSNFiLMqxc/5CGSvHHgBtUqCrrWlW
+1eKdxAk5JrTw/jPjPNKFqj0egvFcQq0gqyvGqHs811PJuPR4fRiM8mya8cjBhLsrFKiCEvZq8jiKetgZ
+xEmgn9b1rBpubtpVOpiq37M8FwNVnmzKYuPng82xGlWbew+e3jsgheXooSrqvWI+PRK1fgX9H/8nLro/
+P0ncnxvflsY7PHtffs+5YSSXiZjNpCgrk8gbZrnICvg/4kwwBvqw2mdo8mIkZzW8bdXx34JEPU/UvNTf
+oiJnGKCGrAK88M0XDG1Sqt9lwRohUYvuRVCC8S37WmDHME8TKjkqZQ17RTUvifWzCjJaR2I0IsOayPOF
+80li8YU8wvDUBMvOZJHJYiRdPVVWFC+QFXLbCOsLvwVDGfnK21QsTNRDHtMAHqnyOBuPcckRK80r5CPa
+nXERncO8qLMczjU47VR+zP509148XupnwMmamRArOht4l+P+ZRfDoIuudk+BPnSe47IZyZKMHNSTrExY
+ZSbYFd4+5EvzSs1xtRyL43zB4sp6oUCevYs3/+DOedBpeYgZ+/4kbvfTTg0Joxuf8bR4p6kyY6VJpPjE
+OJNpf6bldrexrOqsYAHDI3M2T0+MZkWt2u2lWtRw+wqeZFUdOAj4RlR7gez1fMUtU4v3hwegH+MQ3gqI
+8uQJnSVgN64YPM1NR+Kyrc1JRNcFe9xZisrr7M3V7+OaHicJPuAKVdI0zxJsAM/J8dLwB4qAbDrLJckw
+u08YgGeyJJ9NNC6NWcnIqjaL7c1L09ehNYNDrbQ/2OCKC52GQ42K7l/YWucXJZ5PwuYmPKT3LnW5cJ3D
+w+dPoVBje9OqCQ3mFMrVnOvz5iY8Quumad8w7DtGwNmEzDjjxfdFKnkdhG0C9BseRnxJIh9aumhIr7Gp
+45fm7ZC98mSLJg2njakanaQ8iTLM+V7xleHb5jy97dtM9ueSTcJsm8bh610OhIFWSDQ/iXJhboFUYaPd
+MrZUXWfdF0VDXvWxahC11gLClvPC9SAqyAJwZpM7PBUGR3V8lql5lS/stRXuDcg/56p8R5Nsz53IP3ZF
+pChxYymLt83oMg5Hs8iNiDN4+9pnaCGkLwviExdEgraJJUGaSoWjcT64LlcDMoZltcfLtAsDT5QSRFXN
+p3y0ZaVK8+yxlIUPsg+VgnNW7qlijuYlu2eu6GdLR/BKnFF4sOMFVO8yPpJal1m+B5peV9bTqhq0hpCA
+X0/J4Q9Im6zNwIijsopyK7jL4JY7z3b2bzzxZ5coLm14Rd3Qzdot/z2spiuZdw5X3+r9wnGntdEz4uIr
+DC7mPXkpirGapp8xNjqi+4HGTQALkvXh19vBvQAa/be/xHr64+2BRS1PZHl/jrvngfzyzvHLxcr/i2Ge
+vKBLnmUav1zr+dIaajGiFsC42YRkwmWPIEOXcS8pxqDvlUyI+kq7LE0RVFaAgHNB+hDlj8h+14kfcOtz
+TdSE4Y4NYLRaEzFUIcmZGaGJ8ViOQZVciqYBi0cpoZTs7zyAAwYl+bDFpZjapFpoZOWYwJGRRo7mdXYm
+oZJ0UiacVFZUULGDM+vjWzqxw0jNC6pngVN2qW2E90ghkXBgYuQMpMcSJD/boU5vwoms7cgtPlAr7cFI
+I4IuboGQTfRP/eaIadRjOCy/iEiyQJ2TLEfyXDfBqcGubA+2z2MxescgNMl0C+N8NpmX9amstPOic27n
+WiUlr7RvGugjQgPbY1Z3qmZvuHPp11/EJGgqEVNV1tnvcgy5OukWPXtjUUqgUwbb9AJ6ZwVCo/1B1gNt
+74z2gKWRx+LKVz3HiaqHAcho+IfKJIF4Kmba9acRhGwr+DYVef4L8y45d3A8tNdv+pQ2q8jqTOQwxoWo
+N8NTKWYxAFm6yGQ9Hzypqp8CXmfnCsC74hNZP5M2l9MjVRoz85LSwXGm00n7mJ7Imkrj5KLx99bWB16E
+N/0zXVez+iPN28lel9WJoCbMjBGfRCZGjVx7gvIDDp7VzCm5ehitmGrtnQXV/GQyZ6yOb968m8qb38bz
+r+nTm8Y77+iM7On16awn7aCXjTnBbe0jT1ROjr8xfPf+4zpE6EPngM5RxT89y0BWjGlVWiGJoluLbX0c
+1dthVoDlqE6QnaeQ542UvI47fOFz61b4DWPLHSqUCFXXg9JnDKLwekvG6aPQfKXy4qqMkMtWW8v8LWvS
+1cYDM3G5OqfyfvT9NDs5TRYU8vyXdu6/evpN+1U4INh4K95Nk/PPHzP91jhghWCaRnjq5ykNMtk3tpuB
+iR/54UNzszCFURrHw9NSlpLUKB3LPF9AoQz2jOoAfpV8BcvX1cpTUHxgAc8jBgxmsFxW+LmosyLYb4LR
+cSzAZkqqqbgIG/mjTjVC4tmeftwFw05oqbDAftgFy04Rze6ZXJaVRzM9qUhIaz3tayNTp4ZSzitJXqeh
+UWgFomh+3Ge+8gdhEd9wlPvRDWGjdQS2tlUJS9YI9QF8zKqgm3dGP9D+/OybPoKwa8E3Qm/6s+mH+2zk
+eW+C1IOKQQZzHYBclk63TfLZHq0Lun3Wc71mq0iSRtrzpgifWfltJVic1/LK7Lw+wLaQln6fiSy9/eYu
+Gkb845uv3URe4SE0E2Tq7ukfl6CW82vjsYqZzZqJrIURjlV9Sups1YdjORJYzcZm47AFfDxtZToMvarR
+jTbDgJGa1VKEdYK5ja6+6IYokbiqYV7NyV+BHFP4TZsZLa1GSuUtxUyHT5oqtDWHMnVzE36SJZHBy6Cr
+/dlLfbqEqha55EMBJzsGNWFCDtJbTXNz6e0sremRL1BlphnV0ro8Z1XJisTexQFm+4nZ4JJeJPIbUMXF
+p0ClPcGD+iNsb8FNH/3Gxmn2zSlJ9dzaN7KCJs5SmGbb2AFkDWU2NptAlAOZeUJCrZQOrDGVU1UuIJcC
+zZ2B5Cslb8KRZtAeAjRo0MaxEdRgnbNFWFui2mgNP7QSG+42W8GwWT1ShQ8+/kB91ZHa9vFk5VN13dpJ
+6mAdRDy94QiY0sZCUerVDfY14pUX8+oURJ5DVWeki2SeSaxWNABivkSE28SmpJsOtPZ/o+2oB9F0pOWp
+rvdkuUBN3TK2GFC8HltlNuxGfa4gtq+U2l0vuxaS09HCzOipN5eoPraSmCr6yuiSE/VpY9LbF3WDs9sG
+1ajoDy4/rfrez/KU8iLqC8eGuSI/rXgM8AOU5u92ejfWRRuKjYqfA8UfkyhG146Rfac9/GmiJt0cIres
+D7f/1nhPhFeFt79cFf7hV4X4go7vA9fdfeB6agqRU+7fe/CPgxf3HuwN4W/IjIf37g/he/xrf+/w5/1n
+Q9i+g7/uPTkcwjZV2Tt4MITb3+GfuuWd2/jjxb2/7x39/GIId+7Ynw+f//psCHe+pnbPHg7hzjf450/P
+n2Kzb/HvJ3uPDodwh+BRa+pk//Hff8LPhApD+XqL/t57sne4N4SvqfGD50+f3kPEqNGLvf3Hzx8OYft7
+qnpvCN9Sd/8zBP7yP3v7z4fwNVV+9vPTF/ceHm0N4ftvvd/fD2F765u1SxPa9/adLzz7h/PsEzUSuUxy
+Lar1ohwzNQhf7Ts8oEsRbqmZOav2D594cpR+d6MMYnypTNKZ03U9JOzohObbUO3nGMD6k8P9dQKwlsyf
+xShZ/vn6mqGhT3J1LPIvPPWpPNXM6v3FaeKL08T/k7mxaH3QGxheDLQuGy4PsgZh7qJFrePl03U3Xdnj
+yjszrxHpcge/0MGjMrUnqtTPlGa0+qCaTyGrQOuz2GUBqgT5rzlioRxAtODKEo5x7fewStBEuwvMZzNb
+h0Uj+ppfZPViiD/BvUcZwvNu0eOP/5rLcoEf+Ppdf2V7gve5j9+9W/lciskDcoQgCxQO1nvu4p4hNeXM
+0ov5ZnXfzmg77fv3Mxmfup9IMQls2Om7/GYPfj5X4oGCgfHw3MXy47isa/EJb969tvZvv0Kqk8Y3L7W2
+GktT7TbcXFb1cVHfuU2RemAXeKsceN/sfQ+SjD7txH4M2qrhGnVt971wDFlNGTCrbkx/SlQfOwt4dExf
+zleuTtVWh1bBfdoAd+Mv6Ra0JoIW7suV1/lNVmle6DeYov3auck/KdZOXTy3ceZ2I4tSXOcH8AB7vuGN
+ijd34XbLtXwLv6WO8Jav2o7utoI/dk4yEyTJdW+FSpsi2xtoKq+bSQJnfouyfI3f3jjHm8bQRFkuHwyz
++LLR6EXgDadNHvGI6NEGlrnULWn6uhx4y2vDrYbc8bIf2Yzu9tVABrsOCdK6dm0vQRbxSDK8zt6QnAoH
+l7pGQ2iG2fVT+jbcPUwtcnFzxPFHVBA3Npbj5n8gQfkGbjU/YranN1cazDiWbFsM0evffaEUNamGTBO4
+1UaXxPXgawsBhxrcU/uwvQxp7jOlkgtXkCu8ESfHa+00JqVXeKu9MKD3NZG9XOLysyyynn0p8p40L+3W
+ckk8xvqanYNuVozy+dhemIGwUfWc26Op3euDKt0pC8Ehtzpo2cRdjZuPBlxWgZrXoCZ8ab704cVJmsl6
+nmXTTJh3aW2vzRxTRqIhvM/4KJ7EljqU3m4Lr7Ty2o+wHT9RcoX/BbfJ1t3Ih7WsL5QgyH5cq3ll8FEM
+59LIs+buXU6bmdJYXbZuH07P+BhGZU2bvkFW6T/UhHZzE8mhMtDYJHC8AO8EACI/UWVWn04H8LP3GY8m
+OpicvUSy5xID0DocB8cME+RnKeO6YSdd6mLmTTCTg/B4Osu7233Y6i/bP2KfNWQqBjkwWSUT+2PomejV
+3l22m0bs4TNdggWccrqEBfTofeMNBUypQVQeNftwPK9Ts+eYQE9iy+z558qVZtJh/5Ez6bFC6wyZu5cz
+41cVzVwzH2gp6RGpy9jiwdkIZES7vuR34m1NbEUJ4O/qrTYlkgbmwBS02WnW03lbrwwyewU7RSujTSmN
+1k/s4WjEXj9ZwEed+/IkK5ZV2CvGcXErYzgh7wEnykYQE87C7gMYSexL/pYZ7gfpKok8LTrNZdKRzsfr
+aTaeqayow80jMaBb8XC8LL4eJcx9aBIhuAm33yTIkBSMgZLVh+b8pceRkJgJr7qrpHJDw0v31o+JoruO
+VmqoTPZ21pIL7dYyDXQnplmoELRmsWye8dsvddN1vZCS+g0sG15IsV63F/frcNcUDG2uLZlPEtXos610
+nhVjdZ6opguG8P6yZ65TvvlyHfeHX53sSzGqk9cjDY6xT+xKbEO5oPrv1+nH+vD213Tr/+2XKftzpgzt
+4aqQRf1rVp++mJdyX+KAn2YXWfEx07mZZ8ebV0IOJn21JuvD7dsUMPa7L6zx2VnjUEdJmdc6iN6poCD3
+nOjt11Mpc5Bn/Ga0GNMNUuXO9OdYjmDwOz5r5HcbOig+TErssxS1NN0lOZF6+YkC65dfrl2/XLv+P/pW
+nfwhH+mS8EF6UGTCrhZ4Ls6z3yWv0qBBWGZaYAVZ1feKbEpvjh/h+nyh8sUkyyMAS6va9/ON5bs0sKKR
+JxlHCbMygw04iMKxNKEsSY6wAHdSBLIJQxLFAs592XSKQRkLOQ470oFxMhOr0Yddn7uDfzWEscxr8ZJk
+HP35CjKd7pAkM0UoTcQXbBCgSwM25Tc3NSr9NXBulx9cMacTORiVKs9frlLpFVe6a2rdddWqWs1wIYgT
+wcbRZfe+DcTDR9wimPfHD8GPQMNVNMWiZ9yaePHXbGyYNPzQuKb00gAHtIkyAZvBQVRrN/p9N1xVA2Q1
+bWo6LOcShu3lj4zrlz0Ot+D2aiXcXkW4vfrDcIv4oBW7uN5u48tnxZAnful07bRUbNDOr3jlMPzKejk+
+MKJn18gKr5IqAl5VRYpTm9fjjRUV3Y5rOC12Kl3qu26TaIuvzbRYH++d8XuHUNB3uY1/N2GXabBqb8Wg
+BrPsQuYvGy1fhS1ftbV85beMJzkx9V1GpNfW7FWy2Stu9iqwId0Iu8PwWkGbRGS62MwV0qYpRNLEgqG7
+SQ9p1FzqaaL5ECR/L+nfh3Ii5nndde8WOe013pSNm6+ZNeZ0d+ncWjQ2jRtN1y5aKd0oDB6j1KiUulbS
+qBkdNCYwdmmr7HIl9/Cjudu4zO7RPWyz6nKtphvuNys8DTFV2wzKptx75bXCntkigIL56wfz1ttJMedu
+C8vZ7wmjXkMytdv0UlXppUYg6NeHd77DJxuh8Fkffk1fl87H+vDrv+FZ/m9fzvJ/uJmHpc+xKAelttGt
+dLR++PzpUzWv5FN1Jg9LMXpHar13NkhUMCcMnerJq4xfTClxV1i8byyBtnyZKSjR9ipjU9sxJYbjF9vz
+zaiqfhFRbf5mQI8uotILU3Ld41xdiqLKRS0fPn9qnsC9fBU2TNex6KKUxuOnXckD+0XX+PnZwYP950+e
+3Lv/ZO/o4PDe4Z5+DeEentPbl4pYB60KQzZWwKWG8Ojeg72jp/f2//74GezCTJQVXmh0mSrdTmV4bmMi
+RnJjKjC1YKfXh+2t3k4M4Og27Pq/8cLHq3Tw+H/2jp5SP3e2NH/tvbr//N7+wyMextG9p89/fnYIu/D1
+ljEM5aKqmfPl2K4AK4upjv+Z6eQrckiOcVbNcrHgVL4dW58vrqfIW9UQXl/JgG+o/sxMwlDvGCNV4KrH
+59FDN2kDvjscZNU+z7i+VByzLvDCzlDcgqtl1fOZ+Nc8gIinRC5VZSYLztXgV1AFZst/3TmTJSXX7PSh
+c6rK7HdV1CLvvOnp1gWTwG+K4ptLZ1dgVq02zhpFyQOVqzKF4Qm6URp8fn/Mt58xTHymw+909Nvjg1rU
+Db9TV9Jt3tCaKMKVUUDpx85a4/5xJPLRHNciQ6JqA0MKVML4SzR7WLClzY/0vtv87fGE+eTNWU+/QVoD
+GDl+y/N9OZLZGVnHKm+YrXX880UhL2pju7NDRzR47XihlGzNQRg2g7S6RIP0C12tcmLAqYuaiZakJH2s
+8M+BH8jDoMBEc78DwrnPPvF66ayIK+LTHOEnouN9D8JkaNbVun88p1FJgnUjL4GG3Njq6xInKki2m89u
+lQcblC32RYgnMXSxWZPff89+BDyekoRhELAKPxD2d0P06SznTb7bgxrRlf1oyJZ1Ky/wnVtf7jUAZhw9
+QPXOfcI9KvqUVT9Z+WegMT5+iV//F00J2IUbbXXujSiiZgBvokbzSo7tec308rAUJxgMNELTG51ur7/6
+/fC8hlQwX3fWAlLQZocbHOzC6KJrKNyZzY/zbLRp97xNrNwZ0pGtv7SSG3pnGNBxeTNDP2pkftgmYV2m
+I9XkP1vq8YipHv/JicR8graN3wHCWvHAw9KrRxzWX3WoWLcx1HAIcXCmUGDCTf9rRc9eb/mq1o4zG/p4
+u5VmV0sQn+g8G9enQ15qLo25WUWpqpZ3N0LNz5zDDZC0atu1sPue0+lWWqCnUT6VeLZaDWdTtw3p66C7
+5TDuBbLKojn4/bHvY8mrlb950+OVOQ2JI8qTTpSYsgGaFk5KPEXq2mD088nxPNsYy+pdrWYbrtpGjgPv
+hIj65gOtIe+xl2XXsOw4O7MbgCMjBpIczauhtXnQz75Xfj+fl64Yf/ml/5CLh+q8cBX0B78OnUDDWvaT
+X4/OdabOuXfIG3hXM/zfyEiEYSggXQ2yDgwdoV1JLY71/rfFb8XxvxTlmGSBSXIyhA7JmiQqgaxqoGIZ
+zub+pj/a9MWnal7UbYoiFXZ9bTC1GTZ0U+ZF79TgNiRnSg8g3Q2NYS9hGH54FdxFnYcHd7xjbV67mcnU
+bfjmjwtfUjAijjjJrg0SHLb6qOv157d9FTYd55SFhbd7r2GK3A+zcSu1TZklNvc8bZpdcLQJe0s34Hks
+6EPjEzkYjtWI7jgH5o89G08oJWLsBhJEM7Km2miXwUqJxrHOP870Sbzb0HcDBvy5mC7jT10cEa0w2nvi
+jjIm6KCUuRT4SX+3EcCQDG2mAz1IN6orbAxmhFEYxBgZxzPMSfcX3sDNJ9/ZnEy94eDNtREXOYjhAggC
+GvkFCeiN4ED+offqtW/Xtt/PA7L8ayRhGETViJF91Ybsq09H9sbnRpbrtmGsIa2ANodTc3y86rkYbvEt
+XT8+9QQfgiNpmjS9wMBhUUms9BABjy4htj5NonGsQeq9YPCVhhB88cdABRWlEr256Q+icSImZyLU436A
+bTxneVDwnWcVxCfUg2+aSJsnTRzHP+QCdqGDUYi96egc4Qfq0/zwOzXfPKx31uI7OQN8d9f21MDSq/tI
+lf+QiwDJINBifa7IebqyyRwo3QCWD0zt7R68OM0q2tecOc/c2B0vgIQXbOIl2bES5dg0vN2DJ+qkvR1z
+mocU5FF16/nzTs5qEBX+v3nDwOMnr5z6VMJbYzx4awBaJiMgVimHrEYYCmani3BQHGoaoQzW2tSc1XSb
+SscSorne9Gc5eXDn3Er0/Ida3GQA/jZsa/8Q2r/d5Js+u5V3OHHVNqHrodEoD84+jFVQocnn1gIT3Ovq
+UKKegTDslc0udliW9D/419De+XUrOM0FTX70O0o29sob+DtbipWpDRXrbkuBZ4jpetvAWuqNKU9rdNpL
+z735auadFkVWOUbmDcTEtmVPzFzUJkoq+crpa0LKkF3KMfxrno3ydwsD8HgBb6+8mng7sGSq9K7zPuIP
+dw52hkNDk6H3tyt1yyhtDmkPb0ycPeR/3Dd3CeX+ppsoPdKRGJ3qkMc0Bn6BXMsS5pUWbrFQtSJ1Jy5m
+OWpqBBui/aL3OnNO8Xc5862x37/sx19eLVHiXvWa/bxM9PPyE/t5megn0U2r/tl2T+KLet40MM8rxWie
+iIrfX+oAQdZhQrt4jpV0W8XPFcn7hCvBW6hNnF91JsuN+Wws6qw4Gaxdx6q/VJu69vVMSEzPNuET1H5u
+9fIK9EBPhFKlAXtwkyrGlgV8//rw+VPK2qnTtUwqsgv3grjZ91V9Cm/VZFLJ+uVb2k7f5mIhS/xRcr58
+m1Qes3PaxPLH8xqnaoHVHLypoC08X4A4ExmvSlx6x6U6r2RZUSKqU4y657muCbRhGrc1HpD3bWdJ3Pmm
++R3u+vAGemSo4fmfeYgwTNR9laxr7A40SDtbnHtYahG0QUY47XyRL/rM2lg+kqi16BjVDo5uhrOi84Dp
+dRFnBFh28xjsOfbPzYaR19S+8jBhYWykrhTgJmwNvknA/8iby4ap1sfv/WWwcdsie0Hi6x6J5W0bhC7M
+jQ19JGb1vPTP/r63JGUrG83ZNZ3S7VYKspqkV8k3qFb51ey75mx88UrsMfLdhFzArhNyAT9fR6Qv4xbr
+vbl0BXEPoH3ftQOnacb/phjsryVltZ2rhaB7xfgPsRaZuFw8yPeBXqTdZUJcrUXbR1N/XLobvJOLBxwz
+iHlO//b2Bltjd5fcrwaH9+4H0v+JrIG4kUWVmkw8cYbeYWtN31hPiR5n+vXLbov3jcdzJgoqK/XhwbbJ
+gw7L6jyrR6d2LK4AYCQqDlM8oDjA9jsEvW1s7wQlFuW0GcSvfFxK8W5nLdEhBRteqcN2GByauAXIUhj6
++t5vG3svR/7/Nz4PnTlOs9et00Cq02xSB+aIqyjTeEy/lAaXK9H0T2eEn198KhtQUOqP4QIHwkTM/oSB
+VyuP2MXjXgXpj+vtegy+1D1+2Wb4iVuVGdpNN/xP3rn07ai/FzxidQHep/YXGxKO9CH2SIh3GL5R9UHi
+l1UhJjat4wjgsQ/OSvWsoossOfbfDtTlouG4b/BxHNGmOR0H9S5hJEhmKfZUu4wukIKZ9fENZjxye6OP
+fd8FaeY7whlW4n+Nf06kYCU95WYJLzmsjOZJvihq8degHO8Ns/vHuNkRbu59k9fz3fg6DoYOQPCqwDKK
+RywDs9ew1rXg1hxOdC/Y7CV9WMArWMIXDXlZ5VF+AA8piRWZAHxDEB5xc0lnV1DnhSwdqFoF1e3tXVZU
+tRTjgR+nJ30PElyLrn5X0njMosWE9rpL9NYSh63x8M+b46++arudvLHqNWYriMFRuDRbr0Gxm/ii13Fd
+9JjG3Az7giqiTIqYJKnYJcoh2Ooe3qa5uqZoBF/u0I7i3nqyN5/OWFCch2Amqkq/nUnc368Pb+NTGdxr
+14e36e99HQblG/tjeVCM29/air4nxPrwNr3CYfzXh3e+pl8X68M7BDn5kCftzbQ+/GYL3+p8/+Wtzh/+
+VufnSpb3TmRRHz3ce7G/9+De4d5DfqGjCQsvdAOQRZ2VMl/AKEcFY6PKxtweCADJvucHMJY1qysDeKXm
+5l4BQ61zlA1t6Cg2xnJWypGo5dhhATy/nFZ+pqoqO85lX0fUw0mvZAVqzoDEvMYbslrorPElWmUJqxc/
+vdg4FjhzFhtjA2PkDTng50qc0FxXdSmQwBNVnotyPDQVWNKlyDTIpFY+blohD4/39M9LAwCJgHMm8krB
+WMGZLCvEh19DrdoPJj79rtnZd6BKOJZ1Lcu4XzSEa8OoFXyVSQ+K4hyeiWfYae1VRFM4FKqGKSo/fagU
+wVq0jYFvgth4pSgv6blYrDymH1JD+hZUCeeqrGQ8omeqdskSLQ5ZBQImuRLMgWg341iyEgRMs0KVtqp9
+HHIToFKwcOwp8nOxqGBemaz6nB9flRXUCmayxGCQerhZpYqqj1RiQPSIjaM6ZrVdaHDzJuI7vHnTXwY3
+0X9AFSf54uZNvSZgJGbimKPgBNyq54SAmRG4Cjo0ad2poJSiUgXdVznKn9b1bLi5eX5+PkDJ+a6aqrEc
+qPJk87dqs5rPUH4OTutpbppwKueSkuBS3mDBeRO8zP9TUc9LCeelmM0CrkLCk+ugrAgWr1dxjItqVIM4
+x6sYUSzMmCArS3kyz0WZ1ZmsBkAhImhajbuY0Ld0NwFOSjkzOYqLk6oPqgRRvQNVwG/iTHAYmf/Os6rm
+SwiU+YORmsKxnCjCN8N7GlhInjFRMKuO1Ixk8Tqd7VDf5lhuA/q9rmeyJQ7QTM3oEDeGXXtHi1cePMCK
+K2WyD0eTrJQTddGHI2KqPhydy+N3Wd2Ho9Fpqaa65b1RPRc5PN5zq1aVzHJ1pvkD59BAPiqlyI80YzCI
+F7lA+TXVvasKO+UxVX04yrNijl9EMS5VNtbdlqPTDJlqXkrd7jwrvv2aSx/Ks2xkvmezU1XgiLKZGPfh
+iO8xDEGm6jgjO62Xp1bTSCtZ5Otm6RYmLHKxDXzCsv19jS/2FEqFdyBKjrM7PzlZwP85gDw7LkWZeXfU
+ndr4TE7neZ1tjLOTrGYgoSSo+vAcpwS2tzq0+EsQtAuxkw+ydDateJFRRQbyfZ/vEvnGVwubCgT8wtA3
+Xw5ewSSTOe4isLlJ/8MANr8f/G0LuhOlevCilFWtNm8Pbg+2v7Ftt7cG21trfJyaC0qUKM6yE5RFg7mR
+pDu6AmO7C5vdu8OnB4/3Bt1/jm/9c/DP8a1e70P37rB7d/iIue/D30tRvBClGGeV+vB4JM+lqGTei1sQ
+lt27w8EtjdDgw6AX1bk3m+XyV3n8j6ym5t27Qy69y+WHZTaWRf3PTdNscLM8G3pANgfyQo66c1H1zEhU
+RcN4KkaoQ7zsfej+ynzb+9B9gnwbNloDw47Y7J/H3ewF/viQvXgt1Jtx3ANxrK2ZrqJXBda6x39uZlEV
+Znis8ej+vWf/3Pzn+a2dRiVeB1jpKf0VVmBeCHeyx3tQSzGF41ydwLn2+T2TtD8dyzyT+m+9hxxL1h2y
+4oShoZzo/EqLFi6+/bozALg/r+HpwcNnzKhovBK5XiB2Lyd5ky+0R4eDVqopyIwk5MW3X4MqIRMIGioF
+uJ6mopZ4Aemh9Nu8qhkpQoZwYWjkVAVZMc5GyMKgJrhzEfCFmncoujZ8+/XGcVbD470BwJ3b+m9QpoAh
+aX5gDaaSxRg6vz7/9duvO/4JmmUXvoi6sUlYxFyDMohWjQ1TlOFc0afX22/gLp/EHqE+0TVfezDUNb5J
+1viGajwTz+yV3uO9lOS2Dr8ZBaEwW53/t3WXfhrYyRnLZCXf73dzEw8Ox8RV6yg81yFzmpJNEVTzAoVa
+vZMFYmSv063hyFRh2WJXNC7i5BqGxpZE4puBBCTTH4mst+BrGGJLmhuwe6WdkdtJet8O6c37qm1zJ9nm
+TtSG92Db6Otko6/DRjR33DDODz7mdNBizB6DpTyRFzNz5hDHdFcvq9olQs906DHWPH0fh3p0Cp1KTESZ
+ddgfq8qKkYSOJ3c7gCqYKCuj43QekCrR0eHDGBb2bfcMvakZO5O/dXDbf262i2giGWsrhmTItVcvG0u7
+yLbmgLkaa1EtZnqPJ+xEu9ZuHjWcS7PKVeUbjVVFCHmT9pBUadptzAoZADyeQKEiNYHnRFZ9qGRNahVN
+bBmkddfbJcgLgfm+qiHA9hYabY6+PdrGfwffucovkFhV3I2wJ5k+1ALlO08/B5qvzxX2X4GaOECk2lSM
+N9VVhcRaoCa6DCgZJYWuL3Wse5LW5A8iflOehTJEx3cPOZOlZhWzQYPe+F8Pjt7orT8Wtbw0K5y4M1mG
+LHImy9fbbwalnOViJLudIwwAMOj0kGWc00XMMwxs4of44rWstwYU+6p6fVuHoWaF13y9Q19DBmOAXnvb
+xHnfr+kwialjLDkv+qEG9UFGn6p/vgdZBZxER9awdzHLVakpe9P9D8B/W49LJeoPeC7/JWSNbjZhLuyB
+KpHVB2smZUDm3z5kMk4E6ivhHz6wrDW2UYu2xfpc6i25gXRiP/PGYUeADwxoBhFaViRa9Zm2NqYiRaps
+1ALKn1pDJr2BPvCr4d4XjLxRejUpwg3rxxbq/KoVFt9QklWB1rIvUZXiVw5Y9i4rxqTrSPEOKjEfyR2A
+c2nCROYLKKQcc3WzIxy8W8xkeH6xXcBC1gPAjYbbKSglelV4oSLJZHaOOxECoGpZDRsbHN+CveL/49ut
+7e+/+c5nnm+/Doj47dcx1drNN199pfWtBM1SC0EfRT4v++vdwRuE/nLV7OtqK+JO56LPizltZh7e9Psq
+rKnSijjrI9pnRZq3Ww9r/nAV2lwrhfcjVcKLeXWqf/76+MkTuL8H+3tPn/+y9xB+2dt/BQfPnz8bICOm
+mZGBWxRZdfJQ5A8r8bUZzYoUFsCqkxELn5fWrOR4A+EPV9HaGJWuGMFci7FyXhSoaujz1SCFPUn1tyjW
+O35zNIscEKf5bYlN+IPPJ/zlSkbhatfG3uglg3bi0yDAJX3izwhQxJpYVmlLq44RdBPS/5Ed8TyrJG15
+m7SxeQu88sWSqq4USaq6WLY5p8ZNtpHWjXjJlKGrNExyccaHcg8Ook5qkIc8/b4Kfap03QGoAkQBbL4B
+VUL2Qo1hpg2Z1xiYDzMam3aL5gsJ7uj5gbcHkh3J3wXpw5XaA9Vyw2W7jweGP1wBpqvhaJCCwrJYWxT+
+zWA8icRWqHuzmdeX/Wa729xE0XyWyfMKsoJuxZgUui4eIKtl4zPWXdOtxsnrVH+5ilDW3Gwg4TADcovx
+1cQW4yXZ7FOy3OS2v7P15Rr5D79GHompxICcyViP6+76ZN3cFpwuZqeyeCHqWpYFni03uoPe5smOvXd+
+gBBHopIVCODadCnAVow+2Rn1Wdvdev1oEel2vCAjI5WrsqNzav8A61GYknU7KFGKKbznLi51V2ue7DFF
+NC63LZs+uZRZ2b7gwk/2oBuOu+8lOzjqw+hUlGJUyzJaDPb7oFaURPCBqKTWUTCtSWJBGJTsItheZRF4
+IfQ5R9KXhfHpC6MYyTwdkzbws/iJrnOrQEIXY5jpulZDUZMkTCL/C3o2oE+A2shoHgwRNPyWam3cLpwl
+/eZmatkm29oU58nSDx9MMevWD5ZXmqrfr6ihroJQXVFhlEtRHmZTqeb1TnLtNJv/1TKM3bn9ZVP749du
+Lqaz5I62eTPYMPjUcAnTrEh9pifUyfriIthd9PfU6nObDSLVnWYmB24fobj7dZPYcJoV0S4yzfQlu1/x
+R9faVRQXpqL+otNcJzcaRMfuMne+cOWfwJWqkOSZic6qH50xLQTTTI8Wlq8Pb29t4wx//WWG//gZJhfa
+VcOmPzg4OPrl3v6BDqQQR8IWFMNRq8BD6PzHd2P8v04/UddVGt3G/0tW0qG1h9D5enaRrIFOJvMKa3zb
+rEHezEPobH+TLtsgpzOq8V2zBtmyHZ7lybHo3v7mmz64/9ka/K3XoWANkZQ2an0hpjJS4NnnGgucJDV0
+HZyK6vl5YfOZUa1IZJq6r7Hwjb2GdMm5dGIu7ggD8XS66/gP1sdf670h/FzIixnZnHTKLvKO5izUeDnZ
+YV2fYQy8aTd/JpUZqm0F9JfEpH/C8r1oXatVLqrTfT4L7qOLAPkkbeK5l9Xr0al5mb7mB2C2cRi7NkCj
+Y1Rq9doWvIlPj1Gx5c64gHdz/mEPrA2M+xhJis6erfAv7dILQ8xk5GNHt1dTUb7TZ2PIs1qWIq+glDNk
+oYKcNR8cHDA6tEZQ/EGlrM/UwsyZXSNyrHPy4UXcgHsWeY7XuhNV8gFHlNnvtJYQGjkLZ0U2yUb0DdQk
+7tTM8ONCwz6az6q6lGKqb/r8oVl/qgxtElNZoPEYjuc1ZLX2k0JY5FtqHa80mXVuOLRbko8rTFUpbYZE
+DDdsY1HZAa/RxZ+oLVk1k2KQUER39M5zGMOlLcdECeRKmVfyXAfi8mUkz8gHfpzmuIFmzBBQsv9tAbqW
+muBjcU48ThRLm8ofM3YGtKgoJpw08YVEKWGkirEOEoYDawU1lrUsp1khjf3XIfpOLipv2No3czzwxvia
+BwmDweANwC+izCiOSJ5VNBYLq7KrnusjygNfXdffzaNClGcEqZrhJlhJ7A6JbpnqmT6n+zvPhVvR2l+F
+BIH9dq8sxWJHr3R9UHSlgFHCmKQ2Hm/UFnZNXk6kjd/bYJLltSw9o08kXHwhYptFkgTM403fr6KJAv3r
+cm8OKhT1fKC2yRBdfrNGp9x8KmZdXxj2Br+prOh2oNNmBbuwG9+X9M5//MY3lsdqXozkA1XKZcfmx8WZ
+eqcfjZxkZ7Jw70bFpJYlCKhmcpRNMjn2Xx9keZ5VEqVERQ6yCEvmYlahoM1OCkXrsZofVxi+qeAEqFaI
+P3IG4z5kE3JfPRcopb2nmQLwpXcmzzUm9napqtWsQkuNtghj6xGJmTH7+E0UbjYYiUP3ByBzOR2IMWde
+e5JVtSxk2e28k4v5rNO35OJnhozAC+4dVdmtnvaU6e1oiPiih5cGinKzak9FBYJYsAbcPtRYP7/Qk6uT
+j9ZKG7ZAIKyZLMZIraw4U7z/DSzeHMaFkRv/7OMFu7agFeudaw0+hB+PmK6xyM2+7w3A4E7Ty9XS4AZE
+la4FZ04ChnaXREUKRSQdQWtlwaWMNuciq2EDTtU55Ko4wer0KSsCDvWb3rzkSIUX2FDN9LI0n2pFs/BO
+hmiEtqS7DudK1tp6+YixF4XTOawq42ohGMgmgK9e9EtmfCTqNAYdEMFr0tDZ1iCNi29LXYaNX29lfPxG
+CYyWGMksk+KXPs1P39C7HxGw3xgE735hLdiNP3z44H3BrSsGA7vNTx8+BN/M64fa/PRz/JpBuOgHNmf4
+US4L2HV5hHXO8D5+qcx228VavT4cvaPogls7/NcP1Jp/3LplQAM1fY0f3/iQ+Uvw8tvi5ZbXWiIAgHc9
+xt+6ri8sG4jZLF907axg/+YdPv9jGg6Ojg6eTiXFh6KW5jdX08RrzFDXtGcGcOpFNAAf167TosKZ6+pe
+GIqf5dBCS+sgpthqIt990UT+cE0keGyevIR2Xhrindzzq6NCGlzhNplDF4jyRHND24lXjEZyVvP7yHFW
+jUQ5riArZvO62oGspr27UEDeGXIykaO60kfXjDaQWZlNRZnlC5hXcjLPIRtnampOuSQP1Jksy2zMsRBN
+v7IYz1RW1BWrAghKv3A17qNaMRD0vJrfF+BbOjF6V4GgCGUbWM4dgsgFPFAjJaLjS0DnLkVJWWtNzQy7
+TWLvrC3P5Jxq0zU6wvIk0amWdTlf2vDZPM+TDSkp7LIecc6acsQLmr2zdrmk/T0tbwMYMSMSvyX9X2RI
+Uy1pvuQ6/eMlzYmsf5HFWJUvSjnJLuT4mZimDz8Jc+DehRzNEeG94iwrVaE5wEu8marhsoRqJ4+wiflq
+8ntmxRnaOGLI9rMFN5VThYmitQHyjGJb0aCQxV53fqX7e3yoMa3wf5+q3/Gf5503fmVj1uR0KSd7F7Nu
+5//rUrR6DUyf2j908P1Xp2fwrGVVm8xIqWGje8HPFUYKgbvuJVwiyU1vwAw2bNpR+T4L0fCM/VarylhL
+yuAHhysrVjuQOVXJI8xY5/Ey1V9nb7RR3z0YC6pmhRtnw8ji1/SeRK1F6ecuW282bPhc6ktNQMCoqtzn
+WrlHmoEp6/3dBggcBOg4AU41137OZAJEbLQej7Bs3WiPSK6QrunH2bwKJqV1prI1jPXL8CdfsqTDUPGy
+mGU15zzX04MNBuhFda/ubvVCLyo9X2yR6m734okjfh7gpHUjwF7gMbuYeG/qQyc5apjOq5qff7iDOVl+
+54WZfLocQpNhMBPVAB7XcC6qoNV/4ToM6MTsAhDTioAE3EehjKe4SsJ1EQ8ycgUIAadV3uTYTULv1NJe
+H3KwID3168M7W/jbUnV9eOd73NC+v6YT26xUI1l92eU+eZezM5HcyjRxf668it0e1ArwWF/WOjYaW6UW
+ak4xE09Qcomqmk9lpRVS/aKSgOmoQ1DNyqyoJ5qafBkKXVpC/1VBVjmx0yMt2x5cEUitQA8EssJepII4
+VvMazk9FjfLsHTvanZu38eeSrZd8GcsvZq35zY4PprKi0EEmiwiSYzZjSTkr1XiuQzbqSOYBCblRKemq
+plYgi2pe6gwlLgTPOJtMZBnCY9na2Nc9o5Ghv71M6Wuq9UH04bgPoz6M+yD7MHFXiHqhDGRxNnj2/OHe
+0d6zXzhrkeu64z+nZZDtGXeje2+Hq162FYgCJBZaQpqZ6/i5usxj3ht2OL6kJwA7KyLFve36aD2l6z85
+BnmB5zSyVY1G87KU4x1AHjehqqam4liegXSCC0hac2gaCRPcEKNBFWN/vZ7KfIbHuHNR4kOFatBJRx7E
+wWlTzut41t7shJVMLsetnfaBPrb0/yVTuUmlC7c0a9jb3s3/qjZP+okTb3jqrV6bfm/dsuhc9sIsjoTG
+gDIsVIfqhZpR9FZ+l6BdVEeilHo1OnW0AnWuMzOsOWayU93yAsC2jzw1rcZ7pHm80yMfynXzG+PWfXf5
+pv/1l2cCf8I+UtF1wIGR2X/UyWheyZ9E9UhSvKqdNRQOy48UzOdBMz/eRmTQ/uqrtqLBqWv/1Vc6BApb
+Xszre34cXeDylF7qClHBTM+6SYkx4Pb/XUlpwnmN1XSAd2MD3K7OTyie13+M1XRjrKYhJhunopowJmuw
+ArrdDh7mOj0S+/wE3x006AVXRU/lCvZgCLZewwQkOosapKG1LysNTzx7frg35AVA22Chag7sNFKldlWf
+0OPvYuNEFrLMRtwj9jc6BVHBW87z9Zaig70lG+7bPrzNlRjjvyQq3rLHxNtK5nJUvzWd31dlSS4hpZoC
+vhEvi+z3ctDyAIT6ReX1YD6ZZBdAzEtqcx/k4GQA66M8G71bHwQ3NTrH3iXoxA/h+zfzcXYqKhlQMTyR
+WSiH+jE9Nm6SnhuZvGX0w4gKOzy4M9gabM1KCd0H86pWU7g/z/JxDz7A08eH4WktXqHdiAZ9MwCnPNxY
+vrTozoPb4MK50e3Et4IdyArLobFrnReIQR8ULUaAzg8FbmMRkuZaJavsOGAX3FCC/mxYoBte9UDL4AUD
+u8utDjvUQFceVLK+V9dldjyvpeu4Dx0d7szUD1HUDh4ayGvbjk+7HTNLnSDQiY83UjiUYl991WBjgkXZ
+bTvea0FtdmYvHdSvMWhfrejEaPgNjKrzlpq/NQxZwOO9728NEiNaRfAQM1QDxqgPnTuDrU7DGcQDmz51
+xpx7xYETt/ztL1v+n7DlP1PjtEU0smLdvDTeYYfOUcyYrFqEownNoaOFeB5mWQWCckoVHKnk5masZ3gy
+D1HscsPA4n7jhv6Ky8i4Xz0zWU/ceoS7ttuC4/abevZ1jy7f9Ty2EGhQOkBcDxczDZ49DlqqsQDEarxb
+dXq9tnXxjKKE8aXA118eDv3xPI8G7gcuHKrH+NqPdAMF7DJbygM1Pc4KWXEEy1nu+zjyfFecaFQVxNxG
+P/ytmslygsFPNxGJESOxgWe2jTP8txSLSNkZDAbW+uvFcF3+5NUbofMahM2bfXSrhJubnnbQcCp09TGN
+rbEvmhR0BNXGtyxPnpABPuHsYMzCrs6PsN3wlMgyPnZmaNW3VfG37/tgOw5cH7LMHm5NVH6q5NqFg/Eo
+cdcruAUd6MCQlOtb4Rj9bCOBmdW2Tq9pj/x2YX95e/XHL+x3cvE0K0tVXndZr3wRZyeoqOpyPqrZUFbM
+p7IUOo5KfcpuzhRxWocYzEp2pE44Ow4Dv74Hz588p0cjdijd98f5XA7pOqcPpRzzn5e9HddquqCH8rCr
+22OuFOmVZxWV/yJyCqB64wZXe63bvfE9GHNR1ZBnhdT+k4WqyUOdY2zLsTnraMdwxb/oKEju1EgMa6TF
+xhEdkDYDO+bH6GwxBHj/Ti62hwh0u49VbtPfty+51vN5TdV0LfxfUwv/9zISmbwNk7oSiEr9nThAJ1Fj
+GvsmWkd4dfybu/4qyRGJcxLqtvinM+iq49987eK51UtusHd0xl7SBLUPHdfNYDDoDcF6GNAd1LF0Hvza
+CEkiEx3EsgIsZlqGq+Pf4mdQ7+TCuwAbqaLOinkQlq+U9WvtTqYH4wm4UtYtvgwWcaPAx9dAX395g/in
+SLrnk0BNv8ePaeQFxXTXfp0CrGn8nVwM4ImsO3yEPEYTA1SLqpZTrrSgpcnXH/WpmteQqyorTnSIVI79
+VysYLwox1R5O84pQsTqPqLRc4NcvclrJ/ExWA3iBm3dWOK6mTkAA9sDjwScqMBNZSZYhE3+V+dG4dHvv
+Pd5JPSGiNkBKOVLleAB0xqykdSGHWtm4uwiJUKQFJQhMx27nHS8Wj1uA8A+NXfAeaUqqmo7Fn2eUzoKw
+QZB8Y9a5ENu3OwOere57240R4fEQuT5zguBXOAPYl5XKz/TbGX9ISHxVjCQIusIr6/mMnC05/DnCKyXf
+kiAxSgRDB+2qNX4E4RkJw+eTriowMfZzXxxqiREIpbCWEU3m60cJqHlZJIST9rNokU7PJ1bn+vKc8o+X
+RFNZnsifZD6TZaWrmEtEE0ilGkKwB36SChZv3F49+9lX1Z6Ki2w6n3oPVXJ5JvOKYwSLM1niIvsVFyJn
+lBtl5WieixJYw5uX+tncf49Q6bPKw9N7L4+e7u1jPsS9F4c/wS7c+db1+quEc7pDO1dludCXaHJ8IoFj
+JuXZO0k3gAckzbqdi04PVEmf7rP1hL0hqetHQSaPh6J2L/JQWpVyDPzkTuRVX9+zl2LBlQpVD2I7Dl+W
+13K6ySKOnwcac2KLMUeHjWWFzxzD2JBjeh9Y8mTVof4W3H/br10VumBqAwrfahsDzIcPoHylCkfOHymR
+nBUD7B7n+NDE/Y3maBh/4HBnFqeh93cUIk8VZ7KsK+pz095eGyWYjvrk5Gm2DD8wnrl4YJVw9xJp5/Q9
+3ikKvOglrx4gZ1byFzZPUXphhL33N54bRVaHyLOtnyIR7pUnfui5uMz5rfp3xuG1PBLZfER04C68v4Sh
+dakOqfN4QtTHXZnWN/IgfaH1LWp5soCbqNfexKGirTrP5HjAwbzrPmTIRQwK10W+0DcxeU4BEem1CdVu
+POqVZ7IMXK5EsaBXKwzteF7DW1VIndu+Pldv4Vginm8J0beV7pw6pH7MgYbRn9dZzilwEhOKC6mQPGSo
+lW6CvDBoVKzPVVwR77j8YKJy9E5PUSkW98qTKogs2ijtUq6X+lzZ7dYeRaIDRyEpFnD4FRv2oXNYZvrF
+N+Ek9PTpQFNwomr040Hq/Vc16PTBdppgg4guz62F+CrCxDXbKMP1Wknjihu08YXDINGCiLSzWtWrRy/K
+k2XYL0feX55uSm94kpMqNA+W+Dkxo0aLjScVZxObfMxQHhe1Wj6coEZ6SM0xffhg9gEje5wJf/URkzD+
+mGHrW3RSvY38Ohfs7MXvYIVTDhh6+NSeWp6KMYOrFZ46pOBg/3JWn6aEiHm2+ASVEqxZytGcQ8nVCveX
+jB++nggcB0xZm0mtDoKQnAwq6ZLa05wG+gw/NLZG6BjNaSzlTJODhkHuWBKf7VPqtKkgd1lR17gDZvzk
+kquT+1VCn9InwXnhXBTOxWLQuWpW6lO3fcQbTFYxtVJENvZzlsBhw4iUVOVAl8XUDAq7BkKTpqakuak6
+dItQ0vigM1n1oUO0nVe19ZEUBctnB6RW/uRMrJZYKyZ9pq2U+tmmrYmPY2vFR8IzaTdvlMW8A6Sn4oAT
+V5i8j3AsT8VZpkqyAmpxk5+oMqtPpzrgvSxGlAe6NPBZQTBx8YWzd1F7XMYndGXI0wIb8HaUq+NjWb4d
+snlSTmqze0NW8btyOXbVM/R7u78g9zfdppTVPGdNdXSayTMOtq8X2hnqG5Y2iKbgWOUAIMXoFAjgALqF
+qmEha8+3o2dZJ5q9oW++XQMAeMCD4OwZ/TWOuOEQ5e8UMHQtfbD1mSVpeUOPbNvr+vDrO3gA/vZLWNF/
+y6GYI4TuY5yDqg5DVbbG3F3SxsXwLJPFcRDP/StqTdXvV1VRV8KokjVSvLtkZH+1wJ1ff3n++yesDnMk
+pQTVq77Ga0nw49l/UjWsz2nsfxQ2jUup2eYm7NvcrSbsASfbfPH45d6To4PDvRd4f73FZqknj5/tHf20
+9/jvPx3CLnytv76493fv69+2tjzblMJhkmMVdPGQdXtjkhUnsuSkMDMx7pntBhRT+FweQzUfvaOkUrV+
+h4z5efKMcjWj8Xde8VSP1Yg4SuGmVoyhe6pm+Ew5X/RgLGuR5XRfp+Ynp0A6c3XO+WzpEQitVmMuXuNj
+PpqfXYYbwYc2Oi57I8kKjtMyzkY1Ue5cmNQ7msnX9EmfOzqWVS2rGiZlJovxAG7C6fykoj5p/fAloxoz
+FFlKEKWEr8l7iB3ckHsqmzkxJ79FqJW7WtXudHGkqo0NnSCke2f7Vq8Pjx51t7/DPx7vdb+/pQOSd2hs
+DQC2LdX+Fls95/yxB5TPRDd+qn6nWX6RXUidjL9DjR896t4ZfEOufDd60L29tb21cXtr+04PC9n//pjy
+V9zQkEy6eAvEoPHoUXdr8P3gu1s9/VL89tbWHT30A8UvZ2oFY3WXs05rcweS3VEoXJODE1nTgkBvJxeg
+5XHBM8ZUd/Eb+JbBzG+t4ETWUImCDmOynJXShRrREmss81ogEx/adt5lSHxPpB/RoWplMa5mWfGSKeBZ
+z/ArVDPk0e684lue35Wa9mADLmCWi0K65q+4+TpswMIvm+FkvYxBjzM2REK3VlylakClz68SUNc4UZXM
+/YhqWi6SOupnr6J3V5QSWc2p4txexPESqxXC47ibIEgozMSJRDjCt3STj5IqNbbQxT/FCQYXA/iF8cAV
+cyZKusuqshO+4CpqylWn3zjhZJuUH2wM0ohW9HjpRNZ87PPSl/ro1R4Ymhmc9gOFOwWnL4YuCQMj86oe
+yGlWc5A99i1HcKKGako2QciKUUnOqhWzB+7otHtxyuoF28ApzQhDEhUq+vDbfDrjJiyBCilKRokO8qOR
+zLUfR7BDE3fSu6+srmjdQK0cZ+jTFfIzsdtCzR2XbjBH4porF/pw7FriQWwiSm9m0eBQzeYFzZWhCIMC
+GJfi5ESOe2bZsKe5LE34CcPsdtPgLOTepaGBxK92KPE39j06nRfvFmbGqlrOKrPhvHig87a/MzeJxqHD
+wZJQ5eqciT5Vqj6FOisWIZynYkQXqTAWtRh6hK05ObQFZ06l9m6YYCMsBCXsCoDtgUdl4nDIKktcs2DG
+sspKOTZjo3miR3vUYF5wfsZXat6hiP+A/6H4IrKU4veF5V7k1GNZn0tZeAvgeF6DqCGXoqohqzv83DAr
+DCxeejd8VFWVoULMksAk35WVRhERf/j812eb+6gy9KGQJ6Q/w88vNp/sPTrUk2/g62iKFHcyFzWJ36gH
+ZAmE+viZB818fP7zoY0KiXBNNk0iAD+CMbsnrcWBFWYLEk28I5Mw7muh2rUSp3c3Wggv+b7I6jl02Y5s
+bdjEsLy+xoLqNJvUcMvMur4ox/+0AwHtzqwM8TmfrEsId8PRM3wej2JSa3ODCL9XQKn6tCDjN6YozDPj
+N0F3+NkIxEVWaQ8Lp/r40B7DuFT04hTh/s8mEeR/jNbmrAhG9qM2cyckNZLAslGpjsVxTnauY5uov+Ic
+jsVvLo0XikNRjBZw5yGW1EgCrDgYIKsacDi6d4U6t7pd+IIKn+MaGbanM6biaEP0dJQ+IzVydZ4voDtW
+50UPcaRcp7hs9c0MCfEzWeJGRUQbWnJRytJbWqEC6FJxjxUcAICvzULS6xe6hMhD+rGxfXurF8BhDawB
+59nmvWVwEFAIR2dEbOKzNdim7QOScJ5t3tNwfs2Kv7WPa3tra8VxaTit+NwBaMfHwEFQz8NV1j4D7gDC
+PdxeSjnY+LaFcjGc7aVwNOU0pqT+bqp87D3EGwx4LZJy4J17QFRaFOmzqvXM1otI5JWCaVaRJsV9u5i4
+xvkx4m/djXnwZ6ilnbnPz88H53fojd/h/ubD5083yNC+cWeDX8xs/geBqTYIqFVkTF/HospG+kmPqCGr
+TTjcCgTtS31GE9+JQZflaZ9Vur7W4vpmcFTxpW7wCiieFf75P0bTMuSzxmSiraYAR3zOilrwtoVK/bko
+x1HeV1rGPBs8GNqJVBUBw0fUMK8IkKxrWXr6jtPOB2AEC+ec19b9GE/HoC3/fdC7ksdF5hMfcb3WG43/
+bl35Kehcy5WzbzaJzeGDAbx9e8viw/9ttTT8NSu+W72hXo5n299ds0ev4fV61P893vt+eUNrSPMbm1V/
+9nWArq3r97rd0tDvNdlQH2291w7h2bULmzf57Hhzkxih530w/mvVS3ySz4Z4gOqV/2tzM1Bn1gBmQe0Z
+1aan8nxQ7Otj3xo/CH4iT8RooZ8/dJgLyalPY/N+zfbpM6qf/aXj2Lml5UaD7Tdh+/ZWC5BXq0J5tRTM
+ywSYlykwL30wTBXS9JxGpgp49IhFSmjYML2iguW6s+8kB/id7tX450/P9x//z/Nnh/eeHN17+fggQKt6
+teOG6pCh2axewk3Pirezpue1ehV9d/OYpuPMn0esEtBu3EI2wsFr9TJ4MtqdvURL++xVz43b7gW+e2VU
+BLu77o0NUd0ed9A2yacdw8Uv4eaub7LcceydKohCYPiw0cKZgO0ZPkPYjYJLj08eiTzfwB0IB0j7xUgU
++hGCjSw/1oSavSS3gOplMO+zl/ADbMNd2NiGIWz7EzJ7xQ1eBctg9irRwDmnvWd5MITqJcsAkgtDqF7x
+T5YCQ5i99H6/GiLYKPgkW91wXz3OCq1qsxKN8aVkyR3BLe7BCjaqyUeHiThTpXGv1rtxuIDICqAFah9E
+hevMBq3RKga4pWrNIYHu08cDP65Tt2Nb6mu8p+YemJ3+lhgQo8iLmzf5Pp6l8bJ0zy5V+N2m/XPYfAxv
+X03ftaMZBvbbFq/hEHdzvZpCaX14m25a467Xh19v4fXR375cuP5brpSS96JhIsfElVIQlzO8EAqKjN/x
+8itar/WSivZCKhcVhUPmvekj8kwmx7x6oslk82SmSX7/lB70MpJ8+OCte3Nb4GXRmJelJgA69g4KdU5X
+DTbk9kOZU3aKp6I+HUzFRXerD9vfwgZ0bdMNS0YOb+QR1da55YB5eXH0NbaLSd1NRJnWOHcdftovxoHs
+sUzZ3IRfVfmuAkGJa0FAKUoJx3MyPmn9/1ttkCVv06ysakNWMlySMyuHmh8P1pIE7wZs2WvLJffXvmZf
+j1bX+vDOdyhTl7DS+vDrb1G8fv/ldv4PF6XVKeVl2kMr/7I38C/4Rah+6JrVCzheAOWK0rdBJV0s07Ml
+5d/ooQDiRaiTdVAMGfYXEwU9Q4NT+5JMxyXULxk0GvmCezX2eCLL8W/3CLY6/u3+APb9eE4EO3y1KvKc
+cROljKBaysRPIMJjp08ofNZ5r09du7f1+I2OKu6ze/BQzmWg87sQEveajyAMIPLFd26yCDdV+b6tfGW0
+HiTBvSgJEXbX8x66VvebFe67wDwEQr/6J2yoif6wFAEMa0M3/6qEe52KGnqXhRQF6v5AI3L8U/CAzKHk
+0haFT8wGx1kxNrim4wj7qAdBhOntWtQjD/R19qZn5uS1+fKGxo1duU+N+MF27N6BJ+CGVCgBn8nMu7Zv
+tr5omf8W0Wgv2R4+f/qC7thU8fLVqg5M99m2qc8MmIUpVBqb5VZXTIcrDlonq1gAh/v3nh08er7/tC3+
+b7djM9cZbff+vQf/eHTvwd7RL48PHt9//OTx4av21qgmYXbRX7JK24tt32mqwS40NC5cdU0q4LJ+cHBw
+aPCruj0//tdcOPdIrWrcDX8PCnGWnYhalYO5OdPhwfDnZ/949vzXZ50dCyurtKa2C5v81z83Nzm69Fzw
+E4RNNq3633dMjK7HNYjZTIpS2/Q1sKmsKlnBfMY3iGo601QAVY5laVqrCfz9xc8b1g1BjiEXC3pWyRW6
+lWSNEi8gquHm5vH8pNIun3QNUZ2q86Pj+clgdJLdzca7327/7fbXvYFp/3Ml4fZDOx980Wf0fHDRyhjr
+r76C1rm48zA1G4mUHJ7plQRBHzZvsn/Kzc0L/8fCAQGgqq8tz76BXehYLrozpnD1F+jgPrvo498L/fdW
+r7MTAUkwMYE7zcZjWdjql0kj158xnLbB9Bq4xWn8Pgt2VHFAXvW7Bo3OTlBYU2zYRVB2qWO99rrJQ0h6
+yf/VTiFN9l4fbuNJJCnj1od3/oab70pR6dpTfA82TTTIzUcI+qGoxSG6Se4rxTFmjHN/a6314TZ6939z
+nVhh+yZ5awVCJ2rTImAkKD3p8QK0mKQ3EOcc3eBYTpQ+sFLmPTXXeY8zm9yOHEqN1854sDTx1wOvY52P
+pkEpv47VeFaKnrTqi3KXRb3xstyFxUzWT0e1dcE0da8/ZRWpLvoZsn1Ac+gFYXVeerJgFyitipxyY/8d
+0zNVS+0yZdMOZBWUUowpppF7w8Sq7BC245c7xbgKXWDQwq4KSb0vkn0jPKzmvYIK8oR5wczN+PvQecDW
+epwKTYYB9WWCilDUv45JSra5CQ+VftdLBhGTRNi05QGRb6hpcCxBYBhwUZP6lusEicBhZ7X7ryz5zW4M
+aAPDjXHfWjk49bDsmriWSTOxBhWn4k9HsNze3uqvJzhtfXj7NsmRrz+SpY/IBemByHMOXOsb181b+b6L
+UKXKHrznrd0W+2/q/YpY0wWHR9O9DlCuJxVlNwidgllUIMC9z9yBS7i0KJZS1NKEbAv1PD+DHkrnF9a3
+t1uL8kTWnDOjoqrJ3C9qFid+0TkxOR+zKinli5phvpcd7/NAB+o6piQ26e8fPuhzml8+UsUkO5mblnRa
+4/s9sidwgFxbvec3PS+zOmimD63B2Bd25F7Ld3Lh/2YCNzd9b/6IcLVCoFVfxzx7YUip8wjo4l6T+B4g
+d6T2QfZ4zAHcZVBCFHZsMn5XYwcud0DrEJs34bfqNCtq2Ph1629fG7vzC1Gf/lxnebSH2s8kSTyOkrMD
+KcrRabdU8xrRF/UpR4T+11yWWvHZ3NRBn/lB6XFdSmOQrRVMMp3Le6qqmkDmi41CVrUcs3OksUiMTrN8
+vI/9EHb4x8D7ZiIgep/8cwuB6ntAdqLQiORzAJxK0oPhWB9+AEoVeetW5kXSsTXDZrgW1rxwia5okFUP
+dUpPjAXtf3+m6kd4dO65AD0B8SilCJ9iOA/swO+CRgi7/qw46M2pcWOgGbrHFLUndD1NBFSOb8C98Zg3
+Dq5GSUJkp6RrDzmwkHjGSibdvCB/UmaO3k6cO4HqpgI/Ms88U0wd7tF4yo53AHdQPzMqs8KaSdVLH82U
+cJn/0fBJUBED2tLrZWQty+0DHd7rBZUELQZITkfUXhyoGyX6U0S46+jOHWj69+G1XjM+2De8I/JGrb2C
+1wtVs0llXROfKHFXD7jQTBOOOPhqhhxWXWnMQZPPOOgArjfqJ6KqzeP2oc7P5djOTPKVaJcpdA0ZuPXH
+4q6RjZOQUZAivQIblhb7+4oeykBsRaoHH+MYhFHo8NPAgMN9WP+545dqWvEfXgl1Crvcufe9DGRsZe3H
+vp7RfcrylONkvmaE38nFEDoo0Km009daJ+vFgEZOnlntA4nUohgHG7wbiFAGcRQI+tSpDAQjmEyIBlcR
+x+7ddvDi4U6yCWS1gzAaSTmu+l7ONl3POLOKsRFppJYDX2R4mrkdI3NaxVzWC1LWeNPi2FTr5f8/JDnx
+Qc9PYGNmpMHWLfWNyGcu1AXpLa0MdzPdkpuiKAi3t8ReUpp9rbmV2K6bgp0E+puenyL4KZe2GTNMMR3L
+LSHWh9/gleQ333ykGo9a0SG9WwwVG/PZj2F2D6bZRVYQIcl8WNBBnuMnqDHGbkTW+nn/ySB02g+Dyz5d
+PMkKPDDs47Fk4C+e91wLuKNqCK+BBGE5eKaNAqqAN31T61QU41w+wOwaXefXxmWgHdbQXiCLWqsW3d6O
+q0CL2pkRDlW3I6i7Tp9OIHIIHRYOHbh0n2huO3DpQF1ajEqJtw7dAA89vV33BeAHAaogxHffExreUC5/
+pH9gKm/8sCl+dM28DvkPRoE1VI9A+ryvc2bTNA6NUCdqDt20s1ArBxm9o89KOW4G3DB3mKIAcUzhG2mS
+WbDw9I35liySTtycVwQ3KMVU1vRgCQUSL2vr/66lCr7xQv72xIr51K1VtDfE95lISz1wM7QljXeWDNZE
++GT2FguoxERyTsZ5ZT394bSUE7rINZHDinfmNTL1z86ljjAFe8d4Y/2plJNorPjpo8fa0jgx1kPL+5XB
+GacJUwdxtNasCKLs0Zuf2bxyoc0E6QfYSBUagjZkQFWL0Ts3Un+deaMNll/riFNDvaLl5xouJz2zA/YT
+GGHjrFg2aG4sMXGmN2bv6/WGvLzhaiO29lMW0xrRE3U/NLHxh+4KLGdqLjNZOeFkNzAjgNaH32BitW++
+/ZNMq6LC58V+Zb6s2OACU+1fwYb4r8peIxLNH6jpLMslbcmyhF3YHHZfi43f7238z9F/vtF/bW18f/Sf
+b272Pry+Oej2/vn6n2/++c9bH95f/n//+WbzZMcBe1xg/yvAuvvm5uu7b+4iwBSEw1Jk6NR+kIvq1AP3
+T/y/ux/wf/65Sf+YxsQ3fs273cHN3n9uWvsZD3P8QtS15NzsYbJkXUGXd2f8r5fWwXyCrGiCC+5PaSB4
+wUHpDN+4y1B94U46PDazaQgTM+EnJdSGDQu35/LwmrNO+Bk8HAYo5bxKjTN6p/v6/9u8+x9vbnkXZHwr
+5pkc0CXmZmdZD50KX7R3UvAHN+82YDfSLHb++U+8rEtZDIz22SD7a03IN6QoeOYJVAyChNwIWpP/FnT+
+E7MuZZ1efy0ey9D727+Xc7ptOxL2kOib2N4nt+Tay3HmHW2yyqolTqBl1T39beiH6jQfu/6xROOIn1z+
+Z569zU5Cqv4flSE258pqQVUUMAwxwOwbXt/4syv6cBz1KlxazX9u3vzPzT52iilAjneWa2IUg02HCaXk
+z8adzGlZ4YHRrB+LoW8a0PNo0W2UhYvbot8iAQaOIRLD2GPgrEChbKcNSvkzayhr1C9cT42hMDT/aOvc
++8gHpxRTnTH8R1YzKbJ75dzyzEmX/IAQmIbsniokuj5Nk7CNfJZ04WHYl7CafLDbRs+dNdtmauV11Hqg
+S3bSMjWu7c/QWnxwnrGvMx7kNVSDAol1+tbwt+DTNgDAZWCMoj0jkn4TVe6J0amfhtsU9jnWnQPPgF7b
+Cii4CIPXVBFuwfabnVDo2SVtTDttC8n3ZI9OL8x/HPiFh8JhZFSOB54BHOIVlY4hyCzEPvPC5CaASp6g
+Hmlge1DxCM0epbZZobgbT4bRtt7gLf+zz1q+8S4ybqFF3c4Cbaq47Xg5gSM52NxnAyVltW3WfoJd7+8P
+H0BverpXdlkyqzWrbLxpk/6XbJ0TSlVDd6ojmdrHdWr+jW0tue+m910fF91kqw8b285W48ENec64spqt
+t31zdlfSTTA3drVtsPNUvwJf/69q3QltGinxCH7veJTtg5MGZqf3bF6a2XZSxGGSMNmbQZgbSAZ3IBqu
+5SWv3mvHRpTiOUEA07o5bGoL/4GBneMhO7CJMcfEXoJfg0566gJSXfZSnJ5SpnlrXr4t+zF49fsZHfeT
+NyQauigW2vahbQx4pBL6QSHcbGzbp31/o6IvpgnJDR9WY28iE2lza/Isp/FtYCj//UOCngXfnIns9C+y
+pleShcHr7Te964tcK2yVToWfHpRvJ/YG1TQfJ3Q7M83+iCib8SdgqyckqXExJB6HjiubGStJ++hSQ3Pj
+iqwEZ0KLxaw4+f+tZCR3j3b9Zj0ILj3grj4nh7Vs58OwU29joQqcTQKBVQMeYjZZdPVdzntWXR9RTvkh
+dI4xNIesqw5c+uh5gBqKxrJrAzyp3MWDiwdAL/QVWi8zYth2bMMIzAbrw79901//V7U+/Nu3y/1tvvtI
+O0fTbuF60c4coQWDrOtJ8z417PQGtlA3MBel7hqAPlnThw9Ms8f7y37UUT86vD22caV0RHN0iTHhotAn
+XuSV5+lFP/0LJfzdnbEvCf7DO6G9fvBVDu1bMqtem4rN1w94vtXeRD8glwSAkHd+NLgVqtZZlWAdaxqY
+WGmdfplj+2UiF8UVoxaa2n6eDhw+qYjDiKQD4yf1fNKlZr2P6qxs9La5ae4Druiw7NEjmecPn68BtLTB
+KVtbu0zeXXmcxuY/gro+/OZ7vVjWh7e//r6/3sLS6MZGt1x/u4ar6V4xErNqnhNhBJSS31UYO6in6C93
+Ft3XDdtMtXyLpGAXarWz1n65nLpaTjmemv6s0+n3Xzz0vnjoffHQMx56H7UZXveW4FzQs9B0bV1o6q7k
+G8jczhdH2vco2HTbXWOoRte4xaAGyMkGOADFfZ38oA/Wfa4PzmOuD+gPXMsS/3gixZns61vzcrk3zb52
+TXN3UYV5aR950dTmvBD71hjDU9oXiUq7FkbPa50aH+WLTRX47QwFuLL5FdQwhNFVzE+vjiYY7BrSBWVE
+Qyqjv7wyTVbYNQRO+wdpr8PAMwhfaxXjB+jUl/INotIq3rV4IzMeYJ0qcApc7qXj9ad9EN3diD25G5cj
+uwFozDtUMPBgcPKMeSVBcPITcHqjM0bc4MtD3ws1/qJvftY89wyvlG9MfJ9JVrzAJ2WtWOXv9JMjN8Xd
+0CWpMgeWzg+MeoC5Zf+eqXhrFzq0EnZJNbQVSDd0jf3ayORebfxJtX/sxF5ClX9mITehiF2YnbSnSoNd
+HlBpFZrFaZ/miYPnM7a3m4w51tZeuLzxBhhbP/HUfEpBfthWgxXHxrQY5B4y2c/MaXmi8NmwBzAIoQ3m
+bQknTaH+U/8d6iuOwJA6sMGHyR2jVpAr9W4+A2EgLvmPIEApc45tUivI6gpHJIva8+S1OvQKIOdF9q+5
+BDGlOPd5HqxHgzk98OVu3LiJF1L/3UtNDdPYGkn9yVkBS05USx4Pi/DqSE9nrYyFiFx6VgLJfhQ2ugGN
+R8fvHYCWwgT57eZ/vscOL9+uANfAM1Z1bMgCsA+qdD0pYxXzqXrV3BOITTcHyT0H/zssOSOljjxt5C0n
+ShAUyrkayWIsirrqaTArEk1HrDX5iwLuLj3U7LYW/deGWsMH3DHlCpjhQsCAWphJ2UfC7pyrI9H00CZE
+VkACCZtGxGzPicXivwhU6h3zOoXzP5Y2PaNmqlX5pISsghOlQ6ZL6tqN0keLNYN/C1o5dZ1CyyglCbTo
++O7sHyFa7MeoEVuJnU3fnN8Kpaov4Kza2xTsgdDV+aQnyqeylvQs8lbh4ArEvFZTUev84DZZG5GZtk+k
+nBbNnGt7xTVrYjFxphKOOIvBkkY2s4S3O1NmuhUA4+NJTiJqsprYVBaE8ViOclE6lFehQTGWqKBJzqEw
+AVVIizAt12JOtJllciSt+8KKVODJOs1kiS7Qi2gnP/TppLUMs0fb7HucwiMChBE1FtQWamXAeQTt6x8P
+vach5tsz/+UEiXfdUNtTDDie7nAyQ3aZV7LyOVpUnnawXLP2cO0yv1Z9CGOO4X+6CHbtX/4FbRiJx1Sl
+GzzWbXvuK7ynTXBovzgg+g7errzdxgE0uCk03x2WYI7BZiiDAJyLA5Qu3vV715kEPdsqsrjfgNQaj35m
+ogzT0lz8055zDFF3dOpcnYGv035RGNIigXPyWlUfe011d/x1jxkCaPUpUoSrBQSu6arshqVl8NzNfbRv
+3fx5QAjuGB27Mfk1l05nfDddn8bTZAcQfrNuG/r5Mrba0lOq46IWsqrdJa6ZPPx7x1PPbFL2yB+8Y2wb
+MS52Op1nW+Rr1zpWypulFfwZOWnJi1ryo8qsDJezZhCaS0do8tmKMepDhFeD0SykziZdHFjbiD+GBHtq
+S4rtDu42p2ZofNICRkU6NFmIA8X88+Z/6hgxmln46EmuifSM8t68Vht8nIeb1k1Ya9ZWg7OGBY/5S72W
+yGmwYaayCCXNVY1l0E8sAvfNmbHsB23OMh+MWWsVuYZFZfjoNFpE3ipxMxC+obQeDf/FZ2l7YTTlWBWi
+oE030MQ7AYc7XCP5NEi91txJcX/ZeCJ79TCil5ErjiPihFVHknyG6S+HlHweNC1UDZ5Hzi0Wmi3tMxR9
+/252B1fXalR2T0+JAW/jNSAaGaAdgU340PYt1tQ8iqpFVDB9cVgafUANx92Ekeop9nTx+kkYy5rK1Mr2
+JHPIYzeX4Ljgm1K0FcDAil5PrKJL+dgZGde41WWm8ZUv737a6mHv3VGaM93CZa93BXECrfLPpQ4cz2so
+cOmpSXAYhrFahXIB6p+BdAbeyrQzmvfHkC3UyM3lbWXSxugDtaWcCtXEATx2kfnMlsbhtc2Y8Gtwsqgp
+mxXDs5QUo5Gc1VVoyzQgmhZMegRn/rtXgMgznY0NAbzFffGtbh0axjwYtWockOtTvqjn/2WVtFb+lXZw
+1hbTKmhu7HrzygU3NE1xFVpMWk5/1NQkMjMMymBdv3yz7fXLHz53twR1lSOYubX/RL4P1LNhQ883v2ni
+0bX1Zqe/5o71pLN4uOkvXcfBLa+/+D9XbVBG4xnUqu9hY5x8DTBTQkCxwD0Ri9TQYBWHD5L1ztL2INkU
+Nx8kL3fkaPeP8kv00RMdQ9Bv6tut/y2ht3SgzF8prBNrvSb2XCNUZqpSGEqGD8w/z8ai1io03avTfbs8
+O8A/vedW0bdmmF8OoEHx2AmksUHbUJC0RZE9ynkpnopKR7UaD3RPhIMXYAFPkaZv+7k16K49OyAbMSgX
+UgELEZa9EnSATSVdqzoVpRzfK0ayqlUZRsCpBpMsx6Xm7tWDm06Nk+toQG8Jnk9MNQzdt8E5OIJFcSPV
+66DC7L3Lu9KaessV9mXPT9HxQgd89e4DnLep0Imw2IonTqQJKhjNJQISo5Eqx9pMTDpEeOfgIuszSlHI
+OPbLoNfj8Q6+LxFyhYJZFnXct9avKRA+ZfgrXJHZDsOHPqFGUxL0cGEEL2ibxd0w0oS7cq78kfWg+c1/
+ogKJcnyrdvqGg86mVmzXiNB+gkou5IZHi5haq5DkRNat9GiUfS5i+O9+UzT58MF/9bNmHSHttcKvWZ4/
+VfOi9nfoRmEimJ95amxdjewYzbLp9nwbZEvwv4jfm/H/Ghg/zMatCJuybvBK+2jui+cWmCzCW4ByYRel
+0Qvj6hpJ8kRPro7rMqjh9dbW0g80nthreOLjDSdWprxHX1XstbPKPHpu32H7XgRv4I/C8np7F2EVt2n1
++uB9ESaNRbvvdyAdjc7TIg7Wh9990//kwIzfbv+bI7qYqyiUWNYkaEIxUTyPNbipFU4vmge1FaXV2POF
+vg5cGhPm3rGa1x8RFob40YsIk4q/QlodwtHvv9j/hpxD7ecdi03g9JNV9wj3bkcggp1ez1UCDyh5+GTV
+Bo+0E0Iz2jQN6+HzpwPRfe/aDt2flNDFQw6tX6UsloZ9YQL8URFfYotE+Pj1RNZRnBb9ZaWwEbJ+wJC5
+xc71sFjhqZBGpiAixyji1+ujya1WegGmnTnsNUe1dGX4KEfPPe2366LLba6DLNPxWujGr5XMp+shq5tc
+48W7Pj6shiQr6SGW/O16aJo2CTzvUcJZWcJU1qeKBKdNEwjZBITvuukO/VZuMhQcSYy/kUFhHAMWSx8T
+ImhJ46U7YC28034UveXbFWOAe7FVv/lbW4IKP/J2uIf5Je6ZkzYpBTXNV3+zix6EOOMK1MrOj5ZwNlEi
+OsQcepGGOMBn8PFRqabG0aaCUo4kOb/cBFFY91mbPsQ+ieDbBx3fTwccGoSZfVwX+ulfKevg1UnkfE3f
+xLEq630pKiKeUc71650hPKBzPFWFWoENLFSXC687R5uegUtViMh1qXPa4Hc+trqmXhocwiR4dVISWs5e
+0UTYvLFulgA3JiPbvfvP9w91FsVk16VjCtf7svc71FWXg5+0PvfpLemRY9lHKSYT4MPQ802IZEgMsC6d
+qdDoXk5Vi51LuAaOfz7yDRKmVt8IoCDEQuOtiCxLVYbHR/qE1HcI+RPkKnv56hhMIj4O20L0Fa7fFHnM
+/QIIKga0cDTgKBBvPFIEl5EcXcAPs2DqxfG+KGY7g4EsuoIYRB4WAWLGLeIHuNNzow9cBEb0VrobDNan
+VG8FD4Em5Esv2o43/m6Tr+iB2nKuimKuLmGsfcxq1M5d/3a2IiP7Kmx1hTX+38NShJRjqa//UizV0Asc
+jxnlwBdx68NvbmMqEyNV14ff0OH2zjUect4b6Qu7dJzRzZQO8UQ7ZJq2u62vkvlphM3ekFVwLNFeio9N
++AC8JL7fi58PfsIgofNKB/VNvsl1pxfbjXucq4MSjB3Q/b0XT+492BtCR5ctBU3R4VHpKGqdRMOHPVVn
+fmzOtmE8f4GjULNOi/IXkdM8Tf32Y5NHNKfH6W6DTbbHVJtRrdBwERv2+CTghVHOphmdjX1veFNbJ2mb
+qrEsC5OMpHLG8MfcVmfm8axZxEUpI5SnnaeKu8bU2wceHWrPZuFW5xmt5GYJwEhUMibXgNhuWQXDQs6R
+k5OMMNEOFca82fLkw3EpxbudpV0+fzEMA+ToAYXSJ+7H1BpgJBfz92K589wVuF6msL5ccnJJT6aWVq3c
+tj78FrMbffvNdSRVC1fm52Jhrl5sIM5azdzTlRM+MExqWQZHk+TTdHddc6gO1exzsKeZxVbaLzeMBnhY
+6fDtl4frXx6uf3m47j9cP8qKU1lmtHTcCKv5MXFSH6r5TJb0txmRyTpnCzjTrmVLdPKNCnXG3TSjH2Bd
+kBezUlYU14ge78qMnKeOJTUGVXqcT0kpgF6uxrjQlBnsHU1dUlxeJl3XIkTXn4b34F3hDOG9cexxxHFM
+PGQW7oNhPvZJ64PPx8ZPDe3lPK8ecS3SR0eEw9ER7HqI7dBK12KdXh+5h1KUJH0kq0qwf9OJrF26dW1s
+My8n1ujJK2W8g0zfAcDWYPvOYGsAj2uo1FluHptgbG2696cQYVDJmSiFucjXGab5WZ06L2S5TqaodXYl
+XDcdayXGRQBKBP4xdjZu8WspZjNZhlLsiNr+5wMz6CgmQdi0uzyUQFjZv+yLezFR4pxOEddAx+B8oQHb
+ON29KJ2xXWXdsO9+Ax5jEzzQj5sET6/5fqnlcbl/+dS4PQ/vdFp9rsLeyfmKL44ClJvZCuNmJjUcBdbB
+zfi7/4s34y9C9X+RUG2/o44uqW14lJ9sZI04NJkuCWq3RTCzOvoPvkv5j6FgF/w2XuTwLivGoCbwg66G
+mjy250VetflyX+G0fROeF/kCsLyaj05t3iovrURN8RP4NiKXZzL38pTHzyWdrH8YPhgJRLmzydmvfvUr
+xLdfNRTe1FdCZJsXHNcR1OGLziOvNy0YHwapylAsmir2buNxMcrn/LTTuRyLY3UmtQwhXY594zDRdTGS
+cC5hJAokPewdfOflNcV6Is9+J7vA5qZNdn2S1afz48FITTePxbHM9f9mVTWX1ea329+vrT0M8qF5rI4k
+4utodxvPt9a4obCHsSuh0HdYYDaNZKG+eArK5sXIv96/3Ilw0uYQbGHQsmD8dZU+8kUzwafo4Eaw7yK8
+fbdtf2ig68PvbuOG9Lcvp8Mvp8Mvp8Mvp8P/zYpM+xHro8LUraQXrXl+IE/kpKZsYXtnsqi9JGiG2+jL
+4Hhe13S9TsHeLwMQT/FGJZPjNgg3bvDHwVTW4h+SXpPwB5HXwe9RXebBB8py+g+5CHzbf0A3vx99d0NR
+uvgQzBIgCvhB/Agyl1MbmwRTa5H1VLiwnfArB04RdRx9pM8+1+hSeCKxkwLWuWRdC3DciaHLntcIijjK
+qG1vue4D45v3liRqz7iGPKJw9OTI2Mdpnk/x0L5Qc345Gz4XI8ycvyNrlDp8WHWqzl+oql7XAcI2Z6qq
+q80h/vP44brZl3ffY6XLzR81FHp4T9c88yrubuRCuigvH5moG4g84cIQC7z/3H3/HhiDIaxv376zDpeX
+YDuP3taZK1OeH9RlZ7Q/5qo4CcOcu/f+CMY97npLtZjEg49Fj2BgCdYe8uJmgmkVWbuXrm7lwAZXKMdY
+5c+1aGCPK9kxuGJgvfCSDLaYMNIZFa0brUAecze+ZlNf8x1ts9G7fYp434yhx6qLTnzY8+uGbrmq8DEI
+4LTIqw8f4EaLNOw1sibZq24EGCCxu8tbhxNhVlWmn3JMdXDQvQQx9AsnDb4t/6Q3mghEb4VEd45KtQrc
+hc0y9D75j/4Sr3RPZI25AjvLn61YsYg/1k9LOVkHUddlduwFbFJ82MJnc1pmL3+hrLtusZW1JjX8vIO3
+wv1aFCA5sBIJ+jrFi0nV4L/zDmBGe407heqwYFlldk27vV1JXgstCnV5tSd8vGpPZM0eo+QC2u31Ysd3
+F9SSQEWjid8SeT21T43XYYukirFahZGc6+tnZKTrmYT5dSOfu70Hx647/83xKWUGNZPAC8Y9L/aeEJgq
+3qS7elqaDiFONOt5cdrXwMH0+/N5UC9yyo3ewhNct6Jau9BoGXOB/xpCj7vl6UPaTo7Loc06/pczCCGy
+A/9xhja6rPI243JHt48NSdEya9qUPCjIDLXyq6hCPp/gn93Xcbt+jM+bXgRqpt8oeODoNINl/+IHAaki
+jx3SFSynRlkFLA0ShqsGGTr8hRakt0Q6nRbXJmakpBXrGqkJvv3+y/3KF7PEl/sVvF8JAs/8+BHXKiim
+j+VJVlAMfjXx7lk6VRBeGJsuuXHpQ0ZbgEm6FV79/JH3Mc+isFdXXcgE9a84dAZ1/7grmSigZuJOJqjx
+F7+UCXD9q9zKhEh92rVMPBkfeS/z3daXjezLRva/cyO7emsy7xCude2vHXh5m7KByKKQY8YhwAa+9/2w
+7Gu0q7YBU/WKHcBU++OEv+khKfe9FFV/ZZFv0GxI+1imO2mPLyUSJRRs0sZyM6/ga5Wo2iL8cWMguU1R
+aQ0TNVPN6T3AwHBjiDeH5B4Q5A5bLv5R1G9/uYL/cgX/5Qr+yxX8/71X8NcN//cHnJztxXfscFjZRDxx
+7B1Reik4bETuE+nlCblJoUWM16EXvoByt+tLVOqRL9hFWVIEP8gKEFDIqkbQpZQuJcOAb9P5RSRCp9i1
+/5pTXQqxw9FXJV2zVxLPvHIMYzmrTzc4kkGtYJKRI7xRilQVJgTyn25yf6ogeBMO+I3y3SbmcB0SWXIp
+xhzIjoaE7JgRjRCWvd0XNsR75GNgKWqzMFO4ME2LrLAZmhCcKsey1IEaFtTcQ8ePEDEr5USWCPZc0HsA
+w+IShJeshW+7/8/BywG1evn0yUaevZNQLYpaXEBG6qWAE1yPBtJZVs1J94FTdW6ogqjkIhuDmtf/f/b+
+vb2NG9kTx/8evQrYu2OSNk1Zzp2KklUseaIdy/JacnLOV+NjQmxQ7KjZ4DSakjmxfq/991QV7o2mKNlJ
+5uwmzzNjsQEU7oVCoepTOJkYz63IyXttEMAqWZDFU/pmjQ+sWcHufH79nUmMbBMKeZ6XngnCC/hNT+ot
+2eWiDvPLRd1egJ+F+XfPwuzf0lbSv99qGCT6/9cauGJRdo3/umPXP3oRDokUvRLo15hvdfrmd32WyTFB
+b5zJbGmQlghgCf7WOUnuo7bHe1W/7ll9AMqxVJNehiYczkM/JJheEFwPia7oO+/51IfHms9XA2NRfcMY
+fSKCoOq6L4x9m+WXJEft3PcW0P3v/EwsbN5mkPjtZpZfel9agKrg74MJK6WZadgO5OkiMgt9aHApdAwi
+PYBcs43MHzr/apVSr6006lhHwZZQrP3WZh1WyXajXUcqgOTt3iAdyKI+pAH5X6874i8wKZp12QMbaSEy
+rBcZr5S1Hy6q02/i/SES7BoYw/8dHvI+Vnm4+o64KMeQlIKI9fOdSVngVfJTaA3X0RZ6CsK2N7EGfOFX
+T/+8W/55t/zzbvnn3fL/3rtlw4HWh6oL0n5jg3Bs6v7zdy93D/fZDuu8e1d5fPvdu06oAw/kTacHN0+w
+ziItkFeNRhKiAk8NkiGJC3SDisUydzm+pXSmC64jpP0YBn36HWU1XfP6IpstcBqbIcIg6/XSbuzm52pa
+u5mf2qZoD67kw4QNHCawR2xL579uD+ESY163NK0dGzvErMYxsINEFniVmKhTs3Df9tZqC8FTr9EYyvhb
+tgbgy9+Us3UGx8u6XotwxbY2IVmmpQ1p+uNwA9rWRBaTStRhwd0al5C1DH1tV1W354HyrbS7dWXal7tP
+92bTTsx4QzSiZzaSvNHN9VfEj2nk7hqpLRH/rgU1FouvGrDQ2rLyw7E1rdY9oH2NtY7c+VXTmBRbiph6
+SatSNCo167zvh19Do771YIVdOW3wtxbAb9PMNDAHpaHfp5sojYcJ69f3evspbXGn9pCiDrRMfm/bRZL7
+lmTWze9MmOLyXDHU5X377PjYWfP/rZKL+aZ+Qs4w6ArmgnkMe28a8X1yHOxIR8gU9jsQdL/sOY3/9dgw
+SbRDnej0WcxpEvdzPQr/va7putGtdrf6jHT36nIxOwtsb/u3MNENq/TO6jvUu+Km7mai5cIerpH7wy+/
+vJUZ61drATN68ty8kmOhWtCdE9f71RK1Rs1JC8g68a4vO58ksNMq4MJVuIUrQQU9EgY4Tm2mcxtaP3I1
+NdWEFAzEpNr089hyBAB5Y9Ewm8PanlTi5oqjbKb0MV6Xbyoc5rJloxA1XtEgyU62i+umAJ8bl90zo6EP
+yq/MahebWkEhV8kygWuYl919X33LS7w53oh17s1xmCkan9thrB8i0myQDT+tY3wF6WoxR9aRbFmUaMfE
+BJSLxsR89l9WTzyIT7OS8HAG9Qfxaw/cc2//+e6bFyfvXhw92z05OHrJdjze8H24tXS45VRFMdTjjfUd
+P3t99OLFux/2f9z96eDodVRtC3cYarFvw3PRVJ5CSjtPmHMzF1pEtapFc6Ciu1ZeBhmNjw/9Hky5Oroq
+rdLOL9kD1Q3Vdep/f4s6J0cjTGwNABeEpbsOO4cryz7qmXgJEBlJu2aV4n1t/obv/4egmuHz/3Gw7n7U
+tWZ8Nj/uUYV9qMgaL2zqhhdHX4dANwHmvKjo281suujmJtsv1aIyQU2LwkXX0E9ebMwroRi+hbIspycV
+irZHPn6hkrjPCmQ/jZjspD0uRLnNHj3KnZBrMwaFQHXs3z3cmJ7aTGZuxWUjpdeYUht62O+yF0Cw7Jhe
+EdKxE3391ZyezgcPomw2zVsAPS+Knp1onpEYr04ktFv/sIurdN9owOylbnujbeD1glo16JUf5Nkbagfy
+7UdH9N/jvAadupxvvRjd4Pi/Iki3CxCbYez9Tp85Ou7W1VpPGDA6avXYXotUb+XQ+jnDUcZJCqYICxwY
+x0zSO772N/9LO1Z6yfh51g+8aONUWqKpBUO7c7cSQXsst8F/WxgsMledAcbsGN/6AgqWO76l+JI6yzxO
+vD3fxH0WjaLeIV6oi2SbdXqjyVi8pcX/jNLWbrA+R9Phn93p6WFDkF2TiWfLdnUOoIKG2yR6WWUv3TOR
+20LgMoWWM7VkKOjDH8SElOfEA5ZO0JALIeZYJd4Yy1r58MyQXy3LsbHLOKIGGVchXtrIRBaIwwBtPDaq
+cvefuVplPRQp9EGAzxdUwkoxfkRl+5E8zsMI0oHYAgr6jWZEZcaYMXvCwbvkeQGPJX12f/M+Q2P2q1wJ
+akIUyY+a0MC3TrQkLcm0NMf6ezXbRI9RYaNkiS9Zrvyu0++YcOsGSoYUIUxAAWWK72JQmlsUx2AU8MVK
+7UrPKxqvkVIJFmteMs6UqC5F9RjM0phwF0kNRhOh/6t8Ni+WhO7qBQvDMK9oBKYbY4Nnoyme79OWCH7m
+BxDyrjZVGAhb/2A79q8PH0zcT4LZCK40tnDPK/mrXtc2Kja7tqF5UdMtsmfOVmqHnb414X0Ldwc0G9t+
++vChIaCbYo3YkqZwlPDhQ5vMbSmZqHnX5guIFMeNr3NRwjgHNzmjhoX0LFdzEFfdUxel6lHUT6auu/B4
+SzPV6XmfkfOFF9+uSe1Zaja/Z94QFrIo7qQp6d5zFwyIDU6qmoEoLwcvj/b23+2//ImaVAtVd7Rw4Rn2
+LJRgXL9yM692Cp7qr292JsYcssNDcScIWlwUxkjoQsxrw0ZdNGMvsJ8Ox+pHBHCiUdAV05hBKUSm4KOF
+btHdGLsQsH9VUdTXPvMGV8s54ODhB2cBYgXDkC61ZJMFSu7nglWk28ADhIqZ6BG+nlffabGDP54cvrCB
+N3ZfHQzi6YSmx3qZBw/YvehiDCALrkysi7E7j3Z7i7Ugjiks2oIvtVs85TcIKHHAZwaajwX64Zpnd5NA
+Ea9exTvEf0lJ5+j6bxt4BY1z+BlYcxPqYFt+NBy2cqtGQXBcFwrBq92iaMQHDBOCBmvD09a+bcc5PfHX
+8pYggzPSfbvdaKKFpMEgg1mmbORDScAweqPZEC8kL1ljVLqzs64SggUsvWfDD2kMGeZEem8k7Dd9aYrn
+Lj4odK6eMz1eqV8z+b3wSjfc2Zqj6hcOhnQAcXn0436QYGJe9W4Y79cUf8eOuQni9wnHXIf4aYx78D0x
+9mYZRgu1sbga89fIoTla96bBeCWqiaxmytjkGnnXxU1k/JzD2RQMUiDim7FxVO058GsjDtlcB1zVVxh2
+rSNAQeUDC9CEUmI+YaV0RKl9Y17CsTPjmWiOO2bxxht/R1HT7X0GVVIDcGzAv+LFhKVuXEp2CPiZksWi
+9sQ92iJZvKCQviNBY2EDyiKOnhdBNNXHCxEF6DWf2iOHOlWWeVE3W92qXgFHSfehW8texK0pJmUtV8b4
+CV7PpS/R4Niy7+HrMLHZT2v5NojF5iQEoheTcuHgA8eUQEVSyzC+m+6D0fP5A3G9ES8ONyywlumh233L
+S1jv+tWconhqJYEfdHWdhaNFfLMJlkzxiSg8oApSKk4rMYGtyRGP0dHRHi/aLcfsOlpod1pZP6IdQbCy
+fkSQtnVWlnnPJHC3lhW5HQ91KDL5F97vWed/gOyJtIfB4m0ZV3dm24hMMADkkZW7W5e1B8P4HAt44Tx3
+VJyvlCxbQ8UFQ+ej+XnD53/+pEN4G0kLfZPx3GFKGonKjXomhUJhXtUcVUe6q34gQ5N5oA+wbsAck7zA
+FoHRbeT/DWaRmhbMY3QTMcVumEvdx5/zgMd6X1fPZGOsbp7P3u1XNWjIc7lQ1K+JcbezihZ3lKLFbD4h
+vZihaC5yHN4oCgFUzyWjaJ94IXAqGjdArvhLWYuhU3JV5EFSV3x8oezwirKuctLJLeWi8v3ZyP44aJGZ
+J33xYopsmftMSSiO577mjWjRj9KInR1zFTxf8CoL97KWXpRe9zB4C6jA3AJB10y9ZSqvxYD9KK/Epaj6
+pMVaqIBc/FjeJTDInNj0xAQMncjmq3xehrdKRzS8XsLV8gszigQeauKP48X7IQFXPMQu0Rovchw6PvaO
+BnN5J1McGO9KFDk/MxNl54kePZr74Fz+wAG2zRnw4YfGRU/308Q2/Y5t+Zd55OrRqPVaOIWch7e/QM+c
+OKuNRsSoCGwDYV2Tw01mx8EP7KpX7dQ9TseHkvcE5m9LUh+iutEqyAZG//jhgxurbku423VVPs7QXxv5
+vymp7iyhu7zHqKIhamr8mqOTwkvyK/etA8K5CaF1g7C8LbQsiEl6jtuYokcNwTv934ZZ+t8ixnnDERQu
+rOuWacWR9qd134Qxbg12TDHYca6UhK2mt3JeiLIulkxdIRgw46gew8LKHaw0yfh1mwjlin6yeSXPcK/K
+Kj/PS14bH28362wq5cWgpS9mJVFk+AYWdJjcpeeTxE3UqEJ1jgHJuvoHKEJvcb8MQ/PHl8wyQ76uooDQ
+waz3vBumeWHwqMKBkJc5RPgdgG/qmaynnozAK+0K4zSZHUVKY48uVzqWZjZgR+YMjGuyL/oSsOLr5Bl5
+EM5W3/mNk1N9JmpRzfJSKA0o7Lle5OWlLC6BeS0dZF7iem0OTW1PsZi7vmkHexpoqlHHNNLJ+mFETTm6
+2jdoZ0LVsPDscy7dHfB4y+SV78Uv0wXQJ96nXBROkgGlEa5gaAT090JkTJYOKUCv1EqxK9Gp/OFHD+sr
+vnSk4YoNLyisgpNbke8KZEN//wF7kV8ImEd6sQkbciITzXCk12xPLVNr4AdYgYl+w0AmW0FPj9p1C1c+
+k5XFpwolJteAATuRrBIK1gyxmmklS7lQxbJPSAdAVR+CdoPZrTEgR25HmVpyxXP9xBXtsQVo0r1amqKD
+YRse3zGf9HWZrCpvp4INL0towYIXJtzC0Y3emPeh3EHGp1SlsbqI71CWHFjHwB+grPcp3HQwbm6CWDyl
+xcAyeW9jI7iBjWVF0pp+bTWBkxlXTPCqWMIfc6kA6FKwWvqlz0XN8pqdiYmshCdFVkvNrFaEoQ969+CB
+G4adZATqntMgQovjgNeaUDwbM21LaI91q3fbboprlPmetX55KS1CJ4GJQEHU4gzYIb8QDA2PbBgOjTZB
+kTX+qu5/hyL8FYl3+q5BvLRDWrw+i1sCY6KbbBwz9E/zXBouNKvop6VG1EEwcMp9f1WiLZhbl/hTv8TG
+mVGzZPOSStQ92rq88JJpW0HK0vZWOLsvm7m9FdbsyuZtbwXwWWPGU0v6a7ux0l77xlThtnEE2I43soNJ
+XtSiajP5iWTze9ae0I3K3WwK/cvGdagtNN3Dd9z39ce10/X007RzhdAbjLC/MFq6lLxYwVzXwZsbeBzb
+D5qHW0ZhNSOEPU/f9a7zWpt6zHO1JJZaYGjQMD4YqCIfC29szQ30sTcIZhV61F39A8jX9WU0f4GHLeiv
+ugTQ2hfmuuCdzME1UK+LyL5gAAzbDpqWeh2J3jYdLawUOpiSLOu8XIiBv1y9TtUy6JKZ9XCZecaWK/vF
+btnaflTaoGXA//ejFDqJhvrffqIcYXAYDka/43zGXMXjBQ1K5APn9T/Kob3d7KAEydft2++m289xzata
+NV7OootOpQcTz4Ceu9rgd2PalrxiTMK7jFMsKGPg0c0HYsC4e5XyDJp8W49epDXVbQNK8DcKxQipPRbe
+vShtAXUmmAbuDuRVzS4eWoUYvRoq1hWD84FJDh4ADDxTNYiUaak314VvpVAtyq7pRKB/sQ9L915bly8y
+hegbswnoNC8qwbMlqygtUBE17YOiLRRuiFJcHdPMJhmGFbg8JYROCZ/EUpp+NN/0eIWVS9eyoYiot7Gt
+cEcHrUXFV1vBYNs0TypmV1nIU8y/vvGWHcOAptuIkWx3bx0dW3M+TCF4XyfNyItc1aIUVY+1p3WDEQk1
+K+EUxisuirQVHMGbm+wHKWtVV3zuGc6aG79Bq1330V8nBQ8aOvMKrY/ttO8qTE7hnoOpX4+q5dyrBH52
+73jLC2akEjN5KVonJZW87rwkZqVF+3suakMm9Gs3X7sJM4PC2nIlyMV4UD7RMC1FOrSQTFbge6snfPF1
+Stf5sMaPklXq6tN4w9W5Hjxgled/gAQT5k9t+AP+wmnJ4rXUBybwGt2QE/22sB1XaM0n+vgREU/RR7GB
+bvx2EuyXCPPD30iJSW1oM+7SQPMglrAlXtlUkrhamluGgccSTS5tBLB1bGa0SZWc+H0ARQjh1ISmC6tb
+ThJest0EbdDeakj/ZG2msV6v1f+HpM5EozWwQlubsZL1m8yrii9bW6yt6la0s2HGFie1tzRkFzctZPdS
+7dtKuddya7tC2Ld+yDyv4SYenNdg82n1y/365lC6j7VENVpK/3jtM0brwuMPChooAceMvZP8dem8kx48
+YOSgY1RWcNEMHYS8xdG0LNjY8Gdglr/PSzVkp4Gf9VudaqEQh7bXrXFRArINiARH4BboDIyth9Dg1xxh
+KX08ytJWP/xscBerCGPJ1X9A2BYop4b1+ynN+pWVcbW8G1IO0IZei7EAx7NKzlUbIpGfJ4YlUoKq6DYq
+7a2oVWMcrQ+BpEUqkv5Cwg1M2ySAi7EifJ2G3XnS2w7HsNL2hWsgzjj4nJ71w7Y7RCsBW+1wtZW/eSI2
+FrwNs9woQwN7pUK/uQYaiO/aA7gsdDGCFvett/w77fHR6fUILyRw7r8//OIpIIboHX1/+MVn8BPVkPeH
+X3wOPyyPuz/84kv80AAI1Q9O94dffO3Fl/jiG/gRsIz7wy+fwEcnw98ffoktaMHNuD/8Elt0EybG/eGX
+2NiVhub3h19hpshe/f7wK+xGGjXj/vCrr6PUULlwf/jVN2GGyH7l/vDrJ2GG8Gp5f/g1BmKKfD3uD7/+
+vH/fzN/94dOvWqMRpnBLAM3lSTs8q5+iX1qALMK2fr4O/ksA9fL153eCerk1FOJtsGH8MG8R2ocV9jf9
+TKZgHLYtXTLItRqcwysV43SsDFnk+CdAHtpt53DbXqLNrj1/HarsBmNBJrYT/f7wgXXelOAdWzrsOOQ7
+gX+wDxiBVfiYCfZjCjQCSvZCLk0K7x1H7NRkfGvCv5oP/bC5IUYa0fE0NfukGIvsuzDbYCaU4ucB+HTg
+NO0xDO3O64O8QbOds2MMr9YzLoAOQMxw8qnBaNJHwNRDo2fMNxoqyVNLZxjE7/zbUf4XAh412/IDy7Pu
+bPoE0WW327pt2aQ5ATUKOLUV9WOYPMjVT7zIszhb6PeNtcOYaBhYnQutf6xHZSL28XIeolj3bUkz1J4/
+5cBbj9HeIELOu63PwgJ9lqCHcrnPCsI+0S7VcoKfrdu6esipMKAfRmxsryDId6saYmO6BHGT5Sa6qaKr
+29OP4w4E8aNtMOcbXMESoZ81PMMq1ADv0qpVS2lvmg28Pnr1UagROdE2VY0XCR2upR8FDcU4KFGI0z6T
+lRdbrs/gmWIYRHD4dXXn+8ZZxRBh142D7rF+veltB5TXdLSLAoCQTcTm/fUigSyUqO6bMvBjcwj/f5B5
+5d8oUV2H8SF8EjVXF4YE/K02h/BPQOKEq4vrKMaEHRCYzp37tcykV7SWmnIQZCSIGxL/7m23Osknhs0t
+3l83ovE+JUckDYio8w0mstrnaAZpZtbtDfzmbxD8EE5dgyVTIeNQSU4S9G3bXUMC5eqNN4ZmPzWgYCi+
+t8hK94dffhUlB9vh/vDLuLi7JXz1ZH08wlVy6hd3DC/wu6MGGuaV0sHG9l1a8XaVlxlaArNf2fs+W7Lr
+CNfhXNQ/Y57I5kof202feecEdy7q1upDL/lgYdGSfT/UbRuAL/x/HE0mSqAxu42iY/7Qi1cjM7wQk5qU
+JMuAwn+uTeFEzjdQk5Ja2S3DQWv6o25KsNK+vMNK0wmDO91DTGGITN9aCBL9zHe8uJjit7q82EIr62rU
+QbrHZMi0uJgXPS2ozBVOEvVG/i4AmbbsnUEy3XDeFSjTULgrWKYpfyJUfVNpPw9ud1P4UyGV2s7oPXwi
+5zcRS2QNmnYTuKXdD/wyP0/03n2PRztayfgpqNo7QJO3dj/DdlSqnRGEhf18MY0VWzwkEkUCj9qfxhAN
+u2DDyCb6/tF4qhHR1tLBEqoWUTXVonS5SHiJNJluqu8Pv/iiqZyECb4//HLrNjrGlQv1/vDLL24nNwEX
+vz/88uuPEqea7NOEd29hqxTIKRzr+8OvPltbifpHa0vTLOz+8Gvsll0Z94dffwYn+Fd3lBXbFZgb3nsh
+KkZwhDQYawBWSJ/8l7ikQkWXDBU1DRCuJvlk9R8+sF24E8PDJPxrEyyG60BcwrOxX7qXlq2iNngyFQrw
+MLxf33F4V2Fp3wimnToHgoMAc70rtIWPua/hx1yR4U9gwYMpVOkJ6qvcPJSyzidL7QCHrmxWPWb1L2kH
+icjh9dEO27JATWStqZHhjfGrv5+aplSYEaoc4v9vGHM21017/XSXTpNm7p3mN70Y+RUad70klKpAUNfj
+gltDMA+IYGWzrboNMg/GU17t1t0nPYIe2+xEGitj5nYd0DTOoJ1Ng/IQXE/0HPrtlSUQ0JPmJizohql6
+cxMMZK1PJoViL/3lwGqMjFsUkAtU58aPe8rV1NAw+LbsgMD7rGcmuhWji571KR8X+fjCmOr9YNyxAU5s
+UdcwFWcLQvmgxhRKsjOhrX2fy+qKV5nOymTFZrxc8MKQmPEyny/olW/AjiX7ZaFqdr4QSrHOXM47AwtY
+PF5Uu66TO+EOgEzehwBQK9gTIZUPH5q74eiVg361kahMLvIwXCih2EjfDq25HozvyGFpR2I9opQ1zCxD
++KowrbEdvL2DqhWb7iCc9zTU1g/7z49e7+sNBHM3kRUuAB+uOVhgHvDvPZ/teNi/E9bVfeZZtn8pyvpF
+1EL4ryVLt+Oq7/SDNa/RGZxZR2xWa0jWNR9PkWq3I8tWerF9CIv5qLNPpcAstD2bxpbBg34zecUEsR3v
+R9PBpmjYSxRo9GyK6MYFscIcOc2igSk9SU4PNfamGUrk+gST5FH9uEkK8LrNLMG692bFwbCwXxM8IHXS
+bW94jQ32LtuxYDxmSSAnb+Kl3KLK1/uvXuw+20/XasjF343RIXvUKEKhzNkjD0So51o8D+yU556Z8g0D
+c/SKWmikAHTh8Ajf1vTTOAWJsczEm9cHXcOgfkbgjg7y0PQsoMujwbfI645ipaxNcYyjDmukZnxcSaWc
+3+hj9jyvxES+J0CReSUeU/Usr++lJ70Sk4GaF3nd7fyPTu906y0+OHe8fteSYKS9HptPcV873/oc/7uO
+fuBMBsjxc9oAOeGdcKWtCciy3/xfIcveKLq2y5/hje33EUHDOldKobJ8JedklyYug4dq/DnQxmo7O2xR
+UvDWLPFGjQIfgr4w8b6ueCnkQsHOpuJISrG8ZD+Ls7/nNbrPBSOZlm9ukG0CtB5PpGloG38HqeY3lEjM
+MHb63mzdURpJ0vp/XhJZY1qSYshHzUwkgtxtZu4kfugGmI0D6cQBfvU8Rtl1n3U6ARZn8vLduLskL+63
+FVeiJuosa7VyZWO0oBMLI75A8WkkiVsLS3c+zkNud8OJHma+46H+9ZM7Huor34FaQqTdfJavOiISOOMD
+wnoygQk4RjK4NYIcyyRJfA8b2ORfRODk+lhqPmH9eovN6gGV30X8b5PpU5L5jZthtUxz+yUcjczqJdyA
+aU8u4ZSmHFbu1h1XbhQxO/A7NsaTfT9UvQk4f88mB6hvXsbW2PLawABEOsZ1HBauvFDyHYrxb5vokOnD
+EOFQg/3ViMBf8+pc1M7cNQrTRBGZMNHFaHr0qIch5SuWCYp7ao1RMTyT93ng4syznZbvHz7og8xP94PP
+GxkEh7SDcWg7LC+97D2/qIljb4tpU5ig70vbc6/khVj6v2mArVLUjqg3fzhwtdR2luT6/8oMpbbZ08m9
+5uB7hPzo/V4Z6nNAdxWVsAnbpuleDgjGz6573d4dDHjcvQFXLK3QQ5nlk2XDViYRPGKGOZshMDqRrF8E
+jBzRD+B9hY1lWfOcsJvz8rwgq7sBe6PEZFGwHG0SgbYohFI+xoKOJ0zgC4D6JqoQlhIIGb6NFolYKwUr
+uiF+jBemv2Fk0I3tOO3vMGuoq0mG5w8LGFGXXGro0cAeDI14+WHZRMR8x75XBMxPOs/6IcRNA5KhpA3/
+b6mgcTx4B0Q0rGTzCIol1/tHrHP/u45XeRh1OaSAcZf/LQMth+10LAGFdLbT3Hbb7UX0GX/LUnM5T5do
+HsWNQQ2swxqGX18//fPw/fPw/fPwvevh+zuoRVtO4FyxsSwvRZmLssYlWmuQT15mDsVeUyVzV+9UjEzn
+2s9EP2PXonCvOhT9EsGROLV9NX95YH2YI9b+2oR3hOtobhC47bq99MHq1x8dqyaSlj7vzoXvxNs85Rqa
+nODwTLWp5SBNN99WFylxdoLh0l/bm+ErV9rqb1GR2xfyUE3uVOXYkLR+vKEjD5GH0oFlw1luCTAbZjrN
+33p+v77ePDkcDbVxy5DcrF6O12RDydzSgpSataURa2lkE3sjatgq3exK7azDbEv0A7rb0u6GRiTc4Ykg
+FzfsYpdlPb1ly8CjcNU61k0VjH83Suy58KakCTRwpwgjupQJ/Hyf5mmiAvaYbb21t4T1x8FXmabnTs7b
+pi5AoApnzceF/0QTdvSqvZGf7pJz0xD/Fhcg/5hZccvxs9k7TkNsj3LdWgO80rvj68/uKOT7trOrLZid
+lDLNz6ePC3EpCiefjIWJ2IGiCxUGXq4lWkFgNkCgWqAqQaND5hTwW01ljejphgx4T/F6gZoC54JoYN8W
+JuLeqQuCyd72Lb6e9S18gzhyaXMsVks24yU/pw3/5vWLPgVeQQ/KTK6o1veG+9Hzi35IYCBoGqthPr7V
+6Zvf9Z130JnMEOP2oeHPD5lrbajN5hjta7xQtZyx++NFpST4MFZyPjTFkg1Mw0jeoeGM6tz5lf69vqEj
+sZNYKa6KpV4QmZ51E32aouocTHDYDdorBT1assmiQlB447w+kZUHdV0xtRhPgQjXESQ2CT0evTAJCd+M
+WhTtuY/KAz0+1DCgk5eqFjwbNL1SK7YTZrdxhHXPKzf8q4Z3c5MNBgN/sDxnOGvubWewsHMW4nq2huR1
+l2TNy0w5thOB8rly1hbyemMj6nIQdpkIGujZyoOdLSxAoPnL8+d0A+NtzcjRswUaxg6I8YuI7fyB731+
+B75nCrBGZFocts2H91jNL0RJntczmYmqzP9VbVCkgKZW6dDk8P46K+TZ5oyrWlSbLw6e7b883r9z+YlA
+Rvg4E7UY12rTnH6/KCKp7XVZLRm/lHlGlxpWCvAauRS0c8izULFXU1nCDCbaATGS6k3fR9soyb74+ssN
+AzgGS2TBYeGQX4qsBgslqt1zDTAIq7O74IO8zMT7o0m3s1tmFbTq6aBDwfcfY7ikVJbPB09sHjTz9zMd
+yrO8EOyYT3iVO1JRrmfTSs5Eh8yym8nBMNhcrXH/3UoNn8aBbMe+4KNeJsyQXtLRgsOFDav4i49ZxSeS
+VD3dS46Xgr/ADFzywiH9/7rxl78k1W2Br7IRgs+EAUAmqRfD11fOEAmEgb9cb2z8RY+MV3u612EtQXAh
+o45SclGN8cb6FwOFvk1/Xoil0n/Wku243lJR4CZ/sRdRqG1rmyn2rYuCY2+gCjV4G3/5C1BnOy7HqXq7
+vfGXv0BNrrXwq6urggI9rOkvKUUhZI30hBt/+ctfankKKaf5W5D/gYb9DdVdB4NYy218eNULYi1n3SbH
+9Lwj87NNlNqIdcLP+8OvvwLaa7kRbW6yF5JnjBKUeWcBSRnO5qAuZT4bjdcrXqnIH24On7BBQHpPjAuK
++lOLquSFMmo5/dNEpWh28Vcd3lvXOHRt6mvzv0qJIbXADOh9Xf394dfo2GVL3x9+jc/TX995RBBULOzp
+Aj7dqqfY8EwU+SxH1L3OA31XyQiY7wv6hfggLyDTkD19YrtLsJv6+9aTJ0+w2xu2hgH2/Se49qhAh6/q
+qm+Eq54JlA6Nk2e/eBG+yT1G86+60jaxutzANtuSGoRtQg57UOIzzpJ979gIG7aUMHfrWL2UF3iNrupQ
+qZQXWqcUhxatapMf1PNholQ6zZ0Mb3fcofF9lAZJw1T+R9oRygMsl0pTiWHE5dkvpwS0SfZKXaDXA97Q
+6TRDtbEUfLhmiGyHNQjp2BBP+tC5Xm+7UQ5PhNZy0OxHbKsXwndTlzRHdI9VEVbWhVj24s7CfyZsQ9iW
+62YF9+TZL+sShVG8EEsYtktexKT9X4nxiwicvoUXlzGvu+Zjz3yg0yxN/HrD64fzj9xObrwj7TgZwKfw
+vOxD85v7D4cDMySiyei6bL+v3Z6tpIRKqKSa5pPaeu00tzRUQgXg1nD6tuNXQln9OCr0BeZID06yi8lu
+eZqhxoRAu8aF4OVraju06PTJW92oDkFry/oU/i9UpkGGtx32PabrBbzVZ1FG2LLwKdz6uH9p8ytxUNZd
+24Q+23rSS2SmEwa3KXtEn8IIQPdy9ZK/7GIKSK0bYbAOWaOo6roa5QgquTEj+26HPUmnfOuw29xZYVdW
++/yaXYFUYF+sP79r8i2gbrv1cTWsue3+TsKc23T4ytm+5YDdNHZasMswWqVglTgX79l4uigv1IZ/QAp8
+Tdz8r+7pf/3j9B9v3z7sbboD1AAkbXb/cWrS//G2t3nuvPP+Jmpt+AKkHGklzmdEm1IG4r0YI3v0PfvK
+Ts0o8GY9FTNWSyYvRQWPwsL4blv+bWwmcqHcCNzE5HUzTrfe9m4eqOOaq6nXG6ghr5l4n6vaGzUtcZu1
+CM3wqvFqgYz04uClx5W+kHKOVgWL86lFb2Z8buJ/66CNuDXYoqxzdIKd5jXJWa5ZJNrjz6tpXgjW7bpJ
+QMJuDugainekBw9QHnGSkY4tYHvx6FEeMY71B92ajm7+4/TDP95uQuyWTq9xQq4YqMYWIm9htMbqoEJW
+zDiwgKpPjrY8y9jVlNdoA5YrVohJvRFPVHqWgH0/gt+aOevMA80iHwH7DudP7+c0XwDCDbaQvh/cIN5i
+y+uKjpAO+/DBNt581fAGwfunnNjkjncN9udWt//X66BXDnfT/OWFo2uI0GzH1JZIgrrp4gLNZgax/bU4
+338/b4rjPfZ9gsrQG2D7NW7NvJ6mWjLXvhwdQjDvsO+jxJD43LxnN4+kJnU/LV2Fl8Ovx30OK4vvIHGF
+iTtKotIo1zBcnl6SJ2nVYjY/OvvFVanqaPa+Z8m7WbRgh1BwOyHA2b1bi4rXAvk8MjbkprzMmBK1josL
+6h7i/g2m66s5dJt72+33rlDH0X7totuJ1nPEYRevaGASRzUdz7odKImnJAAaBFr6M1GdC5Db+5pwkp9Q
+XsCP4WOU8nvbnlZgQZDg3zwBJcA3/6ZKAFziryoxyd//TZQw57LyogwwdgZ6W1H76PjdOebvs0is8UaG
+cgArPn3rXz+d5UdeZvlY3J2sPgKI2ydrqMRc8PouFTSPtEgIVJ6qypHH5WLqOKfRFLtugINzwrDYHxaT
+iahw8TTvSXArck/IjdsO0IGMnh3kXhQYzadzcHx0I6kdT60b0uh0gi3gPROZct4JEh9wNothgyuynEkJ
+wnzyBDwVpXbGsqDcelbx3N+BVZHIAqP7tnGrvTRaK41Rmu5UeCQ3L8sLoRqU5dkvf28yQrmaCepC6/NB
+XSBghdCDBqxRQ5CzPac/mjdvu8Ct7iK5ooMdlV7zvbWVT3doUhs/WLMpITc3c3mT6IfbPBb9VspiZNa7
+rhzmFlxwmPtyVqPkdvLak1rTcKHo0Kkd7MJ488crPdr+UBOeHc9lNeO1u2IFIhUlsryMhar4xPEXqF8y
+0jn4lUXMq6MPFHwt82ZnNVVdiH3PXHkyBBO87qxQL4XkbOHGGCXWYSCjJEfj1KP+dvvfga3oRQX/rLcz
+UeJasQnDrYd0f5F52XVXjDZR6psndxKlbvUwpOnQ7Jg3wfD+Z58UW7SgybmgUjdNhbdjqcBp/pb2bcv1
+0KrY3to6gtlbrdsynUWZN+hk8/3UqbTct4hRUKE2WYGKBVwo7np4fBG5xglGn7Uygpqy5kFDJU+p0NtV
+IUPX6hCltnZIJ++wU/rLvgk0Gr26tuSg+Irae2EOTT5YJrYtyeXtnrwjFpa40gWNtyv9glb6ReNGdwHP
+2LjOL1bc6C6iGx2ex25BA1+Jw9LquYSk9ALxHnPCSb55geiSwe7oeml9InqDUGGmM9hpGhwo0ifZuaqr
+ZXNbZaIh1cJ7qVPcPUKtHetYDsvGvB5PWVckowkaeI2wZfou25R3KjEJ9VyfQKRAFhQM2YQuuhPlO3bA
+WiikvFjMdaJ9JXUnH8W2xTz3Gs+jujooekqZ3jbqJU5CBDfWE6ShXXq8ROZLXKuO4pt4f3N4W5h/8zlU
+t8T25DR/u+KRMR4dW36d/R+JHJ9QleO9n0Zr0hMucDFur3WoGR1mvKIjm6yGqtzcuslzBAvQyyGtc0ZU
+33Ya1dF9PlWdE5G9fbHqIrxaGRz4Fvl9uXevq19TjYOaf05ESa7Fa+ShLeCbEn2ztV4kNIpMI+fLKj+f
+1uzpk63PHj99svVFnz3nY3Em5UUf3HXRenW3KBhmU6wS6NxtrWYR5EOLEISvphg8AZRKZGj7QbrKH473
+Hqt6WQiTyCZyURoXbyCkjSbZJC+sZzC+pRJktKx0fFdXXV0JMWC76PeDLsO8YOcVLxEtRE7YnNeirE3D
+x7xkZ2G17NXuyf7Lk+OgTsVnwtVpuvm/5pW8zDOhDnEk2e6ils/leKEOIcwo5oC1goGdlHZPfCzLYknu
+eQlD/AmUfkmHjlEk3h9s2s/3je9iWJVWHVqE7L08OwwjVwaRjFwoyAE3dNy+t5V1jVPY3tEh/u6FwcdS
+/g1hu+zFwHVg+HTrKSzIpx+3IP9cj2usxx/ERFbioJwvaoS6elUszu+2LrE4utLyMjAyvD/YDNPua+s/
+qrCSc47WwakyXqotlYhqEpVM5DCln2vjchS9KGxKHAvh/mCzLZehcrws66mo87GXAdsbkmnN1qDjJqCF
+gstg9/eFWB5Nwuz4yWbYf7n37u/7//nsaG//GISab/ps67M+e/pVn3329O02Girws752wuizfTXus+M5
+Hwtq3Mnu6xNDgO2wp0+/0YQpzE6i790NlpwfFx+IzqZOXNYzjN4wzTeuI4fE68j9AHjTjTWwjl8WaZsP
+xMMi0o1YPPCdIE8Aa/TsIq+ZhFNTMc4uRbVkC0I0GdXifY1TMyIQRe3URFsUsUhqCURoHxZLVol5JRTk
+HJ25rTcij6aDfaKY+xRzct7mCuhQvXCNd+jdhHA68GbmxLTq1vMCJeMJoaR7wZjRp1y9qoSqZbe3odE6
+Dkp2sP/Noz60joC8x2OhFKslG7spp54pwvyup4JlvOZo817kIgM6Gjm8RL8Ev6goMyqNWOFnguXlWFYw
+uAP2v/mclwJGIxPyvOLzaT4GYgqWNHhieZE0Wfcfi8+ePHnSY7wS2jt+LKtMZEzTK5Y0pAslEuxgD1q8
+/sB28VDs3mvZOV48KD284e/v2NeNb9/usK2t3gZjevDpHDyai4pj2lOWE2iKYnZW3aTS2FtogEle4eEF
+q4/h8tNzNGCvRbFkEhV7sHSV7/606cduMGvBCyYKbdnRNQ7w57ZzlOhu+JIy5fWvnw8eNDIMLkWlml5M
+Jqs11Qsyd3tosEeDsoHx8K61mfqr3Wf7P+y+fqc53GdPt6PvP+6+RiAVvD+AZf6zKa+egaATlDUsq5bz
+F+JSFBh9k+1EZ+IgSKYNgznoNb6eiryi1afmkkyRLGhNyWdC0YLEiTE1wFh7jMQ8986nXInstTjPVV2h
+qxXEB/Veg88WZ2eFyIZ0inR/leUPPhVgttfWuX/M5/Wiasv9jFJNoQ3v7TYTc1FmohznUPepJhcMAwyK
+vx/KrN+W7e8CNM9KtWawfK81xyuuaoGJbzd0K8dB5R8xgM8iQjeOYVjg44fxh2JR9T9+jPfkVXn3Ofi7
+WL6Zt6YeyoUSUEHrHBzXvKo/zSxoUreZByzy+80EVvfvOxdv0OP/00yGoXWb2aAyv990UH3/RvOBF2gQ
+08Fyh11NBfo5X4nOpSDEPYokmzHujmepr38g9MBXOjSmXKFk/3eTzWGnB/E6bSXcCF+WsBVHuVKLGbmR
+ngnGYcXMeJkN7DU6V+ZSklkMfgOrD5KGYiNDVUu55HoKikRNDFFfu+NF3WdjOV/2mRKFGNePeVH0majH
+gx4WZPUUrXhLCR4NFR/XZIKal0pUtWgKKab/z6iaLnVy30GrB8KJnzwY11Xxd4FARf5nXtSJrzNR87+L
+pX3XgagDuvyDB0yXyRWDYbrkBV4dJNst6r9ViH9gxX5vdOFpKNmiBw8SLepZUcfMMIZOL3ht5epazhnB
+M1gE+FrS3yh2Kac1ACtG9is9D18HaxfTjVUryW7XjeitscQLBbs+FRp8dZXjO0czBViFEjcz02EIzeFk
+pUF8xmyvRxWO8nVo7pfZmhQ1J1yHKGWNAwntSaGYXFQOEflMqPoxxTuayUwUrJ7m5QVDJRNNJ3jU5pNc
+EDoyUPGqAe7AzsT5ovx+3QnX6Xq+/eUXrAdtAHYdb8O0gqWqg5nvs9W708+LN4I2jm02ob9JLsTyGd7+
+d3ZCTYfdNq2jDUNsR5jX/jDrIFmizJic+EP8Bw7sfpndNKy333d4lJk1vLnJND+lFybivoirUQhesYPD
+fbpUDsJF3/U1VPYpMDFL1tV/e3WTYLK9Ru2/n4txjdWb6ZYlE3w8hd+ZvCoHOg7auagZL5dMwtnnCKAi
++Eqw2ULpKGnifV6LjAleFTnCkwT9SS2we/ECu6kTKEMMV+SxgsKqTCDz+EOh75la2TGXSuVnhbAhsMe8
+HIsC4VAODvejfjmziozCew5bsPF8LvU3Kc8LwfA+xk6kLBQzuueA96Du5zLnjLNniMKCTUWMX2gc7qcR
+ZBq52/BcYrg3B/U7ykTN82Jkwj2Sw0qOUgRILJc8LxD0UktHtFspc99o0KBQKes+lsRibF5w0oY3tFd4
+SBstVymBHZwTIg0vWC0pzsq4jk/Qm/b195oxNE5QUDhBfFJvjJrCC1knwkgg7IZbjPRxO8SEMTlDpQvr
+wGBrBFHI0IihAB8HkClCviAN7bUnsfrwaLAL/VG07NTMQj6BLaj1mFTI42JWA2zW1ypOyl6jMo9wWUah
+FmY08EvvHR1i8gkad3hU8DdqZV34O/t4Rg86pczEYGVTkMjBHjvYg+NgFH4PG5JYGOwl/m0CG9C6G4TL
+xRTbbX+TiGRgvTDjXHCkBqeE/5uMxxpfDvbgm7+U3TK0As22/mDmew8Xjl6JaX2oWXGWBsW8v1mIxAXp
+DEnvNZeR/8Z5JzGkZ6+7futulDOvw5bd4aBODcpKUfTaDPI9my3aygGiE+Rs13V7kUNPvE3t1cpyxVQt
+K5Hp50JeFARKhqcnAcYYGsbntBaldp30KRk8ADXwXN2bHOHBA38wdlbOgpu2JGtpe/CDp+1XUhYi64ZL
+31pm2Sldpyn7ZRYGOGpfoPCfv2XYTqLlA30wdL1ARO713d+JbGcFg3CdDFdYPxSzvT0f7nqUmPUS8tvs
+h5stgU/RYW9siTV/NiUIQBOvoKjAMM21fM8sv1yxGdjDCRK2rVQgJ7pVLO7hQVmLasLHJmQpnYk0qiFf
+iuJB0VkEJ66egzVOYmfG5pW813DGCRrhcm6HExi/gg/4eLyYofBzciVfgUpuL1dzGg4dIs57Y8EP/m3m
+05yadzysTKXAQejv6Lmj1oFng7dRJhKn2LmoqUL/IWLKK/XprzorlQFrr4d1xH3GcJbov4cojtKgNt6Z
+SZK3Ym0fL6jnkhd4A5Vsxi+EI7RQgmxOxGzAfpRX4lJUfRdeg1u5FRo5dOrDM7IyGHhNKvV7ODrsQEtg
+2vStgMmScVey0WhHhm4bytfZ4ZUxN4Gg85o95IWSDymUNG10vbQcmVri7mXIPWrJ8lrZtthmGDBHGAHC
+d9RRqB2dOTfBjOGn+45CLOTm9sbjAfPB5XBwjrccRMWbb44BUi5fzAwaHonK3+fZzmdffLH15LNUFQaK
+T9851ELQfUQ76vqqV66Aq5TSH1lHyalnzapwXdr08L2vpvl4Gl0P8Nu2H3gPM+HVNXjnbJriugjZnilq
+Qtvsm+brosEzqza4TW8T+7Dn3Wg1s8IrhllGyuiks4z005BKYPfOzhe4RHw9MjKpgzvAmLHearZ19LX+
+W8ecMXYORSV4tjQKeUcqrxmvw9kklStIRmdw/8pnM5HlvBbFcsAMwCGhEzoymRSq7NTsHNjBQgExWH/K
+mIIYTNSzgo8v4MaCJiGeoEG93tmJHrcfPGhM1rqTrNOQsh68SD2wuQmh20mpYliZp13u6yBtcHUebCQr
+9BUKQCoV482oFUgREDOdvrnwGPs8PgeBoYIBN4dQLXHLwRNEwuBqHSX473p0WoGJPmK71z82jah724OT
+tsWVwJNHi6LF0sjt5TnrHhzu93BZL/A3dw2tJcyVkn2iU1dLT0VCWxjJiMzfyziYQeQ/rd3ZuEl4htTE
+M1taSRye786K+w53NCzZCKeA7GYd4b31FlKJQnAlUj3eXnWt8fdtuF3dXSF1F7y9kISGFcOAg3I2h49G
+1zYGVGjGJzU+Lhpm2NfhfaS2BEM1sSODrR2wV46QYmqKsNulrFkhOHI9by3rXC385E5y2K5icsIuwW7S
+vGKC8Rm8ZEZHNP0Db6hl8CTpaGFU8TOh5R2RDdgum4grrZDNi7yGp+yEoPCYjfBQHjHY6E9GA7ZbwcAR
+5M2+GjP004Sn0ZtK06VJKNhu9OSaCRK7dJtbpAgGtm5D1sFXSvaIZSOWl+yVLHI1RQNGG60NA8nl/m4G
+JuUTQn5Flc/O8pIwpoF3tL3k2ouhT8UYxFHrnz/Xr8vBaQvcETsF3WSjrSdPRoyXyyu+DHr2UrJRYHeJ
+M4Wr9iMG1D7fBv3MlV/zmQBuuVCwGGB8R89m2aNnowE0KTkUfT1QPpGb277pSQMN2Q/kgHurX8cTYkHK
+Lq5BOlJNrL0lV167Vhhlfo+U2TAh4LW/YKTkjX19PPGkmTYsq+C87bMzDgtAlkzknsQDpHypRAbvtnQ3
++bQixv+FOu1QGmtqs2M3hk+tzXZCrtNah1bW9njU5/1dFBQN9ZNH7A5imz3O6Tz2OSvpD4jzOLZSJk5S
+fdwSFfA7ySs0q7EApA7xISVJJBWQ3my2qR7VwNtbt9VDhqo1I/B8alXas0rwWjBeslFgD2tdASSpKTe0
+zmC4uXl1dTW4+mwgq/PNk9eb4Km0+fPe472jw8fYs8efPcZGKvBf+mxr68kXm/9D0Adk71a0cR5LVNUc
+vXZYrhwP8sznE4qYh+58Z3mpb5t9DZnfJytyOmYO9gd+TXTAohSHjE2WRnQaYfZRbNY6ooOQZhOSqSGm
+E+GVJVeoiupkWhqpWT6bFwKOeHrr5eXS3gFtnBi89wXnn7VrN1dDKLcwUWgniMaBAseZXNSIXQi3YByW
+cb3Ah4szIUrSI2BsmrridGkh5ZgYw6gsVD8YXC0KnAkVDbFdEHkmSgiR1FBbUBMuRdQAszcp7IiuXjv/
+G1Zt14JbBaA6Y6RXpWd+OK3ELK9RXTfyXiRG1hejni5oBAAclCQSaLGa4n0v9XCLAhVQPpP1NJpJPTWJ
+qpxlGcVdT3ugmfDrjiEMvb8xhrmW0T/u1GSf9txkH3Vysrudncw/PR9eoyehYW0wYaD4DB9TlCmlhGC/
+esP+4+JsoI9U/KqubTiP4LPnNdowxUpx61tw8PAwMTa9K16tm/f8fvOr14r2ljRbA//1+mEbUrLGb94G
+/PftdrtXbXobWe/ayANzuPXk837KyXK49eSbfpsP5XBr60l/lXvkcGtrq7/S8XG49fUX/RbHxuHW11g3
+eTEOn372BXgBf/anW/pv7wb87PjYYAknHXzN+B4fe5jQ+ikBXPzmNSM4QEXXXm1mlpdsUeY16k/uz9/f
+d1w/V2/KvC6EUi+xnHFlku+fF+L9EJ8L+u7D3yq5mLuvY1ksZuUz8l83HydBwQmVugq/vMLFeCnCr8fT
+Ki8vwm8vdVAk76ss658FjL77VuSleFbw2Tz89GOUTc75OK+X3ocqE5X/cz7lpXIfrnIIPuR+/+sAjCO9
+31LOzC8SzY9/+tvjSpBRnJsiaHZeFEdx/aqu5IXY42oqJxMl6jilpcDPeYaRYKsFxUxpeVTWWH6Xosxk
+9ZjCtOVj/bnPxPlQvyKmCoMChbZWyWeCctcVL/UVf1HpyGHemWeKumK6LhOaaEQ/R309NKC35jNRPANp
+GasAetSmk1Rd3o2TSP1dLJsYpDG0KbydgpC3W3ef9Aa1fDOfiwrq7PZ0qlqcUeO7W4HR8THFf/J6pGVE
+UDyOJXSQo7opL11Xz5bIOspzNhfVbFFj45XmHjQZJrMnf5kv4CHeoSHo9FlnpuD/D+W/4J+jzltyRKG4
+gx5+DJuKSvSZrOjaWk8Fu+RlXhQcJMPHeckKKef4Hq3Ywf7X7FySIMsxUH9O8V4E5upbZ5G8ZjmhFisH
+W0zxAelpD6ZRsVrKwYbXmG7MVXqDiaz2+Xhqo+1BxPE5zZYdikQeg/FK7wAh0dPmCkCiGK6gmVcSRBHE
+ubv2HHUPpTLT63FU4zleKlHDfGYSxnuA+YgWhZcAJnuw/zUQMi+DRX4hdH56mYSFr6ayquFN0q9ESf1k
+FFRt/AJgks4qwS8Uk2Xf8PhKy76ZnvArYfyAdWPRqlpOzPGXl1l+mWcLXnh1DNixEOYufLY4V4Nf/rkQ
+1RKfz+scwIk3t55+9vUX2pVIsM8fE3RYZ1zI8UXHby/2d8ar87zsszkt/D47Q876+AoYFVNCzFgtgRhq
+2py5M9wH55U8K8RMDdizRZXLhSqWfeykPtmvZHWBa8wvBbSMtcS8klir20t2uM1huv9+DtyE4ETxgOPj
+i/MKDm3jN+e+HMz4uXfe+EmvtBCVTn2t4ZFTac9kIfVJYzz8zvTpo+vHXx5n73ufj2Ekmp9bif4g61rO
+QtL0raUCSmyphhJbK3shJnVYFXxpqQiSWqqBpNZKXtNJ7teCn1qqwbSWejCttaITOQ+rOZHzlkpO5Lyl
+ihM5T1QAkouhDX83CsPHnyhQcPw5lng0hfxfAYGU0EM5n/NZXixde1BugL3iiZx6b8TMc9j4AmRX7LHh
+qsT0tclrhUPCWitWaNcHI5djof68h3zSewjqIK0Ec1s0onB1eRA5XsLHIwppzJWZKPJ/CdxV4BIdlm0k
+m2ozXp6LSi4UpvykITK9kokMpux0OZ+KktdtlTbTTcmZmElsDUidR2URDU8j2ZS74lVJ0a283PqjHQq9
+EfxGNQg6MUuZbIH03Gy6l1HLUHjWwsfnheT1LmLMSLhDdsZK4bfOOlBBzrBl/2sGy8nGXdW4Najo0xRZ
+V9W8zHiV9RhXXvX6LaQBIrRPimsS3gaWDNigWDjAno1TmeqM+9qxj5NQ1X2QPBY4iPfRSE+P+0CUl4OX
+R3v77/Zf/mQ715kpvNOA2rvDcmUAbhz8Dhlo2YuANrDA8K7zvOawejP9lnPGs5/wJiEyOz+veF2LqqSg
+Vt8Pr/AO8WEm//VB9k53H/9/bze39SUVO2Tw4akaEF3Hsqx5jsajYpaPZSFLV51b/D/n9fTYZPAq3f6H
+evg/qQ6zUr3WKYvkHCXaSJc6WIpJ/tEswMxfx3bVlnbBalPJqLo4NBPlf/Cg0a5TSPHAbhMRstJFAmvK
+9RYD+95sYKOtRJ89o3LsvCn1yheZniRrVf9XNWB7eYaR12eCl+yv6vuOKYhXdP13g9FR17VNFME30Jtk
+MNw/JFbU/1PjrdUk9hp/1/GHv1eoGzBZh0C8cVJaNl08LwY4OT09tMHi+dElwgmirKeYtvYUBYV+g0k6
+DiciZFv/SPOtAeuwR4bASbVk9/+qhuyv6r6FzUrOHdK2CNA3cj2M6dY2h63vYlBhmPLwmmoOYrbDmPzE
+i5zasMaU48qy4W4fd3rsuwC2uYWp0k5t+FGtOGEGtVA1FQupp3hIO/nVY0uVUCfDWlrKBcPiue/4+jwn
+zKLqJRMcvRBQRxMq1Z0yISkLm3dRO8nHosrxhFaMsxmfz4EuRhOLVEtQ70IJ9CIoi7wUlIWMDTUxxr5j
+YzRrOOTVxWL+XJIGQHV/vaKraOfpkyfz950+m+rb3pPrnil7n/Jgjm2d/mT7vkf/jZF7zGbCEA7nJXkN
+Sm3VjaEd6KlzXgGQ32wGncoVE1wZr/uHOsimQmcPK7H8eHL44rFQYz5H7Bk0OrC+Je4J3fgEPEw/utLI
+RC+rzjVbb5bkSHkvo0Sl57mSKTNZmReFyQWlt8dfXrKwsInUgB8bwR+teOyyN4MqX3tGycq/cRDRU0vl
+re8IcgtR020Uxz1c2/pepQ2TQIq2aJLZvWbgKm/kHu007hneEEDUoGFnu6Vg4krV0kSgs91pulU63H1H
++MMHZ/TUj3ZnrbRSHDoGUz1bFHU+L8z2I6cpNB9gZDVNWbUpDJnRcr0WOx3WFbN5vdSm/72+Np73negi
++cFYHKOaNrXoweyBrirX2I4btwSsfSVqHKfUui/R/DWx+vV5gp3FH3/IBvgtlrW/fXo37rh1VmGSot0n
+JCQjCCZeD4Ot4jIk7pWrt16DjtcKQ+4nPwDHdSpYtzBKt1DBNlihnUuwH2qbpeU3jV4847eNJZ0fcJm/
+kvZJBNd9jY8DSnqPFSExZ85TSwZSGK8Fy2svk12l7mnj2F+vLQ0145goFUf0D6I6xOOanA+/9HXKA/wm
+nacTLED72aMQCaAM7NsYle/01uj0etqWxFehDb/57EZzkaYGDGSEfouGa/j0yZf9tApr+PQpJjWVVMOn
+n33V9xRSw6eff92/b5p+f/j0q69AsfvFn4rdP0qxq02G/s9CLESr1pZMgZ8VXEVg8F6CVTZyBaBmYT79
+GExJRnWZl5f0thHmtZ/ve8+yu+Z1Dw/2uRKLTD7WgNPUk1qyqSjm7EKIOasR5kZOHP9Q7IrnNXkV0sMj
+K2VNxzj6BRHMLyBDWyxwLdoHLt1GXg/WjbWGVWzkDckIWdtSLqxvlLgUlfUJJczrGj0eMV4DPvPSdZSc
+jUfB9HhG2T1rqPu/xjgrrwUf1xg44jX4u2KK1yqvUZSk47GFJhRBbRowGhv2bqxTlOe8plNkWYv3tUu4
+3tigae6GjbeBX/rRTWm//CdkUIyzseeYiM5Yl/LCzM8Ip2u5WxQjcrrBtJTgZPpz7egdrEvJErHeDrqD
+7Jn+t5ZIlo0M8RFeF01xN7LGPpO658lhpmDf0DYnU3Os4y9ezKbG8EcfmlkNFQpfZH72UuR0Ft28hABN
+A6oYjITuYcZcO9GzqhC8Iikb0wcWhNTMqzZXZ9pWvLn3YAdzt4c1HqIo9RUvY7AXEYsxXAiNSbAzngir
+Qm9FrWPuImS1jrWHLGJKOWFjbZ2X5XvOcNVNGYV7QtHSzhF9c+apncNcEQBLhvYSxP90m/PSUqPp6Ohy
+PTb0ql6nRhfltZ05JFeonxhH7yrYTqO7FN2q2Gb5o0fBDcLkO83fkmhkKvEDcl1vtA0ie2LyRD1zSdfN
+Jf9aKHNrNOtLL+yVSw+Wcyqaz6qxax25qEnBYYMR3JT1Oh/YFmTChJlqawa2sWsck/Apz6M84FkGP/Py
+/ESGXL2XlGP9HLeQX0MhYbi1hUKlL2IMt7ZQnHQiwvDp05RA+eWfxsm/g+w4hWtCGJ7o08YgMr4PiRI2
+7Y+MWoRSF4EBR/X5KY2oQqsCCoWxhHKFv4/to1RQKE41FeXK+UCSBBiXi9NNyRtjF90ykkYyLsYY183H
+wNdrAjdj1mPG3xqoHitpTy7y8UVrKgZca01dHTRjDdz7FbD2xwjcnptBisHtHbbLwT5T03ym7AMIH6Mb
+jF1ZdDg1Ug722tOMxm1VMuzWBrro8f6zk4Ojl0MN6MNGtJicA6V3naFLF+BYOkbVFYWYJTCr4fOglJmn
+wTvef7H/7MSGW+0mshy8fPXmBMFZMbE2WCYdYKadXhDUJpNCeQ35AVe1g/u/hVXMsRBsRP1/pnsPaAai
+rNmZKOTVBmutLOYY3Q6NXwcffk00pO6qOF1eTKRBHAzJ9dhOwoyXC14YB15/JpIouQ2f5Jvckcfe7ouW
+X4sj8l09jq3DNlXJfA2n/qRZFZhhdzL2y0LV7Cwv6bKv1Z5wYcLTHoiRgY9wsYbQGDqv2blE/BUIoYAn
+yQ/kWogN35/ldS2qATvOy7FWhjokqj7iYuOgF0vtF2nNyr2aCJ4K66ulucIxdA8FalqgwlL8TBHiggnw
+RcQHmBP/j/0AY6UfA0sBJXm1ZIQ8UpJXDVWjFpVG6IKBIIK0lCvFqkVp/NnxgRAqL+HOXYlKoBjVpdhZ
+UFNUltc1x5sP+jCMhaplRcSEUYIYw3g5cXHftEc2ein3BuxnbVGOoir5F8uCcMN8ZbTSId7AFN25ruSI
+kPqLWfn4iEytxHUBjdaOFETO4iuVGiHOdFNkJvCUfnSqxCWBreiB0YvIMD0gZgcCZLxqUTqbfsDEO8/r
+6eIM7fknWrLdrGBhGUi8r558DfPpiy2DM7pL6p/damFAO3G2+8xsDH+7R5m6wm3v2J2Vlhx+9d36o2x6
+IeJXrZcKa1Q1r+qf9fp7LiuPxRzsf20D7Nfam5PaEh9hLiR88wgzJRupA1pzxM06stSstN/O8xpNl/P2
+llNL8XktqDb0xDVY32HLMnH7lrGWg521Hut+X85FbRxmw658jCOyG4I14MKwynBwGgSNuaZtNm0bWoTN
+Kfidmh5E8d3cDNZFt+dZUXBWSjknvnq2QJRD1EXmNR02qORRgl1ZQOdZju5onJ0VC3O6KjkTU3k10Bau
+K9fgts60eo+F49Eciwj/++YRAYHb2eDe2MJrP5xOQ0TMQ4gN4+jqPJz969XtxbGD/W/YuOD5jHAZiJY7
+VewRULMJzwvMVFf5+bmoYN5AI60PcFEIeqAQ72uDH0lGOCyvlU9NbbDWDjRFPCz4aSS8b3pxGJYuIkkW
+CEjSY6/JUg5KAEeoRbWp8B8rgozwRBtFb8PnosaHCSVqcyQT0zGntzO/KsWVfz3AWThPatg0D0jfLECk
+dGopo+JmLFTWXfLC25fPuKpRvjAIjzRHcOJmkol/LniR10tG7hCDpkhqbj2dDnsEJ/t2SxZsn/Lbh2Zs
+ve34ehYOPgKqK3r78oNUauGUVo4ZXvJTBUdQPcJAD6QO8OusDGwnTpYlZE3BTKdzBQt2kaspWygnyQKo
+B5Cj0lQ9rF/2v48jmKiYsWD3aYf/lie3mQjKMLg0lhOtl1CtmjwXtWffsifUuMrntaz0QUHU/LD+7tEL
+c3Swpo4FRNJkyfjOkO0GreibQv1g5fdulEbC2e/0NTs0tejjctVWnslLDdqH86ovEwECqMUbfYyrTmRm
+NWGcGIocwsT7XNWqMfMBV/cn/jaCj8c6BcNAtwZoUFb5eV46P9clw2FGJekGM0XCIUwvhFiaWndk7yBR
+sVVqEnaTkqRtKn/E9inGkyyhz5QgCzUeqVNYPjE4TrSVyY84Ys34Kkfl4rh+qYFp3vtj5EPTSNSzwIOZ
+3gFpwRfOhEszTh4VVY0b0wo16bw7O4nRXEO0NlOiaW6wNTUc/uwcTJpD7YQ8RHLr6zYoD1mqo9jBXhM4
+2BO7Y/Cb3150xRqDeBIYhq0qHSR0Nx+IQZ+VkkzAUKP4jbaQ9CWkXDHxnsOt3BC70mfNFUeplUDljV4E
+hWC8JmthipeRpmKdi8CGxsKGlhGs2jeD1tuBG96D/T/qboCR3L/umyNYK70ZL2ZSkVJlEB67Dn2CGxoR
+F6BeVth/eEM02NX6NEc9hr8nDRkUeFgtvWPNNfGbflwNQdQBYWyqL85SbAH45/x8qZVQvlILy2ozHYyE
+rjT/zshZbizLS1HmeBKZkKS5ufYaWnw+J4MESeTyEodL87RKzHhOCGJcERgDDnE9nrJ6KpXwmzWR1RXX
+IPeGU3ps0mOI3pho9FEgj9OnVUi8dDYljp6ZE5+uoZVb6nQQ/+9j3dpKkCTBOPOk7tFAi9woupG5hDK0
+5CKpmcfnVQxgEcHO6fsIjYheYprWv8HtNZAi0ldXP8vvem9ttO1m9tPG3H8/7hM9EjkQ9rVifL6Z37IA
+PGd5vO5Iv0aH+9lILe5gZLmiFWRuqxodJQfeYYiBceBkUWj7PaYkFcHLWrgFrEtYtLC/+eavhlvU+Uz0
+TbBKpgNsLuaMVwI2tVWBD3Bm7b0f+E4LE9bXsklehbxRI7NrW2l7fcaNbxk63UOwHcRl0WlaN6/vgbpj
+KwfsmV6W9JstFCr0DTW4jLOcyI29nNjXAhIXCuth3ARdNLd7bL6hA9A/CE8Fiw3vh8DOCWSeVYiNwrp5
+3SE/CM6KvK4LAZydL+EZF40WDDHvSUCJsSwzR743YEfIWmnYZqKeykyxrhicD/oEdt9zmDOag+dVc2GV
+spqZlw3aIeG6gAjRzfsCiqjtsmSL/Nhy8WjTW5DEYuzHV2m7xvDefMN7KGSJn0MRRUoH6/BpsFqyTNRi
+XFuRopa0bc7ke1wkFc9ySaOv6FWITGIwpAcfTzVkDx9XUpHJoMOKvYLjgCsnDluMWL1ZNjfZoqzzgo3g
+EEBo+4N9fKy46QHXvs5677fuidb0AB544+db7FAn8ZoZabndOP5eSm6ocU0dtw0v7mHQkt2k0uDIz4Ib
+CIoHtAXQbTFQGxEGL84f4BXYR7UUCHHNODJIEtgwYI+Na0vG15o0ijtEqKO07GQwnZQQOlgJdFlYKHan
+6jRNYLwSiBP3WDOAroIH8qaRy6hHuUCE5JXg9IuYgPPujI2a/kS8/W+FeJvahel92I70GmkorAGwv/sX
+5bjv3w/hg7MDTpqehNX1Qhe6pMWGy8LiytlO8CV4F2l1Aovay3ZWv39FXpZ+mNR4b63uXPKt4jadc8Vb
++7Y+iYP97fVHJFWsMR6pk7V1QG6cSEsjAFiAeqKiYUQjo+2OCC7K8adCQtYJgdtjqJ13rVnPkIexFeY8
+9F8dbc40ELPXqrtHFAjELxGstngmonXjeh8l/DZDv9KLMD7A1sOatua0w60nX7rPt4WgDuxeh1tffdNv
+GrcOt74mK+7YfHX49Ok3/bR96vDpZ1tNDOqv/jTz/h3MvItcoFvZuH4tZY2IxxH0W6vhN1hvhSXJxYJE
+rQRhYyKMcmqY1P7C26zl0aMVkOypeh3I4Nd/+qL+QQtt7+jw2TQvskqUHwcyuIfuy6EZO30LDPcPF0Wd
+Y43EsIyNemzGn8xnLeKVqIFboXtgbGgfpt3F7/UAMVLwepwX2UuZiRHj6CoJv1F6n/NKlLVOqk3UlUy8
+H8Xhq3xECVeKvcI/8eLA8lLrzsg/VVT1oI2CbRHDsaHy6WKEQX/NsFmMNjqv45p08JW8yAYrnFQpL1a5
+W3ddN/quQX2qyOo1foA36Hkhyf+XVxVfKs07UKdmYf9GqMjipQMCJErmuYXIVaIAguNiofJLQUakI2oW
+RXvQSBuIlTLyzU4x/gtG4SRKI3iTKDPsDBbqjbxA0AsVty4nzQjq5DWweFEQKfvcRk8BGNtf41ahmRHC
+rUOLYFV4sz8IGq6jW5lxxJ9eXpuiTnFc3hqIldgqKMJVwkcHC6wEfp5jvdGdXU9y95vLtwUkKJbaxAha
+Ada6BPMz1Ht+cFNGvK8vaBu7nTmMdnF4q9fijO+J2lG2BzAL+gzA1cWUqHKBoRWoHtSRaPQW+sLGspzk
+54vKczBHnG7xfi7GNRkxU9ShcHsbnXHK6xmX9bd0Wf/u2tTNXmhH0GTNgxQJ0hZ8d81mOGJIwFChT9r8
+SbX6POvx0APnHdy6VX2Ptu+GS8nbRkl7qGGzUGVC48AO9ugdqsxrjIxObLDKhGsRIyaLGcySCtwrNzdt
+h+wsamx20kWD5QtQZRUawWSDqIVZg+xG06eVvFfNGrAerb4rKyWyHZPrNA9Anuir00u2n0aDw6Of9t/t
+/8fB8cnBy7+5VxdXyVpUXu8jnZdRSHGv40ZI00RhZrT8lMiM9F3mNCPxCb8NyVABvNEGJEDHaTN+jH81
+C1rq3zA74RLu9obsTclJg8kmObyMQBH2V9zoxkiRJDeHNGgonfGzYokokcp6ul9xxRal2fGQvKihKebd
+4oyCkUXENJvv9c2jDcsW1CYJF0U64Ni39ZnMlt+RApaOkRoar/oxvVKgCR+r+bmG7v8W9Lvf9dm38+/6
+TFbsW07/EplSlo+Pf/qbU75G9DAWHPtWXZ5/p6dvwABuMS8VdFSH+NeDByeeMk9qmmJMEE8MXLKgrRz9
+VY0cUqM3gbh8/O9mpdhPoZ+7P++9nrecmnwj/vLhg8atTRY4NRW/ZTsr0jx0hpVEwu1hNwI13Gt2ky/F
+X6Iao2QCfAhGJan7gG1pnFToRGU7ybMXslCGrsfrdYs3N7V5oWmG48P0AGoMTUe1xJ6PCB+5VKj1rt0D
+XdQNx7UsL/6FePEvlhe7Phue/IvPkxtDc/rLW5910ZGAid1mzvSo2cZcUGMuEgfDxcqD4cJOnYkB7rH1
+EBtBiVUs/uDl8f7rk3eHu6///uaVDWiNS9CXq70E1uTf/SA5XA96xQ5o1mnd9lPk9NR6Sb1t7wfGOtle
+u1/BAfhp+hVvyugMensaH4K/Rz9P9v/j5N2zo5dwyfa7GQqwt+qnaacr/ona6okTwxDWbbeoAKbIyFfm
+sIPQRBiXiJ/JSzFoq/lmKLXkZeIWUBRaWzDcerLVX60GGG59+VkKkqJx+R8+/fyzBFLFN3+qm/44dZMX
+W2+1kmnz4S+qyMuaneX1Va50jJU2/dNaCh47pVjnIVcXZJrchzpmXF2EXuqYxh64RBDl9Q/rY+516aD8
+hWxbtEbV3maDS5V97odougAHOMY4xeaeaSKcScbDm6OOSsVM1KVJLhSbyiscfq6UHOd4rIOga4l5loAI
+emmuWHR6OLyawzfHJ+/eHO+/2z05eX3ww5uT/SF78n6r7ye9en30av/1yX9CylNI+XH3+N3xAQj7z5/v
+Pzs5hoTPTcIPR0cv9ndfvvtp98UbJPa1SXn55nD/9cEzl7L1xCS9Ojo+ODn4ab+Z5+kT9iHIevTT/usX
+R7t7+3vNqj5/EiNnwcSgGWOAaXdRyqtCZOdCRz82cMi0PWsM1GZmgdE1nwiihEzc09hqOnhpH0U6V88W
+qpaz3bqu8rNF7WGSaS+s/FKg/M5NDlwAdC3WC1HHCyLvjrw2e9IERA6gnNmlqM54nc8GYFpkjO8yXvPH
+D5msGOyGx7pxtkpFJkFkN5LPPMsRJsrFDJi58A13TYaIDC3dvtf9V25Q9PhZXO5gmUJBqE2WxuGByic3
+19g8pzFZoSpgwA4mbIlmtbYpaIfI8pJitOnRqzUY6rmo2VWV17UoWRoFe+/o0E6ZxkaJ2k/3o2jWHLHU
+0AzYbjhUrJS1B2280LZgDx+CrrECvmBWsGMaRCls6ivPUlwNmcpnecErVstmP8gpUlb++BPUujdfy5XN
+W92WQx1s8ZBMAofeGqAVr7mz2YjMRGc0RoQwmxpqSxte5soph8kGNSpj2Bwh2nrB/JarkM0zObNuMrTF
+CR0A/+SKZehrdiYyTzRCZpnjUvTGnYp7OrcGaV/j5g3ITrMRAy/Zu/bqoyaazFT5Zq4mmWDBtFEJMzWJ
+RDPdRibOpgltOCucuFCDabrLlteswbtGvud6/DWWoi7D2BpVxI/94R0SlpMBN3bz82kAB+/5fcrVsY6s
+BNXF4N6mGT0PgLBlMXYHg0FvyP5TLv7RqQSrqyXhsOrFG7JfX//T+Ufnr+ofHf1QNOWKcX1x0FH4f0Hl
+2QBIYwhWki0wmj8qxwJilN2ooFBIDGrWu62+yscUOnXpqLYRupK6mGYoqLWHL0VOOUJRytddmQFM4jDe
+ZSI8PVZ76VOT3UVm8WyIkNdj9F22Yxs4qOUL873b205UgVY9dAwHdTlybz16tkbYcQ0O0d69SCPN/WJ0
+5wspua5u24LrtDogHDa8hUxQbzDCAa1W27W1ybkBtbQ2WubDY5g+iVSdq8edfW/LNEt5pP0rfttUR/x3
+rbmOOhaSCEanWUFzCdw09ivI+/Cl1/6ugUz61N7xWHKzcr8ueKF9o4Sd6+Qkubuhq6OflEQHzVtTb0W1
+5vcnq9VcyJKVTrk6zjOxP5mIca0+ts74qtdW5Q9gaMhL9Lj4FHUGV7u2Sl/CFSUff7JKg8tnW6UmfP6n
+rjx9BW5rxdGlqArJM5F96pFvu1+7A++TCT0rtmXwqnpvrU3lyUZe/iF7xstSuusHva2dyXrqDowAXw5C
+ld1JdFivO+t1xuE+f8xor1XVirFu4yVtQx1f+VBCU3kmmCASZCuzUOJOo33r7qzVmU8z1PfurccR/QfX
+RpkWnrKyzI2c4NsdttU2YZjX6JW0EuaMyPSZtJS9b1UonpfUYmbUqYuarKbYWM7O8pJTwPZbbKhbD+Pt
+B/FuQ9gL7MGvdfxiMeGLghwNnwEmogmlqh8MvIqYeTEBt//FXKuV9EbRM7BQCCd/IaxGAZSKDzd0WLrw
+poGhmE47edZ5SxlIXbgi60TKM16Z7Fa1omvYLcAb7HxKjwb6LgbXK+SYfZbXbC4qsFhQ7Ixc3vOSnYtS
+VLywin+lkRCHm5u/KMiPQIgXYvmYoGluyARe6Giy5yzVggjpG4wd7Dmx693L3cP9IeugnhNBFvOsE+qA
+n9Ezw9VUoN8qj5SPuWKcmajGkfqI3inYrwQd5JyiwlvekP0aQcUHan+r0kto+p3Gqlgyeg64FJXKZYkx
+STIiV0sMvWVeXJAW2IfRUDWVeS2tbrmC3dD8SOmHzY4UvzpkYT6ZiMpTdRIxysErvbjRSoaeIaAKbeMm
+K200+dCWprArq7sT3N3u0A83ckyWsNSMXS6sh7Edzy7F0kA0VKH8UthxsiUytkHivQ5XMOFj9Ifp3Tgp
+3h3vDp1oqlGxufiOgK7puO8QXgjo2exjEovOBFOiZgoC2CzN27BdqbJiIyXqW81KeKtr9uhnvRODilAu
+8F+rQCIzVlpcMV5qhrnmFo1lsU/RDG0jnFqj0RLh5bKekvKLGyjC0bfmPPmO5SX7VttgfTe6YYVEgk57
+R2RFentrmaaxafgCMVZ8KUwtxlPGlYHzQYYLpCpBb8VKBwXSp7+sCArG+vAb7/QB+1mbP3towy7IjI3i
+iOtQiVq/Y4UgViv6Hopsa86he/o0Fg/IdKD6WjJOqJM6lPGN1fsCwS3XkBGNJBpXUtRZTuVMEqyuT9pc
+X/a5ZXPn+l6bbrdN/o07kLpdr9kRX4bCJk8Kju85V6Io8F84YvS2GGijOH3EUovRsKBYOrAlbPo202GS
+rL88N/7zzVL2xEZ1b1DSFkMkcrBsuHE00oJpczx23RNt44Gj23PCpHcrC5X6ttIVbytDdvr29qLVGKk5
+kcH0mU4rT6RqfS/vBnpdo61M2X+v+0iUtA9HWo0yJdtZl2xkUJ6gFfXE1c38F/8V8XZpPabCJTn8On0l
+iWK243jx8A3GA4vjZfRu3RuwQ6lqS82LVd3pMFlRU7wLH7zG/ywrJdhS+9Y+JLuLbl6yOa/qfLwoeNVn
+I1jtox6M91LHBMFw3STcQqJv5e09454c7R0N2YFieW3uH7Vk5xU/sxjt1oDBk9Fwk6KPI56KuqOaPnKo
+S5ljNAD9UIZ5hXu3slnhrep7X9LZ825/z2XljuggDDAFtIUW+Y/BkKLL4wtqfJE8NUXfurfXWijjpOvg
+D+75lNySWkFQhx/2ajcPvNeOKioLWV4GWb0l67UFmm8wjGnwdILtvtWw+MTwfg2N8UjRt+3mym8WdNsg
+N/rLYVKr2Wq1aHLewlbxpmBYW0+e/Glj+MfbGH6kS2ugc/D9Wl2CdUn950LW7pphWIGOIRIWX5nVOKzq
+6L1hSf0xtGUkuesAQQKRWLdETnPpELH05jEwrPiqZmPsrFK0lcgnHjxg9zS1lkKBrs0WytVL/pKsKHut
+RZPvKJYElWbfsq12Ci1qO0vDoc/iYUVOk7eMfE4+GHyMt3QTVItZFwqyTdXxrZxLxrGoD8pSVD+eHL7w
+s1yIpf+zEhP6CaxsW9cGcy2ywFZIm82Y1Dcl2C+W3hp1540nIUFPXdPj51/K+eCB1zs9cp7+PG5LK5E4
+I5Fyp4UD+bXPueki1lCiaSWhTQ/KhJWEhTAlc0fPvtFdTqwebpupxfm5UGQwZj8bzZutWXlKMrbDuklz
+j1jYDpvrv+eHKWyYINeioYtHvb2O29mJxKYE2rmYMW9En6NW4apPV3iYMxKoTDwjbgZTZGwsq0o/JGog
+tMpGUEBihTw/JyFLshmoIHyfuYqXaiJQiFIncrBxqwcZzSDNHIVTR3zPPEB09PYJZeG/qgHbyzMyQxK8
+ZH9V33ccRtVM9BOkN8z7BdCnQbte4ZmdCV4EjtmB5rap8G66Zrubl8aW0/pTAwl5sLfSANJgk+UZe1MK
+NeZzkRHMdQAmZrId+g7I7opIIh4lAmjTnifv5lkEF+KvyIbinj1inR33nLTyfATSiZtPYiD4WmNQ6vlz
+KQ+vHfSvNxrfJ4ejb2yQDWqwqRN9TUFMLPKsdcxSN4X47LZ2PGuaphlenGQGYdGYOztYrTZpInFV7XTi
+4L8thmLt1lalb6+TT9YRSYK36rsLBHWV7lTahGyjPY9ZwjcsXhrF7RjLbPVZUoZKAh9QnzjOOpOiE8qP
+busthCZzvEeCCnUodblLR1w+NiGgfQ2Ge5ooGfcAGJugjgY7BfLciQdsUoiaG673ffZHbl3Yc7PgwaW5
+6SIzu8auC8s72iyirLvrr48EMt86LAQDYROqfDy63pim6lhpbxONDcG++M9XIzp7zdP7mRjLmUFwHp3S
+57cG9nbzm75PqGsiCcEsz9BFKK+ZXNRz7UCkxR9Wy2NcX93eY6fzttqPQfCcdjN77DOv3lbDysBWfWel
+fWow/dizQ3zAeT8v8nFe00O0VfjBpottAB/7L6DSOO0YanaDoipPQ0kDmAuHQdnWg609PAikGm7/ypcz
+7NJcZcCTOBCYniUYaM/AB9mVP3/+GqFoU7KsK14tWS2jFdOP3h1zrQU1z/YhnZGZ/BE52uIyGnh5wpa5
+2CYm/boN9vLTHRWl86yPCLQur+bCpZ2dWpq//bkRHRN7yEd+p5Ni04YzWutY+H/hPLB2RB/Pr5NL82Ye
++Vvwxdi6q1m6RfvfjXa7BUrvJ2z2U4iqn5jv+Z0I+V6DF/lZPzVLuonr/LYcY+PGR4C7QRd4CuHhN4in
+ulrJO3z6OYIcGIXu8OnnyVeErT9fEf6oVwTU4q4PUiAu80LrdJlanK0GK0jB+Yaa/lQO+9ZAWgSE8npe
+yZnFBPLKJ7OYtwUxm9dL814dlguSTH44K5DAzxWP6gmSbgW2CRmPXu2/fHey+zfUAr3b/49XbIdt/lf3
+29P/Yv/Y/O7to96mhg7dP37z4uTdwcu9/f9A3RHb0baepGx/jMiIHWdvu09w9SQMjAznNSFctIjpwPXM
+Inh5BLgHurBxWvSK2zgZuleMs0qcw3s2yM2VUIrC79fjKZDTkccpOvwFgjQsZnONP4iAA+hE/phsZ2uR
+6ebQizxUDlVUgpUYwUHN+VgophZVBWvctE/OKSZBzc9NCPOKjC5qVgiuaiZL4b/oRwilRrbRdR8nxqap
+E4SVRcoEbW+iFvN5kYssKJW279WxBB7DuJJAFUXXe6kHXKNYBW9YmrxanFFTulsG2HCAi+Bo0u2wTq/n
+8DkMMO2vkakEsC6rMEQIBV4SUCn0CRpHKJI2iwqwIsl4lYhRaYwPAWVtTAg1YLuFkuSzTtYmkD6qhFoU
+NaBzjUyIs4ds5DC7Rt57hWFZ7fiT64NHunbHul6i5ITg764tCRsfn3rUhj/ZgkTmicNN/Mm7O1qsjhNt
+dfwtjdLO0dYjCFLcSmAcg+cI38WhU08rCE/FDmEjo43j6CovM3mFM8hGxvJhhBuWX/K8QOjC80KeRW7R
+HX0ZJibisCToFaTMa1ZrhECEAPQdoTuYc0AtPtF3TLzn4KFdOZPqQWej6VVxQ3Tt3nZgluJ0n5fcDM8P
+S7M5PXORzU32t0ou5joPXHg9pplPYEArjjvIRunqk5x3tmSdh51BqymXWzNpa627ryDm0T7NfVem1Yvm
+UJvba6bTSbqvBKR7gdmLHrsmmzO505ndKeusadj3LtMQxtEUjecqsPlZkRjgFbbnO80dHd1qX5tLb+WG
+w7EdSzNM2FUqP8cl+EwuUFZ4su3Wge1YXjZaEio47sXJjWu0KebKMTaWZZ2XC5F6vHA9C5Z7+4BYl0gT
+hQtA1fKSIa6ausjnJIhMZeGwN5U2qgXGS+cMIuoyOXG08lpfRsx5QHBDrPZyM27HsW9BG+ZzUZJXAMki
+hqC21+WFhgVHIn0TGZKiY1YaXrue8nrgjQrNXIA8izPlfXeTFQ6eP/CoWUjkiWfNIxtMnD9FwSr0JsYr
+6+ZGD8GrhZrq6KP/XNCDtSZGxrnBbaMheIa0nDUrYwc0rZqWlRQxFqYmzb5l4ykH2QcGPa9DYgYIOcvV
+mFfobSdgsru2XXO4pFe5xpRWEu6wVSWrnq9ZvHFA7KANNFZ5CFzYkMlD4EKzwmn9mDbjWs7YGR9fMLmo
+qeWDoGDnf27BQdYcUnyOug9JXivh433WSYMiXseAAIhtioc4Qnqf42HkpB8cQAOKdFVpTxp3RIWrHOjg
+xYntpG9a3Y1VYz34ReZlt9PxMFrC+9bmJtuTsDbQM8SgIGEbv6Wgkt8hNPDAQdKswFf12mtPyEePfomB
+O1w2tuP9UKe/vA01Ti4NNqTDq/K0kMzijzaydRuz27PRvExBN8c77JFHx9fpJehsbyRrj1VJqYJeyY9D
+rmbsnju/VjGscM906A4yZHTkaftiXhpkncdyPF7Mc5Hp0aHNNQhWf+gdu14remHfXaGYH7jxjLklaX/o
+8MGzxKOi1xv5RsVyGkqzEYMTnQoPmQBltF0geLTDtrz23F0zqM97xzC76enZI84L8+NQwlHEGnb6Lcv/
+Jg7l8SnEYNW+ta7XaCgyl3MMlZUh95QTczDnNkh9Ka8oYmsmSuXia6P08JF3qRUi2c5Oc777G41hy8j2
+H0uzWjJxKRB/HEWMiU8idSu5Zf12VX+KHmuaWFNjDTd6um/WBGV112nApMfrMclS9sr8Vw99qpV8ozUr
+x2hli+3Y6Hu9K5b0FsHjX+lXMSOkxMoxxmuW14qNF1Ulylp7gMkS8+clpBE9iz3vxCAdEgWHSCtJ4PJU
+iDhuZvtjnCx0TIMw4o2WXVqiburqD+NpykuGxYwQ7jDx19NrJMOgWAWHaarRSP0xao5EG2/Ud3gaBq36
+uKu+w6NkVR//5vqOO0wPjV1/7ZGPlQYpbcFHNEePlll/g5qfN0HkkFRnWs+Kzp0XDmax3vqTKFpG51ug
+/h3tJ31NUFYLbgLXwiK4UKQRx9eeRVmJIo9XDy+zTZhnuEhofFd2xclVS69gjZyArASSLaiMR6axTtix
+ECy1rLq95Bpae1At76UgfFcmEkv6DmFWUHA36J0+0eK4rTWIgoDDryNX6Qr6Nmtve9ULJ070LZ40V0ac
+TL8uDZ8++aLfeEMaPn2CkSfDl6Lh06dP+ms5Uj39M97k7/DaSS/+XljSowpfN1oeMDEkaPi6h5/8MHqa
+NLoao8IAzKdwl6MkMApjoI76lNNBm2Os8EzUoprlZa5qdEWnTYyPKF75ETy0gIh/Kcoc/az5kvgEV7Kk
+tzagRhHZVd96cDtvzzkfX3AKvV4tDQRSPRUzn5GRs+mUl0BMl9U0YTKweSILhh6/UdfqqVjiaWncrQ1r
+3ECcBF67/l2Z15+8nBQLUY5dvAZqKJ2W9JDzkI1eCzWXsDr9QSF+CHfLyhzBo+N8Ni9EkEvHfcHxIUcN
+IG4cfqWOyqt0NBgzwnmZJEaheCvfkSG9tEAtvMFoIXV/TbWf3Cmue32XrVFhIs8Jn6/OsF/Wonoh+OUN
+hBpBfVMNQiSM1XkozJ+Lad2acbfkxbLOx2p1tkN5lhfimE94lbu41WH2jbfJQyA9FTZYcRDod+vJZ39y
+3t+e8yZX4128VcNI05E1SZBmzDTiaNOJMl6qKWUDSx/KhaJGhwUTGazBBwV1kYu4jPtuqll5wlzyykXr
+1hFkwz4OgmRrxvI8rxRFIQa/qx2vPYM4VVfj4qRrp88Z9gpmbWhdnM5zVdNDDYE36Z0qy0Mvs9vITOMd
+iHKMkRFOjS+932boARY/WtT91RkuyQ6BvaU7vm4jLqp126gz/05t3Lg2o0umICLbpzNmh52ijx425K1Z
+2aktYgw63PwMvb9DRQc4L/JiJlVtNVO1gHpRiyHYGK+wICT0tfWMedcgeFDo0+MCOmUsNaBL8lJU+jJM
+vxf1iJqgD3M4eNWAHZXFEm8lXjZ75gI5fHlDDZoeDpYtwAif10KfvV6A2pm8NIY/SM4G/yBa5pKFhk5y
+USPcE/YG6E/IZilu74AdlMQQx1wJDLy7UMLhN/n9tUOhi65wrfNXAnstxrLKqF2jcK+OBk3tD84kxuG/
+juLyo3qpyFVNVk82PGysUWpvjg7vD16SIEeG3+PGmGARJa9zvQDZS/zbjrU3EM6g5uE1Hijj8WIGml1Y
+aXLClOGNZmJ1KSUE+zUUiAd6MeBX5eCAgs+e8imx/RpbEnuY/nqwZ757PfXN1oPZRN+51o3uPxZ1PXKD
+SqCSW08kxDH2EmFpaK1fL/YY93DAr9MtutfeokXtN2jdUpeicq0w3jEaKY/bvcdkpf+Wi5o9Zjl4PXnu
+K4nmWx3BVV5uNzuDYzMgXVs4zpgStCleuwydrXXoU840EVrCpkVXyDvDgoHptUefoHae5+eLijp4NV2y
+kbwqRbVn9X8oR81Enc+ECoJ4g9eL/7SayXGj5kFAzPc+yOTY9dU0O5PjgbFOz8UVLCH4REqSn7G7rT4A
+ROEqzBTPCSzCPqvl9q3X/cKbGqDSMsiM1dID5Y6ljvW3Sy3NZgmQGsyairqvW2STqRnpZeBCs1Ap7HOw
+7l7qx+u5qGqek6WJXIBKueTnInNMWa2zD6AWDDgMf7DvI6HsYA+bAUo549VK4h8WqWWqQC1Ndpu/EPxS
+sJ2U+EoQCbIQmWGiTpAYOFGq7w1lkltueI+AWJ2O/8w6SAQ/dYJkmljquJ8QTvwOrkfbE1HWorprT1CW
+cifAjf3AysJ+4KdOkOy3032Oe0G9xAzx5WJgD0rhpL29XM3hyVmoLg5Ln+j2zQxg+43SNZIkT59g6A4o
+tp1M34J0pBe+mEX5VulUk3KpvUxHd67h1pPP+6lr1XDryTf98Ao03PoSvUNSN6nh1jeYFt3VP//zrv47
+3NWDKV2lHD3MwcygcX2lz/YKa5ZBLstXU67wimlzdX89W5ydFSKjWxnEipzXi8r8vvY0rXQ9hYd2fsXg
+BZ0XiphpPXVi6pgvYM5JEQmcn6Ec7zR18Y3aa8oGyk0/FAt9j+3TB1KRhZ9AIxV+kTPzZLxfZm1JxzWv
+6rZECv4apZa1eF8finIRfZ8vww+LkOyeXJwVotnMvYqfNz7EDaZv9jrvf32f142P3q3a+wqiZeNjs/97
+lZwHH/ZhLoIvz+V4oYIvqGYMvvxdLPfkVRl/e1UJpeKPb8IKX0gedh/5UIMafj2Ul6L59WhRJz7G/cev
+Ud2vuIpm/LVQIqR2PK5kUYSfDDRxYmkeL85m0SRBsODmkJ3IxXj6jJdjUTS/x0sCPzZ6j1+bc/rzVBia
+G9e9Ns2d3m5uMw7Dn0CswTuGzU8tx1ZQna/81exp+PSzz/FQ+eL38T7Eg6B5euzOMZCCTumznwiXiz0d
+PGFdyHBfJwE/ZQ9t5LhS1jpUZa6IyYv3YzGvWV6iYFrkMLMuhKumAi2xMe3kWc3zEsNXzJfmoVtnZPhW
+Arm1a9PV1dWAY2MHsjrf1Ged2tSH2+Ongye6wJuyEEqZQwGfdPgcVT3wLFbwK4zQel4JcjHKS4xSijBD
+Sk7qK17hqZnlSps7+uNlmperIIMsGS/Z/d1jdnB8n/2we3xw3AciPx+c/Hj05oT9vPv69e7Lk4P9Y3b0
+mj07erl3cHJw9PKYHT1nuy//k/394OVen4kcoX21lxs0E55ichIJ8Endb4LBodKPeWNW8PJ8wc8FOwct
+UqnvDhi5AJ3hygzIFPksrzXSVaNfKw/lF6gPavhr0hF9W5dHs3rfzFVdCT4ziHCwDki/V+jqBmzP2HnX
+/ELr4vh4jApu0/kNjH5Q4xV6wuYFryF2hjt2gw7EkaPpu47kal7iskVldIAkI7A57Pc2S6pAl4b/EjEH
+fUvaYVHZjjFZtmjR7IWC2o3oHn0mBucD1hnDoYqowB2rMexEdAy3uGZghoAG48/MHyYt1qUZHRwhYxs7
+tRGZ/o50wAMHskWd8FRj1Om+a3vfVh5ouDDbgGdZMCU9h3WbztBN0O1brMng/usDNc3wzLCNDM1VdVWU
+69a1eQaoDa9604m65uNpoOVjrJnW7cgSTPNTQ/eRfcvErWpp9qlp0XjTbtEi9J/bxd8uelBuv1/u3bhh
+7mSr3Wqp3dmta2DWdDgWdq5vmGc8AYNoVeCCqS9FFLLGOuss5iDWJNYKSAaVOayhLzFB+8ZSibHILwWB
+nmstf6eJ73F90+YJjqXkbr4TW0LEu9+LKwWVrdjAZkNpA4Ww/usVYqyp/haGbSnrtKbV2Zd/Am/8kUoW
++wq22tyBsr2mt+1lyn4hyBAaPmDSmzovVGtBTLXqGqejPChrGRYK00xFE1nt8/F01yZmYalm+q0gNPRS
+O9Am60zVsiLhOxRTlZU1zZcfeHkRhaizVP65EAthZV0/Vgj3+lFPRV6xzKpo8SFc30+ueF5rszos18gr
+0LiUlvqmM7P4P1izCfprY+f5deieQWWVKAQqzfKaHA/NSzibS1n02YIuW3NRKex1HQNffG8OSqJ5HL7N
+6tBHttXZQK/W/JLXwjWcuuIauVtmr6llPiC3cIIWHIreT8YSZAx/85bhIMpiXe8pD21DvZdMtJUzAUaK
+siJTe9MVmBrv+RnrJyJ6C+6kdhe+LXi5nksy1tN9cQ9mAakHDwLScSfc8dIcgFXl/KfgmwZKqAOyc6OW
+9uOqzAsCijSYZZCrV3bVdL3HaEoFAaWuFsDFBnoRBoNgI0OaFfyYjQ50LLkf0T5yNGSnRgnwNjCUtZEV
+C3mOftp1xeH6yQtU8O4dHQJBxqa5qHg1ni7ZeX4pSpZn1rHclPSEVkQilsWlv+PCFrldZ880hC5GhuZn
+7Do8fMyg3zIjag8eJL4OdF/EyZVEBdUN2dz7CozrXd0isJX0ytWJWlnK2rfFRQvZhRL3QFwLnQCQiAaV
+MUp3bRXM2aLMJzm+eNeimvCxz4KNdTAxE4woWBQ6ntwYQzAvKpoVoLkflIENjQavdO+YCu3+HwZ7dIFB
+R4FpyMhdHroGqzq83vQdhjUywt6QPbwmUoy91stzAEG2St8GSvcMlWuVyPo6TijeKiKQHENMN6xh/kJr
+HkXnvGQoi54JOoBoiELua7pp3zRH9rJjajqak7jSdyMPVUBLTZ1oFT1fnBW5mjLOZtodHP0UnZGcIehF
+trTBK1NXQDWgILrKylKGMNRnqJ2JKN6rLFHROIrt80YYchHvHtnrKE2N/MEImZk/61RT3x5CZr57idHi
+sLCUv1zhNlrlmWBTeeUO3nNRK39W2A9LQ0zbZWiwIz0saB1CMSWBBV14ax089am+yEdAZL6HgHIuAphl
+NhNZzmsEqZiLCjSn7mCHWR2HmjVbvAEBReiVhG1LNZPhuzN7dJdkL7bQr9qbl8ikbMUOdDfwNddl0o1j
+hqahitmGzXPM1jnw8vXXrj1kdjc3I8zv3cHSBN2p2DhJ0gW2P0Yp0HYYRYY8enTACqStNx+pnVivIfZq
+nRqC6/Y5RH9uN4VNf5ybJjEuMUyKcum8Ny8tsz+RC51II3ke8rmOS2sj6mIKoxuzGqxuqPpBx7xdq6k6
+94ZVHiBjgk+GBz7Dc1Wl6bXl9vQQjtOSZNZCqSVzhF1fy0ooNjL8cMR4zUb+New0pvP2NM/ejgYY4S4T
+s7msb7CzzTNtylpPhS/1tagk4/rYSw3jZ1rFuqifHMkSX8lHvYjQ904paYucTIXV+7Ba0lXUsc/5wqpq
+gigh/UZr+pbmx3tP37PN+/ABNa9+J8GsrWOa4jxirYv/X5XLS9Ib907SvFS14Bk7l6TT9UPbN7sU1Z3w
+Mr1FUwM/0zNeXjyXVSwiWAvD1SsNKuvekAW0BJq7tVQG65Xt2KoSSAN/2Iq1wVvckoWViqszswt24Adz
+XHOd+pf31km4Yfi3fcOzNiIPHqwa9+12HHTuhsfaAvlNQLL/BnzFQan/7kMPEkALBT+CJrTtpklYCUnP
+i8JdFOzruDekzhrB4qiaeEx3mRs3pLtFYUZVJeMzWUypxvTlZTCGjQG5+RBrHZddum2YS5TI7MWDl0zi
+G8yizOulDqSPd0d9e6OlHPrjlAl/DPWnZ8on8Uxp4KH8Ef4nVkOsnAG4WTFpZaVO3U4Ai/ZZwXZMcYcq
+yr5lRQQuSpbu2meMCpi3DOupDdZCLkI+r1m1KOt8JnzHBxOO2Hqu6cqjSNFhthjBrekqF+YP/YX8d9PU
+lLRPy6qpSRmKJ6IWRE0N3xCFaX74ZEIaVNWP+7kCVMs7PN3iiDjNfonaJMV4vMI13IDFWXZKDQtd9pCN
+tKi5b58nSAlhVRkNFvPw2vTwozYZNbuxyUQwoPYlQUUqavOQkhpiTNNv+2pFNBXv1aUoGi03cbrxF2ns
+gtFo9KgxkIkr+eYmOxa1VvDp0a4lhdfT6llNBnZeXhs3SvLhLFktKA7fTFYWIU0391zUZlBhfvPCJ+We
+Qdy3fX8cXYNovBKvVZrPRM953RTB/qpHo48GAbrnzbK51DQGv9sbsl33tqsH6UpUon2UQnCeYObZsTac
+INEmV2zKyaJiKWp2JoSnyBZZEmbHa3YvFeDxZCqUBsMXAlgtViVUTWG7BhpTE/X3dtG9ezdfVOeppZZ4
+CDVVvnvn3QIgPVFcsx2fykpPkUAReRsUnsQj9nCLAHUa79TDrSdf95tv0cOtbzB/4rF5+HTrs/VQeL76
+0x7ij7eHMEvgLvAQtzMosFA9PuJO8KI1SGnZDbJL+IDvkTNvIqG20Cfs6Qw1/TJUNoZWCyC8z+YLE/dL
+04B9aeIJTz28HXfLMH/bDnrD7x747XquTDVePxEyzL7o34uHwTvUfuZ5zRZlnReMl2wU5xz5Tx8Dj78Q
+S/HkV2qzuZ2FA+PLytFrfpjx1FF5G4vTBpc3bqKNreHKfvRB5df4HXu85VRwzRVvIehomOLHVTLi0xAK
+MPd5GcZuEPF099nor2rkwDldvxJnU9zSnmftsOLyceqV88Kbhcjz1x85ipGVhHcFWD2e4VMzvom6t2ZY
+pdFjsjec9NRLSKcwitZ+cs3hbG+yGdh1BzWyEvFWMz3vepelsFL7ghxdEO3rAMvLmMinCTqhiSJJiBaH
+zXLJLK711DbprX8L87vTj29XYUQ5l5xeCc95XtCjtq6aiNDUwsjorRNuGK8q9yma9sY+Wt33dXqe7nfc
+614vNMWxx8UrXYVnSmbuEHltDm7vJni2DJVy1MHYkMxoYrLgScleoayhB90DjfrPKGeadPxO6h9mcMyR
+5hU2ep0zinV9zU6qhTDhyKmPADGtFmNYopNFUSzdUA/az7z0bIV97IcT4iaCNstdN8q927zcxQjorg3a
+6ieUvofsUFZobVUyWdqDgfu25WYjWCnNcL8OjSdFPfU3hL8Co4vNp+kLrejb0PL2D9uJ1ia+ICGjTFuY
+NAoMWjJua/GnJbmhZcZ8lsGuLrSScjxSlnAvVpylCbCdtupPLSkvNIJeEXHmgHEnyd2RaW+367rqimQH
+z6cnmqu2B5MbOnEDlf5Gey/CHvS2k+11QdzQW2k7zZfDNyrYadY8yBhf5Zko63yy9Dm1VmFoT0LNybEA
+Pq1EaKMD70F61GLP1RImLx4W9rrZXgkXxU/A2lfx5cYsNubrd+TNLbYQzWeh34AlN9ZLyJnjJqzHoNfu
+UDtfXptEQopdh96eZ6iWIrrRvB5Ysdc7Gwa+wZu/J1/rLeGsAo2ohEC2sMXM25xvqulU2w83WqDNrlO6
+A8+LIzTTO9Kouia6Ynyb96xM6MOQnb4NlYeHvtrBHeGsliwLhTRLq92q6NfrFcSrFDMINBu2hlZjo9tX
+4LrUSn0vQHOMqyAVDT2/ehofM/HdM/v+BZX0NCayNhr138OInHadEGO5mBdCv61ac0bzbM3H9YIXjdVl
+G5DruBK8uOJLFUFBV+KcVxl6eMiJA0XuM1k+rqfi8aRYuhr7TNTj1gCaq+3t4seMNFhfbKsZU7qOLEmb
+dnoNc8s2hdJHPA00Lf7upnLxnj8t4/YUBLIck8ck6uuL/EIUS1ZXS+0TBG+lbBZwfAOzQLj8yfeBxnD0
+rAfMswJo+MsQFhNd6DRiX7Ys+QzcIoolRa83mraE9nIXg+rMK1lLULIOVJGPBenrW+fGSDwtasLEg4bZ
+cqGHAEosED8zQkenqCreHtQ6mzPa8Y1naefWsGSj9JIb+RvCdyI68zSmXLE5r2raY+eQkNc5L/J/6TfN
+yttvqQ32KSxHP3oHapPRFXvQmqDaXZhusK9kzZWZ3728quHc0mJteOEJVLarqWpNcjpT48LjNLHrxrWM
+1MLpeprqYd2sct3m+HiEbIXyGXmX3ya/Hx8XqO1ee63+xWsdvldfgZAwmYhKlLHiOfIBdy8OKKE2ZdEW
+LVmD17W3vufZQ7TnSupF4b/mmjW3s/iyCVMe5fZBWVfwuTQyg5QXii3m/iuNRYkx2rVWROHQUXM3tkZo
+WHua/B7PJHebShjn1WStCbuFFq/HYdK1U+OchmpAbT4wiDUwZpTXvLzby/Nt7hp24m+oROdDg+AYajfU
+3bC8XFM1FHG19Qol1Tp3ZXCfYqRWKonemnHzGWUbR9PTl9qYCS2PmYXYnun9XCpt/eCHzrK2gUZjYCwg
+KqFEcNIlrBlaX3BXnGTlihOsvMvBpa1KyxsPo+u072/8UGTj5EFY6dCKMHGta1ktbdlXPRu1lQnH6A4a
+4OZgraH6bUU6btkIt9s22zfbELeUDEejJVMzuGnEGxNjsrbapbme1jDfMQNyCxuem41rvv7TuOaPN65B
+G6pPFF3ljhY3IguDblBrHIgAOoC2YwfA0GCEpgruhfWVECVdqFkmZ+xgz2rHmVG9YDDNSqBsO/Ztbpyu
+hvDotIuqQbIM/FYbigv8bnamu5aZsC9BNicG3dIT82PuB0ELwMEm+DA4FxhvL/1uvsI1VwdLtHNJ5Gkj
+B1eFTq7YTEeE1JVZiKjwJrBeS3sN4InbB8hx7wu52i+zXE0D5HsaePOisx4k/pt5cBO8uZTBV71LMYJr
+Rf211xPAZf0UXQE6d2kVlIM2eU1CUNhP0SbA4b1Lm7ABOFAbFsWD12Rr7iyStzduuSlb6LSA4IQ3Jeui
+ZC9L7xpJ241SB3uJ/Ad7ykf9N6UP1G7ldHu5wn+7jUp6rpY8u6HQwZ6f/WBPvRDALm257/2GGml06H9k
+37MtNmRPHBXbXqIVNf/76KHWNttSb8vgavpIFbIblZ1G8x48sKPgJ74QZX+jhZECt8SFo43+R0nd790q
+1Wbk/pPWAfptKDYanxkwIFOiz/KsNxqw3UuZZ0obj4J8B4dTKZkay7nAJ4mHBmUpI6XsyHrQ9oEEm/O8
+YmIygQPiEjTfBOiDiMVmYu6j8ZyGy7oQ85opMecVr/E5ZyxLlM7YTMy08OK9/2o77mCfmd6Mzxw8z203
+13pb6w6HdQtn8DCTrjXZm3Znw5wEHbrIeattU0Q+XfbuBSBPDjD7uJbzuci6wY3irBL8ItYzbG4yN6K8
+zEiwqgQqCmEOi0IUFJjfw43Rb1h5CbqrsXEOc8uw0frT/G3fn47T/K1/yjdtP9wo6R6sIB5Q1jPgdolG
+YXT2oNZ/aRUkV7cXGDxYNDWc9WsW/iZoOMIlSYGd7jqgTQ06ZHy1UyYZGcaPAS/YnGwFuFImOLTn4+1v
+orjxDW6ANGkoabno8Pc2mkkk2BpZrEsFzYaiIx2RgTx+bifG5k3XYbQwNpq/JeZzteOalxmvsk0E2hEs
+r4V+H66nlVycT62xY0exsSwKfdWwO3H12DTw0wyTuYERuW69S7Ei07cmk7GJn7CLZK2sajlHDq6DYEzy
+StU2l+54Ls1Ioza/Woi+xhuEb3pP5/bmbLTOeWbetVupXk1lx0OVsASxCong5dBxfeJE+USGGdecK+Bn
+uzVYgh7M5oUvef15NPy3OBqSrP00fxvzdH00JBTNYY6EtmuNMySZmGhCM2BftDJ0lRuRftvtcHzTXW81
+33YHxKu/Qt56q51zA6Nai9E5Ju7328bZB+7B2X1SWd137OOxDkmqn/sZB5WCqpksnaU6EPLBWQPnXFmx
+vNbRu1VOtlS8ZAivPWAHBH9ddmpWCTKP4Bd4KisbysHwVjKlnC2KOp8XrnYBBkIUq6jHaskuhJizuuLj
+C80RgZru/SVh5PGaCT6eJpgkMeqcWsVqUeKJDm3SDSLn7IcsE7zAUOnQpsawCRXz5xOEP3GNgLbpWs2j
+bV6ee91q47RQUXjcOS+w34D5pfj1rdh1K7e+swXoal5szO7TA2Z0ZM1bX/QGfkMlPU/E8s3VTQZ9WY4/
+N3lXT9+ab7GTV3ICu80DY8o2sRhJNdw4rA/HhJWL2ZmoYLF6283f67li5/isXZE51ZNo3U65aqwtX9t0
+7177UnLt/xt84QVb1HmR13kEiTlZFCwv6Xkd780EP4x9NKZFSYdRA7+N+0frHYf2L1Q1Gx3e0P1J340i
+bej9TYBwqYU3TH/ubzSgh4fxh0Qee1oMW1NWlXJnzHCNPEApmMVh+LMf4lPaP+H7QgnSqhokCV4oceMr
+F07Lbd3Um7EOb375+ubPl68/9OXLxaH8RC9fSdjVNLj+j4uz3x1avzXsYvQ40shClXrAEA1Uh4GX6B72
+jm0UEET1UxpIn5XSyHzOtqxh2k1QYTYD0LuPdifqPi4P10htkD3Jy8xdMBXGF9MA4SbkjX80mIy7NXYS
+wdb0+TiPBsCXnpNQgykbp3bjmYj6W+/g9IYxjf4WgHDzc8U4G4XH6oiEQs87yENpfmYOKYoPZwOsPGQg
+Zlsk5AVqkEqpQx+AdW5eZkxW2ojMlqRJQmnWee4cLmpeBw49HQXa3DO8GbbVoClzdlWRyT/OuNMZk1mb
+BorneaV8F08DIGe6Gs2120V0ACK38QSDjELqLuZXvMqUXgd3FGzJ3ssp0OA/0NNcsVJcMQxj2e3sedEI
+8oyuN9pYG0SpTi+8NjoDtB3TSPZ9cysP9EWEJcIhDkwEVSM5OoDNxlbQw2F2w9Qg80DXYoDSdolR9z0F
+w5TI70GfbqcIk5y5mtjBnvLVir5a9xmpxYJrm7lUirLOK3ircLozDbjkB414zJQQaHYG9BB8pzdgL/i/
+8mKJq3qMDxhTQTqO1FMGYz/rq2wh5dxo74Cc20EEz0GBEJCajYHgtposxQBIjXmJq8Zk50BKX+TCYvXU
+dNN00g/pp9iZGHM4/bx2zDhCrWiW7Ri1DoPUtsFMeAO3u46xQckgIPCAdRvembDZC63fG9jX3V4j8EIQ
+HdxWfMirC+cTcgPDCDw/ndcnLjiz4tzhq5A/yUWt/XbI86oy3jk2AFch5QXOFvWeFAfxqThgx3DucMVG
+cSPjIR+hNkHXTdRAQiDOrJXAo7D/I9gPVo7ixGO9o+Rgr3XiPXYKJ1d+XspKZHuun7daAKtgT5uH8Ho0
+mi/NbGedE9dw9aIR4u1G5nd39nd3BphnLfgP/or0+CDFSI2lCFJFAaqSYSBAIl4ug3Wu9ysO4o/jEm2L
+JLkok9tdRxf3lU6+Dc0qthaADybA7yi5vwZr7G23VOpiwHjVYlj/PkXv76P/EJzVtTTyxp14o6uJuKMm
+i3/X0vyVGldKoUbhn9iwDeSI1xvJbsVTf/uRTC8eXaNd7kzNEL1R1NGFgc15XYuqVH068OQE8P7GUwrG
+wscYMJnr4nwmF/ZSmpdw0HJiaHBSn6NqCOVWXY8VWVkleLY0emgKfHKfPaaqgB6vBBRS6XBjBRVH/ED0
+yYGxMPEsdV2OM1MEYYN4baTzzTxTZKex++oAYyMLiJCPTiC5YlckpmS5GstFxc+R9SuhrTzsaOEWE+e5
+0ADEZD9ZLCMNsv/eqHKMMo2k9BPPFaeXby1/+CJJ3Btl5C9XgZaNPAEHhCBzvfeCUqXv9pe8maA1b6v2
+53Blan+DrViTwxVpYcnUNh/ekL5Ki+W6+PFarEhlMdx68uVdUBYbyq+tJ+sov/7Uc32knss8LO2Xl3kl
+y5k5kDc2H/6iihx9+vJiiI/r+P2+04Hd1xqjMS/fKAEGJjvs3j04H7o6RsNVXmbyCm/EHRjESV6KrEOB
+zShtkMnxYqbP8OjTgG78+2Rm09vwLMKP0dABZKLzaX0l4P+NKTFXKkenH3fpz0StLzQ6sFgt3gNDwUDv
+Es75AftRFHPFONi3sXFejRcFrwJ7cyyrlRO4QmrJKsEVUD3T8vPVVGAgeFlR1POpWCITz0vGdU0kS2gA
+qiUrxaWoWF6Oi0VGgvWM56hvGaFxOhUauZYsvZeCxOQZfAg7J0P3Z98lEFk1NME06Hc8UV6BIJ6sojch
+N+8PHsDE6+mLI+2C15xJ8sJqe8R/ysUV7OhhTFIXU+NKiBIL5OqgpMYO2T2Xe3OTPcdBv9IB13IyHGGP
+2QwXx3jKy3O7OSaLelGJQZtDS2JcgVH2f0XGtPUnY/rtGdNzbZj2DMIEqJwsTQjs59bgrq+kLET2rOAq
+UtB7CU7XrkAACvNRqPABJRkV+7moT8R7OBFhFHYRPk5WYcF0Ht+1Bad3Koq5qNgYW6goJpInSBJ/Yci0
+xkSKzCF0/PWS3DEeEncywRV4lStS4ZgyWmZCPjapRWW131YzC0QODHwUTBaQBq6GMpjRCmk7vAJkT3i7
+hC0nSibPcOUCjTNZT1leq7DNUDF81OWZmVizMvaODgfsGAVDvWZNpAYII0DI+sWSVWJecMrjj4CJcD52
+K6avoc+BFCwLqNxWWkt8EMCPmuQsEQY3DmoBWya8srYt1S5kpTsL7KrBO/gN60PKett+VDWvcJGwHfqi
+1wx5i1Oeia5BZ7PGQbQgu20NcCAdfWyFdZP9m6jtJOijkOXlfBF6l5snd21H6vt8Q0MSnrJwIe+g+UiH
+5tT0umH/5JIGl2Sw2QRys1lO09uo20vH9yEcHKGvHKCVhGWhFmfUEev8VU8tYEex1EGPiEZj1UJWM2D6
++7pDtcdr3jJUzcltGSc/y3bsqarIYSX4aSxqoyUW5XphXIBdGWP6ZzOKMgt+BJSDpWqzWLImuyXqTBCx
+RjJBpD+/9ZukP8bWh66Zp/gnQWSYWvS3G8wQvYGb5eV+mbEdv2b2mH55nrsC82xtQ0Xs2x1dDH/e1EBL
+U5RZ1Fg3TpS6frMRb+eE5wWNMPuObaH/CtJhQ2bFN6KQ5CB2ZpAYtbvvKEcIhck1eL0Bcc82vCMUBD/4
+mZfnJ7KVKfVSEldbZpK6GifwcGsLL5n++T3c2sIbZsuRO3z69DOU3p7+Kb399tLbjyeHL/aODo1n+jMN
+HhfcK8/y+ipXgq6WLZKbRyMUrLwEa1eRvg15hVI5rNx3+Ob45N2b4/13uycnrw9+eHOyz3b8+j09aTPr
+dkji1eujV/uvT/7zZgomJxH4cff43Q9HRy/2d1+++2n3xZv2JjRyOgLHB/Dc/Pz5/rOT45Xl/Yyu+Ms3
+h/uvD56tUX+Q0xF4dXR8cHLw035MaYOtoJUu5Ige/bT/+sXR7t7+XjxAK8m2FdNzPuXq+Ke/kS9nanEM
+7AXTGXhEbj87zGorwpRtMks7/ulvbIeRzjwq+uBB4itgOjwXvF5U4sYMXeM3OK3r+XBz8+rqanD12UBW
+55snrzePf/rb1tbmhLL+jx+4ysfHqAJdVMIF9NwabGmfQqeRx0FP7WBrivgMLRh367rKzxa1GAIa5v77
+uYcKVwtVD8AmhBq5+V/djNf8A69y3nt8yh//691b+P9/ZO8G/3j89uH/BGkJTWNfudDtJkSEi7hrHF28
+XEHwXNLMO0988+XZlFdKNBKU+rtYhh/JSND7Aleq54uiOEYdxDDFJz40N65X+KTipZrzCnQ3qeIma9g6
+cHYYttJd1BKOykLUwi+1uYlJz+V4gRqQuSyWk7woRLZZwmWyyP9FmHe7Jtdh/j4vG2VXVvyq4MvWDGNR
+FK/gwCnP/YbB5+M5H8efp7w6FnX7qOAlX2TDBGttHXS8RR/stRPd3GQnEp8UMCvF4SwZ7FQdJhLeSMEk
+qRSwRniFjyBwOg2UqO2qtwCL9FQiqwt8KoWdYwmxWkrU/hWFucwqJt7DmmQH+18P2DNZXooyx+u0bd7B
+/tfW7UDp2FHQPF5mTEl9qTWB46FdaFRi2sXcjNoqKZpbRApKzc3xmpdR7kyWndo2KQQUNXpOoKC7CusN
+uy4rqGDg5oKgAzUv/D61gRLzq+dSFspLjLdc+tSwRY/nPNjL+uaW+LSf5RiBp5H0vj4U5WLFApVlXYWN
+XGOFSlllKqirkkodVfl5HjQ4w7sj/jYaztG32sxt87sR4whKq9hIVePRwBQRJ/lMeA2KmpyJiahaN3CW
+V0ELcgXjkt2O72Xyqiwkz4Yrz26dt+Ln5/HYi3IMqAz+J9CGtXcKUnc1816VZ98QXpXpECO4rM7zUv6k
+/VNahxKykdZoBamKz8QPkmzl2zJNBc9QWe9GY4pPHiuK5Fl229Nqmp9PgzoqMYl/v+AhB5/Ws+K5rMJP
+9Xz/n4v80v+Yj8NjNc9a93zBz0Th5y2iOuEVu73nYDh3q91YyCuf+oyX+USsqmHGK3js1DPgl4TvP+dZ
+PQ0/v49+0sV/RQUiy/kNyX+r5GIe0NWL1vsSMhPjmHarwZkt6lsevyVye1dtefNGkXNRrkis89li5pPU
+xhnBp4KPxVQWuI+8z1LV0ZdKEGdynyqe5bIxnpXg2VFZLG/V+SpcuQaIqrVzlSxW8CI4ju94+lXyKj79
+FC+zMxmsRUTtaHxob64aV7IoIiFOCT4DueB2zIZeE265ttSUR+3N/yXuOEJQVLWPvcLRu5HIXBTFMxBS
+g1ZV4+jnnhy38jtVjVfKwKicGzbv5iZVBMsWtUj+h5qfYTSw8BudSt6XvI6KRccvoDzxoCbU27dPn69d
+oAJXxBnb+nk1k1nLZoivgS9l+VjdcBXU95lnfJ7XePGhdy/4JKtKjMk7T4vEAnVeh/IsLwQ75hNe5XB8
+G0IXYnkmoa5pbsISR8SDu5urI7qc5bWYQXP7+NcxbDb6E2QSbI9X6WE+riRIgKaRA3YsBNN3fTWeihnH
+u34mx2oToDHrWYGFTTXtY22rv92mNU1debeCTAd7+rFPzF6LielZs0uMK3Yl4G60qE15tChVczHOJ7mw
+ukj4388/7p78/DdMc1oXGBRTFMZGDTc3YSQGkG1wNeX11TkOE51//FxszkwzcMj+h/35OJOzx3ye276u
+ukXqvq0cCne5Ut5Kg5E4movybxWfT6F/M1FzVvNzvbJMqWjxHOzjK7d3zyNYQ30DYRO89Z+JKb/M9Spa
+lMRmSbxuNpRez1CBZm+1aEY/ZL+mtCcd+v14TB86/fiS18G/O5Fs2JnIqtMQDlFr9RhOyMuO1xCjcQrb
+EW22DnwY2w+dlFqE8uifncTW1Bnwl5euNSCYimPaiXUfmDQv+LJjJ2ckyrEErQdGK8VO8UIj+UAasNNR
+Hy/2BQe7BV6670yJukaXJLeMG+o8GM0vNhEsi5YtLNaJemwqDu9NHfO5E8vuHfiz4DbFl346+OMcfnSa
+B1wHf6BKphOebB1VjTM57oQHGnyFVWJhFxvPPkklo33z8VLuD7/5pt+mwR9ubT3Bp53P/nSX/YPee17k
+5YWgJ0UDFHtrKx00d4MJN4ic3stNmHY7DFmt6H+t5XnzgA2MpXO2qGtZdugNClZvBxt8Jt/73/IZPxf+
+B7pd+19w4wQfBCx974NanM1y8wW3g12I77hSoqrJRh2GsovGGB8XiAxJgEJ+rgZajQqk2Q7Zj4BRnp8F
+pTk/g4ap0NEe9HwzznxaKFExW3TADiZsKRfWknuhHLJrxyvYx1zzSp7xs2LJMln+o1MHpVxzoI7LfCwY
+OqsloDA+tqO9XgBBqifjJ5PNn4u2idr+RPN0SWtTN/zBgyBRls/IhvGGCQqHTv+mUDSaAJl7BZNlZ8kf
+f7/QLabshjm6VSd7keuLnoFnbp5/z/nRy+tjZ6i5hQxhKzAmpstOUWKTmfJrT5gl5pWG9qy1vW45eTdh
+vLCx188A+tAzsntHH72Dhurqap8uMCuA2xnBBsG5ODQm69oGJtr+AzgwhKoNmYF2W8UMXsPv1PL2puPK
+vVvTvWlqa7zOEoz7K7vuCmwBG2EXR95FAm4k+h5R0AVlpihCmZrKRZExWL8L8vuBBjG5qBXQ1JB+eDyD
+GXZEhGwxSxPwkKzWY1nBYKjDS6SR9+fmqDcfrLrBCng4JoTEQOE8bV1hWBjtb4/ZT03ut2EQJtYQEE5p
+yEGEaeSNFnsy1WgP06nmVcVvZSO0SQie55ItRoBXtPOftNdhojPG7RRDdayWjNN0THJRZNbXOIhKh1RG
+plMjvZIrjZyBbm+VQPmVo2qU7qJIEE+SiBIBPUJtegWdCQwsd1YgIhIbZYSiioM9GrCjeioqMAfqx5SU
+qJnIIZl5zYOXMDPGI4sO70cGNhYO9gn5N1o5/x1Wgx6D/wbrQR/sv+mKMGWG0ZVjACukLUKXOQOwyDOz
+YK7p/GPPA2YXGQU/vLa2wxZaj2yP5wvbE/SbpGScJFkhux74psQ/RSzQk3xorSblXLesWkTb7dDkOEmk
+1Uq7kXv7txo7I3yomtcL1T6IvixF1Pz/UuP6rMEiVo6sdw43xrYpmK4YXf9AX2d8df5PO8IOVVoLMwYS
+OhhUy99aF+eR3VW/8fpskwT14DnY1k82Z20C3A3TJUuXrUUBFstCt3DHjVQiw62vnqwFKrf1+SfVkn3+
+p5ZsfS2ZHPOCnIkqPtemd6u0YT+QQRYW2Z/ldS2qhF4sket3R45bWxtn11UlZvIygPPAPwf6O11h8L7Q
+HDV9ZagrPv+BAK0wfWjXtR/apW9klx+4Er6EdweNAN7GAGhzUQLuRK/POocaI2pG31gtsV3UG9UJL9Nx
+eS9K88GEccektXBFGGhw1ZYV8DReSuTJLt+E17wg32QEaFLOKFHgUWnFDVj5OozyWFbVYl6LrM9GpcxA
+vBOVMKsd6htYdxNI9/x99o4OEce/t33XMdS8tfRiSHUSczyIZ1dj476mlaWoYdQfGiyRJcOjlF4IqAja
+p237NOveaMYQMo2PVlff69+GJ4CSF01hO3ojDlprgQgJSIsCm7jsnmkMt31CvUNRG1GjSsGrYslydO4c
+8wLhwdDE09mX44Mi0SJrIov6hQjZkNe5fpZCQIwXqNuuxp/zonhT4k4YrvB4S/TJHcoJeJcVI0HcohHg
+q3nQNhfYbc/aFJsdbj19che0i3XO6S/+dFT67Y9kMvkgi49nRT6+8OBM7vKIdYdAiGI2r5fPzbEYlAiS
+bIFbRq7TSyQ0brGQcpO8Mkbg4M+NPI+NYST0CcZkyUpZPsbQzxyDNgE5ZylPwEgzwUuFQUbPUWYgEg5r
+NZO2OsIbAnt5XsEsAjlCEMsVO1ucA++WxaVQjOAi8vKc8ZLGKaJroPw9N/BgQc95VecE5aEDXZOZPlWM
+RaHCs2VrVQFskizZqAa8aLTJGrEu9R0HEwdSoi99tTTD03Nqz1UrzYB24IhrvSc9IKQueSaujz/R7LUY
+yyqjy9ooXBGjQVg+9mi3dPA3Tg712HrTk0QipR3hlc1BMgd77GAPdu8o/B43xsQiJ+9+bBd7iX9b5/9k
+EPOH18gxDGfVYJVR5HNlSikh2K8R7pl4X8N6xq/KuWwHn73L7AoJIOxh+qtBSGN+T4Oj8RbhCXueVrwy
+i3/Hp6wfBPzA2zob4KrQnwNZ4hJ31BgLU9hOyJ3Wjwm8arVbA48WRKmQ6w2fPqHz8Mu7nod/XlHXPw8D
+P2vtsOvs6uZCzgsxmMl/5UVBxof/v18kSN+ymkw2hfrycVbxSU0GSkqMH8uAXnxiOug/TNdLtK8HQjmU
+R7PE9buiXrAONBn2iAZODnpg9saYnmDPhL1MOZAjjeqo3Tvxkkw0dK02kkYYbdtm85wewxyId2ADLpXi
+fY2GuAQm4H5+y3h1jraMLvCSTXUIA4bGMS2SHVfq1OZ+66BI/azhqJHTUl46daOlj6eH7b4j0dveMNdK
+e2twNwFry6l97RUrZQUyxftcELhgRcAzsMgNnbGcL8204Dziq8EVvqVWnrEklCF4mSuNjGzfWJEO+dIh
+0Dh6x+WZeEzRJZV3xTFNVMvZmSxgpxH61hWkWmc5weqKl2oiqkrfbLz5uxDoIwdDFII+hJNOFwrI1Yci
+QeSpWp5eiOVbtoNU8O8kR/VC3aIWpMFdaXF7kFRf/Wn59gcxTA+CovVScJtQ58d4yWBzIFsBljPsB16Y
+mDGXolIhqv5cQudyXrhYOBvsoeMOA7bLjBVssSQ4UlsBTJLgCv1brRqAgH1Rd+AwoYXe3gbLc2QrGGno
+0gE7KC1aKsKE9tkIpmlEMdQVqyUZEAiG48XyWoli0seLAS9ZrrFnkRPwcslQ10Uh4kjb0Dc+rzxD4X3m
+4hLISs9bXjF5VeJMekYHshS7usGvqOde4GXgRc/hcVI9t7sbCv1dI4ZBJwy6PX4bmKYCLc20fT5tktkO
+S+Sfy7lRn1EqsgyTpc+i5myH7wuUa9tFqPt1I3oPRqLdBBUv+PmVbB8OvtVn/OkfPgy6GXfsvlfa6/a0
+EuKmjvcZ/+zfpPPYlI8aAEvBDcIkv1xrDPqMf95n/It/o7GwTfroMQkoubExTkWvRSG4CkfGVNM+Hnc3
+MdTDY/6QE6KtDQhPqiWyXckqapfPLVle1pJxZOiECuhFYgChNGXS11JfzwbxMCmDTBjcZHtZbSZ1g1ik
+rUuCfasnGNp6nP/LvsSkFsVCTd2Qh7O0t/98982Lk3evjo5evDs++P/2QaR+st1I23/Ndpqc3521u4vz
+Gaq4RiD+PHMQ0SP9rkCjykklBvPBOBWB6UCDl3qqk4AenWcIA4Y69mLZo7MN8TDwSDN3BUgmgxg4oMsl
+i1oA5MB+E/AZSdYBCEQdamNkxm9kDUYJaxzNcmyQCK5hul2FIzdlIybdGlKsK+ckOPVi/MPn1j4gaiLz
+/yZXJJKsFoqewSqhKAxIgpYWPwhHJv8XDjF9c2e2Dyfmb8SoHX1d0O3Ll+LKbM0oL6wjkxqsN7bDTt8G
+qeeC1gs8f+rWfvgQLS+zW+7ZUvHKbiSwnYAGLl+zeWxms813GgzJiwRlcm/bfRHinEID/CEcBr+AtTS2
+xrD5CfI1pIVh8xPmax6vw9RHyNs8hYaJb2nlktfPWzzi3Pzi8vWft6g/6BaFD2wGH46dF/KMF4q9e/d6
+f/fZybu9/Z9Ojo5eHL/724ujH3ZfvPvx6Ojv796teoEJw2emA+xhqrFdIMOpaV5klSgTNhYmKcxvdeOJ
+AiYtLNHEIHbfI9oEUp6ijClhblLGHF2VSQsRPzkop6HVE0V0Siq3xnOQVXsxmyUoTwjxcZG9o8M4FwE4
+tg9unCUsT5akBwYILlU+yhKUD0OgpGYryhGURtOORBn8HuR8JapJIiN8DvOt6xJnS8BbUDnOi+RacIlB
+mWNRXYrqNRpSgLDSLBjluD1gNqAta/ORMLeXYPKCcIV7LsxpP2PtybnUCIBd07wghoCx+dA/wwAD217+
+55x4ZDK/TtT5C1m2kvfStjc2bhkpcFXL7QaLu8BWdiAuZnvCVvXDKxV0SBtoaTvwHbesBzPB1QKOYfzU
+6bMOZer0vV0yoG893+JNyy2G3Rr3jxlATwSMeDDjcwuzBEYWcbr+bBC3wCQlyoEfKV0i0ItdXcYd3TKY
+YcTwta/60HIsg1yoH2/DzQmJGmg6/1cUcNjIG2QW/0bHI06EscPjahAGLAYBMSi3bVpO04vHytA7egbe
+9368xIbhz360KIbBr3680obhT5euHXtsT2fw23Rwc5M9sw8JnJVSzgcMzIj8i4SJBE+gdRUfC4ZENF6C
+lodnZNRjBsAI/btlRjwrnkxagyvy3UDmh+XB3rqkIC9JvZbTDf0ffRTrS0Qvon/dlxN5jC/swySjHoSZ
+wmJwDYU4Zov5jYVdViCxICMu2/7dmprs9TadBZe6QoZhl03AEsPEPsaBqadaphkGss/ASzE2bj+ay+3/
+Pv4PpuaV4Flfv/EQDmJOqnFeLmu05hAFxvVl795R7qE+r/BmAWaX5S8m1lS1KOt8ZhUqmbispSyUFkfZ
+FKoOYRC1hcIACO1SZBioPBNni/NzqP1qqnHmsXCudPRxkRnDFcAjGeDBsMFMLJbVYm86mM5aRfXRiNYF
+HbMfO9p1dp2SXdq0vjg5bAqgxFIjIWmYFK4o66HHnQ8dU3aSyjCWayhDIAMO09Ij8AOyJb7l6ftxkNKk
+F1iNKexFPKrlHGdF/wQ1Uo9Mgoxt8JXoUByhZ9NKzkTfqXkowJFeqzOMWkghYq3fp4FjNOTAdwPaV8qa
+VEBFgTdQ83TML3OMDganTbV7jtsWnpePJt0OVd/pse/Y463wIXS95bsTLl9HgpitLMQA90/g0Lane+C8
+TdmeuDyRhHtTMc7ORF0L2HqXopBznCjxfi6qXJRjMYxdyIxBw+RsMBObFRB8bMYw5ToWP5QDadjGGogZ
+DuJTnXdzk6lpPtNgVGy3qvhykCv8tx98dHYDaDPWlhgIMs1kPTNtyVZMYmyP12IAEZL0b6ON83IDYLNJ
+1jL8hVjaaLZ0xnj51bzI69bUuspn/Q1/XPhMhdRJRIiqnFRC/Ite+N9uR8/wOQWNyNm3jUmw1hN5HJfh
+Xpz1NH+bWnii4Ul5VAomKzaTlWD7x1/g1G5iP2z1YD1ISxIfKmXN+CXPC8JcWmPdXfEKLO0eG7Dm1AJs
+iQmho5Fi7QP9NMx2WOfJYOuzwWedlO4M89426p+vMBluPfl6JRRPvzVcQ6hJGW49fdoPFSDDraef9Zsa
+lOHW08/7sfpjuPX0m35SwTHc+uxJ39cuDLc+2+q36g6GW5+7djQ0A8Otz7/ox0qR4dbnX/dbVR7Drc9d
+y2JdwXDri6/6oVJguPWlax5e/4dbX7oBa/p4xff44dZXbtjia/pw6yuk5V+yh09pmtx1evj0s6RK9Js/
+jdB/J+2ndi+w63K1e9i6upSGL1lIX1+3rYnxa7xKKRONi3xsjHcNMDocMmsTnIyBBObFtCWu2YkZdyQk
+J8ny7H9N8pIX+m84PpCvBkGU7J0p9irRtXrdRpbWW+F02T4c1jI13iywF57+GZD0d94LvqvNb++MET0e
+/Lg4a306gEC3zRKvKdj4srWYyRAq1b1Omj3Z0KrHeQwFEzjzUEDHoy5GibdX2OYKKz62xphBkTg1sGJb
+zGacFtaobUZHGjYH7TlBv6FXBHvMTuT8cSEuRWEcSjBIoLIKIXSwnElVa5P30FVAEUgF0mL4bg6rhMnx
+eGGMxDDIKj5N8oyQSonnVULNZanys7zI66U2pcOrqJkF43tm/F78Oz05YWIF2uLV2J2C8wmC3Gt61HNt
+5kzNNU3AtoKXCr2pY6tVotkDN1w/C2bDe2AjMvE4W8yLfMxrYQOHS8bHqPJEYdqMGEznRXPEztBy1u7d
+KwoP26j5uayueIXXMiXMZOgKuzb8LldKjnNO3rFmZmEvmwnVFeO05nUPQTPD7Tay410yZPgUiF1daL8e
+ZQPqXmk+BfSk8eZA24uEc4hdcFPRqJGqQNNjLRaRdSMWRpchirmuLSJnqMjSNd93kcrv9xlnCuCayjEe
+gc4nCqbqYE8Z44lKmECf8DuIydnWRGydqwt+R507uhTVZS6uoGZ9Sykzl4+pparFzOy9R4+9/x4xxhjQ
+YR/gL5AH4L8P9nNLbuay6f+8z5fx59Yq3Z7zqvzAmNmBN7SEtf3n533U0t62Io8+UOBpbNaH9Qq7tA80
+c/S3a/aHFc2+bG/2B5/6B1s4GApNddUQ+MT874PHjx9/9yFccB9SxD7YhwrTrQ+N5EE8SIwlqnwEH73g
+9B9aFkIrsQ8nfG5bwtgHv5umZfr0Wdmyb3Gq/dli7AN53hO7+fhuskUNZ0wulD9mzQloW0trzOaNS2O9
+Jcj+6yN2jkv8sF/Wotp8Ifil8HbOo1WFH9ltl945/iK/xYb/wPicTsdcluyDYSLBcnn8IWB+QX9WfU40
+bCOCIAp5IjHlZ7ISZgz+Jko0+X+1qOZSCb239AgcI78mkReFuQKO5OUL47CJpnK/XhsB7lCWeS1BEXCM
+sRsM+u2EF0pQJtRCnci59ap/BkICPug+oTcSiDukD/UivxAWy5YZ91f0BwK5SdX0kqf9iLV/7JWgo53X
+jAO9Ql4JDSxRTwEI24C5j3p9BipItJasve8ayYDACKxoA7R0uygqFrXEC6Y/Y1NRCeM1jQN5yOdzMnBA
+CBE5/6FYAEb5WbEgkPJazg2SUYcgkexncGSEr/Cv/ehClu6XGaS6D6LMEtmOKaCDnxH9iRNZ38wpcIqf
+d4HfXGYvSlVHx6yaiXLhMsyXmDJf2k8LrH5ha9yTi7NCmO5lZ0XQw72KA244BGryP1Fv4avXTUrAmCs6
+qRZVkPg+r03a+7z2k5A16LQC/vYTQY7RafIyJGnGE9KCgdzDGAidrJJz88mAuztg91rOD8o5jgiCN5mP
+fxdLeHUYss6FWMILipfwqsJ4J5Ayhz+9pDdz+r6wVR7KhRKa1Az+9olh4qG8FCZxJi9FkHi0qE2aXNRh
+Eo0JpXmDgolv5ibJNeUVV7ia5vCv+UiMAeDa8Q/72YR7t5vBBoAPd8UJeh/SCNbmb5sIVgnPeDkWUAP6
+zo/xV5CBlhKmemsJk/TYYJo/Ns4Z2qQGU//zVGCNV/BvZ+PaXU1PJBOlWqCqHpjWpMjHtULhnekj3npU
+EW92VtLeo621Uq49znmw93cB1//OO2Sq3vcOe6TfR7qHvJ4OKl5mctbt9XQE5qcBNtK5qC1Dfy6rPc0G
+u/jivqvdv/HxGiIK9NlIJ2DwAc6mUtXaKYuugzqUIJqPj0J/xRFRIr1MAWoZejG5wbeVHg90tf3mIFi/
+R53ltJHjLdtpPXoePdrWQTHjw+20nd5bffDRw4jWC96Ogg+bu0Jv4WkiCDbCu9bSrcre8QZweAI58Z7D
+xcEqOdqxh+YLu3C6ndnyYA/Mp2T5jFgym1nX+N62JkaQnHhC8qKQeOvn7H5F6iZOr92giumm6aAvAFVl
+1XI5zANqZfU6XwFIpr23f73ut6i0+vSWbdXM9LyCRv/6Ns3HF6J0Kt+mvsUDxLAhfhMBYmNYiSahIEgQ
+kUrVZjW9zUT3cthMGyhR01PPiYZvcM+JrTM+DQpsRG9/rcWatVu7Pf/jdhuk6bGolY3kKSvthrnUZluZ
+BYJUHmarKGHWskDxr4f8TMpC8PLa5GEn1QKBGG+kA3OhRL1f6lCTdux1Lh8m4xajEc3TeoUGriG2+tYR
+tG8etu/NPvMq0dtcNTsbvWjcu2dWzm36DDYlt+mtbUi313NoZVE3fzaAMKQ5JFw0hoeuBxKEdij6pDIu
+wd4yeZ6DTvQ9u/x68GSLddFCRSqVAzg9Ofr2mHg/zc/yGkFeK5AxbDgjWKclEUL+DRL8SJZWZBqZhvCa
+KTkT9nZRsytOSEd++4iSBukhPCK1nM1rOVPsSlS6ZI5A+xXDWlhOZGbyEiqXCFMgZwLNzIgeSN88LwVB
+KqPqlNfUXjaXeVmzLgygPgrMmMEyOUc8oh7DJxsg5Q4Upy6EsmGfEb4AttOYTHjYj/JKXIqqr5tOtOgc
+0O9FI694etL6DLWKeU0KUDlhY7molG7YGDSNcMz4lIwKmxeyPCdRKj861rrlPvtloep8kgt85MIXGjtk
+qX7WMtUsVIz3YX4LwVVtTJ9Mu6AdM+PziMZ5ZkH4Cw3a7SMDefBAXtAlavdMZgIhTs4Keb7Jq/E0vxRq
+8+mTrSebT77ZxCvSOyT2LhOFjb6mae4WSvbZCG8Co82RuSnQn3ADGBlILH1X1r0mCzCYmoP9vgmL9pBU
+2y6nGZsUHzZ4TP7xjzGu8f88BTTrisH5ADG0oS+jXgs0k46RbKRQOt6Y+amVAPJKPxubTVBZZkf1nUiP
+18Vt66cr8X2ItdjGdtJZHb5jrqy0x3ZuFKVduUzMRZmJcpw7VLXw3W5gYYHD1u95JU/jRGPBdHvktqbd
+U58VbCdoqLN5Yt+yIjJ9Cnq1jEqe5m99dKh73QCZvuuNYiT6dx3JHkYAcTlPXdLbnkevF4Pq+61KQV3h
+9c0vRMXiJ8euvt/1wqy3O/DbsD7Nf+nG9aNcuiXxZ73Mgq+eVVeIV93oHXLYf58ues25az/DPmxuWrGg
+FCJD1j8mBNPAjx0r1tFnPTg4n84NPDyTs00sqDZroWq1ScQs1/4jh3Xv6BA1NsdO/3K7od1ILKabdhhV
+Zo2MV+0xoxdidbUQH70OA4TaG0eMmtkYMnXXoUqtwt90ym/Xgds05eeDl3tHP7/7cffl3ov9T7w6UE0a
+Ryu5qRAo0tdaUFr5+kesJ+xYYzb8MJ+3WE2/dWNhQBttte8Un+6Ywe7nZfOMwaizbMoVG+k8I5Sn6Zdc
+1PbaRUIgCai35c2xfP3k680nn28ay5/y/F09Fb8zo16xTPLy91got2ptcp2YKbrLebIRTF8t2YxfCIZK
+c1h+uAaQfqzuZLzSFkykGEVHp7HwOYInLSY7AmpcYAzb6xbCuWqW8lZ89PS3Qp4NN8CnmzRXRTgbUct8
++bm/sXrSvCnzJqxFGI9G5zpSaDUCKbD1IimYD5Hq6lOM2y2Q9j1ASE971WC3v3Onkqz+o3tldXJUF0rM
+WmWgxWPYm5VQYAporCF/FmwMm9FkwdA7GgSTawWDbxGB5sZjXmrIP2fdhsZpVX5+ThgBlZiAz6OvhHh5
+dLI/ZMeepK5CPcct9C6ezB5J6wTUjK94nlmDs3VIaFXxjttiDxHemCsxqYSasp3YunagU7yS6g5K+sGM
+2uCR6WrKdk+3G264bey2Lw4SKiK0JSAF0lZDFkNet2REKrHiggyym0Ra8iEN7+2qUc5Lw7znoj3vuQjz
+ZqIQtWjNHiZ7JXaLwnxVLaX8LOAWdZPHgD+7NyFZhxXC5y/7LSbhw60nX93gOdW0Ah9ufYGuS7Gl93Dr
+GyzWMNEePn36DfoybP0Jpv07+TKgf5Vz1rpzZPRbgLpgoUnB61qUaUyjKNFFcMIFnPNarAI4as1m6GhQ
+innWyBESas/nOxOkhtF4Nys2FcXcvI5YkA18oakYmkrB35wpUWtz/rHu9IAd1BjJdr6oYUGqRU5P0kBp
+zgnqNq+ZLGupfdMXRZ1jQ+i+A9Qw9gNqqjJWCVlp+Bz0KSiVqNDBNnxEj7uyE72Ok/1fLRTj7D69HmEE
++vsO9FeHdAL3giIXmd8nWqBjbh5GrAvG3tGhHd5Rn3HmkSa7EXo5gBIzRIJIvS58bwM2CFVDNLy8yMBN
+SrGX+IGawmZ8ruKQDbborp4Nndfa15gCzvbAPJe6BeeQYSz/ilvSJxhvjqn6ZeF97b8ljN2miHZCg1gv
+0sOXHBEiLInQ0djOQ3TFgFLB5cK2gu1YUqclPRd4Vy/0nzPud7bVMx1yDIZVZIRXYQeRcRTYlp1K+JR0
+eLJBswUHDpy1dWNTx/qEI+9aGLac7YQEm7cNK0ybggnBlriBcceJu07SLTkpclaKq2ApwcivWrPzSlxa
+hviqEpe5XKhi6fiGyNL0Usv/ff1y7S3gh+e0O//ErdJrf8mueIV7X7fvqXgw1thXyB9TW0oH4XajRbeS
+VKcpxd9x+CHadRQ5wMUMyKQ1SOPsii+1V9f4Ah9yFcWjO1vgG/wVhQjOa1GhW4AhCP0TPIMOT2Q1GOQl
+ZIUj/F/5HNePLUKuWXDiUhQ9SJmBYmvDbo9Lszlg2x3tHQ0BdaOUhCIDKjCyesz6Zg1DBcGCoqkw1yp2
+ZWMUQJP+ucjHF2AZzfNCUr8SxAdBqIdnq9hUcyZ6LvrDvaA4hHzxW+oYUSPI9LVrAJ8Jj/cZvucTjkAW
+/KQbGWAYg8JUTFXbtrKdcIQfPAh+hwzTlHQYZo6OX3DwTi9DDzPNH3VX3u9QWBf0t1146XrN6Ps0gxGI
+BLdBJcYivxSOis3pbcWAXPqgS6FHNHviD8/2RqAyC59qba6EbsxrfQwH1fWbDJOfCmKuTzjNL/Wp5h1C
+GTsjP8q87qj0EWZ7ttYx5nXAH0f/86Io1h7BRuWpI29zk+mYiO4YQwZBcCEMbGZQoBXKPmwGWy69dYO5
+adtxBA5l/rvXjdnCzVt21Yptn3M7SL0VQoBfeUoQIOoKjXYakoC2OHIWhcYid1wIXrLF3OYkahqJy0cf
+QDve0l9VbXKDqf2OskPz3NUD1zx445rM8Dfkz7aMRoflpbKd8HeDm904r0HxINjmCoSF6JpjdSVt6CXx
+nXT4dAthUdpvm8OnT1FnsuIaOXz6+Reo8Hj6J7bzH64Fwc3YouMIscs9HYGXEOAmPK/4eQt2sUlyETMr
+fikqBbq+tD4kkcFUpuGZwvz6o6sgEU/Fa/igkb69Ih5JULCZwylGvIysEvoAMQF6YFleCIG+bp4DPhpn
+zkU1kdUMk4hbATk9CLwYGDBDfilzdMYzTnnWrNlO9tgLAvCckMp+kPLi71SzD/5/b2LR/zWkmQ16av+o
+pWmbaw02OQgj8P1DS8LAZJt/PQJaHLLFN51/TbOh3ahJ/agCYq8YiDhu/E7cne0op4PyDj+At4k/1z48
+f7fZxH5zlQVOQ5r4cV6eF3Sn65plrSvss7ETyPosd0CHk0Zt+NQQlN7eYIl88XCQY5APzJvIHLcn7/me
+Nwe1Vn/V00ouzqcJsUkHtCiWTM3FGGyLMwZWChAOQw1M/pHjvMIoDDN/toxaAzH0emTvbK2prbYNKBSC
+63M9jo8Bi9E20HKXuhKeKWyweu0x9BAqrnvBdghyti9zaFqYFK1wk2jui6aFfb+y9CL39Vhx7MCU+sZG
+TgyXiw5r3lzHLrJGd1VbtjeYJemx5WZPvAXfjxuBVBJN0AE2us3s178Vd51p44M78tZDPm/jqw+vgfhr
+oRZFrSEUzdIzzUW9tECdFBz3FeZVgxb2POPzlaxZdyXNmGd8voIpx0W9JRv2sGu71Pfb0/foezzZZmY7
+biy2vVSPW3u//ByOS7sfKzl02N5+6jAP+POMz72lCnHidS3rs+kZn9/IonU+fzj8le+Njs58IZZvyvyf
++Lp8zyYnb4MG1/YWMLq3iIqlhSpzSbftMtfzTgMAvjsYDHpDtl+OyW9UZHBGOnZsEYhQUr0Qy74HyNkZ
+/VWNBsSyIU1ZDcQCq93Wd0afoJrySjBOpNDOyaMHFU3ySul7vj1O4GY66LjwzzNt1cGGTp9+vaGH1vY6
+8H3g87m9zGk6zXkNj+BmeurU1ZRozq1ew6tv24CMmjDyfK4+8YHsNd0cyDi+dzmVSXN7YgJmAVxFsSTw
+VPJt5EWBRjOjxmIaGbVtJShwlr3iV6JYUsQvXEwTPq5NXna+4BV0gp9zuKjijPoKgE8tK/jzHMkKCc4L
+g9UoYd4OjHbjtkfGZsDTUgcznufjTyFU+JyMnLuTokZ0eHgihneMhM26Wb5oZdhpMSNqQruIEZqUmcuq
+hkJ2DSZhZIV4v7eYzZbrHR6Bnt9tZnS3x9m2sWPX3tvoI9+yvW+/3s2apHYQcGizTdHqG/tKNG/igpWn
+Kd9WlMTBtQ+e1xvJKFG/bnjhRyKRu7+hw5Z4e6S/YUORBG2/SYuGu2JtyGZfYzLc2nJww1YvMtz6Au2T
+UmqP4dPPsYRRcQyffp5CBn762Z8KtT9UobYy4vRdw5P9PmHEqkpWidhsUWIyKNchn68IyHXI50GpF/lE
+PFuOC5EoY9OSwbZeSLKFXRV0y+ZZSQFMJNehgvkCSqTQ/j8LsUi130u9A7LrGhHJ9d3gMIcZCfPZz16+
+o0kjz9HkVkrUw4P/OHh5/O7v+//Jdohi91eK80OC8nXPV30WOTrq4imVCTWu8jPBZqKeykyxvGQjt00O
+ylpUEz4WI2eJdTwXYySypMqoP90ADftkKpSjaWBRKXCFxpQtx4KdeWFgzRFJc4qhA6AHDvlhb//5wcv9
+d0cvn+0bSJN1azxbsjNZT1sr42Xmx0UCFN4zAh6tiQZBjTmBeopgBYO4VrgF6VMTdBSNth/uvvzPG9uO
+jiCXoqpIyUFAB0o33JE8+mn/9euDvf13P+we769FVOWzvEBfar89fSbej8UcRXKu1GImyGWe+kG0SN4l
+e/i6QpOTmajOMSddALVZnek8mcnLCb5AQsIsf4/2VkTPnG0KA9bXU1FpO7oLsbTIUtAg0GNeJYfx3eH+
+67/t71HHyfhY70+CFEYzX6WD07qDk7DghHvKpKGZ5udTUWk8BS8N9wj+VkJjXI0NDTyvKgPd6wrZM1Zq
+PBhtboWwvXLib69RH80lGY8X/0RHMa6wJC1Ze/XyQ5GNcA3SltIMgpAfNACHjbAcVpDrrkGgZIRlxUKc
+jei9cqTXjekK3R0Ol/652GhK91fK6uJxha4ElGbFym+z/PK7H0VRSPazrIrs2034sG3yXdMf1x5UU3rv
+aqBofxApdPOYonqbHYD9BbMpOxq8YDPBkbtqPAfd+96AHQuR5oMgrG4gakYlNGrDbF6JqSgVLANTM4Wm
+1qhopAnKBRlTeZxWC1GpbhFKswmHYvHTLKhYgDYFLWOJ1q7AowozNixpQT6rKo7SG24lwwNYLS1MJGp5
+iD+W53q9JQMc4NL/FQle608marbd2+a8csfLwOdScetk4+rdMsw4787YwR1CFh7ENbqjmK859szk8lq5
+wTdxyk0dvURntYqgrbdEYM3u7tkjCAXkSs41VgshubTGlVirJXMXe/H2bTEvhZ+uOZriJ2pR2BqmRE2t
+hOlM2r2u10Yo+Wzthm6C0/eO306zNuF7BO92KS9ERjsrWJosd2ZcjDyoHLS9eRkg6QRtafTyHqGSHpUN
+IwJ65zWtIA3K5BQT3XwgBmyhyAifjcBlGQ0/g+VNCkJsPyH4U4O1tdnoXNQHZNYDgRHFyGCma1T/MSK/
+o1qQyMlSN1FRdlmhxar+JmqikoyWcsMknQsTkgjuC61TpOWIfnIaSEilprfPxkkk+ATqayJIluXG4kln
+kxPTz0YnGYsGsumX99A/TL0vjOXqqBwSTnHf/z6R8gf+ryGKFD/wfz2Xsttz6dfmz+u7DXbY2lsM9i3q
+eOZtvFvV8UYJ5N9zxSaVnIXbApYoToGfptdjLbU04wDG6HhYVMIIvUlu91KigpuXtRAkY854ZsIU4PaW
+FZtKiC5QG/u6xrbqMyWJXF7T7aKUJMQwlWeCickE5fJg5aSlL0pzBspsh7kxGFiT5YdpEa3PfoUs1/es
+lLZirYRqEjOfpYQm6xbbEiixOvRK0/bm1MK1r7+xETJUxDkQd2WnejcWDrcRVgLNUC3ZmbBb3Gd9fNmc
+AIQbw1cS8Z6ELaYWZ3CzntPFUVYs4zU3FRE9ExRKT7t5Q0MzTJGxxRwv47bJENtXW3aGDDFxhnpFdIjQ
+dU7SFcM15YqdCVGaIcGhgo8co1vYV36uH0uAnIXUsxe80tsUdBQF4bJye6SlUNremCi1UGvJJIr9izKv
+8T4Ksh+vraAMNNPd0GPv96XrW6KSnwxZbfZsmFB6nazzmUiZu/qBuyopMZ4vNkHjJDatIBqBvNoncS/P
+7jCHyVNLG8orPAJo5689wIg4DH9wLEkG9CTOnC2dv6DHJmE6SJyIj3MTB5fagNyRHOZFxi5zHrDnkLkF
+S/s1dUef755X23s688nkP7B5QCKMBe3pegkMHz7VQQk7VaFDvCU3gCR6+PnOZ572s3eY9pIsknz8T7zt
+AAzwkheirNko1TczYLsly8uxnJmLDtHz5gD4EoH8cz305BhDrAmfJeda96Elv7paCNS/WLjLUoiM5XUf
+vuCkzCt5xgH9EULmQtUIpBlypHkWCy+x/5UdwLX4VTipt2NbeSFYJsakOMuj09m3eMdG4zs71++zqHMh
+zE+oHwjYTdLXI8rLbFNW5oqx/tYZmVB7IJKNiC8t5aJTCTYWVc0N3qndO968anBL25ZNbMqmM9EsCkYi
+NR6ljLsOEy3qa7iLaCTsEW1iJbTtIFyE7ZvJwO6Kfy544Zd1e6SnEbEeGn8Kl1cTd0LXirzuFRly++/R
+TWnkhkWY8krEpqxIf5Z0JGwgGCdXnT8NKX1AekZa5aC1hRwrzlADWLYQDJm4t8ZA7G1eF/uhJIzoVf64
+o3A8suM66tMP4lhEDst4Izdaf88Ys7d5JWAq6JyhM42XpjMU4q2JovKfcsEePqTL5sOHiRtltzciUcOK
+X4Pfde3cxat1NdtsXzG3kfM6KiHOOeFP883fVipLVBJPCFgYrZoQSF81IZCenJDfWpD7NLMU7OszwSrE
+ks5wH5OmkqN0RTL6pTBXyOTkZkLVlVzeZlYz4YIl+HeeSpi4jCtlnlEwIvpGw1RejkWkRKbjDbqAS4MI
+2gbTBYprhPBbXIl0nSumwb9m7maXvByLrP2a6TveB7pk67RtbhptdyX8P/bDkmWksqITzj3NKFLad5S+
+mhigjErzjly/Yxbnssrr6cw8Y8r5NFc1hnzK2LjIkRSIile5muIGpYdGmu3UlrsViwrc9dj/0sQpemfo
+OG9GKZiD4Elzww89Y0JP4QpPPZfgI2Qt7cOOtpnBMvaxUb+Z7Bb1FL0jKPYmr1BoLHglMpT+rfu//6Rg
+7Ez8WjeMd6R9A8GmCWWuPc3nuj49rtKNp16QbRi+BWzo89JdhnLv4aflWSJ8E2J7Qs31y+SZgBYQaV2p
+UTDYJ5BFjUoMTec+ZVb3YTDJCIg6mGvYenrNCp8+9OghQQ+sZf94//VP+3vvjl/tPwOzhGNt8gUBNwu+
+BIsNT9x85jrX97NoKZN56QMvme34mbUfrHtISpOnVB/bLPwSA4cTPjjlcZDhPlY41girmOwcjednotbT
+POXVe91PPymk29/I6HflVtbljF1S4H0BdPbExPl4+zWGkCV+zf3Qvblh3DPwS4SxWa4b89qg7qLj6KK/
+XpsKV5YzmRoJASBg/NDUMth/yDjfaojXHN07DGxqTNuH08XG0Rx4zJWIH2E0M9FXIhBaCIJHcx5UVeiL
+rBbt+KKWM81wqnOwDm5/4EnPYZTNn0a/uy3ZGFuRie1ohe4hGMNkZHz8PECCuYGCm98oIZrQEF5idZOi
+L9sho/Gee9PjZTP8bgve1njjajear1VL3VJbc503arcfohVun+zT46aTzSDAmYBfbjgZTDHtLeK7PcWj
+GJSr6WOfFXp0esammg4v6MVLjXig8/oTqj/FvkqmlAdesbnJSKtoLBE98YOXnj2kkp4RlCtMeEUKnrro
+RQOWEu70Utbw+927vf2f3r3TJe7q88Swl3JiOntquvIW5HXWMaPqgTp3/qqG7K8KSzBwadIvbzDs2/bF
+7Uwwbqe8zxaKhDaURJ0Lk/GxGphFqwZeTW0CzIcPuhgKh50b1j8UUadmvt+GG+ilcY6K3KP01g8cIszC
+OkQZ7khfAbooSGrMGes4p3xrz4TlUBo85fsN24swu3aUGm4YxJhtfdXayxVcKq9800c58SwfrcS5KAuh
+FBPvAXY3ryk2kbyiC6wNdIbVouXSSkCltZebXedmwfkDs7PTeo2JXPCCwSBFFQr8dS1mc1IqeJeyyOWO
+Fp2z6wtvIwO2T9E5jWVfpYcMZ1T5xDSuMNRT8Dk5+2H7zCC3ON25QViz9z3noufPsb0vWctZeqviJZlc
+3DjFuFR/94n1dAQuwsNa2a1Rwu0XA12uGktBlrGuJBxDjfJjjWmNRfQiIFVLxrWd9d1nPDEstxkUs0Y8
+T0lokIYn1SIjYXorNieimoMZ223j3tI0dEW9AN7RzxZ5gVyFFjpuICUabnk3XuWAvvPMu+d+mocQu+Lv
+ugT1QQaU2b3E2eWtHlw0/+g0Fo2Z4g4d3W6RYLfp3YumnR3QcU6x5vRRX4nzRcErE4uwsxEvhvYm0mze
+tev3fNeXQa5+gnnWP3EF9j5+CD668zc3EgZhw+DqyVqynVjkJI2NOfx+WNICR5ZIhuv2raNpRdxnV8JE
+Yja+T0QH/RIIwTcTFaqJNcxtLS2vRW7v71naU30T0JRI6WbwSkewtBYFsmJ8omMiKhFZM+OKMEwa/454
+tPNUsZy6qSwaUOXNfacT3KHSAPfyt6PdnysPihhQ8XrDFkaiwL+8Vvty8c9C43BSqGTNpIwrCctLY5Nu
+rsP8TF6KVbUayR2ayXawsA84tobgtu2anxjY1QPRLECVNy+IUDp9N93cZMeiXsztwpCl01AONwL0womE
+U53kAHRYNWW0ggBjHwinA0DB+wxcAT1CWwO2/36OPh+ewGlJdfXCvG8N5e/3Bq700wHTw5gJZ5fbtf7s
+1nHFFqJAiZ6MiWUsuABbW0jeDgju0hra07rZHdYu4vgFAW5aZHuyrH/IS10MsxJwJ/09eEdR1U22sGYP
+4EOzdbcCA9buF6MZ2l3Ukur1wqhYeh6C4r3EiIXJUf/9xLCPeoH7SKKmGXGsxHs0hrr3Jtchn/sZGWvL
+ZR3nY/DNtgIePigN4HZYpDU9CR8aj0nY6HXuZoZ1hEGJyDEM+JHdaIaBIVbEAhyLz5YtzMajdXcR2yyV
+xJpgYR7GureRJeFGvVb+XlBLLwwZFEgXb0ph2AtQNhLoX8mRAJ5K/qoCRQDTMS1LOgdAYeKbg8zFONAN
+hOJy+L10l/qmQL7O8P32g2dkHW+BPZeOk+vXI/dIFF1T+ogrghxavIenSgpv7IgZQmfGgaAozNO7sYmC
+ndQ3+mHYOnwOn6vcGNx4m+pWF5Ngw8V7eIXm18vYOC4bIbbWG/E1mvKMnFJv04o45FeDK67gaHdSDNtp
+3c0ydimqM6mE/4hnzN2cvk1f/0Qx1/c4bYoYEyS3lklOq0PKQg2CLEbp2X68we5BaTHxApkclugtMi7L
+HrHOuw575OGBNwc+mITm02CgtrutStm7pPpfwntqSoYOc8cyKaX6Yqlfx11kbSNS0SWe7diWNAXS7Y9U
+7txz9SSUMTfoYLhVNPg6FMeCQDHT9x0Oy39QkHlZNl/YB+QQJlhe+9TI4Ca2QFgarfu2jS6vau1l1DQW
+8Mh5dgM3ani8sbFMnabmoJyKKq+DufGW3CeYFFvD7WflE2nGPDLWRnI9zZjf/J5msN7oJHlooO4iV/or
+aXxsjc12Ja9gbyF0gXZ0DTDZYtAgY94mS8FOrNMCfTWcNFdstqi1gV2zKLQCiioxlmWmyyaxr6ASUgTk
+vnsI0Wa1tC0GH5AlxWvIERQuVrlB94Gd/ZzX05dyb0Hh9MTfxVJ1ZSkQvZU4yF2XGLT1wQPsHPxDxwB8
+xBOAetTxkiCjn6SVTitb2huyZ+TdiNlYKcvHej4TOqSPbZHeoJZ3gxyal26k9IF31bhBXgg/VufHiPCy
+FKcXYknva4uSdmPmPa/dNFzB69lJZdVU0W5oYhEOGQEQhvs4IOepu91O3mZ5yea8qvMxaPi0kZ3Ois4T
+ZFN4JUNikXdjt8dkFT+zd3tWVKWdgmo43YOAGnYH9LC4JUBB7V8HLsRyIyXtp0e7Z+U5lw7txz/95z9z
+6EO+AMcMRUflPXHS+UUOiApo2RTjV1+dk61jXoV4HzE7crCoshQBHCpRJ/40SBeBihNFiC+FyGeu0PN0
+H6a0nrhGGA0tAgEkKr9EW+EAIa1dvA95kvHtCNiZLtL1ZSjOdpgsxQBUskuCIrNNMhFoIN8ZTeHKfLC5
+eYwGaNtyFmjmIO9Za14eR68Ze2qPlTt43Ge8t16+M51P1znGE/DjlmF+XsoqsQ7/r1yG8dVu9QocR7nN
+nN+0+FYvumC+QOGFzyjI8sytzbeFTwomNgOzVxd2NZX2GYHlip1LLd6dCdL1tsyMLnFoWxDmb87KyVRn
+8MBtvJEGMGmry8MMXdvcvi7izCKQkFH9GoJAwhW6G+avR9hoGH+ATw6w01awvbJA1LjVmQ34smI7Xkgr
+ZAemNn3LdXM8Thu1uKLvzkgl7Vd6ZtXPmw+ZUEVe1o+zXCHGzVkhxxeP1VjORfb4Es7nUj7Go4493Gw0
+XxP3fNauUB7osV+tVMSrc7Vz+rb/P9+9e7KzBf9s7dhVbax5IfFbSMK/wLIXig3mCzXt2synkOasdyEy
+kaIXtrpamgUL099jvMQXC3+pbbMr4yueX4piiTc4R4p4GW4hiwxxH/bgfeckvWhUt1DC4AMQmULUHYV2
+UQNPRW7G5d6ON3kUwcglhEfDx1hhMQ94Qv/XoYEZmpd3dy/z0NoQwcpsYcNQQrVqx5U092MCavqrCvSp
+wZLd8JWnQRBG73i8hzNO6+G3HwZzmzXw9bwxJE2GGg8FDSWGUIWFghcB9NoN3sryknHENnus3elgzGJS
+V3wJ6wiLj3nJFJ8IRBND+1ykDtQ+ZqidZOI2cCp8XSVCvoocRJ9JXkrz6GJh0fU456pCSe65qoDPQWE1
+bYdiVlDQchHLAkWJHFCzuevtUGYPijYO4aLAWX/sMxz9+PzbHcgrD07lHYKhVSrX+f5O11XXvvY3Otif
+q/LFF1yvil4Y2mtm5nQVuVOvvA3zZQsEqWwn0ffuRmNvRGadDqd1gBDorgTTTUzusLbzFtTMA1Aze01z
+O7FpLX1tIJnJUHZeCTKV2hOEVyIr7asjysVMVLAuPTChc1E3kWVwdENlOLqTRaau+K3UPzp2wXW2PyrY
+QcBnO39VA+gXyxXLdN9ENoDDGp7pCCC4WLJaarWpgwR1l3Cv2YlPGwkOp5Fi6VJuVyJJ0R1oT6fv28Yv
+xBAHY8ODinCxUXPlbMH1TofXElJi0rngdKM+NKh+2UVFaVEwAMcZL9lE8HpRESjeBnvogXGVsrYvv6AV
+MY5gM5kJg7H+zOcgMXwg2enF0IFeIAEDhOWmgp0JVLRoRAX7/nzFl4pBWBjkWqXKVW0RDHBCwZAfO2IQ
+xUhiWOinbIs910chSVKQGBSfBh660LzgYxEDapXiCj/1bSAZa5oUwQUPRPlP+Pe1R0hPsyHiXdBjau30
+numcmpYtGPhu+GP8jELga4stJiucTId2F2Kc+mhlN4EX6Hx92ldke3aVK4tcMK9kja/h+vckD8KNq0Mq
+n2ASd/IjqZjUkNoNHO2Bdq/1I8tS5k8t0SLVwTtIEdkuOF6/FhN1UL5GX9xAxvurYrkyTzLluRsQlpfo
+bJnXxoe327Nj1BDvbA6HWcLZHFa/KWKQIJWDLxuwA/PwFNMrQQmvm8WUhGOGXtG5c7gCIoUgnCo0NDdh
+mnO5UOkG9plajKeMQ5cmasAOrcRYyPN8TK8AEYwRtrdNsLcu8ua9K7SUoIk4Fyhudnt4juy6KjqrJNDV
+k8h2EA8nFXOVUIXJsdkLlRvDp0OzcP+G/NysowaFBw/aUu7taOoWaH1gPclxCPPy3GQOfJQCNzxBkLgA
+ZF17rsJztRJYAZTjvCBXsuOgbAkytEfAoSo4FYthXOwZRZyh9yEH8hRAO/i8g/2v+eKsyMf6hzsvHECK
+aLj5+Y1dn3cfa0Ka1/pEfh/erQ8PC41946SU4urfcEL0YdoE37q65YS89gi5w/Q3nRCUrUJZxoe29o+u
+6+0N50WYyO6Mufs2w6o0KzdteJj8OjwFyDCo8EUogeY57sW3AzJxOIuwQw25zOnYE6RJhGTn+aXAi2PC
+vWjFCoWM7FmzDOvSozM60unnegeyHYkibtW6ufDuPO6wbC5QWI8eyoK3HH17cBgf713en2gN8xWEv7Hm
+knmECq2YdCbDZ0s2k+MLkr6dbj9XFv2VyJh8rJaMKyWqmsmSXcEBfC5qTz7b+Ajjqo8RcghwRB8rcpJ2
+1WWsc2ylB60YylGFFev2zFWL7l4cQ39hNJgqPvz/9/F/2NOeHQsxZNO6nqvh5ubkbDATm3hDf0y3GU1l
+xUF/veHZ5+fI4rR6hLRt3vDifXS1cXBKt+Gd8V51DjhLW3qobT9l7CmhbMBBm0qXoR3nEKk74HazUKjs
+Kzsa/tVbkMpzY0GcjwWC9whHJnq/RgmsiRFqVUcIkG0QMfXe9y3GfVL6ph/X8H3yc9fM1vbHLHJyvCBH
+QpxZu6+wJMH6EQzbslMJ7zkeqh5sJCwCwy6BmYV9Ye/4puh2wqKODd6BnD++ME9ufnMdE8mVA1Q84xmb
+V3xc52MxwL2WZ8JFe4E7Ol3YfSrmFCbRndjSpShzAZpwL2s0Q/6Ix1aGH2cC0jp8ngHLvV2A/R/kCv/t
++nl7gS92c7UMg4gq3IL+ywr7dCsHa3PkPYsvC0nHtjv3yLOGDna2n2nbKXlZ2i8MJk1cpcSNbm9FMV9F
+GLqc6UaFwVFMTGkznynfR3rTxOAyPr2euXdssLXwb/AY1rk3N9mB4S6C0H59AAtr8UiyKkjJ2p/K4oBp
+G4u743hkIWLGivLdXsMr7JYMy9v9nNX8nKwIMrSPCJ73tFzvdO/ktp0rJi/6jhjhpOV1hyQMso7yhB+E
+dMXkUiKOmCjpp47oyR2pWa5qDtYMkl2JTlEg+8F3IHpehMIG78lZuZq/HB17WJAqyl+FlVBz8+rpv0qu
+NWUrkU4Gvv/D7hwivaF9qnPZuW6pz+2XiOO01dxaYN026NVzd1abbk0V6J86fiweCu+bEsmRpa6K+dNJ
+mLiuqr/n+xzecnPcXWC918YBNf85Rj0YXQ+DY0ajllNnTWjcwEAvSaPbG7C9PMPdMRO8TCO2dnvfR2aN
+U6EtphWbTyuuDOrwPxdCaVDTMTd7zSr38shm0Log1dIeh/SS4CvIGh4ILfqxBkDIhpU3s8VYIMw5ECtr
+luHjH/hcLOYKLjFK1Ab7UiXDEvkIajTIBoYm4aHmVgI+wyfn9NRReXvjDvUzR6LPddTZF/Q+MpXyonGk
+6kO4YeN+hyVeV0v7d9tLUXBWmgej1vc5d/lgY16Pp6z7PpKPD/bp6WXC84LJkoXVsa5QXzxW03y2qaZ8
+xmope6kx0gstGgTSZpEkkctyqGumDyhYeNdvPLm9SQ7lDzSxoSy+cmZVTFdYPbcI6BqGcBxubX3Zb8bx
+HG49/byfjNQ53PrsST8OyDnc+vxr99EF3RxufbHVT8XVHG594Qq44JnDrS++6a+OaTnc+vLrfnvwzOHW
+l46CH9VyuPUVlnNRKYdPn+IXF39y+PSzz/s22uTw6WdfrBO79vM/Y9f+obFrHbb7qvi1t49/um5Y0xvD
+kRrbFPssrXFclA2lYUNF0N1ITmJDFc+2JOx0Uk2XVry0KV2c9UzygUS/o+3Se/TCoCDXUju2bJjAFgO0
+4tKPbTUIXhFaumL5DMqcFcIt3NYwKEFZ82qez2Yiy3kNUrtW1JPB30PvgTGsFa9MzkfWCy7Dl87CQDBZ
+ZFp8uLltLvC/DeBBTawWJVPLcjytZInh/vsbNtTVEmsUl6LUILdgPASHFcow5/hsPWA4iLBN9FonrGkD
+3fzQPV8QHoMeF/FejBd1gIyNbymSmQbSGyzVjHQkyLu14yE/Q0keyFu6CZlPp2+97c6EERV5jQ+oBDrN
+8lLbVLDJAq0TuqWsw1Hp4btsRMX6tCzmrJYs02vLQ6mx9mQYbFD0WbT4jbmHjrWqmU2WTyaiIgZhXnFz
+NXhoxUx0jbP9tr4v3utQ5QXgQEc0cr3GpZ+SefvaIiOnsDJha0nVGMWqWAogSosFDmahDR5zlbZT++Ce
+BvRzHc3yS6Cqvxhu4nWuli7oKCOhzbyaBWV0rCfUKejrNAkBeqtv3OZtTZn1557W3MNaaD/xcHOj7b3I
+BioIHim8vsfPax+JNeWTDvVdHz6szufcp3XOKIsNTMxYx3RK305B96B8td5Ejx628KwQyotbISvGHaaT
+qVU7NRJnW02rHcXqhs6v2/VUx3t3cwK467U4aMI9f+ybow+sd84x5BIvnUeZUa6a/YQjse17x+bmsWFB
+ZldjYW/InaZx3PXGyqd434TKb70dt3VfkVe9+ga2dM+hxcoFEdFuhPooN2bouR98Ia9ZrthFKa9K5A/I
+qSlwT61DEFyR3d3DhxRxJC91YDQPI9/wNhh4hODnZc1qaQAwcmUDA2FVRBePmkyIuagYV3O9tE3cYC/W
+AE3XlCsd94kgVv0jm6NGUPP6QJi2jJl6zUZJPj8it2RzIlJeoJEMCEWxX5qGPaOYxd/IUWmaWK7sMX5H
+nuqtVZ+thiusbX099xa6fQH8TRbonjOW3H11YM064W/S+NaSsFKYLEnEzsc+qKGgUxv1xEDvSrArXNug
+ACa/eV0BrKMZoMl1tDWoNfXWpv9ihk/d5nSmuNdoHOqbndrXxyvhkBLORU0gcbA5eFXikxzqoU2kNxL1
+b8kb0dLYDhCOCVkrE4L20SFEbBmy0w3nuqs/Wj1ZBx6kcbwGk7zMdLJvsm5tz7CEBtr1TBstdfvNEbeD
+MUPN+oKMdjGmI1vMo7CQsEfmgrxA4D4lVK1YXoYADs3AJfTWicI1m4kZXFQLwS9U2ODQQMe22f+caDZy
+IxodUu8yfs7zkul3ilrOGUbJT9alLWvjuvBzx0N2oGd8A9OQCfPe4OR3ag7rKhEgMxjLgPO8ni7OQNu7
+OdG6A7IT2MyVWgi1+dnTz77shW10FmSn3pH4qcZhQ7/o0QKFTeDp7n62t2anGbN6yj7Ly4l12/fVhWll
+YauNEfNp/uojyyescT/eYCTh4oQKdpQvQgN8lpdsXsDw/W9+yY9xA4QsaxB7FjEcldMnbxMft95GoFiR
+qadVXVqpJvH8bVSOCR3q3TWo12YZWBX4pDTq75Bt+VbRYUrs0EIUPF+WtuWlc/ajmk7p89vYB6RFw+p8
+pNbWst5C/3iTfvGLdfSLf6oSP6Uq8YdKXilR7ZeXeSXLmVUubj78BZ3TmLjMiyGaR69UOu4dHR7sHWHA
+NzzcmorHKIfRKWIaHqSJMvjd1yzunqm64uNaMX7FERjcMjde5PXSqPJMaCwjhGjCJMcXIjsXTE6M8uaM
+xsCG9rROLASVWBSiUp4tfqVPMJH5riZiw8RQNc4o7pkkdp9pH3xjt6m3BoY8r0RJ20sNnYwZjeYgA7G/
+QpXTq2RZfLjRRzKIPADWcMiri8X8h+XB3nqUX7cVj2LXTRj3kFaY8PpnJ8JdcGzguDD+tY5eT+/XSzOi
+OpI+vSQRVjjIoCbAYD8UeAt70SJi80V1TrDKY47ayBL2/cGeeeMMzVwNJIMJXkby18He80rOvDnzzlcT
+NfBgL7gQ4DIeYOUHe36m7RufvNqXyvaGz4Dj3TXcoicdbxsNt77cQjb75Sd9xvn8T957R97bYLoJ5rrO
+04zOqPfEDklmgY17osaGhfiRVr0XS00LnZL9vZsJuK6Qvbjg5WKOz+cDkNMruChe6v1jGaqoxz0AaOZw
+cx+yXZuglqoWM9qEyoaAJ3/GjMkyDmN8sEfoQ2QQqO+n+DoxnkIbQW7W7wGeHTMqYsvA9231PiaN2c2D
+At1GNq+udGhCuajJiJvlM37uIgbO8iwrhA0vXU/1+osdKRIc+fat8cIeookJYUlgKDStR1ED9rMGn3jI
+Cl6LyovDdbgo6vwZBU7zfA50Q1tOJNfKFqOANKP0ltWnwSO7Z9Z/P4rJ07T9HDZb1u3hRjf6P/0igniB
+aUtRW53D4GrdaYO2BecBZ4s1st9cUetiaqmpNf/NVaWXQ0s96czbkV1I4IV3vfbJGB2Ja95a4stJ8zLy
+1Z+XkU98IN50HsIafLWoBDllkp9Uy7GopugCsA/B4cOT0U/xbw4HE9I4Ra4qNqhu8Bx8H7xt7/eZGJwP
+rM5b59PjjP2rEOhNO07ZXkeeuX37wI2jjv1Cfs1JjU/W/8jKfaCWMymVhe44lKqOm65toqGpcR/sU6o5
+e/VP0lDdOOpshzIzO7CdG8t0ettUqBH2tvuroWaCs57eSO1t37aY6c7FaqyHobbn2yy/JH0S6Dt2fnWW
+IQP79fq7iZTfbmb55XfbhsA1/YEaIazxpQQtJu5HPA/G5G2v1xUT/1zQTVOfuJEPtrHi1vinzv7gPflW
+kzHdojLQPTgk+CKEk4mi2+NSnHOwmiazGXoAAkrmfX8MB/kRNI6K+57WysZMuhRMoalvc0FKJIeR8YM3
+vFH0CgVVN9qNlPU7U9vNNrmoYNbSVgSBC6kOt993kfVD6Gp2z9/jXTfRfRerv+dCdOF/iSJ6KFwl7i3m
+hhMn0Tl7GQvYz/Dp55/jWfL1n4Zzf/iNK5ABV1vQtdyXYu1UKlug1nLgU82imBLm9oxPU0W85KCcNk1N
+FNEpqdw/UXwPWbUXs1mC8p5ta6KolxqUsoaviTI2LSjxEjmg75gdl4tyBKVfiWqSKAKfw3yxWW2qUJxn
+JQW03V2DCuYLKL12OstmcZcYlLFyd4udp7L6ATK2CjOGBtKarpjN6yWlhLm9BJN3XcNR5flShFsnFt7a
+8q1tgmqZKHoMQWB/HG401d4Fp+1sMYsRynykGQ8eTBuCmVhv7yjLhw/WscCCzvhO5hQ3LEYr2Q7iijWA
+eDsE6qN118iNte2mnLCRiSMBaF+jQScJ7dzp+Patjxv/oYc6bLXHtA/R8tYyRs/rvllUs9bHvm/X0Lnx
+cYNIo2Wgk6kIVDAgSFSiRlhaPKUYkoo9kE0Kdd/8Oh3rS2Mn8MNXbylDM0tMVoeIBJo3lTEgNV6+Rpon
+ljJGC5W9mnKFynnzPerea9+msktCJWkYcrQsE2VtDAZ7PpGkqOQyNOppZojH031tG1cmrQ2oFhvn2Lu3
+LfVa0x5vYOJmkT5jjQnTOd82Jp9lQtWVXIrsLa3Erv3QGyYX3FnBy4s+q0QhuEZ2p+sTLQeekQD/t2dG
+cHj8Kf8zb2j0ZlWyvBxXyESAdYEy07N8FXw8dcPg25wZzaVxIdUyO5UqUXAne26K92jsIUc+/x8ZzR+I
+SvUyBSfiQ08jAxPv6cUA9EZsy12h/1chykyxX1tkKmeMcJ24FQRZ09hx5Dbgg35IcgFOQJakAEl84eWa
+CfojAW5jMJcceoip09dQUnFrmYFwEeGJwHZMJdteHve8E7igUWLuIKQ8uAcMmygaxmNeOW0n5CqOCesM
+x7Up3J7Hx69zrwWNbM9DgznK5fcT+YrI/KPc65MeMCsBx43BxX1UEfrWk2CM1ImcvwDzmqje5jDRpbZh
+dNfsjLG/8yGuI2Qm55auQvjdvmahCuMtLuZ9zUPOc1XDVzLFwjippaiSYE5wxyjPr/GedLCHDx4He/at
+Wsoa3z8GieVs5b4TZ0v6AVOO8dGF7qB5ee6lX/uGpxHOzvemKa/19OlOaTv4vFSiIpsdjTa5d3Q4WGcP
+4Xx6OvbgTfRgr++3qYG1Ey+WACYlsV48FvXoUevmo5q9WEYEGmQc//UCoau2A6BqyH1zD4zKUXF3u4CO
+/pymZHrox1fyt0/qWgMipP2B+rTnstIEuz7YRFRZgNHQRF2gTpDCzAO6U7VGn/Dcrtyg9cO+97Y/GhcB
+FasU64ieo/KSac6ir58egyETZJ5ljJdM8KrwUAjMvQBtpyW6nbFMnC3Ozx3sz93t3WBgjCVgZFnvrN6G
+7KWM/em1GkN7StMmFB7+TujobU6HITmaW6WgrM5lXYvSi3tl68l1wKu0EzuTVUiLj8d5JsoaHxFrE3ZH
+dw2GVZ8wATkChTbQuo0wHQojnTVQoAYBRIv5GHmou4QENvBNHutkoeMsc5So2WJuFERj368aVw7Bn1nU
+HBjYDQusAZrSyoRI12ZGcNtHkxKgjBbRi7kLgI2wVoMNu0CsH6LbMdsu1XG3YBN5OQBGk+34F/DgLPVk
+B/jTOxRrWVF8Oq0ddgietgw50kn9kXg3q8S8EgpWgzkpmsCWStRdoELxkj5iy1MnYGsdTHTf1R4qtFcz
+S3dYfAIwluMcBkNbox5bk3k/RCn4eEhYLeXSxu8OAbEcOQQ6xQUy5qV2sKhJnJ/JkglQOihiVQPysgRn
+OiyAu7H2AjhQSVxkkLHPeB3Vi9AMfWug/vEoGrjqYvQuT3GeSk+invRbw2Wh54kZWlmyv6o+401zYO1M
+EKFn5ASAAxdmtZjPJUomiJOo541emTK2QKOHxstTRDBA8PADO5L7TBxt8XuPfxncLw96lt8IrfFJ5sZH
+vUnNzY2oOOHcBOT+jebmjTIT4cENeZMUgDYl4IH/oAmaa62u8kbZfgvGty20J477gAX9D0YmMRiuhn+f
+kdBMOh4M//PtxyMYiPbFEVTy7zAkFkgOByYBKMTuBT6k/69gFHUTM+FDFPVWSnwJ/EkcYeVw9TB0fgzf
+5wImelg0plwEzxdoKq4/ErjrUyEkAj6iIu8qNIHUom4tV8AiJkZ6JRbib4SE+Ptrp2CVjMmK0ROwL3ml
+bzki2zdKO5tigO4dxDpbH4V92wnOa+TWCoPthr8XLV2fYdg3DLduGWvJ0u1tBxipU1GSSiYvz/tJFI+z
+JRs16Wh0DyVqn9yoZRpHVlquq/z8HBVReBF5TGMd4a2mifi9i3ZmqKLxCnbdhasfXK96KaxT/Ye/Kizx
+c1E7TYOXoRsTjFaPJdCmrej61RlGxlCHVixDHPP1lk1zkQbcsV0r618f67zxtNpNd7C/QrlEEGl0A2dX
+lTaCho+B2glR4Ei9uBO/cQ9ChWGoymr0wjRGKxNNZk+lGJRHOI/UbPaDRWCBTNP7z7z5+XButkLgrc65
+4jW8KHV7xt27hRAt1fBQ04clDdN2KmAAvmApvI86VxmwMtIxdZZsFI7lKFBBr9TaLsqwaEt4J60YDPUQ
+niqgyZT0U14YzcWtYJ28mtG6TCGrZbcoEbDbkOGmeKkm0PVQ9uIde8vaU31OghLG+yOemm7L1uhtr/8o
+gyiPStTW73ySiyJTv/9JvdYDzQ2PX6EWkHrCMslKWbNK4JyhPx69bFTYb8LPrdG4k+QmfC/esPoc8HUz
+AFuE3rXGW1biyc9Q3BOFIKyFleo5jbGwUjW3uakfeMkQ1dfkKd1JuhsVS3LQQTc6fJ7SiOgYR9T2FUAD
+Aq2hVg/qpwHTtkFaL0huOF3N0KwqUs4E4VPYKBZklFqJAu927tymhlll6rKj8Z03LN65fuEH9oKZazbl
+ZQbemL2ByUWumoH61c1OmK4a8n2Y3pzfOKoOuAwuCmG8G3mhH9ftyKEShixzF0rrQ+yU1gJH5d8zAk/j
+ZHhn4BEOdMrasXcCzOtMyHmdz/J/iYzNeT0dsJ8FM5/0Az/C7U35JQqP9gliEBAbm/glJRPv64q7YQ2y
+owFbJKBF7OPDh6RIs5Lh+JYFg3EhS7FbZkHoGizNTN1GGtHBY3697ttmapk1iDzkiyH+67YRJzzkmRg7
+Jlyhz/MCn6T18wcuZ83naqnN2ckwnV2IpQWHxvdeojDydSmjFUtV54ufl3VyiwvtuxlXF1r48tZT9BpM
+YqO6ENmzxpZ0K0KzePf6c+7AiRuhbQz8ZDA/EJhlUbMr4UEuJeBw8BDJa6RxtsiL+nFeqsFGFEWiXUzG
+ezM9u3cahn/BY08YdsyfCbbTXkGgenOy7D3/87r1xsNusMwtooUmamAt0nUEVE69Im/d237w2W+CFYg9
+Gr/DUierDgrOoyw0/xL55iVc7gaffDOEJgO32w/6nuO2U3RfvWO8xU9hjbDCHsFyOYPL74W3TawjSwls
+Y40BtXL1MJam4KPGBAPnJ6RtuwfeVIYNvr79Gm0sExqRZ8nVcsNiadNT+MsmIL/G/S2lLjNKVZho//OD
+B8nvvmVzcLX+JF69oR7dIbT7NWn+6CknG4FlwgYPWbO4Uaua14m81FaVtQz13AslWIPiDW8Nd4tBc6su
+93ofFVXppm12c2v8neUnrrPjvOxt2847gUpz9CRX28etN2ap367HLevsQizZ/b+q+3TJrP3V1SDnr6Fb
+raJ+1PogOprr4/od6zXGXrOoplY94Ibhrampg2vlVP3kXLZzFF2fJ1yvpJdoapg/wbk1ho5Q+vbkxb7Q
+L9EGehnW5aJ00oX1tSRCJFDYcLv2lujJFWxPClofBDPO8lpZBOht3WSlyaFxHlGgrY8aeNMoZYCM83Kd
+mKK3O4F8S8lEzM+PjPT8R0ke9i39bmJHwgCA2fFdg/lB+bVkDUMzsVh3cY21LrBV+gbTegbufDqoNPHA
+WhqLPtPodhIqbe1c6F6SGz2sifn9PruvJSz8E/apkZ7vty28cB6GYeBOGnyDOG6q9HURqOE5ruWchoPC
+D0B+FC+UDbfnYsp7N0VDxRTVEUj1BU2vgpfk8hUy7ejiBBWaW1NizcGitJ9jEEBT1oMBpBaggZl78Qq1
+24EmJi9rUVr0GF66k4HVNgzpmC/OpzUg1neU9bEN6Z0JUNTIinHl3v6gDtSuwYgx8X4s5povcXZGULAz
+oRQ/DwIVftxB7aC5zaidmmF6GyF0N0Aqh+yvCouz0V/ViIYHJ3ib5bWVBZ2da58tFAUyCKOXMtZBVS4Z
+N9kVOohqDNeJPctJNRHlbfcVPTVrOwLFNL2OUDGbQOc3jlMvQNHEtcV2UuVMKA7zoR920duG3musgdoU
+78MHX1OPeN98uqV9QTm86LTozNkIs/mzCJC1xftamNAEFJAe9qLKZ3lhdo7SugBHI8B8xVfzPlOSHXRm
+zia4PGd5TfiDeVnLkIBvHC3ZLC9J0WkwA7Fw6YWM8Eqjl672UGU7K/1XfVNcN05m1HFaV5w34chhs19h
+AwUimSi2UEzZQL+1BGe68QU4uKCTmba0J/yCgNDHAcomIWUZ6zzneSEyz1fVrEjYxg3gWL2gBprhxIlm
+gIPPSRRZ8CBTgv36e/aQrtr6mPtNO9cSEdZFrNdOoKmXYXiKMEYCoVsPpCTUD/AGGptNpLTvJm+s5rLu
+QGs+CmIOeuxoGhg0bQa85plPfhe9XNGl1uuuU+FfN8EwS4TEGEVtHvXdJ8+kps9kxUaJ11PSxjM4a0Xd
+t09P03W8IlNuZCk3scYzkEYkohYcTF4KWOy8WnrLwaPi3wGS06SdZ6I4/t6rd7zsQtE+UrOs+bTjlWpO
+fvS6G14WPF+DtufxezrYh6u8OXOhmHdr7UwSlGOAUvFumQH+83NZHeLdMQteo264EQUXDt9+pmXrrB6/
+tiFfJzXWFa2cksYWg4bySrD6Spq7hOobDmyCgZOPhwuQBAXpavDaAErmisCeyAcbARwea3oMH5wgX2a3
+Bks6s3gbAymAU7NVhmlvf/+bmehG5qSSv5FLj0qTbrJ4onqP82Li38VSsR2DPg9PJ6lSAyWruusV9t26
+dm6jvoquSTl6A7Ocfeu1Z1CI8ryebrP80aNQawAath0v52n+9uOttxuDfHohliQsN4cC0zyNMy0bAjLF
+CA9YQn8wq1MvRNZhvm65SyqlId1KLhX+28OxgX52/6p6YAFSCGvZCfeYQD3dVUIM2bSu58PNzcnZYCYo
+MoJZx4/Plo+pRT1PtEh32KXf2OsLsXQ/vKXQZs7d1LfRMeMC85D+QSMgeAccWhy2o2tYvVvS+F3b1JNu
+hAzrdYzsLldqMTOvxzboTNnRnEJd5PO5yHqYXIkZz5G36IxFPhFjRFVpVqDjo6EjNdEKTXvCaGH29P5h
+aXR6fW0ZZII2KzoPLHAhWU1kFvI8J7K8OJdVXk9nA228IufTXNU5GQ2OixxJ4YUpV1NWSwz2UhmYwo+W
+JJKYDCBDveLRSdSWGcHcEpkDGYX9L91swE90Zo3hAebx5I3kMdZoF31utMDlflMmHhYhf5Swwdi6hpQG
+eyN6iBvHZuwW5M7k0HEvrckZWV8tYFrPRH0lRMm4VgrqBXspKrVQjBuAQDKNosQNp46KBoVknXhMHEMO
+G590i2+U9n3iHZUVLvpNCsY//2NELCiUmL2mvMpWe7J6uRhr72y/ka+5bBoyWsKifXOT7VqOiXpMst63
+hmo2EYN3eSFFnYH/5iZLiLVkaYjUREmBjKZcO8V6i0Wh4tBR8qswDElkxieLyQm74jmeXMYiBzpPwUEH
+Gys9IXw+v9ohws8Z40k+i1bbdexgZPEgV7oftFK1dHwoMwuL3HZBcI6d5EyXPr1spvY8SfjMZBt/13j9
+WgWpGh5w1jUrerRv8V0DYV1DKNiS/uKKPAbPpMTQW+SHxg79oFwmHm61oLihoJj5+Hf8ptMajLPf79gh
+/iYraVJtap8c7YiDHG/U179U7ULu+ZGPR4OoIhSx4jHdaOVW/TApfNByiyv41Hj1T94Y2xmeGbtQ9wbw
+JTp+XCZqUc1w3okhOWFQLy9WSgOnRtANNQweaIUdNYryG+PiGmtANZVVPV7UWthT1gaXqA5WOMawxAG5
+vZG41LKd1Chs+xvc2jOb0d9OOS/aiQhSw1oab/b6iT7B2aLXNdWA6lnDnOif2m+gRWXiMmr8+Tir71hw
+d7+DhAuDs438Z+h4pplB7E3qbWHT1AcPqHv6SoqXwq0GIcxy+uTt9qrTxbNfMNS/tyWZb7TeS1ySXZEt
+NjRXZr9piduysVrf0dW4y7Juy6rtHUbCjZ6O2Pcb3osUZSEgfsIOcfTiSNZs2CyZVMLZd3BvvUe3yEMw
+flCeiYQHeV3KGgw0gIMU4ty/DMqJ3tju9qh5pfJ3/MOb7ir6BwWmFqn7jbO/0Dua8mqHC2e9qkTNuDKe
+7rlomPz7hLxo2A0KJoR1a2Ej565sh56qwcffBm3diyb3T5qcJM+s+DrXUNZH51W0mBvnVOOMWnzUHQ7v
+TYn7mZ/e5r/efAEJ74CrPO5iVXNLjrVERBb7lSaPuYTrhDt4FqmjbdXBtupYW3WoebUvNHJw5ATnP1H0
+44b1WkfVYpvewQG05XpiSQ7O8jLTzNEumL5bG31/GfT6AbmbFeIUVFoEYS5iZDLgjd4bEmAS8vFYVnB2
+FstP/5LUNjnpl6Sk3EFDostFHp4J38dwS71u+G8nybW/TN7OfbwXKmxefwLv8fgqEblxJzrZT9Xdu8vr
+W3KsQon+ddp/fOWr0a0ctVdcD8gHtL6S7GCPtK98XJPJjrFjusd+WNSslGTVQONo3RLhLjHwJCVoHXpz
+xg6e25E7sxuTvfYV1Sx+o7NvkpJTcn20s/9NU9bmbBROhFvfh7dz87/R0Z/pKfgNV5FrQ0sUrGgS9vpe
+T9uZrx+Q3rC+FVHevOtWe2V+MMnbRf7qbqT2755vwUCZWw0Y0v1Jc62fySIQY1QcVU2771tcIlNr24Ph
+7N7dz4lMxciJmi9q+Xgmwe+8llSO8I/0q3GnMqidwDTgFjnwlJT6SpRoaqjbYg8exM4UVAbAjg/l+OK5
+HiDXyMB4c17JM34Gsel4xuZohDYWA/ZMh46yT93GktWnYcJB09tWrnwAzIHN2I4bkFCT0oCw5HnbXDyR
+SN+2dHzvgITVf3JJbDcAfNzx7IdZMRAN2/4uClLYTisrWQWDEuvzG64svlOvH8AlqreJBpSYkCTrXHMP
+dlcD0DRHIxrQMCBguiOfDjfLWm3vlmyUK+wjsLaRXSM6xq/bGILNJCz+OVwuqlwvufRAehY76VRUKTl1
+e+D9rZujf3YbBALQLsOrIPYpGhhHLNzaGhtk4AH7Tx+tN9BmVw3Vd5/xknFA32KyomDKsp6Kypgz66v8
+4BOBgn26afnoSbndlBjD5lswrhf8X3mxtDA7yiB4WLcbUi3JSigPRmvEOF60JqOVKPBiwl5b1I2Sz2IV
+jaV37W5wnoNMLanmqLIQ0CFmvLyu+Xj6Wkx8aHYx8Uy32w7nc1G/Qs2QEUP9u43GLPbwi3cCBGP2Pet6
+iezX655RbcIXMy8TdVqJydsg/k5LvdFE7Ymaogj7SCZuTNtn4KX2csmELbfOOGYiOY7hOTVRKwbP63hG
+6DC2/4n+/U3UjDM81DKhEJFVh9sJLvgmHnIJe84ECUGkbwienmsNW64xiY07hlUsGhcwM0AWFVLDCg7a
+bvWambTIeIR/0H6b2PbBDmzYj1goBKV7+MV3YAy2to+8GEONd/06Hjzwq/SzeqEDkUq5RvEyLAcj1ipP
+/03UHsxOsfTQhmLTIBNHz83yY5YPxIBdTXltrYHF+7nUIW5w5ZExkD4rTNwbI3CyZ7REoInkEFHDa71Q
+2odrHIV+jtZHeHxdex1xJUPkoJZFE26KxPLRNTavB3o4AfZ8cbbBWPtdl55jKYiwDf82mAkOb8CHpP2H
+BbMqSE1/g612vaXWxihqnfBDR+uVY7OkTvRF51shJHda0+DEvt7oNeIYBk02MXewb8OVPb8x+HJQ5Bah
+l8Ooc8OtrS/7KyMqDree+jnIV3C49fSbfjI04nDrsyf9OALicOvzr/ut8Q2HW587Yn4Iw+HWF66Yi1M4
+3PrC5Y/DEA63vvy8HwQcHG596TqQjgM43Pry6357wMHh1peuQi8U4HDrq8/6ccS/4dZXmNmP1zd8+gTp
+x2GvV4bcGz79/Iu+F15v+PTzZKTsb/6MbvrHRjfV982WkKa/SeTHG+MwklE4eid4AL16wv8uxFyxugKs
+MiPJ6Huke2zUE+vgfXKF+poZr/Mx3mHnXMH8ZvKqjKQhtGhW03zOprmoeDWeLoEaHI258k/cy5xHVjNM
+lm1MTjXCHVucpFDrEZmoohgUuNdj1DHq8dAf91BUOBGzuax4BTcRcmdUqbHSRuHivRgvUM+jxnIuMgOp
+6B/kuyDTwOihn/OYK8FmuPEKKS9YkV8IL3NL4G1M8p5EKlHG+hbw/dV/d3+dSDlknYmUnes+6/bYznes
+69fCmL6fPWRhOO/vfjX0r23Qbsx1vRrbwD1qX9khqiVBI7jAVh5KYfAGTeQcqF44mMz+UUtWLUpycyZD
+xqsWHKiQrX7AC/u34cfv3LLwxi5EWXhmdNphiz4Cd+HuBn16Z3tGe16zYYsZtaM2gEdD0jNhfGhYXjKu
+PWDRGBneU1MBIRBbWFR+fEQY7xicyGL+N03xNoIGsx20+GvY5VAg/0+qT/TMeyJqfW+R9lKqP2gL24nm
++dOo8K5DRYjp9fVqaU8T+EgRLyWQ3CBbfPZkHdniTzHiU4gRnhCdlCVuPrsp5rF/cvsJMJjhKX01leYh
+Fo5wXvrxSbV9Z4XjaTGW2ZnQPJsu3/7cCeA69ZTlZYZuLmwqr9jMUg0DiOpX4jPr+keHHSvEpSjicz6M
+zL7OYR9dlJuHvruatmw6r07Yef1fcT9s/Slr/5GbBJ249CSjr6jSMXsey7JYtorgv1+c/g0Ci5ynxHj7
+GTOa9YDAunAacxpyUiTiV8V+PDl8wWp+buwZvajBoUYV8pzwc1IadhHwZ5Tll6PegHJ7MYbt4qRK9o4O
+n1PV3Zqfk3Bya1lGH2gtntJYj1/Jdhg7PYT3TeS+joYL4/PoqAeIAONCYJkRo3AbZtE4bH4TH2tgtxJc
+SAolG7cSLDvYOzocuUFHrVnInMBwa8dNeRcGhA9Zh6MyiZ+dVfDj7Kyi31lWCaXgE/1FXyuBRSqhS1V1
+Pi4EfsK/6Cu8NsM3+Je+LLIchHv8F7+cDVnnjP7iCjLDP/Q7y+FnlutfEn/pUvk5/MrP6Vchxxf/XMga
+y9sflCazJXyV2ZJ+Q+90384WdS1L+I1/4LcxLy85dJf+0N9Qh44f5xYhaJxjffAP/ZbYWfhH/y7wZ2F+
+nVdyMadP+Cd+z3gNIwn/2N9Frmr9Df6k7xl8IbeXTADlTBT6V83zQuEX/Iu+TqC92YTamuW8kDBk9If+
+dokfLukXktQUsXaqV8yGrCNm+u8zkeHPM+2AQyD6AvKbP/X3czdo7odJW1SCvi8qGquJlLWo6NZXi0p/
+q2b4paLap1tD1plu0d9P4e+n9Pdn8Pdn9Pfn8Pfn9PcX8PcX9PeX8PeX9Lfg0An4x/7GuukP+oa/9d/1
+DMYG/sHfsCppTeaTCp8yOvQHfZvBMOczGuO8nC9gcPBf/UXhb5qlizNoysUZteRCLM8FjBj9gd8Kfoaz
+jf/SF3EuSihGf9A3aFSR67/LC/xVXuBv8D0GBTPPS/17jj/n+ld1gT8rnVuUC/gtyoX9nde4Csyf+juu
+W/jH/MZBxH/xS8lhfZWc1lcp6S0KPuk/8Ttdw4cBFituHL1v5Lw2+8b8ab7T6pJuZclFTcNNf+A3KEgl
+8ACCX/AvfcnHNS1F/Rd9pS/2lzzXDND8id//OWSdf+JfFVRRUR0V1F5RzdXiDDgP/IO/gQSVVXwGZeAf
++m1GxhsXJca6g/ov/bWg0aI/6NuMFzBg+C99QeEHPuEf9G3Okdica0p1JUtYqvSH/rZEHo7/0pcF8Ge1
+ONO/ZjOEcunov/RX7Iyelxr8qoesg//SF82Ca8uDa1i9Na1cuCjq08T8Sd+BE8BH+Je+TOHnVP9Nm7i2
+u7jOcSfCP/p3Te2Af+kLLM+60n/z8QX+5GNa97DoacUvYDAXNJKdS151hvQP/Ab5Ds4h/Be/XOGBcoUn
+in5m+ulveDpUdCDSH5h3XOTzVxz7Yf7UHHxC7HtC60MURT7Hw1D/hV9hss7NDhe0w4X9zau/VTzL6c0n
+/KD3uaJ9ri70bsB2zE0b5ryuRVXSJ/iLvspieY6rUP9lv+o2mD/xO9THC68d4QfKQ+u3squ3lrh8aqn3
+wiUuystzuzr0yqDfehnjvx245TSEwl7rDWnv6PAWKok7PAc5eXn49LMvU9qJp39qJ36/i9cPKNW13rF2
+F7V8LscLha+X4c0nTAvuWT9U8kqJKnz7TFy2kvkCSghKmyiJ31N3u9U3wQ2Nb3OYa4BEL6/9bDOSyNsI
+VRLcZYxcbMrM5EKJF7mqRSkqfIdkO65GvEjI8lmRA2utqwWa+8tyTy7OCtH4fAjE9uRV2fh4KC9F4+Ob
+uf8JqT3jczq+k3UlUm2VbWlQc1vam3mQsnHt3YkPHNYKZ9/SqH3HSnzojU1uMgPerH0naFQpXpECYggm
+NcpyBSdoNtL4bYPGJe6HYAYpRrIXLxmnwzNTGbJOWFIf1+c68Yc3JydHL+kUm8FaVUN2Gu6D/ood8BYL
+tj0AMYNoq3SMEIM9AkzOYRBvGyzYemrGpdDLDS2sKdiKGZrQxxUQj3Jt/zEPMR8sHBt+j8FyL8SyFxpc
+d+95uU1tYNJzr7kDENgowNnFYvjZGCa5L6vMo2nZGC9qrwXmUatnFO8rjziaXNIANjnZ8Jut/moONdza
+8gwlkBUNt55+1moh4VjL8Olnn+Mp99mfOsc/+Oizc3qj8nHzITsv5BkvWLgthsDk2o7OZ8fHJt/RXBDg
+bHSSJbOYQ23v6NAkhqW8hETetrqSWVKH9j4w2f1ZXteiaj+y/VzhgW1G1WR0Rkdobd84yNvzB3TRITTR
+Hvwe5lwUdY4vmansNjEoAyZFidzw2UoD65t8qDGfixPjTlvWz2WlexeWXpHR0HKI4kFJ+9nmUzgjx1Zl
+GmaPUk2pC7E8mjSkoKPJ7QxS0N7WMHzDZRPrZBDmpCro7DqRq8qZPFSiEue5qitr6EXbWq0i0FIEhJNN
+9lwCukY+viiWbMbrMboymvMEzVX7rJasFqqGU1IzoxqliMy68pf1gLb90UtgTO9O/vPV/jEc5CZKmRaW
+WKdczM5EpX9f60E8PvnPF/skKR5Nur9qLQO8Z12bcd5/sX8IlFFND+TZDtty8tXR3CLR5yWsSjiRmXTs
+AKQADIZhEPno3YUEph/4+EKU2cFewD80wsfmw+B54vsoTIH//EChOfDaR1BRnpiBICHeb3Oqm4eDzU32
+UmrPaeCnAO+7szPSsFmaOU+08TSTlfPZGGw4sH81yHh5LioMD3ss6oOyFBU+IES4Y3d3pQlFDqa9LKyP
+yjNeUtgDJWomS+qIyT1CJOKVDR0NUo4i6UqNF8ZHx9NfPXRRmHrT03fvUONrwi+0lrdDc0PHreuOgXOX
+1YyNfqVqhmwwGFyPfJOWziuM48wuc5XXiNCpIohO293HXp2PlYAUwBQFwj5B3CSyghZA5RScYkUw/9sP
+29qD1rMvard+uburDRK1J/faH67sPZStChe3Z24klFHuJlEHZpqLCtlvFszY88QA99FSbjFnmRxjgB78
+yiRc8W7eIh482F37fU9vLmLk+1lOzPPDh5u2+q5voa/YKKJAAGI6ZKLymcCMl/ycXAm8waHXSXZQ0xhe
+saVcoB3VXJYqP8uLvF6yWrLzBa94WQsNIFhqJuNRqqdSCVbKTOgotaV4P0eHYzjlZEZxlWTFssW8IPzS
+gX059ehYrzOYUS/eSWr8rzduMQMRH6JxpmuGD/7t7zKTGgel64CVyghTR5iVUV+bD8pIwCEAsVrqiFN9
+2+cOdJQzOrQHKByI9xx0F30qv/PrrzNenefla7icDJma8zHU8Ij9oyNm/+hcX5N2whJcKEj+38f/gWOW
+Yuq37XaPXs/toTtf1Eao6uZZvyEh9a2GIAgtcEezAACO2/8arkKslGz31QGeyRRLeozqH4OeCyx8JMvj
+cSWLYqSzgG5HQwBTsNgzGxL8rts37i8W7ZiKOzCisQTc7ShK7KMY5hxMcQ+caWkd2/qPTm1MEZI9atkJ
+JrArzz2pGG8rg0leZvryo9Ofy+pgr5tnvW09I7akrxzK5FjHesWkAWzvExMNtykeGvgwVwBNxvY0k2XD
+OJ2YqJG2u81llMmx7V+E2vPKrUHEh3OwPV4KTVie0Wg36HvVi2qDMW0kQiI6yhFsLCpoKxmDGDO3WY4z
+k1dsXEglIBFDYl8IMWcc5MdaAFlYqECOmCOGl+MFGmxnSHBAgrakY+sZ0DqBenZwDsiSw+k8yQrD/135
+v8CowftJ9gDeh2mQGx7D/Z/4Fu590K/d3hd8u/Z+4yuz95uecL0P+qHT+0Ive96HK9sFLY4fnewP7fN2
+R7nx9UJE6+HqI9Kj9phc6Ch/Z4WYqQFa5GmEiDHqLnm5RCoUYd/4DiMFlZdjbT2I8bPoMoPmzbVk/Cyv
+K14tgRytiCthoxPNHBYpNAOxLTlTfEJLAspoFPerq6vB1WcDWZ1vnrzefL3/7PH7WbH5P16ePIaFSMvg
+p90XB3vvTnb/9u71/t/2/4PtsM3/OuWP/7X7+P97q/8dvvvH4B+P/5G9ffg/N7fxXROgn+lsVRhpHild
+Gr+yE37+DBxrtYIX0kKNEiZEytdtj9MbSntGLDrh56GF172wML3jNRrQhwHpfcRNKBqcQS1UjQ3ps86B
+dpSv+TkE3OlQZWy4TvGedYOOWnxa8/O3nqX5ddJ+rBRXzIsDpteBYnkmZnOJOkWUyPicbscTw/zgyEI3
+f6CnKTgPFZDIaOUqfYo5lTvQA3MxX6zwjGxpKCwIGBqwebo4a4bGHrP9kPIQThp8pgFUWu9lZtRnoibt
+Lnsc1T1kI+w5LGMoVup/8YnfFTS2vb7UhOvPrDTlwnoUS5KeDKDZ7quDYEA4PB8aD34jb8nJCmnLamZ9
+79+0WtZ46ETKu1Dp0CjqtkTrhtneMJgfwId2YIm6TxbMwLniGCAOymDcAI6hj/g+E+dw8FQ26Xpjo9HS
+QRjjo9PI0NlOlTIPqmg1bUfRe1MSIY58C96gcHBsDYfqUlS4qaAjyKtnhEaFoR0qMV5UYHBJdwaNfgci
+IZgxMjGZkPxtsD/1ldBtw8AROjL2jnEFpAQgsBPzqABr8GBPY50j4UysC6P5AVOO4Rmkem0iPdwAupdy
+4wfesIBDiQZlYE3RY59lOyHUiyi0VwTHl1g5VIx4YkO3lppVi9xv3cm0GMN2GoLNqd0Ab9n3rNNhQ9b5
+drPDHnk74xHrfNdJwwFQ7ch7j+aiPOHnhHq1W2aezKfCWFaPEqW19ptKtwAWmnKmO61QAOYw0MvVQOLL
+uUCpEfk1LwrG67rKzxa1CJzyVy7nM4GSjX6HBvFEy7DAK3wqBzUqUYEPXorK8EaPFeaKTTiUg7OiZHmY
+H53ZLIyEEsKILb+oOXjbj+VsU5798vhSPeZV9ViX1gs2AbHx++wLmj7g/DDWeNaB0OUizt64UlbHP/Of
+x9tXvo+iAiyu822wnvWrehBB9u/0Kp54EL+XfAzXZYIHbVinebkQcZRvU8dPcPDpwJ8U9PPv3lO30V3r
+XM34GG3kc0TRTj5urNNqX3EQM5++GZu+60CoPrCNiYM6mu7AwMLVFB83/HqjHodJLBiw9vM28AW0ipKW
+iIwh0eTDqzYMoZX5XFZYl/JamQqlSp4hBNPoVZZ8bY0riKemz1J1wUjNAsRC+q8SNXu0wzqgaNJN2G4N
+QGm0Lc81OEk+ZnOAqumzUrJSELLNfFFr0fdgj8RkfHJZzAbsmF8KxQpZKyYnVnWzNMwTG+krBEiAOpHH
+WNdh1HzrKVn7x4vnOTozQ4Rn4FqDebDXWMExQBXW5g0X0bctWO8c0RpeGwQIvpHu4HdlvpGDdtpH2nBm
+fb42hJXU8Xs75OBJ/h54bGc7CumIMjUo4QryB+84HB/GoizzSqxKthbPAdjkbpZRwO0cgfNLcVXkpbAn
+tFbgKZafl7KiJ8VJXqna5rRBijG+8Le6nd/12bfzSnzXZ7Ji35qqv2NcQW33+aKeUrxhD/DxPnv8mCkh
+HD3zEAXvPANQMw0AW+jqHPUNM7jEwObbVMuy5u8HkOt/zHkFauLH4IHwOC/BDHzgUEb1MP+jbG6VdU5E
+q7Z1+nvzwHpvh9WS8UuZZxRLGjr3L1HJTQresuHAy+yj0Opnq20P+Lvl5TVKHtCLWDOX3bt6CB6xuExk
+KxYeREYDK8r6RILru+PQwSv9aaBuN280IBKHX7SG10fpNUmaflid7g/7nv4ZRuT8wz9VbtU4rLAcCWjF
+ZzTWFbQ5UZk2Yy3rgxmcEWZx4SdzI/aDcgUE/ShcSXRhxsZROK7edms/vWYMfpF52e10GlGmGuW2Py7e
+8vjusZZvBesfB4QNGuHV2GdBW/3QbtGRpcGKGDdmtXBVdg+UfFKLiuU1Xmx4UQG6PjsTorSIjJm7sRO0
+IQkGWvMzYPb0Us7GD+qAK3g/0gz8ZrEO14xy+AnjG95yZtivd7yu+/EWnNCToxAqLoMSKUk8Km636urC
+K1dUNOHeDfZsiWGjKHS/0RWOhTI3KdQmkrrPLSpsm2d+xLhipYl+rXVJZtQDjGbID+cjhmlVelLHVY6g
+ReingwKaDm2C+IZyXuez/F9kweHf8BFt9QdRjqcgD8FjLcK7orBrguiNp3C4ZaYDeclmYiarpUXAt9A6
+jLF8BhamQgXVd4V+XyqWWuLGPxExIZelyKz3d2+Qblg9dZoHOJ0WtcOb5vQUWcu5fi/55pu/MnOE+W3L
+JCtlrfuDXePsPL+kjeqFNm1pA0g/KoDB1J3NCDNmzFFiuFn4NYJqwRWFJ7kzZ/DCXgR7xNuvtpLEc3cz
+3Oc6ugR9Q3Mf8K4Jt+3ok2bBXmwpT8Ng2xUKQrYxbVd2Xzpm9yyVj9FL3HhFhx5BTcdkfdB2D3dHN3bW
+DovpLmZsXv1tUtwHS6AXFmLB8LKd8OeHD/ie1pb91FJ9691W4qv8dRwHf4WOPxKpPkIFE9rEtmthUpJc
+WuEwyNVxzcuMVxm0xSqbglUUFXi2ULWc7RqVaLKlCRNRbdFrCPmxEVL67H6kjvm7WKai5rv/jzeQ3Slh
+CDbz2Q9/1NCxmQWtMzYWvxdtrW3+XVQ1uweTqrx76+xor9U7O5bgJ9m7PlMJt5Grcz3FmqXiqZZibd+6
++8XfYYYHNFu4ucnelErUtIEVGgGanCM0EChljV9N20YDr3iaCzWrCZvQzoVCpyfj+mSHEfydzI8VrCyu
++9bcbH1+Fio9r6ORxfJmaOlY15IOiRFuqFcPanp1hSvvdoNqKvb7ZkNjh99/09FM1LfO8CZ2xeYmey2K
+XK9hklhIpwx8coTr2Nrjj/wWBYMf9c20L6Hr/STn0ZpPAqYZK18E/j3OKBr5jzij+gkGuvrcgo778+aa
+2N5AtzS6Gzc3zqd+Y+i66BZn9VkWZhPMcuRCNW1Y8PrB0M0PCNmS1uWlHTP0U0v65jp7o5yf1OCsK/Bv
++KKC0ZvvbKzQGjpx3NccNr8G2kPTpLVqcNKEX0Pzq60h6MSP9ayw9F2z2lwWHjxYN2uggjUdCmpzTbyx
+thuz2tqMMrvhKnRvbVchb4adgY8/4Q3NbXM6o3l0dPxpbdBpTpqnnTe+2+oq14p4tLsyOVktzb7DlwXo
+1mU+JqxX3tcwsIYajIwsMlMiXBJc6RYeVTRhyd5/+OAW0L2dxOpN0En1Xsu6TTpGBLOjZ0o8eBCNaqyk
+9hWoOlMXcqzQbAWnUnIUHjwgYS5OSFbr6d6dUtqLbZ0YiPDWH454MHIu44oq2aOgSPIlxDTkx+Tzih0I
+St2xs7TeiWq35m2OVFNF6/kZNjxeGTesAK/E6pWQCnmj6kouFdoGkY2nL0UpZ29mI3GwPQdTAVsPtytR
+sxrzEzhGjVdbJksBilNInWM0+3YzONRIl63mZIHJmMlnxsEPkNbuh7tbFJ6NVvrt/CYXbVP1wd7zSs68
+hDaCrcaRFi65NahIwwqynzKX7OPArBM65ObAIYQi4cK4N2oDqaGW6CKcTLbRTiLzVUrAuCLNQmTwnstS
++x3Qb38DeuvA/xxEtAxIpr1701/9n9rQehWUxl2ClaRxD4bfYIgPH95g+M03/VbsguHWkyf9hg9+EPok
+BVYw3Hr6pL8O+sBw6+kX/RBmYLj1pYMGcdMJ35+mw5OscvEfPn3yTTp+SMNff/iUIrOQd/7w6WfrxBD5
+7PM/kbR+PziR57KateJo4XQ+k3hy1BEyR5hmoBcgTk2BSScVnycAtBIZ/hthcKFn92pgLcjS8QCkjlFD
+Jcvjxdkst36E2oGQHb3WzoeCQk/T4xgCj8N8Hux/ja5CpRDgPICytM6e16bEt1Dld+ZhjuW1EsUEznBR
+CcYrYQzg2JTD/eIK1vkCnRFYJoEkH8Nre5GrKS5P4xJFj5asEkWObie5st5Kukq+wR6mANUpoLwSXjjE
+vTxDZjTCCvGVHqsgmWXKy6wQlWpCYT33Rvw2QFjPDbasg8F6fvT6MALBal9K/dRSvgEJywbFPKAYFDDm
+5EZrwZ9HemcWS5xX7Y4GQwWetuxHeSUuRdU35Ea/qGkOgzbheYHDP+cVZWZKsrxksgIOBVJekZe1dtW5
+ktWFYQdyLkpDTvOdSsylXVaQ/xeQ9HC+TDsHMMFaoaetVOCLh7Xlvbs3JrlN4qsrPv8BF36GA9sNmcig
+lvMXsPTBOxR/vRZKgMAETLvu9LY/ghTtvz5Cns5yTez6BsQtWEUWbyvieMOtJ3jypzjacGvr808IxoXH
+4hd/Qm/9wWelL2Ougb71i4ItycRlXpB36m+IuWUuUStwtJpZPikO1/pIV+ugVq0LIKUC3BI/q5/iBznY
+rypZ0b041htrblxKvPbStUpbjIwa6vis23Ng/FFUryjKATT04CU5j756ffRq//XJf77bf/366PWxviy1
+aA/p5bYzuhF4RonanDQpLUdvNAhQoI3/ZGtxT6sPZTeu3fh5K8TEltWcRo8YHlPGvE45eI4Zz4S+FXId
+2ADojda6942akkF0Afy1xabQtITmsRRXsZ2XbqGefNjAXtxczwBMxP6yednwiW2NMpxn4J2gY+Rgc2qp
+yQ7SJTB6hgkVbptcIqCBEiJVtaXx8Jr6hmHP6C9dtSHUGo+1+ezkHeZ51tctuPQdYGBWsE8BXASEA5aZ
+MPAQHwP2dK9l98Qvg9A2h4mReEEbDAY9cu7WmVoInwKltwn8pFu1pNdLqMmdMaFsqvl9oVBr5/xZM8QC
+x2Yc+dxJnHnJs0tR1bz0IY9q6cHDwII3tM7gE+xltDrIS+He1/B0PBNTfpnLClqGgeil81/QgYSt9piW
+WkPpmfaEUaJGpyHfpYgscv0ltp0ykU8TJP3gCprtatQEu6ilmQHuds0qVuG9ZDz8Q1hFwCRcg1awi8bu
+bxpG/bn7P2b3f8Ri9TfAzavVHm4kd5uT7WDCOP1ArOm5GBPKB1es0+k7pf9YVgTXhWAPAQTC0gZsXJQE
+Vv0JVq55bdcmPYcNuKsQdmHVUeVElWipEp1brtK0i6XHqqg6PU+6irWmqKM8N6BPuv3xVXW3pHBT9PGG
+MQukw2jYpt5L2pqD5ovaemSQyrrjgmE1jYeiEjU8No0CbLtPzDBNXSdexTcMmacLTwzaOHwBXXPckpey
+xKspDenYezJtWMrMCz6Ozi+40WBgW2VuuwankLiF71Z5w8DiW75FPcK6spahJarMApeYD7XUsj80BilY
+woO0s4sSgv1KdHzrCt1XGMmf83pK7p8uqOJNOROTF7opf9Tc3VS7nkpd48r9YZVqgF+la4KNoU9NYJec
+KVGhpd7E3L1S00lBhonlfndtcjJ4RXUlYXlh1C7dkxQJmuPv7CQbCvonJbfza29wXlEnzAjqXnvzohsZ
+T44FQMjZDnuyzXL2re15IcrzerrN8kePTHazedVp/nZAT9cvW6e2kdW+/l6vmHE9HboHjXa7p8gbH4j9
++6z3Rux/1s/EqTtaQt7p9Fskuk7zo/e07B+onfiTly86RDqJr17uBv/sJL93+uFCWbGHO+tk6/TXW3id
+G/N0blAVhw/Qn+xROaG1G37z9c3Py23Pv8Ezb/MJN9CaDZ9+/jT1QPvlnw+0v6PSGQMQ/vlEu+4TLQVs
+vOGRljKlnmlfSJ59xCMtqgwfph5pqcrf+ZnWVMrdK619sv3ED7UHwbjf5qn2wIXYdI+1B89f7x7u/6bP
+tfpdk8bo937ZhHXWZ51C8my9p0gapX+Xx8iv/jwCfscjYHb+J/9fm//Pzm9i/rPz35fzz85vz/ZZJrXB
+z13Y/uz89+H5bqxvxfBn5zG3P/zb78LqZ+d/OJ+/KyF8t+6zjoB/1zwyZuf/LufF138ar/zRh0g5X9Sf
+MGLqxxqNvMjLC5GhWv1NnRdR0Tj1v8cBdAdjGOciG+fVKbcP5LaO7YzOOF/UNx2WkKfjSpATifphiYXQ
+EwKDftqtO5HVWLt4HkywvyLTPHTzIZkzklEDbBAbitBD4cuVLRWwXo9wtxdjqfthWkv2Lba6LUwrLwp5
+pdzL+FQooYPO8wKI4ekAkORg2iUyACLHpyj4IxMTvijqZzaJl5n9iuvVWgUdTBwJDJ9FRPDQLyVF5yhy
+kbGu9vjbtHYAvT5bKFExPrbGZpFnK9OU0fBMAHmkTo91dZWfn4vKN8iBIloG8dpXT8US2+PaAv2B1jUa
+hGzOhDzQpKi6UiIju6FWYxXbd0FhyQBJo/z4xqwb7GG6PnqDoBoyHxI+3bIzYWwV8n/Rs+eiNAMHox5P
+Zw/I4esIL5mYzY2RUJCZZrlnGa6HdBwGaHj6ZOvp5s97GK3rCziynm49efrFZj0Vj3GBPjZDM0XXsli4
+8nfnrcQr3LKRgPXy1ZuTGyIAxzx3sGZk4HPQGeIgA26qaAkR7A9eED134KcE2KfW44+o60kaJsrqJPDZ
+RADIflgSSQ/DJjgn1+AzOSOT2t9H5Gs3+X5WyFKwkWvUiCnJrtBvrqOxEsiMByd90MDA9IBLAuHUizAV
+ddP3Rm2OoU22NV3qz40ZPtcP28h5ez5BU+QyGqxLPUrYUuQ9A3+QvUrHtrGpanVXmhW7YuYvV7n5kqpe
+EwyGTZbPiLcY73m6ytDH7SBEM87NjRGa174s4GGZNR6aDNzvuYCNis9OxkykebSe5pmJM52o/Oe8KN6U
+sxX1G3dF03u/0u0bWmnK6oz0YNTSxvTI0EntNQ2AdjTsAfyJvIL+fNYEPri57VHk72ix3MX4zMF56sqd
+B3BHk+/0Qw/nsG7DfWJki7vtwxUGdcB1uKqtROEZ98HfotTRhIRn+yNqsvIB35MB+3maFx70rwUerpdz
+DfmXSexigHXYZ7+oTM6MlmRw6yG2I8s62LJOn6FLeGDrZ5dTsF29lYRqiWC14B72DhD46G3+1Hgf6eR4
+yE2xGPfbsFaT7j3vkZ4keCyGOE+iEnAMLJRgXHE0gbjiec0WZZ0X6LBtJCW0q4S1xM85xqRCQAhDKFcs
+n8EdmYNYMxWlttUey7KuZFGIzG08hdIL4kctITTBRoTtfJ7X08UZhmKY6MszhRzdzJVaCLW59eU3Xzt3
+XH0HGUD7uynJnnhlz2P7Jb0CeNujtHiD0Z6tTRC4TsWzXHYQQIHPEgt+PZZA+f65ENXytZS1jkMCOXT7
+GAxsIVjX5vGsAlxtLKCRyuu8/90eAnHf1EfuU+wKNo8sH2uMB5g6q9+rqyUbQbaBQdQc9b0NaYKSKTkT
+dT4TigxxhYJ9DuuvWGoNJMaMI5q8UBIJ4wJx1Kiec2EueeqHpY60ZOtBcRktWvWBSIoFa3riiPEyY1co
+2uTluFhkWq7RPhQLRYwHHpC/oIp3Ri5qyYDUrY6alYvYDLU7paxxO5Hozji6nfVZIcAOxvqpQSmKbe91
+EifqWBSoD9mFQIeOFzpxLFeK8XJZWwBQvWzOK7mYB9Md03MHBN2K0Ux0B9jX/z4+ejkg/ptPlhrlAiHD
+HrHOWwTE2bmPa/z+207PLsbQmqVPTXghSrZDfzqTFvatTYwMXDSvq6ei0psDM57mb8NYDF6OHbcvQuAq
+5ujgAmb3vKz4xa+3ifQXR5hAaqgiaMgXtqKeK3x3C2Hb9BC1I7wRDRneWc6pMYzuueVj+oWTYxayNYB3
+EWWRHCrcKDaZCQ2ljK+7jizbtBnW7er1thNjo+UptpMSrXTJt592hHQ9q8bpTXlRyqtSD8rZoq5lCZaA
+f1WDTr855Cs7rqvzu0+8stZuQdw/x4IacSH3UaFkwJeJUwCj8akBmzUwjsXS3hKsFSgxNwqxkBsdwljO
+BKvEY53bp8cV43M4oqrc2JCy2xyJYc+3U0FNXHQPX3ABLJHVGn3o/y2siyLV7fCbrZsthxqq1+HW1mfr
+IVZ8xCvBKpslowkdbn2VRJ5oWih98+dzwx/83HCE+tTW94b/Bg/JGqYkzKk/2kykNr5Bh06Z/DfnSF1N
+Gdr01VCnIsF/pFAcAYUy3emab7JHQYtuozekkpHi8OjVycHRy7UfZ982lRRtKhKNlsC6y0V5rmpRqd6Q
+vSaXK32sooTk99mNlXM6u1UU8LtH6w5u/KZJcWR9xjpvtHgaaot9/T+SYLJk3xKZ73zvPV/k6JgnCm8I
+pDcEnQ137tog3v4F+qb3cCLkXclWqL5uOppo9dzibPqEB8cNiEKfP/nTWun34/x0Y/qEL83/V78Uf+L3
+X8xJ3OKGQ4ky4aFk5SASl2knq4PJK4H+gNSQ3TK7y2vuu7lPJAwZGySxHVKebn8ibSkok9rek1nYU63F
+ayghvfflnyggMz5wey/d7k03+QocwkDYgjT0mA3Me8yrA/zzEj0/7fn5EpUIJki6i8KpYb9DNZlm6g6Y
+0Cs1oPhtLqgFpNzbBbeeQa7w35h8rxH5sBRXBJ/R9W6kGI/7Pntkm88esft0yLlX5Vq6w86ATvCSIsZC
+U+73vGOve39kWotiDqyqwf1eAi/a9wqHDv2x/WFqzAtexZ3RKvgJC3tFgeqS3fLWXYA/bdnTtVshzOoQ
+5KTJgn1ECBdJdFe/FaDdYJfwTWzb+oxjMHeKhW6yYbiftvUcbqaxA7qMQqU63qS3c5/lfVZs6ySSRhTG
+o9MkAiXvQGdA/RmFn/MALRNrPKhLG6pYvZvWuRU6qAbmCRRuRaRpC6idoo7PFjzN3/pR/oNAIF5FugMr
+q/EHie2EtcZ+5poeuKxdBvFXUePnEi09EHfND1clY6m8LmcTKz7YeZubbI/iNSlRG1l1YBijYuI9H9cO
+RyJHA9uxLBXCuNaMjyupEMF2I36OggHkqLsxsdkakxpOxPadxz0aMn347EQzcPOguTXgOE0b2H7YLkfb
+UH7SQvl6hfWTY0wrrZ+MvZMPEUJSJ11PVhxs+NLR5GXOrsexRKDnmEiiJPQpVdCcDSET8i2rdPs8Pex6
+tlR+/BDTZTvINCorjaisoZGZPNemvMZb+S1NqTSdwJSK6mR5yQiWQZFPu9eNyJzKv1tGVlVAz6EEJqv2
+/eXtAAeTrsPagbyf9eH9xGu2QYsx/dbR57QBlj++TW3FcSCq3kZbQSUjbcXx/ov9Z7+VnROMLZpjD/VG
+9UdoGEt1fS3HptL+beyKbjCQaZoDeYZFWoiirt3ScmaVXuiGu8HtbHA+lcXFjfcFBwDfYtm2Hs2Wwg3N
+znqWNr/FSNx4dbvVYN2zbbXSGwop97yBiMU6FxI+B7+YcV4v+6wSfD4vlg221Thw5Pl5YeJpsNtN2F2m
+LBFjCSMsXYqK7Hj1Zf2Mjy9YLVleK8NX2KK0BxOZuq3bDjuU37PTt2zIkuGH/1vb2KzgFE5Mar7Xrafg
+sEYtH/FSR8eTdb9JP8T9wU9t/rsa6km3/tST/t560lWPZPvvxXgBOTx8/8jDM5HDqgE1sMlzWT2b8oqP
+a1EdTSZKRDRasxk95bmoPZSO3fFYKCWrBpFEHh93FS0O2ShXz2RR8LnSb1j8kucFKiK0u6QdFkaINXQD
+GdtCQCtV7jXyISrTZwf7W1ue7dS5QPskMWNXlUS7H+P+wHg5nspqcwKbkyBLsUaJQ6AYr4SdWy24Y0W5
+YrZNg1Ad4nWxS9TJ5JH+prEFs4bxQlEC/knfiSNqnuMK40XUlgDVpk/MpdLvbe9q+DdBx4xn0KDLYjdd
+tzepx2zOc33xPtj3HSzGvB5PGXqt5voSdbDfUfr8gn7vvjqw/rN6zbO8xNDSepkzfiYXtY1d7Y4/Gjao
+9YpXGZMVnofwd18LvWgiSS62ZAjHMMKzXJxPWQ62Yby44ktLwl5k9gldjEJ9K0KYm8lMVKVfdUkdymtr
+ZWepY42FHF/YyNdMlBoVmKMKschLoZEhZ4KXio3NPrKrCO5CQIiawc5EfSUErdv6SsLIKccuSGO3d3To
+AsPLDBnd/zKOEQ5p2Ft3YFm1TyuAAOpirRu9CxsUsIH9uB3p5l7rI91m0Fcy/N7txdlfoPaC7YTlBzDu
+RuOCGFebbG8B8hqv/Wmn6R3zkuHLL+0uGF1YKWeV4BjdHK++rj26BTCbxzWv6kblmamJmmszDqCSE6lH
+FlgWjVSYSYl6v8xeyRxCVu2X2YnE751+WAvdpXAoINkyV0coGAPKKsrMZvSLPYrGc3tjI3KEwdxDvxDd
+MUWZDR3ZDXRcaWqQV66n79sW1CFulZsX1VVeZvIKZD7Hvx88SH3u9qz+9p6j8OGDN7u4Bp6RC+XODnvS
++sChG+LzSY+M+7wdZHTDH2U13FMvLstu/Zz2a5AtQTLgxzqvDt7Y3F/ngj7u1t0nPbNbDjxEQ3ckGfVK
+82AKWKl/OKF1rJ2AQfsRTBrDYkl2wQf70Qm6oS12c2WcDfVhip3Lla3C0mc7wWnoKXDDCeonU/xFnpiB
+ZILdBG5z4nKyTCrZzO/ZEzYMpmdQy2NUPHZ7PhMDerWYzc0UBkXGhSx9JmkzalYLjdYSknJsx88FbKcb
+0MTdDoV4Xoqqz5qJWnCwzcvViaG4ch68esM6Uqn+VLgkUWatxXyGFDFKtpNuJM2CI5GYAstDDfdkj/z5
+tQeNqMV4haBhpAuzdjPMn8vSzKs9JBsnX5gV5gxZfauk11IIJrpFBNy2E/mDbibbiSnY7d16THilv8cB
+0yeHd2YEWTCR4UFy8xHyYe/oEA5PaLx3nETovlr6iR+/Iymlb/K5k6WKZ6FFEomPebvC+tANe87ogLS6
+mgGuHvA6sSr5Ts8fO7Zjs+LvbTNiZs1tbwQ6rCAz+86vp40uts5RbVQX6IxubFREFc9FHMJV0o7LQOu3
+Y6VWEHPgk5etXSCqtCDkE4S1HZCDZj5OEEXNcc9fagelkc7BNv9g3z4F9o2UaEwDz2Q9tRcGXmZ2T7sH
+B/955qWsxRCuhU8eGRIqed+ks09H6vJt/I1P+ki8r0WZjdgMATy1s5YW/fEagf4rue3JwX6frihAbC6V
+ys8K3C8UOuO84nBBIq87Wt6M29545xs7mS5U376JHuwDjUtRKUIM1a5mJoLuwT5eyGppaMpFlRiXT723
+mwJjtL9R8EvIhaGQF8h3Nwiabu8X5pCHek/TOolu7210mpj9dcjr6WCWl+GG7muqvfDwuZmnsO83wtj9
+xF8btYgy8+rQot8+29qC6VSNyyqtT3PPNrsBZp3WpV6WWux7XuTzxFpSfe/epURtrrPgNlboS9igIaUP
+dA0PHjDD6zweZ0QjtsMi9uYxLDPWkDGcY0gA3FZRgQ9Tm07KAq8bVqJnZO2S0GB7MPiVPnjg6Phdqm6U
+CDwmSazUIzvw2qu/SHfK+9IrBWvYLQqkrLrmoc62MxxtvyjP6D7adcw4zEDz1rX9G9ixSLUperDwT4B1
+SdzYOmvBAEO8UMKKBGyHgXiaUmwOxrx8oyCoPgVg79g6Oiwv7QRR4r1ux+cRmIN4R2+j5zsh+JpYHY3J
+onG33501pDW8cVC7h2Evvg+0MWzYuEv3N1ZXlOLCbBUf1i1S7S1SYYtiZr06YO6xUxYZRLGU7nm4tYVO
+RO1K5eHTp1v9do3x8OnTz/At4umfbxG/31sEzYR+UboxtF3iqeKThJBbEdaY7Wwwn9hN+QO6fnCxhKG1
+n3x7tK0VoZLD0isy+u8kkEG/Q1zmsiBpcCwX80JAoxazuWfGhO1H0EeldesOCWCo55w9Zj9PRUnBxdHO
+C2rIS23PtHd02Gc8+4WPRVmz2tXOK8FmojqnTQJkvKaNeanD5NFoiIxx3Rpc7Qd7wc6yrYJ9ZeIlXVXc
+Bg1geen060pS7xCdChY8bsBzaSRwXNuVGMtynBc5LiQqkKPjprHQNfRsS3Rs1kuh6vyc10BjXgklaFio
+9W78zE6C84aMnGz37XYao1dA+y6ig1dFy3sjDI8QmUQFVECb7Mw6rEnH5qYZ11LV1QL2ON6BcnyxOqdz
+MFdGW1QsadDPlmwmxxdqgLxe2wcl6/VCxEeR9QKLZChzjQPTFvTBttCzLfDBXmzMXBMmsBJ4EeLMVsCo
+iegt3sdLFA88GzxbAN1f54IBVfkh/Gm5IVVtOko5TFte2ZhdN8X9N4m4rQ7KTLzHkBTNkB7PUF6ky6aO
+lEGGeLlyS0q/H5X66IFOwlCWGS1leJMiarxcsong9aJCSAiV424kJZzd3EFIm3ScFujTwR4ubhc8Bz5S
+cxJz/VpvOHFS8VKRGeIHTDmGo7R6Da3F0XXp16x2PzRNo6wyLTlsHZO2NYVDbpeqt7CoU32/Uh03xy24
+xJRSMWfVQpw606tkBd/uxovKF9q9RgzIzg+1JnU+PgximuC6ewmvpLDur0QnI86Ig5GXjLORmvNy5Jlv
+ciVLOpdFBnz/UuCt0JFTeTkWnou9yusF8ckrfMElXkcoHjW/EExeigrvGXU+ZnN+LlTPQyMxF0Wwt7IA
+IdAUnCuuyPJ1EHpXeKO4nXB9Nz4XnW+hd+D32IIjRPctGrPnstLgVAd7CK7xne8v6c/bI0t+E+h/R76S
+q0Lu1OFBdbb0QjWarq7YWB4/LMV7/AvfzeFHUHjNrZXaOo2dUImxyC9Fai+YRgS7wff+sa0Ee7sU+4xt
+/hrM1VDw0Xfg23GDycY5Tf1eTtcKbzuFVnN0UByjJYCGptLGX9rm1SBbz0UFZgcGmOGlwa/yifEy25TG
+utudt7Uk5CvB+LhecIPRhJvP+iyMlygR+OQQ88FbPnB0qu9D3+Hw9An7vx2iTNwUD3eQjJ7jo33EfM4H
+7girtgmtRoKLspXlmim6SSAfaBoHe88rOfMSunFD13M3DqQVeyddjWyxwqpu1VViuPX0i37ysjDc+gxN
+7FaJ9sOnT8i47rM/MSn+DW65vBL8TxTs/5a+zbfCtl4LvaPWC+IGV2mTLXSW/uOBrtm3pmVrQl1bGGte
+4jNZ7NiF/CEwlUPJp+J2y8J9AZ6VzgTBKRl8SaC2UAvjT8a4Yq+e7e2e7FoAuU/nu+VAsPEB7A6w114D
+Pgb4GqhBiVsBX9/NU+vT4F8nkK2d811zQcBStTGD+0y3yUyokWdZNxPzSoyhRb1BUp3h77Hb+HiZspGX
+18n+f5zsvt7f/W+DZ70u1E1jaPPS2+MDD1pZ59tJOVw5Pwubr+HKcgfgnI+BzmEhLPetgHI8cBzDxyJc
+PttNGQxXiMjnAeQ4nMKPAdQLF0ADB+hgwpZyQfyl4ZYky4B791lGDtRzOIgtx/TxfVwDUvX2As/vEAbB
+kOvdaSoTHWeuhfrB/dsdthXMresainKIK0pG2zWFLZKlZiStuImpOlw3mb8HzJ+nT942gFmjbYq30XCj
+OHfs5MjaQWtQ8ovf2sMugrjf3GQ/C6boamuZtiZp9OGjxg1kZA0B6Ehw1Ea1u42MWHdRWhBnrRy6MscI
+VdIbuLInjRZoeZyzMykLATNaGfcM3bgOIJQt0c7FEUJJxgSO5n5s8gY2P05Mtw1tPnCs+/cB57/7LtIn
+hQs6e+wFXo25SWfUknHkjJQw8JfCy1qKC4Z7a63ae8kQBGGEgTYH4dtB8G9usl3y3lDCIQ4bMQrveATX
+yw7Amuqb2NzZ+Aw4AIlzUePlt0YH0GAr5ErPejbwN6IZsMCRuRnfoPdbgN1/KtfcdVGy/4SP/3eHj78l
++PpH+KkaEfv/blDZmxBkb4YQ/PxPc5TfS1FHB80P4G+Yl+fHdcVrcb5cDSW7lqoJcnsvLGFmL+EORiBw
+oX6u10eYP0iylF/vH++fvPth9+TZj/t779682ts92T/W5mfuxj4MCYM8MC6kEm3695ahG+TKfHLj5AA1
+Nq51o56/eHP840c0KuBak2KhplityMy3s7zMun6unq375PXuy+PdZwA2++7n17uvXu2/hqpPk23qp8fv
+ra+eWzUg3lzrAcSzshKul0EWtFDXkuAGW5e0Z0OxwfyFpxURG0zPHZjCudSfKz6fi0olJtly+dRo+YaV
+VsEZrPZSXK0/LIGNZDq/WRrx6hqa235gBsGLwuiaEEbIdo8eufUzvYlfQkb1cB6hPm1ExrW1GBkziIxN
+qhyNa3gl2BktNKYW46lGeHKGPLwS7ooE/NNIJHmxHNi31LNgrXqjD40AE+o+43121mfjPguMnnlRCZ4t
+m1vsdntSC8O33siEd7Hh3d3MiXFV5XWNbsbwgy9R0LuUecbE+7riqB0ecx1hUMsv6c64BWgGo+uNRdJs
+2Dd/0O+x3kji9YY1aVh+1CKwpMfFvv+tkCZiEcDn98Otb/CFL+TVw6dP6Oz/4s+Xu38DgeCg/OUGvIwf
+xERWAmMkYEjjV8XiPH4SS+cx5zjJ5q2FG8m2XJGLsiZbDimtOZhfNJHDlNYd9Oge4StA+IyYzmRo7Je1
+qF4Iftne+mQWW/7OWCNQGvQG3gXgmSwnefT2lsxiyh/Ks7wQx3zCq/xZkY8vWjuxKucf/jz6mxkwt56/
+McWWnB9jDu2X+4EisyQLUVpc4rmsZun8kBLnpoDyicwHs/NG3r22F/dUjkbpSUWBylLlMK1RQsdjTRWA
+pDi/DcSQKEBpcQkLhpgoQWlxCe9xLVHGpKZK3bACgiwfYQKAu/MFGk3FDK2ZHpS07D5RyqZFJQgJ+UdU
++6hkuSDHHUN2p6z1EgVT2Qwdms1WJtdIduXI0rb9nEnlsKXBfrP9fGgk23I//e0G5p7KYe+62ppiURSv
++LloWXgtme4HJhd8Ucu/iVJUvBb6mkRPyHDJCqCTVjw1ey/JUGxQyzdIiCvR1UavrS8KAXS4v+i9KCEW
++xP+Cx8F1//l3hoS+OB0H/WuT7+gHzW2MdwfA5zN/Vle16IaUMbmvuu6i0fw3SI32EscEWYk/BJKVCWU
+LC5BdQy2IdNcVLwaT5d4Q5vTKkObBvPyg3etVCtpzf24ONPtjCWdbosE1Ntek2C4+7spjrA2LeQKXccg
+onE6ljPhBe1EBa8eDWXCJqI7hoF67BrEIwDJJjW83hxEsJ6KWe/O46fjPerl39jnw+YnWolJiXGY/kwl
+GhLysPmJcq4S5IYrU6l8g0cOm58oZ1rkH7Z81xusOcgv0cLKWeXSKCM7ysf2M/GZjaQhr91Pa1EOjuAE
+2SD9dqRBg6/5J9FsY6yOA2iHo1KITJFHynyOUTphDNtYfLdHdtFXubb1QFsdIkfOB2fCbodBs/XEwPWO
+g6LddpH9Ft0PR1WYY6FDcQc7w0jcpVXUAQ2Glwbiq07JUV700kiANKmzcz9pdm6+Y5j+YShf6jQdMWwY
+CZM6VYduGUaCo0611orDhpjYJzVRZ1rPis6wddYoXZ+Fnang2crMkG4yn8lsuSozpvda95gnROi5aogV
+3eRFMsW41yGWElySLdsH1VC8kMKP3U4p1bjK53UnScJowc0x3BQPvQ2eSt7QYsBKuvHVr3ujVrGNd1j5
+0WwbAj4IREuifoO3vnGDSOlADJxC8JENdYmUNJss0daHxoy5BnZvuoK3rKmYpH/L7Kaung5v4lYWd4iM
+UBVsh3VvREMwsChGnzuYVmLSA1Q9Y6YE1Xc3T79/8LaCJr4Dlew/zjZ7g1qouruoil5oR+AvmFeimrTr
+GiD1vrUtiFMIRKUbwz401Lv0xgTjOdT/blzf4k26Ra03/OZpP6m3G36DmuGkVm74zVf9FQq34dYTJJpW
+pw23nuBb9Ep0hLQubLi1hYRXqriGW1tffOQL+W18XbRSZ7j12dPVLjCBVme49dnn/TZFzHDrs6AKrW0Z
+bn32ZT/S+Ay3Pvuq39CzDLc++7rfVKYMtz77pt/UmAy3Pn/Sb9VsDLc+f9pPqUyGW597/WrRqg23Pv+8
+n9oOw63Pv1xtkRAqPoZbX7janHZjuPXFF/025cVw64uvVkXNTeofhltfYX+TF/bh1tc4VE31w3Dr661+
+m3ZhuPU10WzoD4ZbX2O32i73w6dPPk8ZW3z5p7HF7/u2Aov2I2E/Wp2eUppsqG+35MVS5Wr1yWJy3VFX
+13J0mTMLM+rXSdhbL+VVmDtMC7VRFczD80LyGgzyAvUTgnxNCikrSGIP2daTJz22Cf+EuhuekVFUV579
+0mcXYtlnlpY8++X0QiwhsFjX/v3hA+DhosnbtsFPSpzVUP4dL4pDwdWiIoehITt924erV8FVzfJazMyC
+wXhvOUEUaC9fJksBNNBp87jm44shO33yFsT8d3Q+i8x/59eokw2NGSKINYQCS8JkY266tFiFSLX4l+5D
+t0FlRglhUIhmXdEwDCxK3BMvTARmFSU/K4TO7IWSuNZdlPM2y5tk8ShmzbmoX3BVh1PSFpr3xm44uvMq
+L+v99+NiofJL3zZo5mU35P1vbCf8+eHDetVqxLbFbMarJdtpFDJ7FuwkbbuOKXvYKKI2lqWShRjUMHxd
+TXcwA6tH0xVYrQ0l7K/O28JplQmSpaQLORQbBNE0PQ+NE1nzgtQP0EBW5zPBujPV6wz9vY00bK6eR8D2
+jRApVhIQ71cSII3zSgqUxS9+6GqdiwodZXg5vrkJbNMMDGguPYKvvVasQ1G3uo2ckViUm4lFadzNdSzG
+a+PTYf37V60mnLKTfCa6PWf67uFRaBNlQ7BczM5ENQh3ykH577lTDsrfZaccXZWiYt85+6ibtsnBWhsE
+0u4896ZbhbQ+Y3p3Atlhp99yuUwvjWDcBrV8nr8XWfcp4oWwmWoggQA0n1dED/8hn9+wQD5ianV/fKqm
+j3W1EK6FdgZ/n7n+mataZMFE29m99eRGJxTR/l02XbhNGoVaJzxs0r/F4sSh2zs6/DdjVnC//oRsCiqu
+hFoUtReml+lPp74i92Dv3e7JyeuDH96c7L97uXu4D+IxHdFZVKwDl5mOTYdfcQ5enSvM8b+Pj14OyAUm
+nyyJpUFibzvcXFTw34p9vavEWFbZz1UeeCDlWZ9NypcUHcIQ7zPsVIy9ZtN1cF40oF0oMVkU2lswd5DD
+uNfZnOdlrTYrMQGEAbt6wORUKDR2SvVYf2assQBP15bbH7Ott44OVUjzQH+f5hnMqPfjwwd2+jbOMpgv
+1LRrFiAsjqEZL/MtnwlvbMxnGMEh/n+Dz+mp8/cq3rVpFsxsQGIk7fu3AAxeDGMJVeycvu3/z3fvnuw8
+gX+2dgy4n41lDInfQhL+BQGNoRh1zmY+hbS3PR+nyXbL/1hd+r80bLPnWEw9IKDrdyT8vRRXoAmy8neH
+ffjgu317JRIeAZ0I4WmX3feW/30NXiYwGPlM1FU+VoyWu8jQ+kDw8ZQh4QH7WbArXtY+vVqy80ou5vT4
+qAlAOc7O80tRUlFWy3MKFOFAsQspLxjXKBOOoGdVjpvEwi7wMrNokdLZpWEmQpIqAvgpej8l59hM1KKa
+5SW9kXK6TMgJu39Fx/GVrC7uGzWFi125zl03WOTwn3D3xF+vfZ/tvGxJMMYw4VfkAir+Stsr/uqBOzTS
+7Docsif287Xn520Qw0NNTNfLUV1qlMwBhgDVTnUB915jqE69Rt2GF9libwe2L4nmmvgHcWhwb895ISWC
+jUa4WTN+Lg7KWoL/Y7TJHJOhEim1e7DTPtGYrtdfm32djvmtJC5EmfaMPIJ3XpSp94C/qdOtt73tlVPn
+HY9dTaz1ZDx94lNrmRDPb/oVaa6faZflFFfD3Y4oKrxgOES1l6YrBcyffT6eOvmInFVCQt4Ru1udq0BU
+ckNMJQcA30OPqfd2moFsve0KpLzcOywmEFZx3VZhLdevrpZRZbW8VVXOoXu96rz8rkr3cc1qCb11/V5S
+frbD9EI9bdJ5u6rq1Ws5qlbTnvNK4BJPJ4fWidE27rf1JPgebLfr5naJlv4ZV/m4ufBv0Te9R8LWhaLa
+yo4QHIp1xhuoIh9r/2sg3WdbvY1k967X4tVJ7ovykMo9ZMkOPNO7jnWt8B1jeHZ7DjYy4DshBmNwBvjZ
+IkRLyMcSgttPvMghY+Zy9npoBBAwbB3nJIUAiouJaNLNKeJ7nmy76hS53tjw2VuAzLuq+9832Sgbet9i
+XMntoJpcaWXnzpqDExc3b1Armrgd9sw9pSQu1wPvpSWsSpR18jr+G0gw0WFtxiicVvtkhQ0j1Y/qe/MG
+Gyp5iOpBC8m5bpOw+iS9NP44kWWtUaBlE46CresWo0EamDNM0c30B0jOuwH7dWmnXjbvoswe7TSveon2
+22tBSxfY46BZvRWk8nI1qdUnxkcQc9NGZf1rx6kr/VY/j5r/NFMbWrwUKNDt+WeIBD3qMM0E32FiFOxp
+RU5XQ8CtADQL2vidw8O69hZi2wH068atuO21h510s5stMI4UtO794Tff9Fe91Q+3Pl9pmqJtZL5EG5no
+iX349PMnaATy1Z9GIL+/EYiZQrL4WBsDYwNibhKafIVu5ohktlCiUqwQCvUgJdsaPJ0p1uX6XY4dsBnP
+BFvMe9qY5OXJu2e7r/ffnfz4ev/4x6MXIAJsDZ5aqN13R6/2X+8i6sHJf76y2BTJm+SQdZSoWW5grVAL
+e/DyeP/1ybvD3dd/f/MqmePw6Kf9d/v/cXB8cvDyb0M40i8FJrzexySw0hyyTiVsAqBRvnt29BKmQJP0
+rhaYRctkZv8AVHdnaL4yXtdVfraoiVwnE4Vo5qWvcV6icAwLUkVUcZUqP5tF+DI5m73377avxbzgsCcz
+8XNeTwmA3xStKLGDTMQPGN2ix7ZRS7TIqwM54zKQE601U9+zsVwUGazvWa4KwTPtNWRLaqIsq+R8LjKG
+toOK8UktKhsSRnzvFynkOZ0X9KwlJ6Q0tJmt6q6W88eFuBSF0/IRHTIpoJA0BPUqMpbXJnisL1OgSYnV
+4Ob4heXs2+BFxuhuWf7oEY2MFhBdnugN5zTXF0VX16MgxyA45K+dJZL3/TqcqbYnHBuFNa/FDI6E07e/
+caeQaqZPO6wnR9bm9480i05YaqahUr+hR8E0V4xRr5p60Rzi4WaBZhKfBBJc5xRp4u0H3xbcz350JRnq
+NO7fod2V+TqwirZThi1sTNdqQxo7aWNeZrmBBiH1EHz2ZCEc5994NnlRHOx58Im6w07160+fkz0TiVYA
+NM9ebpHQsmF5qatzs+z1NmxlKBPCgtEymhW13PidenlBZmxL+fDBW13Bw/rQb4hbG56m/Yn7Kt6nvhrl
+u/cJL1xOW37tI7/6XaWi0El/+bf0QueOuYqjESPnxrXZ5q9boS0Q1xlQuqnavPQyr1NtXrZUm5e3qJYu
+vevWibnj+hyJRNQN7XIH5qgI7MmZklUtMsZBl6XDkU2Ffo5W5iTiVRXya38j5KXXQN888+YZ+m4nJZy5
+rvOqIo7aQirgcxuUHzrk2DQgAUVvomdeAx4z7n5tO/cxnZVXVYNfrjSn6iMk8bNC8PJG1mkXxt/F8r85
+7zRVjKHjVk+lvKBV0bDAf1FmirH7piTUeqcgU/4Ahya5N3DroF7Qkt6LqmzsM1nWebkQDbDn6KS7gfdb
+ng8Bl0MjNx2ZQJtAQPtnvFz6r7/GX/uilFd5eU5xtRw9DF3IK6FNKOscAWfZMb4v8/PzSmAAwsj4FIhS
+YHP37AyxhVDDwMvMGmf7neRkt30hlg5Z2a1YtuNnHhApsCT5DkNu+WkrjkKfYnQWhkmrDkM/pzvOyMpi
+5fn2Ebw/aB2asf22fD+s749j/MESWIfzJwbqLlw/IHNHtl+TvpHjH+sx+5vYkbl+PvdMP+wFsG+NPTTo
+oKXBspxigngWHJubxlibl0uEpWYukipuUtNONuPzOaRTdMW8gkiLtdwwDMKz9CCmU7MaGlJLUwNkEmWd
+VwK0r6gn2khy8EjWr+rlC8EndIxoZc2FWKpu89Zkg6jHxw4gnqdPmvT26UVnY4LV43e1l5O3kPWOsDMj
+uD/6oYFNnymBenMe57BXckOKa3UCo/iJea380Uuf3/6Ypc5vHSDAy3WagzCZifdHk26e9fAB6Elw0bQ9
+NW4k9N9ZJfhFc+trVxlT6sGDFqbBvvOrSZyUQYXR1bJx8Ld4EHm+Wb9usNT1c5j62DfRUxJ5D8pkXqeF
+GIY/dfqJsw/yf62nvDbdWIEPiarmrz8pwOPnf+qfb6d/tnF8VyE8a9z8FA4fpYRIfCTLkAF8ooiXfHu0
+57ViiwFC8euf9vfevXp99Mpoqi/Ecoi7s497ckI/cDHrlfQzr0oSOBe1RsI3Q+dFjLbhQykIx7UOxrGR
+CPR7Ich9065eCmz1MzXXQusTAXRBJO6ie065U9n6xlRgjN7DiwqMvYd+oB9RLmZCf6ZOG7jjJLQW8j8T
+KFNWgdbOaoxNdAkno1n3H1sQPSU1/9N1qqBOihdgyd89rlEU1aizJ8t/dGqGbwBTwf6q2FyPnBYCaHUm
+QoP5IY06FHRr6bs0mThnU1EaLO5iSTgrJkKtIdpxDcLJZyYASBACKR4vtkNVeDc3DTRmAtPraMIGRrmW
+OKkwbWRdOzureEnRIhZjGL7JwrZRZC4y2EKJQ724D00hKw585D4QHiuJVnxcZ9daBNFKqKulJ6fMlVhk
+8nkl/yVKFxTcezqGiVVD566j9XBWuLggwTtNJ4gqlNiNXvR12JB+aIj06FlPVTYGA2vWfe8Z+R/sUzS4
+Cc8LZsfDVBYFGPyBKxHElYce8aIIV64a2PUAV3cb0x8vLbC0gBTYLeuYcyxblnyWj61LoZww9DMfsJeS
+pGg1xbefAm7DkvYzc0Zb9vyg+X54jfr5JsP7YFZCJSZRAeKE/hcNsNcajd9hXVrWYSelDzX06W5ug4z3
+WRik/4dFXtSPYRm4JYRC65koZHlO/RRu1dKuJLsqZhxW8NsFXuUvxNJ+qQR5tk8MQNdrNKDTTMOIyJVQ
+c1mq/KzQMYwdx8iVFxpR8wOpD0z8d3vDf0fbM1H+cEqfmQ7r1zPjf4lf2ZkYS3yXQz4AlZtbTyUX53SF
+xSpczWN7vuu/7oaaoyHQL8mAC+N0FvwcW6ljRFfWx71Yau5yKdB7YL5Atj1jsjS0eMnEe1oaDJDLcaHW
+snJBubS/wKQS4l9kwH81lYXQJ/LANSq3spN+PDXxGNnPgl8c8jmTFLTdaI5MpE8BctaGc0CYuR2XiUtR
+yDnkYsLBuOhQ7B6LB8alORb+02eyys/zkhev6Kt366MlfO1hyut9DauKwyYLdodigqvcBK0UClfXfFHN
+pRKqD+MDhTecYqwxOaUsHztZgXUpRv1c0srp62s58QdDR6PGMXEpqqXfd6iwWpTYEsXystenqRL4hR6M
+gTFZQuelrITS77n+MdAmA3nDSoGIyFSw0w9VlGmZKJaLoiS4lDuBKX4nbDD363ieB7Y53pHKTIC5TiVI
+nQFhYYlhK1HrjUGyerE0TMnFd7sSTPBK+69sbhqJi3QdroBl1k4WqCWsiiteZUZJojeRIUWNttffxOHW
+i2eDdpofd8nIgJG6yWGosh3q4zZJ2jAULC9rUdJtB8NVlZ2awWVPIxVa4UFazmZPRCPTDTY2N4OtYM4w
+RDXMctwKi1xNcVljPF3O5gXPS8sd/NLORNkAeOTKT/cuC7dkiy0CULpyEvoS8ZBbT0Na7H0buc09qcBI
+2TdnHCy69bKSzDYqQaEoxrXIvCJGn6U/0dlnZH/4QmcffdGnBDUiDopGGSmNTsydHWaD+7LvMTMbejlo
+EVGV+iv+SJejeJI2m1VnvRYznuMl0Tv5obs8y2jjccQQpt6a25uRH82woeYWiYe3JF3hlKujq9IXGrFU
+jz144Bki3Atvoq2leh77wmadmqS3dijcp3bd9TMbxJZOu5nEYHIctpJgxifRCA6w3zhkqHipJqKqRMZk
+6WtJS3FVLE2sEpEFQ2bVobrOFwbaJfaTZI/Z0+2NMCquybwDkUV158NIul5s0e0Na3OZoPGdo2Cbg24H
+bIeFUVipQG+7VRMZZoyUkI7waf7W7+Zpzh6xp2/9i0K6K1h425uu14jfLCwSsZaJrfE/aCLhXxMA8pUT
+cINAyK/0vm3k3U6v6xS5wOWgsQbRucFswc7q5epTv3HRrsT0dnY9F+bZCgR/B/3j65LMG5qfTNJsmGIG
+uUcnUnvw+ZjZOtY6sRnaWTUF3KJYO5q145zvu3MOPpszzpA0BnHuPDLCbl7rXU10BFd5sdRSPm5c7364
+PzgfsG+fS8k2v3OuIs+lHOgLnRWL9alZSrg44NGQsZF34I60EzpeWWZ8aXL657ThF7w2Cgf/ZkXcJq9t
+aXEpdERbrx7dphfinI+XbApvMXT1QWVErrWmubId3mBmzOI7m7HGptTULBeyFLtlpq0sze6xky2LbN+0
+vRRX3jaByS/FlTuN0+vWEfBM07yPdjV73+zC9r7RlbD52dx4McG00MKw3uXedjAxD9lWBNV3FBhzFGz7
+3lUTBtAmmFbY5jWlYb/xUWpkM+notE1cQhSyK219aejIdM+7mcp53hCFvLuZcNKaCUO8nlhFAo1wkx+K
+UsKtANs6mHdSHJ0JNjcPFn22KNGkGwrmislLUVV5lolSEzQqBBEsoBvkNJcCTcDVYll9z4+SfZwXdHNX
+teCFfgufkHANP8j/cWDvBaH4Zw4A08ZWBh6HBvckwZa20QAn5cHrlVKhHkCYslxfnm3chz+lwT+lwd9P
+GlwpB4nGYdKUh7yjQiTPiUju0Spno5H9PvGaZUCtdOD5a3ai3xxGlAkDenM6BJzaE19b/9ckNxrdgIPn
+Ct07EzycSFrtLZY6EarGkMXI7Ca1KEn1Jhe1gl0rJzoIAtidkxkY44rKslwRJR3UMq+12rwQnMIo1FeS
+ZflkIipoilGOQ0WagnSvqURqzs/FAFRyeSbKGl6JuBlso2JwBHUrNFmM+a80HU66t5weoKBCXja188YG
+UrnRundPjxPcDOivQaSpMHImuTmaomDZZ4p6FQUFnaOIEbVKVKWMeS0rEu+WctGpoCPAKmeLos7nhWCX
+olKIuGJHzoK3gJqJz8jETo8/nmpqXIkrnBpauGqaz5EPqXoxmQzY8/w9y0GlXwiuROCG8rPQnipaSbnE
+p0EGk6/z+fb8KpAm0lYLNsvakOcrYl2aF/Hh1lMPCdx/8x5uffZknbDX3/xpGfFvYBmh3dFlRSYSemiT
+OUx4XcU4u6JILowjWKPPJ+hagm90qFs3krAy9765YnOu9KOe/7hsNybqT7Uu6wzHl9givE3kJdvb/4ks
+Vo1C1D56nAHHInBpun0ip9HvBwUvzxf8XOCrHG53tZjDOiP9/CoDkdsFpnte8fOW7CYpyA8S0slyLl6Y
+iLmJgo08KymgHfIaVDDfxxi32HJRNJxE0SiHNWYB+61aVLDAnkcWMkGSqc7G1w/z2s9rW9IEDmtiXHCC
+M9qvKlntwqUjW8y0CQkcNq3yvC+PlWQa3prXeWi70BklStIR0maHPYOlqy8hyIlmop5KPE1HcBPAqh6x
+zmjQSdrjQXSO69DmgcwpKtFRrJR4qxDv50U+zvHSI1AiMI/paBSMR54V7JBHmLcbsPlEebqUNe1xvX/x
+AUixBcogF0LMGdwWLwJKZ6K+EqLEna0jzNjXcTwvf+Tq72L5RhnrBaO0hwyFPD8XGSzj5zwvFpVQfurL
+N4f7rw+e4RVk//XJf757vf+3/f9gO2zzv/6RPfqfm040/JuoiScZgaFD2M7ILIAR5KVZRC0GIkaA/N4Y
+RO2RyTuRkZW7y4VGI2YRmIq9a/zirMjHBgOV7di2gYRj/oZF9CrISMsJjZxCCpEBtG2P0UpAnf7z007U
+goGXaKvwvt1EX3/3SviuEmDS7yeV+ptH5ro5XRZFHhZKRwUOC7/H1Pn7es/V3XWzaFp4gxJAt4HuQKbM
+gwd2eVj+4g+JvuGkNrY9gw2c5pRfCjIzoF2OW56EOjpc6eCjfWsK4/kLpZADDNDkAf/U5+15Ja9IlJ1W
+eXkBY0VndCUwGKPIBihy2c2ORy00BZqkY8CzMyFK5lRovBKGTWdox/5QN57dvxDL+87Urdn6Vrst/3y2
+1ltMfzAW4FilYlz7uIQmPKjvAUZjSneU/thRzJgOeYvD9Gdfj/ffxdLp7hw1d6aINpXihw++Pq358mie
+pGGPiXa9pI3fD5tht8wOZZnDYSor4q609Dp4vcT58qceZjbXx6/Ro+tJWZT5PxfCmxtjGGh6iz9cj1ct
+WuhfrtiZADavFznjeDvTR42d/DMMYqkvbhaP/SEZF65cC2aX4+62YUXwl5x4Pk43rZ5nsQfBb7J+TANh
+/ZSIJbhyFd1Ln3kUfqv0NW7hwlm9KFC7pqdABfMPNjVoZpKPSQxQ0sZhZWhZZ65Zt1sVx1MO298IbsBj
+ZtQ2+In3M7QD0otmNYOPJn4mlOLnArnZGVfC1oLzeC5qxeSini/qP3wRtM2K7sGKtWClJ+2guOqsMip6
+oqELmNdR12QfgU3jEHmpQ+9HfK57KaV5l9AWuro211SbnV4wjEvrTOb/chaDsUh4qgcEHQNpzXZvyAhi
+Ys9EOiT/O6ojVlXrRrbsG1PqVGd763NaN6hHFQ67uUpo4GrX6+9Z9/4qKf8+e+TlfsTuD+4bXCdv1iIq
+uGKNxz3oerQy61ug5hV7xO5/5+h1OuZl5o1CZ7SmoGVi+MjJROORATfOJyyv8c1yXit35AOPJnqGd/fN
+C6l+W8WHVFkZ7oslcTt1VGxXSoTo4EcOU9ud5nTg0VBjj4Iz1j1VhI9Hrd/hLebGOx/4U3qHSOQ+Zt4Y
+naU6qYCwxYNQiX8UblzcpWGLDEBFsr/d++ygZlfc6lY45SO9LUx+VAutJ6ufv5ufgmdhaBjsI9b5q/qr
+YsdCsGldz9Vwc3NyNpiJTQwO+ViXf4wHBxrhy0qwvCQUz1yW5tBIbqJ+ywhsBF4I7kjZLxHSB2dDm3Pq
+5SDyekqrWg9YXjrdkYl12ceveE/1nGQjidpzxNDiSx9Fp9ITYQoUpPBkQhL0pgCnmDP4u0mUBbSka1bC
+MXiMzcSFpVtPk42a9qU9VVrOISCxpiSCQsDfwb0TCqXFD8JAzRX+i/ks30w9O0GGtBuk3QtsB3NZ6AJP
+AZN+aKFHr+BhMSWLY66gE4nHRff0tqrCoJvmTcGsLW8b4qqi2bYBVLEMDkOryB60A+vy/Vx9dVmgI6Os
+1lJwH+E9TXZl9Ldo/m1Xr7OQdXTDR2D3HRkDtlyUdRU4m4RNYzv2z+clvTl4TTO5VS3m7svVNC8E697r
+wmePwKAU7+turzfIZBmhVt80S0BpQB5ZTbjr5vpw2ZOLJMTtvQ6XjpsuLUXhRkX5iXhAJ1zoE6ckDjTD
+A21dcTAxX8KRi52ADB2/f9AK8z0WbcDnJ8YFbd460AnFkEAfrpYRuU68zjv2u6uUqIwvtdX+80oYy5qH
+KVk9wHZoOV9hGERVycocPGqQ8tmaa4W3YugEQZZ/dO9DO9lAK95GQKUaaXYzE2CLBqfn/H6f3ddP0vgn
+sjLzgWhU+SWvRchr8Y3CtEF1g973XQ/6xrvC1EyzaNeDb8phC/k2MPbjGoYZl1wP77Yzj4H89KriOVmA
+SFdPK3kF/oZszJX2MskkumeQ7TuEziB0Ak0KvcU8oXc+xSsZPnDnNcvyDIphLnoAN/AmhTy33hQU1WNS
+0yOdEnQ5RcUS+qKLjC3m0IpOUbBCaP8baqwm4DtkBE/DoQU/6hvMq4O7MI754nwKjpZ4iLqXdEvtTEz5
+ZS4rxh2oC70B1rzWYsj7sZjXxlvnTNS1sEt6YGmtLZ3ZZnYjtDm99HH+Y8NXsxI7PsZc569qCE6mUJiN
+/qpGNCw49dsozC9UTWaOpnyfLfTtAYVO3+2UaezygV3ng04Y8sPf8h8+6OwEjxjkbH/HOjUbI4BzN321
+n0BOdMO09tj0PKZHjGcnVaqrN6n50GfRfra714oexvNGvPf5sqnDBS7wgWMo1bN0wFcrMoPAtIGRx/My
+8WDSi2LjHJXgr0YaB1qhE8pJfmNnYswXtLMrwWpRkoEJTn4ha82dfYKwE6gLbhE3m3EaNDW4Rjs2xN0l
+Z9VDXeCzc4f7DF1lWAfapm2/ThClEW4znT4Lmtq3rWr4PkcHoXmJFBkasRo/Gftk5StE+RkwCHIbNKZ6
+5JWtRI2fRmZZjZgs2UjLm6NBy0FqcqPWiz6S5oxMhqgC8jTkCiq5UffVVFU9l1XQs65b+bqMU05pQ2bf
+3Mtoe/S3UBFVN1VQ9G3I6kjltJ3Qf4kUjLZoIGbHT2mDtteqofMK8h6YCQjLDvUj1vkAz7N+h8w32zij
+m0itjPh0dtWsUOPGRE5dqYZ2SrfsoJzIlKLkpfccHWVl3yZ6xja/6/jPidjJBnHbdUM6yIYL1L89GXXJ
+2ZJ1IjVYZ9D5dFoLH1WBDJ8Hf1UhnoLl339VHqaCD6NgDrrOrfEUmH6utBTokAzswI3nOzl12UECgcHA
+JZiGd5wlpIMF9eawH458U2kCYHVFXgrjD5krNpfFcpIXhdv1ufKwtWBuOY48D3RiLwPcLcxwZlYJlUFQ
+IzixzkJ8o81N9ujxE7/4FttklB/+OovelinlLHgqRhAuXjpVOcrYpK1Umu85aRE5rsi0J4jjdHrKZMm6
++tkBo5ujVrWW+pzUo9JD+XfOqzofLwpeeVXyEq2hnEueqxk99owTMnl7d2rnLUuCwZnWKyEUIK1joOeb
+eZfe7craKz+8JTfHBu+W2c/E1Q9pVJCvdANWjm9O4aujN3n7iW1MOuhRbP07cl2VFS5pQ2TU7ng02mbq
+Ip9b8A5qt/H79VmjYUi+N7p/LJDyJUjejtwjAp+IEIUsvm817lrr3LNwKIMWtBYK47Ldy1U3KOdE0H5s
+VB9Ie2ue2Z4QBpb2cr5EZwJjJ69ZnPHdgq9gbuyvQMVmuHz5Oc9LS6ylzcaLerXZ/6odbrQYxpnMbPOJ
+BHskuz9IGUD7Ce8seiuBXgyPG+O4docN5CtS6C7f2DYiDG8UvOqb6ye+cGszCTrbByYAgru7hiIA+t2h
+2ihSk+D9yXmwmOrZM1leiqpWRiqE9mh7ctSLjE2MKU2BOBRc9pF5OVWCb7msDUWBHteEO8rlNcinmRjL
+xbwQmW2XD32IreHVuTCQiQTVclYIeB4Y20cf08Vn2L6dpBUiSHfPgozPZZXyPDBvgp4JX1hB02opSC09
+mS5KaqhiInWPXualB+PdRqEfPVTRVXNjxb2YCntRfTcCN9ioIrxe+Q634QVYt/+uiFarKxtoK39M3J3D
+jhQWMr8TtyyABoIjAErlY/36SccFUvKUEB236dWAvVHCPffMfYOQjI18D9+RcWMY6OjI/oXvOgA5bNhL
+7xCM2YqDdbgqEfHFgrNvGMMj0BKI/AE1IgdOABmGIz6MEvhkm3msBHKJ93Oh+YYRLq3co3V2m5sEu+Vi
+0VaC/ByK/EIU+KiL92M0HaN9PPioxQK9M1ZPdhX4s2sHBHNGXr6LoghM+EBFZ/IEYGhnwnIq1oWTHdA9
+9SioHtDQKmJaTJjFscaAlF3eqtdcKfbpRlhD8hWe1VGIpYUJf+6DEOmI5tovAKrJ1RSlTTaT4wtq+nih
+ajljnuSOO2YQRujeQ70uuq5oB1jtKVdKBkhSorKgLhoPGsfctMy9H9nOxeErtaQujB9KEr+b7bCn9ELY
+CEQdPxPGb5Oec9pbzxvdVtJ+MOtBbTbw2tt82l9+mHKXp2k1FWTPk57zliOkXOjNAeCt9JqMoyyu+m0d
+x1mjQaEPuZ2xW7lRx+ryNIJR4mHJNCJQL2MAff9L+Dy3AsdoFc7jndV/UXmWqhTbHQxorlhmoMvA7BWn
+QLuQA8Ow15tQF265z0RWAl+KtV1LLcMlN+hEpRrqxtWTQTxEj3VjkBhJ8UO33tx/140a9B4xSrvUk6j/
+d1O//T5SOq+GDmRdob54rKb5bFNN+YzVUvaiK0Gwd+PF5m1iT5syTDjVt5yhDSyEVlf9Vob9GzE416pB
+wOvSrM7l7m37A+YjEeBo3uhAaNnYLTwJ290DfcoQ1e/rfsNJarj1xef9Nv+h4daXLjHt2DTc+vLrfrsH
+1XDrS3RhDN2Mhk+38KtzKBo+ffr1Gg6NXzz506HxD3VonM3r5TMXXu3TOfSZtwGwIWiW8FKtU9s6Tmob
+wT12e8Pc/ytxnis4ai+EmCvnPeVU0gCmH1tEqBDTntUSCI7gsBixLorlvMjrJcHEjcVUFrAa1AJUNIqN
+SqnGVT6vRxQwEcrZsTzYU69NozxXq8SoH5S/CFrdBDOX4+8wj8+DgwR7Q/a8CNvhi+LCLvBoS9vIoDmQ
+Gq63N1oyejG9bXP28sxGZg5FD5pystvznLfi5QGXXvdQaQXwQ1Dvo52iwZdEEZwsgZXZCeZxAS3t0biC
+bjRlR4cSLIWzL0X0WwJlPJc6jgRXajFDE6UrTDDWFjPBS4VVcw11ZHv8c14Ub0oKJWFUUoR4WelANrbb
+zlOtm5N9hNHqzOAqz9AkrjJvxWxBVPU7J7LBgVEtxyOZfvaibSKql+FK7cal/TjdhA1xiyn3B+ATz/rf
+RM2qPLNsVE/asRCYgRBZopV3yyHKxG85SJUNch4Oy12NVWxv9dPcSbXUK9dDaDc4FJVnhF5KSvL5miXm
+3tbgQYL4kSBlTmgJYkuQpYf1XLSh87ctc0HOs7/GVb7bMoo99/IPsU8jpS3HJyLou8fNsYuD1Ct/njmP
+lI5iI28qR5FZb+ty0GBDbVw/iP3htPBvytltWj9kuTmg0H5ESWBxkLOUVx/btUzc1Dkd5nZVH/3O3bYt
+K/BkwgHKlR6EcF43/RfWtj7oKu7du7EXLYegPpf9FTwMfvXtqZ3LcrjyjMescVOHzU+rbhYB5dveK5K3
+B18cG2598XVKlm/K7lt/RgT/vcT0qpIVoh5hFjhK8OjTccHvO4n9fiDL2WJ6CeNkMJgO5LiKcXa+4FUm
+MgPYA91x1okOXgRhP/ygApk4W5yf6ydkHAVxKcqaTXmZFaIyKOQIjcTzzOV3QdPOKnmlyB0dEzVW1UN9
+tltQeAW290u9j1WIRGhbiiXx/ywXMmnXmIs91z+1GZ54L8YLAuRrc3oFpbHv74pjpfMbxmUrwe+bG4xy
+eQI7/NFnPmKFLgsJN4X+d1NIQZNw4z39c+P9XhsP1vT+LK9rUR3m7/Oy9YqMOV8Vi/O8/HFxFl5hw7QQ
+SKValJj8fxZiIQ7KH0Dv18WtpF8/w8IDUf4TcuJXZTJuN/Npbu1od3vRGRd3zTy9WSZxXFeCzxTwA40x
+YAKyY7WslmwU1jrS9uhz/KCtdrSZ60MmETloUcKN2mptjdkK0BkZE1l4OYaB0O8uD9N7tJbzF9AcvKTq
+aBso6lKrnkk81Wo1GoTljZ+CLU9P57Dbi1zVoiTXaXP64xJFB54bmoFkDvYgrCAAzoTf2xpRoooOG8xI
+XRdET8CRHljeQtz1RFP2mIx5bfCGpB9/w3akvx7s9e2jum2Pr9WlhcZ2GsuRTCj0cvwtWhEEGl+1W25k
+pfGCt2Hoov053HqCcei++OxPPvt78tkXuPlEFck42uLgMVgvrGa/hkCC+5oko6Pcx9MfLOK9zRYWS+Qw
+pV/BbSUzZjReIS8hqQ39EfevWqER1TmC0kaDFpfB70HON3MTMDvOq1NuH10P4krCCGouGYONeWleiTfl
+GawOkR2PK1kUr+DxnzScYemWfPe9y/7zHF0nLJZwbGKMvBbY8ygRlAq+h9hFe0eHXpOv2SsiOpZlzXOM
+HSUrowLW11CyW85FZsm1/6fttUqhbIw1e9RP8jKj6jz3VKvbOrC+/pydS5mxPBMcz0k+nmqw9VqyeUWH
+76IsBRzwvFqCBQZRqisOcjwvSMkDJTW+CGCQgpUtntqZNJrRYmmdrMjeVEMQWENReYZMqoJidDjaAGRg
+90Fhfm3c4xKv9obhkvoN+brzx4RswIxctmjlQwH8/hpzPa/kjDQG3dKo2iwGF01ZWB+MskXdxPTnsjrY
+61KlEYhHo6nP80pR9XtHh11bha/eopLG+PuNvhRRWCfoB/zFprmoeDWeor9pLeeMBCe4p0PIHbcozFn+
+TKf8IOXF34WAQMXd4BxtnswUnMzLw3aCo9eGJvNKsh2fjs1h2q102OrrDQ1yvqJ5Ay8qHumKjAdIwswg
+1VYXPTLVyjDRNtBARoNvPB34ve0Nj+0OeJbBz7w8P5EgkKzoAUgbftH6Su7qV2P8XG0EonoofB3M5kX3
+zBHzXHZCuXL1EjMhOD2m5FP1RwVhWMCS8iovM3ll4EdeSDkPIrnZtdenUOPGC62jEOmAeJP3CqYNUtEl
+M5+BXGN9Nq8EO1sQzIhBNEO8BLNatDkGLGvkHqUOyR3qAvrWGy78jpLETGbGKWTv6LCPWMykw94wkcVk
+qZD1jHNBTqHeiCIuYyaIS9JTwP/Y2nryhQ3FbnbkTjQv2xvWfd7kMYvVH3+38DC2us1Ka9Oj7nH3INN1
+GIcbFm6fFWynpRZr3cC+ZUVg5NBYVkkCFgCiuRLTnDnMg+uro9Exm6LZ4F24CYzE7zfl46X/lvXv2+T6
+AecVyg4/8WIhNAJUd3zmtqOKRZB2uaNLOwvrGJ91w5Kp+7MncWKEMFGC+VXmggq/i29sZLO2wdjPBy/3
+jn5+9+Puy70X+8OkQDoY8/KNEnDQfq83PfMoKFH/2HYfDKs1S+jmCWU7EZtzNkFK1Pume7Ye3eFVFegs
+CFWu/3ZEc9WkGanJVtA0dHytxUnF5yrWVSh2ttQSDv5mZ4uzM2BZv4V+wZTXbA4iuKINPJYihWKXEBDG
+RT6+uN9rUQ1QeescJEtt3V9Lxuuaj6daItOGw56UayjsWjAd7SxPL8vMeaOSFxWalMlqLDBEMBFr/KfL
+1lPRqNfh72g9RV3x+Q8wxCLDXnuzGwo10RiZD4HqwT4aUppDAb4X+Iok41Jf+yspXETUiW7oIGBYUNwu
+32MgJGN0VfuX1jxVR3jyOtrzeFc/sVx5vGDj9Trm83pR/blgf8sF+wzH+N94xdIiEH/QktVYB8futPXG
+qBKTSqipPwrmrsN2Eie0X6spS/1PblI6/PqsQ5Q6fUvda2DQrdb5Syg4cWZWHDPxbEWG8pUvsLCdVTcO
+9J3HG8cqXWlS5glNycF7DdIZvgZoJFit8xdZoIEbL8eF0BDjrvRIKxK0j9Bo4C8XrSwanJESXv/sNi9A
+fb/rNizuRAP4mcauGpBKYDiPboLOOvpcM1ehLtd8BVXuF/02Zd5wa+tJv6H2MpE7fFXecGvrq36bpm64
+9YVLJJXccOvLrX6seRtufWVMZ32l2fDp1tf91dqw4dOnn6NC+vNPai37p4K6qaBu0U8b8+qNjzaQXS/O
+goE4ETaSP+5fdiGWwgEM15LhDCF2KBvLotCGpFEYH4Ueu7IyV38lauVHHoDpAwpXHB/mvAAHCH4O+Rl3
+6GsSI/l7+gNrJVDKK82KopjqJZNzDvDXGa/Re2wxhpNMI6kLtpifVzwTMI/ovYpqz6KgXoBKBxwQtSmr
+bkb3V2jdUHtVX/c0BDplIrRTdJel3APPAwzaF7YDqqQG9vGqrm2rNm8d2dlHqSOQk867yl9AHaN/zHJ0
+Xwwy7dE3m2fMS+3iGMdIcaHE3aGwucmeC47dqYWqjQO0uBQl5qoly5VaaFWyWYJo2qpBFCgYFVJq+BIN
+3GmXLWazZdOG0Uef0Bxcv9qt9Hr69doce97AmU+/Nn2arANOtRDXwePgmtV0LsSyk6wAL+3kJ4V9DMm3
+z4bDn/TcdhyYwryS75emPeTv9HNeT12YDj/gWR8WrhnQdI9MLued1OjExk0uXx/j7AXr59St3wA6rLMb
+MkwdM0zvfrSB1S5fWl8IvCqvVYx85iF1RA5jr7hS2u9LliIw8h/Y6IYRtako5qJSnmtYwiks7pY3scHi
+xnzeWn2LWI8mm11mKhh7AtT84yYgn8GTzlkhwqk4NMBZt5mCjxvF9PCxHdrXEYQFSfcWhgn5V6Y3ThSV
+Xtf5XFY+n7RGWBHkJ3l7a4A2HZhHh1mmEMy0rLBNdLxOxaLKFUbJkiwT2WJuTVuAmuGncFRfyjxjas5n
+M/oi2WwxnjrwatOYZ6Cz1idAJ3J9a4UqbRR+tIOZH7HOEHCfDFBBgETag+R+J/R6q4x/ws+ImHFEpvj3
+7oWjfBpXqJf6TbmCiddbp1Gfnl5fr+rNnn4f/bnic8YD2ccgRdNaRgZrQNmqUrGlXMDBBv+Qi697mZAO
+z4gW98D6LA/TkSfv5PbrIUboFt/zYG1ByX5Pf//wgYVo0LruT8UsGk65nRVCFUFEGGx6zoBLF0J3IYSf
+pG+rWIGedMqYQmRcBUP8h45CJgW949NIGEQD3baYUZoXdBfhz4zXR41Oi7wRw0fT4idWuNqzGXP2AzHL
+o3WD/7iWuqJZv+6tWak7EcI6r6q8bsot67YGvycbE/PRcENbmNMVcplpOYhjTexm212tRtl/X4sSI55S
+ueZE4+cmQlNiIaAIEcETWYPngAtakCJ3N/NPdw13QNTQh9GOhAfib6KkalO+YfuheUc+uMZCRg2cPbF8
+6SCcso/DKGiKCK6LIWxBZ7dEpBwyhw/GXGOinAm921HDEGMVrOIu9nDRj+dad0eTgaJGgxoFv/CBDZKg
+BsauXFeUWrVRlmCoVyxOn6RZns8MWJ9F07DZUJbKJ0zJvllWRs6yAzlgB3AUE62g9GNAyNG5+jiTBqCs
+OfgDdnBewijiPZaIaURAUYtqlpeCXUHhi7xEv0E9i7rN3rp34PF/yA7Y3KTxsDFj3Ebuu5tHNIRJ9PoV
+22ed2fdXC8Ac11NRXeVw8SZ0RgHvOBb9iYLZ6ABjFh6CvsIp6pPKawNMxiFE80xwcn1zM99nSmp1BM27
+4FWxHLA9MTGBzDUtGApP5NZzOGjl/ynI/5tB/8NY/e41JS2yhBJ3WJODqiukvFAIAhXo1Mxqt8BMJvAE
+L2MyOCim94ONBOBIMjqCW8xrBWdo3f4rVPLPbb6Pc0q7CTXiiz8Ns38nw2zruNhqeb13dGj2TKjP9hKs
+1fWtnGRcgGWjam411m7NFtJpGmy770HOhgNoXCTMEJT9gdzafHeDBIFEro8PDm1L7x0drirqJwflXolq
+ksgPn4N8aJtbZuJ9IrNNu6NlerjutBrAQ8JonetB7nvZ4qQOvYkPk/0hGDYHrZHZLOWh/yPMFUN2JBZK
+VCBY8sPodyKvXijD1oUWlolWyDAN+BkUgZkeurUQJtqZHUarIMymJ3QYviXbLCvODzvr9jnXZyLDb/D9
+NOGt01+1q4ZbTx14knnPffpZfyXbGG49dVSD7TLc+syVjdjAcOuLJ2uCL8GOGm596Spx22a49dUXyddj
+PPu+/PPs+93OvvmiPhbFDQegWSI2Z5rh2mQPxwi9C9RLjHrll/FTDBedyPEikdV+9rxvdsdoP5J6jo5T
+Q2fUXB2Ue3K8mIU+Ki4iuW1VN9PZBuaPfSP8a1cPDxUiMZqaObAcvjJlPjPNF9gPXMPU2hKDX+gZGxxb
+0GY8p4fxM8HUgpRIKPXjSypeQKf6WXwxL/Aif7Y4Z5P8vVCsq6N9Az0N8XO2qGtpXFYrXp4L1yr7TN7D
+7XKQbDNWTtd+XCreUeZ33HjZTrmy357xOT/Li7zOgWsGaHuRLSx8Avx/o9foYqYBDDkF8AAl88HLV29O
+OpANE11EkFq8rzseKHqi7Mn+f5zsvt7f7Zhw2gOYdZjeTI8xUaoWotOwEDsXrp8HLjpl4gnSLmiBtycy
+yg4WZjdE3rOvH67Q0P/hXt10/a9hCodxEKRwLgZtk9D1KPd0EJR2Kn63w5J+/aCtMa/iDSvQ/1Wh9b7w
+9scBxaV0y8yL90nREiXMCwXKKKSqNXiCpsTy2tllLBQYShJW7VxUQAcuznCuclrgqGChYPXabDKTMyII
+C4Rex9Ckg0KCoWFfXvY9kwq4ZUPntUILmuQcl5v9s2tiXuWySq2bwJhwUT1fa72QSjyXUfbWSgbehEUU
+joO1tJJIuOycvWfUbNIWRY3zdAwB/40zRiEQbrWeV9BKr2nlr+lAZx4S6sdp4aAloE39jYwnSaNtnvlf
+tEv8nYZoYDqGnPnG0H5OaRATIsmA6/FK8L4+bGRF1GLG5rn4P/5fmHUIvlUXbDFP1kAqRMhnChlepWpe
+1UNX6Bh+95koM+/jfpl5mCGiTm0NpO5vA1vaAyTuhPV0WF6yoCAqjQ5lJqrSYK6QWZYZDztEg5iL6tuX
+/krdwlKDqHMb7tkma2TZLzMz+9tx5E8rR7g6HzzQBFLHWtCpg/2vdVYvBmOld2uTslbE48rs9rwwjSeI
+WjHmJb1/ngkmS39ZzUVlycHwHuyjplJH9HN0zlDMlAtrAj7wNiy2a0Deo5ZpYdei2WobfzsDj4kUsGkc
+/W5nPOUVH9ei6vTZYxoRfCLT7mS98GEtC0jsl9nNBJyWcDsVw9Wbk2e0rZgw+0pWTBYZO9hfucwaYjIc
+q0eTiRK10vsgMLvWe82R+PDBbrwneq89SR61Kthrx6uZiGkyRaeG7cLLTLMWZdCKhLcILfPAHh6L+gbe
+4W8/U15St+0jI5TAewscOPotROkRMCFBzkX90LITtYKd9JkmH/AVGDi2Y5IG+Nsdh6LMvERRZu6E00YO
+mAN2qQ0g4O1UKu7RvL4V90oxnJAeS3ActsMOeT0dzPKyK8qszxKr+hNyI5/zUH5iNififR0xHNp6YK7L
+50p0QZaO0lp2NvY4kbWxg2HAHyezU7+6Yc9/9W3/gz2ooj3oFs96NvqhVGG1Os078XDrc7SYD26+w6dP
+nvaDO+7w6dbTfuoaO3y6RSbyX31SE/k/dSa31pkEzhF3gXK5nYL5dtDRx/uvdl/vnhy9ZjsUFDH4+O7F
+/su/nfzIdtwn44/tYY++z2eLGcvEvJ7i8BvMDesNDw+YslS5mei5VCpHUXxJh8oZz9jBnlMTHO7+x7uT
+1/v77/b2X2H1W0+euBodVh84Ah/ssXklJvl7Vku0UMBLnQUGjsBRVIyJUi5mZ6K6ZjmO7S5blDk8M+dl
+Lc418o6TYskrkEjiuqNG++5zPr5JCNxxjMW7WFGgRnJz8IiaMailzvzZl4Hi6BktGozQYtmbXZSL+bzI
+BYwkmQ8oAX2sZcV05EFRZm1RXvOMaTtZPaiD9kGiZWiww20zaqnhh9fAMXVtbzRUlJmhbVuRinieqx9g
+m/Jq2c3xMIuHNc8GUM9ubYYcjik31h8+6O7A5zyz6zo12sHw0pBRyzFSQmPc1hrgPqDbnIk1RuvmCluH
+CJ/jm9CvuZZNUKlFt1k3Wk/ikXrwwEu1I8Ues60eu+fnpAhobQM4yStVm8VZOqQIOcFp/+eC64iYgikx
+lmW2YjRN4YO9VGom1FiUGS9rnd46vCNHaJRo18inNFq11XO1aykdTbqObD9oTTALNPB+8gBXZFC+Z2Oc
+0jS5Ve8X7Hsj4t1Qgtmw2gG6cWlUvmBph+tzlGejFcuZynPHWwdJbumqoXoRT4qXhBes1/aKNQz+oVgu
+uYq/h5WpFmeqrrpP+vCj4IrOwqNJ1y7MHliHdTrJ0SjFe9NlEwO1roT2vLL2hHaIghVDyxXIufRMqDov
+UQsGa4YdYOeXjFeCFnlf8zb/ynLzOjfD7S3QUmbCrFK/ypYt4bJ4c+J9N/qexBy+hDFy4+OGJh6NdFNa
+5hWo7nmLON41jtDHYcI7NuhvqwcPvISwNo0dn2riX1Wf/VX14LVmLHKMSVBifQ22rG3DvT6Z7e5qauDH
+37qphDN/95FZxbXWG5HBYNAzj1dTrtiMo42WHRR8TprjnFNseoulrxGOHDzTgO1jHEHYRn9VI/2YFfPk
+v6rRXQd3zd7SoGKEaZsH2XBiUTpXDpvVC6h6DEGGbcxb2w3jWZPPZiLLeS2cCDRgJyQ/CwYSbAHC89RY
+hfJS1lNRhQLTlcAnvqkR8dIsIdBqNA4L9qgh9Bufy3zbwDXlVseAYExBFakgVDCA4Xnl8vdZ7qnczyrB
+L/wLtB3VsBKP0/damDmvhEJ5fya9daMZ3pVkB3v2CvBGUST1XEG6Gk/FTPRvIqOtUTGmoc1B8X/xEqL5
+qq5K66bMNBdLyDYWmcgYZ/ch8IGo7rMcNGH1VB+Gqu0wkKVIyzv1lYwEHce42zrSt5cC7xyGKStlKVbz
+bcRre4YU3YbqYuv61Bbn+zvLyxcGn85qnzCrXjG6hK+AgnXjlfPjuuv+dTpmj0EdBTfNOcQBNdfkJzpU
+CoRpHX27Q8d1uLlgAO7vH724b0WhqaxqUenxGDSQynDp77hurVrwekRyzcDtd+xwuAHaupA3FHI0dkYY
+JwGcRtB+W7mvcMBo8VKFwNpoaXnbK9mejzxqzAEW1e6dLemFZY9cezikrkFD9lelTwUad/yThhr/jKpd
+cfTGDQxiuUSJPhcyjDuQslFWOhP1lRCl5QpdkSMfX8yZrFgmr8oe+eNDIr2gPDSBaI2+qe+FzYUsTLzP
+Vc14uqKpmBnRE2hZ6BkTM2CEnkKjvtPWYFCDWqIPQcSAvjfshM6Pgz3Ga4dkRB8tnUFLSTmPC4oyaynm
+AhWMz5gBSYEieXkpLwSZ3B/s4aNdWJ+94UF4f1xP7OepwMFGibnGFsOp7K6kTu5NUnnBVxMpeEgjxTUN
+26GrzCteT7uK3kBhYPpsfIZBH/uu2X1bN21n5Sv5HSYhFMevcu4+3nWPEu17O0RP78tE00nke8ZLHAqd
+roMqlZm+FeGipXukJ7FhFY29F1bcs5i0hvYb6GMkvtHAOZX+XTvt1fHhQ7MSO0m99vEgDtVnK8YlkAcy
+6YRgyIomVsFGDobL/CnnjYFbu/E9GzcsaNYI89DVDfKNmCyFVuXymnFW5zNhpEf6rM9Vf3rYjv3zzZx9
+79/Z2TB109sOzlX3FsY2H+qDGU8u9nBzm5INeVT1UYe8x7pK1O79rXvPbX0Yk0yvK1gnaCl2z25qP9kj
+ibye7QDgJVTnuoZ7NIiUChVi5h0NQaJp7hia/kPwET6lY8c2N/mkFtXmJrvMVY6Kahr/QfrkvvvyZjRt
+jx6xbyO1uoX/uHFF74laH7xodJWXeS1YIeVc48V68fE1LRKsRXBC67cYfAzSwN7ZQlD8nAIeclF1rbyD
+3Kx/s/bj1d/Ws57DRLWPFCU/1wczbEOCtiJ7SWhdJeaVUKKstfWXwQtqgPoAMXjpZtygoI/1zYHlJYVV
+DMRMc8cVgDaeU/gegg7E0CmuhMpni4LXGhEYAxk+bOobV+Dnh6FKENqwWoxrxXj4YBEBDZoDerfxrKGf
+zrWJiHvHaAcmTb53RIbxMTn42O2lgCATXTjYAxjIX2SObm7cNNZgI5Z8JlbBQWqY9de6lJw4pusrMVvD
+H+26bB1Fn7pcsUnB61rAarLhnwc3jLIZ4ATwojdAwVhT45NxkyiJPcK0xEja67FRc/pxYG2XrB2FfVXV
++WDfYqIzE+N5qZI0bCwrqxC1rxSt05JnXsN4TDIeSitS3q4zrePdhq7vDX2eBTe8LHwRaXkv0YqV79iW
+KWyCfeqrXWa1/bZon2058wTqL2X/jj3eaii76VVryPIsAZRp8DOb6KreHeVgzynfyEUZ5WsVqbzHZyAX
+oPWtkWGImvbMvML/r0gjyjgbzeRCiX0Y6RFGj8DfLwS/FCM/gA/+H3t5dLI/ZHsGYYKagC2wtxZZrlTL
+aDB1Y51L4SDvC6hfZPehBfcLManv06LwLdFaVmQBTSU9ubbdFZM2zoDV+Hl1vYO22GvpK40s6VajS29C
+jR6bcBE8FvPd6pwZWH6PQjBiuA9lyTQZ1aQDt87bUNIN84mlQGNxdeHU43x7u0gPat+MGN16sDt90xpf
+qvN1r+1qp5hqz0mCHgGQl3ROtxsTco+l5iuHvWZqxDXPQum6rTrTnlXV+bUEo6KHo68R2LDadjPgYy07
+KM2uzY2eDPau5OP5lEOzNVzuJmJ9a0nDgsQ2tyRKao7alM/nolRMlsgFDMhLLRcUVEUfMKv2VR1GBMPW
+4qdUOLEbdkxzRfM1V/ONy/fkSr6CEfMWr2m5var7J4JJXDnXnU6fNaikJjhZ2pXsdLzS6fXYwvMZx2HG
+O7kJUNFk9BNZESfylMUD9twYiYv3fDYvRB/xOfDeMngy+J+VvHr8ZLA1skcB+ECwvCSS56LGKw5UauJL
+IlsZDZ6M+h6FESm5ApJ/rku7HgzbU+sszLsvwWj57L+nQKewMBZlXrMa32TObUeNwku39l0brx62cvGP
+rzDWMCTVDjrAgq8kGUa/MYuVx4buz42VRp3BZey2YA9NR9ebQw1//afT6+9swHnI50kLTT3IozjziM14
+rm9KnM34HMHQdeT5+eKsyMdswsfwUdW8Bn80oJNrCozwVuxLseYJkaaCaRxNH7xYaepAbSbqqcwUq6VB
+bgNiCyUqU7etjxBruOFTUPGMz51zG5CrpWuIJq1hgW3UttfCOMXlxIvZ/vGXQ9ZQXBxy0OmW4ood8nk3
+FRqQcoRqDezl7qsDD/eIDhU2osjsI3SHvRKdTMda1dGZFaIAS8aLK740fgoVL5X2IBBK0FsGvsAi6znY
+x5NRVvUA1P+Ec+oVyhXzgi3orCIzcD2VQAVBHvj8gXG6x7w9ZNsLsRwQ6PGBHmEzDmyHWR+CwLU0TUjf
+/9rpORpTru5IAyXcRKtU3CqNTrxWLy3e6fU6zPaQz70I0N/clR/+yQrXZ4Uv8ol4BsETVjFC0u9SqZmn
+doURvtAhIdBE27McZOj8g9n0Sae7RDdqNOYWGZOV/rAo9afAo1eDxRnQ9hLjoRBP1cepYl1pweZVj9pB
+KFylrFklQLMgyowUw8BZoDg4D9W8FptIkPAnzDjZoOyH2uDcLM8h28PwNCTmGVUm+s7RZcxVS+bwOKqk
+O84h9DvjJVtgXUzlJbkZO26tiyIDsuOzFPWA/SivxKWo+oj+5jWQDgdaUBoHoLwUZS6AHPC7mYE+pory
+OucFHU2Nzr4pZ6u6u7DJprNR23FU67wobNN5meGKxfdtdBoArTouIjsOXt9qi6+Pi9+fOKBkIdV4yURV
+yUoPYQ56W7JCI9syt5Bo6ZrKr4Ru3xUva39S8tLRnMhKu4lzJfQ5aE8xu1e0x+KKhULxy9jq4YVMK7ii
+rc7xxC+f/Ckj/k6MEYxkFnMyd1/MWh15eFaI6rOnoU+O/hiiPYUEDeTTj/vP/n785vDd7snJ63cvdw/3
+h6yT8Zo/xhP18Vhn70T+ndFld4a0GVWhJZ6W54ogD0lzphLG67rKzxa10KGsRGblHJ5lpuknkmh4UgHV
+H2AZuH7q0TCZAsgL+jbQkTTM82bnO/ta2IGXyNQADppDB/jfO/chv639Eevc/67TGpisZRzJ6yjMAjGV
+Sct8bWNf4RLGtplPwaArzzvhKjI2mQpTWa7s0nQPRrx8LRZKtAx0n0UhtmDE0XYoL8+9FaZzgZfxrpna
+ru8HeeOQupFjyQriTw8esDmvlDgo626c2GdbTzwAi1m8H25eJy7zTrPum3Abw75aN02zV4db33yNLHbr
+T+fKP5TvwjH5SYAYPyFQ4TM6xo+uymRxPzkot1a0pDg32ixyCrjbUsxm+WSAjh8XvD+837eVPOTzoFTj
+RIwLhhnuBuIoxrIc50Vy3lxiAsbx/2DQuTYoR0y9I/gjmmdr1IMgt5dgSN8OP81/+NZL5aB85sWQD1HS
+VuQ1FEnCr3Njv9CyvFqzOTo3ey9DPiXqg7IU1Y8nhy/CrH6KzY0qIxrfVc1rz2co3RitLPasTob1txl0
+ASea7ASQmgd7eMQe/PDmZB/TqRGlzATGFjFRX+Dj/ov9w/2XJ+/QHuzkP18Bra1tzQ2fBZ+/oSs7qN+c
+ZrJylhCslg2jCnOFGxhbJPNB/bD0jChMi24iPxqbFTTC7ihL1yakCd8SdRza8e7d3v5P796hpz2brWgU
+HnlaGKIGaSNDt/STjbpGJegbHZ2OIMfAii+rp4/JzlgJXo2ndKVGcLC8zOzaeo02KlBR4zNIdwAcg9FQ
+2A47feu0Lc/zMlP6eu15ZVOF1s16A1FZOMWGt/YTnrE42qee55eibDihmDuB8QFv1GVrAbm1Ep7LsmJZ
+fimq88gYGhu3l08mohLlWJAJGJXY6uuiT2MXEt9/ROe1HiS6iO9DkvDa0IQaHhuGWuhTYWgmvCr0iORJ
+h6WwbdoQ1G8e+x5seYamNT4sZOL2YDcCWRHSZ5xLtKHUyUmrqZTN1C67b5f7fXwIzhMmV+SZWmbajKUd
+0qBrm+emy9snbCfI3zw4vPKei4VP4cGDZoB+Lz3wAnNh1ICZnFp++pbJyj54B9cbm6XHZMk4wgkBJTPO
+pMen2a4VjnYlATZQKkH2f+QUhn69ynHwAQjsQAgmDTmbeR9gI78BcBzTiwWK1VAdL5SERpp3DXIuI7c/
+E+ZSSfbLQtXOG4qCPXQqYfawhNcZ6C4VrKdA2W8Q66rFeMq40mH0ew2fD7cKP+wdHf6MueAvA8cHfwOW
+D/Thmmga4jc5gnvO0mRtN2qusoM9D2/Vmomb16e/uQzGYyw00rOnYxzFIM96oT3eGHIBbVvkNM9s8AcK
+VoE57u1QnjtFYGpYZcN/97TPka4B/Nd7zZBMuPSH7ORKarcnWC+LEtecXlzO6hLWJPhbBFbU8J9dm1BL
+EIbJNS3Vnp6J9gj/BUOkh2wl/Fl7AWKbvjM/bmW7BBIzbcKfHUyQDjos2YWu40eYuNgGt6pv4MSwSJ+V
+FCKRCJEnktmZVqGloAxngSZEP3f24X6rg6zrlm/Y8Bu+J2WfcQt8YQmzK3TeypFJDVzfsTcPHuC/Ya2p
+jz7X0i4/jgda9DZcOo+tjbrbfMQhzDZtO3ewSSdTt6c100Nj66JAkGFRD1qgIU5spECL5OJGIdrryk4w
+rje732WRoWy1YstTlns7O7YgY/QM7C08zPTW+Itiv1VyNLH67Y22RXudkLloYBomzzT256IUFa99E+gb
+kGGSRRwj9efHTUtQO/BUjdqxApQIUUbNkGGkqVXsEmPzGc4QjA0xCD3w8ah5xzZItPgTKv5hSUge26Hc
+FHHfW4y2tqUw8Nn6hhIN9sNrm8R224t4ItMnHW0wKzf3v66pMDrbYvUHbHqXd/CukrIm03Sz/pPxFNTL
+RVHY3zTW0bu+tQ3/bzD9u5bX38fT776OpZQrKxtn4BTCvZdO86hoMlSBrnQmuMF3tqvKxjc0RiNnIibv
+wZ0GjjtnDtxMZHRCr5ClPM465mWW4/tuKDel2akwzs+OkzdFLfuCYNxVbQebw5UcpCZ4VMSZjWxlpv3u
+AlDqgCcoLvuc44s/b0o7AgR2P+YmsjvIO1bWiV4jQhfv1iqtlBMoH1qWsb2+PJeVW8xaVrRlHzwI4wPY
+FB0QoHGfdMFhY9lIBzf3gLX4wrhwoKjGaskmEg1W6XVblgkJYMUBRL7njky0FuaL6lx4AEyNQ9ZsWnIT
+FXOhcLMfy+ccRpEYjqcAKLM9yoXFM2uBOZsXAfKNYZDmWyCmu4xvY7wWDzLHfvON+ntOXGg01uTa3ojE
+WROwTG+oslOzuZyj5b190MDpQCzkeSXUYoZWCFroZHmtDCEH3qUYryC+A/qF4txNNMKInA+CmGO0CkLH
+xtcuEJ7uirnQhEJbrhj3IEpGxgw4vnm1Tk1k5N46ySyt7GyYMGvna02Udu/KdbFhAs9TqIRFmWk1d9yU
+7dXNM6NpKPj7Cje60pdxqw0hxyglqlrhyVNqB3cyKg+2VKgvvnZEzHjgTrOSSC2JDScZv/bqC93c4GPz
+rFhDY6RrwtYPGk22jxsnFS8V1/bttfvhl3DoCKgp9x6fEXECrckzqZ25YNj0i2y40rA5Tk4pa4lsEhdC
+Y9hofWgXSJ3FsFNj7W6aSh8abfMUivjbMHfb9WoQNqmtKaYZQZ3+O82GfgVvFB28y9WJnL8Ql6LwooF7
+Z8w7bMLBjJ8LOyLmGd/1ONE5fxX/wGvkAHZp/TdfoZ9mvZ3RqBx+0mW3YpV5q8MsNRP5q3XLgcj/Cnqb
+ESC1R2Ogw5RQS9Obh9pkzMl+m21kFveaHapEIThiettvvQbH1eimnvknnpZkgpj/K0df71hHfbYkV2ks
+XCsyA3S28ZfCX13KY+LaSnFGjJz77k/2OYqMLsolhfAwkTGAQJ4JPyO0OxOqruTSCQFGBNZbL9jhpFfv
+0ndvivrmi0VcPxdGy/3D8gDwzE2lnR7l7W3bCr7N8kuWZzv3bab737Hmf98+fsyO27rLDB1nUgYUB5/d
+/65J5bV+HnCvZxYzwdBCOWcwGLCb/gsfLbCGzSy//E53zfy9gZGmcALkxG9933vystZWpn16XXnPHlE0
+KpwWtmMfCn3vo0ycLc7P0Y51UcFnxR6Sy1H64XPY8iDabzoxoEA2lfLCxLWlZ0Oti6Tmp/CrCXKDCIlS
+GaNbfZnlc41noMaVLAo2lyo316S8VsHKNXdeojWeIhx+wkkuzbrhlPCpkWG2G3Zi4mm/OcplXefoVr5Q
+tefOIfXhk5GoRSWcJwV171CWeS0rz+TNOyPDSpwGxP/aTdn5nXDUJIdQAmA3XFSCZ0674MuByF60YSIO
+9LyScxX2Pz55IVC+/Zl4ZLcVaq6yd3SYIGinphTvzY8UsVrqvq8zue4vWwy7G5b93s2o9WOcOMQrNJjG
+qcT2FILkSOO0RzbdsD+8eJx2IjdMBCVvjMwV3+up+RQdY4yNo3m/Q5zypA3VAK1Fd0sTN/9wUfNaZKAw
+U12vYWFsFo//ByvXX68NUBXWMDAaiPKf8K99SCV1RjccJdZshnnHCsdkVRVmh7TVYWlFzz+97Y27jvfm
+JgMRosqcGGm4eV6ijT3Lawa3bbRd0q5Y84Jr+31mZJuklcZp68v1W7Zjh+NWz9Vx6J1giBKM5bU4zxW0
+PvB8MVzE7tQZnyM7QewjZTg5PqcQnRktHme23cJgYB2sZDCfkifEr71OLrABGtzer/RIpHZ+0Grv1vPx
+GsdAN9d1b7j2O0a0OTHRIZu2VB8+sJa8gXGViQ1l33I7zQ5rDL0T8jJ3LdN+PdwXXgedhC4z2ZnfqCvu
+Ebg1zDKKIuIY1+pPsFQP7SLt+rrVKrCbCuRiM0Jtmy0tWp16FPGxzl8+gVm4lzG5OXFFc3RJTWxPiyCw
+xvHrXWk/9Zm74i6MNiD6u0OnNI4DdPWRZbRdV/IMf8NCe16Kq5XndeNgvum2DJdJp1z9iVe5XCg2R74n
+J0wuKjYOjFVcc1Veu1DSHatX1S3V57VwhnQ9E9dWe2wVhfIGmlcC1LmlULXQaEqAzSeqfLI00jUKgaTj
+VWJwO16kjUMDfwrfEHygH6/Yzo53fyfOkRr5LkKuQ4p18XYe0RxuK8LOjQ/PJyckmZoDphbbRlJDuyns
+v7f8F9pOGQ0lPTpmfWpuRcGAEXC2FNB/Xi37hrChamjlQYtsXXu5trn12B0MRfgyEyurdtqNjn1xrG9I
+3ciHEqx6I7qmhtoUb5UbBxj3WuAcKd2IqWU5nlayhKV+hvHzlnZscKkRoAnLFuaIB1r2RthHaci05ee8
+KOgGK73h2ctJz9W3xhpa+2Wo8TEIWnh1JPZm1h/myxGToOK1OF8OPMZv1D2amP5pxmelgq1/0yBW/m25
+RahP8Q/G/n1FzvBo+jRCZmMAW88ylTLs9O+scdyoUCFk9QAHaYA9jLhciUtg2WgM5d38Y+0MQkFQtOeH
+Jt6y598sSxhmXmYUbRQdkR10JleOqRDLnhRijPoOIgjzo+o0buC6R7aTkxuH92Cd0zvSZHvn9+ATXZrb
+z+xnTcnem43mtBIUBWSIxG7LLN3Oa1yk7y5++4M/0I+z+mdwcw6f/43CtDdkBzqiyDieq4Fn6+jkeh2I
+059lDHpFN5ROEMW8w2A7CZ6R2xpZEfPSTqkx6guwRLyTxzvRkFyO+mlDKK+tM4XGPzXD0GHDG9trpmid
+FntjMy64Ur9tiyG4LahDtEnOPxccwn2RJWbpe7nGaht2j8QctG90nwckmwTYIlGnffRgBH1Ea5ZFmaOC
+Hl0QMfo7p8PtSqKvwhzWUFnHfR7LeS4c2G/QN8Y6Hf2jl7h+rbuYA8OSUOO303ajaddUeCddQCu0aIYk
+u4LCSgfv9EGv0331ULvfU9ejGKqXXMX2gPJlqYSOL4ydntDutWj4kgKBr+mzn3r4ioYWftbkzqmpQvvk
+QDWnwSBsi3br0HAn1nUlbrV3dHyI7I5+5MpzabSSQ6OW0DkiV+ZRRi+nblzgIyQlNBNsa+CHD422DWAK
+j/Mz8LnoecMdeYjoHGynQWDbFsEHD9ZtlvLpegaRraPRJBBSuPt9zvxHcIzRx447wU4cqKCOlYXjZ0/r
+syVNaF/7hIgq5tNIkFeCLcpKkOVRiS4A4MkhisIB4sykRcotlh6vTNC7mua1eKzm+HiBigKRMV6hozY6
+m1ePbROpyYNOQMO7q/mfPRB7s3G8H+ll0PjoryRH67ptKzb1IjutG+vBA3YvUto2r5qN62HyPn6Lp4mW
+W0w710rr7s0XAhq0ze0lsKDHK5TSAcp6Qkesn1opj6xGGrhTX2vx3WPEeKlRPSt98/DNpG66XyRwNV19
+jWuHlxZL1uQeda01HAfUQv3L+Co2OviJpPtPKZvbLu6CwSlkTmncvIHoUycTynJYycKeSYHQEoh1CWoh
+pkbTgkE0Lgu93315cVe5tsrMRFnnk5xYnVt5eXbjktNNe7be2vsNll4jtKqNHfFbrDxqkj94ay1AMN+/
+YRHmmb/6tIcf22k1bLH20x8R4oQqsTfHkyonewo4bc3w4crK8b50/6/qPh6xHggbWQ65EKfnYmAvldpj
+Loo+QpX2WjfKimFMDpwhuOLpUCWWfFlL7cnWtBXR2j/PmdcaoKCj6ViHUo9MZGABoirSEPDjpUDeAMHI
+UMcRzsv1bUgai9u8jwbdbIulcdJss5wYeirdKlJ9RC9NKfuRMLyQrytuvZ1texGBbHqgazyYMFkKAiMK
+rua0DE1Yu7BTg6aCtA1Roi2URdCgGLb+XltrX8pke8hyuq+XDqNokeu2sBFMphu0pwX2IX7js9lu8b6n
+MfXo5UNb7KVDmMRc8gax5ealrb8mzRjjxd2IUM4jlauFT4J+WPhCfKAhUs3/vA7YTZC+667aCf/dHuhY
+9xjRoIolU3PBL1B/5yFTXkkgiPcixsNXFo0hrCEgM8xI3kfa4QuG3+SFljK4qQ16v9ObYIue4jZPgnLi
+v8Hd/UnQvWp55H63J8H/jqYgLZP339Mc5NanY3yzXsOmwx2r98ZNfWfsG2VOkhVqPXMuujtzfIexDm5r
+N0/nX+f82r6rCk7Xsd5zY0ILYNxNWg/GyOWJ0MrxsoV7fUUcjfjuYcatHUDqDuKhnkdsjCk/yU2cjChs
+BvtfSgj264plQBvvuvVENMvEjwNmXYAap2PsShRTs2V9HexaW84pfDy3VFfS3KoCtem1NUGY1vV8uLn5
+i4JX18FYzgg3Li/PH/PHUOuGp2J1VDH+MwSzS1Q/oIWByckirYFonCv/ClgjnaQaa8dec+zDbxBjDu74
+7ExAfGZ1Q7Q57e0qJ/4Tn6+EvTkIXYwOkO4Cio9W97C5QS6OCSfiZLC5FjuVtaV+e7GOnZrXY1N35VNN
+EKjbsCyqMoKA8n4OyMEBuohtaezEj4flgWdNXhShhzqKeCbSHAEUaSFVv/7pxYnfQmItc84wOoyd+N7A
+KxVUHQBeIYdIGMs0HOZf+9YsB3ssI8C1rAF65+nyQ5niE47DXUZh/TEIMIqCxY4MKeCX6CaEn91jQsCL
+qciDBza1cbXdiUFpwrLRqxLd+0OmN+XKWJpibSF+E4DlEO+QRRZS8k2U0O2pXHNzsTxalAggpmpeCDzs
+SyEyZUDxUebOBhqlQukrWUbAw0rfliwlfY9hYOQzFbFmMVfkGjtIv/ysedGPJqzxivuxT3eJh7v2zQSz
+dyZg6PEg1DsqrxWTVX4OEknjkc057w3YS5h283PY6bdwt42Vz2vJ9y/73OOpR5JHcMuxhz1OIOzcGL5V
+H6YOJ0erTm44Rf1DFEfYlpeT9vLBMWogZW4+QG8J5tFU5zZwOZ1azUJHNSLvkRInfAYrEfUvV82xTw3z
+Qw9cb99Jw+iHdKP2qJ6GBcGylFdmo7fUn4hA13hN98bbh4Mx8DBOoIWduBXpFfWFdt/jYzruBl12o2m/
+4aoXAij5QJEWLIw5lDmKotsWunfoyLcGzl2QYb2BCVENVEOoltUSF6mJuWjCEXOi1ow5viL2cXI9pATS
+S1HWpEK4XhHtGCPwYUV7R4ct84iHqFE/eah95qKgkwDjhv5siGX0OTQgWWmeYUokTIp0Umh+w7wGNhux
+fkRkzRHb0MUcUorx/o6mm6iMzHIgOEsGbsSFzGsbZ7nkMzSrpljxtbRmsEgLkYGJ0j8X+fiiWLapmr05
+Zn6d7BhpmKs6cBcv6jUQqFYHvEwvyeDlMsW//fa0wKSFw9jKYxr81Vubfk9dDEt/tTq5rhIl27kBRdm7
+F0GRAx2T+4mn19IQMrsOeWgdbJwPH4JZ0fSCxp0+eetQawyJhlwaFLFowgDmHWxE1/pvk0UirFMtDoft
+cUQePXq77WWnbulGbQTWWONQOeCP5V6KFY89zYAVuSl7Q1yGNaW9mWDIPew/hLgtpLxA8G5Z9XWeXE3h
+89z4S1hS9bSSi/MpCmeKbIgUBQcjk9ipWAJJDZmkpH71FFrpD2hwEUHJwOZd5RqyF9vFz3leDtgRnGNX
+uRLYLHzdK//rqXvKwJGIZOepKBmSM7GzZrxcsrFZx3gogEVuIaxghchzg43I9M3uaDjQkiPL/PlkOlNg
+nUVSteXUsaIhjJRqps/bjnF9dPUx08jNZcfHpPKiuAf4pOHFVs+lKE1wxTNj06ynC23cTKwu4qaa/5zV
+lRAxKWCNImNcTyK2C0G7NHlYNldMkg0eq4QyqFwxIR/SfRAktuzfmN20F5kv1JTG2OMNvRZrusSFyNw7
+Tf/YlGeslAxnTN/TKtFBve+U3IFRRsFnxpBOXv5CyHt8UcsZr/MxWlmfLSmwH7mDIuKtDuw3+rYGVvvd
+KKRjrennlVzUdA15X1eY/0xmy+9Guql4PcbDVkwmYlwTYq9HaTTITXiHEQYRAkNBdjSv81muTPvgHpSX
+C4HR1HEkQiJnFS/HU7K1LGXNzkgGhQjX9FqLV3AYJss7/Dm+3XR5kzX2N2DKvNHmdRv7WcxvgU9qTW7Y
+ks3VJ5+vCNjcxH4775oJOpFVlRjXpVCqb2xkpqKYkyTzt2esEuOC5zOfCvAsMkPVG7ISrBCTmmlYElFm
+VmzCHePGsWWfPPE0Oub9wj+NErffGyjdXScYKAc6zWvgYDDos7+qHoBUwjhb2d882JHBjQ1K6D11GixS
+Er/gfX9hcS6tJ1TGumJwPuhHG67X9ykt1AIXfbbw0CBrsjnQu4t25wL2CsP9qfr4EEu/z4M3XHTi+BYE
+1O/67Nv5d4ij/S2nfxcaZL98fPzT35zFDcVG/FZdnn/nkyKhfOB/OqmWLC8V9NPsM9oUtIj0UgkMt+hm
+dLCHaJ/OMisEEGRNwcOXxlKOHBT23Lu8JyDYEjHVVoKx/TdGKmhB5Vv9PE2gt/8+z9LOmyUxKx8dpSK8
+zYZB8cJYfHa1+Mrr5v221Tclio3oUUlG6WO3i9THXLS+SCmrX/hcBetRjbXwQNHa4Pvk5aKmsztdu/qo
+vgW+QTqb39+gifAoYqSxZFgafwYP/ViDPgUqwHZYh3XHRQ6zHGmAicxALc7oBt51FT9mT5/0vYY8Yk+f
+9ILCnX+UrEt+GEjXa8z6FL2Of9zrWGJD3os3ZPAo9Z9y8Q+4ZNXVUjucG5uu8HEXMWz1a7Y+YiJ/PRoD
+5wpvvGTcJoETNCc3UX3qmnMxIkUH71IunBKU27kMXp2hg7IyttxlTAirxzl3VhKyFMaOmJqMp+ZSLkzb
+u71me8hki1eC5TMw1hoYZSAvCZgcLmEmTowS5qCP6IwrqdRjLSGALqm6UCA3uDHjdTjSqCZi/ykXjdFG
+DorXbRQMRXmZV7LEQs6lcqxvpUuyBbSmxxExXmbepVuPJq+Ee/XSg8itzxFeAIb/KKNAJm7DtTxbrrNA
+gxfDO7xx/4YvTozXNbyYkbF1BaeJA3EJzL/N6m8+NrVvB4owgUHDHQw+bAOecDXr0C5sbDuulTK6WS5q
+hV70DTqaAjSnlDW7MnCFes7hO0m9eI0zYRn0pbNBrRRXXvBdWHOiVLwW+uX5SuKaX1C/QIXDCqlq0qw0
+iGlZ80yUYpKTVWvc34Fxte6zSX4OS1gu6ialq+nSHxUCRHRjkyuPv8jS73+zUZVuRHP5JzfA+k+En0Am
+XYPv343nn0Wj2oH5y/Ks/EcHDgSRmJifUV8D6QgUmYd8pwM3B5irlnWsWWiKZw5CQsdCP0Zq76cTeUyn
+bg9ZY6NhK2XhdfiSH8Cy6102vHjHDRcRfUFa1HmR17lQ5rl0g0VmmcPod19n0Sn6g6IPSng56Dak//A/
++gFOhqmPmFnHERiaPzACs43FOpgJDifEIZ2F3nNR33+N7/SRDbdiUrVhJnX6bbe7TuJrZwOxDFvCQ0N2
+iArdI+9LWHl9Ewu0805vqU6vp+NG+wGHh998018VSni49fRJPxkseLj1mUsxMYGHW59/3W+N+Dvc+tzV
+FkX0HW594cjFAXuHW1981U/F5B1ufeHqi2LuDre+dBQxuu5w68sv+4kIusOtrz7rp4LkDre++rofx8Id
+bn2FvQii2A6fPnnaj+LgDp8+wdI3hKsdPn2KRdsD0Q6fPv2qH4ScHT59iqSDyLLDp58joRUhY4dPP/+i
+74WHHT6F+TJL5P7w6VdfYSTxp+tEEv8zaPinCBq+KOoc9YSYBQR8EphQjZ+PMURsa1DxcHr3PZk4Eeo7
+kS0Mam2bQmsHDoVUUOhkPhtp+M6xq5HiyoJRDqxSL0JsCZyi4WOVO94BpcX4e1RivKhUfmmMyxQZl2Vi
+Xk9RI/2QLXTkXIQRaxbwZAgtYM1QWT+Z5OMcwz7ZSYcZtfFq9TLIL3ktLPA30dwTc6sh1p1CJgQdoRxs
+LEsU+fR7OMXfNBXt8/FUf9MWfSOoesTmmtdbqw/w9G6fyFHUcg56+m+J8HerOmCijvsBgW0PnIQcOoA2
+ayIFQktNRCZV0z4hBat0TR7gPvyofaB9DB/cFo2I1MWBaQJ9ioPbRuV0K+hIopG37uLoK25t3oLiNqyx
+JGXJHirEuY5BqdsZDovh00xjJWtln2m4EdL6hqYNXPny6GR/yCA+G8H5KlaJbDEWbJpnmSgJsonkNm+C
+6WkJSDA7OEP7V9/7TtKM8xWDSR6u4DSDg5fH+69P3h3uvv77m1dUhtqO7R76k0+tmGlV6mO2patAvNCy
+RsnL1TxByQ9puI96OIbmjw3Ci75OrCh5aQC4wFcW/vYcQXhJj3KfdBmZdWBbzo7pvApiXcfNud1Sih42
+1lhZ8lJ468q27b/B0jo8+mn/3f5/HByfHLz8W2JpeaRuXEKu37dcR6jHjqDceM24no/fbu0cJKabTjBQ
+rN888a/FrGXq/31n/PU+zjncZX+j+YbM7ZOt9HsnDDnUYaK2fOqDxms/O/HqIdOTNXb1iSPgzbBH9t93
+jk/2/+Pk3bOjlyCArz/J3o91jofkNL+iC5NQaGygR9J6EHsCf2Lc9WULB6fr4lj6QxZay7VK7wNNypgZ
+RBC4HsX+hv/yg582vBe3cSF4pRsUx/R7VqCN9u376RPFnjR7aKyO/JPdT/EjCwargHG0k5ujgYOcsEpf
+CmDHzSDXvBD2KmCbiQs0ee0KwuUmLkQYkcfXb73SVzmNaWaf5HmR18Zyxb4+oPg3oxj51E5hW2ni3eim
+WsA0A5A2MrbRdgGMbHCyvh7SvsMkMm6pSNBrQ2DAW4gyU8Yd1fYRVlMtYdM5N9TD/H1eDqnvtvdA429a
+k6wYZ/epyhwUVvex7wLvIpEhuuvjQWmBEQw9QF5q9rRvIsMScYIlIc7nLhUDTUP/04RBIvd+s0nYS/yp
+R2jG52pgSxpTYryJXKNaAP7CujS0RWiU7JV1S4iZ0TOR0XTNAb6t36QoeB9y8Pd1wliWjIlTd+GBpz0y
+ZLse2OnN1W1Eb/CgJTEAc66sMRSrROnb5jqNpdL3MpeWx/aFsEK6kFLymWB5aSm6HnsmubBiojjQUC4y
+7/TtiU2xU8j3dtvLBVaIZZGXOqCWNnonJ2olBBsFCsYETIyxGI/sCtDKWA+YjYvNHmEHt6P8bqjWjPkY
+GOqFjy9Vw+kyETTPfyp38xzMtSVvQj7qKcOp8zN586yvYPZDQCwn++225x9rRefRo7zX/QareU2BrOgI
+MuvR7Qe0x+KBjKVZRBtjMKJT6YlOx5apODlt1cYmxnviyxVRzBhPdnIFUMnjxgXWg6gqWZ2AiW/pReGk
+mVxG5jcID+l2YnqLbvu2kCS6p4duEMLOxhzFgAYYbuJX3gsqOTnaOxqiCk2J2hsTBvuVbpwO/EWUYANv
+izeYQVBNA1PVT12DLRg25vflh+VLPhMBJeIUfWxDZNTc/Gtzkx2LGv1lI8HerzIciWBNuArCufd8yRi7
+ZogmUfhLwFtDjx+H3gv3vLTmqHn1hIlN0W+FDTeLRNfVA3Xdtp/f2OgSom07W19kt0pXHe/v65drH/G3
+jDN8Mw9Inu5xi2464T+aO+hlHrRo/Xb8374ib1qSBzN4mhG+QACyd65kwfUtPldsKrWB0ZzXU2dfVVfL
+zTE8ExhqZ4UEFPqSjcL5GP1bLGULUsP0k1SNhi3Byn73CZf2rc8tKIDs8gaJN1rsG25JuMr6bN0Gry3+
++k3zDXDv+QkOzDlxkkV2tn63PXkR/EqAJMpiI8K+zMtxRdo7e8uihZGXlNkuNXSW8CgVXAWUzsjWDT5r
+AR39vjQOsN/y0cBroCXjy/Nmwg6Sgr451/3mxYJ+MHZrHOvGl2Y7cTwH643tuL/1tDTP/dCE1jYlmus4
+byCNoBW4zR02FleSwyiyZfpu0PpuYAMe5g/3Ia+ngxl/7wj4wnobhWRm3bODULpP8Negjwl/OjrKg9Wl
+3ZZGXnUjila0KJWoKT6GgT0MndQ+vrfriHsrpTwbKEvzWx0G2LtcZ6aHed1RRiswiKf7XVz/LrU2vNHZ
+BUNtChbECh4VXd2ug5gmB+HN63ojvgpYSQu1UrwSrJQMcKpEZWCHB6n928bN1pLKYwSbe92YW97MARK7
+6o5y/c0iqkWhK4rEvUkbrNgrjTEWAMmlZIu5zWnoaSSaXPngc8oBpjYEg7S8WbapkyJvkuahtfrEXev6
+Fxde46TUEAfJIT5END8eKypZDNNvnsaSglOM9EfEnvnE9AtXWO4Ob6NpAo5lvWiepJqG9fRGdHlo4Whw
+g/xlTwtvjrVbcC0bHDACdabwvboTukKWK1YIharo0ufX2vs2ry3mkqN1JrAhWexLnkntqEmrHvIAAYPC
+ZT2rSc5w9Kz9hayIsD0sglFxqr/gzPo21WMWvE3HGrh+U6nl3qq3b2ICzzQaeWONfsRqJFXioE0j5ekG
+D60ZCw3bTUuGKLcsGkfXDV1oLtIcOlcmMYy9Fdq6WXpn33LU8P/9N+qVvfdAIOPeN3qsX7PXWCztvTwW
++KyTq7voHW9+sl3Z11Cz5HW3TukdE6+8zZ7XDe1UkmMbYNYIJc6wajhl477Tm7F51JrzqkauFF2MyTxL
+EZofSB2V4Bk/y+Ex7aN4/yLBu800QHMZiAwWWQbnv43V554pBa8N+KfZnisI3PJ+bt9S/ft4izgZhzyO
+HglQqNzwNPLmR+KNoHFv/5RvJbd5J7nzG0nQ9ebbSKLPrO3yf+NbCPbBY7kJRrt9s3AZiZZwN/q320Na
+DLzlLkItQlowHA1WL/WmSN88z+hO8as/GQEMcIB2kZpMTzTdQHsH9P1oca+wL+TbG9qDImmNPNx6utVf
+aWw93Hrq/A/SVtTDrS8/a3NRQMv8z/60zP/dLfO9CWq1xL8Qy8MclNqh3br97Bur/0y4NGMXj8LdyjGa
+HtaX9RlnSlQ6KGjKBhyHgszAsSBxpMziMjkLdu3ImvMi/xep/nQjM8TbOXfGPPvlYmZMO4oCs86lgkuM
+QIus1sY4c6CbrGlC9wI7RGgkFhgBO4OswIDTffas/NxH3yyMvq70nko1y+50N3/Dp599jjvw83V2YNdk
+YV39RNGyLT//c1veblu+5HV+6WK5tG5IChIV7kZyzBpQknE+sV5VYV772Xq28EUtjd3TzxWAl1bP0KLM
+nCeQCV1s87FtX5gDtYu50lGfqiW7EGKOgdzHFzB6V0TWmFKasJcl9hlhcTYICu/8RDaq+PWamkDSfLJ+
+txujYTxA/2ZYrzvG3BMbysdjMSepHwlpT4axyC/1E27Nz829x8oonI2J6xTFhkZLwyg6pXW8LZfsItc4
+TBxvTORh/bfU8AWBgPwEIwO0DXqYW7uppjqnb3FBtGpqdM0vTEchD/WUvEs2vCA9ImMYgV7OlevMSWMi
+buxJcu7W7saFWIpMnwh0QbMLSWmb3QE5Cl2IpTNlIyBYmCaOk8hrlDLz8aKgxUa6nIUyiA/YrtxF/Eak
+PJoDE4KLRiDsimjvv7AjQJuzm1jifdYoE4yFmM1lxaslm8JuUnk5FuxKmFhJhCg60WjD5IifX2g8UZXX
+Qmk6IDvXtEkLwZXXm91FLfXG9zqi9+xzjrzLdqOdWYQFtL2tkw7+JmrGXbP8iFs+d8B2cqbmYpxP8rHe
+RoFtue+Fe20N/0/0poXy9hZ7LgwGAleKjMaNMaQLS3niQLAhWxAZ1eQahEbAgJEWTNpzWekWmZidzgRa
+x5zX3we1gVdyQefN6Oq2+TlpHA1U6DnbaaQG4X7MZCTW2WnNz99u6zbFBYiT2u3aUrqxadlO64qApd4z
+rY9j5Oodfx2tDn0gGEGrwbo+Zm0ID9bbqCtbl4Nrwborgmge6HLuHh+shrsCQyQPArrud07wZowgYTbq
+YhBLjDY+bQ6Lc+Evoo0YRCFZHcG36eEqxVW6UV2fcN9WY4LdugkPJgxOFFIgbqyItpoecFLUPJcVEOk6
+ZY/X0ubp09X2QS3t8XUHnkTWxIAPm5Sr4GjsRqG04k1gAzXBUdNoIjauTa7R4swKNjRclQhroGXBDtsS
+/DLBmA/Tn/uIaB8MyDD+0LdnUC7L4WoJboUqIypyC8yIUHIebm19mQIkaAIJfPFJL0t/6jBueVlCqI7W
+K9JaNx/fAQfJqdj5RtUSheJKaCQglLHkVSkiD5SHZqbc15jW5iYiFW1uOmqoFwVqvk+Ntm96SFjLrnEr
+SRuSVEGqgXimlXwmMlc/rJeLUl6VBAR8vxKT+6Yzr8WE6uGXPC+wJnzrN94iGBaVtDksW+hR0q5K3ByO
+QIikk8PlszjQv9EyIzP+lbIyfemILQAeBgZmrOu+MPZtll8yWT4r8vHFzq+oMiUQN/xy/Z2fl7Fvny1U
+LWeuNZWY7Nwf48f7bDPI/e1mll96X3rb5u/rvvnLq6qt0VqLO1EDqsZvXjdF0w/MqQPjrElZx3PP/yV8
+wvTHNX6xc6uNPCpeiWJpbD0GpMGrzOTDN3xIX5r8uCrPBMtkKfBN/SE+NVSyYBmvuYVicFh6LuxJR1Ge
+SSGvUq5rbk/bQw8/NXzUGual9O9NUVpGlG3kB4HG7dcMc0jBWBDgB5vgzQARieT1e/cs6iqlO7sgLftL
+fc7UNR9PX4tJJP+DpVCYMxPpnD3n3xhBU+1mWRheEgEJJ+TLHzOadeJLOkrBy4llIC2R9aFOfDg5W7p7
+GBYyRjCNUBd+A3DAr3V7XcWy9ImNZZVpbe/kxiiVMJs8c3DSu+q1mJzI1J29DwT7VPnHAwy7/gz81dQl
+8hbGLdE2DQV8BNuNB8cALwlsD/c9yQAJfOoQdjACieNZxrhdGt40Y36PWiaFQtg3rNEuoq7e5X0LNej0
+8yZ2i0GPSAUc/kdHsRFx+pGOotwbMECrdqBxsVGZJewRpNhutZw/LsSl8C+LGqIQlDtTWbiVkgKMWz1J
+Pf3chr/c9u3iMnHifRIpzhqMxDsSTew/6Z7MxNq7Uqu19PR7Zii33YeaSK70fhTZWjtRP2gGCx4g7P5t
+tmNbA3/TPVlpf7Y/t+WttiUEzwRUMRqj0dj5ksNlBQPL44GjY1jB4BpzPmfwpyOaGIJ08rr17UbN9UTV
+shAspy0LWahdABj+anFW5GNzF+72UEI7rcTkLQWNsbs9kde9/RO5THj8xo/4u+pdH8fpFlfgmy+7X/55
+2f0jL7uA8XhbED3/dgvlSejVuMRs9+gVU0tVixnLBGg+tMUrIYP6NlHEzoAUTTjdAKY6cupUygs1ZMrg
+tu6JCV8UVKHROvKSF0uVK1ZLWQxC+R4z6oc5c27+QHI7tEdgoI/NLMfAKqZ5FOzjWODBh558cK5mVDV8
+m1fiUgc0fMj4eJxnoqx5wQp5fm6AlaGLrJBKuWiXVNshVTI00NF+036URaaYLLUYa4bLqf9KtlBiwH6w
+zenrEFc6q25SuayneXmOd30It1UUTF6Kqsoz/RBFYblIMcZ4oyLXZlXLSmS2ze9Kqf/uJ29MyQtTJChQ
+GogKqbIWxk6fEJBP2eaE0LKNW1PfI973CfiBLW+JEW79rOHlLy9TVK03lpcWu2JciOUtfLGoG6cXYvmW
+7bilbKB1fW8Yr8feV68lSKXfKIGfmxEkrtuivr8xj5i1xPc0XQWh7+sTzszPgP1/opK45qaCZ+QFYwZ9
+VRBaefZLc2GYxEnZTHOPKvBXdEO3ifH6CRcOrRai3seUj1guaIVJtWTPF+U4sJmjZPMauZPyQ/GCkuCM
+B0yj6Q58z68rTGZxOxzRYFe3DEGbn5ceXJ/2gM/nxZLkAMarc8QBV0lPK116cnOpa/OHHq5Blqt5wdGw
+ke2YlcIesc47CKZBbd/+/7P3r/tt3MiiOPpdTwF79pikTbUs2c6FipKt2PKMVuLLseTJzN/jY0JskOy4
+2eA0mpI5sfbvPMR5wvMk51dVuHc3ScmOM2sv+UMiNoBCASgUCoW6hFEydNOGLKKAgLtTeS8Ev8VWp3VK
+83ittb4kCM9q5DWLF65lEdiBAesLYd7j9kk2m+dLNierBJMQ0TslUttpn5mA6VSEWb1b46fZLde+4dq3
+W/Nm81+s3ImxaqsFq3LZJnjCtG0sd9alzK9vLEC/lEBZyjna649FueIZZWNLMwwO/tSQVFA/KDL1f5VZ
+oS1dwtpegf9G4zyWKo01YMorMdHBf/FKORPlhHKemDB+OglbkEb4LhtixRMNYJiAbxmndhdo6yTOM7lQ
++ZI8e/u0dpTJX1SqYa/6vMcDvcn+oyu6WQzTshvAqe1B3CKAsOqD2NOn8fqn4i0sXS3kYBUjwyCE/a0G
+f+mgWoBX1xUZFDzuaJ+vq2hwz3CdDtqGbgfI++zMxmikRRtaOZnuH5hMSgtMHcW6Q4fRkPEzeS56MEEe
+JRA0JUaySP2GiP6QauPetGW4+zvKBaXFTg6H7AJeRAjeRFQGs1QUCXuaZ5S+nKyVp6IUiVtCbQn222Wf
+nfUZBysEj9hPIxLPhGJpNqp4JdhUXvhJfXRNnVt+aH7DeqtTiQGvtZNFNqb0M6D5LZY6qPeHkZjDTCvS
+yyMDVJTPxfkA4+lHLz3aOHo4ymUhfsmqKXbU7Q3hkiXLFBLoLt0NL1rZDDd7cNV7Ls6Fow/tLmldKfDo
+Hlmn4ICT9KOk8QQAZnyIL0pwgFGEcJgZoB29/jMPtKk4aCNFjx31VvSIFKJ765KOi5LumreiVhSw5aB5
+h/jSxTPMUOnTvBHIALZprjV0wkRGhxm30VyI5AGYaRpzMXM5RAJrKijExUtbZviaLgy5msEI0nwYRmVa
+05a21zagu5/o6hbWsBE0Ll428TLdzuNn4YXt0uUYj6eXHTTQ5hsN8K2X3bDW8M6dhpbrMYvhmCnRNd3c
+WCR6AStu5+j1vuYBHHZQh+1LsJYnYbNaYNJQUIgNDwK6QwB+3PDAbor4L6/zKH1NbXoPbpBSnOIoKKu9
+ENMRU11IjZbxJSE0Gpaw4c5rdSV5+pKAlNkEHjY0zC6+i4MZNZuXYiRSUYxErxmGWQP4w80UCSxZEV2L
+TSPSo9MvkxcK0/HJakqSiBxrYAgpTbx7dDkR2KN/ldYD6dc2mu452LXeIWUa9ryW++u00OEKWb+TZnur
+UEoc7N3HtDC+NDjYe0B+Yt/c6KL/8KvDci5+liO089H6v1VpXBqbaLeOrSuqcFYD3DIMcMA68D9t6qqd
+YQeso/8y30G2eOwKtV8/VbEi7AryjnH4hMvvtzeX3z+Igj/R9bGVzOsugESZxpvPUqX9EJDjOve+Wncr
+XPu+vn9DXV+YutbwRJcUO84FpUuCDFJPSz5pqW6KgvptDDJu3FjPUvVVtDqOePJc+9vJsX2CoIhceS4v
+WCpGOdexc3mRkhkeNw20aITVSyQBG4SjksZFxxm2sqMPfDbPBVsoPhGDwOSU5C2HdSdcnk5v39V9tjws
+q2yUi9XGqXPTeOBbYe7soLpgrqlNO9JBXW1wezsValRmWAENbHVD7/OA0E2ocd8OxMB3Ts2iWMwC4CMQ
+YWW59CGbbwasLMSLcfdN57m4UJ1+5+VUVlJ13vaSTL3SgBv6DIaQ8VxObhs3SWyjKMML2S7A6j3BSv4I
+8YPBwlR9Me5STb//uhlskykwS5KkbtB6SPoMfLLOrXOQJSpQmlRToQTz0z+DbaujGHTJGhxQWPyPYDr6
+Efr9SNL3R4qV8pGW56OeziRJ3vY+eqMC+yACB1TOBpqYLM0lv0E3l11v2L0f/CxnsCHEuSiXwTYh6Qim
+286FF6QGWBPI0rzChz3zWo+7jRQGSMt6LshQ2N91BqRK2FNZMkE7ys4MbY+fs+L9yr3RuDWad4Ys2etX
+xwF1TY3pObWDn4OaohP+R+8SlgM8N+Ey7oZBMuX8b6DOwyCZcPM1Td/ue1Upvp+peoveAT07XL/sgHUI
++w4a9t4NQ/65mp4/z+tXx70Qt8Aj6QilgghU5whdOkXqZYMoGS9wwsYSzbbsPMDTGst0pvQATDA/QVnP
+n4BL+7f5y26+pr2XJAnVcxsvDIiAh8bh8xfP//HsxesTyNP/3Xe8kMVyJhfq++/N+ac9woBa0HQLr+9E
+U0e1oq4+1kwu37jFc5n6VetHoLkX4MY2ir6XZTbLquzcB9ntYJUOKvqAAayurK3LqTpM0+rqznwb6hM7
+Wd2C6lB94kKr62t9NdYn2lldX9NzDx9YeWHn5rBYBvPfN5P3Ymyr0E+vGlQSxu2svsD9LWa3hgNjzN6i
+igVlS5JpXEAjdO1f6N9xtcLrA4J+NJWfYgomqvO6yGQRVVJT7iqcwA+vAmo9ohecx1OeoSmAP3mazeqX
+GdcCiqFe1zt/2Wo212e5FtJ6+p4bFLOD6PfHj24r7m/5/M5jh7HXr46F7EmDK6+7b0zNt368aO9wq8WG
+Dlif/de9bVqw2+xe2P89dpsNb4ds7/bQWrjqw55Cw9zuBRneu7exYTgv0Dq53WszrHGIukBK4XOYLjdL
+29142QLrTZPYhWcFRJDS9ACraP5OzrIi7eLdkEzREEDcwhMl2hpXJb7Lee6nEQzUckTk3MgzhD6d4FtE
+0lefDj2h6w9sr5KeIzCc1b/c2dsLqdwmfq8j/RnoHB4kbc8QGxptOJwo3GeKL/sMZmSnFBPxYd5H0xCU
+xwyrdrBw3Yw1IN5K5XgsSkwSVZJ6OVOCchiwmVBw26F4aCVHXXs15YUD15FjEmiNx1MnCWPWAzR/Lu2H
+YDq31u3c7u3jAhd+421rEaMihwiWBvu3e9u/+rXs5L5dXqrhL3a01XsNVkZmk/sBCjbg5k0bJj42A3/v
+dpjBRTqB29UrbKNw8/aau6odv93K/f1FNyY+TCE+Sabw/x4FfbbddoXt//uQa0SYtqYj3avRKtf511qo
+076NZph0gGXsO7cUOo/gPsvu3QtnWGhVpUcMbn76LFt3NHkJTPyLDM5iw1GOFetmqb/H5mq6F3wmMkcK
+9hVvxttE/4xFps9H1Z+TUH3yayLW1dQYqCS/GMdsEP+thOGFjvpMSxyvo0/hYa+flW0FoDWM4FtSNEvr
+/0H04av4glPWDeqLHrXRnc4SDfI59UXPvyZOHaLTzK5D3dOBJ6ZSqzfZ26bLk2eofhkbuVybUKEx2Uvq
+XIEH7L9OXjzXuuhsvIwneH9rFXle65TF/l0ZTUssE15VIpQFkizUC8Z3j902tNr7TCTZoIr4w2SyT7ks
+WS3Sf+Ih9ztKYzTsdeKY9jBys9i8n9e5Fm0gq6FR7zppbVN5rVliq2WL+Z24daxd6/La9eWz8ewmdtzQ
+XbsIPbLq5YZmb7JAzzXylm0zJGvatt+Zs38+Ft0iP6zVsH0u/hqo+T+nWJgpAP0/R8iH0X4xQS3Wm3eV
++aBuTsTfQZ3mH4GfpkvTesMND8R4Wevc1NVA34wgveTIl5GaXW8vG7Qco2uemvbM/KNUGh7L8aQIdZFV
+o6kNFRuVMjbiSjDzKDjwPul3PP/TokjFOCtEqr96Br5mTqmiecSMqt2yvfuV9UYYePO4XvMX2JdjYYIG
+Dl2ahdrrS/1iBHMO9+N1OprWnt2gXTdeF6GFVSI+VCXkeBmbLw0qRkf/LeJg/XDBCm/ev+01CmZBOuLW
+nPJuIDq4wGCrGcolmvHvsKN/LbJznouC8qoQaQ3pmSGrpvR4xnMK1AY3IxgYCj5oiUIPF0kQ87iBceKA
+IhYbU7EJOryOYPRI9FO82WohUXii7isxOfowN413dtgLyN8kzt5nlWJdXrFccFWxRVFlOTss0lJmKXuY
+3O/ZjlwAtvglZWfHkjzOix4S150m7BeM21Cg0RHlnjBJapRkaCe1c5bzHQNMewY7YyB95wjGrTvcr/tm
+2Ic6Exx9SomJ6IWI8ldQDjxvlYYJRSvCPI4wiOAFSSVbmPJdsGH7o98wXv+mt6ImEmg/ZYMz9qDhjG1d
+7yf2Ld2fNOCxnZq/zAYE40AQqXdaPWPs7K+2xVVhIh1jyjnYffhNv2awOdh99LC/2hJzsPvVN83uEWjV
+u3tj1fulrHoX1c+ZqkQhKIF7q3HvSzhRUxMZ3bOY9QoCU90fS3mhRHl0LorqaJZVlSgbDHUbarnsHZt4
+abtN3DgefanCgKK5LlCn8uWiYgfszVskfQLWbWyfzEtZSYr4/duWTc7nVfNsyfwEfbC50AOudoo24JLM
+F2ratXvXghl4f/e9E/4nsRzYHuKTf+D+pF3vxfCbO7xVQzbaJjVDE7qtagZTz8xwQ2NP2dBGAYmHprtF
+BLCT+sTENaL5aSp2kxRcBGiuSqFE1TBJ7RNiMqlT+1SYwPptQLCHrlYlwDJteZsp4WkKP7NiciqbiXOF
+D0VUc42Xmr+HB7u7X/dXbc/B7t595NJ7N1z6C3HpplyUV42bBtvzMc/zMz56jzQRMtagyDDyL8v0LZTj
+Yr6oTkQuGhw0GioEbWPKb2gdVzHtvckNW3kFVzycNPUfFWpRkhxb9SneuMnQ1idasIMteTERrKvjOuZL
+XSRSm0Mig8H3TCzqNFPVojyjQAk6vJ3xlvcSaLrgBSdHPx89Pj1+8fzdq6OT0xevDuHvOH6Bddm1k3zJ
+7J8sK6AXHgZscyG6B02rCEEh7Y9jB6DfFM3N61WJvLlnLcWKlCIBDF3/fpCFXKoWfEqhKlkK+yGIebSY
+z0uB1xoMuKdY9yxflDtjOVqons6NZeJIZAVPz0VZ0XJBsChejaYYPt7El5hmkymjmKGQ1GjGi2y+yLUz
+XReTGlU6F1KWLylkKrmVw6LTituABwDwyYtnPbekR387en767uT1y5evjk5OVi2nCx0+FTosYIqcY0HJ
+ztu251An/iZwyDHjoPgNZFA79QBZS9lHuvuDdjkgU7pSV5/OrTWVqExVZ1PqdFlRnw1BjQ3p2Rly0XIM
+okfxfFFLtn7aGmaMydFoUYo0wagjSgwNME2XZFFpkKB33hpde54kEbJmxjeZsHrjWoarl/pQYpyh/IuS
+4ki7xEHkmFpof8xuC02DIkrYOGQjfdook2MB2VUby3rx/N2TF8/evTo6fPKPd/+v10evj46f/yWm8WNL
+eEon1Nd5joayePLi2SvB0+WQsN+QWrWMBhknYUQIIZDZoij140qUuL8zxcb5Qk1F2mdZcS7fC0zM6aUw
+CnCyk9G+vitRKmSVjZeHed71Fg6P79en734+Pjk9en70Kp639SOfx1eh2tBXYllr7n1Q3TqJHX0Qo0Ul
+KE25EZFGcm7jeg+9Y3hodR8JeywxVnOpXeE4eomR4fJMgFbZeMf1nYKQFyyb0bsBBjKCyhfTLBe6YaZk
+jgGmgekCPMFHU4bhP7wwQK8On58c0ln6y6vDly+PXoFz0Jst1jzzcNQ1nr5QUOPh8LGR8Lfeel6phrGB
+WxvbRraOA6IzSs9IKejuY8VRb5cBsY7KrMpGPNdAaqdfQt9fGc4USStGdH26qCAGYVCZpXKEMXl25Lko
+IUEGU6NS5jnDXHl4AFKgM1EKtihg1xYkYqMcyxibyZQcIc4zTturUKKkphgJi4Yk5+w8ExdzWVbsDGRv
+TkI122bH4PiHSAD4SckrQWRAToPZv0Wq86DxrKjYGVciZTlfykVlggnzIsXMn4SSy/6JMZHSbCYKhQjN
+FqpiZ4KVwkRHbAoA0yLP70TKjKZqvkKjFG4XB1X2KXgXqkjxFlVuwxbxwp2XAiaYFUKgF3OmtDcj6yqh
+Q4nRqXaCzV+ZhsMe7SIf6kIJtaJJbdsKnupY8rrPWL2cVR0NkI9GguRkXAH9PKBcfGSUpanbJy+ePXZR
+0vH0cTgFubmGTN+ZsgrTnof56YfJ0E0wjOFUnuDd6hkv3y/m7MC9RzSxY3YQXrMwGjpel8jy3bab128q
+zSooB6BnQ889yz5kRU3MU0KwgKTwKz9T+PTTkMPAyIX4LPEdaau/v2TQP5C3nAvtqouBdzG2h0jhKpMY
+ceX0xZMXA9g9IAKzStIzy3ceFjpz4vf2cJuIql7cpI7S2DXx2SYJLg4zBMyQpJVKGmGl5eRFdpD4GL4K
+V7UduyYacNhNRG0914Ca11Q3tZEOvev3kOUQqtzmQO3jRsGwkVr0gK9GDkXnaZPugICZg5SyrLJSKEyY
+ZOdipRorpHSdfrXbNCVaIG/ZMTrXsYtTW5c9PNDzuh6sVeywwI24Eah7mzisr/L1P+OW69POW6+ra4Lc
+rq9rqm11dqFqZvDto/7nU+T1W1Qqg91HX/VbFSaD3a93+5FiZLD77SNUCz64iWP1H6ErLFcHasEEXDXd
+2CsxDtRp+qXvb2TxIcv2uC62iq/4+qvI55QdC1it7dilG1Im4EOmmhIo95ma51nFJKUOrCTj5zJLkY+Z
+YEQNMi0e6NslMhdz4/OlK9d914u+FSKm44PDf5N3WoGgh9oLE5i6Ga+F64svpd7ASLZQbIZSRd8YJuAN
+UQve9sVCbZJAyVx4jftJS7YkKavjJyhHHz+xKZOkrNAxvSldUhNv+rhG0Lv0lyM6nn8wqLwyedhpCvQB
+RNI93lV0NoonL55tlH8pFOO80yqemb6ehb6PZd/ErfKVVTMj8sUgkrCz7kqA+9eN6d+4vxIUXg+L9Bde
+Fk9lSSFUKZChey2rIRzRcJP5m698qYtA3V6iXz+7bpf0ax2FmjeawMYkYnigK4zaWwrinspsbB33NxbM
+/V2wkhQWxcbEEGjKgAXY1ESqgXDWTatZ67hajFCjBom0Y0GGLBNetBAXNuP0J/OCMJN4IT74RLHB7m/a
+3VFcUE367anSRsLPa+ytT+P89X0sm3cZpvr2bWfFuYtFtm7ZvNC4Xkdow+MDunPHxyN5R+nJbsXW72B8
+lBUjm5id4jJls9miwgizHDWF1VTo/GaZ0qcBRqsyILTDfCqBIKaCzxmm+8mqJdIHgMRbcyVZKioBzz5o
+IER5dhTjDpRazEH5spALZdXQImHHVUfZhyi8QaiKV+YmYLDF6/5iNPW9+vGRSofSrspsMhEl44WJoy3H
+3uB0ajSsWgqyx3KgKpe+HgzPwFI3z0YZvKQgm7PJljBIudDOejYznMqKkQctqw2IFwa8zRknFxWqLbgn
+cOCIHZxUCMh5MSPOqo9mey2y3C2Ix/xF2LtHfb1aNOhSjNVjXJrU3SvGCS0T8RbkatZgxBK2obqIFbCe
+ty886NEI1jJNr6cQ69q2jPlCd+22X4vi5zzUGrITPQVFPx5iaVbC1sT+USrlV0uAuQHP3pQZ1/itfhgm
+IjgePxdAlbxcruW7sVzgcdna4rV10q2CK/Ca8Mp2jFfJ9Nd8ERnsPvy2799sBrtfP2xIA/j1wxtLli92
+Ox2vvpaaxNjxLRO/3+7Vb7C/BTGr2vLYBqlWgVdoq+eynoba6uTG3dUpLfe3rF2utdP+WUz4CHPhbkWJ
+WZtyITeng7WW7nZUqfico7Jq6M2Rb80cu2YETRf+A18WN0eEPqSdwTUOwXxOSjE2BtZQEElc0aLXoCbv
+ArT2t7ZaD0cfO/+A9I9Gm7rleMxEpo3rjbgjS8bZsBTjIeaSpOMg7bMZf29SjkwFiPNCVdSEYEFlSsxF
+qXIp5TF6NcEGHpKCILAJoA672Zil2RgbVD0HbCzLiawqUZh8rzFElLcWSvgymHmfITBajpsj4Wv1Kogh
+NtMslkpVGTkH7iojXqBdkhFJCRSOKUGVPCwgsHgxryh9cJ6dC8VkEQiDW9SOnhdOtKQ5zUgzws6EFfN+
+QKDS5CuGvmnSDZZbTjQcy/IME9nA7TJcev8s9vDkhYamoVC2GXXBKQsOpuCRY5SRoWc91SpheIzRu/QF
+iemAPMp2GhSoflKUwmH6zvhZvmRnIpfFRNkm2AO10vk9dWMk8SqroQ5gU1mIxAuLRkd748UluN6Yzx8/
+1hrQpgtrE5vo7YcbygmCv8NGj/jgBhu9TcYYhw4NdLoMdr8iFfajG3ngS8kDoDcrUvEhMmddo7s2rY5N
+/sTaU2gtgdk7uv/FvVpDIAD0uKGKJyI3ggj1R+Zr0lSVHTRjEVrt1NoA/FEjZibAvpdGsmV+Vm0GhwZs
+CdwAN3mxv9iuaDXhbt4wkc4d98nnicVvJNu/av+7JrProEbQmowj0LdOLWYNjcMKQdv2dwR2sMVqkNqr
+hxH+6Ym0Ib4/FRgU2s/SoGVrNQdHZ5uP2+nP/rtYsyZWn2JBFjLzWAIU9NfTZz9rjXr4nuXsVKByNzhj
+N1NKsR8c/t2tWDkVuyWbDkg90Im67w3YP+SCTKEwNiinMAghyA6qEgZet5v02CN9j01+Zp7KGavKpaf+
+zawKLCLaGmN+YmyLK5/s1hGmZ5Rj7I1rCad0Ta0W6TbkXAZM7Q3KIIrq6hZaM/PQZ/YSFz9VbdWCpbe9
+WWVppFLzNkccdbJhC+ONVv99KqmsS1gYdZmH5iXDB5t86R+XK+bXGnzUdEeXn28XuYTFpGKuSh1NBN5G
+eZFqO7EFmoB2QZ88EQWaRBUTza3ZnE+E6rXtR2c39gfuSg+J/2F704WI/qO2pu71c2zG32071eTC37ZY
+dKYMot/9oIYjsEHj163La+pyQw/zWP4Y7D5yFk6RfDHY/coZN62QGAa7X3/djwSDwd597LT9yB/s7X3d
+Dw73wd7eN02q5Wvn/n54IzRf7SrZvsib3i2v4u54VVfK/zznxOtmwvJ8gYbBUIctvkGNFrcAyfP98TwX
+tdbxv58nkHaHCSbuS/vi1Dv/H+Ves9ZhxvfCWMMwYofAxoOt7rTRDrXbBGFTd45V/ghNn//vc0+4ZEdA
+3Czf0DnhxtXgxtXgP8PVYGu9fO58DrZYg9fBFqMttbXe8aC9k3b3g/Y2n9UJYWM/ghWhoT5vluwb+Xpz
++ZpeSldHicKKP2dj8XhJSUdj8daWBXKxdmNts/3wi5u8E66o4H7G5yuU28/4PGhFw25Sh+uSq0vdm2iK
+od4FLwuKW+/V0h/D2Ffaho0wajFxxhRmUQlqnsKFSWywBGSbWTEJwRjLhwvRKUVk5vZLlufYSgfxK/ss
+lUWnMvgxzkpB0oqBZEwJgrnmSi1mQvl9nJEDkzH7Y12yK82UAXRRSh3oEbds6Zxde3ROISx+hto+SWa1
+VIXxYnnBl4mBdCIEO1tMoN8/7X798H7iDguNYLJmvusWPBNRHUe18EB6KksNZB6YF/VRYBClTQT6aRpD
+fweZBTZRu7W68M8K07wO2GMyotC2vPqOxguywDWKT30/Q0ZEiToBRhfshBlX5pIxpAke9hLt7GHuCsZ2
++EwwzuaLUtjz3gGzWZNh9bDPxKS3t1PTrKdcNVpPSVnbDAc1PgHCbbQyNtLjrTZPgmtYAhtnbdjc5NN8
+IdiUnwvGvdEm7EU1FeVFpgSUuzlUWW6MTAnYL0js1qwEQGAY1FLOzF40BzqaAdEuveAF7g3AIjDRLq0x
+DfTLc/Ksgmo6/Cb5NpWlUHNZpNBVno3FCA8BWvLERlbfkIw1n3MeLbfcRLiYbgHZMng5NYTLyR8Ir6D0
+J6DlLPosoSEYPNgXigySZoIXCg19sMuU/Rn8xGSBJu6FARu0H/m2Q9r+nhVyW84N0YaE2/RNfwKC9lTI
+LXGa2xj6wQqG/rqYtbD0JvD6W9zDvvcAE8sFJjmzcyVYaI5OEr8aTQUIFNq5i7OcV+inGAfHUZWYO01A
+rZvYze7IHi/mmqWDSsDd5EwwYZQf5HnB81wrnYhWNZIEC3edpklzOWky3Abx9pKFvAHvgf4tZ4EaE21w
+Z86uyMnuB2ejYrF/THRH6NJMZkrjmSZtVt7RzvbjAzUcLlDFrP91DxdmogdbzEOzU0PmnQg1vWf/4bbY
+UFFYXbB0HJZinvORML/dTutANVBzCK+a+S1LNhzLciRMlCHSRUU04QHLVPHPToXl/CwXSWfL7D83zNXj
+6/VcIP6G4+Sqp755qtrZYS9tUCZguRfa/rGUF3gUU2oUEMJYmqXAu+mwcNvVwHGKPHJJuhCWzaNJalYZ
+RmVsP/tQZ5ZhF+aK4KS1KT/PZGmPp6wgtZ2hvbvmhLfS1C+C8VxJVggK0p9NClkKTx+SFQ0CZMJOhO01
+kLY05MYT2JguNrknrOSMbaJuU9Zc529S9yrTHMWQuZc2YH1dCgJrd2Zjjt71UEBDamC89U8Qa0wLPMrU
+IA+yTOlVSUn2MHsMCvT2JEUtgWlarSOygx5nH1hWMVmyNFN4GLBUoswosVkuKpEvWWYjkY/5uSzh+opb
+BbWf2HXCDnMcapWdi3zZt95wGmw7LkZibYx7Q7SzifSOarCIa5m9vNK1+Ia1fl7W+t9uo21MXc6HTJLv
+s3Gg1DKklu9RqD0TWseqt2iGlt7vC3lR4FoQoJEoK54V1VKLP4LxUrC7dwtZ3b1L92hw/A+f1qx8g4Yh
+fGnPhxHJSZmiLkEehg4JtpKgOBJiLkrG1VyMKv2QpDEx+7KjtPzieUPgQ5EjN5vznOjVRwnnAaU4KKcZ
+YEPfct95h9P7U1b5GmmtxA64hCFhXqSNwQk/SeTDqZlqZ1diO+vEtaduX7VKbL5n87XkDOPzGcqBZrd6
+W7tTc/xsvec2u8G2bRxvmOxAp/y45n55RcwJowVoqtMKAnaYX/Cl0m4tmULG5QgNhB50p9XVHd1bX2bB
+KxLXE0VtuHKe2yFp6qOzkGyy4CUvKqEJIGhv7iDZbCbSjOP5pwX5PlOSgOk4Z8UkbEvyv7m9Q4kWr3Cf
+2qccwWSe+iFCPz/x1tz76SzHeWXPQSHnz2grrb/yjpVV1xMf/O9M+/5J97sR/0nl3V3fBMN7u7+6qT9l
+n7pxTkSlGGdqcaZEFe0cCsgHZw0q+pyTVzAG5t7yqDvdcO6MQmainJCasOSVmOizSIer5uc8Q4mAwhaI
+OUGbU0rJDHS+Wq/LRrIYY/iLRAuuRx/mUgkWoCNLrbsCTDP9huzZlQAyX2pTzHlp5VfaE/pLGFYBMEp1
+nqJN9syJqNbtF7/n33m7GGb6qVsF0PsXbYh1Ipe3eez9rrvhVnurxbt/6RC0atoNJuvzbiXUV39ZakNB
+np0EaBRIew6XlbSFANbTFlb7/WkLu/lU2rr+latOVZk6lfOfxbnIa2iuummhtti/EFlB01yd5rwM1cUF
+40WVbc95BThQaBNaRZIhPGATUTG0bMjOPXGCxHQTSSZhx0afo/1hrZPyPzsquPjpVxojW1SSTMOtUn9U
+kXDBuNII6chcblAeOPJdpQuKjrnSeP1bNdc9TxP2DBimCRPnlLbWY3kMfdGZ1WfSPZBAA/NmZWCZRm5z
+IC2LFYGCdH/mqdnTMLl/68ML+dk64eqoLUN+u3S+qyZDqL/f1sgG7v07sM8f5bIQh0X6yrvrGwoXYcQX
+7DTYbp9HHP+inBDn9Lm42Izn+bOyiu99AYbnK2P+w5mej2oD4wvUSjfM74b5xczvP5OH6Yq60430u4W4
+COKAbDQ012h/YwRXBUnyHkOv4FnTbK5XN7Ea7D5wvjMrPXHAWGqw+8gVOPuuwe4jF3zJmEoNdr/+tslv
+xpk1DfYeNvrRfHvjkf7HG/+pPy6z2HVNBF+KsinOMXwO6gVBfOtRkU3h7+uO89kMA6ESxqOz6nGl810S
+PnweL1ibU0DPNdFWFqnLgIBFZ7waTfHirfU91sbaM09Ui1JQMAqA+ekGbeYtuDUsHsTqjDHTlm4+gAG5
+wlIADcZddMzA5ZScRniRWpDOTM2ouVp8Zq+FaM95Ujw/Ojk9evLu9csnh6dHJxs7DEWL/7PJTxl9N5k8
+6UBsz+iDscXa4cLiNUMOTNwOHfMydkGY9kUYwjpbsvqDjLElVUyWDpbKZlnOy33jbiAXJch3jCbKeVhd
+kM8HjUz1ffuHchFauSmMy2VRs9lIxuywo5oQg0NXuddy6YH7sc9+JMmVwPmJwOyDJ4Cl4tIoULUcq1X+
+3nB1J8aMLp5tCJM+Et37/RXL79K8Q8zKHylLnabPbvMzaPOimjynJqO08XGL535TYh1Frh2+a9uaLFNh
+09ZMWG2+ZOHu6scjeFvLL6ynC6N+Xicrz8rNaYxM6iNbzaBtxw3c5eAKPLPmyhX5r7QMfp3zCvvts3ti
+rc+tu26OVzoUBXPvu/zEi+KgbTjDQTdlc44Q21vzgjorTZwK7TnrzQPdZPvk3tln3LPbP1JKFECb2ihY
+myY29mSjI9TgDa3Nvc3d4J2WHWWYrmK8JBm0SoK1jUnEdEXXGGP+ArcZ7++VSK6taCrowehfNCT9g2/Z
+iPgbpWNu2Q6BY8ZZyGUN/fQZ77OzPhv1mQ5VHItIgEUsFiSbQPPtcw/Lkpto4bySJRrmhqEMFBy6aBZs
+XXXdRaA5UPFol42zUlXuSATbkE4pdE8Ulqq9+R5TsEDpuvbGybJYgJsx5IvA36SNWSgKTr6kQToelChZ
+Vt1elPEDR/gCBvjYzkZ3tNtno72eDneBwEe7yTtXl22z0Z7/YT9w6igXRXSK+uE1bITDXBT4cOuItpE5
+7X+CTIxdtAphWu49+jBH4qLz30fonx0bfBQhbNuVUYxAsO6fVY9V0sm9Mxh5vTq66vqNjP17LvQebMax
+Jj2vHpLWkdlI+9Z+HLMlOMKCzLuiNBdeim85zUTJy9F0aQ3+u4WsCBon+5HtbaaE8bCwnWt1Ta9Hkp27
+K4ymWZ6Woui7b1tGqpx5ol9W2qpAuUCp5qEa5w24ZE2yA3JuIl+agaaU/bkogtz85LDlT0umPEcGjZ/R
+LZoI5nw+B8NLY1ClqizPnR0wm4pSaJd/oR22TPY+svXKBQeCyirF6sZzFMlUzxGvWgOUG0cKOXcKy5EX
+oC2arTfZW6c7PR63g53CAVWgLtf6phVLX/6Hq4KBRHSAjmzkSRDI8TCXVZa7p1ftVqb7MFY+CKjiaho1
+Rl7qBsedfaEdaN36kKSEFRUaPJ2dFqM9VPtWFDus3xBFv2zMXhk9XozqlpmWVH8lUv2VfeeGa26i7FdH
+t3HHobBv4vbbqsxBe/Pr277/fVUcb1vP3o8u/bsNXSAarkx+fFsbEnqVYOALRrilcIsgNURkTLCIlfIi
+NWSMJGqup/6l2c93ZW/L3SwRSX8rNJO2l9ee2brGLp/SbeiMIhIN+/EIhCIYNwFq6t1egEf+9kaVEX7C
+twYK/9FtuVB+/BhomHy1wzq9QhRujB2sXIP44hSTmJF5a0c7xd4KYnRZACs7bIu8FXgSNA2etSjd3LC1
+/Uxdn7fvAXh8lXukM5WJrtLtF6Z/eXckqw5o3i5W85ro5LjdrUgdh7JCp6E1FjR83/ICE0Hgk+CU4wqp
+m2QC84jcRx16gS6tlH+W554du2QcYJkgI2aHG7u0mkeZpMdqYeFTYncVyZ/hg49FsU32p832N15ioPc5
+LysMMy4XJb08WEdbJ1yrzDOGNvlz3xFOOiWGcKJMLziy6SpYSesQXQo47AqhKkqWv7PDzkWZjZcmrzSv
+OvqNlCuRsK55yNXvwWdLCulTyfl2Ls6FUYMRKDMrfZZn7wUzRjN9y0n6zLNI7jNRjZJ9ek7NZKFlh50d
+qwWAj3LsdebJo5lC69yUkl+7UCtJb+u6zqibelSHa94bbO4DbV2frbRtfaD3Tb4lIGFaID9dmN5r6Oir
+F1NbXjpQ4D+DT+RjVpiDv2/AGpgGUuZ5ZNdPERspUjusGtfo2s01Uz/qT3rjGi637o4bTKKX+iJIM2d9
+VuPDgjw9vDbubtzkM0phaRYF4zoiaJEagxazxAZfhs7NYMEAgQkAYDZmhbTzxkvbBt03oIk+XEQaMQdg
+095lPshDeN0b4foVaHgNSQATdKX+px+0oXBnOc6TDvqAWFKqAksi/vCB6mjgek0b4opuQCi40rWTzIp/
+9Xkz9b2jk6ym/aSdGnocQJ4egppUd56Cran40929Xq3QWjW9WekXg9WPVk22J43o966ixsSzvCljsVFK
+0jT+GC2uH0q/9uT1yfP3rvm5b93kmVbMf8iLp+xd0wvdZ/Hue7eGAbZ5/a0fk/e2Y+d9hRveFRH5HSeg
+xgKoax2Ib7MpKFgdigbAeFWV2dmiEleajTVYmfloeA2vwWrI92BhhiHzsMwl71Fs2LonhzpXaKXdMeai
+rJb2GkfgKsm0U64AfYEoRkuXOyKw1qwZT7b2a7JQGH21JZhB9Lu/Fdk9DcKfLRL+oOljU9KLmKP39TEw
+wP+uNaZSVzCk+hzB0NotrtA2ZbD7lbPO8uxPBrtfP2gLmXZlq6pv7t8kuvn9DahO/vaXJy+evdRb8rEs
+xhnl7djaufuryjOQKrMK7DYHKKi0mFh5MELDH6/AGv88e31y+u71ydG7w9PTV8c/vj49Ygc+hMRt+3pV
+DaMJb82gXlp/rQH+ZmyUZ/OXvJoOGrrWUZs+rChbtpelK4pWgExXgBxneb669MWcj7JqFQhZVE/5LMvX
+1DlBu4fWGisGMF4BeVLyNBMFPanT8++6qq+LrFLt1SAhgiiPinRdlWfZ2ionFS+r9kpyPFZiVfm6uddW
+4o9lUa0fmK68rpbMipXlmpcdoqv7K15lsr1yuaJoxYKXK4as5qXg6TPUHKyoVcn5Y5nLcnWVtcStqlK+
+F+vKn3A1RR31uoo/Z4UY8fm6ahui9UuWrmIzcAU8LEbTVZNQrd8256JUKFu0VsjExY9yxXJ+2F1Rtrei
+rL1ouQLkcgXIxjk19lVPXjw7NBIxhCFrYOkd+BOcM6adBhbZgV/betd26uyxAz+2x/irE3NGKlTZv0Wn
+lbV1at86jbytE/zu1Bhbh/7eFkXaqbE0UzjL0k4DMzPFCn52VvChTsPXThMr6vg/OyvYTKfha6eJLXT8
+n50aS+jA39sj+NFpYAZUHC5jbaN36Mt2aj51Gje6qZbTh07jNjeVmrrUm9xUuYCfnfoG78CPbY6/OtG+
+7Oi/Ou3JIJskHBvx2BerBt9+i/Ly7o28/AXkZZGLUXV0LorqZb6YZEWr1wHWeSwpTUvkSBCWGct5glrK
+OZ/wSpZNbbzSKHzwfFERbjWr/4YKpu3JsqimospGCDxsFpZZ4X0iqkN0b2uMbxyXmo4ydSo+EBKN7erl
+puV7sXwROUngJ1Oupqg1OPrXgudhNb/Eol9pP7bT5Rw1GuFKJEGxbiKgiqkPp4/CaTQn0XzKlUhfiUmG
+SrpMFsFBxdjZ4uwsF+mAhtL9TRYnGgAoJy57Rmc04vNqUTZUfEwFpr7Ot4T/s7oSvO280ZCCUcCYfswX
+Zb+t8DGppp+JYtFa56kcLVRr6U9i+UReFK3lz+RCifU1Xs9byy3lPjYJjxl7G2iqeESUZGBSKzl+EpTl
+XAXbxpXMDM6eR4lmnn8RFT7QUgpHGxOEs0WR/WshWCnwUCwqbl//vEcaZbpznFSw0jcftDGlzgTDRM0K
++RkflVIpVshUYOwgHXWacpsAoOpCsiwVRZWNeO76semrkcNBc2Mihn26FjQgFZtYPnnxzOavg9ZbdTfn
+8MloItycdqGFi/HdsVih6NJhWUEY3bnj2yuE3CqZcuUIgM/5WZZnVSYUAY+Ms3/TgBTJRlAlCXs1VCaK
+NC4/0qZfl14KesD7IitSeZH4I/Pdr5VHQg1Vu8GDoMWQRIPnMhUDjyrc135Q74W+mcY16Xvf2jGNFioG
+aT8GteoAvc+Ns5DK0QIdYFXTJJSwM8HsrVbL5M6DCi1zQU7Xms4GBCsJPnYtowRuZarA3x7XMJ/P4LRH
+c2jLU3IxruLin8XYH6n3AvtS5t4WYpVkSgh4T8RQkpiwXQeMi7eL3hKMwkPi+RJYDP8QHqzR3oH9jnYD
+nqDR9UBZY64jtMuwsU5RakKBzqb6o5z8+rV4odxre1ryCUZB4lvaf8cMs0thvFCdrfQI2JDKh3QQ2jCr
+PfBjx4SVj/oaBIEbZ6XQ0ZpkwTLYyoSL+FDxUnBWTRcK6KnC11nAiQhSpAZ5Mm8EI36Mn5Rmag44JZqL
+ON5snb4j9k+cvK0YXoJiSaXbWxVv2sRAh7GZOKRm0qZcAXyy1nVEQRaTxPT9IyZgDAFeLnp7eCx9/Mhu
++cJMNyju1zqxI4lPt7jivgt3pGJJMKRSzxbLME8rFel93m+a6uMn5nOwHTx7zLDjpFrOgYfoc6Kz31iH
+lxMBOAZdaXixmJzw0WgxW+S8EqcX8iUIa080OQnVDSFH2TjDwphDUGgxvAPoaAqK8QISK50E+wW3YCHL
+mc76RiOjQrTN0Oc6GlFq+rdn8MliDjc3tzPQemEARdt6a3WVEGxYF6CHPaplth39GtHN/yjNMGigf5lL
+s/FYlNo+R+99LWNgxjQxMyKNMnemsTSJlMAyXaP1VO//uC82zkSeKkzBIPIc/o8jUInfziTo43PgB4Gw
+ZOtQ6EFiaQDBRWOvXc9MOHZHrgPv7yhzlubgNiGvJ4WiTUFJsYbZMLw1DKPYLyAwYRdIpx4c/I3yXo4y
+XRD0ny69KI6sQQfBHD+BPMByzIbh92FLIBpv/7Hn4eLifCRR9rC7l3jDNptHC7J2S2j6Tbzsab950/7X
+xVmCKYv1YqhLF+nG/+xZXTSI/rXrAI6w+Wsjp+nR4jOmLrJqNGVdH3rg/ntaUqRgoXcVrANtXWDveDwl
+9p6mRMsNaeAifENk4Nqe7IY49+KgJWFxEm8gfNSvyoXoONwbDr8IzH5bzeMntbrHT/zazbcjU3pp/zor
+BX+/v3J64AY62GpEowa47cK2GVoaGbe0T1CGwJMb1pe4Mpl8+5KRkYp04B8jBOmowcbTecbhsqRsNDva
+ST5PX00m9irsJsO/aZIt2BXm1bu8D9Z3/Hre3G1gve3lhV4ji/qz/HhayplAQe/4iGa7kAWopIuUl6md
+9kB0yqwUzbrG64YmWs5ElWGaJR1RespV0al6Xh4ZOAvG8gNLpYAipuisdOAJdB/ju6MDgetYVbxaKAeL
+ThQ0C34vlkwUVblMkFO7Ntb3COu+F8sUJo8XxknJQXsvlou59T+ivmXhmugDUB+iuF5yzKYyR9tvqhNA
+69M0zBZ5lc1zYSGJcyMS4IxT8GoteQtCg2VrGFekWllNRVrTs7aST2ibU1PotV/XiMeHu1WHR/rUwe79
+h/0mlelg9/63/RaV6GD3ERq1RHrPwe43aBdT02wO9naxkwbV5WDvAaYOJEXlYO8BWsAE+sjB3sOHqLXf
+u9HafwmtPeWQNLn7i1R82CTxtp53eA00HL80QED8UnM+EpikaiIKUfJKsJIXKcjP6BesnFMzzg4AO35C
+LkjeBZijxJupTCvqzLYuUgyJr6N0qKWqxAx1dwBnIiodhphCymHL8aLSWoFCiJRxkMtZJR16f3kN/QOb
+GEtMbkRB800k+wtZvqcIeWqGuZ+YGvFcOAH7Lz+/+PHw53fPXrx+fvru5Yvj56fvnh3+nR2wZ7yaJnN5
+0d3rs0cPjLq9aeK1Fl3rhIKi9pALCH4ksryLf9Esd3vsbgtGXoyNBj5SR4pYCe7IBzfRvP6obYrXzN/z
+cQ2hvq6yvPFxzZV+2pOcPUIe59n8TPIyXfW+Fla63tNc0AKvIquauQq1tj+J5VqEgzo1CChormruKtTa
+Pin5ZFVTW15reSoXo+mqpq5Cre3r41UNXx83t/plKkS+qqGr4D+eEnVOefkY9nX8eBqU2mabhl5b91a6
+UWi2z/FCega3veu/j+JlEe9CK19HoZp9G6XaToSk6EzZ6L3p6Bp4PKb2axHBeqsx8a5pn4CPD2U9Vq72
+Gtzmy09Car40gFdiM1+uRmPxKU/qjxeVAbsKiUW1EodULs5y8fgTyeaJD2UtTl7t1biVfPIpSGHz9diU
+fLIWDTRY+yRMEMJGyBwV6Qb4VKL8ZIwQxoY4VaJcj9WHrPpUpBDEZjh9yKq1KP0s+Ln4RJw0jI2Qwrpr
+sXpx/smrRyA2wgmqrkVJG1Z+Ek4axkZIYd01WMm56fFaCMm5AbwSFzlfiQZqwj8BD1KTr0cE663EBPX0
+n4DJMbVfiwnWW4nJe60Uuz4uRq22Hhtdcx0+L0uh1KchpEFsghFWXYfS6/mn4fN6vhkyr1cTcC75pxxe
+P2PztXhAtZVoYJbcT8DjiNqvRQTrrcRkZ4c9l5UYUKp1SoSFfpjujbKS9FoArNM8/LkkBpTPThtsyIKu
+d0Ulyh39A08Bk3GAnS3ZEIvxs3fxxpdL+yrxCXPj3ljWz4+tu3KOEKtn8pPOzmcOxmZYQd31WL34JCb4
+zILYDKcXi2oDlD7tPH/mYGyI1LoTfabfvj4Rp404kK65Ep85V9WnkNJLar8WF6y3EpNSoAngtTF5Re3X
+YoL1VmKiRqXM808xqtYA1uJCFVcjszibfZLwfqIBrEcGK65EpgLN1WNejMSnTM+pD2UtWl7t9bh92nXw
+1ILYDKt1F0JE6RN59amDsRlSa3k1YvWpt4pTD8hmeK2/V1xMxSfR1S/Ufi06WK8VE2vGb9SLZB50Ko2t
+XuDhbexJGKvZIp5pTwcwjSAFTK0KagVNHV+j5tcJfSKwJqi5GqDJ+dJUgXO0qcqi0jUCzZBXw9M7mZqo
+rqnDgsupVwX3TUOVoyINaqFuo6FWJUq/HqobGup9yCqvmtYA1Krhd68eneH1evDdq6YJulbN2upjPbi+
+Nk0HGXhXcq4l4loVlLR1HX37rNUZG/eWSs71vbBWB++buo69r0V13jtHGKqlL1G1WvjdVXs9b+gQ70y6
+Dl076nXgNqOreFJvUGXmO9+YesTb6vXgu1/vxaJGEEbqDKqd1+jLSoJ+PTPOuJ4dp5ZsauNEiUnX0TJH
+rQ7KMrqOEQbiOiRlmEr6kK5Vwu+6UnBuepW8U9mvaTZkXNNtSO9sqVfz5t9n9rV6/t7QHLg2DOTsyFht
+cFu0rs6KNVy2Z5jsijpvANTbxPc/g5wV+NUGM6u9517FGveEzwTjFMgxFWO+yKvIDLnPxIeRmFdshMug
+AvM+UWjTAYJGnlWZIlM3beNnXBls5Mw0w8jtKUWR08YUOphoU8JIY1yLQ9Qh+oyzAjkBeJWNxcAlO5zP
+82yEYzBBIXXQnhbT31TOjp+gLcjxE5cFUAbBmhPPwlaMFpU1c/eMKnBy+vpmL8o+AQ6ceHDO/oazdVB7
+NE8i0G0A96+Y9jEIYWmjb/m43GqJsgU1MDU9ruuQ7LO5towz8YbRvFPMSzFCDQQvUrviXnrEbFLIsmXt
+nbZjxouFTVHhNxeJAgamrQHIhEWWTCTzEpF5QjTc7fWBqvl8Xsp5mZk4lXGkSrIgDlbjQNtpOttfhFvv
+dj8ojrvf9+W/G/P3/9vN383GTmNBeh1/9xB5u+/yvqYNR0WD15QxDw+NdSgtzv4GJvnNlqUkm62sgpLS
+yhokKK6sQiLGyipaeNB1UPcJvnA0WOV/RuqYVtV8sLNzcXGRXDxIZDnZOX21M61m+aOdDOzPEvj7T0RV
+2/dt83jqao5ZVzIWtwKpjx6YUT+VH5wPE9zZ5lBNM1GQHgyZQhmcPFKfoKWYyXMRDBdt6gvIYybSCJRK
+GF45WKbYVF6Ic1GyM1lN2bzMCnJxcFbgCAxMyG1hwl4Ugl1gYGKB+ULYKT/Tx64xqu6eLSrKceubixPx
+xgY2oWMlMNj7oWNFjahDv4edu8CRUfAo5WIypR3+ea2nV1FAYIi1f113jI38WVah4czJrua6gNfgmBTH
+ASniXV3ToSy0hyteGahIhXToeWsgQEuHHhwV0oRHAsnZoqokZXbZ+10JYWOvDV9hcDW/khXV8PKxvtqL
+RbVJLbj6Xc3zZBUtOePAK9ESqkvW1oDL2QaVKlFuUO1DVq2vRaqStdXWT6JTlKypJjecaGtKeaV59m/D
+6yuunW93F15fLRj96rE5W88rDU4rDTbqQluFXgk+XdQ3Au9sR6/oDTZfrp7Kx+s2NWlgNkIyNFxuRPTy
+E+Mwx93bm19NtTBgrwu67hlfM0z6yIZ/VsOk0xRYpiHActydCZxsjVwDe1iqU3eND0XkK8jrLsffVf3Y
+xXmcdoDwXZWsvTaFVwgyvNLhyjOjH+ze/2alJ1abFfxg95uH/WbT78HuN6sctZqs3Ae733zTbzdhH+x+
+e7/fYqI+2P12t99iSD7Y/Xav32QrPtj99kG/xRZ8sPvtw36Ttfdgb7clUnLoR7YubvLDG4+yL+Cq0ky3
+kVMZ+plmo21wyWz1ZbliEDa9chQFfcxHgrmeW2+aI4PlNp9nase6c4XYH1uQ2kXLlD7hFY/ViJGDlmF+
+naARBnjC6uyHreD9IgnqMXfi6PhJQXGQ/NSbg0jJE+k46H8LeshkGF+mkrYSkZJR/jToXHW9Zxhbk+n/
+UbSs8dKkRtRHDfnrb11P8XSX/W/xoRJFqthvMSuJwgO1kF03OnIi5Pt1pVAU3sUxfXYVUKjpj0DxxWQG
+bC3nSnVb8O23EV6v8aBqEzj0YdRwECAjfHTDCL8kI9SJviCK2n8yK3zy4tk2CmLbD7axutoxereRG4IN
+z6P5ZDS4mFOmyCBBPXDDnxqpAY6Hulwc8ZYt9ocxqi3Wjjvg1UoAW2tYVtRuLdP66oZpfUGmZW8Vn8St
+rujy2sC3Qjyuwrssi7IgmngTBScX5Q2PKhoW/xPEJ7eyn4c1efCaBSmLdL9hxVfzIk/lFzOh6MKLnOjr
+G070BTnRtbmQl8Qp5D5egXXupgRQYb0wN5R2FRezebV8arZMUD8oMvWNNuPUxGZs8Gunss8quVnu18j5
+YCYHNuspMZhBhKpJQk2hMekb0J4S2orI7N2smOyzQjJYgaxAr2lgYFnFpqIUWywEMQhnMKmmvCJrFfVc
+44OsCxV5Dkeyr1XuA5k2weun+1ZlM3FS8dl8zZUcvyW2NsQRfcIrkRTygsxAyLGQDENekpmISF0/mTot
+F8p+8k+Nk8gQgvHSt3zCHLtQoANkqj6sRTZCw5msgM0/lwWqnymlsUuPS81SkQsyZzE2PI5PCGVi4dg0
+tRTeBoDD6sylzLXbUinShc6BPAaKxKxycswmvDzjE+QXI5mbGJPsdGpAG8i4ESG85ktR6kDcQzwBRSXK
+WVa4mMCa/RD+Ln2utiACVqENtgA9HUSMMpC6eUvYayVKhYEHAZgO4jPXfach9Kw4l+8FG+rioQ0aGi9O
+FzP0Ls4w66ZQPWdHhwiBXRnuK/ZAmy+ww5fH7GyJKOjIpYCoOXRhZ8PT64kFaTzHTM7eLF/quMTS6wyX
+Gnqz236fjRaqApMtzyROzcUoGzv0gePyXEk7BOTebXGX/7sKME0SyjWlE5yemsFP+GE/rqfHdhCBt/X8
+MRz4faK9GvBgn/1ik5H3SmIL99GLWBukghEcuBiwrHDN/Qz7t+xXiAL/4qIwaU+6pmnPMxqSRZUVC+G/
+PUEvhoYBMQvvjQHgWTfZig4mDMRVhZGbOk3B6yhWentb1yLu/tKEmgaEY54cNk1qxbd0vGvSsa6sOqhV
+aTQyNKGo4/ZmYnB9M/WkjmjruXeqY1sGk3QNOE8Nfpdbtr1n/3hSyfl8IwiXW1sk9HQjzcC8lJWk50M0
+Vw5NKBuClNFGqo/BhfP0XxDj7eTor8liMzb3bLHnDClP1FfWravZGp+yhmg6GlmeNkzMVYYdQdvQzLVx
+4CQ1/YjCVLAMwcCvRjTh0K3V7C/2iGc8z30ZaBhS1VD5UUZpUnIp5328dhQTAldNxYzpROaevKDNnNAx
+W7mwfhAylEmoyF0iXi09ADQKn38moAuRNsG1dqlaiGgjbl8AusYsPab7TDb2DtwmIakNw8g6VxuCXzLo
+zkLV8HTmFAOzT4TPJEhpkMjUjdkf1GANuwjHM/QuV0NYxvcU6nGYCnPiDZksaK0zfCYfCZZVBivlkHAt
+WvbP5sfqpgdr08kU2e02ixCuVrPoEJVHIoNLqHAJd8CI6frDDG9z7trxV5HPRekJ9mcyy0U5z3ml0zKg
+6SBmTLeyaSwoOj8MXD6/6AcjsNm+UTBboTgGvmoWDT/04+lGTeFijvMDs2LFJXvKsAOm7+CIvehidXcK
+IafTJ5V3NGF3Ua3oGztwvTQU+5TEDqgU0aN6/oro7n+77NNgXKE/YNdHNEfUxv+I/Xi7KOFp+pIubqfS
+TKVfXk1LIQ7LCeaWwYISdGf7W1utUMJ12wTcCu1ZqDnbPKF2qC4Z7N3/ul/XiQz2dr9Bpds3N0q3L6h0
+cyZCn6T/3ziAZIPeK0LhWsovByPWgJUCWGNqdFI3yv+Gpf8E7f/r48+o+jfAmvX+Dt9+03qv5l2+hX6s
++vdt1pAFfXvDgr4gC6LA8f/JBhOwsju/PNmucyBY7we7u/cfAQD8Z00p0Fs+MqJwQ70xn1jDndxU/Xcy
+nHBYA0YNy73GWMK1WGcm8e39Gyb1BZlUYC79hUSl68XL9lv9JJbNDX4Sy7juM5lm4wwzqlct3QRVWthp
+faKuJdAFYGJm+V4sB/4YKarhSKvg7MNhVeY/iaX7oKbZuAq+8Dz8PRMVDz6UYi545X5DL/5D5ESEkzJo
+nk79xPqzmPDR0r9as5FeubaHzJ0dNjR1hrDpcL8ItcgroH3OjEerZtS8SF32XapNgR/keMtzT6VsjZ7X
+KfTBRxW8Mpp6FnIQO4CS+mTIIuY5H9G7ls6ruRQVG2ewQQGPQlYGln0B09EFiiWb8V9dCt+EkkvaLqdc
+2alJIi0pXeshGoLxre3UnLBrfq4iSrBja97f9yJ0rlsJXaVtIcD7cOf1fPOlmE+XChMPv9fkDn+4FTh1
+KzUTHKMFyLEHiGKP2OzGCyVK1XGwcr6UC7sElKV5xAutHUxFJUaVSBN2qNRiRuc9157DjLPXJ22QDI9k
+nKlFOS8zlRWTfImxAkpeCTbj8znAA13c6xOciKNFKeeCF4RkYkA9WQiK4gGnMvWci3FF32hE0QNqhZXR
+AmAlYUB2qA48+DeULeZ1iqFqeoFX0glO5CoqwQpII/hsm3HSjIoMX8kdEYG21O1tWkxc48J68cJ2Bcz1
+snvp1T77fvii83gj5Naliv8et/AA5X7LOb1ayI2c9lddx1e6ifnCzGBv9yv/WyiqDPZ2SWjevRGav6DQ
+7Gwqv4zEDK3+lokLWMhnAoBFBoFRYU3K/kwycDTuawnADkYs/apRKUTxd0+uxQ//cB9GeSaK6u/xh398
+Xtn4CrIvhZVYcWb+Is7eZ1XfxL/os+Ojb+8FksuAsV22xx6YjwbkffzcNVk2e/bR0ISy0AyafroX9w5C
+dd55tYPMb3Bpej0++u7bGC3YyOOsEGkdtfvsfvxxlz0EfGWBMTwW895WrU8Kv8F+YHts4H96yH5gu2zg
+iSJUqPz7SqDt3sA4MmgB57xxY+xSowQCXrms8mY6VTkyH3/QnyppvgxYrWmv53kw6uvQ7ZcYAExUvFze
+dreiZIuxOZ+Iv6/Bv4OVGvwrqXMsZYPgm94Y7F7MJhKTGB4DIPwsxtW+C1U9Ef/YBJV/rETlH42o/GMd
+KqdyfiMwNZ0o/z2kJYdvv4mjr5aT/Egw64Sk+GQb7H67iTx0k3v1S8pDLnDCH6BB/EyyTTSGVtkGg7Ru
+i0iqca1rngpQ4tv9E3M5jT/rbNm172vFlQ1Eng0lmhteHFHzfw9e7PDtN9Hhal7sB1Ta9MLawnMf3PDc
+L8hz9eJ8gaflz+IE9vp4A9666t74+jgka81ezzNx0SbEOs0f1GrR6EGRuQzZO1ZlhhmOLdAuAnRdzxjp
+37lj2BWFOsF7BX1xve/s6E+kjtY1iaeFCFK1GnKpHDEDNpEXhSifyBEau+1bBfuLJy8G7ClwaMHkAvzd
+lmwY1EU9rpIzARpn5S57aFZ69I1TxqZyVJu6VI6MUTwIaHC9gk9zXoqi+gVH1GjDrZtf+DWct1rFs3yj
+ux1VhV7/Z2ldVx5br48/9cz6QgFjNKL92pZefVK9Pm45psIgYS3ml9/exM76kqeTi4r2B4dfiBC51tHj
+YNSsm0Re8b9vGj+LavvaFMvPoMAqUiBerg79zyoJ725C5E+wzhBf3EirqN+ijQNclyg2U4zCkpwLGyO5
+40EIuqd7TOIVgxou0mghdv+40iD/0TbIf2wwyH+sGiQ8260b4z9Wj3ETHAgF0IpeB4HV/bdN8f9jLmyk
+RfyRTgTFLrJqCmf4baz1DF6KAIFSANdCR/WClfyC8j0RKPi7FEwWOndjIeFw08/plCmFodPRBV8qdm9n
+m+3u3e8zjHCcLxlstHOe68wX8+yDyPFpe2eHHbKJlCklOfiQzWz8/Ccvnr17cvTz6eG7n4+fH7Hubg86
+ePRnKDvXOhymYAZlSZD+z8P7GnQfJ9tBOHn86ujoOevu9fTT+TdfJw2QEjNxz9C64X+SjaFje23SgONZ
+/51CoDis+01cd7WA4EfW3SAKyrcbBZHrmiqsq8Nx3ogJnywmYMwijtPaKgPYWKnhyW8/++f90AM49GLM
+n+XA2c/kB20BpBjahFWSXZR8TtZaoprKlKnFaKq9K++ykSgrnhUOBQqCMeNZAd/BmVGMZYkpDbTnJ4xd
+g8qUDt+QAiwMPMyyMeOFzmkEtJRRPPmLAh7bcPbO5XvDNQC3uUg1vF7CfplKcS5KAEcOh1WmB1i5ceMS
+6XlmohjLciRKZQxs/KHQGGGSoBkaHKFFVjSLsDNZVimRjymnjlrM5/mSyUJ4NAOw+KKScBKMXDfI0Zdy
+wbbD7mkhYOJ91I0bJQCLXD0Xdopo/fJS8HSpw2uUiyJh/5ALnTnCBSIhEqCoFGjEJWwfZNQWjhWQLQXQ
+4GyRV9kcaARuxX1Cdy5hK2QAGddA2eMCyUjB4vCcpdkY/XQrvXIqYb/gUpZEP5AfRsxEvmQK7b7Y9ha5
+BcOc5ktD5c4oDAniQlpwejd9Ny/F91vGLr/278L02c2KX9ESjvEqXO9ee+vo3z37x8ZNPto/Wprc247/
+faz9Qf/aOv1Y+3LeUvRxNYQYlXtXg3Bve/ujN+W78NmM4t5GED4243AeDmUNhOaJvXc1CNj4oxvMHo3F
+TctaCB9X4PBxcwgta7UOwnlIBw3UcY419fBaId1DtOm//nDML1P20eOpAGwuyrEsZ11eLJ8R42Yf9dA+
+1pbKfTH/dYcLAKtvke8/bhNp0X+3v/9oOzIk59egNq2D3Biv/2gIGy9VKwSWFRkwdriXNPwb5VKJq7Gy
+tn/Iyr7b0awbfrxWAhM1qAH82mYvSTg0UgC6WTEldAQrVsLzoNKSxw5JHaUYyWKU5Rmy9oSQfCVUJeGW
+4jUWKIQU3qVEokSyKCjbkkgZJlNNCJUnAk7Gc3K3x/pKn8Kl4CVgYrB88uJZ3wRRgW+QpFftYNJVyPqI
+CFHLyYKXvKiEsAbZOIYLXqaq7yGmg3ThBVdjQfIy22ZP84WCaG2Ms38tBFqcmyBfIgVc2GxBCSRtDkXY
+V+z1MaumIDhQnyQXsGj6WMXfC8XQ9p9lBePsQuKtjppqFI6NpAYyjOt7aLPdPcnS1/OUV2Jo8zea8CCl
+AIkeGhfiglDAbMT6nrfNuk8pN+FCk0ZvQBIENJnzsspGi5yXbAzTIKxsN3wFE/ULIjukiSHglWRzfeOg
+isqY3gxZlxee3EYFjF/wEte0147Qz2gyD2Mb6fR5qi4Ow1IscBas1OIJXDzXweMgGJmm/kNGVy8ijSlX
+jBds6Lbn0IjXWE5KJ4WLMIdlnM31wmvEERU2xA0cNuUjEMLJbSFqauuHIjyj+BO+aK6vglARAOQCHUhk
+iYiPeZa7K5658h+WJV9+F8yClg6/v/SFYf3RiI56WhSJitxLlBrODgxYo09KAxNQxevxkp2QLOx158m+
+WeGROsKgO0B8W0MrzuyDzvnq53UVlWKLOV0zrbytpO9/MS8FvBKl2K/eP0DeM20kxp5IoQicku5aRjCV
+qBZzs5ozDtcAEsVlwbi7RrjbHt0WCJybrbSv9zdfUtVUCvLzGclCLWb+/YbNxAxuz4u5LGiedGbIU2io
+r8ULJcaLnGVjvPKkYpSlglWSzfh7AZ9KF9/O3MJnOIGcYN32o0u4+DGlcDh7i9AWyKdORMrElQGDk3pp
+13vJvIX1tHB0XGQVJGcIo8rEpZAV+G1rdLS4dpKLYlJN2QG92QXRaN5l6rjwEPRDWlG4objGgCpEaU75
+mcIUnFFModrWcxsPi3BRXKm9QbmVaJ4/T19bRy9eIo3MrVuNI24IrHRECXmJUxlwqAxG4lV8LKqlyamB
+0gRAxn0FLSo5Zxh3k6DpO6Rhn+jelRUsB90k4zO5KCpFx6nlhzveYQqt8JJN0ApB998zYfDA1x2TGLkt
+OzIhwZ6J2ZkooTc1knNAFY/KKL/rC60jpTonpiYxZkxX29jgh4NLxsuJSpKEmSAwKs6srBHpyjnt8p4G
+1f4P4hMpI+m48WekDpuhNOCiP1naozhT2q2NUuxS38MgPhfcHDyaoSp9Gnqf8T4767NRn6V9JvpsbEjq
++sm3bunIXwENdns2B5f3NTEXmyRJegP2mPzsHGOKtFDmqCzDBMyWFzNeMLmo0KwdJUGvp05D3q4WTIP0
+XWUpy1NUp+372a7pR1UuQ77UwGxcFDl8mXisgwITZIVsXLFUnC0mKPjOJIbEHY9BHqv6TEl2IZiqeFnh
+/nSQPNSYEuT+Vy6EEZiUqCodabiSxNG0AAX7QUeOI0h0FdneZtmYZVVHMVWhTs4DquV79FPNl+wsl6P3
+6HwIPpbKgZKF8btTlGMclZHiwjxk+UiHc0NrYZf+MM+793v7zraDHeidRc8FbeS739iRH8TQDsKunLeK
+2rDHNQ4TeoI3w9huM9KzKrykjMnpUk3lBXE0VeHLX8lHgslzUZJiEmGGAM+WTkVLUtZhng8Tr1KIoJ0s
+U9ebJxrgiGOKZFGWIfYu82h0pmpMTrJiJNzo0iwtOhXh3AcqTDEu4AWnpzuV5TpuoHDa5xAgbNVkaxO0
+DWr11dngGHcAAq9JvU3p6Asoy2OHuLOOIZeyHzxvhbjTUBQF0cvYAXNg91nGvmsCqIWWfZbdu+fWCQBo
+GYEdNDV7k73dbyTcz8Bc4kS4L348OXr1t6Mn745evXrxCipWlr8ATV+UmeExpjkrBc9Z6R1NPkA59hai
+leNE3Ya8xwcXsSEzcR4bCblPs/T4JoNQhv6xFCKwv0Hrhp5d5jLWUOwePXteFjMW5Apu2gxoGdiGxsGq
+YdQ42SlqggxCJRKwhsoymjjGC6KlfRbMqQghlQJUIUAJ9oHgbFFZDgGcz3IIRaIKRiytJBOFWpQROH0p
+gyUvVUUImOgBshAoHFGQ1sV8Az4ZHioZu8d2r84wa2wmkqhRdyMUhe6UYzZsYxbE/95kb4eWCcEJwpXC
+nVRU0gvpShtKgRotOxfBtlKre4FBQycErKuv7zpIPBvJkoLppyT2WiLQ5aRmoKc5ei0z91zUXPScgGm4
++UqWen1h8gqypD1XnCSJn+oiZIHPjKVgci6aJcP1guF/jxMCO3NX6hbG4deuCby/wzHjw/pMUuxnlWPJ
+U1WzbE1D4THSLsYSh7ZzfmslSwaj7qCfkP0ERb6VjAHfIECtkHzbD5RV8i6cEojAugPCTJRwx0EI6Zpn
+gz0OQmif52ywTGPVsbD5obCReopMytGzK5BnMWg8Kj8H9L/whDmV70XBKolxo3EtgoQi2gwE50OORotS
+pI5Lh2Q3YL+1ZXcO1EYb53WOcw/Xsgt/e5Of7guYPkX+rK3mT/VwD0h6NXfuAbvfrxWcyrn5XopxKdSU
+vmO+AOWLAfj5pU5jaPbQWh9yOBqDlsmH/fUtT+W83nC5Ko95BI7M+pBWv/6stnsPbwh4YwJ2yeqPUQ7+
+dPM9nGcLVbEM8z/hiTJbqEoLt3jrYrIMPJW0OpfOGVkISqYQGWnBow6snHnKOVsyfi4zVD9y1P/z3ETZ
+U318SaumC8UUH5WQrIge3yBv0igXvChAJjZ6GE3gQzPJiKSWi8hGDW4NJjicDh13l6oZy8FM2Q3Zpycj
+OlKlyemWVcyaqJsOB3rihpwdRGvSBYVbb3/o0ylg75IyqDkvKbQZzFHCTsslGzogZBCHvmEoQuKwXZ4u
++65y9yNO33d34SGlcFhok3BcR8p/5AyUI1T1cPqsEB+09fF1LyIAwXji0eWjE3VGGmxHaimhuJrK8O4R
+3jz8nui+AQKaHgo70AXhuw+00ayOrPtlNWW8FNgvBpJP2C+U/n/AnotzLT2zD8lIFiNedZc9uhjBC6Nu
+R5CMtSpumA86Ohu9a3U/sJFZc87Idp20QATUmpjqvAV6CMeKmh8QmCSj32aEvX1dHcbUVhfXc38rnBtT
++c4dv62ZKV0pmS/UNIGsYcuIPPb9CdVFdk7r3TTB7W4GqAE79H04wzuOZCkY45RyofCVd4E6tyE0GiY+
+6Dca9luziLb3y62tWi0a5Vs0ma8dhSEpf1a57yYxwZc4NtNclA/2iBvu3GW/qjwrKiAoSFwzwItvy1H6
+7MUTdsC+evRobxfOzB2aQtjnxJm3Sylnzi7E8l/dJUsFnCLa5IKiY8K9D6KXjtmMl+8XcxPn9ALfeeGt
+ANRZ+kZ/JhJ27BlJuMdfgDEql/NKTko+n2rja1WVspj0jVEzV7LgZ/lSOw5VDgXXPwCiTI8VWXPgxALd
+4MXRWVZXU645na5E4YYS74ChUXdTa78Acwin5K7hW2fmlhcoe+6Tjid1l8FAqQMQupzdowomvOVh1c16
+PfZnWKR9rAfAu2fsHuPe50u32zn7COXffcd2v+q17HUagifu3gTu/wJbdMRnAhSskccqbVmgknfT5Xwq
+ipe8qkRZsAO2s91NejsTK8myxwAC7QAYZ1QbKZqOXvJzEx84bFUjwDH2ve252wE5b1LCeLZHMpdlR5vq
+f8duu6LHUHI7si8znmn0/0BO00Whp5jtk0p7OqkItqBPiQ6E3A3H3XfXx3d9F185knfs96SSr0HR8pgr
+oXPTtpC9wcjS/d79a8eCv7nRXZ3uT2BMkDWscQPcdgfTbX0wmYbhFc98tbFDZsrbMf/v7Zna3lm/Yx6f
+nLC5TgjKCj4TazePRf+qu6gNyvZM/nsbdfe4Ih6QZ/Lfp/b7ahCqGcJMhQCQMhU7LNKMncyyasrUYjIR
+inwLup7LOtjNKKiRjORs5yyXE0jjsbdzf29nJlNRFtm/y20wdsg+iHSn10c72u2ZGjL6qC/HI1mci7Ki
+kzyXF6KERWDDmRomn4mzuHloYDER+7G8xhJLn3VmarvTW8MsbCfW59PS32D322+Ri+zeOHp+KdbSGuih
+znVyWYhfsmoKwTLb9aBo227DdPp8xi8xQXLxGwDE3QUyY9nQKKxg+dR7sXwxDqvjJwP8gq7nYQ390QJ5
+/Nfjn5+8Onr+7uWrFy/ZAQHt/jaaZnlaCp3F4bLn5Vu3IXjgZm8MdyganDZSl3N8SOYMoRiNUiWhBVh+
+LoxnYqUvBpWExWYcuSjaHcd72p+8Sw2X/ivoI8DupCzP3pN9JqxWkwc7YUf/DZvMIErYMqH+YYeiAoyo
+Gx+tzxDgTJQTkTpfBBhLaMEe4soJF5gQQhj1GYSAhpUVUdbtkNa62K5PbYgpZeMN1U5Xfi3X9GENL7Hv
+pBRj+z7+D63Q0Y+lMbI9JgvG/bFiQtjE3gI9M0unljgTIw6baSkX/+yUQrt/oudMIS4MNMyc4BkQ+LAo
+ryxX1B2rJMsqxSjCk/cej6qufT/DdSEuEHN20LQdE1wjGhouQJ+QSWg19rV6DMyZh15BYjbQkB6OyZUA
+GQrwWDJhNz3H+cSDPdljd+7oMfrwVzYxq247MNjAQdSApK/ow7dZrRoELSxco4tOxUqBWrt3GJQLt8Y7
+dAb6UPX1wmQKw3ZVqNEGUKYpLKy/KRIkmcMifUWHKOHoztqwLlCC0L9oKySUddSMru3EDejyChqogFEP
+dh9+029hxIPdrzCEE3Hdwd4DjIZvOOxg72GD+mrv/k2o1y9xR5Donaqey7T9fpypU/Ghel7L5uS+61ef
+NuWXWSWbUpqzSXYuCvQnK2Tq8GBkUcALzP1sy+Nj7ocnL55Bv5dMLipRwp/sxaKKWjRUz4pCVz8uilr1
+1mTVQ9vNMEZ1aEEO49PJm9uuBdB3OLgz6pYtZh8/sltRDbvhnfMM2RBDU9cSTBDbWlblIm7oVs/B6G3Q
+odfO9Ra1Wzt0HVIQ27YNKDFQIuD1Ch4iK4DNoE8TJjF+G7duPOubeL2xO6Tti6y6a7PXzHi9SbK3HG9P
+DfYe7CEnvAnA+iU4IZ6f+DT0tJSzZ3DNbuWIlTSvYx471B+9F3D2knxsGGdTsSgzVWUjVglVNZkPkeTN
+MsVu4/PrNgjctw26DD1pi/eMq/ciZf8l1XTRZ5z9P6JgM64qUfbZ7b+iWJfKCftxkaZTzgpeLUrxg1Zl
+YCNMg5aJdMBuP1skt/1Ft5zLoqZIKlOMa48rdIQl/DTw2wNKko+OuwCqsgn+hWumc0tRbjBsTgoMU0F1
+9PjR25YXmDr+9NnPj7UnKVzfRTJJiFmjdAN7BJkwfpoII4qoH5enfIL6iV7PTN9xRfKwvzNR8UTnjJtw
+jYai687TLBd5pqr4/Ll7CfUaz4zwDJhyem18jnPVlWe/BtqSrnmCxPeXRZ7vIGb49dYtefarEWp3dghH
+RbazhGOfwRz8nKmKPpteFcsKdsLHvMzoUgMkLMfQDF6xO9Qck2RFJQZCp+d1/K8F+rrjhPCC8CC4HXpP
+wbB4OLQ7d/wBkQ8jDabbUYICfp3zvLmBkwacRcNIFipLRSlS2zUuk50U1qFoCB17wQUKtYjxImUdsAbo
+GK1jBkSDoXDjuUmg79PlXIANQKdYgDOjm4iuc7Ti5GjhJoKx7s5ORK87Zm1MjfAdHUf/8aMH1OwEU78D
+QrgQbq4+fjRD0V90ipItjDh4aZnOUWi6WduE0D87W5JNqDbwzQpXZC9ihUTCZ49t4K2RnC9NeAIHdhwF
+cTKQghMlU2wmVZUvrVd1mjnNgFP8ogK6gRd7vLbTVN7p7VsYdvth6IcXhXhRPpOlOAWrF9VF4xcSKu4a
+a9RigidyA1xTfd/UTpKE/rz0hwemRhek66kkqwAQeggUE9Vh3E08paOkYAVMjXjOS2UOqsOXx5Zfkcu5
+eZLVWt1wD1iOiT8cKx32yYLK7dW7MOf20MoKVfnRANp5GjaPtcBNc2T5GgqzDUwvErPeyLNf30aiWn1/
+RI1giyoQNrqtElclXesVglfDEKwAZk7xwd7Dr1D6enijZf5DRbKnizx/ySfisYnE0iiVRSpmSNUpxoJX
+bJSVFF0F/URFMRIKmB+tJMWsyZTGBTSUVt2MURsatMz4PVBMb6TGvnLwQ+K6yHT1wLW7Psgx6Bgaxh5E
+K7JFgS7/jnTsrOkD/LtpNcu/Z99NBU+/x+PxuzOZLuGVq+hQaD4xk+ciRTkMdIUARXCV5Us8JdiolEpt
+m3CmF8DSpvICLdvQ8QM8pEw4xjNhIjMCFODGjKfnvKj4BDW9OEkdFUfsQTPFaplTPJ6UfVdlVU6x8Wa8
+4BPSeLET9G35daEqluo7GtORhWl+ssKz6DJu/I1vYBWfoEYP/q/j/wWc0MU6QFZcZEBI8LeqygUQMYwG
+NbAelda4Zo2UuxWfOGMWLcE85bQTD5oUfLoQG+4b1WwNrmmLtKpb4t9d6IuxigTkAfwRPuST+hpCv+Z8
+SXU6CKrWR4fdg+Zojc7cuH/J8vw1UWFDtIxP8UdjJjaI+dn5s2JVmdERqSk/YT9qFbkcR6QK2+290rKK
+04gz1slmc6lUpolWQ0JDWVbJ+TaG23AjVKwrJnoj9fVO6ocA3b7qsVLkGdpJwUcBjlmZKIDVsFPJxtkH
+nUx4ys8F4yaqZQCtAQdiBQXuOo2v0nGodAAATUwq6fRDH1qPaBNvnXWl0CIWJ9y43l3qpaZuGhdXb5eQ
+kMmjz7wDWB9OIl/dokZd+ytO7obKV1NaEwsf7O496LfpsdeZV+7dvwkz/MfKBXj/R/mNTA2bpAKrkhbn
+kIKkKheiDzGb6E+rzqGQPJksjorzrJRF/TRvqmFP9TXXFbBdaKhhRAhI4oUjAB/SWgYeV2TqX0WGeLKY
+zZZG0ShK6zqhOQXPc22omdgIYCk0IX3yFmucmWTEi9dKwG39B3vwRu9PnTQ775h3RE8fpg2GEI+zJRtO
+BOo6gQENHQ6F/uTbF/1T3f2u+8+Lez3PyOjoA4aFIg/EoWk1NDdU7aZIKJHwQuc9VHDjbpIHqJSdNFR3
+QsEPpjZOFxgzmZ4xpDIcTP7sWlbgDbpLFZwMYAbxDP0fDwyAGfzsRvOC3FQjE7a7cyf88Gb3bVLJn+WF
+OeZ9fYG93hsFgKYXEwsSIJnDRaTWTbQ2SBCgcPfr4Ev50lUZTnmR5uJkVGbzauiHvUKDAePczmQxIr0c
++PJjaE2FTb63y2hiwBl8ErgrF7K1h0xZLDBuZUu8btTBLmvdkWLN9rWaWg498jrneZaiSiggHfMg5eRJ
+H2123DQNtnuLXVK/pX/35MUzvfk+PnnxzDwiaA8eEw3NQsI1TZpk1IitagLtB3iG5MoOHNPYv56jz61b
+FkKfdRoRoT5YGKQq7URRpRycnnNn0TuB0n7FO88K0RfEfm3tO3dC1mx3n/UMghbWhACU0PgwhGtOUUne
+7L5l9wx13KNPe2/3Xcov+PJEzNE/GUvv64AAFFu1a8u3t01HzMw59phzVT0GbbgnWPl6kRpahExg2EFE
+pdjBCk16hyp17Nh1I21R/+nBJnzysiY0zRRJ7l6vXYjbGo+w+7UpvkRAyFogbVRq6SH2krEsj/hoGrYM
+ppBYZIsCMXqywJZ6fcM1DGiJruH4Pa4WeR5g56vk5WgCryAtN4o9g93d+/02oWawd9/klPREl8He3v3N
+pOobZ/UvIEBbpQha2f6NIkVdPZ3X45MTY1gVyqJegVN9qddFVuVCqef4ssIO/PZJXOzpwYzuW0dbtN7B
+9KDDRkoxCIaFiiasgpKItkhEqWwI/x16WSjOBMvlBJ4eYA9q+3QFRtWKqbkYZeOMFF9ZwYar0Gw1qsZ+
+a8buFPiWKzas5PwZLydZMUwiJTyNMmhKn2zb3fvzD1E0YCeJ6oRWxiKTmqJ9YZrNRKHwfZCTQBQJAA1k
+0SUTfYRC7Glnhz2XlX5euhCdc6cxFGrE5wJEj6ey1Gmuuj0dihBDR6pMR60jQBdTmQvnv0qiIEFJXXBm
+XlVldraoBMVv1rkvdLSmnR0dDpxEUP1uoumdLQrFxxjHt0T/LsICU3OMeKGBben3t7OsKnm5xKknSz0d
+inheyrNcUETt7jG53pKp37yUuKl7AwIC9vxKG/TLC67mmIYug0hDyXw63/n7ycm7p1leifLd0TmHtXj3
+eCp49e5kKkTlYGgQoMmZ86IQZTKSyeL9zt79+1/v7O7u7H21s8irbMYrsf1Bqe2RUts0GHh5NAaK9OpW
+SHw3+vvJCcPphvcn/QTEuMuBgsO2MFim1MKuEswFPKvm0KaSjLMJ8v6SpZkaLRQMhPEzuahAFQxUUQo2
+kXiBMItULlTFXr/6WbGZxGD4Y4lB4hN2IgT7097u7qNv7++aAzVTR+A7zQ40AWvna+/J2nw/YB399I4P
+2t7njpFVNLDoOanTCY7wTD2XxfPFTJTZiB3AT/68S3Tv4HhVgr7uu6fcmEPEFqiwoeBRuVbxDZS8rSHJ
+7lE/+zCLI062I8Zlw3k212eF6nRcaEUsof8nVZnNurEgQVXusc78Q6dZnmjgEPbhzGf6g28f4Gn+9c1p
+/vuf5hhl4Kme5igsA4SdOPLLu7wMHXbaAlrzcoLk4WsKQishE3GfFynyAV6mitJbqH2WkSFGIZkCRizG
+YzGqlLVuB2jzMpvxMmuxCCDrnHNRllmKR7vtVxTpXGY6dwVpCXTCQy98NcCAZn195vzXCcspZQMykm0o
+pw4Zzzl7LEeSR8dhMK8wPZdbW8G3BM7AVzplwUF9rvfbqz9FA6SGNlrlvqIp2qQ2tazKxcqGz4GBNjXU
+tv7tPcKaHQSUYh/cp5naZ5crGptY3T4AokGf0lhjnCARzqZ1H/3mRvX+R/KaFzqneYv7qFeHHbDfYGWv
+6IdDjZNxKcS/RdeD1+LC4NXY+HJbv3reOON/CQKyd4THlCLHXRVa75lHJ48PXx69+/nFi59ev9TJSTp3
+OgPWucNn83184Ox8j78nlf75Hf7Mzc/b+PNfC2k+/LODX/70Ye/r/c7WZdjTq6O/HP0dXh3e3Pn+u9ud
+txgKwJ0LOISyizr54CwNEH2D5W8DyzxsqRh4A+ksPpQbCVVKaO1QQehy1WCbhW1g3rSYVkmNSPNF8LCw
+Fyn6FB9u7evQrWzMJg21i2IofrYOvf5U9c2ctO3P9s4cV9+9f8PV/6BNOc6KVLvIXEcPRG/q5Av3At3e
+GqymvOLAeOpYJ/d5Fj9+xqVBq2dooVGvj9+vZG5l7n515ybj2LSRj7AmQSMN6hxqpKu3ry5n5PVn9NSN
+zrvWqOGje9G5dIYfL0r9Ldj1ftVTQ2rYtxzXuvS4gLfw3XoX1/ShhfmSmgxqa594YbkINlW9FYVXu74r
+LqPOk3fwXaSHoJZ4JcbquHiF6xDaD2UK7zEUAXwiKj0bTJb+5LAMzdDRFYJWs9tLQiMd89lTMXI2X3hm
++biL0YOWHKZ5RQGYdIMAGln2EGZexD6d5QcpEPcmKnMr7h5l4UjJ5EI1Ide3KsRSjFXCnslznVwIlaFk
+aOzS3dEeA1wDWPWMeNaiuNOP1gDe29ARA43XDx34jmduZF2N169dEPD5UmsvgIjqxNsWr0/H+L/0dDrN
+xF9zaYtr+GBiZgVanw2AOp6V6KdJeEkxcJoAmE6v+9JWB5mUemqtig0raoVSe/1bgcMIrXzHci/WRZ3z
+e7FUA/Zn1XNuk0Nqb7P3GeLqoGKS9JXaiNWcYk9ePNMd6JsBgG2anVpIxw2He8XB0rPhdZfAs2Ls6G5A
+IS94qbTyohAZuiWFBwIrJDqsIkPq2pm95sxY074mgc1jfVe2rfMP+sHug/v9prN8sPvIuYvToT3Y/Wq3
+6Y3QHsWDPQK2xnF89yYszB8mR+a8qkTxWIdLaBUYq5KfixKDvz92ERd8V8p6hatKYvUsdQboYwrHwMz/
+TdyVaSkXk6mpxvPQYifciya2Cn7Vf9tdFzS8FbwIaoMyYyv2XixhQaYUGgZCNkTyGU0opffEmYAooRrx
+bjQgHXGjz+iJQT/W/WJW13ckMBk1TYBYnTjwIJ4jY0LzXixfF9m/UPF4iyo3vmvsf8mYKxYpay8S0Z+2
+FDkqRniclLDIF3qiS1G4vElI3e/F0rfi7gz/rIYJQ1hQpsMJnwm2wE739QOlD1BNeSkYJ1BwZfLhOYtE
+E/OF3jsxVLOVnApjih0FYaF5detw544GcysWc2Bx6C3JRDGxnlB6azylaVIOccqgWwo4CE20T/MMjk/O
+UbAWYDBL7BfgeRPqQs5kxShfpO4MJ8SA+MltLVRb3KKPl4biReqgvhdLujvBoJo3iF1x08hdXSyYZnkw
+DO0S7QbQXbImbmWh9ldv0b6G5Vtq0peWczcczhXO3iaOOdh7+PUmB+beTTTGL3A2ytHChFmp682jQ8u/
+zxd0/5wvqh0gKV4KzipJ8KLNYLpAgzF7AhwffYNjIZ+o24/R0QssNqiByZ06kkVVytyGmCI/maw4z9Az
+pr9l/boFxP3VibExfhmIz1HGZe3hWk11L8ltfNDjec7eZ0WqmBwTQAokrDwWJCXGKS5Udk6xxcYln2Tk
+m1MJdMb3UsrAUBPswriFYuoYkzmm2QPUzpSnfryJsvFFdgGaUnqx+pvdN8PtQH7IjBfstp+M4LbNRuAi
+a+mbkw2VL0sAZP2qoDYmVYb3ZjzA5zwrRepkgSBfgiYcF5kbgCkMyc0WVZZnlc40rt2/aTcRSWuDGG6z
+oXvZE9ALHGChSakxtMdInV6iXRjOBw5uqUwWhDvrwobAfKB+zG4cIyW7cDEaes6hoj7t4XNs2WejM52X
+2B2doUc2L0urvuBlaa1iXcPIoZu7lH6jszBzbFn2XDamht0ZY+tt04c32/T336YTUR1i2kNf2Vx3rsK2
+JwCRK+cJxP2WQOho2q7IAacql9vIoCn/W8KOIRYHHTYArZAVph43ycPbwGZjt0kKSdpR5Or+2gkWNjIi
+NyUDAfmcwNhOGPhsapBsKSpmkoXUHHiC6en22M7dH9yhfXeH/RYcUlr0axnLx4+uBBDY32rKgBbDsDUb
+T7gYRW8HPbrZQV9kBx3B2+5jnVDgqu9qev6HJiHBEK1NhaKs91NhYindtgHZcXZvo8CUKbuHACrlfbzL
+hqR9SOCxwOAFVzmF7wL6xopX3YZEpfMyK9DGmm58ulOM1pkuRoLMs7PUw7ivEwPmXpZBEjbpMD0qKko+
+hDv1lJ9tgzqEYliboD+FLLZtzzg2K2Fqh2mvPzIqE2oxQ39rT5C1jRy2FT/bdnOXFUy7idfe3s3NtOC4
+nWBR2XP82zRh+Iq/1iDbW0tj2V3nKwHRdL0+nc+VAeMpZx5rlyBXPdFfbaqejmmG4YNqgJkFG8FxvZmY
+S0+fuvlUovLHhZkRp4KWdhvVIEjvjE9A91+xoUZL59OhC7rpFw1p79xxAzo4YLsP3Iugq8l2H7Q4POn7
+jkXQpk8JCPNs4aGChop0AyphV2sT2XhS7IS6GLAQ4zkiUdw+5DMIrJT0H67rHdtr39hMQtezhAA+Mxmr
+vDLWhR56rgs7uclWNIPfH7AHe3CcBHPqptDL4RAMRX+/v992lARU6R0lN946X/Ao+Uksr2OdES9fzcU8
+KPVV6IZ32cxDKRxBI7w+gDvhI9xDQ9ovKL39byWEdYJIxbnIgc8lM/nvLM85OkOIYvv1yU4qR2rnF3G2
+c/jyeOcnsTyTvEwRkT/9JJbvQNOnPHdwzUN/Ektj/nWkRmDARRZVZNJ1Mgdi4iV8Z/QJklrCz8OylBf4
+Az+/ntuPr+f06RWsnP1Kv7Dgibwo7Hf8QZ9FDl+fiFxUuv9fMqz44oR+PhPFAn5rVSD+xILD+Vw1FlA6
+zc7A/PWzHL3XsOS/X5r9/5NYQpXXRZaKokIVLRmwGSPtkheKLshkFpCLCR8tPX5XSX/5oM2Lwmh8eU4s
+DHymkX/1UWeDYRIVEIAoUiZRKYtrxnK+lIuKydKchr8fHVR6ZOJUOkr4ZsA6P4LNHKw+zta3A9Y55Wf4
+9+4eTHQueEk/HwDNFJXQP7+CuZ5mmi52v9arUsqcPgDww1yXAtyXIFHgz737UJnPlV2lva9DgnywZwnx
+wQNsOxGa3B481L8tQT14hJil9APw+qucaThf1yj4wTcR/T74tk66D+/XyPYh9HJcKFHqKl+FNLyL8/V0
+t9NnuzhXT/fwT0D36QP8EyA8fYh/QuOnj/BPQPHpVxoGIPf0a/wOaD39Bv7E+Xr6Lf65i53cx7+pQ+xx
+D3vc3SMwD6HT54uZnd/dh4/qW2NvD6o9ExUPNsHnF96Q062R234Sy7rIBid0JJh5uQdtFywrUO5VeJAQ
+S3VCxNnSyqcsXVBALQIQ5ofTCSUuZPkebsRpyceVecqhTKiJk+OyUjyVHxwEpUcJ0lGpDdmGEesZGjWu
+geLkEu9S0C3czFWSDX1mNez1WTYpZClYViXWYuw97mif1b+JZu0tSDbRN2dGBu3RSiNgjLXQRLbNpZ+0
+U5NCk9QIM9Jnc5kvx1meMw7vgKMpafLg14WA4z5pWGjUiqMr1nuxhHuJ6vgmcp5ouVLud4I3XJOEEf5Y
+plglRtNCv9edyWrqLQYlpY0vTyNeGGCYChcdZebVQhsnDg2iwz4rJDH9unyrDdaSJpFSC5zsB8Nm2YA1
+3DqtyBq8brZOXgr8K179oMZi3vE21S/oXY9en/E55YU29m7RM8ELHbiDAo8QHHdk4n6Eo3AmFWp7nVUf
+Hpf6MrooMrId8O8QNgS7f3q9abirIYGH9Bt56HU6q0X0n8SSpPMmyW6wt/sIpfYbr7wvKLU/kyksZXlS
+8eq6WqCaSDfTQHE76vc7rpQcZSicm2PKYOwdcUYw0x7GFw9QGDt9tfPkxbPtn0FS236wjYirnT8BaW+b
+AXjhz033P4nlqQQLECOVg6w0YB2eAyWS6GrkqQHrjKoyt9/xyB6wzkxU3H4kWWzAOgr+gM90qLde6iHM
+hD/BTGFMSHyiWbIZn+tcp0YTBaD8yYMXlrlUxjZbTxXLKiXycd/1qMVhBnIHcF+VACRt65Yv+zqU1q9O
+DFZa6cUeT0s5E0wJzH4um0A5d01/LH8RVSVKONgOjc8mxDObZiQfZCmQuk2yoWO5LItqKqpshEvIDshB
+Txf6QtBBVDXxCvcb+HE80bFZ7YqqZgS+icV7sdR0U6OlN1T9rWcyYWr/wG7d8np6o7+/ZQOTa+Fyqy6V
+hcjU5DPdR8PUr+a1AVhPJ3KTdvkLctdTXk5EdU22+hehdekVQiFLC+SvXBN0eEkAHsFHaEymAxhQiBAr
+t48yoTxVMnqAHL48/kwqZXhfcmO+ZKcO65b7CNVo1iLrMYfKXv0xkrRUOfKeqiiq/77WgGKWAQyUMc5K
+zT4VkwX5q1FcpC5mUzk9+vvpO7DmAxJ90NMaz/hAoiCtM2gAB9OvaodAvnPh+xOIvOo2LuHsgveDSAgC
+qP7uEs2wgf62el+f6jp2Q9/4hX6ZDX1ciZJXsnxaXH0/s0kuz3jOTpazM5kbhdHx6dGrw9MXr96d/OPZ
+jy8g/Jk29NfVDgKrfnh6oIIk06iQyfHTw9d/f1eH1fnf/9vU62B0jh/FWJbCAIdbd9LoGGZaGQ+IOGOU
+S5WONeHa5WwmAdiPglGKB6kDJ2JlL6hiNQ2bc3xUgy3pEi3osCd20tlBuAjd2fJYt3fJD9BlxlbxciiE
+0NiBB5hMP5qgeckUXDoFa3FtuOSML8+EaRwG4LTG3TX+54/Db+8YYDDyoA4QAhkZd+NVv3MnrPomqoBX
+uLBGE/W81Uk7gtgtPkJRMpZA1nL1Vj7/H3vVDC/bu/Gx/QMZnAuIt/W5Qg9/jmDAqajEqDJZQ9FUh5eC
+FQJWnpfLLxQbGB9T7eVpxItCUkrMoY1fOfRiF49ECeNwgVpVxYuU57IQaAhAF0EYDj6w9r3o6Ph1bqKg
+oplCwQTFEsbyVKjMREm1GVkwZ1C/3q/G1IZUBgSwd+garoW2Zrdzt+MZ5ZEaTUd91ra6TyXQqc3MM5Yl
+O/nbXxwIa9MwpihmIK3R7KbGR5Wz79Ls/Pu+DY629KLs2sCphngvSqn9UfABRdsXGjt+HR8ekGadUVaO
+ctHRYbTxS57NX/Jq6n9LxVj5v0WeZ3MVNJv4P/KsEPFvXv6l5GkmisovmUc9gU52Iov4UwwQIPG8CSDs
+WP+3quTc/w3npf5tI0RQtim9aG92+6zzHX1iM3BvmOfi4DY0uP19B8p2qPD7zlsSJVCJGrTGL7qy/tvU
+LU3FB67idxWmFfiuKk2b8vvvdvRHDwAhez4JMT2fGLTOJ67azA8/jnqcu50BNfkBaYna4F9vMQlAh5eC
+mzrfzfhcV8G/3hJtoM7nzV4ddYPtSOaQsd+0tT/dMIggxEQUqe1snIk8VaLSrdzPt5pISj6zlUmE0FXN
+D6pYlc3o6VmNJpRGLecVotgZeHTQ1yWZLKLvOA3clNilN9NjQIUF2HP961jKqv51KngafsXP+K30qnm/
+g52sCSTezP5nvZ/9T25L+18n0W+9D+NPwd72C+f1vt0Oj782AK/t86BQjOJPerf7n/SG15+2LhtUBbRX
+iLmPZDHOJovSJa0JwqK7gPTmDHn+4vRoQAJQzv+d5Ut99qr2w9eCNbmm9QnZGndU98kw2DtalId4eNKz
+Nqt/1jakPkZFh9GMgMDqSoam4NjIQD45BLiHEx6EAP6qsb8xF5njbTWHRYOxH3ZZxwnv3O34D1O33FG9
+FgrUd5AOEJYpY06CCoJyd77Ls+I92/m+o98mfYu29kYQlMd2dY91vv9up/ap4147mSdxvDGVwEnQTVyC
+DoqPndOgvXQ0Nv3BOzq8z0ayu9xqvpk4svlcoajXxpXe271R4nyROw7GsJDlY2ML8GI8VqJaqYXFTOC8
+WJpgOTqwoPGWzQUfUxHoNeSisj6bMQ9sToFAPLElXE5QscbffhZ8HPnTeYHbTZYNb9P4rIQdxKUNcdv9
+6GB/EXT7KEBzCj53FBW5mlLaNXNv095IKKNf8Pw9W8wNtcC1C1g2gu4oA0Q/T58JvOZM+UJV9VwWG0/e
+D5vO3gn13j6BMctMYOS6Vc1wI67g8zV/up2+N3Z7hvklGZClQo3K7CzMcEJnsJ5n35qFV0wiGW86Z7BD
+/XqUS/ZSQwlnU2u5GjPE1DdSF0D3NaBaGg6fZKFikALjpOJlxQ7Yff/jUZHSp3UrE2jXw1wUBMN1QSdQ
+UrlwbzpLxP6WbmXAUv3vDvR4zIYCgN8fBIMMSMF9IAQG+N++95WaDvT/2bbDzla6NBGHLre8sZhJ0mgY
+IvOpzJ/kJirvrdTKNS+rp6HbuzmovshBhZE7Xklpkp0cF48Nh201pX7y4jG+YL07/cfLI3bAvl3luQ0s
+4YnWgF069o1MOkhmNHMpl5xifJN/3IQfCTKjWs5y97K5LzwMcH3MFLDjJ+jMDWJbXcpfMVNdOywv/W/0
+rSH0lq7pjrSAvQSzXIvCZZoY5aIfjKshI7BrEJ/DLRt0xWi9XXrjLP5ldqkXM/QQg+HJFWFiPzGLH3VD
+luWhatre/sHcyqjNdXA+fOfWbZksGLdxH+2wavnifloNhq7pWVGJsuB5bT82zEk32n56HHfurFHSezaT
+LzE/MvNObXpeLEQJHVrN8wwkdaumN8ZMaH2KPu0EDZTW3wEsL5NaKouOs6RykOGBn9zeUdmIRlfa+8pf
+ko6HGvqw2eeFiBWwH2zIHb/FwHy1PcfWla67VguBhrm3BpetN1TkGDd+61+GY7wuzgCWSMlR4KVUmc6L
+UPdgbw7CYne7QghsrkHUkjuak1WW2ijG9zz3c2qQTm9hMOuzRUE5+0F+DNAc6tvdTHAyFlhS0uslhQWc
+kJGQLNG4UJB/oEEDofMyE4p1bTwMm9h4oTBjyF0GJxkmfKHhwV2r4V7zCw4oiEFL9WuP8OaZHgL4ouXB
+8MMQjc2HyyEj+8aYg7WsUdf14PiZ+4bCAU10JBXQD8Y+DMxCzPlE/J1k68C9PmIVCUEHvxpzfVgGMP6x
+MYxTOd+y94rLrQi3DwNv+mq9LhtKCd5lKydqmUNPRrnx8/8CHIdSh9VNu90L/7vFfE6aeC+dbffN4fb/
+87aHgeX1EvzVQFKMsxGfiRzamPDtfUoH+oGD6bMz4vneIdDtnPHRe3hUKtLHMpdlp0d1vmO3Xcn2CIpu
+awBPJWZx83KlqT69uVuwmOmH0uqarFH6sUKW75U2NUe7LNr9PM/ZuShSWbJ5KcbZBwBJweBA3TKcqdac
+afT/Jk+skIO4IVNxYMdLn2yY+trs91ln+3/tdnoN+XBr28x25O2qG5fnL7mrLPVtcn7DbrMtQ8nffrbi
+/kz52aVnantn/Vas5fNbvyvtAK66PVvBPJP/Rn+QjGzDLJDtmfz3dmVLVsKYqRYQKoKA5KnYM5mKssj+
+XTK1mEyEqhTraqPdmSlKRnJG/rx/0hs/hYSKBW55zQsAHAX4OBdlpZ0DodfPxxPcKBuYQ41zWD4xUx5/
+mKntzjqWYPux8r+jscHeHnpa7X19Y273B3EQinBbZbwSYeTe6ye4AAgqq4QF1ZTmolYpSFuBebdWtQ8r
+BG3JQWBV46iGZXVcqWwSRVjW4cKpyPSzab6M9TGYd9ipZPxcZhh3eDnKs5EOHiCK0bKPXrNotqYf+oA0
+RjlXilYZbkC4jqum/xe04ivjzGSX+1s0qu4WW9M0mZeykkAM/fa6ybPsQ1ZABRLl37WS1mAV1bHLLc8W
+8zEQn4k+BggwVL+IYoQblLP3BWal1zogrJKw40QkFF0TnVsp3hGSvY4+DYhT3YidelGwl/Pw/qazZ146
+E/VyoQ0MddBDHdkpRCZkv5k61qV2yKDPRdPmMJWQn1zAufD6RvhxDbdK5OC9KHRUttWVE0wi4O2XzftJ
+SjES4WYLGpPx9mX8hK33KVqwaOrmhaaJkcmYi07k6HWMIapMqoPGXDDRC6xLBkWPnJZWYapR6RASAfVY
+CiXzc9QVZCppvLofskJcOETl2NcrdCggV1UugOEmhtlWmIY3ooI28seXsX4T2p49vu7eRqwqjD+5yfxq
+P1DugvCtvYF9JpgXLsjWEVrcW4AdmonAP1+YPCL6od48XF4ptvmnpLExCNy5YxHWn5KmjWMz0Ib/Wlua
+/LBePhwMA2MgKiZLLX7Zw1kTK+MqfnpSSUtKl0sbvuBEx5dBozQCbEMH6bltomtANsBe79yNBmeXYWeH
+HeJxVIrRosSExZr7XuhjhAy4lfb9TXQ7uykOGk9hbdpeY35d4eVrMf3nuXErdNPGeGlt+/KltUzXJh9m
+N6Mxe6cUDpiJ78eVWsz0TjcM+wJ+XAiTWMjblYlvXkbpb5r5tj+jvWAWTSjcSszmsqT8rfycZ6Sig+vQ
+aKEqOfPHaGM7oyu1lugNQBPXkOKX6EPu8NVpwl4Q15RKYOMFJhtKTVRDlAVbYFXafpGCc7C0lHOaHZSA
+wdK0YX2BB/rjjtYwMsqLGq4WNLq9Wri6RjakydZLc+3KyIjEkvSmhEm1nkp88SALhdpb6XUz2QS5bBiE
+/HCZFrKCpAZEzuUigx36Z2XTHXijtFkPGnLUeKz7i6SW0GiZOU7sAdgiRdRbbCR81JutETzaGy6KlT32
+t3wWT2vy2G3QkL0nHbsWXgYK7fArKqUtzlznxBQACKTKx+Cbvy6UDjKfFS7AvJEiWIGPJQ0TbCjU5LAX
+SmCODbLzJz7ghWuAt1ZepMAvWJqNx5hDM5/IMqumM7WlmYKaC4yFmy8h3jApMeWYnkSY+DDnRSrRadnx
+rL7HQM90InxVydKYjMG1UbBCiNQhU0cAN9nFVJQiGO07nOjjIhUfjEFWXDbjE+Heoa9B+g5gpjAZ03Nr
+Un5goiWE9dpzrtnqhgrMoWFbKzNX4wXItXY5RcrMwYQ5u9EzCn2SqL5LKjARFRmBigu72GlK7L6ailkI
+J7nWlIxZV997debVow+VKEAYUO6Ma6vRNWPtRQGkjFumlV+b9EatovEVTKDDO/tgd/er/gq9w2B3z6XY
+ivQKg91HLi9XrDYY7H71sDkR15pEIns3ebn/OHWXXqtVD9uvlVex29Pioygrzc3oXWcpFyVsowlcNkm8
+NHnpqnJhNQsvCQGmMDbYWM/mWJYzXrEuRn2m9Jo2kmQPOTXX+eAxXijGlSY4WUFtM5s5AcXYs1K+p9hl
++HMpF+xClLhC4gOydUptbJ7d7fjYDLjdRFjnR5gOI1s7rkGxgKtpOIXUqBQzDqsgmSjQ357SZNqgQMDv
+RRnCS9zDn69Ns9tiJAsijr6eqz7jfXbWZ6M+S/sMXhSumXYVGujph7PfakkcZ6MEMMBgj8pSlt2Ow9Dm
+FOUFE1Bop8+sVydme8bYiIYT3JwBwP6GSFFvBx5a7j76LCsoBZWLHy5HeFlK9+0dAOLTzUzFVJwz4Zl/
+BZlLjSsVnFHxKIvU37ZTkc8xQwgxPO9y23gXgEHzcgJ8/k28mm/3w0rBqb9uAo7tAv0tkxQAbBCMiebW
+vmLs/FntTPqO2kAfqo8nwO+N6f/evbf77LIXDWpry+KTjEt4DD6VLzFI0y7Gt7gQ2oZrxEuhN6kloY5i
+oLHEdluO2iwttOT6sO2vn7h/7yZAy5c4YRRGxzkx3Pz3MsNcKPFXrp4KXi1Ksb8FDGQTG8agGYYnYM5i
+J4yRai5RLcXJ1MHRNXd2GM8v+FLZsKikIy9g0wov5BtXbK7Xn8IRlDpMZhTpKJWzBOPDwKF2McFQR39K
+5Ww7lbMQm+0pV2PCZkOkux1wYe708MCgbMmX4buDQiehwsS6845oQxLG2VSYmfeZaoM3qw0YAEYhJo5+
+Ts6rwJ8nohBlNqIelU1DDXHxi4nO3DAshRLVsM+GueQp/B95x7BPxmTkWG3fiX+UJUw6heuyz9Ntr8jY
+LzzWnizG4+wDQ1LWL/gimSTsNrxQvb8dpvy0LyM6Rmr4amM+zqdciWAWk+b3lVP7riIapr5m9Mv+t2Ec
+dnjsQXI/uT8vBes+JiXbjwv0OvvInh2fxi8y4X7tRnPQNwPwTIdXbzSnYTZDv3OH3ep2eEoRu3/GGGii
+DIxz44zX4VUSpQWDF9j4go7jXrxcxmEpU3Y07MBr53Vn78q3vMrNWv2V0UnoRBTGwk9Uh1VVZmeLSrh5
+7LMODWrf1A8RDJXTb2y7t5FuJlBv+XjD/IZ87c6dGikjrIupELkfAddqaU0Glgu+NPnzDM1Z1/IhNh8a
+ooRAJ9/eSxpGtAnzQVJQCWHUZ50Hyf1OL8404YFtuS5H1LuZkfWD+zeSwJeQBJ7XExoFKcG8l0rtcAlX
+M/0npjoWo/ctXPKXqaimomR4ctDNTDfEp2jfuSLgduiARzWDB+dbt/RXL6qXUbw/t8p1uxnZD7Y7rc8x
+9QZmQ+ty/90wek2mr6FXk9Hft1d1EQPM6xVGCeu17ZAwf+WDGw/3L0L78IxyXMwXlUuN156/Mo627KQ9
+kP7UzoU42+bzudrRAtc2yE87FEmIT8RONRXbmPp12xxFEPvyT/QJKGibV1W5rRazGS+XLrKUYZuIKJCg
+MpF90L4wiNfEKxH/rrJZ47ftXI547peAhiT4MJNFGKVJk30Qx0mpC1mm/rcS5ED/gxK8HAWAKpG3BGei
+3xHOizKofiHEez+Wk8c54hXFd7+Ah8AHj33Az2jHHj9/+foU9nbT3L/BBrBcGB6woTnERj18dXTY6VnT
+koYNHyPqbf4br+EvtvlbDz8SUZ/XUizRNz8S3+98PtrQu7VD0uDfdFBGR+idOw3HGHuwijbdgeRGPdh7
+QKLZjcfsF6DQX2VWPAYzSqEiEt00JvZjOTvLCqFsODsyy0RWZYyB8EVXFkhe5nj7Vc1FOUYbcEBiREhs
+g9Zx+xz+X/JldDlPksR6xI48pFfaeXsj7FrMdu72IZIsu7vj+8GaUpvg0HyAi2bHj0ZfgFcnlJqbJi8n
+P2PICnbgHixsFAum0xubOt+zXdMHXKm6yAgyUptmGfvOgYPf9+71XPgM07Hfz5sss0pj6MhWcu3CwbiJ
+YD94BfdYh0H+FdAF3QvH6EKnXEYusKZ18z73Zt87fB7ePPv9Qfv9vVg+y8pSllfd7VeNHfvYPOjTC1Gx
+mAkd9g6dviiTxr8WPNfP9VlpM+BYR7Oamwwg8fjFzy9enbADN5Tub2f5QpDpSZ+VIqU/L3v7rtVsiS40
+7EC3T6CJV54pLP8bWiAdsFu3qNob3e7tvsYBjt2cq4rlWQGEAxYMhaxghYCdyXImUqOy05682jgWNZpo
+jgaTYd8koXE0D8YFlrBD+W3A2G/vxXJ3AEB3+1BlD//eu6RaLxYVVtO14L+mFvz3siWfgDz7tcm+194L
+7Bz7L5Ly7FdnhFsKoIbfLr3csvtb1zcQk2e/+vfoF/YifivMLQ84kIlSx9FBkiS9ATvUbJHNFgoXhhdG
+MOlsxQZjV+mP7MqQYWM6rYLZmdAniDz7NQ73B8nbHBseyaLKioXwY1GVAvOSvCWSjpyBS0w+0PQOZod9
+BXuQtVHvHty4AH8ZJvxiHOi/DvMc3iZ0SGkdOoAz+0oNuXvZzwLeTKGbM1DiM7VUlZhRJUrDbXxq5aJi
+uZTK2J7xsyzPKtTnpsuCz3QGOODwQMlGTOPKy8xaTcVMifxcqIS91H42didpz10GPdCAds55zuY8Kymf
+eGUMIoCKARzYYTgDXOwWV4RXBkgpRrJME4YqXCWwxQWnqCKTkp+ZtUYUcRtyBNOxIkjHRLSWY2/Ts580
+djSblNSQzVC61GnY8gy9NREbnSDrTLDOB76710loubq/2W7M8RIPkeoTKfAKrdYT9oqcKejA84cEky+L
+kWAcrWnKajFnVTYTFF0c4JWCLBZgMtAnY0EGzz53fjEOOHMBaRBf+PxZs5SAa4W1DO8yX6/FwbxMiR73
+0lE997ea+deLsScT3rhJfwHOM+NzOuJaZbxw8dmBs240rk5hDSfx0UOLTpNovcyGwGrAXXlI1A6EKPho
+akRCzFVyUcQZ6HAusGdKQ+lJk2j3osvwMRenXKhFXqkEBTSvz0xRBBPIeZKaPCelcFZKykqX2wZxwgPZ
+TFNBwWf+d43JmUBmW/JzUSo0dtA2bzoHkXNpICNVPq40jQGy6NZsF2fonsfPBDvPVEbmE5hx044tYcex
+nCk+ZAozTbncR9glvZqnfVcdoIFaVXtUuwkzxm+2JpnRCuRNAY6IlzLJ6JvHmopcYDZaSnRDk6QHZP07
+9G9Ls5NSzC1P0/P7jM+7veZSU9SYCkYaam9wbDSDjhRsOu/NBkE37WRoHVjfguwbKJ56wdegNYS4M+I0
+0LGVqK12oNDP1iEQgBtuR5IDDTqFH2eaaeBvCh3F2SBLbTTCfd0F1eprkwfd7X6TBoCgNl//7Qx5jP4m
+meiXYPRiJrN/C8qk+4Iu9dfR7j0jOMTU/QhVJKDarUA7fjQSc0zmZuU8w2bb3YuDXWj2W0uaptqouqa1
+E3hGfDQVdgNpgJbg/BAPRu0HDWKhR9fz5B6o9YY+1zcPXrz0mHsNolHQer89TFNtgN6+ubGV/6M2E2wS
+jAOK1NgW7uHIGgrVYjRQybVyMPlp4Ci8OQYzN/6eeS7sfdFEOUeJ6VyUcHVUxtFTq5syhaPBLWoBka+V
+AUUylDHoiywh9aoAKEtp1uQfeYC5zhH0iaiUETTMzZQbD1prSo/SyKLEa59Zd7CoFiXz3qLQ/eBM4RWZ
+cbRPArmMl1U2WuQcfY8XIzJ8vOtPSNImHZgKDFfXn04LKnxUCz1xLtmpXRWYc5zZYVhn6OXoc/QfgPcY
+nKW0rkHt2ukxkP345JdkCtWb+qfrQevRbN9WcabXjbt5giVk4gMfVT4NNejVNuqYFGo2ITx9bWaMFrkr
+aLuCvTfYffjNRokfHtwYh38BlqqV5bwYbRyo6xPNxL0e97c2thL3WmkbcRPr0Sv4+NEvmamXrWUX4ux9
+Vr30UWmi9hA4ijKbWDM+vLFm/LKk+1xetBp2BJTjU6lXECRbpKSK2djEIMCoinVqSwp50e1RwNRJyUeC
+HGjHWh5llUTb+Ce8slWzMcsq9EIrOhUpCRL2Czkj+wnmn2alGMsP7Du2+0hb4uP4TCRnObYEAopoQbqG
+rBiXPDrQULCOqPhWNIiGDcaeYOjEy9oMPpcX4bYAAMlZVqRd72Nvf/Veei4v7EbyV2Gw9+Bb3D83FpFf
+YP/8ayErYa3j/waXyaey/JE8Ylr1kkKN+Fx40bRdm3B7rajob7cjrKYYN4joay06mJJduxqV2RxJnFcV
+H72vZWW/e6kb/c00pc6TJqsUmG0qNhFaI9lv5bx0saPA/KpzGz0f2odr2tyDqs0Hzco+7V5ZNaWDvfu0
+d24MCr/A3lGiOjYZ3cwDos5krtizk8P5/Hdysvvlr8enRycvDx8fvTs9OjnFEKhv2D/Lfxb/rP45frtD
+Yf6ev3j+t+OT4x9/drW+697a3v4I2eo+FpJ21MeZqPhH/TeuTs+B+ufO92+9wKonwvha61E7Pbw0eav6
+5G1Nj50c8xJXOjltptjckA6Ag41N/irftKdmqgdQM1sYbKnrqRYoy6tbGP9FjvCDdrR3izgpHxTRE9nO
+DvslK75hYN49oBdhjI0MNfSLZFYoga6UXiwgWvV6nDsqSMQHMXpdKD4WP4Mp9lONGGGzKdZsNbCu7z7s
+5UZqGCgUXfacBmxTORxCiBx9MwBj1oLiOiFfpqgxJp+xzfnmkQveyXPBUwr7tLMTkUcpIOZMmsSQC/eY
+0gC2DqkgrmGsWikOYq4kKhX4XCHrsl6dsOneqxnMEdj2ny3gVQN45A4YsWfnQu3s3b//cGd3dwf7hMl7
+x4v0Hdnz66AqxsmLcoJCLHca7vHRN9A9RYREysFn7mxmYm8zTCagw/uh1rQSylNgrfV086qHKSZZx+ao
+b64CdGr9zjanPxzvMz7JRqyaClkuBzhKRbYCab7UsRUERUxR7ExUF0IU5rWtSE00MAfOJHEThdGmkDir
+EeoHVGSa61ZqAb0pBwxfIi9kqYRPFjjzmSzBDmAbzgskWgDhloBVZTaZBMCI42HeUkR9JkvBxvxc0ntp
+Uweu8emLJy9go/AK7T0k5ZhJRQU6aML/hyitmpcDz004i9PjmaABpKCiNbLhwYLEaMDKBJG/1R8yjr6+
+HKNWWz9fePuDIVV8oliaKdAL8pLWoZL+jGQF41nJpM6qjjOH1D6FNoXSVncl7upCMgsZ3/IMnKzYHpey
+qIxVXoxDwl5mk8kSb1JSJzx3s40BqQ0oWBgFw1uS+T35SC/rw6Ix2YMepYjEW4HobE1g53SJ+IMQjfDp
+zX3tDPod8vjowPWa+uu4s8NeiZE8F6XhhAEJLYPQfPMSo+4SE1y6rIaJD+6fi6dHT5+yKdev/bLCjZcz
+np7zouITvCzSc++/RSm3L7K0moLyjSYmCWksYCEEG2RbOjL8fuk9+QmvOIzkXIcfgVBFbGhY71CHpaFU
+fkpvplR8QIkhz314VkPMnsDJmVUiX1oLHYpGbLiki8lR6Y6a83W6dTX1kpRXXNuC4+Lt9moZCRM6h2hr
+mYY9By+KHwL/HHg7Kd37fbbrt9pqab3idDatLtuTnvlse0PN1I0zx5e5HXiXtN8h3sbnvIg3SM1eY7/k
+dq9+H/Czjn22G4GXYgxdo+ZSlkwtzlSVVYuKbJf8jnmR9hmfyWKCSc7ofU0tKH+JErBZvjsrv7dpUPSZ
+k2eVKHnOCnFBVuRTXqrEkzZIXsNHNq5YVunQd9e5sVhLlqYbi7c2denLWa/EyVnZARbSrWVTAR41gt2N
+U7L1PBFxQzRjgVJXWKUgwcbmvX4lv/Par+d4/Q10Jv2YyAd7D/eQVd7kffsSrHLK4YJ99K+F3hIt9i/6
+qUb7qmTVEsQl3L8VcZdSLibanUX6BtIgHZKiTmcjwTgmdNsAuQpscafW4FqLLH7EY3xixV7NXUbbRB4i
+bHn2649JGIEfYYcGgTzPCTeAHEKtJZs0DqOhVtKfKLArO0RjsB/dpoZvKNW4z1ZFScGEnHWbthCGS4pQ
+FE7ksKMIQ3t/o6vUj0nd1+LQt9uB302myn60bzS6+7Gx1sePCJE8L25p9PFXr5ZJ3At+amzf/CH8aIYw
+y+gKiQM4bBjAj9EAfmwZALvVNrpNcAsmv5GfeWvqDIweProxMPrDuBGc769Rw/DpyWfWJ1sJk1AM4cFD
+n7s6EWMhPpiTeNhH/VI5QydfE41K68bIuFrHFbfBj62uhCsmSUFDaijAKhWqKuVSpEyWTGsUMCAyGnb7
+ABP2o6ymzlIbmZhJDqtDSKOc56ycMqVDbo44scu5KHklGFr08ErkQilcaeWskYolg+s+6Rx0z20GQ95M
+NZZ787ZBSC89iStmcGXyjHaq6Xp49n2kHNv2arBbOmPGnTt+XfPZD4YFrciz34Sj8eDs22oAJazmwXVx
+Ph20KJR9WBDFsXeZYVw3UfuwwLRvjso/juFEEXronzdMlzTCG1Qt0UTYBI6AuAXy+t8CzYK8KESpnvFq
+NGUHAYB3WFSDQZ/3AyDQTPt5G+tyVwiNVxY+ydQ858vGOtdIZuLci9zQwtIaOZqxOqqspSmp104monq5
+OMuzkUlg0O19OoTEj0AfbYcQFs3XZ+gmSd0C7AfwLmuTVqeEVUOu177qpG0GYbNJc4T4Gbq57qTpFFEb
+DRjq+v2sHlq0jVbA+QR8G5jrqow2m+O3Dqe2fm+FfHjtfBKiGJzqCvXh1s5LwRuGBshdkZc0btKGFAgu
+i1QDouyqm7GRylf1Wh/rOsbZ1GLTwdqbG2PtlLCeDTWjsNnIN0bh+vmy3L8gK43/r/PdnxXb+Z5lSouv
+lPSJJNYzEE//rFBk/rPS2UGs1O9H3fYAgijQ/bPqBTcElIIT9tikddLvWyapO6/aoGFmjFQW/+xUVq9J
+oq4WkM0DoSdbnoty2Qav5KVOHvXPTkkZWLJUlCS4z0sxIr1HViXsZS64EmTePlqFoM4eUwk+w6kSH+Y5
+zwrKG7AAEABHSa2rFXi5agPH3+vcVRhTyOBHUUU7/RZq87ndx4+s87qgt3ErMHf6LVvFNnmj27xt7WTD
+qpFYWKsRZUNro/zLraa/3V9aRvbErpVhfLQaoVlb0HbNuIIt/ppEIA+/+qy6h4c3uoeNdQ+VPNShtloC
+1F0t6M+5KCuGwbu20cKBblPkuwNfVTDdhy+PPfch8oUWJVqu6A+kPLdRdOApVec2hQhBcJ8HYBciz5k2
+fmIoTyjiLKXglcABPi3l7BnkmE4aY+F8NLT1EaYwz1RDdBwcQKQf1dMXBsUxb70AwQYB0+mw5Ezo8O8Y
+xqOyTr20sGQHZCQ7L8xsF8OOw4MvhM1mWUHwZI6+XoryL47ZCR/zMusln+A01BD6xogwXReENrwsu/xz
+tsiibhyM9FRBhJ6IPHRmFpHWHYlakbkeKj3KFHbdqdGAvbd8o12Ix6gjCRVCpIpx08I8VdbH2Q75E1H2
+IN43omtXf9wGUwQiwBb8tdSA9mOo4x7LkmVFmo2Eqg+i3ldDTz2brO1FnrLjI+uYMKFEl8YHT6E/sULW
+ETm5s0PkGXqnEzCjz5uJaipTimNAGUXShUvCBjwbuQyZ77zP5kxVJceDgwJwETTjTpG4V45IHW9k26pc
+xoopIlkXMQN7tZ75Vg81Qj1PVwQ2bsdH7Dv2rUsZZHwzEOafCH9ZBNOk2UeYbAfMA2GY2ieEjeR8aQLv
+nOHzkxYQDfu1T0W41pwekfoESUmaz0L6Uuacl0qAxZCcL3JeWc6bBKHBaN8SGfT2t+KIh/d1xMO8Idwh
+RMbKsrfER02Qw1pwrAaRRVPx54yM9fAmM/8fJqHooCqHef5Y22utTry/kSe2rf205JOW6qYoqG9UUH/l
+RZoL1dAsqmGN6CeiOsanY1k+jbLrB0WfPbs+VDo5enn46vD0xSuT/zXCMrEVCOjJ6x/9Jp2B9ypOJqb4
+ANrk9I25sQqS95AvM1kIllViRszFLj3ef+4C8VSi9DOhLZQodb649CexJGed8mcp3y/mJjD5QWfAOgf3
+8eTtJPhjl34M8MdeBw1UWsG9EhPMq7Xz5iAZvN2Z6Lpplv7CywKzWj7jc+WyWTqhrxm97sxptzWHWjWO
+N1j9bZAWXseI1JHNdAwNuu6moqjIo5+7rKM4u1kBCyCqBvckV/HQ+xsBUuxK7ZzOOJvxYsHxCAhy2pAQ
+cqktKCkXGSGkKK9qNg4as0zpY4JGnayP0DsR7kr5k1h2LaJ96tU9WtkSUH7aH/i6EmmcdnbY0Yd5no2y
+iplbtkYCgg+8DtelG8Dq7btH/uOZA+LeQW0CV5oVw81E5Sf3TMWHpJIUyqP74Ksg/z8RQrCSuOBaB5Lh
+9JqM3cDlcIlK2LZZ2rDO0PhxAIocRrTD2fUc0qgwnipncGWe4Tpgq4ufbVo5nO/2XddvLy9tPHs9VZAQ
+Gx6p34vlULvbCb2yaK1M7c2CAEwYe0pPq4tMTWE2wSIDgGUz03LbxWNFqcf9PFv669JRbpEzjGVRNoSz
+MVMKM/83Fx4HsNl2odBwCNbhcP3GaKJU+2qoW3b+l/MCbKrrT6V9rr4bh76AP6pSCMMP0NzbNbhlxldA
+GiH5FFy9gH/rSzmMGlKkA/2OedQ0YCC6LX4KQpYILxH4oqiyPEzd68GrRw5ij80fldSh3ujUwThz2AWJ
+Ikk8CUayeEyxr9hrbakwp3iXLr+otvOSOvOnbkdZt8w1LFhPO2yIEFLUx4ssA7N5naFoFS58g8QDjAi2
+lYEAW8iuBvxw8wu/bCCyLRaPcsvpKargjd5F4Nhyzzx0FfU8y/T1mr5ruwY/kRTIr5ou+Jk8p0z8c1Fi
+mnI0CQE+nWB106P30mzzWrmyAyp17y5Vw5t/rUjfnl3RZiFJ9EDM/Nn87uEcGsWuvxrmAjdmmYnTiv4p
+WKfPqlLoSHqwbIwr7ZZ/wZWOh5PqEKs69L0B6A6FjiKFt0JZW1uRNBDXBNRLiYbgtiz5XrEfPFHwXv3w
+NSNi91ET7ZMYYz6ZbXmZQDXZ7wZ52vTAjW6c/kIJwgSL1tT/GLY+3QV3dhj98scT3CNMYCK7czS9hHqi
+2oK6Wyd1BHdOUymxl09399Rryw5srTcuyL57vGbuNanrZvqWmWn36V4oUA/cIvS8l47G1eizLEq8ytxU
+sgNvSdi9YEpNZf8bu3ewir14Q+9vxa8b4Rfs3n3yOU7jnolzx0amOLg2/u0ouBK5BXXmQ652L0isa76z
+A/vn00IHOQyhaCqsxNxPn+Aa4UJaKhFFVWZCxUY7Wo1hv11Ms1yAsTvA9XBIYNK6vV6SyiJws3OkBi0S
+lHH2t5pMJVj4dvk5KG4V1cF28Cr2YqQ2JsBrEWEDITYTYwtBNhNlO2FGQ2z1nbqmFdSnPVPHt9JwQJ3X
++PQMBYwrT8qgu9hSVIyixLjkoeyYnFzqL60dUMaXGcZ8y5lOJUtH0AzVV2fCeU2bF5+MPObqwJT410IU
+I8F2aCNgvN8xiIwiDY5kZdxkbOrs1tfQhit6aCxw6fv4GSZCKkwtjTPYzEv25n3//C2rFvNcKFZyzJNU
+TbmOcamST9nU6CyFvbRtbKAkrBE2dPwAC9/svt3fYmwDfvD5OAJrvHsQOvff9mJIceMWhnK/F9Tr7Tdv
+4U05yjV5SiNXaeMrrZyljbes4i7RkOvP9pe+hWoogevXLUcp130P8sVWl6kL2u+6kXQaZlKn1zjybmq8
+QOh5lhLIfcwmFqTQN/baLtqzq16KgskxcQF34/Zy5wePSy1I94KzfOyUuYEGN9GpFeoSgBUMtZuGgdCL
+WL75vtorw9+9pgV6lXzRA71VcbBy326ya/+HygDh25q+7YSjdGqWUw3aO4htQPRqOdfJNyCfJyX3gLzm
+8DCsElN/iJFRARaduBhJ4Ux4bYzjmdUm2Tj222zYtHnRjT3sBVOg9YabNcrFuHrJC5E/rrU2eZGG0ZwO
+tYAh5/rByHhyWAWyiUCvB6Nfp0RRZaWnZEFZRT8pLZQOWVfJUsAD8WIGb4/0DloyXiyraVZMiINCNzqV
+iaUCPaPjDP3zcnHO69q8FfoxnVNknUbq1CqiFnOnz0HErE5qrTbK/B/dju1k/L5qJu/+b/dNjJinj3c6
+mkD7rtG7H2fxbtvvrtNOp8/ur+q75cG3DvhTA8WGD4CD3UcP+23ve4PdR1/3a494g73db5sizq43hvvm
+xhjuD3pq1ivTGoMWQ42YsFdRuAO/KAhZkM2ynJdaOU2kAOxdh6aSE8W46dgo9UayoLGbK9yMnvdoXSJe
+mMuJjjvAsoKl4lzkco58VjjHdCwblVkFx49+dJ0q8CCeyXNjHpPLyQT+xhUHtuNEzBAWXqXeCzE3y4zT
+S653HMP7YPgyO/EIkHp0j7zu2TqYOx1U4ApXbAfIbhs7gX1GWnzWY79ZUQ+SjB68edv/X+/e3T/Yg//t
+HtSydkLhd1CEf4FmEJol84Wadm3lN1D21lNK6d5AZrdKcycekkFSIS7YEWasc3Ly0Fz7a5jjQQtd94aG
+3nyKCaTtmVCKT1x2m06sdIvw1GOFiK9gsAVB/dQ/f3l793/tUCghqtXbZABwAhmcqJlnco7X/kqyRZH9
+ayHypXnOXtLOD8aggRgD8z7QOeMUAysVOijnuTCdwHV9ABD0h3UjRkH1xbjbecqzXKT0bKqyijL8wL1i
+wDo9smGLXQ5RP308KQAV2869uwIAvLGZdMwhArfs0ob6Sl5OjBR937/LmMU8YJ1faE78cdq31p0/q51J
+n3kx+NhvhC4S7BsD/t69t/uXVriFhwSZiwRmu6t7soW+TRvqULa3t9kvIh/JGS5jKs4WxCno7ra9ve3X
+Js9coA983UCaKRgny4XiXBQZaoTMwwZkRTMBj+kgqDAfiANYSRLYjCCHy6WTrBEXhGaG+rB26cWYiok2
+Hq42xeuCpYEn6jeLGrqXK4gX4ckw2Lv/ddOhv1HY+Ro2tttkJ8/OSDwBT1fdtf0GcUm+gX4ebRQjPA6G
+sbNFId1I7JaLkh0dWbPlrGCckUsHfWIXmLMuSkJFQXXhOquSLXooG+rkYmSm3UURcmiOPKurZBcAS4yz
+D0gAHhydhhCgDf/PkFWSzfh7wZRVWJpkidW2Qy5CC7qC0GhllqaiYLIEaEhUHG8vFPuXnaPgkGx5oe3I
+cpI6ah0JP+dZrqP2iSIaAEDLsJejk6/YyXJ2JnPoYkv78cKQ7fNs0APpB5x1+A+s838gdbMxVbLZQ9Bc
+08veYU21jgCLo1lWVV6Wjfgm9NRebcYFtWBTFHpLbWACG0CEVxk0srdptIJbjOmGCRM6J2j5o/GSx0xx
+kP6GiVlW4U+sx+cZm5fZOa9EeJM5OuqOC5t0q48tiMniFXZcsAM2LvbNb12PHZgWtkRSHHKpY5Y7txiT
+DykrshnPw9nD2EpjPhL2YjuTOYadnPCsUESGGKrtV/RWaG5s595zbHWjRu/XaNAeGIi8ynbusueS7r+V
+ZEpU7O4Oc7j/VeYpvUtzpbJJIdIAhGJnlNzOIoJn2W8vbOoz9r/9yffbesbO75C8FfPknzibDeMszxS+
+8VpMsBV+FoUoa6G2T7QBClU7NbtIO8c5QQMBpM1UhfEWFGxflLxN9HtwNnHBI3kpQizMZVux3/Bp+eOP
+Lk5ObW1a5sQC9ERU10sXh9LX+DnrDHFesQPDBn4wf9zTczCg/28xxljfYzMHRMhmGe7cCX6/EefVW2vg
+YXqkEbJbtywYE8XVfbG13rw1hbYsGReu2P9KXYVv732WswOHsBZB+0yQJ/KFsRjv0SN9HjzLC/Eme+s3
+f5O9TWhn+4oGIXRMZW1uN8sqxgs9c5VkHBO3TjJFeuwrE1/hWT/h14hSLM0dg6sEMV/M7NA5F8jTKtKe
+e203oyRo6xMR/Db0w3f7jO/1GX/QZ/xhn/FHV6QlSxe3AhKC3A01GupFnoO6H5/Sa200reaiYAf2imLu
+W7oQRFb9Z2bx8Q46kMuNr4xdr3HhB1Zy3+kYQDzoxfJnXWSmzAfRdwyrj++J5KfCmLrI0FEjF4WfD04J
+tjsws+ADIqnQfTGpIPvBKyUC2LsKAFjhJiAPrgoEyKQJ0MPrAAJ6awL26LrAgHibAH71KQBxN/hA9b0M
+WVPG4PELSS9kQaJg27uGD4kiMhDC21UGrk1vfXq2dkK6iwBbPp/ny0Z04YK/32QUY50JXStvxzDWZ7/u
+R4O5b1GumzUFOwS45+abhHht6z5p2Slur0SAoqWDj2ar7LOzUvD3+yGIvSuAwM3SDObBFcHgdqmBSsWY
+L/JqEAe6wYWkpfh1HV39aujqV3+FPOr6tU5dv74N35C9H/GoImILh2Xorf19SweQ86U3OjF1DmY8ogyx
+4IirqUAvPu9gaz9Hnzefof7NQ188rH2vf01pu2+cGq/hDzbId9hss4MW1bteXj6zGbxrhufvayaBVjm8
+jaDF7DRTPX2sfcJR3AtlOw/Kb5dswBruoPtNYPQBXvvk8Rho5/GhZhB47ta/oobUANI0pt/j6h2+sYrF
+qMzxHazxtvaWNM1UQJ2HKYpUIVXC9aCjA8XTXRXvdp9MmdkfSpp4R/WIcyQ+nTw1L78h0t+VSPG5RVzz
+tssuhA1OT0d1O2GeTt091gZWMVdeUKauIlRUvVAPDkmG/mr0VJQpU3edAkdDwQ/RvXozag9lEp/um6WV
+Fj3Q73sJonW+wh3Ibo437jbedotx36nEFxP8WqgTHBe2+ONH1sV5h4Cy0aUoqGSW/c4dVpNMEaj+u2ft
+sn6z7Wkg4XaqCRb+Lm1QB7QJuCuk2PpM1MSfeDraJsTKwFHNplnxRKimiQmnpmVy3mRv15sY7exs6bwd
+Ske8R6+QPgVNxS2Vkc1cLjBpBWoXyL22kPRY5m83AghzpnHSDvka3SamF1SkvBXsB/0V0pDo/aJqlxbK
+R9GkdtqMOfI8d7jDgPH0rjyGpqy06SyiNpA4PUYacNGox7Esr86gDvP85yYtX72UVsCLih8enjFHsWvW
+a5zYNdzsrT0jr34oN64UvgQd5hlXOtKG0k8xJknoXMh5LlgpcU51tC581GgTZ8ZjdsA24v77bTB4mnon
+RFtHhR0AvlDaNTIBSPC+xHixhO2zCmUlqmf8Q+N6R0XdwFkznsejD5jyHFPo4Ho0dQrfRWqXrKmxfhaE
+AlSXee56t5y+jGoRQrWHRL9TfPDQUcEfXSUn6qGGy+TYUod94jV7LitG+SIll+Y8ZxxBK2fdokGQiAEv
+bOiSO9ShnLD2kKxIIgOd2pggb7IZxkbpKSOAukBvi/SkkqWwZ3ZUSIjVSkvk3x59dA3L1R4FjX1E3Bn+
+Ndeby3nXc6hqg6qRWw/WVAzgerP44BqzCCf9u9BWalFlubqt40LBk22UOuZXmRXqdi8xgbH/S2bFY8BQ
+lp5Snyy6tbk1sW94EVAUf59rV236VU1Fhq9p1mTRZo1xmRqg1gzpUvunEFuMzhT6eOn1iAeL7s19tZlW
+ZnyORok/eyh6FNFQ3HVQ/OjbJC/VrPiR0qPPLrIsSlskSXlgrREwiDpv3vasxHXvXhao7gwwK54FrT2f
+SF9qxYcZ2/Rto3sLtHZVAvDe91hQuvTtS53DsidEPOPvBeNsnPOKpRlOMScjQ3fK0xoQI9K2b0Qdc6ko
+cZsBzbqlGC1KlZ2LfNnDm8WIAwTKDIknX1awEZ+J/DFXIllLLAqppZLz7Rws9fwSSzGAfCWKFoKpl3rT
+rnyC0TVFqplhk79DQ1PT3BUFy6Mihwbr6/uMz9nBOnoOE7p5s2uwtXNf74CIvWH8pvsIOk9TfbhmMyAC
+b0Cw66GV34+dLhxgMGY3Wjt/2OdPNInWqySidFvvZ38qQ/r/KZjMGh7sHnuXjPg8q3ie/Vt0TZveW3YQ
+w67vFn+7WLCh2qzhzC4FxfOqpJ4CGxykfsZSFwgM/t1lhwUzyaYYMPqsWjI/cg4d5+gGxCst2mXFZKjb
+6//ZHURH0kc88GpM1/vp6UaUgCyqsFIWWP1lFpqXQi1yskNwlGiaU2KvIcrNkASMDA9loQymO/j/KVdG
+2hu4cXpfG5h5C0OHf7+GP82tpOkrkn5EmJrR15Few+cjqCjGJMTsGoDBNTjY0gH1mqeP+/qBw4G1vTe8
+d/ijjdjNr2/3a1V9FQleTl0DGKopSryF8G/yScsCNWHF6gmNwn9tkXlr0Xj9ZD39+sYJrCNpL5L4TAgq
+Y7kQHmLeq4v1TmncSU1n0aFpxOTYL0jYT2Kp7byNqTlXnhcNYaet+fz9QEBO5TNeLAextQt97q44c7i5
+Kdtjb/Vp18CY9cEWQmrkzGcFSTbvEjMwEDrQVa4eujmHlNe6AWyJN9T8LfvBABrQdzw8fkA0Br4RVI18
+DcAmosNNZyatG44Fe+g7hPo+Puweu/2E3iZvvzXK/Te2bq3YYbG/kowbCPYx5STKxkFgDqMfG3Gz6jqy
+Jlp05plIAyH5akz/sGBUxmTJsDiyADOQdCM8xJL6MUCKoY+vzepQNCw0WdbWwahqs6vnDMTAormQcH04
+y8UspP3aoeZtgFpZy7kQStFk7wKvhxGN0EjYbcvctNWssbR3M59VSuTj203e8ah+epdkymZydz1r4uu1
+dOwqAkWxzGU/47pM84jWjr2uGpl0XILnUG/tPIx4AfPgU16m9E9E1yiq5JiNsnK0yHnJcinnt/fXU/yJ
+qBRbzBln/nlo6JuYsNeV0VLaRz99n/yiNB+9Dn00OlHnrOi7R6JS1DzzKyZ8m9+1ILVNxONNIOu6Ndh2
+i5rj6jCcawjqfIE7cagqOTdOpotCLc7cbsMsZm4OsRasBK2AzuXgTVfjEdZwfHlU63spRgOPD7VUqMVZ
+eKIsCj2qs1i480drAzP7heZZKZTvDlib0Ofo+l2CrhDHY5LpVrIk7zjAymNRjabHlJT+pAI1sT8V8QT4
+djKwMIH2gP7s0qGlW+BZ5CYU9/r+VtNsNWrTAqlaO9NAE+vuE81qdNi5idGNDw7Y9m6f3T4t0dtahq+h
+jOel4OmSTWQhSMEUzjtUu3W7V4tWoRIFAQgFdRMm67aT1fU+Xu5v+c29EegwqO4f7IbBCrpyCzCoHcD1
+nujRqn3WnA+8X6FVtj2p5Fy5yyRKssYT4frSAPAXblkjOadcCFZIlstiIkp7I7SnwaoLoU7NF/F2OO/J
+u5QUhRj4ps8kSAMXmRIk1IdyACyF3VQBH4lKVtwNaxyj4bLYzg2ije/dDXFxW95UNUy9dwLlnjm11eIs
+icST5jF48BIYdbdXvzi5bRfv1R67Zbag9tOr7cFNt93K69u1L2tE0DzPIxSASMDhKxMqLnFh/ZrJ5DDP
+2ynlMM+7MYmUYsYzKL4mVejHAguGHfjU0UAY8O7buJjRQhIAXEIHfJt96mq2imUYtzMrzIl/KocJewrn
+FV6Q8ciizCHmZQAeddVUlDoG45QrxtkQ/Pe9A26oJdjkSvzIwfY0UhNRWUQwOxNici15yugFYAIxpqbN
+ixLQVu249kjrake5RwZRETuofdHuJm+i73i6R99CBbK9VofvCcHlpIbZnTus7fYSrWWvSVBAkjhg7a1i
+MgeMsFHcMXxMqmnDdQn+2dJuu+DSMsXahhfu+NYEuIG/XUbfakHr2sA7B1bE8uo6gEDEN+oiz9KyprCi
+CPBlNplQCKmK5YKryphlEtTjysKiOCopuXqiEQjUFnjblJpvYFAVbxnjjZUkyUuzLdWl26KKuc/RnYrB
+4+J7kV7xHuV2J3zW6d7z3O8SJ0D7AH2BW48NxB8q+AJmAe+tpyXPclRZ4INs9zbMM7zR/vErPs7KmyX/
+HZb8Z8FTf8Vxnv/4JQdgN4v9uRf7MT7m2LXmef47rLRb2zHjDpAuV6y6yEaCnYmxVWJNIfJ1ofqMG/1r
+ZiJG3CzzNZb5BO1u7DJrM5yeNuKxtjWDva/u960lzmDvqz2073n4We17Vj8yw51Uq+NqNmJeyBh7dd/Q
+Qgyl8iHNYWAztun79V+lfG/fqatAojfu/YZdaUJGKsCwUQSBF7ZoSJQIhnXDhPQVRoyjeIAYJV4oF66C
+9BkGVKYC3pjAxqoC40VDckrCxGbFhFQTANBC8R320BVAb2wdf09ScBaL2Nimi9WO05bADVsO1R3zUkBF
+74ahv4CUe9m/ygzzcSVKNtTthxRsRmdd0W8wFhdUTbudrvcizpNWxdsh6VxnM76Emi7ch51mnY0WpG3t
+r20vQpg0BGPDcdqd+QVfKjvvoFOovbjWtUuV1i61YB3qBezc+/oA+zG4O9Q0G026CqMRVN4zheV3sNrc
+BATUfLxFEfe0IdLhdR8PtMvJGxMd8i07y4r0cYOrFPADP/qPPpuqaX26HYIuJwgQvKcVpTmoOf+HWPrv
+ALVXgK5TU3so+4vifWYHwS/9HhvefREDCiYYaLf9713yK/2tdh/lZxgkJy5ytLEqmfsovGZ6mEYeokhX
+fkt7Xw8/a2xc0qzgDUFoo2bPXJteInCkP/MzkfeDUdcVzu03aNd1Xc03E7bvyGsIStZ3ftm6t4zkoVx8
+CcoDPvRHPGTdbBwcBoxPSiFULyA4vf88itNfaqo/7VNsGVy4CvPSrE6iOaleYFyweGU1LGzkh61jP1DR
+AHUdh6YrSDnfI4cDNvDMhOBzqLxBBNyY6zjESxgQCfy/Th0xVbbqJcpMKFZJc7Y4Z04myWSmQN6Sjd43
+LcGhWhaj+jrg5ysuRoNm6x2GjT/NRu9XaIRmItG9hkqgYPi9VsKEvFlKZytCKGwmRlNeZGqmr1lwwImy
+5Lnvhbop3wfAtfZ901D/o4u7mRfzBlsKJXOMORlIvN7Rbre8qqyUZGfX5fwylt2YUz2TCxW1NwMHCYoO
+9WCpEf1fsmrqrbP9Zpl8vNgyT081XL3FdDfhqo+qD40MkgYfOzLStxoJuL40CYyqD82KwMuI01YWxXYC
+i5g/wn5jcLHb+s1b/WZJukJH5Ox+r9fAIrfcVSO6XDz6nT1JFPlEXOWacHU/kq+ucUOqAXRrgjj3KXV5
+EBrSWrOh0VZWNFQxbFY7b01E5cWlf6IjZUq0qNFVEIaIMxj71D2vNz9gazroOtT6TFuS1cSUW3XIlBDD
+y9fdWgUekm6bSbuNvriuzzggP2LQJA6BPJkV9RfA+BW9FG8K7ZPgeqFPCUhItGg+6Tdp2/3ZbIDUMEXR
+LPxxozYYtIy2wbQc6+z7nkJf/w6eQnSRf6bZQVAvKDL1rVaosUlcalr9JMQ8rAlfbB5dDpGNyaPCXXx3
++8GlbZd5BvbouETyob5m4cRY7YdJxTODCxHLCjqdzeRhwEB70A1jpIfQLCsS78qMmg9f0pxxzLIbXHyN
+3bAjKC+0fZMDi1fRt7rAUq/QurD4qhef4/nfuyFPM4ey/hRsGqB+48FirRYJNx9K9FjpIP2mMQb73oFf
+dFkPB4WrzLIipLeY697ShPCGvwUOGy/MG/62Lt2GYWhvPyZTRb0qgh2+PNaHHbvN7jFOlpUFeyXG+eJD
+EqCTwKM34wUpezxzbCZLBzGrmLTtYxRtwqXbbbvbTke6+gSyc5E2z0X6yXORmrngHhkBG/wS0+BxXc8N
+qemrZ2CBb8WuCoebwyv0N2km0jWgrTh22zj9p7f77PYYzSluvw3DXbc5Czon1cAbjAyDmjptsROyckkj
+qoHdkN+vPWACJuDZnnvouxCt7yB3jyjSbhR0Ai+EA3abKOK2G6q+QA6QvN4lYZhUV+1dpgiDASoM9D2q
+Rrz9kA0Estq+w9fwdN9Pjz7VrotaNj+l5Ku6VgL0wX5gt3XpbTZgt/1b5+1mVYgs33jw3mpZXhfV7wqX
+GmU7qbamv1AuhpCuDidgzUcYDAR1c1MvxIz8zGrSr7/65qoQnt+DvUe7fXPwDvYe7fWbzuvBHuXkiK4Z
+3/wOkkebTEClcHJHHsz4yZSDGNUogngFn0W+wH1hnyPYE15xMhi/koxhdGNWxECJokXyUJ7oscXuNkof
+n1f0QAMcHYi8QfwInDzQQOvQb2Jcyptfg9zFrEE0gdVBkI1r6Zfc9tjM9YRQ+BetQ9gwKrxtduAa+alB
+yvERXyPkdBukHIAcIQOyz+cWfnwsP8ehv2NK4ln+vYSiboNU1DR36dveZxOWiOQ/p6x0/WkLCBPZY7d+
+ktr9h8vd3cBflpdlpPqK3SLevI0qaPmAHTQKCHFlK2qwA3YbFKgT4R3FHgc3ZnT+oGracCiqWQ/akmaF
+OJR0e21uVJ6jYKOjIy/LujdvswdlJBDyslxlKM5iL0V07eRlGQa4arQjjIQQOqNsfJ1+vCmapDKfIbQJ
+ZUT/tM6aovZr8owJpYL+DlrX0arbiIWSmA0P9h49WCmsBMfEYO/Ro34kBwz2Hn3VtxLEYO+r3QYZ59tr
+yDiNOkATKabPbr97Z9Jg3e7bGBL5QmjxGJ4aYE5p/1vvE8xrBzK0I47bJVbZHslS3B6w2/eTB8n92y6Y
+zFbTfXalOse1ufKZ2MbI1pzCruWmJ75rEak+vBZ+Sb3FiabWWgMssALiing5quJVNgqj5egufBNPTI6K
+X3meswNjK0QGn/sUds4Wc4z1BWwB3goueJli2DleZWcY2yHoQVsUOpjapDDEg2yUXCVjpBTWIoM1dhBY
+rHmyuq6KO6PN/EjX8Tk83ijDaFeWSEo5yxQ5hOu/PTHb8813VzypNV3k+KoEBZOwUrUnzjoOpSLx10Wz
+0w1NbhXiT4FMy12OHXzD0w3kmI1kWQo1hxRTxUQXkH8+iroNtAmz1vD+SJ4l6WIUP1LhJ+/QhldcAuQf
+DZrNvBdL5dftJWNZHvHR1OvRqeSaniLOeR6IsOqNqx85JvFRrYIjkEDZcM7zpufThutrdAuoByqo6VLq
+Wh9lrxtyTLG/44F6dRsmCLBtOHWNY4TWf0Kt9rgVtGrnPHer1eA/0OYv4M/uOc83m9ZmW5PLdS8mKyls
+v91RS1exS3nZ6/rpF0Wl3OO/5gH4lEgDYQsVbhElqohfuAUZVR/MTG/CWtgBG1UfgnA7Fh0tf6MZHOx/
+ekx3e5dcyehN00fPWA4EeJmPBrl3fjXz575/+C5UEEO6O88Xk6x4fGYgmN9dqt8LBqHzStNANNPT3KoB
+exxeVlSlVHMxsq8EO3eFyrOi2k4zBZIoK+Q2ZhZVIDhspxycQ92437173/owY0GJYhUkgz5k02PZmBkB
+3IvsCJIYxVEwCWYQV7zPtVQ3M2Yy6gm6o20EPMH72u2jk0dMTbOZGVuqv0+raq4GOzuTrJouzpKRnO2o
+uczeix0SsX5VfxLq0W1KiqsluTe3Uxvew2zW/bqmo177Cqq4zyP1BtLQYO/R1+4jSTyDvUeYL3eVyfJX
+969gVXBs4lJRBZtBlTplJD4h5Rq+jTbVxgKhNS3sZvIbXVxbTaUBkMqzEYDAs8KjHPze106jJa/EZEmz
+CWedssK40sbft52UpfXiKIfpAi2k6ZKc2wIjH+oSnue6gESx2z4LsC6X6LSb/KqG3kRWUzuZ0fzVIvOa
+4bBfptlo6n5WElZHJ80DG34XsAG3uDFwsQrurRZD0EODieV1F9iTqWoUgyRPEb7UjzEUi8N6KG3h58oM
+1kggJqRmTRiPDD+oSZQy2xfHdu76nSZJwu7utAXoU+yAqQYrnboBpUeS3ciatAgMjknt0HLpf9NAhvbb
+27eBqZ8/CK2ECF82eu3qAiegNdFexIcaqLBtH/8BdFg4ZAgPTYjKxpT5fLTXEDj1k6nPxTvxydB5fscJ
+vdh3bK/PjK5Sr4keJG62XCg06irYnt9V4PK9IZX3Gw3bwjByFNQ2rEkp81+Mw5Bj9Yh1YSscQhx+owHU
+oOljM6o6dFhzNJTGiICDBuNCs6wD5qii2Xw7i9EoRiInbWktmICN68Eackw1jLBRW3iF0DMYIb9R4L9u
+53p4fiB+05EJRlOIC2sTnvVxiXs6DE3L3UOJqovVgsApOjJLS7QVFYVZkWe/YjwFreWAoBJzUXappG/w
+roXDadRzrwj6qLkugW0M4uJit5j45JDUedtF8oSRugdDLNza8mIYr0B+FDpIrDTkRy1/YzgLDSUcYETU
+XuAf9kMt/g+MnQ3Y9m5TQKQ1cX9w+FeO+hPTq6HDFVp1XeVN9rZZ5b8iiBAlrPatig2F6j7gb1/1f6Q9
+pvzcZFinYV/pPrC4ltBsRSMfo6Y9tilRADPU/a6Rc+Cu1jJSYCzRfJuUddgiPhbDhVGCGVXloFbc+E6n
+FZ20dyjwiYk+53LzWC9pdI5NmsLXUN+on23u2S4LZUc1k7Uf5awL4YFCdT04YpoGYruk1qTRaV+GRi+d
+aBTBkuDWRJTZD+yNQectG7jBNuwWeOo7Hh8G0TM1Ej7vjnbO2laxjba3xdu2QmP+mlub02nsy+WHQ0co
+kTW/B1mUfTe1esTB8XWpb/5Nd+/otr37uR2E200wZtkHUAX6aml4eJCKjGO2wmuLL7dYsxn/o28/8+Zt
+oPYF0It5yokXdn0MnohxF+1cYn4000k5YuUvVk7wv7WnWL+sQdurFmfP4q7cQaWfSrHfvkHNNon3ZePZ
+0QAkEF981b2Z6ia9PZU1BfxyMxCZp3tNmo3Uqbc3rp5mPAjN/7z2ruh2Cy2S3uI9JCa94oCn6RPf182d
+5Xu26+OnqQKrrI07GLuBhYiG3dUnFT43KvuL1d57JNzHEuKlnxQkGK1xCFw9YF1r5ZiNQiGGTHr8rjc2
+QLlvtmXTIGH6CnHxN/T2OGAbOC1GSLjGzR6Mb0yFt/FGIScHnL6VrlUNU+m5Nq6eTVfxE4nIJGpvQgEC
+APjcxJa0uwazW6u8M69EY5/GO/xpDXiAn30sHoeeXb8+3hPqYN7crycSiaw8NLD9Nu+1r/b+GJMLm6DH
+ZoBA+c788Cu4COZaBDQ/XSXzZsgOmPnTFdLhgG8D8IffyqixsJ354SpIA1RGID23YWx66MjaVNEXMHSW
+xL/2PUnMDRRUVlkxie4L9DEZTXl5WHXv95JKvoZLKKRi6fbYPVMBrwzd3eg+4s+Rht/XWbZMP/TL5Uv7
++JHdlsXtfR8Jl3mttlYGa+x3B5WXZt77epr73oz2/Skiz1f+3gRHBK6GD1o7uUy5mrIMzV1lmYrSu6mS
+bcFcFKkoRqQVdCM2nXfxLuwEq4ps0bUHmlUG6AFSac0bzX2mZb+NeRVvYetAnCahw+sSZd8Al4aQFk4l
+YdFUclGORB8d1PbrIviul0sy1kauzCVJcBuTuNtOoE+WFbpuk+Dzufww2af4YvpT1HBcNnMk1NdAi35D
+p5uGFpRnv76B1sCECQv6uc4UMF7yy4BerZEkMsZo+2t6PbcnvyNQ/0k0fpO3FxE0CdDmoA/wOmJaNL6y
+xy7hSlSn2UzIRWWLwCuaNGi2GW0OSCqh+oBqkKFJRsm76CYZu+ZA2zalkYRsEECvMO8A3pJuOLXxxLp4
+DpvNrLfHb8NohUATY6px507Qwj+2b9Nl+HbYv1G7oV3KTE18zhBZvWBVrVGZqQlwHmducqkvr+FKDvYe
+3cdDe6N8dfH9tOPZL+7k2RlJAh39dNBugVhrF1XtNAXEmgkX8sq6qXPFON3O2NmScfZKYKI7EGgKUVQJ
+O6FMGr4lidIhZe82OHAQKAyywN8LxUa8pATngpepvCjg70CViU70z2Wlg7RlY7aUi04pvNen4UgWhRhV
+BvpSLlgqi06ls0xPyd0kK/owHF0ZI0/hKTiD2WfiXJTLyiWVJlCg6uVZoW41O2l4DmG1jCS54AW9pnkp
+XW18iHzpBf4JY1jZyf0ly/PXxUwuimoQL3ZSD8oMiTLjarDOQJRr6EGbTTRSm5M7H25Kwp9CmBFp4yp4
+7ZOdoKjTYMrQtmlWqXj8sMOQcyfgQI4BREF53RN1t1c/hG9dNyCw3/Vl45EH4CGpT3DFXAFm87jCrWeq
+hvROS9hdyvzzZhXkFQoSzxvUUPuTLH1GpN42qXa34c2wTumx1OICQ7HRGTtomDL2g37AEhXizLzOz3vN
+5o4mha1IMoUIi5ROFwsVRVK/vNvDGnCTarOOnAmLRDee4/O3vSuZNLJa0iaPukdnvf2V69DMdVCT1lTH
+f6qj22rQBrhHA2e5Fv959D+D/4CM1WfjLK9ECezD6vjtFzx5PN4CHMum+XLV9q/MxNgX52K1cawA40bm
+BwtfxYE2ZW47O+wpAreh8ed8mUuuAzuhWVbSeDPSeRsPPh25kL90S+2gfytkLWuyAkasA4MoLfLq7VUN
+rVsWjq1KMbghT2ermDq7AlevFyGXHzde0+JZ3ojuYsYcr/FM9OmW0bvq/K4kHQd2/6pnhVvw9SdGFPen
+9cRgv+eR8XufGV9temZYZ7ImNt7xPaT0/SE4FPTHhnqarzTVpqJOk0/Z2mPHtTFLFlY3X5tqgndkc20o
+aT6ojPX2ivWy44LfD/u3o3HCVzS2Np3Bh6/7t8Pe4eM3/dBvb+/Rt7iWX3+J8z+4GOMyjPmokuWSotPW
+L8DP8NpM92T0cTbeWRW7oCDZ3k01mLdhH7oBA0S5qNiUYyDCSrIZLxY8z5dMicreHG1+1WGNu3rZYYKo
+BZ+Sxq+Wwu8zp+/7vVL3NUY+t55qpNxYqECx0Y+sX6upZxNj/OFi69dkddSGQOI2CjktW7RdLq3ygJkE
+jwYbrX8xseqFlVJK0K3DrDE5Gi1KlbBj0mb4ipi6FsbvRse7JMM5G9iYVdJLYeTyXzSEvtQZJ+0u0TPg
+9bFzLcFgLEtUp89YVtTEgparGRiuzt6izBS1eDN722s+ir2GLTWc6g9MiDsVBdyn2AVGSc06t+/N7t3u
+kHk27fiI7d663XycX7ZYcNFYmkayuVC2+ioYE2bjKR+R5TX0Wm1EcFX9VnTT3EQ+wHPjm//+50b+u54b
+FKRU+8+zTCGHhD4qyfiikjNeZSMLGZaeDf1De4g8oy1oziZJvj8741atnFttzrrVDav+n8mqgaj/I9h1
+LUf9/6UseqMoIjs7DP69tv60ya+K7SbfJA9MEXipDnZ2nMftryqR5cQUd0c9tnf//rfbe/d3H7H/EqWY
+Ldmhmr4XBVd99kSOcMc8zuWCcqweF+dCVdmEV9m5YK8EYCJKxe6wozSrZKnqOJkoY+NSiHzJ0kxVZXa2
+qESKamfiSc+OT1mejUShRLK11Q021haqo37kSuRZIZgS1WJO37aDf7rikaq4CytfSmlye/fZ8CIrUnkx
+NCfAWSkvlCgxnfxQT+vQuHcpUZ6LMtnSugmAY9Qs1NEJ1yYdho70g6uknPvDd0NomWnhWJsNUE1vdg4Q
+dPIugHq2rIQ9p2ZZkY0zkbLu2aJihazY5N/ZfC7Sno3wosGjxf/LUlay7qfaB/sCU6ZNDbxCuHSY0rp7
+tEGO4tmxfy3g+b0UY1GKYiTsMHV6mrlAX/MR+pBJhsO0oJSeiy3GGAMz0oAPHHhjQCNT7VgLtNFaz/PA
+rSRdmryKZtiJKaOaoRFsWDMsM4M/zHN29+7R42eHJ/gYyx7dvcsK2gnu8T5ISKMDo18INpVzoR0WCRov
+BUvFKOelSNlUlMKfF4J6rHCQwYjRbKvky75X7yexVOGAjbmfX+vHrEi9WnbB0cjSr6gXOQJHBuhmLp7z
+9yL1A5AYUkACWJSlnPBKbNtV31YXfD4Hcy8aJXscuj12e79dRkTGmeJj4YHWh7W3d2hfY58LJdiZyOWF
+6eCdDz2ybwJHMC/6x7teza4JFf14tIYVe55RK3vXdS5beEi9uyghZVpK1nZQYAd1hNxlxQDu3n0uU2Dg
+d+/2TQadnR0b2EdtB5F9rDAn85QNjVDeG0K4OUxEdSE6GFGRoATMjqcpMieUGye5POO5i/Lov+gZURBE
+ko7VuHf8mdQ16WSKK4LpSShVmqaMOTGzbkPgm8joz8k7UxBokYl12iJDQIuyFEVluCOM6l3yt6NXJ8cv
+nrMD1sETsmNWxgYe8AxgeMW8qD5iPM5GeA/pwrSPNHhRTLJCKMuFCZzm/pS+azsr/MT5KLOXYo7ROPIl
+pbQif2mSyBxpEDCDkmGaTM6rbJb9WzwOFPvwh/XlQwvhxyCg+AulC/HZ9VxmKbvf8x279rd8hysDgB0c
+sGKR5+wH9oANanC1s9LuIHYRq780eBVIpW+RDfX6l/s+5L0WyDrF+xU6MC2a+3nQ2o924xvJPKfwKFfq
+s966uf+H9f75aLSYLXKO8X8/DZnNQEWYXTa5/nn3HL87naPEI784rrHHCMG6SlX5cjur7zvJJiAM88rp
+N/UJOuIFOxNmwxCkSlLyUUEHLssKxr0R9TWORmWQCpXBWatfmf5//5//LxMZEAVBy1JRVFm17FM6x7Os
+Knm59HYvd/elGa9GUy03ep9J5JFWZmx4fVuzS41NH+66nnvDNMjtbzU+1+knN1PfMYn2TkNILlqVD+dd
+ogfa9TepLTTj9kpxqd8lWYWLKNqHH5k0juqYHhcYaWrZC6mnYHW6CSO9KZVNUFEd804d08aUe7iBqPQU
+eag9vF4UeVsEBl+aIMjtls1b7p7uIi+ANQz6okfr7DnzO9tp7Te9u6//9Ayl4Xc9+HuTwTTUjIIHwJjZ
+ATND18bJUQyGXNeIhtPsXpk3OE1DFTQyYtoSdn8rsqYIZlzPDD7dBkeV+2jsl+FX3ZHuMmRPvuv81agI
+BTw/BWpWTEWZVVotpSP1GtI648qIzR5ZWek3sK339pur0IsNDKCqL47bCv5HDwC1Aqna3dooXYy5wdVe
+2WGEjzEQZWNTIM1gx1NDN5FRFp1xYHyzwc5xa+TJGbTgbGBXvGn9/iryuX6Kcvze5aLy85JeTEWFyUu9
+mgTEvu0xza5Ik2pz+Zb6JxEBtXklcqg4MHqVuZDzXCQz+e8szzloVnb+z68SNJuyHI93hPpqOy35uEqm
+1Sz/kxKj7UrSVtKECMNVjIMIuWQFV9WSZS9O2Dfsv45P2dliwmTBDl892/7qYcL+tHf/24d63p8d/v3d
+4atXh/94d/z8ydHf2QF7xqtpMpcX3b0+e/Sgx7bZ7r6uPBHVz4ZDWabdITw6PVNLXyx/zt4HRFyXNgKO
+Z2F3a7JEaK5uWhywDlmd4/XA+OYdsPvez+8O4gEGy//Y9mRVFapJIRSohShraQma8EoWAo/5IcgPw+jO
+3mf8PWdD7R43TKg55Z1UrOSGLaB+hqdpZmQXpJvtPHsvIKctbFDFeJ5rapvzUgm/DuNKZ81dsguB2gDU
+fqEFEMg1YNOmsYjusn1DsvUD1Tt6PTGgXt3xg8x45jjG45FC5AHkomZ4/jwQKWUDTx6DBIB8k73tQ8/u
+Am2dTkIrIX2AkG05/NH1mzSiE3pkbIiPcdTom4OqEbet+tliqfIVfdZZFBd5pZgco8S6NCKo6TEWXWnR
+Z3yOo9Qb6VprPlq91nomb8ULTGHP7fQ6IcBOKspI+nju1SIvmQFrJZWWdXqxH5iWZe5vIMucc3vR/snK
+D2TSaeQZNqBm+1sBEqb0IFxgB+xt34PsL/Nl/bRTIeMxmil0ow6yZFJf8CkX44rJkpXZZIorG+XXekUu
+2Glm7687O+yF3qupBiSd/Mq4CSwWS5emsdETcy8MEOURT4VhAlCjz5QQ7E+73367m4Qh/U2nMaHNxEzS
+jrBXRr20IUcwC2r4eOPysnsHzBv1NVeZIVL+6hKSG65xTUSE1vsNafPa9h71Fu3Ajflunz20iFxrQ268
+JV3IpTQr2ffsPvsB5SrdXIsHmoKeWHnJf6Gl22g2ZoUsBMvcu2niXWsaQss98JdYr5bhsc2L60nyPqm0
+LNnVqdUXIvVevnuXNuLdu+xskeUpPQ8y2Gi54eBa1scXQ2Yc6JQWELICpIBhn8DBC9JY5mk+JGaOHELo
+MzxPc/yLmtg0S5oT7Pb2PRkF2cY2V0qOMnpbMGYIckx8B2SXXEn2vgD3MK6o4zLo+BVAsb2X/3/2/nW7
+jRtbFIV/S08Bc+0OWVGJktwr6W4qiodjJ91enThZtrN77U/RJxVZIAm7WMUUirq0zDHOO5wXOM9yHuU8
+yRnzgltdSMp2svY+e/UYHYsoYAKYACYm5rXe5eFJ1HJtUUpgWnUyJEApphaJqMpVNReV1NVQPM1UwnYH
+V8BoTyrueqrQRf1ySKWNC2xZylRNkqp5g/FR2IUDodckdfYCFrkT9mkbP+E3/7u829bYDgjaPbIPUjie
+puTwxH/A2zdLnS9Aw4S5NFc+y7UAwSF6ja7J4VjLzMdxZqJZU/kDsexuapN7xdYn9qETF8SVUh8dYlI4
+Jx5JnAoHra1mZEZD4WR8IdM6Ot12GXfhFS4k2r0+XjHGlzZH5O3DEGdFXoR9qg5xvEHJNfCRFkUh2uxo
+nzdfpFlmlAV27Chv27YfkswQGXTRxL0AwHafz65L/ulZxt+XI+TkxmYLbmURnEokybRsYQpNXLSWZVVT
+kVQik4muRJFLXyqNejoSJeECy61HHkzIaIkhcgytcH73XyvcusIfssBmIeuKDrPu7QtMVmQsHeIVNQ7R
+ImHDOlXJhRgQx351dnZ2FbUsMKdHNwn8+CevuQVJPAPV9H+0PQ0XMXIrL4i+zlZJmdZCetRvVBS8iUvK
+4+2/qz3FrgUpHnmCm/fvTQfuu42N4JQWHN6zbYSR+JobrJ0e9rp4J0XCcjwxoKjfVpkkipx80hFWXdtD
+mFMEo44eguhfgxvCVcbisS8d0W3+hQywNuFFsmy7HsMNPiVwDPeJme2I+K5z+nlx2lSzeUJS/DnylW98
+u3qxodZRTWbmUuR4DCWgcLEocrHSknSRxVRcLZLl1UhMZTWZkzDcZh1HLC+zVRB9gybdFPt6GPG0RVDv
+I8ZGV/DVSBD7A+MrQHPAQjmCyCcIPuqlnKipmoird/JuhHi6EstElcwM3Mxl2dwxSVWVesv1b5RjVPej
+ppSnVyMxk5Qa1LHivuj5AVMCeP940LTydJdJeU+FRXKrFquFveUGRWn+PhwjlQNzlRVJVCMj37rdXaYV
+aCoOjTIwxpQIzxC0TIMvnkyBFYlUyXtT2Cc6H6TPPgMMi0f00x1TIoyN6/AJfhi1Ucy6QuzhwlHW/AqS
+jYZxK+nb16JspKgWDkdYqUshVnsE7SYw/ACWX1i0+wKalhanjfBy2OjrcH0h0LqFd+atNiydmblfvhtu
+RH0XhXslQF+3aLDzaKj84UdD5R94NDpPxv8+B+Or/w0OxlebD0bHufhPPxav56vpNJM1uxznxrMoUlnm
+/g1Zzdn07fw7zFZ0+D+SSmqhCdDFgBWvMh/eqHdqKVNFalf4dURN/p//4//ERpfciM8Y/+owC0XLCVk9
+cHsHqlAtq+ANhCCp03RXzUgsyiRPd3grQTXkSuGPYjE45m0U+S8jrAQyKvpkR+NUJLYEqtrTFZbS1M59
+QW2w7qZ2uPIJKFXF55/nn38uaIwsPjVC1ZB3hwfAlKsrLfKiMmyOTGM/IRDLZwmipz9j8am167jCF8qV
+fUBQ8nYtVCWqQtwU5TvyDEJml3cIjblOiPOW51RuaeX79+HXhz+3fI3iuV1QRx5BVh+1Id7uaQTGgTSP
+YzIFWCS38Hde4+FeGytk4i372qwKBjOblECb4CguywIkxSmW55ZkGaFEWX1z96kVlHZW+L4YdL6nOkhs
+wyeNw7VSm1C/wFIEV8oTT0YbiXPNRDJCRDhnFdD8xSTAD998iThDteDQdOOrgMbijNo0vqJ6Bc/vuB6Q
+NxFfizHsvaTNovfktFb5K6o8bqvscm7U1Cw4Ypy+OOQRerKXdRSLPuKov4OJnk2lmMxmJYpNRW9WFqul
+GN/1BLwMk8BWj755G2wswa2zKDdYFm3YgDWmyUVx+WQXfl2rSXqGjs3kn30hzNQ4wo210YWH8mntwm25
+bOvGUX8F1OkdzvhQ/JRozUawIuF4vPhGRP8sa2VrFortXdvt5KE/BxrJBLZDOoF/DTyHtsZEPdp6Cf43
+tg58NIoCVK4EygJi37yv4kycX9tg4uvISZdSeSt3QUsstFqoLCnRj5TncBWL8QodNQgcekreFStUwhEi
+7oqVucgZLqrgx1KscvXrShoJVSpvH4aTcHaWaXNzQ4te56hCdid5YL1olhAmKksQJNT3AYHq3AzkyLXK
+qx32AYGqbQZs/Mk3w8FB6xY4CRH0OpnK7M7lOStlkgFJvzbiXLbp5HCXeGrZa+9yWBV4k29wJ3qEv/lg
+GrWaMa3GxoNA+OxJHUOha5NpaJOkGWPw+rVZYyza3qYkwBXF1KmcVO72Ct/uFMh8k/NU3XI5FP22c9CG
+mxn5SgWPYXYsyjJTVcAgCpVXhahu2MZOj0SBirRCe7qzJMuETiqlp3fOzZ0geuqPJE+7GqcFsZ0eDNuQ
+BZ9JWSmOtf5baGJQG4x53lBd6SlpN90/74x2g3sULWpXV+cJ9TLCHqKtmtdzqE3DCdXadCg221+aG0lW
+nlCTcc5ECheUKBAcPXoAIOEs64YJLwkaE1fWq3F7X7cyl0nKehUIqXs1xIfB558jm/7552Iyl5N3BKvr
+TXA5dK8C6hw4dwCMfwBYfwvgINofCgnRjtp5IRbsdONzgqsiAJskwVOuoI2O6Rr/ce+FvI0CaD+kL9xm
+gD948guZV+WdUUYTKPEtPr8wxsVKy+kqE4XnTGi1JYZybFhBo6G3C+eDwdHLW1BrGaEADumluS5xmjsg
+u0lbuWLtQVTDVAvqxRNxIkbwcgqxaLZxlnzYLqZ5dW9inDBW+l23Vg0hJ/WdVkpdmWFsQGQe7bznmA60
+bDr/GFeJyvgYp2WxNIbYbftyy5ZM8uaKuD0I8xMvu1cEv9OpV2TGBcP5mB25YcsFKHxTqoUoVii6QNX0
+XShCyf1honvwpLl3upRYPJYaO7FueMWGxvG430UpIWALsE9X0yypKplf2V7NC44/+ONR+XJVxULPkezG
+nIwF/k3K6oX/igIAxapariq+DlV6W4u+b0TKrjFg8zhuc03AjqMNYmYAZgTNWNkTNdcZM7osQdLq8Xdc
++P69uBw24/cHsTSPjgxqjCNxJq8l2gI5z5PaXg4z0zECIztkBmgu+xqCayl731o5vHlNNLy7CPVcLA7O
+oLL7ejNXmRSDt4RMf2qm5blKbw8O7Gvl/O3BQZuvlgt6+4hH6sFqhbTJHB/rB3v4O8YzHqHcXjb0xLF7
+OLsTg/GdCZwX4ePm7YqteHBpmA9obmiGaNcjFE3wooSVYjzIspVDT2qCcBoxPa/SQpJUlPXA+N1KSAk7
+A82ibhMJaystSNWUoyvYYbZaRJzUiPtPJB0UiUhXkO8UYj1MSylbJ4DxCOwvMU+0ydZKwMZS5gIkaSDp
+hTf13ARWKZaG5rABvJgmFOgwmxWlquaLFtMaem0z8wY/xBn/sWpj2pR+zV13iy+N2943RZHJJB+YNt65
+5iaezKdFxGTa2U/8W5zRrqgbndqmVhO3u6uHFXadX7hCLWXuFXXq5hzppH2zI+kkhkIFnqYtei/xpCkY
+Y34iMoYwIfVldNdTdCoguDgnEJKanoyAoPm2gf8xCuq6LY8YmbE1unOWWQOA4lSpAX2nLqhzW+G0oX9r
+H10bbfS6DWQltcRMXUDXO2nq7InOfapjOsbTuMoh1BA5LqVKVyqfOD6YOBI0aXWslQuCgTC1PZPhC7pB
+luCwDhz9tGSoKleS/ttFj9pH75mN6TkG3BnL6kZK+zYhKO3DRWG2lpP6uz+gqJ0nDiyyvt/sHv6pTiHO
+zx1C/wS1bCGoHUWII5W782YH85aczuGWd3M4FW9bMuV60J3P+dsL20eQ6XjtDest6iMc+PDkYuvd9Mxv
+TPw/d6HZJS5yc/MkeSoSTwZGMVfcWoMfVp7dhSbZy1JqtuNFrsA9YAgovynA+Yr2izeELbul8li31l3u
+8od3cO81Q8OaKqxGOWrBVmoGgv8/tRRVMSPb9MUqq9Qyo+iRmsRvVvdK8z48rLky4MkiWEnOgvBZYWES
+cv6plltO/j/VspY63DOqM28RWD0ENhQ/QwsMvbGsfPdt5qY1rjqKnI2IG+gXfutrN4UiN6RB5amaSM2k
+qjbgxjJ6oR+gWzSZhgcyL5A9wkbSiY+Ullu6Zh3w4UbULAi3Gn6jTjXsjmcksO1UoRFjWWl/F7CZZUN3
+5G8NsGk8R4kjaWKuDHlVpUb2GiS5EBM1y2Rmlok5Rp0spPVRO8TDW0zZp8vIbv0rZlKUpdTLIkfxEfbH
+dNsmvgzimPKYdCvhNnrBHSgyGet0E2RrnqSbtzTmCHJZ4VbSD4zRyJIQtjk/xmbm18nFw675v8q87l5a
+FUYxQqSN3aoQ2/Dr+0Tz+3rqCXtrTq0/GYEz1vwOdlnpu7g2whvRbuwWm+8uOO8MSMCXpldpV4/Ij/Jp
+DV0S+DamACxG/0sjs3K4wKOhpoQ32vnAd/GVp3Olu4gGU+SWAGKMAedjJjVacRuMVlJX1lTY2O9vWEm6
+hi6H4X7Y2MRzMfxZkwXYYpk0995UzValxDc6nv8FUAU7o6QiXy57r/Cp9oJ35FriG0oXItEU9VnRExnT
+rw6hey3GKk/KO6FlUk7mzopF2tnXt+ZHWbS4izuQLHn+6N7nrLghMjNXs+4dzFIXqPwV1gyZv4VKTRCQ
+aVYU5QBrHnDNI+vTED4seXsuVHoRia+YPeABAcQDYAGRHvHYFiptuTuy4uZhVIZdQ5DGZGY//TjdRGFq
+hMWjHVAYC281txAdckVR6W2Nfd7GfXv4Y+8YlEo6v5g6FWAy00giJc6wJRKWJ/jnyEnW4ecBjyIWyn8b
+tuXPscP1IBIslTOsE+e0PxI+eFzeTU9P/4QABUxvXZCWYLKAB38FfFQ3U/8zTUxvKcYTVLJ4aFoj8X5d
+kCEjAGz0HGyFQVPgbkOcRCjw1i+Tl81R1ZbkQKjW4VDIkTS8RlR4jThYjDXv1ki77owWrETe2B5+P9AT
+vNCq8iRy7FgyIdnzRLICy7pUGTGp9Wo/PDGeb1SHpJDsiJY29CVkx2mLoH6WlDNJjy8S+1Gq5rIiAh3j
+BSWu4MHDnCLFQzVin88/58C5bUTcUJKzFjJxEvs3XBySfL7RfOrTBuPwJK7fe1GNzEl671RyJjkEs++s
+gxgFUaWs1EQsy2JWSo1hScVTgTFhfXaWwwn/dFfNi1xclUk+w7CuVrsiXktjIF3NbcWUA3WjjsYaSafF
+RA+XWANtpDM1hpiGRy4yHoalwk5Ycox/+7chqldioatiCf+VS18oCqVOAUnlQlApKWa8547gEqvGoZ0M
+MLG2XELlE377BDydJY74x0SqbEB9HxLMSBzR2CjFs2lvJrP5bZWal1X9nB4csGoKDqw/dUGQ+axSnbYQ
+Ilgr1EjYfNXJHI731vBVdS9Ubd3Hq0LIWzlZVdI3xUo0clq5rsrVpDLWakUpEpEX5cI3ybR5EWw2BPvs
+ZhUew/+mWKGtudsTGIGPAiaO4eN3YfxZIL0qnz3zgk7qQJY+CGv4IZYtPM9cynbXEmlUR750O4ND7ILx
+eSMd1kPlBQ+/RhcAKoBfi5RJLd0YfXNMM2yZTdujB9klwMmKqrC+w8zdDiiIJXoQV3Olr+jt62REvKyo
+HgFVeDQUz2WGdrXIAtfDlPe1oSxXNrg7hP2+EoqJT3KdqMwZvY1VuORBhGF/Lb3Y4p99hvW5LZAF+60W
+vRCKGNEEeKPWqS3t5pR2CGW2yOWNeHO3lJSHvI+DWax0hXm74TmR4svIzqYfnT7cEXhcPwUNy3P/uPC8
+sJF3MiilI/QJkrlJUg3aOo4iP2CNhY/AQrF3UlZkCoHIDLbWnR/M0qjl8D04T7SYJ/BgWuD1r4zXrJ8g
+RR5OFWAutml1JvMkn5F5pBbpXZ4swAMVt6eZ4FBcisQ64SItWmbJRM6LDC961IDSpQh35ALuc2tVYDun
+MNluCJ7tXWgJNPVywdolerppQU92W1D4bBmn8FVg+6gpzb291OLo0xEwtd3jDcCcqwu/M/x5diYuxRMv
+rKsZIijIR0HlOrPIj0fTQHzloPA8ItqVKHhv7SE63XW30yZ3W33HzfyNqonn7Wu/r/3YnriH6cNQvELB
+exAczaVXcAn4RZ4spNlZ2DWKBaarDBdG5npVkvlZQtY2Nvw0QTPZsQvPWBbTDUCbQqjKUc2njUgs/hZV
+cXew4DgMgeRiYZ74hI6JnOnK0DnSXdl+acJ9389McRThro1HHgtu7d0u8oLv0hxNxMSLzuh59eCIP8hF
+AWbFCWycpcx1kDRjfIcZhQ1xMVGByDWWWzYuo3mivRjwlAi3UdWzJqc6k2Qyhxpcd4i/g0OcpsCgizPR
+74sDMaBuxBPuj68tu7uZYotR4K7BFxaYrmMHsQELGjcoOOffFzzYDrC1Mxc2DY5VMB9PlO1FuVP/rIc2
+yVA/EoZAdhnC3ElcqCxTWk6KPNWxy7oFh4QPiKra8mzpFcVrZ8UY9Ndcx5tEVQ8OzWF5rOqNWshi5Xlh
+Rd0h6uGdEtIk7r6Gl6ksfbzEQk/mMl1ltEFFVYhylYtkWnH2ImPINUEb9ArScs4Txswkk0npUDDl4F18
+nQ0YLbG4pNspFPD6YwDaFJPjCYU5wbs5yyj8xViKqlSzmSxlKpIKg+yLIp/wYzEl+mb4TMqDBEtbqYUc
+ipf4NsjuYpwOEJuqynxygh2Vq9ze7IsVaNG0UDDr3HEJoGoXswI6W3Aa1RzHIZayFFeA7SsYDd77pwRu
+vKqEmoq7YtVPBcmtC5EqDewoDojuGmQU2JRWJqjvkelMkvyAIF3d85cRGdSswfjbwWrAqcpEZQ5QqipM
+7QrGnoyD9v0aM+8dqJGCZ0kcPAngc0UbNYis7eeEcg9j8ikxHfAf3pmGVllShTHsA8WJAchthwZfwEMg
+Ylj1cTnMi5uBJTNtQ/T8xaebn2A8cAYSeSZRfKQd0JA4dXBgOYqha0M0Ib1ohp991j3FyEdEXtwYCPTw
+M2zDGa4nGoIXN+LQtrEduln4KdZ5RpYy+cNzwL8CEeD79153XwfkzrSwKHPFggiHoW6mxqlXoX21HNMn
+OhDwkBV9wJqGRkvcwl8he9geuSVyE3az8Yg67vLY4a8riGu3N2Q3JU20QP6NiRjbwRgmMSSxeVEZW0VH
+ZsmvJCSR7sVJt4OqUBymxVjCzPmbdSN8GVytaCh5pRYLmaqkkldCaebrYtOrqPwuiYoRqJAkqlxXMkmN
+1Neg3txCwAJPOmmbHYFP3Xh9DHVzT1q1kLpKFktH8XYhUvRZV+6Ei0MHi2EwIwzVvsKxofoBfoJwfdfd
+wwcc2kWdWvauw0R2hnWEPPwYPeggNaKUnO5voZcPo1IWz036Sjxylr1E4msnDog3g2+n9ttWIKDg3EPT
+NGI3ZG6mQvsPpQ1OL2JPFh07mxCC8Gcy0NFxjT0XMjh4d8UKKiQpmol5PGu5ysWkSKVJgpvkTB2QlbbR
+vSiRAEpzjGAXOitKNVO+Lz2beJfJsuX8Yv65smFWZbhN/k6GY1EHoaSwpmndlttZR9a8Iql+mPiEK3Rp
+YhuyMxelc+NLaBtt51Qt7I1eLIz8gtxWTBhnU1vHaAxmV0CvFsYHzThT+Qk9w06mBSy8ds43hZZtpM57
+0tTOIXwxKpHESZScIcpm9kiFqoeaRBuFSPj5YsvbkmVD6vAwqjVXF/T+opb0bfc4BF2rE7xZeKezZCX1
+3lQvq7lJog3RT6e1mwRJGO/jrZsMSM7hITYRX4kT96HtkdiFp/VHz3G1FFXhsriSDhW4mpYJM63YPGMj
+9Dh9wPxrBgkcnn3n+TuGVbNoigNahi+Gemj93bHmI8y9YyXeL7HIC7FIqkqWYl7cmISLFcdiAOwJVQWi
+vSz5550JaK/+mTgCWuQcnt29xAnnJGRgc2CS9G1R03FlTMOqcvHiW/GV+AtPqcj7VZBjaHyHuWVQ3KZy
+MRwOr1iistJioYDGGhe6eaK/zVeLb1YzcSYe3Zv8tSPE9dpG1HyBtShkwaBvarnEPnmRuwqQ1BZ9zCl2
+y4/Tfiz6Sv9ktGNUYIHEHUnF+22dQ8swdS7B+r6YJJlkiBeIW2fcQ07+L2mMODobTjR4XfMsXqC6tjml
+RuQtTwnKwea8Ev/1TTmPw4R6XlX09PN+e4pEeNqZnME4K2M1aztWWiSCHVYx3ufQ73gpzkTfA90/rYW9
+4NACxRIH4Ztzk0EsfooQVSS5xwIeCtN1h7fDQ4NPYXpv4vHc1b8IgywXS6FQsMvhAc+hiPLVI0r496aB
+3tvd1Bhy3YjVM2UplWR/MJLi11QDxU1u4tMqaez4d9WGermSr5AsvKNg2J2hPjwdcBChww/74bIy1/Se
+fw/zF3nBtxseWUwesPvahqAgKA6DTvAMnnBzMG4jEjS0I3KUJNpy4oKrBErqlJtWw/j0GzLQsjR8cWfZ
+3z8Spbui6T8XI16Eg9oGDTenidOyQUnUle8qsIlppGW0lqZb0zBt0UBak3QvbQtYq7eoeahq56PqoXmw
+DKftxz1+keNzr0x0BdUpTZYK8jPb6vjxx/Hb3zZ71tbEPN7SxA1xAQly/XIXpL4z++fxTtk/G7Hzz+vJ
+dUyKLFfzQ/JkdYjfdJvziFsf40VkXmGhi4gfvxr//E3Oh4H8MccDYdDpOPdzxdlz0nZQsFE93L3kUIzQ
+EHnAFvpBQkb+YJS9WpbEzlp7HUXQunHW8HHZhMhO/5fdUuuxx0qAk3oG2B0DKidsVWx3TfAQp0vHmi4Z
+rY67f2qu4WxFQDe9gUIY4E8bMEidbbmD7gOnS4+jNBrzKCJA9YsqwAfVgJkPQiHNt7eVzNO6uRglSghv
+ZEWxTJzQhuraEAGSIJ3VsiIP7I1t30D0SW/sNOTBjFFwS+cEEg1UNVioymuZQbMghSrYrR79Q46P/i25
+TohtO3ol2any6K9ZMU6ySyLz+oj+PSKLucib2483Oa4sfWmb6Tt/mk1hIKxrYPCxkzcNUd/fME+Lf/s4
+i5GPPLKtOZqbyVXI5uNdI52KHca69QxPiuVdeLeTcMQzk4ZPN3NVSTjoMm0wTUvVSDkhJ1Usit3CpN+v
+Yw7Aaxq6doan6w5v5wu5mifbQAqTlGmTl6nG9HelCiy6OI9mAjPd7jeMKjX7z0lbfxtCx3lLydTsVKw9
+CyBxJjwm3SdaH7n1OlOE+65TYTbomhNTfTJRV8jMLdfOtj1rbBygaJwlk3cde7VYqIfwn/UN1bKfvCW0
+acdstdOteXMheOQOGyYWJKPZcee0iFGbL/8giG1rDGs42V0oCo3nVZYJlbdeRBy9p7EUXK43XHQcUCK0
+0tZ1sh+mf3dGUlYaFHIdL6Y2NXSSeaMSSekZ3KMVFSWAZtFnkmJ54YdRlSg39B/Vk+5U8yRraXdv9gzj
+69bwRsSjI//+tMEi6NPpNn9xY+A+4GBHh5OsyGUauShBLRICrPMh8gGb3N9uJT/aKgcdpaDokRjZeQ34
+Lohask9pCl09kcuqKJ1FG+5Oa/ZmXp4A3gWeX5ZqkZR3YrkqURWEpEMZy1WhtKgK0avg8ZpXRc/luZrM
+E5XHNiwi+kRB3aUsp0W58IJji4JDaxulrHlRwkCZ50JohNgq1BDSEXPTszh2RR5p35jk2nmhuFOCYZ34
+UGhJL7z29ERK/4BpDltu8yA/UZPnoc/xhkce3+AoAKhd4o/qj8H6pSZtqLQHiErKbtYJR0t30KMzd4WB
+1PjRwHs0PCjrYC06n4vER+7VShd5aM95pfS3v64SShkJ45W/+phPYjGORfIajBZjMcZ/vbzULzA44CTJ
+bNL7pJRCAsChuDqm7DvHHB0bKVlSyrxfCWUaDg2o15KEZOd/S8pFkd+JKzlLsiukLYVOMuu2Bqk8hnKy
+SDSy/vwkeLcaLufLJyo9m1P7ETSPnESPIuGPLTYpcj7aX52IIw6UD3+NnWiQI+T5yFNa5HIitYbDPJaT
+ZKWluIKdBDtqlbMp+FXYsQ3nOG7sOx6X7fTnHHX3SX7H6npD27UP0nOJuowAhkiGl1zfbfVxrd4YqHyt
+HgdOgXW7Oj9/liVaX1xc8RvTqUug/GWCySaN9oetb70rwtV6dFavN27dyPpGwVl3Ld3hgT2B7XXMtsao
+ZZ6tMvQ9W7KfpI5FCjcyUeAxhWCjfUjLRko1imTIoEHVIvrnhFfxSs6+vV1e9EeuYyoyUID+4a1LUc8p
+h6y3JwYvi0qOyCD8KDlSuKJ9+KsftfZI83I9cpAqtVCgBtDmLlFlLX6JYWlw/Up72NR1ksm8OkUlYSyu
+el/0wDjMB+5qYdj6XN7wIAa9L3rR1bDOqeFcaGvin+PT1om8xHWpTeTqZfLyqj44IgF5kR+WcprJW3Ut
+h34jprPgei2Uro33ZfIysLg6oMN7kNgtdTCmkvGpD/RpbqhIeIL57uScExTnCfVcauLFiAkQckDIOMZQ
+rEfmJ/w5FiPz86ADTc+TSjokhd84aGANh89w09HODjd2VdihLs2OMYPGjpp7H7eSD92zKhSl5BBWnGFD
+wGY2TO11kqmUR4HszoaWfgfFlHYBDiUvKm89uzBrcLfe9+xi5FOK/nPmE6CzM4c9/H7haUYf2UahtI1D
+MiSYqJR1L0CQuXzsl9cJ1X5tlzIuTCSvytf/6pYp095nZeKVxmTwOMYr7UDjw8GBnJYkvCztMUGMPCN9
+deJrq2Mx5uJxU4nNFwZWwFQx+BdoYcNXJdZAPTL+5V8cCTfp0PXb/wXwxhbeuA6PPm2ABrFzA8U3Pes+
++0w0SsdRm7WOu1/CwEckrlwtmEVR1R2R8rtJpiaCAK9KOEjAstsooliJktajlS5WNwBdK1Tnp8mykimt
+5revxRfDE2GCA558MTx5PPxjLJKxrspkUjneXVz9249XQ2sj8MIYpEB35DBSTEVVJmBzV2cIoD7oEdMi
+lwLTemqVT6S4kSRFyyU9JWkalk9Dh2E8XI42EkDi9sSZ+eP9eyvUHptP48anMNoZfg5YbxOgBot8W4Oj
+I/G9ymVSmsAN4iciz7BdhNIClRdaZnfEC5b8aqZXsHNDcuA4mGsuUfziLat/InCE5zQc8p50t8m4+TGk
+TrCR0rSRitWMaduSMXrImzLyUUtl48jZi7zyAhEzUW9ZRI83bNI/j8XD2rxOeJekQYZykUq57GJ3Dfrc
+KgcLHLglIqnhz1HruUQrDNuZZJPMvCLZk5rl5PAHHIO773zpzcZNxZeB/HWQmGWMxdj9WXvRRJtIRyg5
+q487WNjWV6kvhxcbHqekwsn1quT7d1xUcwM/DOycLLyN7+HF2BDzCuYzWlBD7YaB+glHN46G3pJtWrAN
+uK7jBJX2Cwnj8016zDuYV6FmRc82LGMWHn72mcDVI53CmP+tr1u0C81/JRfFtWyeViur2/W8FstB7bS6
+kpbHOLH1RMxazlZVUK4RmH51U7Q8oVkegg/0+qu8ZtYqf6XSUGrlZC4mfjcy/bGXoF4ultXdE5ubrYe/
+e77YJi+EtFZgoMs7rItRlf4WWj0wK5BBVXtyo1oEfSzi+Pn8cvGLXEh9KA2EgGZ/I/tekwjWEw25Sm04
+JG1DIp7/+IMxSXli5k8/OzBgREyPBs4+bpgXqYSwFPSQiLZ0yiv4pMV+DazXvnBGa4gvgzceHv4tTMiN
+F/z7/ftNow2f7/i9i/VuH3epcMNYGaDBVasFTuBLhFgxrHldjlsZnPVNe8vIU7Hh7oHJfVSXUD5NObKF
+0oh8VuyPhLeHYuEYWfj7NR8apem9C389Ry2s0iQpgL/Q8364b5MxnfctQLBzNQDhb2M9K/oEEP4CgPAv
+AYS/EGD/wgvjm3sCksvzvtLwNIfCiw5s7ryYBpA4EH3znFqHWdKeo2RLJGLKwQ/qHiBGlJ2LcVncaFlq
+imNkrOsidE82QYDh0Q2rkPcrFHapHAxhK9wyPYu5Hq7rcJ9oxKPwqHsRUQxS/O/bcOLZTPYBLVL2o5Zo
+aT+yEhZEpWYRr5BbWgIdLFVSyaH4R1G+E0mJQUNwf5mH5WqmhcpFkaXi+s/sFPTiW3FyIgb/cvLlY1Cr
+JTli7XUyTUol/gwf/vL4L5GZNUM6Gh7hI9Xt+s8+M728yKs/05kOnrEOKWbgW/eJPXOiccJ8+bOPoBcN
+449EYGJpw6OYg/8dFW6kkaaWvQMeYTy+wTIptfwuKxLW8tTJjlO5EcFE8cMTAQIl4/BDryEcEUUNdVkz
+8LoVqtIym0bmToOmG8eKlRCeHS3lRT8TB3W603KNsEjHYIcFQZu7pIU5w7sT1sT8xrWBgm0H3YqbNo+O
+EIKypiwzI3y5yrLdhme8PTZeDFZYbuD/bAp268SlsvISihdlNVlVoY4DuSwMzjDt0EhZO+VUlXJSZZx6
+pMh5T4gBHGEgWeKmKFMd464pcrTzMapS2jcAtq5WC7J12jk8cun0Q7cIu3ZOP25n+HOlUG6xLTScUcit
+cgFoLfWkKOXwLdKiz/PiWZFPMzWpPscc6yaDtrG0ubq8cstUFS4ElPUYL25yWQ49V53SGGCZN7DrNVDn
+uq5bA70XRTW8FGe2Iwcl5AHYi9Wi5e9SLrFbk67KwjZEmWQ4ZAZgLAkM+2ra1O0Yaovmcqu6xBJs/nQ4
+o2CPgEEXP1H8iM5HJhHZqtIqRdWvtyTsIIjisWrbCLo9JH0jFjdEwHeYjCy6N1/snj+z278eubIR/aVG
+54PMrvWzNAx6wfiZWw81HYgnwXDFqCNej2vp2f50uZI5S7qAIuyiqObYIe3a6gXoqjloC/0dHP9AY40/
+xJk1NQATCrA2oEqnXYmrWxgX1pETjfCb16a/8uPacZY7dM6jweOf/nDzDYZIKH+eTFYLayDdkki+y3xt
+QwjsNm16zop07I+MqQ2EgQoxhVXabYMpVb6NvWoyfiwUOZMuklug65NsBeI1F+EU2ng4Wag8hrr+cxaa
+NgKbYiFAPzUFKq+HMzWujyoXB344bvyT+h5E4nPq4ZDr1dNrPcVQbVqNszvOeBWJm+ROVIWYcRJKE3LI
+ufKTrzrjwtDiG3GGOiP8038O1k5nLm+w3iAazsh937c8JhEuGWD/7c0P3wskp0qSolTqSbLk4BLXCf+W
+P6D1CXXS/6w/Ev3PILADexT2v8KSrLIFX2PBzBX0sODXVWGLev0eFP3L7eM/2VpXfSr68vi0v8/HAwax
+yv1hGAP5gS2M6iFZw7ng/lnl9qdRDFfFEQqVEAuA63JZZImf95Bss77FfgKP5UWy9E+abKtRTeYNYrBI
+luf4xSeARomNOc1h4HTFTdHFJkFZHNLuXMrUhDShHlM7Agp6Ks5Ef/BkBO9DFpfAQIdvC5UP+u/7ETwY
+o74XxkjqCjsGvNJblsOsBoFVMe5je71Y9Gf9DmpIePZjCZcUpcf8YW+Qfl+wRp4+1XyP3TCH8KcF/ISr
+D3mIg2CosVmWSIwCuIbs4uMfq4izcKmDrSUwd0xHxVXe3IUcMzuINwDP9VRcmQv2irxJPZdpGwnMD7/G
+lxlddlci0YYgnxJ/e6O0ZQdt4MIg+EZgkWV6j61UwN/EnnmuZ3IlnjDrLkb85dyA8bwkua3l82sOaOLM
+9thqNuqpIpmRekINLXMN9lx+Orl1M2630SSZG0SlYsBFnk0dnKxSikmmZF4JTWYp0dAmtzC+5pVcLAuI
+sI0CRJVqQxNUiin6ZWluC5OP8EVaC5sxVUEOVEzqcHDg2h+Ifj84OdRGPDF/HEAbCPMfxta0+S1jn29f
+aanFt6++OdTVXSZx/BngJZUZGB6gQQ6GfZVuZ1HcC3xImOpaVhVTRwApkgyt01BY6UAxR8KNXps25o6Q
+sE4ATQgxEkdf/WFw/ov+5fXFwZPoD18fzWJnq4gUV1Kls7ZafO6EBXUY1vIx8w8MZLjSVbEgbexVfYRX
+MQenEyk6898kZLOSsriM7TaDyyA200HpmXetlEBmYnEjkTJTYAMKWUJQIBtwklfwLS8qymZSUax7coI3
+ppNHg2H0/z+ylreyRN3RZJ6A3lmWmuAHhF9oDp6KdnqTJIdvy5VxzNs32m4YZ4bcWBZe6W6x8CYW/Bff
+xL/80h8J85cpK01ZaYtyU5TbotXj48d/houc/vCL/2KK/9K3yxZen0e//PK+//6X8v0v+XuCRP/85WhW
+q/1snnTft8Yu6pdf4E7hCXsXr90xzilJLNSkLA55w6AEWauFypISEP9vxTwXr6RWs76uZVE2pMOdRAdD
+zJM8zdAyZKwqpCb+cUSLnPIaA8GrSuplMpEmElGekjkZyDfskgEDJa2JsHeAUgxKxEN5+c1IXBVZajc9
+CdPkLWb+AuIGhPgmKVM2J6jUWBmNozvVPnbpEWBoQyw86IF5t6mB0jW/jnYkwitns0L3zRn541vLddgk
+NpEfpQGiT/v0iY4BnMimDaK4Vokja8aOAsOv2Och8zjn+3QwBmYghl94/94c32jIjFCjqk/fdqlviWaz
+Mta9CPm49/+tb5gv3zRUcZhNR9CpO1Fjfw1V0L5kPLtzyHDuyk0ms3d5uTw46/foE2wOy4IxDuPayeT+
+ZezvWktZZSyK6VRLL2oi93VwRuDJ9J9TbnFd2yeBLmOPNDi3F54GtREHTIONKt/TsVNjNwJ/DL3+wS/5
+YHB5WZ0NepakiAPRi6KzMxS79vsjw05CvSg6+CU3GApT0joE7NaZ16DR42XV2Y/BbVcnp7/kOBWuBqB/
+yYOF9eKvoTKuGEvx33/Q1kiJcMnUlm6oJSdvten8JvDQnEptTQntS6iazP3klc3BuY0NHjhOxslpa2zC
+7JgC04s0qRIv9X9WTJJM6EmxlMMmhRoacJH3dgLCCtzm+/f36+j+lxxfJDwq0V//kvd5TO4kwLm4vKzi
+y8vlWb8fX16+PSOdrhU147GNe+KAEdBbliqvzjypGSL98vJtPUJyvx+drmmZaqdC9A3vfLk8dcOqyrvA
+naGUcCuJMxQJWBa7gQKgOKCHAoJy2Y9F8ARciwmu8sDbSHJoEUB/mP1Coc1lwzi07U6B5Wo8kGnEfqgy
+qBaLSycy27cm2Oh5ZcPDqUymPBybOuVa5grl3HDrLUtJ9Wpk3yCcwh12oMaQOpqHm7/Vug1gs1hQ8NBu
+bqB+GPvRQGvovBPRQ9+fnp8rqErKilyCSC5gHA46ZPdYc1P0erbxhFvX8xUyxcNLA8BZftgUh1QlGPaP
+P/7UqtywT2JvmErbwKphMFc/FEjNoQK5XQLG3O5Ky1T8+CO9d8AIVGluUwpIUqHpknfBDykYg58keuXG
+5OkB/hF0rMUiwdBviA+ZDnnCf5MZ9BQkxeO4s26V2jy8DB/efKcbxMaiRdVaW5cnvGq0zugU12Y9cVes
+SvTop2eRm+YWzc9C3XbuHjaZ8KIu4NcO0weaLAeWuzw3BhCgCMC/Dem4dBTzvGEl4d9hXhDGcyAR1kXG
+ghICDDM5BF6CIW6bIfBqzo9MbbzYeZccqrTh8ho1zyvmSq/js4FrszsbiB5cRnVwi1WVVIXJQdYFKrBg
+WRZoiQJzh39LCfsezVT0XE0r/KMo6V9w4cRPq5w+dlmuUFhCtBVhTQKGSPPXbseVc256wapZ+Tt2wrgn
+VUljzeAGx9GRmpxGjvyyK6OZGf1+YCoWwQtBVrjTz48vTve794HnFx8a1pj1SSYTqfXDFojSBAHWgSWA
+f5Gt/R1w3zbFAOHBkviob8fCt7doE+9HhiIDTUe28QVLJDPY8I4vsqLBDj1GY5t4mly6+lfofoevmmVZ
+3JKDABr0mBAweEmoXEhIdyQ9/1sCpDnzgZfVD8b9b69/fMnvJDVVEy/SZG30qCj1S6sC2541qzpFrq36
+2gjEuzCAIvFuNDz94Tm8b5WuaFJiDnXg8qxY1pk67zfzyCfhMjTNigRolbHwSiq86dDCJp8W5USKXN5W
+h5ycY5HklZqg3/KiSFeZ1EPx7TX6vBer2VyQTj0zpgfJpFLMdzWGqYwWI8mL/G5RgB+cdxVTXTsyWB6W
+n1PHxpMzpvwSb/99JUvMT0pCdfCMF5weMRTILYslyQJyHDFnEFqBiCYlr5VqrspULJOyugMQxg+Os/J4
++R2T3KJQlPLXFTxwxJt5oSV6jGkxwZzGMy+rpCxL8EOfy9zKGe38WQA5iDzeyLNASHitbFehsRk1rptZ
+fvYZfxgmi9RsLO6n79Ddj8W5T4CaCuzg2ttfO+YciMP+Or5fX8SP/3Q8OrcwYJiqlDGtVyxvl0VZ6eh+
+nzIR4i/QvuLnoStAcweo8526lenzpEreIBN+JhjioD+FL4fwKDhE80OQf0CLH4rZTBV+zeHRAsuGb7Wp
+9Eomk8qvU0KB+zrNVrfhZyix34sVif3D5ocllptal34FD80RT+17lYNPDgEbwq/T/X2DOTEri9VygBBj
+MVUyS2NhroQ9XhCcBceNQBfgwf3+3h4yEnokzmkaQ4xnkr8pBq+ropSYsWHyTsOdI6vXVVLJfnQR7+/t
+zWTFHkxYOgp3wt6e6TUAM4QFgIsXVmxvbw2A6OnWbI9rnlelkmaJ9/b2+E5E2qah3+EbHp9vUsMQEMSU
+gr8MX+TT4hwxc4GQ9uAgTE3NPe7pfHphny5QvIb/whZmaIAd5/XBjegzfKJ4XVOX4ZNt+PccPpIhBMO9
+keWzRIOqHcUNkl13BuPwa3Ta7N9Gc4EOu+e9TMpk4WFujwoYBYSUU39g/vZgS/dBL1O9WNyDVQzUX8et
+tWAzxuK+KkaCdyD1NeJ/1xjhL5wMdzqAv/dau07VdS8WlK5pf29LtXvrOjoSvUWaHVZ3y2JWJsv53eFh
+qvQyS+4O/yjgy6TIivIQpIOHh7NS3h1+eXzc2z6zXrJcQrXeDyutJr0oFj3xtejxMds4xFVWmwjsFfor
+2rf/Rbys9xFB6/19pm7Dp2WF8vczPuP9BAuAC3xa+wvplW2XjVcLvxn8xrrBH7rPxLjn0b3e6PGf/hj3
+6jSzN/riJO4h8eqNHv/rX+KeT8h6oz99CSVARXqjx19+GfccGYOCvyC9P/kver8Tvf9vl5fHZ3yfupGm
+GQ4z/mZVVUV+BpWG9Hf8Rt5W38HhplL7k+E9K7LVAp7mIc6GVH7aecdAucFurSn+l8ETGRZnZtmGtqDl
+2uEDyceVavbi/a13ETCH15Luoqf4N11GM1k9J/0PBu5t3CVMbODPPbqIRuL8Ao7b6T5eQtsuMxJ8YUQv
+BAJkfkQcNoZFGsJHyFSOcr83qoJA6FAx0ZMRXidx2Df+JFdYsGChr4DOf6i0mo/Eccyjw2vK70j/upJk
+Fu6VrrR8kd4+BUN1vgNgQHgbgawRMdxn+rKH7VbLNKkkZ8PzINEQo1OHNYDEaMIUF7nMq3+oLHslJ1Jd
+yzrGc7YmbOsHvnk9AEi7kg2cY3N8T/xMMEyTxTtzvbQvM92OHotQ1VmEKhYq5FLO+0ma9mNRDV88H/78
+4jnyCGvTI2zW1j1hL1nszQwMR8r37ZAFJfCMz2RS9i/wI5Gh4U8/vn4z6B8lS3WEy3pElhZ9c4PG9U5b
+Gk4W6REMsB8FY07S9MFD3nFUPlpwa418WSRkx/eXoz6D9g3NH/coFTt+h0OZ3jJEYlW6Z3+p0tsnKr09
+66P5Czewbr1bGuMO6ce230qW3C/vBwYHCKbsp1DTohpez+nDMeGW43Q7Ylye+i7k2JU9x5975/1SwkWv
+0lsrNBgQ8763d9HET7M9n4kupFz4kNo3Z+f+acMjUVWLJeRUd9tKdM6J3J0Jr2FIhe6RHD/ymiR6sm7Z
+KkEjHhYAXW/cB1ARb7j2SWxcXyu5MVTahBvG/BCZmrzDO7gfXAnd0yY3vzPhVUv0BEwmofZhoidoOok/
+Uqkntlsv+jDPimxRwIjlnx1EmhFl9skbe4/RA4Yv2Dfe9dZCl3LLKkAE3ec//vCySBlmKad6iLdjdMq1
+waaZEpgOVZ7LEuGKQ5EPSWH/vZxW3h1279+tN+v6dfZcpT+AcV1jYNxFkqYgsqq+R35ElsDRATrM4fBR
+FAyxDSWnNbTddNy/jQv353yxaZAlOsA/aJzQBY1iVHNmcrc4ZXiD1kojkmQ6iIJNa7aO3ZawXui3WFs8
+R/VScVb/Cmgyq06nbC7VbF7VFvpvVHgoUl7pN8VSHIo/P8YmtN1ZUDG4JwgjQf+uI3+krJhqNCIJPtUj
+tUMQNNIdKCPspxlVht0FzNW4M/pmTq5DrZVmNEQYjj/Zux4S1VfgI+C9oL15DImR9PCrOd0NfRia9Du+
+3KEq1YIRbccO0aszIPPNEfnXEKzJNQpUhsjqigPzE5+U3k98ltLv50NUQkwKljoAkKptZIFQohrijfPj
+dKAj8bU4rGMAhkPMrzhjXDA1ZKbX7sMWRjlcJabHeDwdQgDcN3fdCDGeWYyOEk2VzQgfAAeWkgZXkxfh
++rsr1pwe6vDczPjCchA+Dnqv0YagZ0ByQ7y8HUgTfJg5hDTyq6edS7T2pp/Wb/TI7HbwzYerJ/JfX0PW
+MQ6i4E3iDq55KNG/a/dYoAeTxZ4Eate8ie5NRfw+rJJyJitSp6y9G6kKxI5Nfq3xhLC5NOyjMb3dDiFk
+neA0Y3Y/hFGphXwm4TEFEoBgPLoqOZ41mbI0oW8Vm7VVYB7tDaZSu4f+R9iBOc8Luba3Nnz9m0zaxLMd
+HNmGkXHHLyZFHov7nN7+nFRd9mLhSfAI8YabGvRhVP0oFkX+DDghr4L5tu7iKRfvGhOArmNR1W44+3OP
+04oyCxRebRtYtzoqTDmAowNT47EegLsWKWcNRyyFbMEQflnzhDuwhNv7YXvQXnrizN8/JUcM6/dbhBdN
+MUUAgQmdZ4votc0LRxtNq36dbUVx8iYEotHPSNwvITI3pNHrH/fXa5bLtjbUyySvC5jzYl5cy7IXiya8
+k8fLWwSJg4weCpoArzfJk1neJ+7DxbavcaY0sVCTIifpk4W3t/PJxHdxUpbFTW8dNYXV+3t7dvMAD/Bw
+IoYP2kUyk2ajuF30Aop/fvW9uRvhZ02SaJGoFrM6DhHzh9gKlqicjFjsO1yVWVs368jbd9u7bFs3r09P
+y0AKhj+CgiF66FZtQkdE272BffHfPgGvMvlBm04sV1l2WAKn/EH7z5OCfJId2KS38HwlIRq8XUE4sWVn
+kqrk971fA5UR9t9z+jC7TuvY/c1ssr11UVPznzho6H77mKFW5CQfwGf+FmO+x01vRvF8+OI5GJy/Bwt0
+UwTZdupl5q2xjhtFZsxlcfPMnIG/bmAGgU7NxFkLL2cI14xZamC5A03F0NgqewIe+tKQt2AsFRpXuz4c
+hmFfxB5nymXv34PHBVWz7xj3dm/jW/BveDZ9rA52w4UUizJRWqasBDEZEixNwGumZ9Wnvf/7/+p9QE9J
+mtY7SiYTmVf0C/pJ0jTsBgyrISIWlwx6cZPfNwn8RI9fIlGvrq89rbFCrVSrFbfVnFEbidGGGveb+GJ8
+4nYxxvQRJv8vPV+hs+3m8ZYbJ7DhNrFKRo+1uiEpm49MK/0Sh+LxGoeLEmFTC19rnAYwaElfYAr0V28T
+P4VCtljcl3IKVyb8sptpjo+Av7E86ItjU14WN62FhiB4h977qp+RIK57w9iqDRKDbZofTJNN2HOT8cVa
+phTu8GlW3PzHSPTnKk1l3qcPGy9zUv/GxgzG9O+QgbzF38EAhHeU/UA4dQSfFUr03hrY2v1/6duDtzcJ
+rghsEbw91nyff8CQHx+7MUPw+b+Wxc1I/DHo+lnAxINtZK9tnqS/3XWeWHvzFOsc8kdM88svWke8kP3N
+IwjlDJsn5979g+gjhnrSuiInLeO3lizdM2jycjuv7JYF5M5/p5my9c2GidbZv082T+z6U01z9+NEQsiN
+c27yj7tOioHbWbU8BPbR/jywS/mezPh2M035Hnn4/Q+1lNxmW7LdTnIDV/jwK/23NaJz1nHd1zSjyQl5
+68ac67b1MxPnAPK0XGzpCgzPb2fp2oF64q6by1YT/zFn74nvrVbg/MLadXIufo97DMw1fdvXZo8bbWA5
+zIpv/yooXf/SVDFSeQxLD02btq//3zHXxIlvGmJzf3KBsfEa4X5b72q6Kc6CLetbbRJBrtlsNuobe01m
+6J25Zpqxrebj+Pey3ny8q/VmtwnlTsadphNcLkSE1Bwbx8tuiEdnkhtJsBdkTuVeLTqp+PtcXVDRnnG6
+NA/x/b29SQ4mE/gq9ELpIAkyB2CSByts3z87XSamcm8n2wNb42+otS+Hq+WsTFL5vFhYU7gNsgK0H4WJ
+1v8vhEdm4slcZWkp2ZTU/IrR6YTK8M94mhUYmoTKzK94mVSVLLk1/4gxxpQ+s3bwNuaXvMU4fEUeiXt2
+ZeV05PCL4qPadHyey1MYOfUUltM4Utv4fORCDg7rEPIbw2oPotN1PUM3tYvEPQAhuOSYYsZo8hI88j/a
+gdP3CB6Z2uaWpab463S9dj5z1ek6GgBm4nuD2NEJoXZ0YjE6OjFYHJ2srT0B7WokjfK2QrqN5PCtdgVW
+xWJAma3N+zhofXhoah1myVhmfV+3hWPi1vj3Q0TfQTeXl9geSC8BfajYex9ZRAd+ku/7j9jWpipfrkDM
+Sh8vL/WylEk62DRK08Sg3vyxjgXFSNPRRt0N4nALJqjOOhZm9Q1ExMwGHpXEXLvQFKrZeyAp2IkEpErD
+tcHm5ObXfx3ujYfboKn9KI9pXfkch79KtVxm8lBOp3JS4eGkY+xfgB6Qw8N+bDbqTrK9Me+UxiFpHjij
+VDJzEeYvcwip333/GWlw0LKrgVtxbAeyEX/8lE4gH+etsckP4yn7ZjuvBldCcBnLXDw4398zAv4YHTXx
+b6GLfLa/18djD7b+fTjBGXKB8IMVExp/6SqpVvQnMZ79eB9sxveJ7/fGYgsQE/w6qA3UexMk+I/nFhjC
+sW7gwcTwG+4R4H6bbyAkI/YhZbrwbHEi6+fXMDT0HkvYAH6zKYAz5qtKNZvJ0nM3Dx38Hd8NODo6Mowb
+pwP4+dX3QNnnNuidwMhpJXzHxWZn4KMFPBMwZQ7mxhnu06sMYTmU2wI2SSSe+ycqZasHGMSqzGxEEuge
+wweSo3FBVkE8DnZg5p6G9gYAAH5UyKQi8z7M0Z1U8+FknpRPq8FxhLZuR2jn5n84iTBLxFE/kBNYrhaq
+BowtFaxp+MYLmsKhINaKfKZNrAoz9HfyjnJP/vzieSwS/K7lr0PxAmYKgKayAgMfZMeTmUt6mot3clnZ
+AMaUvhITI3rJ1W/kWBeTd7KixTCDcqvhSu7397T8dUTPz33rZ3MPGw/2hKTUqm4+1BVuiqQsMUjlCp3q
+Exoz7gSlEeBwf48BmA6CFwrW/56GQseCxzU03fJLP3j736+5RGMuZpYS4KYjywO79i7C2d4e9jWwq0g2
+9tzdE6qIDhA2aln/Mwxtd/bF8fFxH7iVUmKQ4CTTYP6dLORhUaqZykmVPoS1cVd7Geouy+FbDUefDByb
+1ZeeYASm9ejMJT9YDl9jCcyXq+1x1nSzjOQlkNoQ3rhjRkJXSVmJZIY5zfd8c1Xj2RqgEB0ZZnJwHBiS
+OgkMVcbh+FKVZVOIUplxsvGf9Q0SZ6LyfVkNts+EgWLjxDE2auWAEG70FbQpKievwcHzmrfPADeJ3Vq1
+zcauyFRGc9Xy16DU4s/ZJbdsWYtOvkm42eD+DR+uSzKo1GzTykY0Fp8EKDbje66mU2/jYGwwt3NkabZa
+OHQ7iB3Gh7crszPIIY6ELEvydnmDZo4uwHnsWRHDf2Ej2V2z9mkgjFvA5aOkFgnSjTYKBSSPiB3RF24t
+1BRgVUWGUe6ApkwNlJtEi4XSWqYBfcMOGzSOS93daW+DGmawvI4ukuylHqNItN9vXz+wj9LhKwk7FOJB
+4OH9ym02e4g3QTvzUqIbYO/fM7BHATCMf+8onKWm9QHz5kqHLzislX9eVa75yHI1KBg+TVOZtpzq8DQ0
+DzftDh8S6aw/DSzKVujDUsZ1h2MN1WCqtOaWCP8JD0vKNG3rgQ1B48E1vDS4bnm7j38GfEgsxkUKWQZX
+GMrIyuFwf4b3E1VH2TvG1RmJ/rIgrnev+yaK943WR48IJf2nk4lcVhAGGI8iRdY5gtsIa+/1n1FS0UN4
+HrZWM1wojH2EgXqGJlDP3QAKI8bptH4HYmZ2LZ0k0ZQMiVUXX5+B7hnOSf3LV+KPx8fhHfpTWSyUlsNS
+6iK7lg583QKxUR9TpwMJo+dvrS8QLkY+P7w37SSyLRSzRjDb6KXbdni/e6tvcGbKAsZyigQ1LSbIsre4
+Ur2Td2lxk/e9syAtdXPt8CXFr1mgHvYLLl6DGOHDewF+CfpGURxKiNnxrEgJOOZn/+PjEUZzWiYTCVty
+AXLc/jJZaTLQGpcyeXdqKv8JK2dyWnl1S3ndUvUvWBVtKV1dcPwJ6nLM4lFt6DUHVeDnJgsk3hIeIIA9
+9nYfmHePOa9gQLmL2OjFZKvQiKUWSSVLlWSHYMmp22y6reSgJny0Iahq1WrywHZ5XouYAgRzDl4oeJvk
+6yjwosM3bovuVy3kTpJ6tZBb0FOF2roKIB+JE/kXI/UBPY6X+6USR+LLYysT0vWvfzBf8XCJr8SJIRxQ
+tXcM9mp6F9yxhJYUcYtY9EZgA90pjtlB5/OvH6/z+f3CZiAUF8MC/6GWL4vqO0gSVqsRFFNNPl21in7p
+5nAZWMBam9pQuNRghDLReXW4hCezNVgJ1vorBHgJK2HMFy+gCds7eDUwWpGr8BMLpMJKRkzlV2RhVa0i
+l/rDsgFMdotfwq2eLpe7nNCny2VgkrEPUs9WrT6prwQL36K4u6aZbWABsKE+XbzN2ryvkms1o0QQrhCr
+xft7FztpAJG+TIo8h5vfeZrOE0N8Tq1IoD+vqqUeHcGtMZypar4aDyfF4qiUy0IfLd6qsS5y3jtHpcxk
+oqU+ypJKguVT4ykv3NOf3/zh98Fb3x/0WpyJt8MqmV0C3R3q1VhX5eAkcnG23FcQV9Ew/jtF1zWA/Gcu
+vXP3YKaeU6ARIHJ98hK4pgfeXqbydyPxdjivFtnlqswIzNqPOeW7/u1ikWNtbyB2MK2CKDBOYvD2Q1FR
+ot9pURUiK2ZC5ZjsmyIIYtBzeOLtMYhGb7SA7AFRE+0kq2r+m4ttoJNWV+h7+DIS8F9GJKwl/Bx+m6NC
+AB+KWPCqsA7edShZMVO5sctmLIBWAdOZdkZfQLHPP14P2iMutAkR2ufQ0aGmDGfFqhr4xywGG7fjqM2d
+FXYCT6XN/xT/bOHPti/wEULts+6z+VLa8lRqf9Lc/5RoDWlMA/uqJReumaPv3jv40DHvmEf4wtm0vPx+
+6N+UBQRV5W766w4RVvsOwfWJbWsMjCR88MDUMMgmbWxbLwPKmJt3Og67sbjeNzgPw7J9X8yKVfXQzdAM
+kWK2QLGq+oZvq+9pVjdkBceULWVWJOkgJGt8YlrZVfCzRd63Dmlp7/MzwZdIH/ysbjTcJuhodYN/meuH
+NBMM76ABb16gw39zt9/oI/t84K9toieeMPQCz4gnWv6KEu1mEz8EwI3mZAX/kOPXSJUHq5Ls2G70sMgX
+UuuaWF1uJRfoOuJCL8IbDg8ZZqkesHOPJYvpkPP8iz4Psh+FgYc8Ud4gHT63rT1/V36ZnxMwY6q0Vytu
+a00V4ZFWZHKYFbNBD4SLqNLA1r1YUPPOEDaEqklW6EZ05d+IrPLGJYuYunCz3iPXSu1+R1fAb2ttNzW1
+5IPcv1bMW3qtg/CV7vSwGpdffJxLb1gST6c0BTCrNT618baqeUfLmayekQbsp8REhkG6Sw+PMxRjWc0e
+UOHmsTKbzA6RE7gHD0X++ET0GyaV5CdFeRTdJfdJCFtVvJP5kYnK3N/BZg1cWLLkriXGEd4CPFWutYvn
+2r1KR6LHDTZ7tfamRbmoN0Ek9WJR5K9X44UyLj84Ggttr0cMrb24RFEKnHxvo9Nrb2kcwDZXM6ZK92Au
+A57Z3E2vzWPKfGw4WYVXYSySVVV8V0xW2hC6jYOwjnehlx3uJPMTrG0RM73oofOur/S3xkCqJUKqo5Z+
+IC9Lj37bTdLSgvZIczdkcBPysOBJhfHTSXX+4H3RS6oK7BKAnFeFKCXDHQ6Hvc5AsnikkPS1HCjf4g/q
+VSQasyLfelWUnSFkayC4HbEt0RGOOTrCg308HbXf6lOKVXubY/IaQ8baECFuWHOYez2ac1IJcBNVC+kF
+1AMRmwi9RwljmzaxERLKfPWikgsX1DlPrn8whWFEqHfbw4Sw/TuGa34Xi7ppYm5FEpeX8F7uGRGtuwsH
+1/QDxKnFSPAvCOA8bI0fErVsLWBobdglV2TejJF55/AsSTnZvZcSG4G6B2B6sZiTh2ev7vfquPId5m4X
+F2iWKFaVqLkA22dtLHpNv9/mtJ/JvCqTzAtbEX7/Wcsyd3JyYqZze442b1Oa/4pB9B4yv72WMWymP+Ny
+t4sJV6ZrMYiLgON4Tn9e9MJgDfQydNvABEpd5S2MLXIH+OYVZ6L5eAFOCBUl298hLZyJk1PVlt8s1R53
+jS+SiS6n+CSR+aRI5c+vXjwzkrx6++Gz16++871pwgisvScly3vPeu3wqN+odYB/K3Tl7ycTvxMG+dmc
+P+4wUAvHH2dzWbadTW9v0pZoPRq4BIj+PuqHKcjtLpvZsBbe88Vw2Cy31S20yMi1dWtSgUakYFvdI72X
+bDbcFlrf1B9BlfXWcPo+fe6ZYR/C2mN4jA+h154pqXl5EOm28Hst4fhhQoTHOi3fs9jcfMPnSasflxuy
+I0CM744rkKS6LUtHH4w8AH881CshS+6KVVWLm9MDHodTu41cvIltFK4xsiEsyTpu+YCnKZjow900mbl8
+oNNmVSwPF5AAcP0B3p6ELWOP7v1iNB6yR1mZ3MiyHj/Dr2cYY6rJBuu7MdbtY7q85E43RxHaeR8wi77t
+nfKAPe5RTC6IPtFQLUnyRrt0ZIpLaOft4Nndw+2xAdMTsqTZhuqW9QIDusNm8z3WwO3vdYPzdaPbVP9R
+ZDDc4K1x6vsbXvhFJcsdvFQB57Kket0e5RSh2bHrFDn7nnKgkA1Sn8LujUQf3VD7Yh37NTzLf1OP/Nl1
+vSYGYnG1/h1/1uoknATE1jJZQer1KO+Hq0a/odaFMQNDBOyiiKWaO+pi6xrTle5QmbKGdOGHsJ8sNgcD
+bw/v7lnP7Jztoa7/s6NtyijrYojXoP4gI8cin2FQqEdBuCZTzIM2plgkoRu4ZvbqSIdge09ZXL33eYQh
+kL/NkqWWKVqefHnaEHymTmkM9AQNehBLbWHEoWdwEq893UwxRZbjYRtwnfXwdjgkIyD43fFmwmEKyrEY
+AO7/snr8xTdfNgFYsy87BP5KCyXftcst6+uE0oy2INb40CDT7DPxZxtSa1kgP4QQh5NMybz6D3Fos48f
+iUFbNHLPjNsY/QCgz0VtJKf7XdsXZvRkWWjk4zVgNddWkgrKaKQE7fLvqkxyraD0TTFgChIKYV+H+KqF
+qe9YIp4oClkCWX9FPzz9HJdQgmRTt35uDUDOUfc0VwukAd+VycIGgDejbXKtMaom/eUDNNttMpMVU/Vv
+7l6kgz4g9HBZEBK9pT1uDazPB68WudwcQnEo2moTG41Q4WTW1lp8Lk7kn8149ZDSEGOAFd4eB6L/h/52
+YXlFkR6Rmp/aeJqzjpPdcqqHlgphUzj6rSfaasBghqKVmp36Z3cXieC0yKt/cBir/hfHFIQVhoAgiYS8
+f08l+Mvp4RTOOBaK7rtYQFJUWVpFnHLhCXmDUQtxtulN1hr6UFHUQw+ie4krL3Ij97NHIxFnRPv8KBr7
+3a14HrsMrx5OMhgfAXU6djPpB8XssEj1J+Q+AsjaO24rvdgzifhx+yiN73V0DdE3yRJtaRJz5gGSvBNJ
+KYVCk4dJkkWUo9uAYodALZLca1dKchO6mavJXNxINLsRRU6dkmfR0FkvVMVshnv1kffz1D7wk1xNilVu
+bTy5+hPRP0FN2WNeW9c/ZBCW8h1QadM4dNhRuPNN/igbwq3/Z5A0x/tekCW/aFkQAQeubayLbFVxXqm9
+cVFVxQLjF1NBRpJr/rkOj0MtoO6eWsy2xNPlg0rDbgbRVS3xc0OJ3YYuQlpguqg/SNoj6JLk59QT/dQo
+kt1+4okx5YYlg5qXE1VOMnk5VVkmU2vRMKnK7LW/Op1YZxx/cfyHvsnnlWvQG45EH//Okkr+x+Dwi+M/
+RMY2J1wmu+5/sosMXPjTTM2gu4nMQSAZ+3McV3nX6EqZJeRsjICK5dbB/Q9vcLYHYssfhoK20cM3fygn
+X5paZmvbwd3MVSVfL5MJPEgodBh9MFEMXRDDYKhoUr/bSEtzuyxvu3CPYB/MfnzamFuoSgTa0dt8BL44
+Pm7I2KHZ7vpKw/p0d1SUQCexq3UUuPerxWyHuVhlWtuaCN46RN6EfzKEWSv4u7ZMouV8rHeaNI/G291r
+pxPxwmTzVbmL8CSE7Dbjejflebh6ln23YeTNUW8PnL3X6SsRCnMClw2UISHvf7mgSFg16ckD5msJ5ead
+ekIBuh6OkMkiBaP/pUwqiKfZQIqN6evI/Sus/anxRWNwqPrgyaB7UPSbr69+p5aX0JcqVvpTDBuvzY1L
+gJr3TzoLuKE/fujoZPU7YRz6+gTYLpM8LRY773is/cnnM19Np5nsdctWW4SfKFBkPVGnVNdllICIfBhw
+aT5qM2SLBaUnK0fgBEKTaoPq++GAdsY0Ah8XF91zHXUCqA/LPvqqufl5NHrKpSF4LH0gaF2DrX2o6LtD
+YPUD4LqHYDW3v49GT015bdRY/FDwug6/beD0YXfQgVKymnslR6Of3DfbjSmzmtkHdGWE6wE8LrTZAB4w
+drxFWweHtzhwTNE+nA0WZ5crNijVsfHv+pvSVVHefc9WCk7LLAZW+4HH3HpVW12zMe/jdKE0XuJMW3Xz
+Ft59Tfsbxd3SMVAO9aPo1HkKOl8yCCt5DIEm2SMMfp/E2+NQBm5kUPYFljmPMSj78qMjU37xKUNKbfWX
++x3SiO+cIHxn/zqeKG3XXZQ7/047fnvo41bXuYcHP7YbpRH+GKXNm/MZn9sMyxcfkMtYJ9ftab85B+uy
+LBbLamDVsVg+6rk8d54pTkdazJqJCQXc8mMJG8l6kU9VuRj0fryW5U2pKinkrdJo/Gkw9KQXhXGGm1Yx
+Bi8NIxd+czSiWGxNhf3QlNaWtBPOURdH1mch7v9XiW1Nx2FTWMgOM+kWS9amnWpvsy3KTqBhFwNk+Hfj
+QB2z1BGJmzcJZl0zfXFCE2MwviGApedOvJMGOdsxxPpvRmdgLvZkQuAwT13cTnvwxNqj+pyCtjSPZ1O7
+uDvBajs/jQjhFtf/i56q7gl9uoOWhqvzcSfDEfDuoV/Ewst1uOHAEIv1QI7qQdEbvvyUfNH/TGzPjnxP
+bMNuU6n96ZMr8xjYiV65d8UOBMtUfjDFMspmMhO5TlQG1vMc03Bvjx3H7O/XMmN3vP4MBX1OwM0n3qax
+TmNRH531Zdyic34n77SL4Qu/fMbCjhJTK1N2A6hDCamcGQQP9QUkUUMGxeR4hsqBQaWZlW2blsUSogUZ
+tgvBU1A935klGcMw8SMwP3YmZsK+NS8m1w88Q6+dRldet3u7NR0i3QKYTozzc93NshHgOxnry8sqGbcb
+ohvAnLUhGL+NtqP0oZdYzlhxb4oT45u2Iu1Eq2TTQSxqEYEtZUV0rWM3lA47YrNSOzkKNa4RWEAT7tz7
+OwiS/GEWpRbbh+PENyiF8s3OOkuPSpxfeBHdfEt3Opa1qHQcqa62el48uHooO8/g3J1R9JNpNVfkKq+K
+G2Nd7nlG9H7JrYW5KfYXmqQbYL9ubOvpCNLcvb/9s9tmZWLOKg1c2237get/eblMcpm5rb15vX+yE7p3
+s2wZnrOeaKNc5y0tLtZdO0Lly7KYlVJb14NHkEj9W4gg7KPmRf4T14sCixtdtT7QXPXQA8yGCKSlgEA5
+Kh3qZaaqQf+XvO9RFzMuTPiO8UXFE3E9fF7kZJp3hJZ15hM7/9bzvLQvWVVapw+93GIlXKWWldTL8+OL
+6CHVTx5Q3cy3xcXIhC7JPZz8T5THpvcUHQ/FC4z1hwMEr87NejmT3LDRv0v3YkimK9lm0l3NZZLW5r5p
+D4T19nZLYVkb5eXlRGbZ4WFe5If5aiFLNUGkmCPci36rHtBXaTfoNNHeVGXSadf2dlP89CoIz9LAFp79
+bl3LLoHe6hv1N96mL+WNlZ4bvJk7PhY+xY9FeN5+l+F9ayRkjj2PNnT8cQdo5+Oz4+H5rY/Ob3lwPins
+EsPi/nbQp6XU85of6sY1bTm8liHbqBv14uTt/KLsPcA3Yd86fI7EOaapMyJsDKLkia9aJdvOhXHfm1KQ
+dN0Uxn5XQaQJKDI9tYmvGMARyZO5/pbAMibhro+EQdQZ4KnjrVpPfO0HdNmRqEbtMruLlhCoRvjjd2Jb
+dNWrPUyhDDi82+BpinI/cdbq0sB1lqH9I/ehMzWR9qm6PFfpLUSjboa0an/MGj8zoznkIHu8l/C9SCXd
+b9ztWCYeUqW3Hdn8NiSX7v/piz/0g0TShKr2iCiYSBEwEAubdIsmISikClZZ8xaNOl60686F//Hpqpo7
+61ou3RzWwAowyRm44NAGvjhz8/O27praOiLUMlCokF5HIJXGkHcYLaoX6mE4glgwbYqJWLiMQr4vTqi0
++AifWZrFFoL8qrh5CE1+VdxQJGC4mBp0ZgPRoxYmqt92CouYrYUwjv3wbnx7PWwM2OSTDuLDlAp1nqf7
+Pffwe71lMtFv2w3qen+DLh4cFIc2Wbsx2AfbgrFacncm6XebLO7mTzzbdqawU1XzKVUzf9pFNQPx6edq
+IaZFKVYYz29ZFhOptVC5GJfFjZblPmerovIOBQ5U+ZUtP84v6HdaJiqnfCocNw5KOS0TqoC9dkZef3ji
+pz+C3ZL/vHwpb6s3avIOCYIQogU0lMIF6oP3ZPeC/2fGGFSbFPkkqQb4LSJQ9v4KGvqDxFq22187+sOB
+Yi8DA3l/7U3Q/y7uLTgzPx+UUfa6jk1orYYXZA1t3LOHNNjep/sWSCZBku7PgVrczFUmB5nM/XH4qDOt
+ThsIhk1gyhCMGBwceEj8StTAti1g/Xu9+3MH8AIt4qLToP56v/lX60LC/7qQsN6vd2sTBHVvxdCB1fqu
+rvf3+SANc14cjwEXwLKbOcO6JOXMxB59WpbJ3cAmp+MRikNxErnN3/j8NWW+MVP0chifiZNTocRXot7m
+VKiDgzriYSDnCnoDRt82OVfeKq89bBEWkeODwUMEA5gbZtdDjrft3GBOnxNKD9Sy/7397U5NLI7duaL8
+ctd/xqx7WixLmapJpUDcUqBSUbuD5w+pTO5MR3gXTDFy1nSVn7oyrIVzLyGG5HofAHiJRkvThleyDpBz
+DBKX4iBSdhyzJ6yDOpPevvvEJfbsmnKZXzMJNiVJObvmA2iKOCANpbKG5CGYhlBQQGdRFSK5LlQqSjmT
+t0uhtF5JXW/czJ6dF8US5ultauwDyr3hpKlJx9L4VuQT2SycThtlxIp0wqHPT7PM1NCNKnKhKlvosKpM
+xi+3csbWjlbPpIalvDj9ekOlRV5UQq+WcBvKtE8LajuY3KT1bcGEHPMqess2maeqDOqmqtw6DmrVMQpT
+abVI9Lta1F0ziuNTsSb+Y30B/4nPH//pX8Hm7v8dAHA9iwcwQBAA
 `,
 	},
 
@@ -32172,7 +32172,7 @@ var Tracks = exports.Tracks = React.createClass({displayName: "Tracks",
 	titleCellRenderer: function(str, key, data, index) {
 		var image;
 		if (data.Info.ImageURL) {
-			image = React.createElement("img", {className: "track-image", src: data.Info.ImageURL});
+			image = React.createElement("img", {className: "track-image", src: Moggio.url(data.Info.ImageURL)});
 		} else {
 			image = React.createElement("span", {className: "track-image mdl-color--grey-300"});
 		}
@@ -32421,9 +32421,104 @@ _.each(exports.Actions, function(action, name) {
 	});
 });
 
+// prefix is the URL path that the server is under, like /music, or empty.
+var prefix = exports.prefix = window.moggioPrefix || '';
+
+// url returns path, if it is on this server, under prefix.
+exports.url = function(path) {
+	if (path.charAt(0) == '/' && path.charAt(1) != '/') {
+		return prefix + path;
+	}
+	return path;
+};
+
+// library holds the songs of the server, keyed by UID, as of seq. It is
+// fetched in pages and then kept current by the diffs from the websocket.
+var library = exports.library = {
+	seq: null,
+	tracks: {},
+	// pending holds the diffs that arrive during a fetch, or is null.
+	pending: null,
+};
+
+function fetchLibrary() {
+	library.pending = [];
+	var tracks = {};
+	var seq = null;
+	var page = function(offset) {
+		fetch(prefix + '/api/library?offset=' + offset + '&limit=5000', {credentials: 'same-origin'})
+		.then(function(r) {
+			return r.json();
+		})
+		.then(function(p) {
+			if (seq !== null && p.Seq != seq) {
+				// The library changed between pages: start again.
+				tracks = {};
+				seq = null;
+				page(0);
+				return;
+			}
+			seq = p.Seq;
+			_.each(p.Tracks, function(t) {
+				tracks[t.ID.UID] = t;
+			});
+			offset += p.Tracks.length;
+			if (p.Tracks.length && offset < p.Total) {
+				page(offset);
+				return;
+			}
+			var pending = library.pending;
+			library.seq = seq;
+			library.tracks = tracks;
+			library.pending = null;
+			Actions.tracks({Tracks: _.values(tracks)});
+			_.each(pending, libraryDiff);
+		})
+		.catch(function(err) {
+			library.seq = null;
+			library.pending = null;
+			Actions.error({
+				Error: err,
+				Time: new Date(),
+			});
+		});
+	};
+	page(0);
+}
+
+// libraryDiff applies a diff from the websocket. It fetches the library if
+// told to or if a diff was missed.
+var libraryDiff = exports.libraryDiff = function(d) {
+	if (library.pending) {
+		library.pending.push(d);
+		return;
+	}
+	if (library.seq !== null && !d.Reset && d.Seq <= library.seq) {
+		return;
+	}
+	if (library.seq === null || d.Reset || d.Seq != library.seq + 1) {
+		fetchLibrary();
+		return;
+	}
+	_.each(d.Instances, function(inst) {
+		_.each(inst.Added, function(t) {
+			library.tracks[t.ID.UID] = t;
+		});
+		_.each(inst.Changed, function(t) {
+			library.tracks[t.ID.UID] = t;
+		});
+		_.each(inst.Removed, function(id) {
+			delete library.tracks[id.UID];
+		});
+	});
+	library.seq = d.Seq;
+	Actions.tracks({Tracks: _.values(library.tracks)});
+};
+
 var POST = exports.POST = function(path, body, success) {
-	var f = fetch(path, {
+	var f = fetch(prefix + path, {
 		method: 'post',
+		credentials: 'same-origin',
 		headers: {
 			'Accept': 'application/json',
 			'Content-Type': 'application/json'
@@ -32524,7 +32619,7 @@ var App = React.createClass({displayName: "App",
 		Router.State,
 	],
 	componentDidMount: function() {
-		this.startWS();
+		this.connect();
 		var that = this;
 		fetch('https://api.github.com/repos/mjibson/moggio/releases/latest')
 		.then(function (r) {
@@ -32543,12 +32638,63 @@ var App = React.createClass({displayName: "App",
 	getInitialState: function() {
 		return {};
 	},
+	// connect opens the websocket, or asks to log in if that is needed.
+	connect: function() {
+		fetch(Moggio.prefix + '/api/auth', {credentials: 'same-origin'})
+		.then(function(r) {
+			return r.json();
+		})
+		.then(function(auth) {
+			this.setState({auth: auth});
+			if (auth.Enabled && !auth.Role) {
+				this.setState({login: true, connected: false});
+			} else {
+				this.startWS();
+			}
+		}.bind(this))
+		.catch(function() {
+			this.setState({connected: false});
+			setTimeout(this.connect, 1000);
+		}.bind(this));
+	},
+	login: function(event) {
+		event.preventDefault();
+		fetch(Moggio.prefix + '/api/auth/login', {
+			method: 'post',
+			credentials: 'same-origin',
+			body: JSON.stringify({Password: this.state.password}),
+		})
+		.then(function(r) {
+			if (r.status != 200) {
+				this.setState({loginError: 'wrong password'});
+				return;
+			}
+			this.setState({login: false, password: '', loginError: null});
+			this.connect();
+		}.bind(this));
+	},
+	passwordChange: function(event) {
+		this.setState({password: event.target.value});
+	},
+	authLogout: function(event) {
+		event.preventDefault();
+		Moggio.POST('/api/auth/logout', null, function() {
+			window.location.reload();
+		});
+	},
 	startWS: function() {
-		var ws = new WebSocket('ws://' + window.location.host + '/ws/');
+		var scheme = window.location.protocol == 'https:' ? 'wss://' : 'ws://';
+		var url = scheme + window.location.host + Moggio.prefix + '/ws/';
+		if (Moggio.library.seq !== null) {
+			url += '?seq=' + Moggio.library.seq;
+		}
+		var ws = new WebSocket(url);
 		ws.onmessage = function(e) {
 			this.setState({connected: true});
 			var d = JSON.parse(e.data);
-			if (Actions[d.Type]) {
+			if (d.Type == 'library') {
+				Moggio.libraryDiff(d.Data);
+			} else if (Actions[d.Type]) {
 				Actions[d.Type](d.Data);
 			} else {
 				console.log("missing action", d.Type);
@@ -32556,7 +32702,7 @@ var App = React.createClass({displayName: "App",
 		}.bind(this);
 		ws.onclose = function() {
 			this.setState({connected: false});
-			setTimeout(this.startWS, 1000);
+			setTimeout(this.connect, 1000);
 		}.bind(this);
 	},
 	error: function(d) {
@@ -32568,7 +32714,7 @@ var App = React.createClass({displayName: "App",
 	routeClass: function(route, params) {
 		var active = this.context.router.isActive(route, params);
 		var path = this.context.router.getCurrentPath();
-		if (route == 'app' && path != '/') {
+		if (route == 'app' && path != Moggio.prefix + '/') {
 			active = false;
 		}
 		return active ? ' mdl-color-text--accent' : '';
@@ -32579,7 +32725,20 @@ var App = React.createClass({displayName: "App",
 	},
 	render: function() {
 		var overlay;
-		if (!this.state.connected) {
+		if (this.state.login) {
+			overlay = (
+				React.createElement("div", {id: "overlay"}, 
+					React.createElement("form", {id: "overlay-text", onSubmit: this.login}, 
+						"moggio password or token", 
+						React.createElement("p", null), 
+						React.createElement("input", {type: "password", value: this.state.password, onChange: this.passwordChange, autoFocus: true}), 
+						React.createElement(Button, {raised: true, colored: true}, "login"), 
+						React.createElement("p", null), 
+						this.state.loginError
+					)
+				)
+			);
+		} else if (!this.state.connected) {
 			overlay = (
 				React.createElement("div", {id: "overlay"}, 
 					React.createElement("div", {id: "overlay-text"}, 
@@ -32605,6 +32764,13 @@ var App = React.createClass({displayName: "App",
 		var menuItems = _.map(navMenuItems, function(v, k) {
 			return React.createElement(Link, {key: k, className: "mdl-navigation__link" + this.routeClass(v.route), to: v.route}, v.text);
 		}.bind(this));
+		if (this.state.auth && this.state.auth.Enabled) {
+			menuItems.push(
+				React.createElement("a", {key: "auth", href: "", onClick: this.authLogout, className: "mdl-navigation__link"}, 
+					"log out (", this.state.auth.Role, ")"
+				)
+			);
+		}
 		if (this.state.CentralURL) {
 			if (this.state.Username) {
 				var un = (
@@ -32616,7 +32782,10 @@ var App = React.createClass({displayName: "App",
 				);
 				menuItems.unshift(un);
 			} else {
-				var origin = location.protocol + '//' + location.host + '/api/token/register';
+				var origin = location.protocol + '//' + location.host + Moggio.prefix + '/api/token/register';
+				if (this.state.auth) {
+					origin += '?csrf=' + encodeURIComponent(this.state.auth.CSRF);
+				}
 				var params = "?redirect=" + encodeURIComponent(origin);
 				if (this.state.Hostname) {
 					params += '&hostname=' + encodeURIComponent(this.state.Hostname);
@@ -32777,7 +32946,7 @@ var Player = React.createClass({displayName: "Player",
 				left: '0',
 			};
 			if (info.ImageURL) {
-				img = React.createElement("img", {style: istyle, src: info.ImageURL});
+				img = React.createElement("img", {style: istyle, src: Moggio.url(info.ImageURL)});
 			} else {
 				img = React.createElement("div", {style: istyle, className: "mdl-color--grey-300"});
 			}
@@ -32851,13 +33020,13 @@ var Player = React.createClass({displayName: "Player",
 });
 
 var routes = (
-	React.createElement(Route, {name: "app", path: "/", handler: App}, 
+	React.createElement(Route, {name: "app", path: Moggio.prefix + '/', handler: App}, 
 		React.createElement(DefaultRoute, {handler: List.TrackList}), 
-		React.createElement(Route, {name: "album", path: "/album/:Album", handler: List.Album}), 
-		React.createElement(Route, {name: "albums", path: "/albums", handler: Group.Albums}), 
-		React.createElement(Route, {name: "artist", path: "/artist/:Artist", handler: List.Artist}), 
-		React.createElement(Route, {name: "artists", path: "/artists", handler: Group.Artists}), 
-		React.createElement(Route, {name: "playlist", path: "/playlist/:Playlist", handler: Playlist.Playlist}), 
+		React.createElement(Route, {name: "album", path: "album/:Album", handler: List.Album}), 
+		React.createElement(Route, {name: "albums", path: "albums", handler: Group.Albums}), 
+		React.createElement(Route, {name: "artist", path: "artist/:Artist", handler: List.Artist}), 
+		React.createElement(Route, {name: "artists", path: "artists", handler: Group.Artists}), 
+		React.createElement(Route, {name: "playlist", path: "playlist/:Playlist", handler: Playlist.Playlist}), 
 		React.createElement(Route, {name: "protocols", handler: Protocol.Protocols}), 
 		React.createElement(Route, {name: "queue", handler: Playlist.Queue})
 	)
@@ -33005,10 +33174,12 @@ exports.Protocols = React.createClass({displayName: "Protocols",
 		if (!_.isEmpty(this.state.InProgress)) {
 			var insts = _.map(this.state.InProgress, function(v, id) {
 				var sp = id.split('\n');
+				var progress = v.Total ? v.Done + ' / ' + v.Total : '';
 				return (
 					React.createElement("tr", {key: sp}, 
 						React.createElement("td", null, sp[0]), 
-						React.createElement("td", null, sp[1])
+						React.createElement("td", null, sp[1]), 
+						React.createElement("td", null, progress)
 					)
 				);
 			});
@@ -33019,7 +33190,8 @@ exports.Protocols = React.createClass({displayName: "Protocols",
 						React.createElement("thead", null, 
 							React.createElement("tr", null, 
 								React.createElement("th", {className: "mdl-data-table__cell--non-numeric"}, "protocol"), 
-								React.createElement("th", {className: "mdl-data-table__cell--non-numeric"}, "name")
+								React.createElement("th", {className: "mdl-data-table__cell--non-numeric"}, "name"), 
+								React.createElement("th", null, "files")
 							)
 						), 
 						React.createElement("tbody", null, 
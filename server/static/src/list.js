@@ -217,7 +217,7 @@ var Tracks = exports.Tracks = React.createClass({
 	titleCellRenderer: function(str, key, data, index) {
 		var image;
 		if (data.Info.ImageURL) {
-			image = <img className="track-image" src={data.Info.ImageURL}/>;
+			image = <img className="track-image" src={Moggio.url(data.Info.ImageURL)}/>;
 		} else {
 			image = <span className="track-image mdl-color--grey-300" />;
 		}
@@ -27,9 +27,104 @@ _.each(exports.Actions, function(action, name) {
 	});
 });
 
+// prefix is the URL path that the server is under, like /music, or empty.
+var prefix = exports.prefix = window.moggioPrefix || '';
+
+// url returns path, if it is on this server, under prefix.
+exports.url = function(path) {
+	if (path.charAt(0) == '/' && path.charAt(1) != '/') {
+		return prefix + path;
+	}
+	return path;
+};
+
+// library holds the songs of the server, keyed by UID, as of seq. It is
+// fetched in pages and then kept current by the diffs from the websocket.
+var library = exports.library = {
+	seq: null,
+	tracks: {},
+	// pending holds the diffs that arrive during a fetch, or is null.
+	pending: null,
+};
+
+function fetchLibrary() {
+	library.pending = [];
+	var tracks = {};
+	var seq = null;
+	var page = function(offset) {
+		fetch(prefix + '/api/library?offset=' + offset + '&limit=5000', {credentials: 'same-origin'})
+		.then(function(r) {
+			return r.json();
+		})
+		.then(function(p) {
+			if (seq !== null && p.Seq != seq) {
+				// The library changed between pages: start again.
+				tracks = {};
+				seq = null;
+				page(0);
+				return;
+			}
+			seq = p.Seq;
+			_.each(p.Tracks, function(t) {
+				tracks[t.ID.UID] = t;
+			});
+			offset += p.Tracks.length;
+			if (p.Tracks.length && offset < p.Total) {
+				page(offset);
+				return;
+			}
+			var pending = library.pending;
+			library.seq = seq;
+			library.tracks = tracks;
+			library.pending = null;
+			Actions.tracks({Tracks: _.values(tracks)});
+			_.each(pending, libraryDiff);
+		})
+		.catch(function(err) {
+			library.seq = null;
+			library.pending = null;
+			Actions.error({
+				Error: err,
+				Time: new Date(),
+			});
+		});
+	};
+	page(0);
+}
+
+// libraryDiff applies a diff from the websocket. It fetches the library if
+// told to or if a diff was missed.
+var libraryDiff = exports.libraryDiff = function(d) {
+	if (library.pending) {
+		library.pending.push(d);
+		return;
+	}
+	if (library.seq !== null && !d.Reset && d.Seq <= library.seq) {
+		return;
+	}
+	if (library.seq === null || d.Reset || d.Seq != library.seq + 1) {
+		fetchLibrary();
+		return;
+	}
+	_.each(d.Instances, function(inst) {
+		_.each(inst.Added, function(t) {
+			library.tracks[t.ID.UID] = t;
+		});
+		_.each(inst.Changed, function(t) {
+			library.tracks[t.ID.UID] = t;
+		});
+		_.each(inst.Removed, function(id) {
+			delete library.tracks[id.UID];
+		});
+	});
+	library.seq = d.Seq;
+	Actions.tracks({Tracks: _.values(library.tracks)});
+};
+
 var POST = exports.POST = function(path, body, success) {
-	var f = fetch(path, {
+	var f = fetch(prefix + path, {
 		method: 'post',
+		credentials: 'same-origin',
 		headers: {
 			'Accept': 'application/json',
 			'Content-Type': 'application/json'
@@ -28,7 +28,7 @@ var App = React.createClass({
 		Router.State,
 	],
 	componentDidMount: function() {
-		this.startWS();
+		this.connect();
 		var that = this;
 		fetch('https://api.github.com/repos/mjibson/moggio/releases/latest')
 		.then(function (r) {
@@ -47,12 +47,63 @@ var App = React.createClass({
 	getInitialState: function() {
 		return {};
 	},
+	// connect opens the websocket, or asks to log in if that is needed.
+	connect: function() {
+		fetch(Moggio.prefix + '/api/auth', {credentials: 'same-origin'})
+		.then(function(r) {
+			return r.json();
+		})
+		.then(function(auth) {
+			this.setState({auth: auth});
+			if (auth.Enabled && !auth.Role) {
+				this.setState({login: true, connected: false});
+			} else {
+				this.startWS();
+			}
+		}.bind(this))
+		.catch(function() {
+			this.setState({connected: false});
+			setTimeout(this.connect, 1000);
+		}.bind(this));
+	},
+	login: function(event) {
+		event.preventDefault();
+		fetch(Moggio.prefix + '/api/auth/login', {
+			method: 'post',
+			credentials: 'same-origin',
+			body: JSON.stringify({Password: this.state.password}),
+		})
+		.then(function(r) {
+			if (r.status != 200) {
+				this.setState({loginError: 'wrong password'});
+				return;
+			}
+			this.setState({login: false, password: '', loginError: null});
+			this.connect();
+		}.bind(this));
+	},
+	passwordChange: function(event) {
+		this.setState({password: event.target.value});
+	},
+	authLogout: function(event) {
+		event.preventDefault();
+		Moggio.POST('/api/auth/logout', null, function() {
+			window.location.reload();
+		});
+	},
 	startWS: function() {
-		var ws = new WebSocket('ws://' + window.location.host + '/ws/');
+		var scheme = window.location.protocol == 'https:' ? 'wss://' : 'ws://';
+		var url = scheme + window.location.host + Moggio.prefix + '/ws/';
+		if (Moggio.library.seq !== null) {
+			url += '?seq=' + Moggio.library.seq;
+		}
+		var ws = new WebSocket(url);
 		ws.onmessage = function(e) {
 			this.setState({connected: true});
 			var d = JSON.parse(e.data);
-			if (Actions[d.Type]) {
+			if (d.Type == 'library') {
+				Moggio.libraryDiff(d.Data);
+			} else if (Actions[d.Type]) {
 				Actions[d.Type](d.Data);
 			} else {
 				console.log("missing action", d.Type);
@@ -60,7 +111,7 @@ var App = React.createClass({
 		}.bind(this);
 		ws.onclose = function() {
 			this.setState({connected: false});
-			setTimeout(this.startWS, 1000);
+			setTimeout(this.connect, 1000);
 		}.bind(this);
 	},
 	error: function(d) {
@@ -72,7 +123,7 @@ var App = React.createClass({
 	routeClass: function(route, params) {
 		var active = this.context.router.isActive(route, params);
 		var path = this.context.router.getCurrentPath();
-		if (route == 'app' && path != '/') {
+		if (route == 'app' && path != Moggio.prefix + '/') {
 			active = false;
 		}
 		return active ? ' mdl-color-text--accent' : '';
@@ -83,7 +134,20 @@ var App = React.createClass({
 	},
 	render: function() {
 		var overlay;
-		if (!this.state.connected) {
+		if (this.state.login) {
+			overlay = (
+				<div id="overlay">
+					<form id="overlay-text" onSubmit={this.login}>
+						moggio password or token
+						<p/>
+						<input type="password" value={this.state.password} onChange={this.passwordChange} autoFocus={true} />
+						<Button raised={true} colored={true}>login</Button>
+						<p/>
+						{this.state.loginError}
+					</form>
+				</div>
+			);
+		} else if (!this.state.connected) {
 			overlay = (
 				<div id="overlay">
 					<div id="overlay-text">
@@ -109,6 +173,13 @@ var App = React.createClass({
 		var menuItems = _.map(navMenuItems, function(v, k) {
 			return <Link key={k} className={"mdl-navigation__link" + this.routeClass(v.route)} to={v.route}>{v.text}</Link>;
 		}.bind(this));
+		if (this.state.auth && this.state.auth.Enabled) {
+			menuItems.push(
+				<a key="auth" href="" onClick={this.authLogout} className="mdl-navigation__link">
+					log out ({this.state.auth.Role})
+				</a>
+			);
+		}
 		if (this.state.CentralURL) {
 			if (this.state.Username) {
 				var un = (
@@ -120,7 +191,10 @@ var App = React.createClass({
 				);
 				menuItems.unshift(un);
 			} else {
-				var origin = location.protocol + '//' + location.host + '/api/token/register';
+				var origin = location.protocol + '//' + location.host + Moggio.prefix + '/api/token/register';
+				if (this.state.auth) {
+					origin += '?csrf=' + encodeURIComponent(this.state.auth.CSRF);
+				}
 				var params = "?redirect=" + encodeURIComponent(origin);
 				if (this.state.Hostname) {
 					params += '&hostname=' + encodeURIComponent(this.state.Hostname);
@@ -281,7 +355,7 @@ var Player = React.createClass({
 				left: '0',
 			};
 			if (info.ImageURL) {
-				img = <img style={istyle} src={info.ImageURL}/>;
+				img = <img style={istyle} src={Moggio.url(info.ImageURL)}/>;
 			} else {
 				img = <div style={istyle} className="mdl-color--grey-300"/>;
 			}
@@ -355,13 +429,13 @@ var Player = React.createClass({
 });
 
 var routes = (
-	<Route name="app" path="/" handler={App}>
+	<Route name="app" path={Moggio.prefix + '/'} handler={App}>
 		<DefaultRoute handler={List.TrackList} />
-		<Route name="album" path="/album/:Album" handler={List.Album} />
-		<Route name="albums" path="/albums" handler={Group.Albums} />
-		<Route name="artist" path="/artist/:Artist" handler={List.Artist} />
-		<Route name="artists" path="/artists" handler={Group.Artists} />
-		<Route name="playlist" path="/playlist/:Playlist" handler={Playlist.Playlist} />
+		<Route name="album" path="album/:Album" handler={List.Album} />
+		<Route name="albums" path="albums" handler={Group.Albums} />
+		<Route name="artist" path="artist/:Artist" handler={List.Artist} />
+		<Route name="artists" path="artists" handler={Group.Artists} />
+		<Route name="playlist" path="playlist/:Playlist" handler={Playlist.Playlist} />
 		<Route name="protocols" handler={Protocol.Protocols} />
 		<Route name="queue" handler={Playlist.Queue} />
 	</Route>
@@ -60,10 +60,12 @@ exports.Protocols = React.createClass({
 		if (!_.isEmpty(this.state.InProgress)) {
 			var insts = _.map(this.state.InProgress, function(v, id) {
 				var sp = id.split('\n');
+				var progress = v.Total ? v.Done + ' / ' + v.Total : '';
 				return (
 					<tr key={sp}>
 						<td>{sp[0]}</td>
 						<td>{sp[1]}</td>
+						<td>{progress}</td>
 					</tr>
 				);
 			});
@@ -75,6 +77,7 @@ exports.Protocols = React.createClass({
 							<tr>
 								<th className="mdl-data-table__cell--non-numeric">protocol</th>
 								<th className="mdl-data-table__cell--non-numeric">name</th>
+								<th>files</th>
 							</tr>
 						</thead>
 						<tbody>
@@ -11,9 +11,11 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/julienschmidt/httprouter"
+	"github.com/mjibson/moggio/output"
 	"github.com/mjibson/moggio/protocol"
 	"golang.org/x/net/websocket"
 )
@@ -40,41 +42,112 @@ func (srv *Server) GetMux(devMode bool) *http.ServeMux {
 	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, struct {
 		Version string
+		Prefix  string
 	}{
 		MoggioVersion,
+		PathPrefix,
 	}); err != nil {
 		log.Fatal(err)
 	}
 	indexHTML = buf.Bytes()
 	router := httprouter.New()
-	router.GET("/api/cmd/:cmd", JSON(srv.Cmd))
-	router.GET("/api/data/:type", JSON(srv.Data))
-	router.GET("/api/oauth/:protocol", srv.OAuth)
-	router.POST("/api/cmd/:cmd", JSON(srv.Cmd))
-	router.POST("/api/queue/change", JSON(srv.QueueChange))
-	router.POST("/api/playlist/change/:playlist", JSON(srv.PlaylistChange))
-	router.POST("/api/protocol/add", JSON(srv.ProtocolAdd))
-	router.POST("/api/protocol/remove", JSON(srv.ProtocolRemove))
-	router.POST("/api/protocol/refresh", JSON(srv.ProtocolRefresh))
+	read := func(h httprouter.Handle) httprouter.Handle {
+		return srv.authorize(roleRead, h)
+	}
+	admin := func(h httprouter.Handle) httprouter.Handle {
+		return srv.authorize(roleAdmin, h)
+	}
+	// Old clients send commands by GET.
+	router.GET("/api/cmd/:cmd", admin(srv.requireCSRF(JSON(srv.Cmd))))
+	router.GET("/api/data/:type", read(JSON(srv.Data)))
+	router.GET("/api/oauth/:protocol", admin(srv.OAuth))
+	router.GET("/api/art/:hash", read(srv.Art))
+	router.GET("/api/search", read(JSON(srv.Search)))
+	router.GET("/api/library", read(JSON(srv.Library)))
+	router.POST("/api/cmd/:cmd", admin(JSON(srv.Cmd)))
+	router.POST("/api/queue/change", admin(JSON(srv.QueueChange)))
+	router.POST("/api/playlist/change/:playlist", admin(JSON(srv.PlaylistChange)))
+	router.POST("/api/protocol/add", admin(JSON(srv.ProtocolAdd)))
+	router.POST("/api/protocol/remove", admin(JSON(srv.ProtocolRemove)))
+	router.POST("/api/protocol/refresh", admin(JSON(srv.ProtocolRefresh)))
+	router.POST("/api/output/add", admin(JSON(srv.OutputAdd)))
+	router.POST("/api/output/remove", admin(JSON(srv.OutputRemove)))
+	router.POST("/api/output/set", admin(JSON(srv.OutputSet)))
+
+	// Version 1 of the API. See api.go.
+	router.GET("/api/v1/openapi.json", srv.OpenAPI)
+	router.GET("/api/v1/status", read(apiJSON(srv.APIStatus)))
+	router.POST("/api/v1/cmd/:cmd", admin(apiJSON(srv.APICmd)))
+	router.GET("/api/v1/queue", read(apiJSON(srv.APIQueue)))
+	router.POST("/api/v1/queue", admin(apiJSON(srv.APIQueueChange)))
+	router.GET("/api/v1/playlists", read(apiJSON(srv.APIPlaylists)))
+	router.GET("/api/v1/playlists/:name", read(apiJSON(srv.APIPlaylist)))
+	router.POST("/api/v1/playlists/:name", admin(apiJSON(srv.APIPlaylistChange)))
+	router.DELETE("/api/v1/playlists/:name", admin(apiJSON(srv.APIPlaylistDelete)))
+	router.GET("/api/v1/sources", read(apiJSON(srv.APISources)))
+	router.POST("/api/v1/sources", admin(apiJSON(srv.APISourceAdd)))
+	router.DELETE("/api/v1/sources", admin(apiJSON(srv.APISourceRemove)))
+	router.POST("/api/v1/sources/refresh", admin(apiJSON(srv.APISourceRefresh)))
+	router.GET("/api/v1/tracks", read(apiJSON(srv.APITracks)))
+	router.GET("/api/v1/track", read(apiJSON(srv.APITrack)))
+	router.GET("/api/v1/search", read(apiJSON(srv.APISearch)))
+
+	// Open to all, so that clients can log in.
+	router.GET("/api/auth", srv.Auth)
+	router.POST("/api/auth/login", srv.Login)
+	router.POST("/api/auth/logout", srv.Logout)
+	router.POST("/api/auth/password", admin(srv.SetPassword))
+	router.GET("/api/auth/tokens", admin(JSON(srv.Tokens)))
+	router.POST("/api/auth/token/add", admin(JSON(srv.TokenAdd)))
+	router.POST("/api/auth/token/remove", admin(JSON(srv.TokenRemove)))
 
 	// Needs POST from local moggio. Needs GET from App Engine redirect.
-	router.GET("/api/token/register", srv.TokenRegister)
-	router.POST("/api/token/register", srv.TokenRegister)
+	router.GET("/api/token/register", admin(srv.TokenRegister))
+	router.POST("/api/token/register", admin(srv.TokenRegister))
 
 	mux := http.NewServeMux()
 	mux.Handle("/static/", http.FileServer(webFS))
 	mux.HandleFunc("/", Index)
 	mux.Handle("/api/", router)
-	mux.Handle("/ws/", websocket.Handler(srv.WebSocket))
+	mux.Handle("/ws/", srv.authorizeHandler(roleRead, websocket.Handler(srv.WebSocket)))
+	mux.Handle("/stream", srv.authorizeHandler(roleRead, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s := output.HTTPStream()
+		if s == nil {
+			http.NotFound(w, r)
+			return
+		}
+		s.ServeHTTP(w, r)
+	})))
 	return mux
 }
 
-// ListenAndServe listens on the TCP network address addr and then calls
-// Serve to handle requests on incoming connections.
+// ListenAndServe listens on the TCP network address or Unix socket addr and
+// then calls Serve to handle requests on incoming connections, over TLS if
+// configured.
 func (srv *Server) ListenAndServe(addr string, devMode bool) error {
-	mux := srv.GetMux(devMode)
+	var h http.Handler = srv.GetMux(devMode)
+	if PathPrefix != "" {
+		mux := http.NewServeMux()
+		mux.Handle(PathPrefix+"/", http.StripPrefix(PathPrefix, h))
+		h = mux
+	}
+	cfg, err := srv.tlsConfig()
+	if err != nil {
+		return err
+	}
+	l, err := listen(addr)
+	if err != nil {
+		return err
+	}
 	log.Println("moggio: listening on", addr)
-	return http.ListenAndServe(addr, mux)
+	s := &http.Server{
+		Handler:   h,
+		TLSConfig: cfg,
+	}
+	if cfg != nil {
+		return s.ServeTLS(l, "", "")
+	}
+	return s.Serve(l)
 }
 
 func Index(w http.ResponseWriter, r *http.Request) {
@@ -122,7 +195,7 @@ func (srv *Server) OAuth(w http.ResponseWriter, r *http.Request, ps httprouter.P
 		serveError(w, err)
 		return
 	}
-	http.Redirect(w, r, "/", http.StatusFound)
+	http.Redirect(w, r, PathPrefix+"/", http.StatusFound)
 }
 
 func (srv *Server) Data(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
@@ -168,6 +241,22 @@ func (srv *Server) Cmd(body io.Reader, form url.Values, ps httprouter.Params) (i
 			return nil, err
 		}
 		srv.ch <- cmdSeek(d)
+	case "volume":
+		v, err := strconv.ParseFloat(form.Get("v"), 64)
+		if err != nil {
+			return nil, err
+		}
+		srv.ch <- cmdVolume(v)
+	case "mute":
+		srv.ch <- cmdMute
+	case "crossfade":
+		d, err := time.ParseDuration(form.Get("d"))
+		if err != nil {
+			return nil, err
+		}
+		srv.ch <- cmdCrossfade(d)
+	case "replaygain":
+		return nil, srv.runChecked(cmdReplayGain(form.Get("mode")))
 	case "min_duration":
 		d, err := time.ParseDuration(form.Get("d"))
 		if err != nil {
@@ -252,12 +341,68 @@ func (srv *Server) ProtocolRemove(body io.Reader, form url.Values, ps httprouter
 	return nil, nil
 }
 
+// OutputData names an output sink. See output.Parse for the format of Name.
+type OutputData struct {
+	Name string
+}
+
+func (srv *Server) OutputAdd(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var od OutputData
+	if err := json.NewDecoder(body).Decode(&od); err != nil {
+		return nil, err
+	}
+	// A file sink writes wherever it is told, so only the -output flag may
+	// name one.
+	if strings.HasPrefix(od.Name, "file:") {
+		return nil, fmt.Errorf("file outputs can only be set with -output")
+	}
+	ch := make(chan error)
+	srv.ch <- cmdOutputAdd{
+		name: od.Name,
+		done: ch,
+	}
+	return nil, <-ch
+}
+
+func (srv *Server) OutputRemove(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var od OutputData
+	if err := json.NewDecoder(body).Decode(&od); err != nil {
+		return nil, err
+	}
+	ch := make(chan error)
+	srv.ch <- cmdOutputRemove{
+		name: od.Name,
+		done: ch,
+	}
+	return nil, <-ch
+}
+
+func (srv *Server) OutputSet(body io.Reader, form url.Values, ps httprouter.Params) (interface{}, error) {
+	var si output.SinkInfo
+	if err := json.NewDecoder(body).Decode(&si); err != nil {
+		return nil, err
+	}
+	ch := make(chan error)
+	srv.ch <- cmdOutputSet{
+		SinkInfo: si,
+		done:     ch,
+	}
+	return nil, <-ch
+}
+
 type ProtocolData struct {
 	Protocol string
 	Key      string
 }
 
+// TokenRegister sets the token of the central server, or clears it if empty.
+// The central server sends the browser here with a GET, which must carry the
+// csrf parameter from /api/auth since the session cookie goes with it.
 func (srv *Server) TokenRegister(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	if r.Method == "GET" && !srv.checkCSRF(r) {
+		http.Error(w, "bad csrf token", http.StatusForbidden)
+		return
+	}
 	srv.ch <- cmdTokenRegister(r.FormValue("token"))
-	http.Redirect(w, r, "/", 302)
+	http.Redirect(w, r, PathPrefix+"/", 302)
 }
@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/mjibson/moggio/codec"
 	"github.com/mjibson/moggio/protocol"
@@ -20,7 +21,7 @@ const (
 	waitStatus    waitType = "status"
 	waitPlaylist           = "playlist"
 	waitProtocols          = "protocols"
-	waitTracks             = "tracks"
+	waitLibrary            = "library"
 	waitError              = "error"
 )
 
@@ -35,14 +36,15 @@ func (srv *Server) makeWaitData(wt waitType) *waitData {
 				protos[p] = append(protos[p], key)
 			}
 		}
-		data = struct {
-			Available  map[string]protocol.Params
-			Current    map[string][]string
-			InProgress map[codec.ID]bool
-		}{
-			protocol.Get(),
-			protos,
-			srv.inprogress,
+		inprogress := make(map[codec.ID]*Progress)
+		for id, p := range srv.inprogress {
+			pr := *p
+			inprogress[id] = &pr
+		}
+		data = &Protocols{
+			Available:  protocol.Get(),
+			Current:    protos,
+			InProgress: inprogress,
 		}
 	case waitStatus:
 		hostname, _ := os.Hostname()
@@ -54,28 +56,14 @@ func (srv *Server) makeWaitData(wt waitType) *waitData {
 			Time:       srv.info.Time,
 			Random:     srv.Random,
 			Repeat:     srv.Repeat,
+			Volume:     srv.volume(),
+			Mute:       srv.Mute,
+			Crossfade:  srv.Crossfade,
+			ReplayGain: srv.ReplayGain,
 			Username:   srv.Username,
 			Hostname:   hostname,
 			CentralURL: srv.centralURL,
-		}
-	case waitTracks:
-		var songs []listItem
-		for name, protos := range srv.Protocols {
-			for key, inst := range protos {
-				sl, _ := inst.List()
-				for id, info := range sl {
-					sid := SongID(codec.NewID(name, key, string(id)))
-					songs = append(songs, listItem{
-						ID:   sid,
-						Info: info,
-					})
-				}
-			}
-		}
-		data = struct {
-			Tracks []listItem
-		}{
-			Tracks: songs,
+			Outputs:    srv.outputs.Sinks(),
 		}
 	case waitPlaylist:
 		d := struct {
@@ -98,18 +86,35 @@ func (srv *Server) makeWaitData(wt waitType) *waitData {
 	}
 }
 
+// Protocols are the protocols that can be added, the keys of the added
+// instances of each, and the progress of the instances refreshing.
+type Protocols struct {
+	Available  map[string]protocol.Params
+	Current    map[string][]string
+	InProgress map[codec.ID]*Progress
+}
+
 type cmdNewWS struct {
 	ws   *websocket.Conn
 	done chan struct{}
+	// seq is the library sequence number the client has, or -1.
+	seq int64
 }
 
 type cmdDeleteWS *websocket.Conn
 
+// WebSocket sends updates to a client. A client that already has the library
+// passes its sequence number in the seq parameter to get only the diffs since.
 func (srv *Server) WebSocket(ws *websocket.Conn) {
+	seq, err := strconv.ParseInt(ws.Request().FormValue("seq"), 10, 64)
+	if err != nil {
+		seq = -1
+	}
 	c := make(chan struct{})
 	srv.ch <- cmdNewWS{
 		ws:   ws,
 		done: c,
+		seq:  seq,
 	}
 	for range c {
 	}